@@ -0,0 +1,158 @@
+package gotextenc
+
+// Big5Encoder encodes Unicode as one of the Big5 variants. For HKSCS, a base
+// character that may combine with the following character into a single code
+// is held back until that character, or the end of input, is seen.
+type Big5Encoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	Variant Big5Variant
+	// WHATWG makes the encoder follow the big5 encoder of the WHATWG Encoding
+	// Standard instead of Variant: HKSCS without the codes with lead bytes
+	// below 0xA1, and so without pairs, and nothing but ASCII in single bytes.
+	WHATWG bool
+	// held is a base character not yet encoded, zero meaning none
+	held rune
+	heldOffset uint64
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [2]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *Big5Encoder[SourceT]) Reset(offset uint64) {
+	enc.held = 0
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *Big5Encoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+// encodeRune queues the representation of r for output.
+func(enc *Big5Encoder[SourceT]) encodeRune(
+	r rune,
+	offset uint64,
+	destBytes []byte,
+	outCount *int,
+) (err error, permanent bool) {
+	encodeMap := enc.Variant.encodeMap()
+	if enc.WHATWG {
+		encodeMap = whatwgBig5Codes()
+	}
+	if b, found := enc.Variant.encodeSingle(r); found && (!enc.WHATWG || r < 0x80) {
+		enc.outBuffer[0] = b
+		enc.replacement = putChars(enc.outBuffer[:1], destBytes, outCount)
+	} else if code, found := encodeMap[r]; found {
+		enc.outBuffer = [2]byte {byte(code >> 8), byte(code)}
+		enc.replacement = putChars(enc.outBuffer[:], destBytes, outCount)
+	} else {
+		enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+	}
+	return
+}
+
+func(enc *Big5Encoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF {
+				break
+			}
+			if enc.held != 0 {
+				held := enc.held
+				enc.held = 0
+				err, permanent = enc.encodeRune(held, enc.heldOffset, destBytes, &outCount)
+			} else if enc.surrogateHalf != 0 {
+				enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+					enc.offset - 1,
+					enc.surrogateHalf,
+				)
+				enc.surrogateHalf = 0
+			} else {
+				break
+			}
+		} else if enc.held != 0 {
+			held := enc.held
+			enc.held = 0
+			// combining characters are in the BMP, so a single unit will do
+			if code, found := enc.Variant.encodePair(held, rune(srcChars[consumed])); found {
+				consumed++
+				enc.offset++
+				enc.outBuffer = [2]byte {byte(code >> 8), byte(code)}
+				enc.replacement = putChars(enc.outBuffer[:], destBytes, &outCount)
+				continue
+			}
+			// the next character is processed afresh
+			err, permanent = enc.encodeRune(held, enc.heldOffset, destBytes, &outCount)
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					if !enc.WHATWG && enc.Variant.isPairBase(r) {
+						enc.held = r
+						enc.heldOffset = offset
+						continue
+					}
+					err, permanent = enc.encodeRune(r, offset, destBytes, &outCount)
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &Big5Encoder[rune]{}
+var _ Codec[uint16, byte] = &Big5Encoder[uint16]{}
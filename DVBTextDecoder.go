@@ -0,0 +1,244 @@
+package gotextenc
+
+import (
+	"fmt"
+)
+
+type DVBTextFlags uint8
+
+const (
+	DVBTXTFL_KEEP_EMPHASIS DVBTextFlags = 1 << iota
+	DVBTXTFL_KEEP_CONTROL_CODES
+)
+
+const (
+	DVB_EMPHASIS_ON rune = 0xE086
+	DVB_EMPHASIS_OFF rune = 0xE087
+)
+
+type dvbtxtState uint8
+
+const (
+	dvbtxt_SELECTOR dvbtxtState = iota
+	dvbtxt_TABLE_HIGH
+	dvbtxt_TABLE_LOW
+	dvbtxt_BODY
+)
+
+// DVBTextDecoder decodes a text field as per ETSI EN 300 468 Annex A: The
+// leading selector bytes choose the character table, the rest of the field is
+// decoded by the codec registered under that table's name. Control codes
+// 0x80..0x9F (0xE080..0xE09F for multi-byte tables) are removed, except for
+// CR/LF (0x8A), which becomes '\n', and emphasis on/off (0x86/0x87), which
+// becomes DVB_EMPHASIS_ON/DVB_EMPHASIS_OFF if DVBTXTFL_KEEP_EMPHASIS is set.
+// A decoder handles a single text field; Reset it before decoding the next.
+type DVBTextDecoder[TargetT CharLike] struct {
+	ErrorHandler MultiByteDecodingErrorHandler[TargetT]
+	Flags DVBTextFlags
+	state dvbtxtState
+	tableHigh byte
+	controlBase rune
+	body Codec[byte, rune]
+	offset uint64
+	runeBuffer [64]rune
+	runes []rune
+	outBuffer [2]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *DVBTextDecoder[TargetT]) Reset(offset uint64) {
+	dec.state = dvbtxt_SELECTOR
+	dec.body = nil
+	dec.offset = offset
+	dec.runes = nil
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *DVBTextDecoder[TargetT]) errorHandler() MultiByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+// dvbCharacterTable returns the registry name of the character table chosen
+// by selector (which is empty for the default table), or "" if there is none.
+func dvbCharacterTable(selector []byte) (name string, multiByte bool) {
+	switch {
+		case len(selector) == 0:
+			name = "ISO_6937"
+		case selector[0] >= 0x01 && selector[0] <= 0x0B:
+			if selector[0] != 0x08 {
+				name = fmt.Sprintf("ISO-8859-%d", selector[0] + 4)
+			}
+		case selector[0] == 0x10:
+			if selector[1] == 0x00 && selector[2] >= 0x01 && selector[2] <= 0x0F && selector[2] != 0x0C {
+				name = fmt.Sprintf("ISO-8859-%d", selector[2])
+			}
+		case selector[0] == 0x11:
+			name, multiByte = "UTF-16BE", true
+		case selector[0] == 0x12:
+			name, multiByte = "EUC-KR", true
+		case selector[0] == 0x13:
+			name, multiByte = "GB2312", true
+		case selector[0] == 0x14:
+			name, multiByte = "Big5", true
+		case selector[0] == 0x15:
+			name, multiByte = "UTF-8", true
+	}
+	return
+}
+
+func(dec *DVBTextDecoder[TargetT]) selectBody(selector []byte) error {
+	name, multiByte := dvbCharacterTable(selector)
+	switch {
+		case name == "UTF-8":
+			// decoded directly rather than by whatever is registered as UTF-8
+			dec.body = &UTF8Decoder[rune]{}
+		case name != "":
+			dec.body = NewCodec14(LookupEncoding14(name))
+	}
+	if dec.body == nil {
+		dec.permanentError = &UnsupportedCharacterTableError {
+			Offset: dec.offset - uint64(len(selector)),
+			Selector: append([]byte(nil), selector...),
+		}
+		return dec.permanentError
+	}
+	dec.body.Reset(dec.offset)
+	if multiByte {
+		dec.controlBase = 0xE080
+	} else {
+		dec.controlBase = 0x80
+	}
+	dec.state = dvbtxt_BODY
+	return nil
+}
+
+// emitRunes moves the runes decoded by the body codec to destChars.
+func(dec *DVBTextDecoder[TargetT]) emitRunes(
+	destChars []TargetT,
+	outCount *int,
+) (err error, permanent bool) {
+	for len(dec.runes) > 0 && len(dec.replacement) == 0 && *outCount < len(destChars) {
+		r := dec.runes[0]
+		dec.runes = dec.runes[1:]
+		if r >= dec.controlBase && r < dec.controlBase + 0x20 {
+			switch r - dec.controlBase {
+				case 0x06:
+					if (dec.Flags & DVBTXTFL_KEEP_EMPHASIS) == 0 {
+						continue
+					}
+					r = DVB_EMPHASIS_ON
+				case 0x07:
+					if (dec.Flags & DVBTXTFL_KEEP_EMPHASIS) == 0 {
+						continue
+					}
+					r = DVB_EMPHASIS_OFF
+				case 0x0A:
+					r = '\n'
+				default:
+					if (dec.Flags & DVBTXTFL_KEEP_CONTROL_CODES) == 0 {
+						continue
+					}
+			}
+		}
+		var units []TargetT
+		units, err, permanent = appendDecodedRune[TargetT](dec.outBuffer[:0], r, dec.offset, dec.errorHandler())
+		dec.replacement = putChars(units, destChars, outCount)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func(dec *DVBTextDecoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if len(dec.runes) > 0 {
+			err, permanent = dec.emitRunes(destChars, &outCount)
+		} else if dec.state == dvbtxt_BODY {
+			runeCount := minInt(len(dec.runeBuffer), len(destChars) - outCount)
+			bodyConsumed, bodyOutCount, bodyErr := dec.body.Transcode(
+				srcBytes[consumed:],
+				dec.runeBuffer[:runeCount],
+				atEOF,
+			)
+			consumed += bodyConsumed
+			dec.offset += uint64(bodyConsumed)
+			dec.runes = dec.runeBuffer[:bodyOutCount]
+			err, permanent = dec.emitRunes(destChars, &outCount)
+			if err == nil {
+				err = bodyErr
+			}
+			if err == nil && bodyConsumed == 0 && bodyOutCount == 0 {
+				break
+			}
+		} else if consumed >= len(srcBytes) {
+			if !atEOF || dec.state == dvbtxt_SELECTOR {
+				break
+			}
+			selector := []byte {0x10}
+			if dec.state == dvbtxt_TABLE_LOW {
+				selector = append(selector, dec.tableHigh)
+			}
+			dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+				dec.offset - uint64(len(selector)),
+				selector,
+			)
+			dec.state = dvbtxt_SELECTOR
+		} else {
+			b := srcBytes[consumed]
+			switch dec.state {
+				case dvbtxt_SELECTOR:
+					if b >= 0x20 {
+						// no selector => default table
+						err = dec.selectBody(nil)
+					} else {
+						consumed++
+						dec.offset++
+						if b == 0x10 {
+							dec.state = dvbtxt_TABLE_HIGH
+						} else {
+							err = dec.selectBody([]byte {b})
+						}
+					}
+				case dvbtxt_TABLE_HIGH:
+					consumed++
+					dec.offset++
+					dec.tableHigh = b
+					dec.state = dvbtxt_TABLE_LOW
+				case dvbtxt_TABLE_LOW:
+					consumed++
+					dec.offset++
+					err = dec.selectBody([]byte {0x10, dec.tableHigh, b})
+			}
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &DVBTextDecoder[rune]{}
+var _ Codec[byte, uint16] = &DVBTextDecoder[uint16]{}
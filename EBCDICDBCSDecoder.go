@@ -0,0 +1,131 @@
+package gotextenc
+
+// EBCDICDBCSDecoder decodes one of the IBM EBCDIC code pages mixing single-byte
+// and double-byte characters. With EBCDICSHIFT_SO_SI, the decoder starts out in
+// single-byte mode; data ending in double-byte mode is accepted.
+type EBCDICDBCSDecoder[TargetT CharLike] struct {
+	ErrorHandler DoubleByteDecodingErrorHandler[TargetT]
+	Variant EBCDICDBCSVariant
+	Shifts EBCDICShiftMode
+	// shifted tells whether SO has been seen without SI since
+	shifted bool
+	lead byte
+	offset uint64
+	outBuffer [4]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *EBCDICDBCSDecoder[TargetT]) Reset(offset uint64) {
+	dec.shifted = false
+	dec.lead = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *EBCDICDBCSDecoder[TargetT]) errorHandler() DoubleByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *EBCDICDBCSDecoder[TargetT]) isShift(b byte) bool {
+	return (b == ebcdic_SO || b == ebcdic_SI) && dec.Shifts == EBCDICSHIFT_SO_SI
+}
+
+func(dec *EBCDICDBCSDecoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF || dec.lead == 0 {
+				break
+			}
+			dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+				dec.offset - 1,
+				[]byte {dec.lead},
+			)
+			dec.lead = 0
+		} else if b := srcBytes[consumed]; dec.lead != 0 {
+			lead := dec.lead
+			dec.lead = 0
+			if !isEBCDICDBCSByte(b) {
+				dec.replacement, err, permanent = dec.errorHandler().InvalidTrailByte(dec.offset - 1, lead, b)
+				if !dec.isShift(b) {
+					consumed++
+					dec.offset++
+				}
+				// else process b afresh
+			} else {
+				consumed++
+				dec.offset++
+				chars, count := dec.Variant.decodeDouble(lead, b)
+				units := dec.outBuffer[:0]
+				for index := 0; index < count && err == nil; index++ {
+					units, err, permanent = appendDecodedRune[TargetT](
+						units,
+						chars[index],
+						dec.offset - 2,
+						dec.errorHandler(),
+					)
+				}
+				if count > 0 {
+					dec.replacement = putChars(units, destChars, &outCount)
+				} else {
+					dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+						dec.offset - 2,
+						[]byte {lead, b},
+					)
+				}
+			}
+		} else if dec.isShift(b) {
+			dec.shifted = b == ebcdic_SO
+			consumed++
+			dec.offset++
+			continue
+		} else if dec.shifted || dec.Shifts == EBCDICSHIFT_NONE {
+			consumed++
+			dec.offset++
+			if !isEBCDICDBCSByte(b) {
+				dec.replacement, err, permanent = dec.errorHandler().IllegalLeadByte(dec.offset - 1, b)
+			} else {
+				dec.lead = b
+				continue
+			}
+		} else if char, mapped := dec.Variant.decodeSingle(b); mapped {
+			destChars[outCount] = TargetT(char)
+			outCount++
+			consumed++
+			dec.offset++
+			continue
+		} else {
+			consumed++
+			dec.offset++
+			dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(dec.offset - 1, []byte {b})
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &EBCDICDBCSDecoder[rune]{}
+var _ Codec[byte, uint16] = &EBCDICDBCSDecoder[uint16]{}
@@ -0,0 +1,178 @@
+package gotextenc
+
+// EBCDICDBCSEncoder encodes Unicode as one of the IBM EBCDIC code pages mixing
+// single-byte and double-byte characters. With EBCDICSHIFT_SO_SI, runs of
+// double-byte characters are enclosed in SO and SI, the last one closed at the
+// end of input; with EBCDICSHIFT_NONE, only double-byte characters are
+// representable. For IBM-1390 and IBM-1399, a base character that may combine
+// with the following character into a single code is held back until that
+// character, or the end of input, is seen.
+type EBCDICDBCSEncoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	Variant EBCDICDBCSVariant
+	Shifts EBCDICShiftMode
+	// shifted tells whether SO has been written without SI since
+	shifted bool
+	// held is a base character not yet encoded, zero meaning none
+	held rune
+	heldOffset uint64
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [3]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *EBCDICDBCSEncoder[SourceT]) Reset(offset uint64) {
+	enc.shifted = false
+	enc.held = 0
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *EBCDICDBCSEncoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+// putDouble queues the double-byte code for output, preceded by SO if
+// necessary.
+func(enc *EBCDICDBCSEncoder[SourceT]) putDouble(code uint16, destBytes []byte, outCount *int) {
+	units := enc.outBuffer[:0]
+	if !enc.shifted && enc.Shifts == EBCDICSHIFT_SO_SI {
+		enc.shifted = true
+		units = append(units, ebcdic_SO)
+	}
+	units = append(units, byte(code >> 8), byte(code))
+	enc.replacement = putChars(units, destBytes, outCount)
+}
+
+// encodeRune queues the representation of r for output.
+func(enc *EBCDICDBCSEncoder[SourceT]) encodeRune(
+	r rune,
+	offset uint64,
+	destBytes []byte,
+	outCount *int,
+) (err error, permanent bool) {
+	if b, found := enc.Variant.encodeSingle(r); found && enc.Shifts == EBCDICSHIFT_SO_SI {
+		units := enc.outBuffer[:0]
+		if enc.shifted {
+			enc.shifted = false
+			units = append(units, ebcdic_SI)
+		}
+		units = append(units, b)
+		enc.replacement = putChars(units, destBytes, outCount)
+	} else if code, found := enc.Variant.encodeDouble(r); found {
+		enc.putDouble(code, destBytes, outCount)
+	} else {
+		enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+	}
+	return
+}
+
+func(enc *EBCDICDBCSEncoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF {
+				break
+			}
+			if enc.held != 0 {
+				held := enc.held
+				enc.held = 0
+				err, permanent = enc.encodeRune(held, enc.heldOffset, destBytes, &outCount)
+			} else if enc.surrogateHalf != 0 {
+				enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+					enc.offset - 1,
+					enc.surrogateHalf,
+				)
+				enc.surrogateHalf = 0
+			} else if enc.shifted {
+				enc.shifted = false
+				destBytes[outCount] = ebcdic_SI
+				outCount++
+				continue
+			} else {
+				break
+			}
+		} else if enc.held != 0 {
+			held := enc.held
+			enc.held = 0
+			// combining characters are in the BMP, so a single unit will do
+			if code, found := enc.Variant.encodePair(held, rune(srcChars[consumed])); found {
+				consumed++
+				enc.offset++
+				enc.putDouble(code, destBytes, &outCount)
+				continue
+			}
+			// the next character is processed afresh
+			err, permanent = enc.encodeRune(held, enc.heldOffset, destBytes, &outCount)
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					if enc.Variant.isPairBase(r) {
+						enc.held = r
+						enc.heldOffset = offset
+						continue
+					}
+					err, permanent = enc.encodeRune(r, offset, destBytes, &outCount)
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &EBCDICDBCSEncoder[rune]{}
+var _ Codec[uint16, byte] = &EBCDICDBCSEncoder[uint16]{}
@@ -0,0 +1,139 @@
+package gotextenc
+
+// EUCJPDecoder decodes EUC-JP: ASCII, JIS X 0208 as two bytes, JIS X 0201
+// katakana after SS2 and JIS X 0212 after SS3. C1 controls other than SS2
+// and SS3 are passed through.
+type EUCJPDecoder[TargetT CharLike] struct {
+	ErrorHandler DoubleByteDecodingErrorHandler[TargetT]
+	Mapping EUCJPMapping
+	sequence [3]byte
+	sequenceLength int
+	offset uint64
+	outBuffer [2]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *EUCJPDecoder[TargetT]) Reset(offset uint64) {
+	dec.sequenceLength = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *EUCJPDecoder[TargetT]) errorHandler() DoubleByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+// decodeSequence maps the complete sequence held in dec.sequence, returning
+// 0 if it is unmapped.
+func(dec *EUCJPDecoder[TargetT]) decodeSequence() rune {
+	sequence := dec.sequence[:dec.sequenceLength]
+	switch sequence[0] {
+		case eucJP_SS2:
+			if sequence[1] <= 0xDF {
+				// JIS X 0201 half-width katakana
+				return 0xFF61 + rune(sequence[1] - 0xA1)
+			}
+			return 0
+		case eucJP_SS3:
+			return dec.Mapping.decodeJISX0212(int(sequence[1] - 0xA0), int(sequence[2] - 0xA0))
+		default:
+			return dec.Mapping.decodeJISX0208(int(sequence[0] - 0xA0), int(sequence[1] - 0xA0))
+	}
+}
+
+func(dec *EUCJPDecoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF || dec.sequenceLength == 0 {
+				break
+			}
+			dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+				dec.offset - uint64(dec.sequenceLength),
+				append([]byte(nil), dec.sequence[:dec.sequenceLength]...),
+			)
+			dec.sequenceLength = 0
+		} else if b := srcBytes[consumed]; dec.sequenceLength > 0 {
+			if !isEUCJPByte(b) {
+				dec.replacement, err, permanent = dec.errorHandler().InvalidTrailByte(
+					dec.offset - uint64(dec.sequenceLength),
+					dec.sequence[0],
+					b,
+				)
+				// b may be ASCII, a control or the start of another sequence,
+				// so it is processed afresh
+				dec.sequenceLength = 0
+			} else {
+				dec.sequence[dec.sequenceLength] = b
+				dec.sequenceLength++
+				consumed++
+				dec.offset++
+				if dec.sequence[0] == eucJP_SS3 && dec.sequenceLength < 3 {
+					continue
+				}
+				offset := dec.offset - uint64(dec.sequenceLength)
+				if char := dec.decodeSequence(); char != 0 {
+					var units []TargetT
+					units, err, permanent = appendDecodedRune[TargetT](
+						dec.outBuffer[:0],
+						char,
+						offset,
+						dec.errorHandler(),
+					)
+					dec.replacement = putChars(units, destChars, &outCount)
+				} else {
+					dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+						offset,
+						append([]byte(nil), dec.sequence[:dec.sequenceLength]...),
+					)
+				}
+				dec.sequenceLength = 0
+			}
+		} else if b < 0xA0 && b != eucJP_SS2 && b != eucJP_SS3 {
+			// ASCII or C1
+			destChars[outCount] = TargetT(b)
+			outCount++
+			consumed++
+			dec.offset++
+			continue
+		} else if b == eucJP_SS2 || b == eucJP_SS3 || isEUCJPByte(b) {
+			dec.sequence[0] = b
+			dec.sequenceLength = 1
+			consumed++
+			dec.offset++
+			continue
+		} else {
+			consumed++
+			dec.offset++
+			dec.replacement, err, permanent = dec.errorHandler().IllegalLeadByte(dec.offset - 1, b)
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &EUCJPDecoder[rune]{}
+var _ Codec[byte, uint16] = &EUCJPDecoder[uint16]{}
@@ -0,0 +1,120 @@
+package gotextenc
+
+// EUCJPEncoder encodes Unicode as EUC-JP, resorting to JIS X 0212 for
+// characters missing from JIS X 0208.
+type EUCJPEncoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	Mapping EUCJPMapping
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [3]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *EUCJPEncoder[SourceT]) Reset(offset uint64) {
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *EUCJPEncoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(enc *EUCJPEncoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF || enc.surrogateHalf == 0 {
+				break
+			}
+			enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+				enc.offset - 1,
+				enc.surrogateHalf,
+			)
+			enc.surrogateHalf = 0
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					if r < 0xA0 && r != rune(eucJP_SS2) && r != rune(eucJP_SS3) {
+						// ASCII or C1
+						destBytes[outCount] = byte(r)
+						outCount++
+						continue
+					}
+					units := enc.outBuffer[:0]
+					if r >= 0xFF61 && r <= 0xFF9F {
+						// JIS X 0201 half-width katakana
+						units = append(units, eucJP_SS2, byte(r - 0xFF61 + 0xA1))
+					} else if code, found := enc.Mapping.encodeMap()[r]; found {
+						if code > 0xFFFF {
+							units = append(units, byte(code >> 16))
+						}
+						units = append(units, byte(code >> 8), byte(code))
+					}
+					if len(units) > 0 {
+						enc.replacement = putChars(units, destBytes, &outCount)
+					} else {
+						enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+					}
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &EUCJPEncoder[rune]{}
+var _ Codec[uint16, byte] = &EUCJPEncoder[uint16]{}
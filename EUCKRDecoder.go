@@ -0,0 +1,166 @@
+package gotextenc
+
+// EUCKRDecoder decodes EUC-KR or UHC. If JamoSequences is set, KS X 1001 jamo
+// sequences (HANGUL FILLER, initial, vowel and final or HANGUL FILLER) are
+// composed into Hangul syllables; incomplete or invalid ones decode as the
+// individual jamo.
+type EUCKRDecoder[TargetT CharLike] struct {
+	ErrorHandler DoubleByteDecodingErrorHandler[TargetT]
+	Variant EUCKRVariant
+	JamoSequences bool
+	lead byte
+	// jamoSequence holds the part of a KS X 1001 jamo sequence seen so far
+	jamoSequence [ksX1001_JAMO_SEQUENCE_LENGTH]byte
+	jamoLength int
+	offset uint64
+	outBuffer [4]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *EUCKRDecoder[TargetT]) Reset(offset uint64) {
+	dec.lead = 0
+	dec.jamoLength = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *EUCKRDecoder[TargetT]) errorHandler() DoubleByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+// abandonJamoSequence queues the jamo of the complete codes in the pending
+// jamo sequence for output; a trailing lead byte is kept as dec.lead.
+func(dec *EUCKRDecoder[TargetT]) abandonJamoSequence(destChars []TargetT, outCount *int) {
+	units := dec.outBuffer[:0]
+	for index := 1; index < dec.jamoLength; index += 2 {
+		units = append(units, TargetT(dec.Variant.decodeDouble(ksX1001_JAMO_LEAD, dec.jamoSequence[index])))
+	}
+	if dec.jamoLength % 2 != 0 {
+		dec.lead = ksX1001_JAMO_LEAD
+	}
+	dec.jamoLength = 0
+	dec.replacement = putChars(units, destChars, outCount)
+}
+
+func(dec *EUCKRDecoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF {
+				break
+			}
+			if dec.jamoLength > 0 {
+				dec.abandonJamoSequence(destChars, &outCount)
+				continue
+			}
+			if dec.lead == 0 {
+				break
+			}
+			dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+				dec.offset - 1,
+				[]byte {dec.lead},
+			)
+			dec.lead = 0
+		} else if b := srcBytes[consumed]; dec.jamoLength > 0 {
+			var valid bool
+			if dec.jamoLength % 2 == 0 {
+				valid = b == ksX1001_JAMO_LEAD
+			} else {
+				valid = isKSX1001JamoTrail(dec.jamoLength, b)
+			}
+			if !valid {
+				// b is processed afresh
+				dec.abandonJamoSequence(destChars, &outCount)
+				continue
+			}
+			dec.jamoSequence[dec.jamoLength] = b
+			dec.jamoLength++
+			consumed++
+			dec.offset++
+			if dec.jamoLength < len(dec.jamoSequence) {
+				continue
+			}
+			dec.jamoLength = 0
+			dec.outBuffer[0] = TargetT(composeKSX1001JamoSequence(dec.jamoSequence))
+			dec.replacement = putChars(dec.outBuffer[:1], destChars, &outCount)
+			continue
+		} else if dec.lead != 0 {
+			lead := dec.lead
+			dec.lead = 0
+			if !dec.Variant.isTrail(b) {
+				dec.replacement, err, permanent = dec.errorHandler().InvalidTrailByte(dec.offset - 1, lead, b)
+				if _, single := dec.Variant.decodeSingle(b); !single && !dec.Variant.isLead(b) {
+					consumed++
+					dec.offset++
+				}
+				// else process b afresh
+			} else {
+				consumed++
+				dec.offset++
+				if dec.JamoSequences && lead == ksX1001_JAMO_LEAD && b == ksX1001_JAMO_FILLER {
+					dec.jamoSequence[0], dec.jamoSequence[1] = lead, b
+					dec.jamoLength = 2
+					continue
+				}
+				if char := dec.Variant.decodeDouble(lead, b); char != 0 {
+					var units []TargetT
+					units, err, permanent = appendDecodedRune[TargetT](
+						dec.outBuffer[:0],
+						char,
+						dec.offset - 2,
+						dec.errorHandler(),
+					)
+					dec.replacement = putChars(units, destChars, &outCount)
+				} else {
+					dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+						dec.offset - 2,
+						[]byte {lead, b},
+					)
+				}
+			}
+		} else if char, single := dec.Variant.decodeSingle(b); single {
+			destChars[outCount] = TargetT(char)
+			outCount++
+			consumed++
+			dec.offset++
+			continue
+		} else if dec.Variant.isLead(b) {
+			dec.lead = b
+			consumed++
+			dec.offset++
+			continue
+		} else {
+			consumed++
+			dec.offset++
+			dec.replacement, err, permanent = dec.errorHandler().IllegalLeadByte(dec.offset - 1, b)
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &EUCKRDecoder[rune]{}
+var _ Codec[byte, uint16] = &EUCKRDecoder[uint16]{}
@@ -0,0 +1,148 @@
+package gotextenc
+
+// GB18030Decoder decodes GB 18030-2022. Incomplete two- and four-byte codes
+// are kept across calls.
+type GB18030Decoder[TargetT CharLike] struct {
+	ErrorHandler DoubleByteDecodingErrorHandler[TargetT]
+	// EuroAt80 makes the single byte 0x80, which GB 18030 leaves unassigned,
+	// decode as EURO SIGN, as it does in CP936 and the WHATWG Encoding
+	// Standard
+	EuroAt80 bool
+	sequence [4]byte
+	sequenceLength int
+	offset uint64
+	outBuffer [2]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *GB18030Decoder[TargetT]) Reset(offset uint64) {
+	dec.sequenceLength = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *GB18030Decoder[TargetT]) errorHandler() DoubleByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *GB18030Decoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF || dec.sequenceLength == 0 {
+				break
+			}
+			dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+				dec.offset - uint64(dec.sequenceLength),
+				append([]byte(nil), dec.sequence[:dec.sequenceLength]...),
+			)
+			dec.sequenceLength = 0
+		} else if b := srcBytes[consumed]; dec.sequenceLength > 0 {
+			var valid bool
+			switch dec.sequenceLength {
+				case 1:
+					_, isTrail := gbkTrailIndex(b)
+					valid = isTrail || isGB18030Digit(b)
+				case 2:
+					valid = isGB18030Lead(b)
+				default:
+					valid = isGB18030Digit(b)
+			}
+			if !valid {
+				dec.replacement, err, permanent = dec.errorHandler().InvalidTrailByte(
+					dec.offset - uint64(dec.sequenceLength),
+					dec.sequence[0],
+					b,
+				)
+				dec.sequenceLength = 0
+				if b >= 0x80 {
+					consumed++
+					dec.offset++
+				}
+				// else process ASCII b afresh
+			} else {
+				dec.sequence[dec.sequenceLength] = b
+				dec.sequenceLength++
+				consumed++
+				dec.offset++
+				var char rune
+				switch {
+					case dec.sequenceLength == 2 && !isGB18030Digit(b):
+						char = decodeGB18030Double(dec.sequence[0], b)
+					case dec.sequenceLength == 4:
+						char = decodeGB18030Four(dec.sequence)
+					default:
+						continue
+				}
+				offset := dec.offset - uint64(dec.sequenceLength)
+				if char != 0 {
+					var units []TargetT
+					units, err, permanent = appendDecodedRune[TargetT](
+						dec.outBuffer[:0],
+						char,
+						offset,
+						dec.errorHandler(),
+					)
+					dec.replacement = putChars(units, destChars, &outCount)
+				} else {
+					dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+						offset,
+						append([]byte(nil), dec.sequence[:dec.sequenceLength]...),
+					)
+				}
+				dec.sequenceLength = 0
+			}
+		} else if b < 0x80 {
+			destChars[outCount] = TargetT(b)
+			outCount++
+			consumed++
+			dec.offset++
+			continue
+		} else if isGB18030Lead(b) {
+			dec.sequence[0] = b
+			dec.sequenceLength = 1
+			consumed++
+			dec.offset++
+			continue
+		} else if b == cp936_EURO && dec.EuroAt80 {
+			char, _ := GBKVAR_CP936.decodeSingle(b)
+			destChars[outCount] = TargetT(char)
+			outCount++
+			consumed++
+			dec.offset++
+			continue
+		} else {
+			consumed++
+			dec.offset++
+			dec.replacement, err, permanent = dec.errorHandler().IllegalLeadByte(dec.offset - 1, b)
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &GB18030Decoder[rune]{}
+var _ Codec[byte, uint16] = &GB18030Decoder[uint16]{}
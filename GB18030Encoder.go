@@ -0,0 +1,122 @@
+package gotextenc
+
+// GB18030Encoder encodes Unicode as GB 18030-2022. Every character is
+// representable.
+type GB18030Encoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	// GBK makes the encoder put out no four-byte codes, and EURO SIGN as the
+	// single byte 0x80, as the GBK encoder of the WHATWG Encoding Standard
+	// does
+	GBK bool
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [4]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *GB18030Encoder[SourceT]) Reset(offset uint64) {
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *GB18030Encoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(enc *GB18030Encoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF || enc.surrogateHalf == 0 {
+				break
+			}
+			enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+				enc.offset - 1,
+				enc.surrogateHalf,
+			)
+			enc.surrogateHalf = 0
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					if r < 0x80 {
+						destBytes[outCount] = byte(r)
+						outCount++
+						continue
+					}
+					if r == 0x20AC && enc.GBK {
+						destBytes[outCount] = cp936_EURO
+						outCount++
+						continue
+					}
+					gb18030EncodeOnce.Do(loadGB18030EncodeMaps)
+					if code, found := gb18030DoubleByteEncodeMap[r]; found {
+						enc.outBuffer[0], enc.outBuffer[1] = byte(code >> 8), byte(code)
+						enc.replacement = putChars(enc.outBuffer[:2], destBytes, &outCount)
+					} else if enc.GBK {
+						enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+					} else {
+						enc.outBuffer = encodeGB18030Four(r)
+						enc.replacement = putChars(enc.outBuffer[:], destBytes, &outCount)
+					}
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &GB18030Encoder[rune]{}
+var _ Codec[uint16, byte] = &GB18030Encoder[uint16]{}
@@ -0,0 +1,119 @@
+package gotextenc
+
+type GBKDecoder[TargetT CharLike] struct {
+	ErrorHandler DoubleByteDecodingErrorHandler[TargetT]
+	Variant GBKVariant
+	lead byte
+	offset uint64
+	outBuffer [2]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *GBKDecoder[TargetT]) Reset(offset uint64) {
+	dec.lead = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *GBKDecoder[TargetT]) errorHandler() DoubleByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *GBKDecoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF || dec.lead == 0 {
+				break
+			}
+			dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+				dec.offset - 1,
+				[]byte {dec.lead},
+			)
+			dec.lead = 0
+		} else if b := srcBytes[consumed]; dec.lead != 0 {
+			lead := dec.lead
+			dec.lead = 0
+			if !dec.Variant.isTrail(b) {
+				dec.replacement, err, permanent = dec.errorHandler().InvalidTrailByte(dec.offset - 1, lead, b)
+				if b >= 0x80 {
+					consumed++
+					dec.offset++
+				}
+				// else process ASCII b afresh
+			} else {
+				consumed++
+				dec.offset++
+				if char := dec.Variant.decodeDouble(lead, b); char != 0 {
+					var units []TargetT
+					units, err, permanent = appendDecodedRune[TargetT](
+						dec.outBuffer[:0],
+						char,
+						dec.offset - 2,
+						dec.errorHandler(),
+					)
+					dec.replacement = putChars(units, destChars, &outCount)
+				} else {
+					dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+						dec.offset - 2,
+						[]byte {lead, b},
+					)
+				}
+			}
+		} else if b < 0x80 {
+			destChars[outCount] = TargetT(b)
+			outCount++
+			consumed++
+			dec.offset++
+			continue
+		} else if dec.Variant.isLead(b) {
+			dec.lead = b
+			consumed++
+			dec.offset++
+			continue
+		} else {
+			consumed++
+			dec.offset++
+			if char, found := dec.Variant.decodeSingle(b); found {
+				var units []TargetT
+				units, err, permanent = appendDecodedRune[TargetT](
+					dec.outBuffer[:0],
+					char,
+					dec.offset - 1,
+					dec.errorHandler(),
+				)
+				dec.replacement = putChars(units, destChars, &outCount)
+			} else {
+				dec.replacement, err, permanent = dec.errorHandler().IllegalLeadByte(dec.offset - 1, b)
+			}
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &GBKDecoder[rune]{}
+var _ Codec[byte, uint16] = &GBKDecoder[uint16]{}
@@ -0,0 +1,108 @@
+package gotextenc
+
+type GBKEncoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	Variant GBKVariant
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [2]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *GBKEncoder[SourceT]) Reset(offset uint64) {
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *GBKEncoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(enc *GBKEncoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF || enc.surrogateHalf == 0 {
+				break
+			}
+			enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+				enc.offset - 1,
+				enc.surrogateHalf,
+			)
+			enc.surrogateHalf = 0
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					if b, found := enc.Variant.encodeSingle(r); found {
+						destBytes[outCount] = b
+						outCount++
+						continue
+					}
+					if code, found := enc.Variant.encodeMap()[r]; found {
+						enc.outBuffer = [2]byte {byte(code >> 8), byte(code)}
+						enc.replacement = putChars(enc.outBuffer[:], destBytes, &outCount)
+					} else {
+						enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+					}
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &GBKEncoder[rune]{}
+var _ Codec[uint16, byte] = &GBKEncoder[uint16]{}
@@ -0,0 +1,155 @@
+package gotextenc
+
+// HZDecoder decodes HZ (RFC 1843): "~{" and "~}" switch to GB 2312 and back to
+// ASCII, "~~" stands for a tilde and "~" followed by a line break continues
+// the line. A line break in GB mode is taken to end it, as encoders are to end
+// GB mode before line breaks anyway.
+type HZDecoder[TargetT CharLike] struct {
+	ErrorHandler DoubleByteDecodingErrorHandler[TargetT]
+	// gb tells whether GB mode is in effect
+	gb bool
+	// escape tells whether "~" has been seen
+	escape bool
+	lead byte
+	offset uint64
+	outBuffer [2]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *HZDecoder[TargetT]) Reset(offset uint64) {
+	dec.gb = false
+	dec.escape = false
+	dec.lead = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *HZDecoder[TargetT]) errorHandler() DoubleByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *HZDecoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF {
+				break
+			}
+			switch {
+				case dec.escape:
+					dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+						dec.offset - 1,
+						[]byte {hz_ESCAPE},
+					)
+					dec.escape = false
+				case dec.lead != 0:
+					dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+						dec.offset - 1,
+						[]byte {dec.lead},
+					)
+					dec.lead = 0
+				default:
+					return
+			}
+		} else if b := srcBytes[consumed]; dec.escape {
+			dec.escape = false
+			valid := true
+			switch {
+				case b == hz_GB_MODE || b == hz_ASCII_MODE:
+					dec.gb = b == hz_GB_MODE
+				case b == hz_ESCAPE && !dec.gb:
+					destChars[outCount] = TargetT(hz_ESCAPE)
+					outCount++
+				case b == '\n' && !dec.gb:
+					// line continuation
+				default:
+					valid = false
+			}
+			if valid {
+				consumed++
+				dec.offset++
+				continue
+			}
+			// b is processed afresh
+			dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+				dec.offset - 1,
+				[]byte {hz_ESCAPE},
+			)
+		} else if dec.lead != 0 {
+			lead := dec.lead
+			dec.lead = 0
+			if b < 0x21 || b > 0x7E {
+				// b is processed afresh
+				dec.replacement, err, permanent = dec.errorHandler().InvalidTrailByte(dec.offset - 1, lead, b)
+			} else {
+				consumed++
+				dec.offset++
+				if char := GBKVAR_EUC_CN.decodeDouble(lead | 0x80, b | 0x80); char != 0 {
+					var units []TargetT
+					units, err, permanent = appendDecodedRune[TargetT](
+						dec.outBuffer[:0],
+						char,
+						dec.offset - 2,
+						dec.errorHandler(),
+					)
+					dec.replacement = putChars(units, destChars, &outCount)
+				} else {
+					dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+						dec.offset - 2,
+						[]byte {lead, b},
+					)
+				}
+			}
+		} else if b == hz_ESCAPE {
+			dec.escape = true
+			consumed++
+			dec.offset++
+			continue
+		} else if dec.gb && b > 0x20 && b < 0x7F {
+			dec.lead = b
+			consumed++
+			dec.offset++
+			continue
+		} else if b < 0x80 && (!dec.gb || b == '\r' || b == '\n') {
+			// a line break ends GB mode
+			dec.gb = false
+			destChars[outCount] = TargetT(b)
+			outCount++
+			consumed++
+			dec.offset++
+			continue
+		} else {
+			consumed++
+			dec.offset++
+			dec.replacement, err, permanent = dec.errorHandler().IllegalLeadByte(dec.offset - 1, b)
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &HZDecoder[rune]{}
+var _ Codec[byte, uint16] = &HZDecoder[uint16]{}
@@ -0,0 +1,135 @@
+package gotextenc
+
+// HZEncoder encodes Unicode as HZ (RFC 1843). GB mode is ended before each
+// ASCII character, so in particular before line breaks, and at the end of
+// input; tildes are doubled.
+type HZEncoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	// gb tells whether GB mode is in effect
+	gb bool
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [4]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *HZEncoder[SourceT]) Reset(offset uint64) {
+	enc.gb = false
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *HZEncoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+// switchMode appends the escape sequence switching to GB mode, or to ASCII
+// mode, to units if necessary.
+func(enc *HZEncoder[SourceT]) switchMode(units []byte, gb bool) []byte {
+	if enc.gb == gb {
+		return units
+	}
+	enc.gb = gb
+	if gb {
+		return append(units, hz_ESCAPE, hz_GB_MODE)
+	}
+	return append(units, hz_ESCAPE, hz_ASCII_MODE)
+}
+
+func(enc *HZEncoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF {
+				break
+			}
+			if enc.surrogateHalf != 0 {
+				enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+					enc.offset - 1,
+					enc.surrogateHalf,
+				)
+				enc.surrogateHalf = 0
+			} else if enc.gb {
+				enc.replacement = putChars(enc.switchMode(enc.outBuffer[:0], false), destBytes, &outCount)
+				continue
+			} else {
+				break
+			}
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					if r < 0x80 {
+						units := enc.switchMode(enc.outBuffer[:0], false)
+						if byte(r) == hz_ESCAPE {
+							units = append(units, hz_ESCAPE)
+						}
+						enc.replacement = putChars(append(units, byte(r)), destBytes, &outCount)
+					} else if code, found := GBKVAR_EUC_CN.encodeMap()[r]; found {
+						units := enc.switchMode(enc.outBuffer[:0], true)
+						units = append(units, byte(code >> 8) & 0x7F, byte(code) & 0x7F)
+						enc.replacement = putChars(units, destBytes, &outCount)
+					} else {
+						enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+					}
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &HZEncoder[rune]{}
+var _ Codec[uint16, byte] = &HZEncoder[uint16]{}
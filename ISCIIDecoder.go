@@ -0,0 +1,199 @@
+package gotextenc
+
+type ISCIIDecoder[TargetT CharLike] struct {
+	ErrorHandler MultiByteDecodingErrorHandler[TargetT]
+	// Script is the script in effect until the input selects another one
+	// by means of ATR.
+	Script ISCIIScript
+	// atrScript is the script selected by ATR plus one, or zero if there
+	// was no such selection
+	atrScript ISCIIScript
+	pending byte
+	offset uint64
+	outBuffer [4]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *ISCIIDecoder[TargetT]) Reset(offset uint64) {
+	dec.atrScript = 0
+	dec.pending = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *ISCIIDecoder[TargetT]) errorHandler() MultiByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *ISCIIDecoder[TargetT]) script() ISCIIScript {
+	if dec.atrScript != 0 {
+		return dec.atrScript - 1
+	}
+	return dec.Script
+}
+
+// emit queues the (already script-specific) characters chars for output.
+func(dec *ISCIIDecoder[TargetT]) emit(
+	offset uint64,
+	destChars []TargetT,
+	outCount *int,
+	chars ...rune,
+) (err error, permanent bool) {
+	units := dec.outBuffer[:0]
+	for _, char := range chars {
+		var charErr error
+		var charPermanent bool
+		units, charErr, charPermanent = appendDecodedRune[TargetT](units, char, offset, dec.errorHandler())
+		if charErr != nil && err == nil {
+			err, permanent = charErr, charPermanent
+		}
+	}
+	dec.replacement = putChars(units, destChars, outCount)
+	return
+}
+
+func(dec *ISCIIDecoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		script := dec.script()
+		if consumed >= len(srcBytes) {
+			if !atEOF || dec.pending == 0 {
+				break
+			}
+			pending := dec.pending
+			dec.pending = 0
+			if pending == iscii_ATR || pending == iscii_EXT {
+				dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+					dec.offset - 1,
+					[]byte {pending},
+				)
+			} else {
+				// nothing left to combine with
+				char, _ := script.scriptRune(isciiSingle(pending))
+				err, permanent = dec.emit(dec.offset - 1, destChars, &outCount, char)
+			}
+		} else {
+			b := srcBytes[consumed]
+			switch pending := dec.pending; {
+				case pending == iscii_ATR:
+					dec.pending = 0
+					newScript, selects, valid := isciiATRScript(b)
+					if !valid {
+						// report ATR on its own and process b afresh
+						dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+							dec.offset - 1,
+							[]byte {pending},
+						)
+						break
+					}
+					if selects {
+						dec.atrScript = newScript + 1
+					}
+					consumed++
+					dec.offset++
+					continue
+				case pending == iscii_EXT:
+					dec.pending = 0
+					consumed++
+					dec.offset++
+					char, found := isciiExtForms[b]
+					if found {
+						char, found = script.scriptRune(char)
+					}
+					if found {
+						err, permanent = dec.emit(dec.offset - 2, destChars, &outCount, char)
+					} else {
+						dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+							dec.offset - 2,
+							[]byte {pending, b},
+						)
+					}
+				case pending != 0:
+					// pending is known to be valid in script
+					offset := dec.offset - 1
+					dec.pending = 0
+					single, _ := script.scriptRune(isciiSingle(pending))
+					var chars [2]rune
+					var count int
+					switch {
+						case pending == iscii_HALANT && b == iscii_NUKTA:
+							// soft halant
+							chars, count = [2]rune {single, isciiZWJ}, 2
+						case pending == iscii_HALANT && b == iscii_HALANT:
+							// explicit halant
+							chars, count = [2]rune {single, isciiZWNJ}, 2
+						case pending == iscii_DANDA && b == iscii_DANDA:
+							chars, count = [2]rune {0x0965}, 1
+						case b == iscii_NUKTA:
+							if composed, found := script.scriptRune(isciiNuktaForms[pending]); found {
+								chars, count = [2]rune {composed}, 1
+							}
+					}
+					if count > 0 {
+						consumed++
+						dec.offset++
+					} else {
+						// no combination => emit pending on its own and
+						// process b afresh
+						chars, count = [2]rune {single}, 1
+					}
+					err, permanent = dec.emit(offset, destChars, &outCount, chars[:count]...)
+				case b < 0x80:
+					destChars[outCount] = TargetT(b)
+					outCount++
+					consumed++
+					dec.offset++
+					continue
+				default:
+					consumed++
+					dec.offset++
+					if b == iscii_ATR || b == iscii_EXT {
+						dec.pending = b
+						continue
+					}
+					char, found := script.scriptRune(isciiSingle(b))
+					if char == 0 || !found {
+						dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+							dec.offset - 1,
+							[]byte {b},
+						)
+						break
+					}
+					if _, combines := isciiNuktaForms[b]; combines || b == iscii_HALANT || b == iscii_DANDA {
+						// wait for NUKTA, HALANT or DANDA
+						dec.pending = b
+						continue
+					}
+					err, permanent = dec.emit(dec.offset - 1, destChars, &outCount, char)
+			}
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &ISCIIDecoder[rune]{}
+var _ Codec[byte, uint16] = &ISCIIDecoder[uint16]{}
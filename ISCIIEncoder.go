@@ -0,0 +1,199 @@
+package gotextenc
+
+type ISCIIEncoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	// Script is the script assumed to be in effect at the start of the
+	// output; ATR is emitted whenever the input changes scripts.
+	Script ISCIIScript
+	// atrScript is the script selected by emitted ATR plus one, or zero if
+	// none was emitted yet
+	atrScript ISCIIScript
+	lastByte byte
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [4]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *ISCIIEncoder[SourceT]) Reset(offset uint64) {
+	enc.atrScript = 0
+	enc.lastByte = 0
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *ISCIIEncoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(enc *ISCIIEncoder[SourceT]) script() ISCIIScript {
+	if enc.atrScript != 0 {
+		return enc.atrScript - 1
+	}
+	return enc.Script
+}
+
+// encode determines the script and the ISCII byte(s) for r, the latter in the
+// format of isciiEncodeMap.
+func(enc *ISCIIEncoder[SourceT]) encode(r rune) (script ISCIIScript, code uint16, found bool) {
+	script = enc.script()
+	switch {
+		case r < 0x80:
+			return script, uint16(r), true
+		case r == isciiZWJ:
+			if enc.lastByte == iscii_HALANT {
+				// soft halant
+				return script, uint16(iscii_NUKTA), true
+			}
+			return script, uint16(iscii_INV), true
+		case r == isciiZWNJ:
+			if enc.lastByte == iscii_HALANT {
+				// explicit halant
+				return script, uint16(iscii_HALANT), true
+			}
+			return
+		case r >= 0x0900 && r < 0x0900 + rune(isciiscr_COUNT) * 0x80:
+			devanagari := r
+			if r != 0x0964 && r != 0x0965 {
+				script = ISCIIScript((r - 0x0900) >> 7)
+				devanagari -= rune(script) * 0x80
+			}
+			if _, found = script.scriptRune(devanagari); !found {
+				return
+			}
+			isciiEncodeOnce.Do(loadISCIIEncodeMap)
+			code, found = isciiEncodeMap[devanagari]
+			return
+		default:
+			return
+	}
+}
+
+// joinsLastByte tells whether code, if emitted right after enc.lastByte,
+// would be decoded together with it as one of the special forms (soft or
+// explicit halant, double danda or a nukta form), changing the text. The nukta
+// consonants are fine, since they are canonically equivalent to the base
+// character followed by the nukta.
+func(enc *ISCIIEncoder[SourceT]) joinsLastByte(script ISCIIScript, code uint16) bool {
+	first := byte(code)
+	if code > 0xFF {
+		first = byte(code >> 8)
+	}
+	switch enc.lastByte {
+		case iscii_HALANT:
+			return first == iscii_NUKTA || first == iscii_HALANT
+		case iscii_DANDA:
+			return first == iscii_DANDA
+	}
+	if first != iscii_NUKTA {
+		return false
+	}
+	form, combines := isciiNuktaForms[enc.lastByte]
+	if !combines || (form >= 0x0958 && form < 0x0960) {
+		return false
+	}
+	_, combines = script.scriptRune(form)
+	return combines
+}
+
+func(enc *ISCIIEncoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF || enc.surrogateHalf == 0 {
+				break
+			}
+			enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+				enc.offset - 1,
+				enc.surrogateHalf,
+			)
+			enc.surrogateHalf = 0
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					script, code, found := enc.encode(r)
+					if found && script == enc.script() && r != isciiZWJ && r != isciiZWNJ {
+						found = !enc.joinsLastByte(script, code)
+					}
+					if !found {
+						enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+						enc.lastByte = 0
+						break
+					}
+					units := enc.outBuffer[:0]
+					if script != enc.script() {
+						units = append(units, iscii_ATR, isciiATRCodes[script])
+						enc.atrScript = script + 1
+					}
+					if code > 0xFF {
+						units = append(units, byte(code >> 8))
+					}
+					units = append(units, byte(code))
+					if r == isciiZWJ || r == isciiZWNJ {
+						enc.lastByte = 0
+					} else {
+						enc.lastByte = byte(code)
+					}
+					enc.replacement = putChars(units, destBytes, &outCount)
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &ISCIIEncoder[rune]{}
+var _ Codec[uint16, byte] = &ISCIIEncoder[uint16]{}
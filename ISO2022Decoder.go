@@ -0,0 +1,301 @@
+package gotextenc
+
+// ISO2022Decoder decodes the ISO 2022 based encoding that Profile defines,
+// or ASCII if Profile is nil. Escape sequences and shifts the profile does
+// not provide for are reported as unmapped sequences.
+type ISO2022Decoder[TargetT CharLike] struct {
+	ErrorHandler DoubleByteDecodingErrorHandler[TargetT]
+	Profile *ISO2022Profile
+	initialized bool
+	g [4]*ISO2022Charset
+	// gl is the element invoked into GL
+	gl int
+	escape [4]byte
+	escapeLength int
+	// sequence holds the part of a character seen so far, including a single
+	// shift
+	sequence [6]byte
+	sequenceLength int
+	prefixLength int
+	element int
+	offset uint64
+	outBuffer [2]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *ISO2022Decoder[TargetT]) Reset(offset uint64) {
+	dec.initialized = false
+	dec.escapeLength = 0
+	dec.sequenceLength = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *ISO2022Decoder[TargetT]) errorHandler() DoubleByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *ISO2022Decoder[TargetT]) profile() *ISO2022Profile {
+	if dec.Profile != nil {
+		return dec.Profile
+	}
+	return &iso2022DefaultProfile
+}
+
+// startSequence begins a character in element with the single shift prefix,
+// if any.
+func(dec *ISO2022Decoder[TargetT]) startSequence(element int, prefix ...byte) {
+	dec.element = element
+	dec.sequenceLength = copy(dec.sequence[:], prefix)
+	dec.prefixLength = dec.sequenceLength
+}
+
+// decodeSequence maps the complete character in dec.sequence and queues it
+// for output.
+func(dec *ISO2022Decoder[TargetT]) decodeSequence(
+	destChars []TargetT,
+	outCount *int,
+) (err error, permanent bool) {
+	var code uint32
+	for _, b := range dec.sequence[dec.prefixLength:dec.sequenceLength] {
+		code = code << 8 | uint32(b & 0x7F)
+	}
+	offset := dec.offset - uint64(dec.sequenceLength)
+	if char := dec.g[dec.element].Decode(code); char != 0 {
+		var units []TargetT
+		units, err, permanent = appendDecodedRune[TargetT](dec.outBuffer[:0], char, offset, dec.errorHandler())
+		dec.replacement = putChars(units, destChars, outCount)
+	} else {
+		dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+			offset,
+			append([]byte(nil), dec.sequence[:dec.sequenceLength]...),
+		)
+	}
+	dec.sequenceLength = 0
+	return
+}
+
+// shift invokes element into GL, or for a single character if single is set,
+// as requested by the shift function. The function is reported as unmapped
+// if the element has no designation.
+func(dec *ISO2022Decoder[TargetT]) shift(
+	element int,
+	single bool,
+	function ...byte,
+) (err error, permanent bool) {
+	switch {
+		case dec.g[element] == nil:
+			dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+				dec.offset - uint64(len(function)),
+				append([]byte(nil), function...),
+			)
+		case single:
+			dec.startSequence(element, function...)
+		default:
+			dec.gl = element
+	}
+	return
+}
+
+// escapeSequence acts on the complete escape sequence escape.
+func(dec *ISO2022Decoder[TargetT]) escapeSequence(escape []byte) (err error, permanent bool) {
+	profile := dec.profile()
+	if len(escape) == 2 && !profile.EightBit {
+		switch escape[1] {
+			case iso2022_SS2_7BIT:
+				return dec.shift(2, true, escape...)
+			case iso2022_SS3_7BIT:
+				return dec.shift(3, true, escape...)
+			case iso2022_LS2:
+				return dec.shift(2, false, escape...)
+			case iso2022_LS3:
+				return dec.shift(3, false, escape...)
+		}
+	}
+	if designation, valid := profile.parseDesignation(escape[1:]); valid {
+		dec.g[designation.Element] = designation.Charset
+		return
+	}
+	dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+		dec.offset - uint64(len(escape)),
+		append([]byte(nil), escape...),
+	)
+	return
+}
+
+func(dec *ISO2022Decoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	profile := dec.profile()
+	if !dec.initialized {
+		dec.g = profile.Initial
+		dec.gl = 0
+		dec.initialized = true
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF {
+				break
+			}
+			switch {
+				case dec.escapeLength > 0:
+					dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+						dec.offset - uint64(dec.escapeLength),
+						append([]byte(nil), dec.escape[:dec.escapeLength]...),
+					)
+					dec.escapeLength = 0
+				case dec.sequenceLength > 0:
+					dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+						dec.offset - uint64(dec.sequenceLength),
+						append([]byte(nil), dec.sequence[:dec.sequenceLength]...),
+					)
+					dec.sequenceLength = 0
+				default:
+					return
+			}
+		} else if b := srcBytes[consumed]; dec.escapeLength > 0 {
+			// escape sequence: intermediate bytes 0x20..0x2F, then final byte
+			if b < 0x20 || b > 0x7E || dec.escapeLength == len(dec.escape) - 1 && b < 0x30 {
+				// not an escape sequence => report what we have and process b
+				// afresh
+				dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+					dec.offset - uint64(dec.escapeLength),
+					append([]byte(nil), dec.escape[:dec.escapeLength]...),
+				)
+				dec.escapeLength = 0
+			} else {
+				dec.escape[dec.escapeLength] = b
+				dec.escapeLength++
+				consumed++
+				dec.offset++
+				if b < 0x30 {
+					continue
+				}
+				escape := dec.escape[:dec.escapeLength]
+				dec.escapeLength = 0
+				err, permanent = dec.escapeSequence(escape)
+			}
+		} else if dec.sequenceLength > 0 {
+			charset := dec.g[dec.element]
+			// in 8-bit codes, only G0 is in GL
+			valid := charset.isGraphicByte(b) && (b >= 0x80) == (profile.EightBit && dec.element != 0)
+			if !valid {
+				if dec.sequenceLength == dec.prefixLength {
+					// report the single shift on its own
+					dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+						dec.offset - uint64(dec.sequenceLength),
+						append([]byte(nil), dec.sequence[:dec.sequenceLength]...),
+					)
+				} else {
+					dec.replacement, err, permanent = dec.errorHandler().InvalidTrailByte(
+						dec.offset - uint64(dec.sequenceLength),
+						dec.sequence[dec.prefixLength],
+						b,
+					)
+				}
+				// b is processed afresh
+				dec.sequenceLength = 0
+			} else {
+				dec.sequence[dec.sequenceLength] = b
+				dec.sequenceLength++
+				consumed++
+				dec.offset++
+				if dec.sequenceLength - dec.prefixLength < charset.Width {
+					continue
+				}
+				err, permanent = dec.decodeSequence(destChars, &outCount)
+			}
+		} else if b == iso2022_ESC && profile.usesEscapes() {
+			dec.escape[0] = b
+			dec.escapeLength = 1
+			consumed++
+			dec.offset++
+			continue
+		} else if (b == iso2022_SO || b == iso2022_SI) && !profile.EightBit {
+			consumed++
+			dec.offset++
+			element := 0
+			if b == iso2022_SO {
+				element = 1
+			}
+			err, permanent = dec.shift(element, false, b)
+		} else if (b == iso2022_SS2_8BIT || b == iso2022_SS3_8BIT) && profile.EightBit {
+			consumed++
+			dec.offset++
+			err, permanent = dec.shift(int(b - iso2022_SS2_8BIT) + 2, true, b)
+		} else if b >= 0x80 && (!profile.EightBit || b >= 0xA0 && dec.g[1] == nil) {
+			consumed++
+			dec.offset++
+			dec.replacement, err, permanent = dec.errorHandler().IllegalLeadByte(dec.offset - 1, b)
+		} else {
+			element := dec.gl
+			if b >= 0x80 {
+				element = 1
+			}
+			charset := dec.g[element]
+			if b < 0x80 && (charset == nil || !charset.isGraphicByte(b)) || b >= 0x80 && b < 0xA0 {
+				if b <= 0x20 || b >= 0x7F {
+					// controls and SPACE regardless of the sets
+					destChars[outCount] = TargetT(b)
+					outCount++
+					consumed++
+					dec.offset++
+					if b == '\n' {
+						if profile.ShiftInAtLineEnd {
+							dec.gl = 0
+						}
+						if profile.ResetDesignationsAtLineEnd {
+							dec.g = profile.Initial
+						}
+					}
+					continue
+				}
+				consumed++
+				dec.offset++
+				dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(dec.offset - 1, []byte {b})
+			} else if !charset.isGraphicByte(b) {
+				// 0xA0 or 0xFF with a 94-character set in GR
+				consumed++
+				dec.offset++
+				dec.replacement, err, permanent = dec.errorHandler().IllegalLeadByte(dec.offset - 1, b)
+			} else {
+				dec.startSequence(element)
+				dec.sequence[0] = b
+				dec.sequenceLength = 1
+				consumed++
+				dec.offset++
+				if charset.Width > 1 {
+					continue
+				}
+				err, permanent = dec.decodeSequence(destChars, &outCount)
+			}
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &ISO2022Decoder[rune]{}
+var _ Codec[byte, uint16] = &ISO2022Decoder[uint16]{}
@@ -0,0 +1,245 @@
+package gotextenc
+
+// ISO2022Encoder encodes Unicode as the ISO 2022 based encoding that Profile
+// defines, or ASCII if Profile is nil. Characters are looked up in the sets
+// currently designated first, then in the profile's designations in order; in
+// 7-bit codes, the encoder returns to G0 before controls and at the end of
+// input.
+type ISO2022Encoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	Profile *ISO2022Profile
+	initialized bool
+	announced bool
+	g [4]*ISO2022Charset
+	// gl is the element invoked into GL
+	gl int
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [16]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *ISO2022Encoder[SourceT]) Reset(offset uint64) {
+	enc.initialized = false
+	enc.announced = false
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *ISO2022Encoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(enc *ISO2022Encoder[SourceT]) profile() *ISO2022Profile {
+	if enc.Profile != nil {
+		return enc.Profile
+	}
+	return &iso2022DefaultProfile
+}
+
+// designate appends the escape sequence making designation to units.
+func(enc *ISO2022Encoder[SourceT]) designate(units []byte, designation ISO2022Designation) []byte {
+	enc.g[designation.Element] = designation.Charset
+	return appendISO2022Designation(units, designation)
+}
+
+// shiftIn appends SI to units if G0 is not invoked into GL.
+func(enc *ISO2022Encoder[SourceT]) shiftIn(units []byte) []byte {
+	if enc.gl != 0 {
+		enc.gl = 0
+		units = append(units, iso2022_SI)
+	}
+	return units
+}
+
+// appendChar appends the bytes representing r to units, designating a set if
+// necessary.
+func(enc *ISO2022Encoder[SourceT]) appendChar(units []byte, r rune) ([]byte, bool) {
+	profile := enc.profile()
+	element := -1
+	var code uint32
+	var found bool
+	for candidate, charset := range enc.g {
+		if charset == nil {
+			continue
+		}
+		if code, found = charset.Encode(r); found {
+			element = candidate
+			break
+		}
+	}
+	if !found {
+		for _, designation := range profile.Designations {
+			if code, found = designation.Charset.Encode(r); found {
+				units = enc.designate(units, designation)
+				element = designation.Element
+				break
+			}
+		}
+		if !found {
+			return units, false
+		}
+	}
+	var high byte
+	switch {
+		case profile.EightBit:
+			if element >= 2 {
+				units = append(units, iso2022_SS2_8BIT + byte(element - 2))
+			}
+			if element != 0 {
+				high = 0x80
+			}
+		case element == 0:
+			units = enc.shiftIn(units)
+		case element == 1:
+			if enc.gl != 1 {
+				enc.gl = 1
+				units = append(units, iso2022_SO)
+			}
+		case element == 2:
+			units = append(units, iso2022_ESC, iso2022_SS2_7BIT)
+		default:
+			units = append(units, iso2022_ESC, iso2022_SS3_7BIT)
+	}
+	for shift := (enc.g[element].Width - 1) * 8; shift >= 0; shift -= 8 {
+		units = append(units, byte(code >> shift) | high)
+	}
+	return units, true
+}
+
+// isCodeExtension tells whether the control r would be taken for code
+// extension.
+func(enc *ISO2022Encoder[SourceT]) isCodeExtension(r rune) bool {
+	profile := enc.profile()
+	switch r {
+		case rune(iso2022_ESC):
+			return profile.usesEscapes()
+		case rune(iso2022_SO), rune(iso2022_SI):
+			return !profile.EightBit
+		case rune(iso2022_SS2_8BIT), rune(iso2022_SS3_8BIT):
+			return profile.EightBit
+		default:
+			return false
+	}
+}
+
+func(enc *ISO2022Encoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	profile := enc.profile()
+	if !enc.initialized {
+		enc.g = profile.Initial
+		enc.gl = 0
+		enc.initialized = true
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF {
+				break
+			}
+			if enc.surrogateHalf != 0 {
+				enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+					enc.offset - 1,
+					enc.surrogateHalf,
+				)
+				enc.surrogateHalf = 0
+			} else if enc.gl != 0 {
+				enc.replacement = putChars(enc.shiftIn(enc.outBuffer[:0]), destBytes, &outCount)
+				continue
+			} else {
+				break
+			}
+		} else if !enc.announced {
+			enc.announced = true
+			units := enc.outBuffer[:0]
+			for _, designation := range profile.Announce {
+				units = enc.designate(units, designation)
+			}
+			enc.replacement = putChars(units, destBytes, &outCount)
+			continue
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					units := enc.outBuffer[:0]
+					var found bool
+					switch {
+						case enc.isCodeExtension(r):
+						case r < 0x20 || r == 0x7F:
+							// C0 controls are not affected by shifts, but are
+							// commonly not accepted in the shifted state
+							units = enc.shiftIn(units)
+							if r == '\n' && profile.ResetDesignationsAtLineEnd {
+								enc.g = profile.Initial
+							}
+							units, found = append(units, byte(r)), true
+						case r >= 0x80 && r < 0xA0 && profile.EightBit:
+							units, found = append(units, byte(r)), true
+						default:
+							units, found = enc.appendChar(units, r)
+					}
+					if found {
+						enc.replacement = putChars(units, destBytes, &outCount)
+					} else {
+						enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+					}
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &ISO2022Encoder[rune]{}
+var _ Codec[uint16, byte] = &ISO2022Encoder[uint16]{}
@@ -0,0 +1,224 @@
+package gotextenc
+
+// ISO2022JPDecoder decodes the ISO-2022-JP variants. Designations the variant
+// does not provide for are reported as unmapped sequences. G0 starts out as
+// ASCII and G2 undesignated; neither is reset at line breaks.
+type ISO2022JPDecoder[TargetT CharLike] struct {
+	ErrorHandler DoubleByteDecodingErrorHandler[TargetT]
+	Variant ISO2022JPVariant
+	g0 iso2022JPSet
+	// g2 holds the G2 designation plus one, zero meaning none
+	g2 iso2022JPSet
+	escape [4]byte
+	escapeLength int
+	// singleShift is set after ESC N
+	singleShift bool
+	lead byte
+	offset uint64
+	outBuffer [4]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *ISO2022JPDecoder[TargetT]) Reset(offset uint64) {
+	dec.g0 = iso2022jpset_ASCII
+	dec.g2 = 0
+	dec.escapeLength = 0
+	dec.singleShift = false
+	dec.lead = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *ISO2022JPDecoder[TargetT]) errorHandler() DoubleByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+// emit queues the character(s) decoded from the sequence at offset, or
+// reports the sequence as unmapped if there are none.
+func(dec *ISO2022JPDecoder[TargetT]) emit(
+	chars [2]rune,
+	count int,
+	sequence []byte,
+	offset uint64,
+	destChars []TargetT,
+	outCount *int,
+) (err error, permanent bool) {
+	if count == 0 {
+		dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(offset, sequence)
+		return
+	}
+	units := dec.outBuffer[:0]
+	for _, char := range chars[:count] {
+		var charErr error
+		var charPermanent bool
+		units, charErr, charPermanent = appendDecodedRune[TargetT](units, char, offset, dec.errorHandler())
+		if charErr != nil && err == nil {
+			err, permanent = charErr, charPermanent
+		}
+	}
+	dec.replacement = putChars(units, destChars, outCount)
+	return
+}
+
+func(dec *ISO2022JPDecoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF {
+				break
+			}
+			switch {
+				case dec.escapeLength > 0:
+					dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+						dec.offset - uint64(dec.escapeLength),
+						append([]byte(nil), dec.escape[:dec.escapeLength]...),
+					)
+					dec.escapeLength = 0
+				case dec.singleShift:
+					dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+						dec.offset - 2,
+						[]byte {iso2022_ESC, iso2022_SS2_7BIT},
+					)
+					dec.singleShift = false
+				case dec.lead != 0:
+					dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+						dec.offset - 1,
+						[]byte {dec.lead},
+					)
+					dec.lead = 0
+				default:
+					return
+			}
+		} else if b := srcBytes[consumed]; dec.escapeLength > 0 {
+			// escape sequence: intermediate bytes 0x20..0x2F, then final byte
+			if b < 0x20 || b > 0x7E || dec.escapeLength == len(dec.escape) - 1 && b < 0x30 {
+				// not an escape sequence => report what we have and process b
+				// afresh
+				dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+					dec.offset - uint64(dec.escapeLength),
+					append([]byte(nil), dec.escape[:dec.escapeLength]...),
+				)
+				dec.escapeLength = 0
+			} else {
+				dec.escape[dec.escapeLength] = b
+				dec.escapeLength++
+				consumed++
+				dec.offset++
+				if b < 0x30 {
+					continue
+				}
+				escape := dec.escape[:dec.escapeLength]
+				dec.escapeLength = 0
+				if len(escape) == 2 && b == iso2022_SS2_7BIT && dec.Variant == ISO2022JPVAR_JP2 {
+					dec.singleShift = true
+					continue
+				}
+				if set, valid := dec.Variant.parseDesignation(escape[1:]); valid {
+					if iso2022JPSets[set].g2 {
+						dec.g2 = set + 1
+					} else {
+						dec.g0 = set
+					}
+					continue
+				}
+				dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+					dec.offset - uint64(len(escape)),
+					append([]byte(nil), escape...),
+				)
+			}
+		} else if dec.singleShift {
+			dec.singleShift = false
+			if b < 0x20 || b > 0x7F {
+				// report the single shift on its own and process b afresh
+				dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+					dec.offset - 2,
+					[]byte {iso2022_ESC, iso2022_SS2_7BIT},
+				)
+			} else {
+				consumed++
+				dec.offset++
+				var chars [2]rune
+				var count int
+				if dec.g2 != 0 {
+					chars, count = (dec.g2 - 1).decode(b, 0)
+				}
+				err, permanent = dec.emit(
+					chars,
+					count,
+					[]byte {iso2022_ESC, iso2022_SS2_7BIT, b},
+					dec.offset - 3,
+					destChars,
+					&outCount,
+				)
+			}
+		} else if dec.lead != 0 {
+			lead := dec.lead
+			dec.lead = 0
+			if b < 0x21 || b > 0x7E {
+				// process b afresh
+				dec.replacement, err, permanent = dec.errorHandler().InvalidTrailByte(dec.offset - 1, lead, b)
+			} else {
+				consumed++
+				dec.offset++
+				chars, count := dec.g0.decode(lead, b)
+				err, permanent = dec.emit(chars, count, []byte {lead, b}, dec.offset - 2, destChars, &outCount)
+			}
+		} else if b == iso2022_ESC {
+			dec.escape[0] = b
+			dec.escapeLength = 1
+			consumed++
+			dec.offset++
+			continue
+		} else if b >= 0x80 || b == iso2022_SO || b == iso2022_SI {
+			// no variant shifts to G1, and the encoder refuses SO and SI
+			consumed++
+			dec.offset++
+			dec.replacement, err, permanent = dec.errorHandler().IllegalLeadByte(dec.offset - 1, b)
+		} else if b <= 0x20 || b == 0x7F {
+			// controls and SPACE regardless of G0
+			destChars[outCount] = TargetT(b)
+			outCount++
+			consumed++
+			dec.offset++
+			continue
+		} else if iso2022JPSets[dec.g0].doubleByte {
+			dec.lead = b
+			consumed++
+			dec.offset++
+			continue
+		} else {
+			consumed++
+			dec.offset++
+			chars, count := dec.g0.decode(b, 0)
+			err, permanent = dec.emit(chars, count, []byte {b}, dec.offset - 1, destChars, &outCount)
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &ISO2022JPDecoder[rune]{}
+var _ Codec[byte, uint16] = &ISO2022JPDecoder[uint16]{}
@@ -0,0 +1,237 @@
+package gotextenc
+
+// ISO2022JPEncoder encodes Unicode as one of the ISO-2022-JP variants. A
+// designation is only emitted if the character is not in the set already
+// designated; the encoder returns to ASCII before each line break and at the
+// end of input, and forgets the G2 designation at line breaks. With JIS X
+// 0213, a base character that may combine with the following character into a
+// single code is held back until that character, or the end of input, is seen.
+type ISO2022JPEncoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	Variant ISO2022JPVariant
+	g0 iso2022JPSet
+	// g2 holds the G2 designation plus one, zero meaning none
+	g2 iso2022JPSet
+	// held is a base character not yet encoded, zero meaning none
+	held rune
+	heldOffset uint64
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [8]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *ISO2022JPEncoder[SourceT]) Reset(offset uint64) {
+	enc.g0 = iso2022jpset_ASCII
+	enc.g2 = 0
+	enc.held = 0
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *ISO2022JPEncoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+// designate appends the escape sequence designating set to units.
+func(enc *ISO2022JPEncoder[SourceT]) designate(units []byte, set iso2022JPSet) []byte {
+	units = append(units, iso2022_ESC)
+	units = append(units, iso2022JPSets[set].designation...)
+	if iso2022JPSets[set].g2 {
+		enc.g2 = set + 1
+	} else {
+		enc.g0 = set
+	}
+	return units
+}
+
+// appendChar appends the bytes representing r to units, designating a set if
+// necessary.
+func(enc *ISO2022JPEncoder[SourceT]) appendChar(units []byte, r rune) ([]byte, bool) {
+	set := enc.g0
+	code, found := set.encode(r)
+	if !found && enc.g2 != 0 {
+		set = enc.g2 - 1
+		code, found = set.encode(r)
+	}
+	if !found {
+		if enc.Variant >= iso2022jpvar_COUNT {
+			return units, false
+		}
+		for _, set = range iso2022JPEncodeOrders[enc.Variant] {
+			if code, found = set.encode(r); found {
+				units = enc.designate(units, set)
+				break
+			}
+		}
+		if !found {
+			return units, false
+		}
+	}
+	switch {
+		case iso2022JPSets[set].g2:
+			units = append(units, iso2022_ESC, iso2022_SS2_7BIT, byte(code))
+		case iso2022JPSets[set].doubleByte:
+			units = append(units, byte(code >> 8), byte(code))
+		default:
+			units = append(units, byte(code))
+	}
+	return units, true
+}
+
+// appendPair appends the bytes representing base followed by combining to
+// units, designating a set if necessary.
+func(enc *ISO2022JPEncoder[SourceT]) appendPair(units []byte, base rune, combining rune) ([]byte, bool) {
+	set, coded := enc.Variant.pairSet()
+	if !coded {
+		return units, false
+	}
+	code, found := encodeJISX0213Pair(base, combining)
+	if !found {
+		return units, false
+	}
+	if enc.g0 != set {
+		units = enc.designate(units, set)
+	}
+	code += 0x2020
+	return append(units, byte(code >> 8), byte(code)), true
+}
+
+// encodeRune queues the representation of r for output.
+func(enc *ISO2022JPEncoder[SourceT]) encodeRune(
+	r rune,
+	offset uint64,
+	destBytes []byte,
+	outCount *int,
+) (err error, permanent bool) {
+	units := enc.outBuffer[:0]
+	var found bool
+	switch {
+		case r == rune(iso2022_ESC) || r == rune(iso2022_SO) || r == rune(iso2022_SI):
+			// would be taken for code extension
+		case r == '\r' || r == '\n':
+			if enc.g0 != iso2022jpset_ASCII {
+				units = enc.designate(units, iso2022jpset_ASCII)
+			}
+			enc.g2 = 0
+			units, found = append(units, byte(r)), true
+		case r < 0x20 || r == 0x7F:
+			units, found = append(units, byte(r)), true
+		default:
+			units, found = enc.appendChar(units, r)
+	}
+	if found {
+		enc.replacement = putChars(units, destBytes, outCount)
+	} else {
+		enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+	}
+	return
+}
+
+func(enc *ISO2022JPEncoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF {
+				break
+			}
+			if enc.held != 0 {
+				held := enc.held
+				enc.held = 0
+				err, permanent = enc.encodeRune(held, enc.heldOffset, destBytes, &outCount)
+			} else if enc.surrogateHalf != 0 {
+				enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+					enc.offset - 1,
+					enc.surrogateHalf,
+				)
+				enc.surrogateHalf = 0
+			} else if enc.g0 != iso2022jpset_ASCII {
+				units := enc.designate(enc.outBuffer[:0], iso2022jpset_ASCII)
+				enc.replacement = putChars(units, destBytes, &outCount)
+				continue
+			} else {
+				break
+			}
+		} else if enc.held != 0 {
+			held := enc.held
+			enc.held = 0
+			// combining characters are in the BMP, so a single unit will do
+			if units, found := enc.appendPair(enc.outBuffer[:0], held, rune(srcChars[consumed])); found {
+				consumed++
+				enc.offset++
+				enc.replacement = putChars(units, destBytes, &outCount)
+				continue
+			}
+			// the next character is processed afresh
+			err, permanent = enc.encodeRune(held, enc.heldOffset, destBytes, &outCount)
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					if _, coded := enc.Variant.pairSet(); coded && isJISX0213PairBase(r) {
+						enc.held = r
+						enc.heldOffset = offset
+						continue
+					}
+					err, permanent = enc.encodeRune(r, offset, destBytes, &outCount)
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &ISO2022JPEncoder[rune]{}
+var _ Codec[uint16, byte] = &ISO2022JPEncoder[uint16]{}
@@ -0,0 +1,127 @@
+package gotextenc
+
+type ISO6937Decoder[TargetT CharLike] struct {
+	ErrorHandler MultiByteDecodingErrorHandler[TargetT]
+	Variant ISO6937Variant
+	prefix byte
+	offset uint64
+	outBuffer [4]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *ISO6937Decoder[TargetT]) Reset(offset uint64) {
+	dec.prefix = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *ISO6937Decoder[TargetT]) errorHandler() MultiByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *ISO6937Decoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	charset := dec.Variant.charset()
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF || dec.prefix == 0 {
+				break
+			}
+			// diacritic prefix at end of input
+			dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+				dec.offset - 1,
+				[]byte {dec.prefix},
+			)
+			dec.prefix = 0
+			if permanent {
+				dec.permanentError = err
+			}
+			if err != nil {
+				return
+			}
+			continue
+		}
+		b := srcBytes[consumed]
+		if dec.prefix != 0 {
+			// base character after diacritic prefix
+			if b < 0x20 || b >= 0x7F || charset.single[b] == 0 {
+				// not a base character => report the prefix on its own
+				// and process b afresh
+				dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+					dec.offset - 1,
+					[]byte {dec.prefix},
+				)
+				dec.prefix = 0
+			} else {
+				offset := dec.offset - 1
+				composed := charset.composed[dec.prefix & 0x0F][b - 0x20]
+				units := dec.outBuffer[:0]
+				if composed != 0 {
+					units, err, permanent = appendDecodedRune[TargetT](units, composed, offset, dec.errorHandler())
+				} else {
+					// no precomposed character => base followed by combining character
+					units = append(units, TargetT(b))
+					units, err, permanent = appendDecodedRune[TargetT](
+						units,
+						charset.diacritics[dec.prefix & 0x0F],
+						offset,
+						dec.errorHandler(),
+					)
+				}
+				dec.replacement = putChars(units, destChars, &outCount)
+				dec.prefix = 0
+				consumed++
+				dec.offset++
+			}
+		} else if b >= 0xC0 && b < 0xD0 && charset.diacritics[b & 0x0F] != 0 {
+			// diacritic prefix => wait for base character
+			dec.prefix = b
+			consumed++
+			dec.offset++
+			continue
+		} else if r := charset.single[b]; r != 0 || b == 0 {
+			if b < 0x80 {
+				destChars[outCount] = TargetT(b)
+				outCount++
+			} else {
+				var units []TargetT
+				units, err, permanent = appendDecodedRune[TargetT](dec.outBuffer[:0], r, dec.offset, dec.errorHandler())
+				dec.replacement = putChars(units, destChars, &outCount)
+			}
+			consumed++
+			dec.offset++
+		} else {
+			dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(dec.offset, []byte {b})
+			consumed++
+			dec.offset++
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &ISO6937Decoder[rune]{}
+var _ Codec[byte, uint16] = &ISO6937Decoder[uint16]{}
@@ -0,0 +1,137 @@
+package gotextenc
+
+type ISO6937Encoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	Variant ISO6937Variant
+	base byte
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [2]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *ISO6937Encoder[SourceT]) Reset(offset uint64) {
+	enc.base = 0
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *ISO6937Encoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(enc *ISO6937Encoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	charset := enc.Variant.charset()
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF {
+				break
+			}
+			if enc.base != 0 {
+				destBytes[outCount] = enc.base
+				outCount++
+				enc.base = 0
+				continue
+			}
+			if enc.surrogateHalf == 0 {
+				break
+			}
+			enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+				enc.offset - 1,
+				enc.surrogateHalf,
+			)
+			enc.surrogateHalf = 0
+		} else if enc.base != 0 {
+			// Base characters are held back until we know whether a combining
+			// character follows, which we must then emit as a prefix.
+			prefix := charset.diacriticPrefix(rune(srcChars[consumed]))
+			if prefix != 0 {
+				enc.outBuffer = [2]byte {prefix, enc.base}
+				enc.replacement = putChars(enc.outBuffer[:], destBytes, &outCount)
+				consumed++
+				enc.offset++
+			} else {
+				destBytes[outCount] = enc.base
+				outCount++
+			}
+			enc.base = 0
+			continue
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					code, found := charset.encodeMap[r]
+					switch {
+						case !found:
+							enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+						case code > 0xFF:
+							enc.outBuffer = [2]byte {byte(code >> 8), byte(code)}
+							enc.replacement = putChars(enc.outBuffer[:], destBytes, &outCount)
+						case code > 0x20 && code < 0x7F:
+							enc.base = byte(code)
+						default:
+							destBytes[outCount] = byte(code)
+							outCount++
+					}
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &ISO6937Encoder[rune]{}
+var _ Codec[uint16, byte] = &ISO6937Encoder[uint16]{}
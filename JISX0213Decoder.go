@@ -0,0 +1,137 @@
+package gotextenc
+
+// JISX0213Decoder decodes Shift_JIS-2004 or EUC-JIS-2004, as Form selects.
+// Codes standing for a base character followed by a combining character decode
+// as both characters.
+type JISX0213Decoder[TargetT CharLike] struct {
+	ErrorHandler DoubleByteDecodingErrorHandler[TargetT]
+	Form JISX0213Form
+	sequence [3]byte
+	sequenceLength int
+	offset uint64
+	outBuffer [4]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *JISX0213Decoder[TargetT]) Reset(offset uint64) {
+	dec.sequenceLength = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *JISX0213Decoder[TargetT]) errorHandler() DoubleByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+// decodeSequence queues the character(s) coded by the complete sequence held
+// in dec.sequence for output.
+func(dec *JISX0213Decoder[TargetT]) decodeSequence(
+	destChars []TargetT,
+	outCount *int,
+) (err error, permanent bool) {
+	sequence := dec.sequence[:dec.sequenceLength]
+	dec.sequenceLength = 0
+	offset := dec.offset - uint64(len(sequence))
+	chars, count := dec.Form.decodeSequence(sequence)
+	if count == 0 {
+		dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+			offset,
+			append([]byte(nil), sequence...),
+		)
+		return
+	}
+	units := dec.outBuffer[:0]
+	for _, char := range chars[:count] {
+		var charErr error
+		var charPermanent bool
+		units, charErr, charPermanent = appendDecodedRune[TargetT](units, char, offset, dec.errorHandler())
+		if charErr != nil && err == nil {
+			err, permanent = charErr, charPermanent
+		}
+	}
+	dec.replacement = putChars(units, destChars, outCount)
+	return
+}
+
+func(dec *JISX0213Decoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF || dec.sequenceLength == 0 {
+				break
+			}
+			dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+				dec.offset - uint64(dec.sequenceLength),
+				append([]byte(nil), dec.sequence[:dec.sequenceLength]...),
+			)
+			dec.sequenceLength = 0
+		} else if b := srcBytes[consumed]; dec.sequenceLength > 0 {
+			if !dec.Form.isTrail(b) {
+				dec.replacement, err, permanent = dec.errorHandler().InvalidTrailByte(
+					dec.offset - uint64(dec.sequenceLength),
+					dec.sequence[0],
+					b,
+				)
+				dec.sequenceLength = 0
+				if _, single := dec.Form.decodeSingle(b); !single && !dec.Form.isLead(b) {
+					consumed++
+					dec.offset++
+				}
+				// else process b afresh
+			} else {
+				dec.sequence[dec.sequenceLength] = b
+				dec.sequenceLength++
+				consumed++
+				dec.offset++
+				if dec.sequenceLength < dec.Form.sequenceLength(dec.sequence[0]) {
+					continue
+				}
+				err, permanent = dec.decodeSequence(destChars, &outCount)
+			}
+		} else if char, single := dec.Form.decodeSingle(b); single {
+			destChars[outCount] = TargetT(char)
+			outCount++
+			consumed++
+			dec.offset++
+			continue
+		} else if dec.Form.isLead(b) {
+			dec.sequence[0] = b
+			dec.sequenceLength = 1
+			consumed++
+			dec.offset++
+			continue
+		} else {
+			consumed++
+			dec.offset++
+			dec.replacement, err, permanent = dec.errorHandler().IllegalLeadByte(dec.offset - 1, b)
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &JISX0213Decoder[rune]{}
+var _ Codec[byte, uint16] = &JISX0213Decoder[uint16]{}
@@ -0,0 +1,160 @@
+package gotextenc
+
+// JISX0213Encoder encodes Unicode as Shift_JIS-2004 or EUC-JIS-2004, as Form
+// selects. A base character that may combine with the following character
+// into a single code is held back until that character, or the end of input,
+// is seen.
+type JISX0213Encoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	Form JISX0213Form
+	// held is a base character not yet encoded, zero meaning none
+	held rune
+	heldOffset uint64
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [3]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *JISX0213Encoder[SourceT]) Reset(offset uint64) {
+	enc.held = 0
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *JISX0213Encoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+// putCode queues the bytes code for output, big endian and without leading
+// zero bytes.
+func(enc *JISX0213Encoder[SourceT]) putCode(code uint32, destBytes []byte, outCount *int) {
+	units := enc.outBuffer[:0]
+	if code > 0xFFFF {
+		units = append(units, byte(code >> 16))
+	}
+	units = append(units, byte(code >> 8), byte(code))
+	enc.replacement = putChars(units, destBytes, outCount)
+}
+
+// encodeRune queues the representation of r for output.
+func(enc *JISX0213Encoder[SourceT]) encodeRune(
+	r rune,
+	offset uint64,
+	destBytes []byte,
+	outCount *int,
+) (err error, permanent bool) {
+	if b, found := enc.Form.encodeSingle(r); found {
+		destBytes[*outCount] = b
+		*outCount++
+	} else if code, found := enc.Form.encode(r); found {
+		enc.putCode(code, destBytes, outCount)
+	} else {
+		enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+	}
+	return
+}
+
+func(enc *JISX0213Encoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF {
+				break
+			}
+			if enc.held != 0 {
+				held := enc.held
+				enc.held = 0
+				err, permanent = enc.encodeRune(held, enc.heldOffset, destBytes, &outCount)
+			} else if enc.surrogateHalf != 0 {
+				enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+					enc.offset - 1,
+					enc.surrogateHalf,
+				)
+				enc.surrogateHalf = 0
+			} else {
+				break
+			}
+		} else if enc.held != 0 {
+			held := enc.held
+			enc.held = 0
+			// combining characters are in the BMP, so a single unit will do
+			if code, found := enc.Form.encodePair(held, rune(srcChars[consumed])); found {
+				consumed++
+				enc.offset++
+				enc.putCode(code, destBytes, &outCount)
+				continue
+			}
+			// the next character is processed afresh
+			err, permanent = enc.encodeRune(held, enc.heldOffset, destBytes, &outCount)
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					if enc.Form.isPairBase(r) {
+						enc.held = r
+						enc.heldOffset = offset
+						continue
+					}
+					err, permanent = enc.encodeRune(r, offset, destBytes, &outCount)
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &JISX0213Encoder[rune]{}
+var _ Codec[uint16, byte] = &JISX0213Encoder[uint16]{}
@@ -0,0 +1,50 @@
+package gotextenc
+
+// Latin1ToUTF8Converter converts ISO-8859-1 to UTF-8 directly. Every byte
+// stands for the code point of the same value, so there is nothing that could
+// go wrong.
+type Latin1ToUTF8Converter struct {
+	// trail is the second byte of a sequence that did not fit into the output
+	trail byte
+	pending bool
+}
+
+func(conv *Latin1ToUTF8Converter) Reset(offset uint64) {
+	conv.pending = false
+}
+
+func(conv *Latin1ToUTF8Converter) Transcode(
+	srcBytes []byte,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	for outCount < len(destBytes) {
+		switch {
+			case conv.pending:
+				destBytes[outCount] = conv.trail
+				conv.pending = false
+			case consumed >= len(srcBytes):
+				return
+			case srcBytes[consumed] < 0x80:
+				destBytes[outCount] = srcBytes[consumed]
+				consumed++
+			default:
+				b := srcBytes[consumed]
+				consumed++
+				destBytes[outCount] = 0xC0 | (b >> 6)
+				conv.trail = 0x80 | (b & 0x3F)
+				conv.pending = true
+		}
+		outCount++
+	}
+	return
+}
+
+var _ Codec[byte, byte] = &Latin1ToUTF8Converter{}
+
+func init() {
+	id := RegisterEncoding11(func() Codec[byte, byte] {
+		return &Latin1ToUTF8Converter{}
+	})
+	RegisterConversion(id, "ISO-8859-1", "UTF-8")
+}
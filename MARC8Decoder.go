@@ -0,0 +1,209 @@
+package gotextenc
+
+// MARC8Decoder decodes MARC-8 into Unicode. Combining characters, which
+// precede their base character in MARC-8, are held back and emitted after it.
+// The designations return to their defaults (ASCII in G0, ANSEL in G1) at each
+// field and record terminator. The designation of an unsupported set, such as
+// EACC or Extended Arabic, is reported through UnmappedSequence, as is each
+// byte of the graphic set it was designated to until another designation.
+type MARC8Decoder[TargetT CharLike] struct {
+	ErrorHandler MultiByteDecodingErrorHandler[TargetT]
+	Variant MARC8Variant
+	// g holds the designations plus one, zero meaning the default
+	g [2]marc8SetID
+	escape [4]byte
+	escapeLength int
+	marks []rune
+	offset uint64
+	outBuffer []TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *MARC8Decoder[TargetT]) Reset(offset uint64) {
+	dec.g = [2]marc8SetID{}
+	dec.escapeLength = 0
+	dec.marks = dec.marks[:0]
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *MARC8Decoder[TargetT]) errorHandler() MultiByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *MARC8Decoder[TargetT]) graphicSet(index int) marc8SetID {
+	if dec.g[index] != 0 {
+		return dec.g[index] - 1
+	}
+	if index == 0 {
+		return marc8set_BASIC_LATIN
+	}
+	return marc8set_ANSEL
+}
+
+// emit queues char (unless zero) for output, followed by the pending
+// combining characters.
+func(dec *MARC8Decoder[TargetT]) emit(
+	char rune,
+	offset uint64,
+	destChars []TargetT,
+	outCount *int,
+) (err error, permanent bool) {
+	units := dec.outBuffer[:0]
+	if char != 0 {
+		units, err, permanent = appendDecodedRune[TargetT](units, char, offset, dec.errorHandler())
+	}
+	for _, mark := range dec.marks {
+		var markErr error
+		var markPermanent bool
+		units, markErr, markPermanent = appendDecodedRune[TargetT](units, mark, offset, dec.errorHandler())
+		if markErr != nil && err == nil {
+			err, permanent = markErr, markPermanent
+		}
+	}
+	dec.marks = dec.marks[:0]
+	dec.outBuffer = units
+	dec.replacement = putChars(units, destChars, outCount)
+	return
+}
+
+func(dec *MARC8Decoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF {
+				break
+			}
+			switch {
+				case dec.escapeLength > 0:
+					dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+						dec.offset - uint64(dec.escapeLength),
+						append([]byte(nil), dec.escape[:dec.escapeLength]...),
+					)
+					dec.escapeLength = 0
+				case len(dec.marks) > 0:
+					// combining characters without a base character
+					err, permanent = dec.emit(0, dec.offset, destChars, &outCount)
+				default:
+					return
+			}
+		} else if b := srcBytes[consumed]; dec.escapeLength > 0 {
+			// escape sequence: intermediate bytes 0x20..0x2F, then final byte
+			if b < 0x20 || b > 0x7E || dec.escapeLength == len(dec.escape) - 1 && b < 0x30 {
+				// not an escape sequence => report what we have and process b
+				// afresh
+				dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+					dec.offset - uint64(dec.escapeLength),
+					append([]byte(nil), dec.escape[:dec.escapeLength]...),
+				)
+				dec.escapeLength = 0
+			} else {
+				dec.escape[dec.escapeLength] = b
+				dec.escapeLength++
+				consumed++
+				dec.offset++
+				if b < 0x30 {
+					continue
+				}
+				escape := dec.escape[:dec.escapeLength]
+				dec.escapeLength = 0
+				graphicSet, set, valid := parseMARC8Escape(escape[1:])
+				if valid && set != marc8set_NONE {
+					dec.g[graphicSet] = set + 1
+					continue
+				}
+				if valid {
+					// remember that characters in this set cannot be decoded
+					dec.g[graphicSet] = marc8set_NONE + 1
+				}
+				dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+					dec.offset - uint64(len(escape)),
+					append([]byte(nil), escape...),
+				)
+			}
+		} else if b == marc8_ESC && dec.Variant == MARC8VAR_MARC8 {
+			dec.escape[0] = b
+			dec.escapeLength = 1
+			consumed++
+			dec.offset++
+			continue
+		} else if b < 0x20 || b == 0x7F {
+			if len(dec.marks) > 0 {
+				// combining characters without a base character
+				err, permanent = dec.emit(0, dec.offset, destChars, &outCount)
+			} else {
+				destChars[outCount] = TargetT(b)
+				outCount++
+				consumed++
+				dec.offset++
+				if b == marc8_FIELD_TERMINATOR || b == marc8_RECORD_TERMINATOR {
+					dec.g = [2]marc8SetID{}
+				}
+				continue
+			}
+		} else if b == 0x20 {
+			// SPACE is the base of spacing diacritics
+			consumed++
+			dec.offset++
+			err, permanent = dec.emit(0x20, dec.offset - 1, destChars, &outCount)
+		} else if b >= 0x80 && b < 0xA0 {
+			consumed++
+			dec.offset++
+			if char := marc8C1(b); char != 0 {
+				err, permanent = dec.emit(char, dec.offset - 1, destChars, &outCount)
+			} else {
+				dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+					dec.offset - 1,
+					[]byte {b},
+				)
+			}
+		} else {
+			consumed++
+			dec.offset++
+			set := dec.graphicSet(int(b >> 7))
+			if b == 0xA0 || b == 0xFF {
+				set = marc8set_NONE
+			}
+			if char := set.char(b & 0x7F); char == 0 {
+				dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+					dec.offset - 1,
+					[]byte {b},
+				)
+			} else if isMARC8Combining(char) {
+				// wait for the base character
+				dec.marks = append(dec.marks, char)
+				continue
+			} else {
+				err, permanent = dec.emit(char, dec.offset - 1, destChars, &outCount)
+			}
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &MARC8Decoder[rune]{}
+var _ Codec[byte, uint16] = &MARC8Decoder[uint16]{}
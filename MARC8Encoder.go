@@ -0,0 +1,271 @@
+package gotextenc
+
+// MARC8Encoder encodes Unicode as MARC-8. Precomposed characters are
+// decomposed, and combining characters are moved in front of their base
+// character. Escape sequences are emitted as needed; the default designations
+// are restored before each field and record terminator and at the end of
+// input. Characters found only in the EACC or Extended Arabic sets, which are
+// not supported, are unrepresentable.
+type MARC8Encoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	Variant MARC8Variant
+	// g holds the designations plus one, zero meaning the default
+	g [2]marc8SetID
+	// held is the base character followed by its combining characters, in
+	// input order
+	held []rune
+	heldOffsets []uint64
+	// flush is a completed group in output order
+	flush []rune
+	flushOffsets []uint64
+	surrogateHalf uint16
+	offset uint64
+	outBuffer []byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *MARC8Encoder[SourceT]) Reset(offset uint64) {
+	enc.g = [2]marc8SetID{}
+	enc.held = enc.held[:0]
+	enc.heldOffsets = enc.heldOffsets[:0]
+	enc.flush = enc.flush[:0]
+	enc.flushOffsets = enc.flushOffsets[:0]
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *MARC8Encoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(enc *MARC8Encoder[SourceT]) graphicSet(index int) marc8SetID {
+	if enc.g[index] != 0 {
+		return enc.g[index] - 1
+	}
+	if index == 0 {
+		return marc8set_BASIC_LATIN
+	}
+	return marc8set_ANSEL
+}
+
+// lookup finds a set containing r, preferring the ones currently designated.
+func(enc *MARC8Encoder[SourceT]) lookup(r rune) (set marc8SetID, code byte) {
+	for index := range enc.g {
+		set = enc.graphicSet(index)
+		if code = set.code(r); code != 0 {
+			return
+		}
+	}
+	marc8EncodeOnce.Do(loadMARC8Tables)
+	value, found := marc8EncodeMap[r]
+	if !found {
+		return marc8set_NONE, 0
+	}
+	set = marc8SetID(value >> 8)
+	if enc.Variant == MARC8VAR_ANSEL && set != marc8set_BASIC_LATIN && set != marc8set_ANSEL {
+		return marc8set_NONE, 0
+	}
+	return set, byte(value)
+}
+
+// designate appends the escape sequence designating set to units.
+func(enc *MARC8Encoder[SourceT]) designate(units []byte, set marc8SetID) []byte {
+	if set == marc8set_BASIC_LATIN && marc8Sets[enc.graphicSet(0)].technique1 {
+		units = append(units, marc8_ESC, 's')
+	} else {
+		units = append(units, marc8_ESC)
+		units = append(units, marc8Sets[set].designation...)
+	}
+	if marc8Sets[set].g1 {
+		enc.g[1] = set + 1
+	} else {
+		enc.g[0] = set + 1
+	}
+	return units
+}
+
+// restoreDefaults appends the escape sequences returning to the default
+// designations to units.
+func(enc *MARC8Encoder[SourceT]) restoreDefaults(units []byte) []byte {
+	if enc.graphicSet(0) != marc8set_BASIC_LATIN {
+		units = enc.designate(units, marc8set_BASIC_LATIN)
+	}
+	if enc.graphicSet(1) != marc8set_ANSEL {
+		units = enc.designate(units, marc8set_ANSEL)
+	}
+	enc.g = [2]marc8SetID{}
+	return units
+}
+
+// encodeRune queues the representation of r for output.
+func(enc *MARC8Encoder[SourceT]) encodeRune(
+	r rune,
+	offset uint64,
+	destBytes []byte,
+	outCount *int,
+) (err error, permanent bool) {
+	units := enc.outBuffer[:0]
+	if r == 0x20 {
+		units = append(units, 0x20)
+	} else if control, isControl := enc.Variant.control(r); isControl {
+		if control == marc8_FIELD_TERMINATOR || control == marc8_RECORD_TERMINATOR {
+			units = enc.restoreDefaults(units)
+		}
+		units = append(units, control)
+	} else if set, code := enc.lookup(r); code == 0 {
+		enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+		return
+	} else {
+		index := 0
+		if set != marc8set_BASIC_LATIN && marc8Sets[set].g1 {
+			index = 1
+		}
+		if enc.graphicSet(index) != set {
+			units = enc.designate(units, set)
+		}
+		units = append(units, code | byte(index << 7))
+	}
+	enc.outBuffer = units
+	enc.replacement = putChars(units, destBytes, outCount)
+	return
+}
+
+// flushHeld moves the held group into flush, combining characters first.
+func(enc *MARC8Encoder[SourceT]) flushHeld() {
+	enc.flush = append(enc.flush, enc.held[1:]...)
+	enc.flush = append(enc.flush, enc.held[0])
+	enc.flushOffsets = append(enc.flushOffsets, enc.heldOffsets[1:]...)
+	enc.flushOffsets = append(enc.flushOffsets, enc.heldOffsets[0])
+	enc.held = enc.held[:0]
+	enc.heldOffsets = enc.heldOffsets[:0]
+}
+
+func(enc *MARC8Encoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if len(enc.flush) > 0 {
+			r, offset := enc.flush[0], enc.flushOffsets[0]
+			enc.flush = enc.flush[1:]
+			enc.flushOffsets = enc.flushOffsets[1:]
+			err, permanent = enc.encodeRune(r, offset, destBytes, &outCount)
+		} else if consumed >= len(srcChars) {
+			if !atEOF {
+				break
+			}
+			if len(enc.held) > 0 {
+				enc.flushHeld()
+				continue
+			}
+			if enc.surrogateHalf != 0 {
+				enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+					enc.offset - 1,
+					enc.surrogateHalf,
+				)
+				enc.surrogateHalf = 0
+			} else if enc.g != [2]marc8SetID{} {
+				enc.outBuffer = enc.restoreDefaults(enc.outBuffer[:0])
+				enc.replacement = putChars(enc.outBuffer, destBytes, &outCount)
+				continue
+			} else {
+				break
+			}
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					if isMARC8Combining(r) {
+						consumed++
+						enc.offset++
+						if len(enc.held) > 0 {
+							enc.held = append(enc.held, r)
+							enc.heldOffsets = append(enc.heldOffsets, offset)
+							continue
+						}
+						// nothing to attach to
+						err, permanent = enc.encodeRune(r, offset, destBytes, &outCount)
+						break
+					}
+					if len(enc.held) > 0 {
+						// the group is complete; r is processed afresh
+						// afterwards
+						enc.flushHeld()
+						continue
+					}
+					consumed++
+					enc.offset++
+					if _, isControl := enc.Variant.control(r); isControl {
+						err, permanent = enc.encodeRune(r, offset, destBytes, &outCount)
+						break
+					}
+					// Base characters are held back until we know which
+					// combining characters follow, which we must emit first.
+					marc8EncodeOnce.Do(loadMARC8Tables)
+					if parts, found := marc8DecompositionMap[r]; found {
+						enc.held = append(enc.held, parts...)
+						for range parts {
+							enc.heldOffsets = append(enc.heldOffsets, offset)
+						}
+					} else {
+						enc.held = append(enc.held, r)
+						enc.heldOffsets = append(enc.heldOffsets, offset)
+					}
+					continue
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &MARC8Encoder[rune]{}
+var _ Codec[uint16, byte] = &MARC8Encoder[uint16]{}
@@ -0,0 +1,58 @@
+package gotextenc
+
+// ReplacementDecoder implements the "replacement" encoding of the WHATWG
+// Encoding Standard, which stands in for encodings browsers refuse to decode
+// (such as ISO-2022-KR): any input at all is reported as a single unmapped
+// sequence at its start, replaced as the error handler sees fit, and the rest
+// of it is discarded.
+type ReplacementDecoder[TargetT CharLike] struct {
+	ErrorHandler MultiByteDecodingErrorHandler[TargetT]
+	replaced bool
+	offset uint64
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *ReplacementDecoder[TargetT]) Reset(offset uint64) {
+	dec.replaced = false
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *ReplacementDecoder[TargetT]) errorHandler() MultiByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *ReplacementDecoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	dec.replacement = putChars(dec.replacement, destChars, &outCount)
+	if !dec.replaced && len(srcBytes) > 0 {
+		var permanent bool
+		dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(dec.offset, srcBytes)
+		dec.replaced = true
+		if permanent {
+			dec.permanentError = err
+		}
+		if err == nil {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+		}
+	}
+	consumed = len(srcBytes)
+	dec.offset += uint64(consumed)
+	return
+}
+
+var _ Codec[byte, rune] = &ReplacementDecoder[rune]{}
+var _ Codec[byte, uint16] = &ReplacementDecoder[uint16]{}
@@ -0,0 +1,77 @@
+package gotextenc
+
+type SingleByteDecoder[TargetT CharLike] struct {
+	ErrorHandler MultiByteDecodingErrorHandler[TargetT]
+	Charset *SingleByteCharset
+	offset uint64
+	outBuffer [2]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *SingleByteDecoder[TargetT]) Reset(offset uint64) {
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *SingleByteDecoder[TargetT]) errorHandler() MultiByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *SingleByteDecoder[TargetT]) charset() *SingleByteCharset {
+	if dec.Charset != nil {
+		return dec.Charset
+	} else {
+		return ISO8859_1
+	}
+}
+
+func(dec *SingleByteDecoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	charset := dec.charset()
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		if consumed >= len(srcBytes) {
+			break
+		}
+		b := srcBytes[consumed]
+		var permanent bool
+		if r, mapped := charset.Decode(b); !mapped {
+			dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(dec.offset, []byte {b})
+		} else if rune(TargetT(r)) == r {
+			destChars[outCount] = TargetT(r)
+			outCount++
+		} else {
+			var units []TargetT
+			units, err, permanent = appendDecodedRune[TargetT](dec.outBuffer[:0], r, dec.offset, dec.errorHandler())
+			dec.replacement = putChars(units, destChars, &outCount)
+		}
+		consumed++
+		dec.offset++
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &SingleByteDecoder[rune]{}
+var _ Codec[byte, uint16] = &SingleByteDecoder[uint16]{}
@@ -0,0 +1,171 @@
+package gotextenc
+
+import (
+	"math/bits"
+)
+
+// TeletextDecoder decodes characters as per ETS 300 706. Bytes 0x20..0x7F
+// are taken from the G0 set selected by Charset (and NationalSubset, for the
+// Latin G0 set), while 0xA0..0xFF address the G2 set selected by G2. The
+// non-spacing diacritics 0xC1..0xCF of all but the Arabic G2 set compose with
+// the following G0 character.
+type TeletextDecoder[TargetT CharLike] struct {
+	ErrorHandler MultiByteDecodingErrorHandler[TargetT]
+	Charset TeletextCharset
+	NationalSubset TeletextNationalSubset
+	G2 TeletextG2Set
+	Flags TeletextFlags
+	prefix byte
+	offset uint64
+	outBuffer [4]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *TeletextDecoder[TargetT]) Reset(offset uint64) {
+	dec.prefix = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *TeletextDecoder[TargetT]) errorHandler() MultiByteDecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *TeletextDecoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	table := getTeletextTable(dec.Charset, dec.NationalSubset, dec.G2)
+	diacritics := ISO6937VAR_ISO6937.charset()
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcBytes) {
+			if !atEOF || dec.prefix == 0 {
+				break
+			}
+			// diacritic at end of input
+			dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+				dec.offset - 1,
+				[]byte {dec.prefix},
+			)
+			dec.prefix = 0
+			if permanent {
+				dec.permanentError = err
+			}
+			if err != nil {
+				return
+			}
+			continue
+		}
+		b := srcBytes[consumed]
+		if (dec.Flags & TTXFL_ODD_PARITY) != 0 {
+			if bits.OnesCount8(b) % 2 == 0 {
+				// parity error
+				dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(dec.offset, []byte {b})
+				consumed++
+				dec.offset++
+				if permanent {
+					dec.permanentError = err
+				}
+				if err != nil {
+					return
+				}
+				continue
+			}
+			b &= 0x7F
+		}
+		units := dec.outBuffer[:0]
+		switch {
+			case dec.prefix != 0:
+				var base rune
+				if b >= 0x20 && b < 0x80 {
+					base = table.g0[b - 0x20]
+				}
+				if base == 0 {
+					// not a base character => report the diacritic on its own
+					// and process b afresh
+					dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(
+						dec.offset - 1,
+						[]byte {dec.prefix},
+					)
+					dec.prefix = 0
+					break
+				}
+				var composed rune
+				if base < 0x7F {
+					composed = diacritics.composed[dec.prefix & 0x0F][base - 0x20]
+				}
+				if composed != 0 {
+					units, err, permanent = appendDecodedRune[TargetT](units, composed, dec.offset - 1, dec.errorHandler())
+				} else {
+					units, err, permanent = appendDecodedRune[TargetT](units, base, dec.offset - 1, dec.errorHandler())
+					if err == nil {
+						units, err, permanent = appendDecodedRune[TargetT](
+							units,
+							diacritics.diacritics[dec.prefix & 0x0F],
+							dec.offset - 1,
+							dec.errorHandler(),
+						)
+					}
+				}
+				dec.replacement = putChars(units, destChars, &outCount)
+				dec.prefix = 0
+				consumed++
+				dec.offset++
+			case b < 0x20:
+				// spacing attribute
+				if (dec.Flags & TTXFL_KEEP_CONTROL_CODES) != 0 {
+					destChars[outCount] = TargetT(b)
+				} else {
+					destChars[outCount] = ' '
+				}
+				outCount++
+				consumed++
+				dec.offset++
+			case b >= 0xC1 && b < 0xD0 && table.diacritics && diacritics.diacritics[b & 0x0F] != 0:
+				dec.prefix = b
+				consumed++
+				dec.offset++
+			default:
+				var r rune
+				if b >= 0x20 && b < 0x80 {
+					r = table.g0[b - 0x20]
+				} else if b >= 0xA0 {
+					r = table.g2[b - 0xA0]
+				}
+				if r != 0 {
+					units, err, permanent = appendDecodedRune[TargetT](units, r, dec.offset, dec.errorHandler())
+					dec.replacement = putChars(units, destChars, &outCount)
+				} else {
+					dec.replacement, err, permanent = dec.errorHandler().UnmappedSequence(dec.offset, []byte {b})
+				}
+				consumed++
+				dec.offset++
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &TeletextDecoder[rune]{}
+var _ Codec[byte, uint16] = &TeletextDecoder[uint16]{}
@@ -0,0 +1,169 @@
+package gotextenc
+
+type TeletextEncoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	Charset TeletextCharset
+	NationalSubset TeletextNationalSubset
+	G2 TeletextG2Set
+	Flags TeletextFlags
+	base byte
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [2]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *TeletextEncoder[SourceT]) Reset(offset uint64) {
+	enc.base = 0
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *TeletextEncoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(enc *TeletextEncoder[SourceT]) putByte(b byte, destBytes []byte, outCount *int) {
+	if (enc.Flags & TTXFL_ODD_PARITY) != 0 {
+		b = teletextWithParity(b)
+	}
+	destBytes[*outCount] = b
+	*outCount++
+}
+
+// encodeComposed finds a diacritic prefix and G0 base for r.
+func(enc *TeletextEncoder[SourceT]) encodeComposed(r rune, table *teletextTable) (prefix byte, base byte) {
+	if (enc.Flags & TTXFL_ODD_PARITY) != 0 || !table.diacritics {
+		return
+	}
+	code := ISO6937VAR_ISO6937.charset().encodeMap[r]
+	if code <= 0xFF {
+		return
+	}
+	if b := byte(code); table.g0[b - 0x20] == rune(b) {
+		prefix, base = byte(code >> 8), b
+	}
+	return
+}
+
+func(enc *TeletextEncoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	table := getTeletextTable(enc.Charset, enc.NationalSubset, enc.G2)
+	diacritics := ISO6937VAR_ISO6937.charset()
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF {
+				break
+			}
+			if enc.base != 0 {
+				enc.putByte(enc.base, destBytes, &outCount)
+				enc.base = 0
+				continue
+			}
+			if enc.surrogateHalf == 0 {
+				break
+			}
+			enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+				enc.offset - 1,
+				enc.surrogateHalf,
+			)
+			enc.surrogateHalf = 0
+		} else if enc.base != 0 {
+			// Base characters are held back until we know whether a combining
+			// character follows, which we must then emit as a prefix.
+			prefix := diacritics.diacriticPrefix(rune(srcChars[consumed]))
+			if prefix != 0 && table.diacritics && (enc.Flags & TTXFL_ODD_PARITY) == 0 {
+				enc.outBuffer = [2]byte {prefix, enc.base}
+				enc.replacement = putChars(enc.outBuffer[:], destBytes, &outCount)
+				consumed++
+				enc.offset++
+			} else {
+				enc.putByte(enc.base, destBytes, &outCount)
+			}
+			enc.base = 0
+			continue
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					offset := enc.offset
+					if rune(unit) != r {
+						// second half of surrogate pair
+						offset--
+					}
+					consumed++
+					enc.offset++
+					b, found := table.encodeMap[r]
+					if found && b >= 0x80 && (enc.Flags & TTXFL_ODD_PARITY) != 0 {
+						// no G2 with parity
+						found = false
+					}
+					switch {
+						case r < 0x20:
+							enc.putByte(byte(r), destBytes, &outCount)
+						case found && b > 0x20 && b < 0x80:
+							enc.base = b
+						case found:
+							enc.putByte(b, destBytes, &outCount)
+						default:
+							if prefix, base := enc.encodeComposed(r, table); prefix != 0 {
+								enc.outBuffer = [2]byte {prefix, base}
+								enc.replacement = putChars(enc.outBuffer[:], destBytes, &outCount)
+							} else {
+								enc.replacement, err, permanent = enc.errorHandler().UnrepresentableChar(offset, r)
+							}
+					}
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &TeletextEncoder[rune]{}
+var _ Codec[uint16, byte] = &TeletextEncoder[uint16]{}
@@ -0,0 +1,142 @@
+package gotextenc
+
+type UTF16Decoder[TargetT CharLike] struct {
+	ErrorHandler UTF16DecodingErrorHandler[TargetT]
+	LittleEndian bool
+	firstByte byte
+	haveByte bool
+	unit uint16
+	haveUnit bool
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [2]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(dec *UTF16Decoder[TargetT]) Reset(offset uint64) {
+	dec.haveByte = false
+	dec.haveUnit = false
+	dec.surrogateHalf = 0
+	dec.offset = offset
+	dec.replacement = nil
+	dec.permanentError = nil
+}
+
+func(dec *UTF16Decoder[TargetT]) errorHandler() UTF16DecodingErrorHandler[TargetT] {
+	if dec.ErrorHandler != nil {
+		return dec.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(dec *UTF16Decoder[TargetT]) Transcode(
+	srcBytes []byte,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if dec.permanentError != nil {
+		err = dec.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(dec.replacement) > 0 {
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		var unit uint16
+		if dec.haveUnit {
+			// unit that followed an unpaired high surrogate half
+			unit = dec.unit
+			dec.haveUnit = false
+		} else if consumed >= len(srcBytes) {
+			if !atEOF {
+				break
+			}
+			if dec.haveByte {
+				dec.replacement, err, permanent = dec.errorHandler().TruncatedSequence(
+					dec.offset - 1,
+					[]byte {dec.firstByte},
+				)
+				dec.haveByte = false
+			} else if dec.surrogateHalf != 0 {
+				dec.replacement, err, permanent = dec.errorHandler().UnpairedSurrogateHalf(
+					dec.offset - 2,
+					dec.surrogateHalf,
+				)
+				dec.surrogateHalf = 0
+			} else {
+				break
+			}
+			if permanent {
+				dec.permanentError = err
+			}
+			if err != nil {
+				return
+			}
+			continue
+		} else {
+			b := srcBytes[consumed]
+			consumed++
+			dec.offset++
+			if !dec.haveByte {
+				dec.firstByte = b
+				dec.haveByte = true
+				continue
+			}
+			dec.haveByte = false
+			if dec.LittleEndian {
+				unit = uint16(b) << 8 | uint16(dec.firstByte)
+			} else {
+				unit = uint16(dec.firstByte) << 8 | uint16(b)
+			}
+		}
+		unitOffset := dec.offset - 2
+		switch {
+			case dec.surrogateHalf != 0:
+				if unit >= 0xDC00 && unit < 0xE000 {
+					var units []TargetT
+					units, err, permanent = appendDecodedRune[TargetT](
+						dec.outBuffer[:0],
+						CodePointFromSurrogatePair(dec.surrogateHalf, unit),
+						unitOffset - 2,
+						dec.errorHandler(),
+					)
+					dec.replacement = putChars(units, destChars, &outCount)
+				} else {
+					dec.replacement, err, permanent = dec.errorHandler().UnpairedSurrogateHalf(
+						unitOffset - 2,
+						dec.surrogateHalf,
+					)
+					dec.unit = unit
+					dec.haveUnit = true
+				}
+				dec.surrogateHalf = 0
+			case unit >= 0xD800 && unit < 0xDC00:
+				dec.surrogateHalf = unit
+			case unit >= 0xDC00 && unit < 0xE000:
+				dec.replacement, err, permanent = dec.errorHandler().UnpairedSurrogateHalf(unitOffset, unit)
+			default:
+				var units []TargetT
+				units, err, permanent = appendDecodedRune[TargetT](
+					dec.outBuffer[:0],
+					rune(unit),
+					unitOffset,
+					dec.errorHandler(),
+				)
+				dec.replacement = putChars(units, destChars, &outCount)
+		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[byte, rune] = &UTF16Decoder[rune]{}
+var _ Codec[byte, uint16] = &UTF16Decoder[uint16]{}
@@ -0,0 +1,108 @@
+package gotextenc
+
+type UTF16Encoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	LittleEndian bool
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [4]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *UTF16Encoder[SourceT]) Reset(offset uint64) {
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *UTF16Encoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(enc *UTF16Encoder[SourceT]) putUnit(buffer []byte, unit uint16) {
+	if enc.LittleEndian {
+		buffer[0] = byte(unit)
+		buffer[1] = byte(unit >> 8)
+	} else {
+		buffer[0] = byte(unit >> 8)
+		buffer[1] = byte(unit)
+	}
+}
+
+func(enc *UTF16Encoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF || enc.surrogateHalf == 0 {
+				break
+			}
+			enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+				enc.offset - 1,
+				enc.surrogateHalf,
+			)
+			enc.surrogateHalf = 0
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					consumed++
+					enc.offset++
+					var units [2]uint16
+					encoded, _ := encodeRune(r, &units)
+					for index, unit := range encoded {
+						enc.putUnit(enc.outBuffer[index * 2:], unit)
+					}
+					enc.replacement = putChars(enc.outBuffer[:len(encoded) * 2], destBytes, &outCount)
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &UTF16Encoder[rune]{}
+var _ Codec[uint16, byte] = &UTF16Encoder[uint16]{}
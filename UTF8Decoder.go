@@ -17,11 +17,6 @@ const (
 	u8dec_SEQ4BYTE2
 	u8dec_ERROR_UNEXCONTB
 	u8dec_ERROR_ILLSTRSEQ
-	u8dec_ERROR_INVCONTBY
-	/*
-	//TODO: remove rest
-	u8dec_ERROR_
-	*/
 )
 
 type UTF8Decoder[TargetT CharLike] struct {
@@ -30,12 +25,14 @@ type UTF8Decoder[TargetT CharLike] struct {
 	partial uint32
 	offset uint64
 	surrogateHalf uint16
+	outBuffer [2]TargetT
 	replacement []TargetT
 	permanentError error
 }
 
 func(dec *UTF8Decoder[TargetT]) Reset(offset uint64) {
 	dec.state = u8dec_NONE
+	dec.partial = 0
 	dec.offset = offset
 	dec.surrogateHalf = 0
 	dec.replacement = nil
@@ -76,6 +73,46 @@ func(dec *UTF8Decoder[TargetT]) sequenceOffset() uint8 {
 	}
 }
 
+// partialSequence reconstructs the bytes of the sequence read so far from
+// dec.partial, for reporting it as truncated.
+func(dec *UTF8Decoder[TargetT]) partialSequence() []byte {
+	count := dec.sequenceOffset()
+	sequence := make([]byte, count)
+	bits := dec.partial
+	for i := count - 1; i > 0; i-- {
+		sequence[i] = 0x80 | byte(bits & 0x3F)
+		bits >>= 6
+	}
+	switch dec.expectedLength() {
+		case 2:
+			sequence[0] = 0xC0 | byte(bits)
+		case 3:
+			sequence[0] = 0xE0 | byte(bits)
+		default:
+			sequence[0] = 0xF0 | byte(bits)
+	}
+	return sequence
+}
+
+// truncatedSequence reports the sequence cut off by the end of input, as
+// such if the error handler tells truncation apart.
+func(dec *UTF8Decoder[TargetT]) truncatedSequence() ([]TargetT, error, bool) {
+	sequence := dec.partialSequence()
+	handler := dec.errorHandler()
+	if truncationHandler, ok := handler.(UTF8TruncationErrorHandler[TargetT]); ok {
+		return truncationHandler.TruncatedSequence(dec.offset, sequence)
+	}
+	return handler.IllegalStartOfSequence(dec.offset, sequence[0], true)
+}
+
+// unpairedSurrogateHalf reports the pending high surrogate half, which was
+// encoded in the three bytes right before dec.offset.
+func(dec *UTF8Decoder[TargetT]) unpairedSurrogateHalf() ([]TargetT, error, bool) {
+	half := dec.surrogateHalf
+	dec.surrogateHalf = 0
+	return dec.errorHandler().UnpairedSurrogateHalf(dec.offset - 3, half)
+}
+
 func(dec *UTF8Decoder[TargetT]) Transcode(
 	srcBytes []byte,
 	destChars []TargetT,
@@ -85,53 +122,82 @@ func(dec *UTF8Decoder[TargetT]) Transcode(
 		err = dec.permanentError
 		return
 	}
+	// While inside a multi-byte sequence, dec.offset stays at the start of it;
+	// otherwise it is the offset of the next byte. A pending high surrogate half
+	// (as found in CESU-8) always sits in the three bytes before dec.offset,
+	// since anything but its low half reports it first.
 	for outCount < len(destChars) {
 		if len(dec.replacement) > 0 {
-			copyCount := minInt(len(destChars) - outCount, len(dec.replacement))
-			for i := 0; i < copyCount; i++ {
-				destChars[outCount + i] = dec.replacement[i]
-			}
-			outCount += copyCount
-			dec.replacement = dec.replacement[copyCount:]
+			dec.replacement = putChars(dec.replacement, destChars, &outCount)
 			continue
 		}
+		var permanent bool
 		if consumed >= len(srcBytes) {
-			break
+			if !atEOF {
+				break
+			}
+			if dec.surrogateHalf != 0 {
+				dec.replacement, err, permanent = dec.unpairedSurrogateHalf()
+			} else if dec.state != u8dec_NONE && dec.state < u8dec_ERROR_UNEXCONTB {
+				dec.replacement, err, permanent = dec.truncatedSequence()
+				dec.offset += uint64(dec.sequenceOffset())
+				dec.state = u8dec_NONE
+			} else {
+				dec.state = u8dec_NONE
+				break
+			}
+			if permanent {
+				dec.permanentError = err
+			}
+			if err != nil {
+				return
+			}
+			continue
 		}
 		b := srcBytes[consumed]
-		//TODO: generally maintain offset at start when reconstructible
-		//TODO: check surrogateHalf whenever emitting or switching to u8dec_ERROR
-		//TODO: in general: check for embedded UTF-16
-		//TODO: check for overlong whenever emitting
-		var permanent bool
 		switch dec.state {
-			case u8dec_NONE: // initial byte
-				// Check the high couple o' bits <del>first</del> <ins>zeroeth</ins>:
-				// If they don't indicate a multi-byte sequence, we're done already.
+			case u8dec_NONE, u8dec_ERROR_UNEXCONTB, u8dec_ERROR_ILLSTRSEQ: // initial byte
+				if dec.surrogateHalf != 0 && b != 0xED {
+					// only ED can start the low half
+					dec.replacement, err, permanent = dec.unpairedSurrogateHalf()
+					break
+				}
+				// Check the high couple o' bits first: If they don't indicate
+				// a multi-byte sequence, we're done already.
 				switch b & 0xC0 {
 					case 0xC0:
 						// starts with 11 => check next couple o' bits below
 					case 0x80:
-						// starts with 10 => continuation byte at start of sequence
-						dec.replacement, err, permanent = dec.errorHandler().UnexpectedContinuationByte(
-							dec.offset,
-							b,
-							true,
-						)
-						if permanent {
-							dec.permanentError = err
+						// starts with 10 => continuation byte at start of sequence;
+						// those following an illegal start byte belong to its sequence
+						if dec.state == u8dec_ERROR_ILLSTRSEQ {
+							dec.replacement, err, permanent = dec.errorHandler().IllegalStartOfSequence(
+								dec.offset,
+								b,
+								false,
+							)
+						} else {
+							dec.replacement, err, permanent = dec.errorHandler().UnexpectedContinuationByte(
+								dec.offset,
+								b,
+								dec.state == u8dec_NONE,
+							)
+							dec.state = u8dec_ERROR_UNEXCONTB
 						}
-						dec.state = u8dec_ERROR_UNEXCONTB
+						consumed++
 						dec.offset++
-						continue
 					default:
 						// starts with 0 => 1-byte sequence
 						destChars[outCount] = TargetT(b)
 						outCount++
 						consumed++
 						dec.offset++
+						dec.state = u8dec_NONE
 						continue
 				}
+				if (b & 0xC0) != 0xC0 {
+					break
+				}
 				// Check the next couple o' bits next: They indicate the length
 				// of the multi-byte sequence.
 				switch b & 0x30 {
@@ -147,11 +213,8 @@ func(dec *UTF8Decoder[TargetT]) Transcode(
 							dec.replacement, err, permanent = dec.errorHandler().IllegalStartOfSequence(
 								dec.offset,
 								b,
-								true,
+								dec.state != u8dec_ERROR_ILLSTRSEQ,
 							)
-							if permanent {
-								dec.permanentError = err
-							}
 							dec.state = u8dec_ERROR_ILLSTRSEQ
 							dec.offset++
 						} else {
@@ -160,41 +223,92 @@ func(dec *UTF8Decoder[TargetT]) Transcode(
 						}
 				}
 				consumed++
-			case u8dec_SEQ3BYTE0, u8dec_SEQ4BYTE0, u8dec_SEQ4BYTE1: // continuation byte
+			case u8dec_SEQ2BYTE0, u8dec_SEQ3BYTE0, u8dec_SEQ3BYTE1,
+					u8dec_SEQ4BYTE0, u8dec_SEQ4BYTE1, u8dec_SEQ4BYTE2: // continuation byte
+				length := dec.expectedLength()
+				sequenceOffset := dec.sequenceOffset()
 				if (b & 0xC0) != 0x80 {
-					sequenceOffset := dec.sequenceOffset()
+					if dec.surrogateHalf != 0 {
+						dec.replacement, err, permanent = dec.unpairedSurrogateHalf()
+						break
+					}
+					// The sequence ends here, and b starts the next one.
 					dec.replacement, err, permanent = dec.errorHandler().InvalidContinuationByte(
-						dec.offset,
+						dec.offset + uint64(sequenceOffset),
 						b,
-						dec.expectedLength(),
+						length,
 						sequenceOffset,
 						true,
 					)
-					if permanent {
-						dec.permanentError = err
-					}
-					dec.state = u8dec_ERROR_INVCONTBY
-					dec.offset += uint64(sequenceOffset + 1)
-				} else {
+					dec.offset += uint64(sequenceOffset)
+					dec.state = u8dec_NONE
+					break
+				}
+				if sequenceOffset + 1 < length {
 					dec.partial = (dec.partial << 6) | uint32(b & 0x3F)
 					dec.state++
+					consumed++
+					break
+				}
+				// final byte
+				codePoint := rune((dec.partial << 6) | uint32(b & 0x3F))
+				isLowHalf := codePoint >= 0xDC00 && codePoint < 0xE000 && length == 3
+				if dec.surrogateHalf != 0 && !isLowHalf {
+					dec.replacement, err, permanent = dec.unpairedSurrogateHalf()
+					break
+				}
+				sequenceStart := dec.offset
+				consumed++
+				dec.offset += uint64(length)
+				dec.state = u8dec_NONE
+				switch {
+					case codePoint > 0x10FFFF:
+						dec.replacement, err, permanent = dec.errorHandler().IllegalCodePoint(
+							sequenceStart,
+							codePoint,
+						)
+					case UTF8Length(codePoint) != length:
+						dec.replacement, err, permanent = dec.errorHandler().OverlongEncoding(
+							sequenceStart,
+							codePoint,
+							length,
+						)
+					case dec.surrogateHalf != 0:
+						dec.replacement, err, permanent = dec.errorHandler().DoublyEncoded(
+							sequenceStart - 3,
+							dec.surrogateHalf,
+							uint16(codePoint),
+						)
+						dec.surrogateHalf = 0
+					case codePoint >= 0xD800 && codePoint < 0xDC00:
+						dec.surrogateHalf = uint16(codePoint)
+					case isLowHalf:
+						dec.replacement, err, permanent = dec.errorHandler().UnpairedSurrogateHalf(
+							sequenceStart,
+							uint16(codePoint),
+						)
+					default:
+						var units []TargetT
+						units, err, permanent = appendDecodedRune[TargetT](
+							dec.outBuffer[:0],
+							codePoint,
+							sequenceStart,
+							dec.errorHandler(),
+						)
+						dec.replacement = putChars(units, destChars, &outCount)
 				}
-			case u8dec_SEQ2BYTE0, u8dec_SEQ3BYTE1, u8dec_SEQ4BYTE2: // final byte
-				//TODO
-			case u8dec_ERROR_UNEXCONTB:
-				//TODO
-			case u8dec_ERROR_ILLSTRSEQ:
-				//TODO
-			case u8dec_ERROR_INVCONTBY:
-				//TODO
-			//TODO: other error states
 			default:
 				dec.permanentError = errors.New(fmt.Sprintf("Unrecognized UTF8Decoder state: %d", dec.state))
 				err = dec.permanentError
 				return
 		}
+		if permanent {
+			dec.permanentError = err
+		}
+		if err != nil {
+			return
+		}
 	}
-	//TODO: handle atEOF, dont forget to check if all input was consumed
 	return
 }
 
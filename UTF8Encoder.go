@@ -0,0 +1,116 @@
+package gotextenc
+
+type UTF8Encoder[SourceT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[byte]
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [4]byte
+	replacement []byte
+	permanentError error
+}
+
+func(enc *UTF8Encoder[SourceT]) Reset(offset uint64) {
+	enc.surrogateHalf = 0
+	enc.offset = offset
+	enc.replacement = nil
+	enc.permanentError = nil
+}
+
+func(enc *UTF8Encoder[SourceT]) errorHandler() EncodingErrorHandler[byte] {
+	if enc.ErrorHandler != nil {
+		return enc.ErrorHandler
+	} else {
+		return DefaultErrorHandler[byte]{DEFERRHDLFL_SECURE}
+	}
+}
+
+// encode puts the UTF-8 sequence for the code point r into enc.outBuffer.
+func(enc *UTF8Encoder[SourceT]) encode(r rune) []byte {
+	switch UTF8Length(r) {
+		case 1:
+			enc.outBuffer[0] = byte(r)
+			return enc.outBuffer[:1]
+		case 2:
+			enc.outBuffer[0] = 0xC0 | byte(r >> 6)
+			enc.outBuffer[1] = 0x80 | byte(r & 0x3F)
+			return enc.outBuffer[:2]
+		case 3:
+			enc.outBuffer[0] = 0xE0 | byte(r >> 12)
+			enc.outBuffer[1] = 0x80 | byte((r >> 6) & 0x3F)
+			enc.outBuffer[2] = 0x80 | byte(r & 0x3F)
+			return enc.outBuffer[:3]
+		default:
+			enc.outBuffer[0] = 0xF0 | byte(r >> 18)
+			enc.outBuffer[1] = 0x80 | byte((r >> 12) & 0x3F)
+			enc.outBuffer[2] = 0x80 | byte((r >> 6) & 0x3F)
+			enc.outBuffer[3] = 0x80 | byte(r & 0x3F)
+			return enc.outBuffer[:4]
+	}
+}
+
+func(enc *UTF8Encoder[SourceT]) Transcode(
+	srcChars []SourceT,
+	destBytes []byte,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if enc.permanentError != nil {
+		err = enc.permanentError
+		return
+	}
+	for outCount < len(destBytes) {
+		if len(enc.replacement) > 0 {
+			enc.replacement = putChars(enc.replacement, destBytes, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF || enc.surrogateHalf == 0 {
+				break
+			}
+			enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+				enc.offset - 1,
+				enc.surrogateHalf,
+			)
+			enc.surrogateHalf = 0
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &enc.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					enc.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					enc.replacement, err, permanent = enc.errorHandler().UnpairedSurrogateHalf(
+						enc.offset,
+						uint16(r),
+					)
+					consumed++
+					enc.offset++
+				case srcrune_ILLEGAL:
+					enc.replacement, err, permanent = enc.errorHandler().IllegalCodePoint(enc.offset, r)
+					consumed++
+					enc.offset++
+				default:
+					consumed++
+					enc.offset++
+					enc.replacement = putChars(enc.encode(r), destBytes, &outCount)
+			}
+		}
+		if permanent {
+			enc.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[rune, byte] = &UTF8Encoder[rune]{}
+var _ Codec[uint16, byte] = &UTF8Encoder[uint16]{}
@@ -0,0 +1,103 @@
+package gotextenc
+
+// UnitConverter converts between the Unicode code units of the CharLike types:
+// runes are code points, uint16 values UTF-16 code units and bytes Latin-1
+// characters. It serves as the pivot by which the registry derives, say, a
+// decoder to UTF-16 from a decoder to runes.
+type UnitConverter[SourceT CharLike, TargetT CharLike] struct {
+	ErrorHandler EncodingErrorHandler[TargetT]
+	surrogateHalf uint16
+	offset uint64
+	outBuffer [2]TargetT
+	replacement []TargetT
+	permanentError error
+}
+
+func(conv *UnitConverter[SourceT, TargetT]) Reset(offset uint64) {
+	conv.surrogateHalf = 0
+	conv.offset = offset
+	conv.replacement = nil
+	conv.permanentError = nil
+}
+
+func(conv *UnitConverter[SourceT, TargetT]) errorHandler() EncodingErrorHandler[TargetT] {
+	if conv.ErrorHandler != nil {
+		return conv.ErrorHandler
+	} else {
+		return DefaultErrorHandler[TargetT]{DEFERRHDLFL_SECURE}
+	}
+}
+
+func(conv *UnitConverter[SourceT, TargetT]) Transcode(
+	srcChars []SourceT,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	if conv.permanentError != nil {
+		err = conv.permanentError
+		return
+	}
+	for outCount < len(destChars) {
+		if len(conv.replacement) > 0 {
+			conv.replacement = putChars(conv.replacement, destChars, &outCount)
+			continue
+		}
+		var permanent bool
+		if consumed >= len(srcChars) {
+			if !atEOF || conv.surrogateHalf == 0 {
+				break
+			}
+			conv.replacement, err, permanent = conv.errorHandler().UnpairedSurrogateHalf(
+				conv.offset - 1,
+				conv.surrogateHalf,
+			)
+			conv.surrogateHalf = 0
+		} else {
+			unit := srcChars[consumed]
+			r, status := nextSourceRune(unit, &conv.surrogateHalf)
+			switch status {
+				case srcrune_PENDING:
+					consumed++
+					conv.offset++
+					continue
+				case srcrune_UNPAIRED_PENDING:
+					conv.replacement, err, permanent = conv.errorHandler().UnpairedSurrogateHalf(
+						conv.offset - 1,
+						uint16(r),
+					)
+				case srcrune_UNPAIRED:
+					conv.replacement, err, permanent = conv.errorHandler().UnpairedSurrogateHalf(
+						conv.offset,
+						uint16(r),
+					)
+					consumed++
+					conv.offset++
+				case srcrune_ILLEGAL:
+					conv.replacement, err, permanent = conv.errorHandler().IllegalCodePoint(conv.offset, r)
+					consumed++
+					conv.offset++
+				default:
+					consumed++
+					conv.offset++
+					// a completed surrogate pair started at the preceding unit
+					offset := conv.offset - 1
+					if r >= 0x10000 && rune(SourceT(r)) != r {
+						offset--
+					}
+					var units []TargetT
+					units, err, permanent = appendDecodedRune[TargetT](conv.outBuffer[:0], r, offset, conv.errorHandler())
+					conv.replacement = putChars(units, destChars, &outCount)
+			}
+		}
+		if permanent {
+			conv.permanentError = err
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+var _ Codec[uint16, rune] = &UnitConverter[uint16, rune]{}
+var _ Codec[rune, uint16] = &UnitConverter[rune, uint16]{}
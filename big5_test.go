@@ -0,0 +1,34 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestBig5RoundTrip(t *testing.T) {
+	checkRoundTrip(t, "Big5", []byte("\xA4\xA4\xA4\xE5\xC1\x63\xC5\xE9"), "中文繁體")
+	checkRoundTrip(t, "CP950", []byte("\xA4\xA4\xA3\xE1\xF9\xFE"), "中€▓")
+	checkRoundTrip(t, "Big5-HKSCS", []byte("\xA4\xA4\x88\x45\xF9\xFE\xC8\x7A"), "中𠄌￭𠃌")
+}
+
+func TestBig5HKSCSPairs(t *testing.T) {
+	// four codes stand for a base character and a combining one
+	checkRoundTrip(
+		t,
+		"Big5-HKSCS",
+		[]byte("\x88\x62\x88\x64\x88\xA3\x88\xA5\x88\x66\x88\xA7"),
+		"Ê̄Ê̌ê̄ê̌Êê",
+	)
+	// a base character on its own is held back only as long as the next
+	// character is not known
+	checkEncode(t, "Big5-HKSCS", "Êaê̄", []byte("\x88\x66a\x88\xA3"))
+}
+
+func TestBig5Errors(t *testing.T) {
+	// extensions of CP950 and HKSCS are not in Big5
+	checkDecode(t, "Big5", []byte("\xA3\xE1\xF9\xFE\x88\x62"), "���b", 0, 2, 4)
+	checkDecode(t, "Big5", []byte("a\xA4"), "a�", 1)
+	checkDecode(t, "Big5", []byte("\xA4 "), "� ", 0)
+	checkDecode(t, "Big5", []byte("\x80\xFF"), "��", 0, 1)
+	checkDecode(t, "Big5-HKSCS", []byte("\xA3\xE1"), "�", 0)
+	checkEncode(t, "Big5", "€中𠄌", []byte("\x00\xA4\xA4\x00"), 0, 2)
+}
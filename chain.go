@@ -0,0 +1,224 @@
+package gotextenc
+
+const (
+	chain_PIVOT_SIZE = 256
+	// chain_HISTORY is the number of intermediate characters already consumed
+	// by the second stage whose origins are remembered, for errors reported
+	// after the fact
+	chain_HISTORY = 16
+)
+
+// chainCodec passes its input through first and the intermediate characters
+// through second.
+type chainCodec[SourceT CharLike, PivotT CharLike, TargetT CharLike] struct {
+	first Codec[SourceT, PivotT]
+	second Codec[PivotT, TargetT]
+	pivot [chain_PIVOT_SIZE]PivotT
+	// pivotOrigins holds the input offset each character in pivot stems from
+	pivotOrigins [chain_PIVOT_SIZE]uint64
+	pivotStart int
+	pivotEnd int
+	// history holds the origins of the characters consumed by second, at
+	// their intermediate offset modulo chain_HISTORY
+	history [chain_HISTORY]uint64
+	// pivotOffset is the intermediate offset of pivot[pivotStart]
+	pivotOffset uint64
+	// offset is the input offset of the next character for first
+	offset uint64
+	// origin is the input offset at which the input not yet reflected in
+	// the intermediate characters starts
+	origin uint64
+	// firstFlushed tells whether first has finished at the end of input
+	firstFlushed bool
+	// firstReplacing tells whether first may still hold the replacement for
+	// its last error, which it puts out only on the next call
+	firstReplacing bool
+	// firstError is an error of first held back until the intermediate
+	// characters preceding it have been passed on
+	firstError error
+	// replacementOffset is the intermediate offset at which the replacement
+	// for the last error of first goes, if there was one
+	replacementOffset uint64
+	firstErred bool
+	// secondError and relocatedError are the last error of second and its
+	// counterpart at the input offset
+	secondError relocatableError
+	relocatedError error
+}
+
+// Chain combines first and second, which meet at PivotT, into a codec from
+// SourceT to TargetT, e.g. a decoder to runes and an encoder from runes into
+// a converter between two byte encodings. The combined codec buffers the
+// intermediate characters itself; errors of second are reported at the input
+// offset the offending intermediate character stems from, as far as that can
+// be told: first is given all the input there is at once, so whatever it puts
+// out in one call is taken to stem from where the input of that call starts.
+// Feeding the combined codec a character at a time gives exact offsets.
+// Errors that do not come from this package are passed on as they are. Where
+// first replaces an offending character with REPLACEMENT_CHAR and second
+// cannot represent that in turn, only the error of first is reported.
+func Chain[SourceT CharLike, PivotT CharLike, TargetT CharLike](
+	first Codec[SourceT, PivotT],
+	second Codec[PivotT, TargetT],
+) Codec[SourceT, TargetT] {
+	return &chainCodec[SourceT, PivotT, TargetT] {
+		first: first,
+		second: second,
+	}
+}
+
+func(chain *chainCodec[SourceT, PivotT, TargetT]) Reset(offset uint64) {
+	chain.first.Reset(offset)
+	chain.second.Reset(0)
+	chain.pivotStart = 0
+	chain.pivotEnd = 0
+	chain.pivotOffset = 0
+	chain.history = [chain_HISTORY]uint64{}
+	chain.offset = offset
+	chain.origin = offset
+	chain.firstFlushed = false
+	chain.firstReplacing = false
+	chain.firstError = nil
+	chain.replacementOffset = 0
+	chain.firstErred = false
+	chain.secondError = nil
+	chain.relocatedError = nil
+}
+
+// originOf finds the input offset the intermediate character at offset stems
+// from.
+func(chain *chainCodec[SourceT, PivotT, TargetT]) originOf(offset uint64) uint64 {
+	switch {
+		case offset >= chain.pivotOffset:
+			index := chain.pivotStart + int(offset - chain.pivotOffset)
+			if index < chain.pivotEnd {
+				return chain.pivotOrigins[index]
+			}
+			return chain.origin
+		case chain.pivotOffset - offset <= chain_HISTORY:
+			return chain.history[offset % chain_HISTORY]
+		default:
+			// too far back: the oldest origin remembered will have to do
+			return chain.history[chain.pivotOffset % chain_HISTORY]
+	}
+}
+
+// echoesFirst tells whether err is second rejecting the replacement character
+// first put in for its last error.
+func(chain *chainCodec[SourceT, PivotT, TargetT]) echoesFirst(err error) bool {
+	unrepresentable, ok := err.(*UnrepresentableCharError)
+	return ok && chain.firstErred && unrepresentable.Char == REPLACEMENT_CHAR &&
+		unrepresentable.Offset == chain.replacementOffset && err != chain.secondError
+}
+
+// relocate translates an error of second to the input offset.
+func(chain *chainCodec[SourceT, PivotT, TargetT]) relocate(err error) error {
+	relocatable, ok := err.(relocatableError)
+	if !ok {
+		return err
+	}
+	// a permanent error is reported again and again
+	if relocatable != chain.secondError {
+		chain.secondError = relocatable
+		chain.relocatedError = relocatable.atOffset(chain.originOf(relocatable.InputOffset()))
+	}
+	return chain.relocatedError
+}
+
+// fill passes input to first until pivot is full, the input is exhausted,
+// first reports an error or first makes no progress.
+func(chain *chainCodec[SourceT, PivotT, TargetT]) fill(srcChars []SourceT, atEOF bool) (consumed int) {
+	if chain.pivotStart == chain.pivotEnd {
+		chain.pivotStart = 0
+		chain.pivotEnd = 0
+	}
+	for chain.pivotEnd < len(chain.pivot) && chain.firstError == nil {
+		var count, outCount int
+		var err error
+		if chain.firstReplacing {
+			// drain the replacement without input, lest it be taken for
+			// the output of the character that follows
+			count, outCount, err = chain.first.Transcode(nil, chain.pivot[chain.pivotEnd:], false)
+			chain.firstReplacing = chain.pivotEnd + outCount == len(chain.pivot)
+			if outCount == 0 && err == nil {
+				continue
+			}
+		} else if consumed < len(srcChars) {
+			count, outCount, err = chain.first.Transcode(srcChars[consumed:], chain.pivot[chain.pivotEnd:], atEOF)
+		} else if atEOF && !chain.firstFlushed {
+			count, outCount, err = chain.first.Transcode(nil, chain.pivot[chain.pivotEnd:], true)
+			chain.firstFlushed = err == nil && chain.pivotEnd + outCount < len(chain.pivot)
+		} else {
+			break
+		}
+		if count == 0 && outCount == 0 && err == nil {
+			break
+		}
+		// all of it stems from the start of the input of this call, as far
+		// as we can tell
+		for index := chain.pivotEnd; index < chain.pivotEnd + outCount; index++ {
+			chain.pivotOrigins[index] = chain.origin
+		}
+		chain.pivotEnd += outCount
+		consumed += count
+		chain.offset += uint64(count)
+		if outCount > 0 {
+			chain.origin = chain.offset
+		}
+		chain.firstError = err
+		if err != nil {
+			chain.firstReplacing = true
+		}
+	}
+	return
+}
+
+func(chain *chainCodec[SourceT, PivotT, TargetT]) Transcode(
+	srcChars []SourceT,
+	destChars []TargetT,
+	atEOF bool,
+) (consumed int, outCount int, err error) {
+	for outCount < len(destChars) {
+		pivotLength := chain.pivotEnd - chain.pivotStart
+		filled := chain.fill(srcChars[consumed:], atEOF)
+		consumed += filled
+		filled += chain.pivotEnd - chain.pivotStart - pivotLength
+		if chain.pivotStart == chain.pivotEnd && chain.firstError != nil {
+			err = chain.firstError
+			chain.firstError = nil
+			chain.replacementOffset = chain.pivotOffset
+			chain.firstErred = true
+			return
+		}
+		secondEOF := atEOF && consumed == len(srcChars) && chain.firstFlushed
+		pivotCount, count, secondErr := chain.second.Transcode(
+			chain.pivot[chain.pivotStart:chain.pivotEnd],
+			destChars[outCount:],
+			secondEOF,
+		)
+		for index := chain.pivotStart; index < chain.pivotStart + pivotCount; index++ {
+			chain.history[chain.pivotOffset % chain_HISTORY] = chain.pivotOrigins[index]
+			chain.pivotOffset++
+		}
+		chain.pivotStart += pivotCount
+		outCount += count
+		if secondErr != nil {
+			if chain.echoesFirst(secondErr) {
+				// already reported; second puts in its own replacement on
+				// the next round
+				chain.firstErred = false
+				continue
+			}
+			err = chain.relocate(secondErr)
+			return
+		}
+		if pivotCount == 0 && count == 0 && (secondEOF || consumed == len(srcChars) && !atEOF || filled == 0) {
+			// second is done with what there is, or neither stage can
+			// make progress
+			break
+		}
+	}
+	return
+}
+
+var _ Codec[byte, byte] = &chainCodec[byte, rune, byte]{}
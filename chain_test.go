@@ -0,0 +1,58 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func newTestChain(t *testing.T, from string, to string) Codec[byte, byte] {
+	t.Helper()
+	return Chain(newTestDecoder(t, from), newTestEncoder(t, to))
+}
+
+func TestChain(t *testing.T) {
+	checkTranscode(t, newTestChain(t, "ISO-8859-1", "UTF-8"), []byte("a\xE9b"), []byte("aéb"))
+	checkTranscode(t, newTestChain(t, "UTF-8", "ISO-8859-1"), []byte("aéb"), []byte("a\xE9b"))
+	// first does not take a lead byte on its own before the end of input
+	checkTranscode(t, newTestChain(t, "Shift_JIS", "UTF-8"), []byte("a\x93\xFA\x96{b"), []byte("a日本b"))
+	checkTranscode(t, newTestChain(t, "UTF-8", "EUC-JP"), []byte("a日本b"), []byte("a\xC6\xFC\xCB\xDCb"))
+}
+
+func TestChainErrorOffsets(t *testing.T) {
+	// errors of second are reported where the character stems from, which
+	// is known exactly when the input comes a character at a time
+	checkTranscodeBy(t, newTestChain(t, "UTF-8", "ISO-8859-1"), 1, []byte("a€b"), []byte("a\x00b"), 1)
+	checkTranscodeBy(
+		t,
+		newTestChain(t, "UTF-8", "ISO-8859-1"),
+		1,
+		[]byte("€é日a😀"),
+		[]byte("\x00\xE9\x00a\x00"),
+		0,
+		5,
+		9,
+	)
+	checkTranscodeBy(t, newTestChain(t, "Shift_JIS", "ISO-8859-1"), 1, []byte("a\x93\xFAb"), []byte("a\x00b"), 1)
+	// and only as far as the chunk it came in otherwise
+	checkTranscodeBy(t, newTestChain(t, "UTF-8", "ISO-8859-1"), 4, []byte("a€b€"), []byte("a\x00b\x00"), 0, 4)
+	checkTranscodeBy(t, newTestChain(t, "UTF-8", "ISO-8859-1"), 64, []byte("a€b€"), []byte("a\x00b\x00"), 0, 0)
+	// errors of first are passed on as they are
+	checkTranscode(t, newTestChain(t, "UTF-8", "UTF-8"), []byte("a\xFFb"), []byte("a\xEF\xBF\xBDb"), 1)
+	checkTranscode(t, newTestChain(t, "Shift_JIS", "UTF-8"), []byte("a\x93"), []byte("a\xEF\xBF\xBD"), 1)
+	// the replacement first puts out after an error is not taken for the
+	// next character
+	checkTranscodeBy(t, newTestChain(t, "UTF-8", "ISO-8859-1"), 1, []byte("\xFF€a€"), []byte("\x00\x00a\x00"), 0, 1, 5)
+	checkTranscodeBy(t, newTestChain(t, "UTF-8", "ISO-8859-1"), 64, []byte("\xFF€a€"), []byte("\x00\x00a\x00"), 0, 1, 1)
+}
+
+func TestChainReset(t *testing.T) {
+	chain := newTestChain(t, "UTF-8", "ISO-8859-1")
+	chain.Transcode([]byte("日本"), make([]byte, 1), false)
+	chain.Reset(100)
+	out, offsets, err := transcodeAll(chain, []byte("a€"), 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "a\x00" || !equalSlices(offsets, []uint64 {101}) {
+		t.Errorf("got %X with errors at %v, want 6100 with errors at [101]", out, offsets)
+	}
+}
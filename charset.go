@@ -0,0 +1,218 @@
+package gotextenc
+
+import (
+	"fmt"
+	"sync"
+)
+
+// CharsetDirection flags the directions in which a Charset can transcode.
+type CharsetDirection uint8
+
+const (
+	// decoding to UTF-16
+	CHSETDIR_DECODE12 CharsetDirection = 1 << iota
+	// decoding to runes
+	CHSETDIR_DECODE14
+	// encoding from UTF-16
+	CHSETDIR_ENCODE21
+	// encoding from runes
+	CHSETDIR_ENCODE41
+	CHSETDIR_DECODE = CHSETDIR_DECODE12 | CHSETDIR_DECODE14
+	CHSETDIR_ENCODE = CHSETDIR_ENCODE21 | CHSETDIR_ENCODE41
+)
+
+// CharsetFactories holds the codec factories of a charset for
+// RegisterCharset. Any of them may be nil; a decoder (or encoder) missing
+// on one side of the Unicode pivot is made from the one on the other side.
+type CharsetFactories struct {
+	Decoder12 Factory12
+	Decoder14 Factory14
+	Encoder21 Factory21
+	Encoder41 Factory41
+}
+
+// Charset bundles the codecs of all directions of one encoding under one set
+// of names, so that they cannot disagree about what a name means.
+type Charset struct {
+	names []string
+	factories CharsetFactories
+}
+
+var charsets []*Charset
+var charsetNameMap map[string]*Charset
+var charsetsMutex sync.Mutex
+
+// charsetNameTaken tells in which registry category, if any, name is already
+// registered for a factory that is not nil. The locks of these categories
+// must be held.
+func charsetNameTaken(factories *CharsetFactories, name string) (category string) {
+	switch {
+		case factories.Decoder12 != nil && encoding12NameTaken(name):
+			category = "Encoding12"
+		case factories.Decoder14 != nil && encoding14NameTaken(name):
+			category = "Encoding14"
+		case factories.Encoder21 != nil && encoding21NameTaken(name):
+			category = "Encoding21"
+		case factories.Encoder41 != nil && encoding41NameTaken(name):
+			category = "Encoding41"
+	}
+	return
+}
+
+// lockCharsetCategories locks the registry categories a Charset spans, always
+// in the same order.
+func lockCharsetCategories() {
+	encodings12Mutex.Lock()
+	encodings14Mutex.Lock()
+	encodings21Mutex.Lock()
+	encodings41Mutex.Lock()
+}
+
+func unlockCharsetCategories() {
+	encodings41Mutex.Unlock()
+	encodings21Mutex.Unlock()
+	encodings14Mutex.Unlock()
+	encodings12Mutex.Unlock()
+}
+
+// RegisterCharset registers the factories under names, both in the registry
+// category of each and as a Charset. All names are checked and registered
+// under the locks of all categories at once, so a conflict leaves the
+// registry as it was, even with other registrations going on.
+func RegisterCharset(factories CharsetFactories, names ...string) (charset *Charset) {
+	charsetsMutex.Lock()
+	lockCharsetCategories()
+	for _, name := range names {
+		if charsetNameMap[NormalizeEncodingName(name)] != nil {
+			unlockCharsetCategories()
+			charsetsMutex.Unlock()
+			panic(fmt.Sprintf("Cannot register new Charset: Name '%s' is already registered", name))
+		}
+		if category := charsetNameTaken(&factories, name); category != "" {
+			unlockCharsetCategories()
+			charsetsMutex.Unlock()
+			panic(fmt.Sprintf("Cannot register new Charset: Name '%s' is already registered as %s", name, category))
+		}
+	}
+	if factories.Decoder12 != nil {
+		insertEncoding12(factories.Decoder12, names, false)
+	}
+	if factories.Decoder14 != nil {
+		insertEncoding14(factories.Decoder14, names, false)
+	}
+	if factories.Encoder21 != nil {
+		insertEncoding21(factories.Encoder21, names, false)
+	}
+	if factories.Encoder41 != nil {
+		insertEncoding41(factories.Encoder41, names, false)
+	}
+	unlockCharsetCategories()
+	charset = &Charset {
+		names: append([]string(nil), names...),
+		factories: factories,
+	}
+	if charsetNameMap == nil {
+		charsetNameMap = make(map[string]*Charset)
+	}
+	charsets = append(charsets, charset)
+	for _, name := range names {
+		charsetNameMap[NormalizeEncodingName(name)] = charset
+	}
+	charsetsMutex.Unlock()
+	if factories.Decoder12 != nil {
+		deriveFromEncoding12(factories.Decoder12, names)
+	}
+	if factories.Decoder14 != nil {
+		deriveFromEncoding14(factories.Decoder14, names)
+	}
+	if factories.Encoder21 != nil {
+		deriveFromEncoding21(factories.Encoder21, names)
+	}
+	if factories.Encoder41 != nil {
+		deriveFromEncoding41(factories.Encoder41, names)
+	}
+	return
+}
+
+// LookupCharset finds the Charset known as name, as per
+// NormalizeEncodingName, or returns nil.
+func LookupCharset(name string) (charset *Charset) {
+	charsetsMutex.Lock()
+	charset = charsetNameMap[NormalizeEncodingName(name)]
+	charsetsMutex.Unlock()
+	return
+}
+
+func(charset *Charset) Names() []string {
+	if charset == nil || len(charset.names) == 0 {
+		return nil
+	}
+	return append([]string(nil), charset.names...)
+}
+
+// Directions tells in which directions the charset can transcode, including
+// those made up by pivoting.
+func(charset *Charset) Directions() (directions CharsetDirection) {
+	if charset == nil {
+		return
+	}
+	if charset.factories.Decoder12 != nil || charset.factories.Decoder14 != nil {
+		directions |= CHSETDIR_DECODE
+	}
+	if charset.factories.Encoder21 != nil || charset.factories.Encoder41 != nil {
+		directions |= CHSETDIR_ENCODE
+	}
+	return
+}
+
+func(charset *Charset) NewDecoder12() Codec[byte, uint16] {
+	switch {
+		case charset == nil:
+			return nil
+		case charset.factories.Decoder12 != nil:
+			return charset.factories.Decoder12()
+		case charset.factories.Decoder14 != nil:
+			return Chain[byte, rune, uint16](charset.factories.Decoder14(), &UnitConverter[rune, uint16]{})
+		default:
+			return nil
+	}
+}
+
+func(charset *Charset) NewDecoder14() Codec[byte, rune] {
+	switch {
+		case charset == nil:
+			return nil
+		case charset.factories.Decoder14 != nil:
+			return charset.factories.Decoder14()
+		case charset.factories.Decoder12 != nil:
+			return Chain[byte, uint16, rune](charset.factories.Decoder12(), &UnitConverter[uint16, rune]{})
+		default:
+			return nil
+	}
+}
+
+func(charset *Charset) NewEncoder21() Codec[uint16, byte] {
+	switch {
+		case charset == nil:
+			return nil
+		case charset.factories.Encoder21 != nil:
+			return charset.factories.Encoder21()
+		case charset.factories.Encoder41 != nil:
+			return Chain[uint16, rune, byte](&UnitConverter[uint16, rune]{}, charset.factories.Encoder41())
+		default:
+			return nil
+	}
+}
+
+func(charset *Charset) NewEncoder41() Codec[rune, byte] {
+	switch {
+		case charset == nil:
+			return nil
+		case charset.factories.Encoder41 != nil:
+			return charset.factories.Encoder41()
+		case charset.factories.Encoder21 != nil:
+			return Chain[rune, uint16, byte](&UnitConverter[rune, uint16]{}, charset.factories.Encoder21())
+		default:
+			return nil
+	}
+}
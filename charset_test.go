@@ -0,0 +1,120 @@
+package gotextenc
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// registerPanics tells whether RegisterCharset panics on factories and names.
+func registerPanics(factories CharsetFactories, names ...string) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	RegisterCharset(factories, names...)
+	return
+}
+
+func TestCharset(t *testing.T) {
+	charset := LookupCharset("utf8")
+	if charset == nil || charset.Names()[0] != "UTF-8" {
+		t.Fatalf("no Charset for utf8")
+	}
+	if charset.Directions() != CHSETDIR_DECODE | CHSETDIR_ENCODE {
+		t.Errorf("UTF-8 has directions %b", charset.Directions())
+	}
+	checkTranscode(t, charset.NewDecoder14(), []byte("a😀"), []rune("a😀"))
+	checkTranscode(t, charset.NewDecoder12(), []byte("a😀"), []uint16 {'a', 0xD83D, 0xDE00})
+	checkTranscode(t, charset.NewEncoder41(), []rune("a😀"), []byte("a😀"))
+	checkTranscode(t, charset.NewEncoder21(), []uint16 {'a', 0xD83D, 0xDE00}, []byte("a😀"))
+}
+
+func TestCharsetPivot(t *testing.T) {
+	scopeRegistry(t)
+	charset := RegisterCharset(CharsetFactories {
+		Decoder14: func() Codec[byte, rune] {
+			return &UTF8Decoder[rune]{}
+		},
+	}, "x-test-charset-decode")
+	if LookupCharset("X_Test_Charset_Decode") != charset || charset.Directions() != CHSETDIR_DECODE {
+		t.Errorf("decoding Charset not registered")
+	}
+	checkTranscode(t, charset.NewDecoder12(), []byte("a😀\xFF"), []uint16 {'a', 0xD83D, 0xDE00, 0xFFFD}, 5)
+	if charset.NewEncoder21() != nil || charset.NewEncoder41() != nil {
+		t.Errorf("encoder made for a decoding Charset")
+	}
+	var none *Charset
+	if none.Directions() != 0 || none.Names() != nil || none.NewDecoder14() != nil || none.NewEncoder41() != nil {
+		t.Errorf("nil Charset is not empty")
+	}
+}
+
+func TestCharsetConflict(t *testing.T) {
+	scopeRegistry(t)
+	decoder := CharsetFactories {
+		Decoder14: func() Codec[byte, rune] {
+			return &UTF8Decoder[rune]{}
+		},
+	}
+	encoder := CharsetFactories {
+		Encoder41: func() Codec[rune, byte] {
+			return &UTF8Encoder[rune]{}
+		},
+	}
+	if !registerPanics(decoder, "x-test-charset-fresh", "utf_8") {
+		t.Errorf("Charset name registered twice")
+	}
+	// the fresh name was not registered either
+	if LookupCharset("x-test-charset-fresh") != nil || LookupEncoding14("x-test-charset-fresh") != NO_ENCODING14 {
+		t.Errorf("conflicting Charset partially registered")
+	}
+	RegisterEncoding41(encoder.Encoder41, "x-test-charset-encoder")
+	if !registerPanics(encoder, "x-test-charset-fresh", "x-test-charset-encoder") {
+		t.Errorf("Charset registered over an Encoding41")
+	}
+	if LookupCharset("x-test-charset-fresh") != nil || LookupEncoding41("x-test-charset-fresh") != NO_ENCODING41 {
+		t.Errorf("conflicting Charset partially registered")
+	}
+	// but a Charset that does not encode may have the name
+	if registerPanics(decoder, "x-test-charset-fresh", "x-test-charset-encoder") {
+		t.Errorf("Charset name in another category taken as conflict")
+	}
+}
+
+func TestCharsetRace(t *testing.T) {
+	scopeRegistry(t)
+	factories := CharsetFactories {
+		Decoder14: func() Codec[byte, rune] {
+			return &UTF8Decoder[rune]{}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &UTF8Encoder[rune]{}
+		},
+	}
+	for round := 0; round < 50; round++ {
+		fresh := fmt.Sprintf("x-test-charset-race-fresh-%d", round)
+		contested := fmt.Sprintf("x-test-charset-race-%d", round)
+		var group sync.WaitGroup
+		var charsetPanicked bool
+		group.Add(2)
+		go func() {
+			defer group.Done()
+			charsetPanicked = registerPanics(factories, fresh, contested)
+		}()
+		go func() {
+			defer group.Done()
+			defer func() {
+				recover()
+			}()
+			RegisterEncoding41(factories.Encoder41, contested)
+		}()
+		group.Wait()
+		// whichever lost, the Charset is there in full or not at all
+		registered := LookupCharset(fresh) != nil
+		if registered == charsetPanicked ||
+				(LookupEncoding14(fresh) != NO_ENCODING14) != registered ||
+				(LookupEncoding41(fresh) != NO_ENCODING41) != registered {
+			t.Fatalf("round %d: Charset partially registered", round)
+		}
+	}
+}
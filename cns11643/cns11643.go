@@ -0,0 +1,6013 @@
+// Package cns11643 provides the planes of CNS 11643 and ISO-IR-165 as sets for
+// gotextenc.ISO2022Decoder and gotextenc.ISO2022Encoder, and registers
+// ISO-2022-CN, ISO-2022-CN-EXT and EUC-TW with gotextenc when imported. The
+// tables are kept out of gotextenc itself so that binaries not needing them
+// don't carry them; the encoding maps are only built on first use.
+package cns11643
+
+import (
+	"sync"
+
+	"github.com/UncleSniper/gotextenc"
+)
+
+// PLANE_COUNT is the number of CNS 11643 planes provided.
+const PLANE_COUNT = 7
+
+// decodePlane maps index, (row - 1) * 94 + cell - 1, in plane (from 1) to a
+// character; zero means unmapped.
+func decodePlane(plane int, index int) rune {
+	switch plane {
+		case 1:
+			return rune(plane1[index])
+		case 2:
+			return rune(plane2[index])
+		default:
+			return rune(upperPlanes[(plane - 3) * 94 * 94 + index])
+	}
+}
+
+var planeEncodeOnces [PLANE_COUNT]sync.Once
+var planeEncodeMaps [PLANE_COUNT]map[rune]uint16
+
+// planeEncodeMap maps characters to their codes in plane, in GL form.
+func planeEncodeMap(plane int) map[rune]uint16 {
+	planeEncodeOnces[plane - 1].Do(func() {
+		encodeMap := make(map[rune]uint16)
+		for index := 0; index < 94 * 94; index++ {
+			char := decodePlane(plane, index)
+			if _, present := encodeMap[char]; char != 0 && !present {
+				encodeMap[char] = uint16(0x21 + index / 94) << 8 | uint16(0x21 + index % 94)
+			}
+		}
+		planeEncodeMaps[plane - 1] = encodeMap
+	})
+	return planeEncodeMaps[plane - 1]
+}
+
+// decodePlaneCode maps a code in GL form in plane to a character.
+func decodePlaneCode(plane int, code uint32) rune {
+	row, cell := int(code >> 8 & 0xFF) - 0x21, int(code & 0xFF) - 0x21
+	if row < 0 || row >= 94 || cell < 0 || cell >= 94 {
+		return 0
+	}
+	return decodePlane(plane, row * 94 + cell)
+}
+
+func newPlaneCharset(plane int) *gotextenc.ISO2022Charset {
+	return &gotextenc.ISO2022Charset {
+		Kind: gotextenc.ISO2022SET_94N,
+		Width: 2,
+		Final: 'G' + byte(plane - 1),
+		Decode: func(code uint32) rune {
+			return decodePlaneCode(plane, code)
+		},
+		Encode: func(r rune) (uint32, bool) {
+			code, found := planeEncodeMap(plane)[r]
+			return uint32(code), found
+		},
+	}
+}
+
+// ISO2022Planes holds the planes 1 to PLANE_COUNT, designated by ESC $ ) G
+// (and the like) to ESC $ + M.
+var ISO2022Planes = [PLANE_COUNT]*gotextenc.ISO2022Charset {
+	newPlaneCharset(1),
+	newPlaneCharset(2),
+	newPlaneCharset(3),
+	newPlaneCharset(4),
+	newPlaneCharset(5),
+	newPlaneCharset(6),
+	newPlaneCharset(7),
+}
+
+var plane1 = [94 * 94]uint16 {
+	// row 1
+	0x3000, 0xFF0C, 0x3001, 0x3002, 0xFF0E, 0x30FB, 0xFF1B, 0xFF1A, 0xFF1F, 0xFF01, 0xFE30, 0x2026,
+	0x2025, 0xFE50, 0xFE51, 0xFE52, 0x00B7, 0xFE54, 0xFE55, 0xFE56, 0xFE57, 0xFE31, 0x2014, 0xFE32,
+	0x2013, 0x0000, 0x0000, 0x0000, 0x0000, 0xFF08, 0xFF09, 0xFE35, 0xFE36, 0xFF5B, 0xFF5D, 0xFE37,
+	0xFE38, 0x3014, 0x3015, 0xFE39, 0xFE3A, 0x3010, 0x3011, 0xFE3B, 0xFE3C, 0x300A, 0x300B, 0xFE3D,
+	0xFE3E, 0x3008, 0x3009, 0xFE3F, 0xFE40, 0x300C, 0x300D, 0xFE41, 0xFE42, 0x300E, 0x300F, 0xFE43,
+	0xFE44, 0xFE59, 0xFE5A, 0xFE5B, 0xFE5C, 0xFE5D, 0xFE5E, 0x2018, 0x2019, 0x201C, 0x201D, 0x301D,
+	0x301E, 0x2032, 0x2035, 0xFF03, 0xFF06, 0xFF0A, 0x203B, 0x00A7, 0x3003, 0x25CB, 0x25CF, 0x25B3,
+	0x25B2, 0x25CE, 0x2606, 0x2605, 0x25C7, 0x25C6, 0x25A1, 0x25A0, 0x25BD, 0x25BC,
+	// row 2
+	0x32A3, 0x2105, 0x203E, 0x0000, 0xFF3F, 0x0000, 0xFE49, 0xFE4A, 0xFE4D, 0xFE4E, 0xFE4B, 0xFE4C,
+	0xFE5F, 0xFE60, 0xFE61, 0xFF0B, 0xFF0D, 0x00D7, 0x00F7, 0x00B1, 0x221A, 0xFF1C, 0xFF1E, 0xFF1D,
+	0x2266, 0x2267, 0x2260, 0x221E, 0x2252, 0x2261, 0xFE62, 0xFE63, 0xFE64, 0xFE66, 0xFE65, 0x223C,
+	0x2229, 0x222A, 0x22A5, 0x2220, 0x221F, 0x22BF, 0x33D2, 0x33D1, 0x222B, 0x222E, 0x2235, 0x2234,
+	0x2640, 0x2642, 0x2641, 0x2609, 0x2191, 0x2193, 0x2192, 0x2190, 0x2196, 0x2197, 0x2199, 0x2198,
+	0x2016, 0xFF5C, 0xFF0F, 0xFF3C, 0x2215, 0xFE68, 0xFF04, 0xFFE5, 0x3012, 0xFFE0, 0xFFE1, 0xFF05,
+	0xFF20, 0x2103, 0x2109, 0xFE69, 0xFE6A, 0xFE6B, 0x33D5, 0x339C, 0x339D, 0x339E, 0x33CE, 0x33A1,
+	0x338E, 0x338F, 0x33C4, 0x00B0, 0x5159, 0x515B, 0x515E, 0x515D, 0x5161, 0x5163,
+	// row 3
+	0x55E7, 0x74E9, 0x7CCE, 0x2581, 0x2582, 0x2583, 0x2584, 0x2585, 0x2586, 0x2587, 0x2588, 0x258F,
+	0x258E, 0x258D, 0x258C, 0x258B, 0x258A, 0x2589, 0x253C, 0x2534, 0x252C, 0x2524, 0x251C, 0x2594,
+	0x2500, 0x2502, 0x2595, 0x250C, 0x2510, 0x2514, 0x2518, 0x256D, 0x256E, 0x2570, 0x256F, 0x2550,
+	0x255E, 0x256A, 0x2561, 0x25E2, 0x25E3, 0x25E5, 0x25E4, 0x2571, 0x2572, 0x2573, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 4
+	0xFF10, 0xFF11, 0xFF12, 0xFF13, 0xFF14, 0xFF15, 0xFF16, 0xFF17, 0xFF18, 0xFF19, 0x2160, 0x2161,
+	0x2162, 0x2163, 0x2164, 0x2165, 0x2166, 0x2167, 0x2168, 0x2169, 0x3021, 0x3022, 0x3023, 0x3024,
+	0x3025, 0x3026, 0x3027, 0x3028, 0x3029, 0x0000, 0x5344, 0x0000, 0xFF21, 0xFF22, 0xFF23, 0xFF24,
+	0xFF25, 0xFF26, 0xFF27, 0xFF28, 0xFF29, 0xFF2A, 0xFF2B, 0xFF2C, 0xFF2D, 0xFF2E, 0xFF2F, 0xFF30,
+	0xFF31, 0xFF32, 0xFF33, 0xFF34, 0xFF35, 0xFF36, 0xFF37, 0xFF38, 0xFF39, 0xFF3A, 0xFF41, 0xFF42,
+	0xFF43, 0xFF44, 0xFF45, 0xFF46, 0xFF47, 0xFF48, 0xFF49, 0xFF4A, 0xFF4B, 0xFF4C, 0xFF4D, 0xFF4E,
+	0xFF4F, 0xFF50, 0xFF51, 0xFF52, 0xFF53, 0xFF54, 0xFF55, 0xFF56, 0xFF57, 0xFF58, 0xFF59, 0xFF5A,
+	0x0391, 0x0392, 0x0393, 0x0394, 0x0395, 0x0396, 0x0397, 0x0398, 0x0399, 0x039A,
+	// row 5
+	0x039B, 0x039C, 0x039D, 0x039E, 0x039F, 0x03A0, 0x03A1, 0x03A3, 0x03A4, 0x03A5, 0x03A6, 0x03A7,
+	0x03A8, 0x03A9, 0x03B1, 0x03B2, 0x03B3, 0x03B4, 0x03B5, 0x03B6, 0x03B7, 0x03B8, 0x03B9, 0x03BA,
+	0x03BB, 0x03BC, 0x03BD, 0x03BE, 0x03BF, 0x03C0, 0x03C1, 0x03C3, 0x03C4, 0x03C5, 0x03C6, 0x03C7,
+	0x03C8, 0x03C9, 0x3105, 0x3106, 0x3107, 0x3108, 0x3109, 0x310A, 0x310B, 0x310C, 0x310D, 0x310E,
+	0x310F, 0x3110, 0x3111, 0x3112, 0x3113, 0x3114, 0x3115, 0x3116, 0x3117, 0x3118, 0x3119, 0x311A,
+	0x311B, 0x311C, 0x311D, 0x311E, 0x311F, 0x3120, 0x3121, 0x3122, 0x3123, 0x3124, 0x3125, 0x3126,
+	0x3127, 0x3128, 0x3129, 0x02D9, 0x02C9, 0x02CA, 0x02C7, 0x02CB, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 6
+	0x2460, 0x2461, 0x2462, 0x2463, 0x2464, 0x2465, 0x2466, 0x2467, 0x2468, 0x2469, 0x2474, 0x2475,
+	0x2476, 0x2477, 0x2478, 0x2479, 0x247A, 0x247B, 0x247C, 0x247D, 0x2170, 0x2171, 0x2172, 0x2173,
+	0x2174, 0x2175, 0x2176, 0x2177, 0x2178, 0x2179, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 7
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x4EA0, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x51AB, 0x0000, 0x0000, 0x0000, 0x0000, 0x52F9, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 8
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 9
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 10
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 11
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 12
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 13
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 14
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 15
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 16
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 17
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 18
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 19
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 20
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 21
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 22
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 23
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 24
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 25
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 26
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 27
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 28
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 29
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 30
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 31
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 32
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 33
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 34
+	0x2400, 0x2401, 0x2402, 0x2403, 0x2404, 0x2405, 0x2406, 0x2407, 0x2408, 0x2409, 0x240A, 0x240B,
+	0x240C, 0x240D, 0x240E, 0x240F, 0x2410, 0x2411, 0x2412, 0x2413, 0x2414, 0x2415, 0x2416, 0x2417,
+	0x2418, 0x2419, 0x241A, 0x241B, 0x241C, 0x241D, 0x241E, 0x241F, 0x2421, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 35
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 36
+	0x4E00, 0x4E59, 0x4E01, 0x4E03, 0x4E43, 0x4E5D, 0x4E86, 0x4E8C, 0x4EBA, 0x513F, 0x5165, 0x516B,
+	0x51E0, 0x5200, 0x5201, 0x529B, 0x5315, 0x5341, 0x535C, 0x53C8, 0x4E09, 0x4E0B, 0x4E08, 0x4E0A,
+	0x4E2B, 0x4E38, 0x51E1, 0x4E45, 0x4E48, 0x4E5F, 0x4E5E, 0x4E8E, 0x4EA1, 0x5140, 0x5203, 0x52FA,
+	0x5343, 0x53C9, 0x53E3, 0x571F, 0x58EB, 0x5915, 0x5927, 0x5973, 0x5B50, 0x5B51, 0x5B53, 0x5BF8,
+	0x5C0F, 0x5C22, 0x5C38, 0x5C71, 0x5DDD, 0x5DE5, 0x5DF1, 0x5DF2, 0x5DF3, 0x5DFE, 0x5E72, 0x5EFE,
+	0x5F0B, 0x5F13, 0x624D, 0x4E11, 0x4E10, 0x4E0D, 0x4E2D, 0x4E30, 0x4E39, 0x4E4B, 0x5C39, 0x4E88,
+	0x4E91, 0x4E95, 0x4E92, 0x4E94, 0x4EA2, 0x4EC1, 0x4EC0, 0x4EC3, 0x4EC6, 0x4EC7, 0x4ECD, 0x4ECA,
+	0x4ECB, 0x4EC4, 0x5143, 0x5141, 0x5167, 0x516D, 0x516E, 0x516C, 0x5197, 0x51F6,
+	// row 37
+	0x5206, 0x5207, 0x5208, 0x52FB, 0x52FE, 0x52FF, 0x5316, 0x5339, 0x5348, 0x5347, 0x5345, 0x535E,
+	0x5384, 0x53CB, 0x53CA, 0x53CD, 0x58EC, 0x5929, 0x592B, 0x592A, 0x592D, 0x5B54, 0x5C11, 0x5C24,
+	0x5C3A, 0x5C6F, 0x5DF4, 0x5E7B, 0x5EFF, 0x5F14, 0x5F15, 0x5FC3, 0x6208, 0x6236, 0x624B, 0x624E,
+	0x652F, 0x6587, 0x6597, 0x65A4, 0x65B9, 0x65E5, 0x66F0, 0x6708, 0x6728, 0x6B20, 0x6B62, 0x6B79,
+	0x6BCB, 0x6BD4, 0x6BDB, 0x6C0F, 0x6C34, 0x706B, 0x722A, 0x7236, 0x723B, 0x7247, 0x7259, 0x725B,
+	0x72AC, 0x738B, 0x4E19, 0x4E16, 0x4E15, 0x4E14, 0x4E18, 0x4E3B, 0x4E4D, 0x4E4F, 0x4E4E, 0x4EE5,
+	0x4ED8, 0x4ED4, 0x4ED5, 0x4ED6, 0x4ED7, 0x4EE3, 0x4EE4, 0x4ED9, 0x4EDE, 0x5145, 0x5144, 0x5189,
+	0x518A, 0x51AC, 0x51F9, 0x51FA, 0x51F8, 0x520A, 0x52A0, 0x529F, 0x5305, 0x5306,
+	// row 38
+	0x5317, 0x531D, 0x4EDF, 0x534A, 0x5349, 0x5361, 0x5360, 0x536F, 0x536E, 0x53BB, 0x53EF, 0x53E4,
+	0x53F3, 0x53EC, 0x53EE, 0x53E9, 0x53E8, 0x53FC, 0x53F8, 0x53F5, 0x53EB, 0x53E6, 0x53EA, 0x53F2,
+	0x53F1, 0x53F0, 0x53E5, 0x53ED, 0x53FB, 0x56DB, 0x56DA, 0x5916, 0x592E, 0x5931, 0x5974, 0x5976,
+	0x5B55, 0x5B83, 0x5C3C, 0x5DE8, 0x5DE7, 0x5DE6, 0x5E02, 0x5E03, 0x5E73, 0x5E7C, 0x5F01, 0x5F18,
+	0x5F17, 0x5FC5, 0x620A, 0x6253, 0x6254, 0x6252, 0x6251, 0x65A5, 0x65E6, 0x672E, 0x672C, 0x672A,
+	0x672B, 0x672D, 0x6B63, 0x6BCD, 0x6C11, 0x6C10, 0x6C38, 0x6C41, 0x6C40, 0x6C3E, 0x72AF, 0x7384,
+	0x7389, 0x74DC, 0x74E6, 0x7518, 0x751F, 0x7528, 0x7529, 0x7530, 0x7531, 0x7532, 0x7533, 0x758B,
+	0x767D, 0x76AE, 0x76BF, 0x76EE, 0x77DB, 0x77E2, 0x77F3, 0x793A, 0x79BE, 0x7A74,
+	// row 39
+	0x7ACB, 0x4E1E, 0x4E1F, 0x4E52, 0x4E53, 0x4E69, 0x4E99, 0x4EA4, 0x4EA6, 0x4EA5, 0x4EFF, 0x4F09,
+	0x4F19, 0x4F0A, 0x4F15, 0x4F0D, 0x4F10, 0x4F11, 0x4F0F, 0x4EF2, 0x4EF6, 0x4EFB, 0x4EF0, 0x4EF3,
+	0x4EFD, 0x4F01, 0x4F0B, 0x5149, 0x5147, 0x5146, 0x5148, 0x5168, 0x5171, 0x518D, 0x51B0, 0x5217,
+	0x5211, 0x5212, 0x520E, 0x5216, 0x52A3, 0x5308, 0x5321, 0x5320, 0x5370, 0x5371, 0x5409, 0x540F,
+	0x540C, 0x540A, 0x5410, 0x5401, 0x540B, 0x5404, 0x5411, 0x540D, 0x5408, 0x5403, 0x540E, 0x5406,
+	0x5412, 0x56E0, 0x56DE, 0x56DD, 0x5733, 0x5730, 0x5728, 0x572D, 0x572C, 0x572F, 0x5729, 0x5919,
+	0x591A, 0x5937, 0x5938, 0x5984, 0x5978, 0x5983, 0x597D, 0x5979, 0x5982, 0x5981, 0x5B57, 0x5B58,
+	0x5B87, 0x5B88, 0x5B85, 0x5B89, 0x5BFA, 0x5C16, 0x5C79, 0x5DDE, 0x5E06, 0x5E76,
+	// row 40
+	0x5E74, 0x5F0F, 0x5F1B, 0x5FD9, 0x5FD6, 0x620E, 0x620C, 0x620D, 0x6210, 0x6263, 0x625B, 0x6258,
+	0x6536, 0x65E9, 0x65E8, 0x65EC, 0x65ED, 0x66F2, 0x66F3, 0x6709, 0x673D, 0x6734, 0x6731, 0x6735,
+	0x6B21, 0x6B64, 0x6B7B, 0x6C16, 0x6C5D, 0x6C57, 0x6C59, 0x6C5F, 0x6C60, 0x6C50, 0x6C55, 0x6C61,
+	0x6C5B, 0x6C4D, 0x6C4E, 0x7070, 0x725F, 0x725D, 0x767E, 0x7AF9, 0x7C73, 0x7CF8, 0x7F36, 0x7F8A,
+	0x7FBD, 0x8001, 0x8003, 0x800C, 0x8012, 0x8033, 0x807F, 0x8089, 0x808B, 0x808C, 0x81E3, 0x81EA,
+	0x81F3, 0x81FC, 0x820C, 0x821B, 0x821F, 0x826E, 0x8272, 0x827E, 0x866B, 0x8840, 0x884C, 0x8863,
+	0x897F, 0x9621, 0x4E32, 0x4EA8, 0x4F4D, 0x4F4F, 0x4F47, 0x4F57, 0x4F5E, 0x4F34, 0x4F5B, 0x4F55,
+	0x4F30, 0x4F50, 0x4F51, 0x4F3D, 0x4F3A, 0x4F38, 0x4F43, 0x4F54, 0x4F3C, 0x4F46,
+	// row 41
+	0x4F63, 0x4F5C, 0x4F60, 0x4F2F, 0x4F4E, 0x4F36, 0x4F59, 0x4F5D, 0x4F48, 0x4F5A, 0x514C, 0x514B,
+	0x514D, 0x5175, 0x51B6, 0x51B7, 0x5225, 0x5224, 0x5229, 0x522A, 0x5228, 0x52AB, 0x52A9, 0x52AA,
+	0x52AC, 0x5323, 0x5373, 0x5375, 0x541D, 0x542D, 0x541E, 0x543E, 0x5426, 0x544E, 0x5427, 0x5446,
+	0x5443, 0x5433, 0x5448, 0x5442, 0x541B, 0x5429, 0x544A, 0x5439, 0x543B, 0x5438, 0x542E, 0x5435,
+	0x5436, 0x5420, 0x543C, 0x5440, 0x5431, 0x542B, 0x541F, 0x542C, 0x56EA, 0x56F0, 0x56E4, 0x56EB,
+	0x574A, 0x5751, 0x5740, 0x574D, 0x5747, 0x574E, 0x573E, 0x5750, 0x574F, 0x573B, 0x58EF, 0x593E,
+	0x599D, 0x5992, 0x59A8, 0x599E, 0x59A3, 0x5999, 0x5996, 0x598D, 0x59A4, 0x5993, 0x598A, 0x59A5,
+	0x5B5D, 0x5B5C, 0x5B5A, 0x5B5B, 0x5B8C, 0x5B8B, 0x5B8F, 0x5C2C, 0x5C40, 0x5C41,
+	// row 42
+	0x5C3F, 0x5C3E, 0x5C90, 0x5C91, 0x5C94, 0x5C8C, 0x5DEB, 0x5E0C, 0x5E8F, 0x5E87, 0x5E8A, 0x5EF7,
+	0x5F04, 0x5F1F, 0x5F64, 0x5F62, 0x5F77, 0x5F79, 0x5FD8, 0x5FCC, 0x5FD7, 0x5FCD, 0x5FF1, 0x5FEB,
+	0x5FF8, 0x5FEA, 0x6212, 0x6211, 0x6284, 0x6297, 0x6296, 0x6280, 0x6276, 0x6289, 0x626D, 0x628A,
+	0x627C, 0x627E, 0x6279, 0x6273, 0x6292, 0x626F, 0x6298, 0x626E, 0x6295, 0x6293, 0x6291, 0x6286,
+	0x6539, 0x653B, 0x6538, 0x65F1, 0x66F4, 0x675F, 0x674E, 0x674F, 0x6750, 0x6751, 0x675C, 0x6756,
+	0x675E, 0x6749, 0x6746, 0x6760, 0x6753, 0x6757, 0x6B65, 0x6BCF, 0x6C42, 0x6C5E, 0x6C99, 0x6C81,
+	0x6C88, 0x6C89, 0x6C85, 0x6C9B, 0x6C6A, 0x6C7A, 0x6C90, 0x6C70, 0x6C8C, 0x6C68, 0x6C96, 0x6C92,
+	0x6C7D, 0x6C83, 0x6C72, 0x6C7E, 0x6C74, 0x6C86, 0x6C76, 0x6C8D, 0x6C94, 0x6C98,
+	// row 43
+	0x6C82, 0x7076, 0x707C, 0x707D, 0x7078, 0x7262, 0x7261, 0x7260, 0x72C4, 0x72C2, 0x7396, 0x752C,
+	0x752B, 0x7537, 0x7538, 0x7682, 0x76EF, 0x77E3, 0x79C1, 0x79C0, 0x79BF, 0x7A76, 0x7CFB, 0x7F55,
+	0x8096, 0x8093, 0x809D, 0x8098, 0x809B, 0x809A, 0x80B2, 0x826F, 0x8292, 0x828B, 0x828D, 0x898B,
+	0x89D2, 0x8A00, 0x8C37, 0x8C46, 0x8C55, 0x8C9D, 0x8D64, 0x8D70, 0x8DB3, 0x8EAB, 0x8ECA, 0x8F9B,
+	0x8FB0, 0x8FC2, 0x8FC6, 0x8FC5, 0x8FC4, 0x5DE1, 0x9091, 0x90A2, 0x90AA, 0x90A6, 0x90A3, 0x9149,
+	0x91C6, 0x91CC, 0x9632, 0x962E, 0x9631, 0x962A, 0x962C, 0x4E26, 0x4E56, 0x4E73, 0x4E8B, 0x4E9B,
+	0x4E9E, 0x4EAB, 0x4EAC, 0x4F6F, 0x4F9D, 0x4F8D, 0x4F73, 0x4F7F, 0x4F6C, 0x4F9B, 0x4F8B, 0x4F86,
+	0x4F83, 0x4F70, 0x4F75, 0x4F88, 0x4F69, 0x4F7B, 0x4F96, 0x4F7E, 0x4F8F, 0x4F91,
+	// row 44
+	0x4F7A, 0x5154, 0x5152, 0x5155, 0x5169, 0x5177, 0x5176, 0x5178, 0x51BD, 0x51FD, 0x523B, 0x5238,
+	0x5237, 0x523A, 0x5230, 0x522E, 0x5236, 0x5241, 0x52BE, 0x52BB, 0x5352, 0x5354, 0x5353, 0x5351,
+	0x5366, 0x5377, 0x5378, 0x5379, 0x53D6, 0x53D4, 0x53D7, 0x5473, 0x5475, 0x5496, 0x5478, 0x5495,
+	0x5480, 0x547B, 0x5477, 0x5484, 0x5492, 0x5486, 0x547C, 0x5490, 0x5471, 0x5476, 0x548C, 0x549A,
+	0x5462, 0x5468, 0x548B, 0x547D, 0x548E, 0x56FA, 0x5783, 0x5777, 0x576A, 0x5769, 0x5761, 0x5766,
+	0x5764, 0x577C, 0x591C, 0x5949, 0x5947, 0x5948, 0x5944, 0x5954, 0x59BE, 0x59BB, 0x59D4, 0x59B9,
+	0x59AE, 0x59D1, 0x59C6, 0x59D0, 0x59CD, 0x59CB, 0x59D3, 0x59CA, 0x59AF, 0x59B3, 0x59D2, 0x59C5,
+	0x5B5F, 0x5B64, 0x5B63, 0x5B97, 0x5B9A, 0x5B98, 0x5B9C, 0x5B99, 0x5B9B, 0x5C1A,
+	// row 45
+	0x5C48, 0x5C45, 0x5C46, 0x5CB7, 0x5CA1, 0x5CB8, 0x5CA9, 0x5CAB, 0x5CB1, 0x5CB3, 0x5E18, 0x5E1A,
+	0x5E16, 0x5E15, 0x5E1B, 0x5E11, 0x5E78, 0x5E9A, 0x5E97, 0x5E9C, 0x5E95, 0x5E96, 0x5EF6, 0x5F26,
+	0x5F27, 0x5F29, 0x5F80, 0x5F81, 0x5F7F, 0x5F7C, 0x5FDD, 0x5FE0, 0x5FFD, 0x5FF5, 0x5FFF, 0x600F,
+	0x6014, 0x602F, 0x6035, 0x6016, 0x602A, 0x6015, 0x6021, 0x6027, 0x6029, 0x602B, 0x601B, 0x6216,
+	0x6215, 0x623F, 0x623E, 0x6240, 0x627F, 0x62C9, 0x62CC, 0x62C4, 0x62BF, 0x62C2, 0x62B9, 0x62D2,
+	0x62DB, 0x62AB, 0x62D3, 0x62D4, 0x62CB, 0x62C8, 0x62A8, 0x62BD, 0x62BC, 0x62D0, 0x62D9, 0x62C7,
+	0x62CD, 0x62B5, 0x62DA, 0x62B1, 0x62D8, 0x62D6, 0x62D7, 0x62C6, 0x62AC, 0x62CE, 0x653E, 0x65A7,
+	0x65BC, 0x65FA, 0x6614, 0x6613, 0x660C, 0x6606, 0x6602, 0x660E, 0x6600, 0x660F,
+	// row 46
+	0x6615, 0x660A, 0x6607, 0x670D, 0x670B, 0x676D, 0x678B, 0x6795, 0x6771, 0x679C, 0x6773, 0x6777,
+	0x6787, 0x679D, 0x6797, 0x676F, 0x6770, 0x677F, 0x6789, 0x677E, 0x6790, 0x6775, 0x679A, 0x6793,
+	0x677C, 0x676A, 0x6772, 0x6B23, 0x6B66, 0x6B67, 0x6B7F, 0x6C13, 0x6C1B, 0x6CE3, 0x6CE8, 0x6CF3,
+	0x6CB1, 0x6CCC, 0x6CE5, 0x6CB3, 0x6CBD, 0x6CBE, 0x6CBC, 0x6CE2, 0x6CAB, 0x6CD5, 0x6CD3, 0x6CB8,
+	0x6CC4, 0x6CB9, 0x6CC1, 0x6CAE, 0x6CD7, 0x6CC5, 0x6CF1, 0x6CBF, 0x6CBB, 0x6CE1, 0x6CDB, 0x6CCA,
+	0x6CAC, 0x6CEF, 0x6CDC, 0x6CD6, 0x6CE0, 0x7095, 0x708E, 0x7092, 0x708A, 0x7099, 0x722C, 0x722D,
+	0x7238, 0x7248, 0x7267, 0x7269, 0x72C0, 0x72CE, 0x72D9, 0x72D7, 0x72D0, 0x73A9, 0x73A8, 0x739F,
+	0x73AB, 0x73A5, 0x753D, 0x759D, 0x7599, 0x759A, 0x7684, 0x76C2, 0x76F2, 0x76F4,
+	// row 47
+	0x77E5, 0x77FD, 0x793E, 0x7940, 0x7941, 0x79C9, 0x79C8, 0x7A7A, 0x7A79, 0x7AFA, 0x7CFE, 0x7F54,
+	0x7F8C, 0x7F8B, 0x8005, 0x80BA, 0x80A5, 0x80A2, 0x80B1, 0x80A1, 0x80AB, 0x80A9, 0x80B4, 0x80AA,
+	0x80AF, 0x81E5, 0x81FE, 0x820D, 0x82B3, 0x829D, 0x8299, 0x82AD, 0x82BD, 0x829F, 0x82B9, 0x82B1,
+	0x82AC, 0x82A5, 0x82AF, 0x82B8, 0x82A3, 0x82B0, 0x82BE, 0x82B7, 0x864E, 0x8671, 0x521D, 0x8868,
+	0x8ECB, 0x8FCE, 0x8FD4, 0x8FD1, 0x90B5, 0x90B8, 0x90B1, 0x90B6, 0x91C7, 0x91D1, 0x9577, 0x9580,
+	0x961C, 0x9640, 0x963F, 0x963B, 0x9644, 0x9642, 0x96B9, 0x96E8, 0x9752, 0x975E, 0x4E9F, 0x4EAD,
+	0x4EAE, 0x4FE1, 0x4FB5, 0x4FAF, 0x4FBF, 0x4FE0, 0x4FD1, 0x4FCF, 0x4FDD, 0x4FC3, 0x4FB6, 0x4FD8,
+	0x4FDF, 0x4FCA, 0x4FD7, 0x4FAE, 0x4FD0, 0x4FC4, 0x4FC2, 0x4FDA, 0x4FCE, 0x4FDE,
+	// row 48
+	0x4FB7, 0x5157, 0x5192, 0x5191, 0x51A0, 0x524E, 0x5243, 0x524A, 0x524D, 0x524C, 0x524B, 0x5247,
+	0x52C7, 0x52C9, 0x52C3, 0x52C1, 0x530D, 0x5357, 0x537B, 0x539A, 0x53DB, 0x54AC, 0x54C0, 0x54A8,
+	0x54CE, 0x54C9, 0x54B8, 0x54A6, 0x54B3, 0x54C7, 0x54C2, 0x54BD, 0x54AA, 0x54C1, 0x54C4, 0x54C8,
+	0x54AF, 0x54AB, 0x54B1, 0x54BB, 0x54A9, 0x54A7, 0x54BF, 0x56FF, 0x5782, 0x578B, 0x57A0, 0x57A3,
+	0x57A2, 0x57CE, 0x57AE, 0x5793, 0x5955, 0x5951, 0x594F, 0x594E, 0x5950, 0x59DC, 0x59D8, 0x59FF,
+	0x59E3, 0x59E8, 0x5A03, 0x59E5, 0x59EA, 0x59DA, 0x59E6, 0x5A01, 0x59FB, 0x5B69, 0x5BA3, 0x5BA6,
+	0x5BA4, 0x5BA2, 0x5BA5, 0x5C01, 0x5C4E, 0x5C4F, 0x5C4D, 0x5C4B, 0x5CD9, 0x5CD2, 0x5DF7, 0x5E1D,
+	0x5E25, 0x5E1F, 0x5E7D, 0x5EA0, 0x5EA6, 0x5EFA, 0x5F08, 0x5F2D, 0x5F65, 0x5F88,
+	// row 49
+	0x5F85, 0x5F8A, 0x5F8B, 0x5F87, 0x5F8C, 0x5F89, 0x6012, 0x601D, 0x6020, 0x6025, 0x600E, 0x6028,
+	0x604D, 0x6070, 0x6068, 0x6062, 0x6046, 0x6043, 0x606C, 0x606B, 0x606A, 0x6064, 0x6241, 0x62DC,
+	0x6316, 0x6309, 0x62FC, 0x62ED, 0x6301, 0x62EE, 0x62FD, 0x6307, 0x62F1, 0x62F7, 0x62EF, 0x62EC,
+	0x62FE, 0x62F4, 0x6311, 0x6302, 0x653F, 0x6545, 0x65AB, 0x65BD, 0x65E2, 0x6625, 0x662D, 0x6620,
+	0x6627, 0x662F, 0x661F, 0x6628, 0x6631, 0x6624, 0x66F7, 0x67FF, 0x67D3, 0x67F1, 0x67D4, 0x67D0,
+	0x67EC, 0x67B6, 0x67AF, 0x67F5, 0x67E9, 0x67EF, 0x67C4, 0x67D1, 0x67B4, 0x67DA, 0x67E5, 0x67B8,
+	0x67CF, 0x67DE, 0x67F3, 0x67B0, 0x67D9, 0x67E2, 0x67DD, 0x67D2, 0x6B6A, 0x6B83, 0x6B86, 0x6BB5,
+	0x6BD2, 0x6BD7, 0x6C1F, 0x6CC9, 0x6D0B, 0x6D32, 0x6D2A, 0x6D41, 0x6D25, 0x6D0C,
+	// row 50
+	0x6D31, 0x6D1E, 0x6D17, 0x6D3B, 0x6D3D, 0x6D3E, 0x6D36, 0x6D1B, 0x6CF5, 0x6D39, 0x6D27, 0x6D38,
+	0x6D29, 0x6D2E, 0x6D35, 0x6D0E, 0x6D2B, 0x70AB, 0x70BA, 0x70B3, 0x70AC, 0x70AF, 0x70AD, 0x70B8,
+	0x70AE, 0x70A4, 0x7230, 0x7272, 0x726F, 0x7274, 0x72E9, 0x72E0, 0x72E1, 0x73B7, 0x73CA, 0x73BB,
+	0x73B2, 0x73CD, 0x73C0, 0x73B3, 0x751A, 0x752D, 0x754F, 0x754C, 0x754E, 0x754B, 0x75AB, 0x75A4,
+	0x75A5, 0x75A2, 0x75A3, 0x7678, 0x7686, 0x7687, 0x7688, 0x76C8, 0x76C6, 0x76C3, 0x76C5, 0x7701,
+	0x76F9, 0x76F8, 0x7709, 0x770B, 0x76FE, 0x76FC, 0x7707, 0x77DC, 0x7802, 0x7814, 0x780C, 0x780D,
+	0x7946, 0x7949, 0x7948, 0x7947, 0x79B9, 0x79BA, 0x79D1, 0x79D2, 0x79CB, 0x7A7F, 0x7A81, 0x7AFF,
+	0x7AFD, 0x7C7D, 0x7D02, 0x7D05, 0x7D00, 0x7D09, 0x7D07, 0x7D04, 0x7D06, 0x7F38,
+	// row 51
+	0x7F8E, 0x7FBF, 0x8010, 0x800D, 0x8011, 0x8036, 0x80D6, 0x80E5, 0x80DA, 0x80C3, 0x80C4, 0x80CC,
+	0x80E1, 0x80DB, 0x80CE, 0x80DE, 0x80E4, 0x80DD, 0x81F4, 0x8222, 0x82E7, 0x8303, 0x8305, 0x82E3,
+	0x82DB, 0x82E6, 0x8304, 0x82E5, 0x8302, 0x8309, 0x82D2, 0x82D7, 0x82F1, 0x8301, 0x82DC, 0x82D4,
+	0x82D1, 0x82DE, 0x82D3, 0x82DF, 0x82EF, 0x8306, 0x8650, 0x8679, 0x867B, 0x867A, 0x884D, 0x886B,
+	0x8981, 0x89D4, 0x8A08, 0x8A02, 0x8A03, 0x8C9E, 0x8CA0, 0x8D74, 0x8D73, 0x8DB4, 0x8ECD, 0x8ECC,
+	0x8FF0, 0x8FE6, 0x8FE2, 0x8FEA, 0x8FE5, 0x8FED, 0x8FEB, 0x8FE4, 0x8FE8, 0x90CA, 0x90CE, 0x90C1,
+	0x90C3, 0x914B, 0x914A, 0x91CD, 0x9582, 0x9650, 0x964B, 0x964C, 0x964D, 0x9762, 0x9769, 0x97CB,
+	0x97ED, 0x97F3, 0x9801, 0x98A8, 0x98DB, 0x98DF, 0x9996, 0x9999, 0x4E58, 0x4EB3,
+	// row 52
+	0x500C, 0x500D, 0x5023, 0x4FEF, 0x5026, 0x5025, 0x4FF8, 0x5029, 0x5016, 0x5006, 0x503C, 0x501F,
+	0x501A, 0x5012, 0x5011, 0x4FFA, 0x5000, 0x5014, 0x5028, 0x4FF1, 0x5021, 0x500B, 0x5019, 0x5018,
+	0x4FF3, 0x4FEE, 0x502D, 0x502A, 0x4FFE, 0x502B, 0x5009, 0x517C, 0x51A4, 0x51A5, 0x51A2, 0x51CD,
+	0x51CC, 0x51C6, 0x51CB, 0x5256, 0x525C, 0x5254, 0x525B, 0x525D, 0x532A, 0x537F, 0x539F, 0x539D,
+	0x53DF, 0x54E8, 0x5510, 0x5501, 0x5537, 0x54FC, 0x54E5, 0x54F2, 0x5506, 0x54FA, 0x5514, 0x54E9,
+	0x54ED, 0x54E1, 0x5509, 0x54EE, 0x54EA, 0x54E6, 0x5527, 0x5507, 0x54FD, 0x550F, 0x5703, 0x5704,
+	0x57C2, 0x57D4, 0x57CB, 0x57C3, 0x5809, 0x590F, 0x5957, 0x5958, 0x595A, 0x5A11, 0x5A18, 0x5A1C,
+	0x5A1F, 0x5A1B, 0x5A13, 0x59EC, 0x5A20, 0x5A23, 0x5A29, 0x5A25, 0x5A0C, 0x5A09,
+	// row 53
+	0x5B6B, 0x5C58, 0x5BB0, 0x5BB3, 0x5BB6, 0x5BB4, 0x5BAE, 0x5BB5, 0x5BB9, 0x5BB8, 0x5C04, 0x5C51,
+	0x5C55, 0x5C50, 0x5CED, 0x5CFD, 0x5CFB, 0x5CEA, 0x5CE8, 0x5CF0, 0x5CF6, 0x5D01, 0x5CF4, 0x5DEE,
+	0x5E2D, 0x5E2B, 0x5EAB, 0x5EAD, 0x5EA7, 0x5F31, 0x5F92, 0x5F91, 0x5F90, 0x6059, 0x6063, 0x6065,
+	0x6050, 0x6055, 0x606D, 0x6069, 0x606F, 0x6084, 0x609F, 0x609A, 0x608D, 0x6094, 0x608C, 0x6085,
+	0x6096, 0x6247, 0x62F3, 0x6308, 0x62FF, 0x634E, 0x633E, 0x632F, 0x6355, 0x6342, 0x6346, 0x634F,
+	0x6349, 0x633A, 0x6350, 0x633D, 0x632A, 0x632B, 0x6328, 0x634D, 0x634C, 0x6548, 0x6549, 0x6599,
+	0x65C1, 0x65C5, 0x6642, 0x6649, 0x664F, 0x6643, 0x6652, 0x664C, 0x6645, 0x6641, 0x66F8, 0x6714,
+	0x6715, 0x6717, 0x6821, 0x6838, 0x6848, 0x6846, 0x6853, 0x6839, 0x6842, 0x6854,
+	// row 54
+	0x6829, 0x68B3, 0x6817, 0x684C, 0x6851, 0x683D, 0x67F4, 0x6850, 0x6840, 0x683C, 0x6843, 0x682A,
+	0x6845, 0x6813, 0x6818, 0x6841, 0x6B8A, 0x6B89, 0x6BB7, 0x6C23, 0x6C27, 0x6C28, 0x6C26, 0x6C24,
+	0x6CF0, 0x6D6A, 0x6D95, 0x6D88, 0x6D87, 0x6D66, 0x6D78, 0x6D77, 0x6D59, 0x6D93, 0x6D6C, 0x6D89,
+	0x6D6E, 0x6D5A, 0x6D74, 0x6D69, 0x6D8C, 0x6D8A, 0x6D79, 0x6D85, 0x6D65, 0x6D94, 0x70CA, 0x70D8,
+	0x70E4, 0x70D9, 0x70C8, 0x70CF, 0x7239, 0x7279, 0x72FC, 0x72F9, 0x72FD, 0x72F8, 0x72F7, 0x7386,
+	0x73ED, 0x7409, 0x73EE, 0x73E0, 0x73EA, 0x73DE, 0x7554, 0x755D, 0x755C, 0x755A, 0x7559, 0x75BE,
+	0x75C5, 0x75C7, 0x75B2, 0x75B3, 0x75BD, 0x75BC, 0x75B9, 0x75C2, 0x75B8, 0x768B, 0x76B0, 0x76CA,
+	0x76CD, 0x76CE, 0x7729, 0x771F, 0x7720, 0x7728, 0x77E9, 0x7830, 0x7827, 0x7838,
+	// row 55
+	0x781D, 0x7834, 0x7837, 0x7825, 0x782D, 0x7820, 0x781F, 0x7832, 0x7955, 0x7950, 0x7960, 0x795F,
+	0x7956, 0x795E, 0x795D, 0x7957, 0x795A, 0x79E4, 0x79E3, 0x79E7, 0x79DF, 0x79E6, 0x79E9, 0x79D8,
+	0x7A84, 0x7A88, 0x7AD9, 0x7B06, 0x7B11, 0x7C89, 0x7D21, 0x7D17, 0x7D0B, 0x7D0A, 0x7D20, 0x7D22,
+	0x7D14, 0x7D10, 0x7D15, 0x7D1A, 0x7D1C, 0x7D0D, 0x7D19, 0x7D1B, 0x7F3A, 0x7F5F, 0x7F94, 0x7FC5,
+	0x7FC1, 0x8006, 0x8004, 0x8018, 0x8015, 0x8019, 0x8017, 0x803D, 0x803F, 0x80F1, 0x8102, 0x80F0,
+	0x8105, 0x80ED, 0x80F4, 0x8106, 0x80F8, 0x80F3, 0x8108, 0x80FD, 0x810A, 0x80FC, 0x80EF, 0x81ED,
+	0x81EC, 0x8200, 0x8210, 0x822A, 0x822B, 0x8228, 0x822C, 0x82BB, 0x832B, 0x8352, 0x8354, 0x834A,
+	0x8338, 0x8350, 0x8349, 0x8335, 0x8334, 0x834F, 0x8332, 0x8339, 0x8336, 0x8317,
+	// row 56
+	0x8340, 0x8331, 0x8328, 0x8343, 0x8654, 0x868A, 0x86AA, 0x8693, 0x86A4, 0x86A9, 0x868C, 0x86A3,
+	0x869C, 0x8870, 0x8877, 0x8881, 0x8882, 0x887D, 0x8879, 0x8A18, 0x8A10, 0x8A0E, 0x8A0C, 0x8A15,
+	0x8A0A, 0x8A17, 0x8A13, 0x8A16, 0x8A0F, 0x8A11, 0x8C48, 0x8C7A, 0x8C79, 0x8CA1, 0x8CA2, 0x8D77,
+	0x8EAC, 0x8ED2, 0x8ED4, 0x8ECF, 0x8FB1, 0x9001, 0x9006, 0x8FF7, 0x9000, 0x8FFA, 0x8FF4, 0x9003,
+	0x8FFD, 0x9005, 0x8FF8, 0x9095, 0x90E1, 0x90DD, 0x90E2, 0x9152, 0x914D, 0x914C, 0x91D8, 0x91DD,
+	0x91D7, 0x91DC, 0x91D9, 0x9583, 0x9662, 0x9663, 0x9661, 0x965B, 0x965D, 0x9664, 0x9658, 0x965E,
+	0x96BB, 0x98E2, 0x99AC, 0x9AA8, 0x9AD8, 0x9B25, 0x9B32, 0x9B3C, 0x4E7E, 0x507A, 0x507D, 0x505C,
+	0x5047, 0x5043, 0x504C, 0x505A, 0x5049, 0x5065, 0x5076, 0x504E, 0x5055, 0x5075,
+	// row 57
+	0x5074, 0x5077, 0x504F, 0x500F, 0x506F, 0x506D, 0x515C, 0x5195, 0x51F0, 0x526A, 0x526F, 0x52D2,
+	0x52D9, 0x52D8, 0x52D5, 0x5310, 0x530F, 0x5319, 0x533F, 0x5340, 0x533E, 0x53C3, 0x66FC, 0x5546,
+	0x556A, 0x5566, 0x5544, 0x555E, 0x5561, 0x5543, 0x554A, 0x5531, 0x5556, 0x554F, 0x5555, 0x552F,
+	0x5564, 0x5538, 0x552E, 0x555C, 0x552C, 0x5563, 0x5533, 0x5541, 0x5557, 0x5708, 0x570B, 0x5709,
+	0x57DF, 0x5805, 0x580A, 0x5806, 0x57E0, 0x57E4, 0x57FA, 0x5802, 0x5835, 0x57F7, 0x57F9, 0x5920,
+	0x5962, 0x5A36, 0x5A41, 0x5A49, 0x5A66, 0x5A6A, 0x5A40, 0x5A3C, 0x5A62, 0x5A5A, 0x5A46, 0x5A4A,
+	0x5B70, 0x5BC7, 0x5BC5, 0x5BC4, 0x5BC2, 0x5BBF, 0x5BC6, 0x5C09, 0x5C08, 0x5C07, 0x5C60, 0x5C5C,
+	0x5C5D, 0x5D07, 0x5D06, 0x5D0E, 0x5D1B, 0x5D16, 0x5D22, 0x5D11, 0x5D29, 0x5D14,
+	// row 58
+	0x5D19, 0x5D24, 0x5D27, 0x5D17, 0x5DE2, 0x5E38, 0x5E36, 0x5E33, 0x5E37, 0x5EB7, 0x5EB8, 0x5EB6,
+	0x5EB5, 0x5EBE, 0x5F35, 0x5F37, 0x5F57, 0x5F6C, 0x5F69, 0x5F6B, 0x5F97, 0x5F99, 0x5F9E, 0x5F98,
+	0x5FA1, 0x5FA0, 0x5F9C, 0x607F, 0x60A3, 0x6089, 0x60A0, 0x60A8, 0x60CB, 0x60B4, 0x60E6, 0x60BD,
+	0x60C5, 0x60BB, 0x60B5, 0x60DC, 0x60BC, 0x60D8, 0x60D5, 0x60C6, 0x60DF, 0x60B8, 0x60DA, 0x60C7,
+	0x621A, 0x621B, 0x6248, 0x63A0, 0x63A7, 0x6372, 0x6396, 0x63A2, 0x63A5, 0x6377, 0x6367, 0x6398,
+	0x63AA, 0x6371, 0x63A9, 0x6389, 0x6383, 0x639B, 0x636B, 0x63A8, 0x6384, 0x6388, 0x6399, 0x63A1,
+	0x63AC, 0x6392, 0x638F, 0x6380, 0x637B, 0x6369, 0x6368, 0x637A, 0x655D, 0x6556, 0x6551, 0x6559,
+	0x6557, 0x555F, 0x654F, 0x6558, 0x6555, 0x6554, 0x659C, 0x659B, 0x65AC, 0x65CF,
+	// row 59
+	0x65CB, 0x65CC, 0x65CE, 0x665D, 0x665A, 0x6664, 0x6668, 0x6666, 0x665E, 0x66F9, 0x52D7, 0x671B,
+	0x6881, 0x68AF, 0x68A2, 0x6893, 0x68B5, 0x687F, 0x6876, 0x68B1, 0x68A7, 0x6897, 0x68B0, 0x6883,
+	0x68C4, 0x68AD, 0x6886, 0x6885, 0x6894, 0x689D, 0x68A8, 0x689F, 0x68A1, 0x6882, 0x6B32, 0x6BBA,
+	0x6BEB, 0x6BEC, 0x6C2B, 0x6D8E, 0x6DBC, 0x6DF3, 0x6DD9, 0x6DB2, 0x6DE1, 0x6DCC, 0x6DE4, 0x6DFB,
+	0x6DFA, 0x6E05, 0x6DC7, 0x6DCB, 0x6DAF, 0x6DD1, 0x6DAE, 0x6DDE, 0x6DF9, 0x6DB8, 0x6DF7, 0x6DF5,
+	0x6DC5, 0x6DD2, 0x6E1A, 0x6DB5, 0x6DDA, 0x6DEB, 0x6DD8, 0x6DEA, 0x6DF1, 0x6DEE, 0x6DE8, 0x6DC6,
+	0x6DC4, 0x6DAA, 0x6DEC, 0x6DBF, 0x6DE6, 0x70F9, 0x7109, 0x710A, 0x70FD, 0x70EF, 0x723D, 0x727D,
+	0x7281, 0x731C, 0x731B, 0x7316, 0x7313, 0x7319, 0x7387, 0x7405, 0x740A, 0x7403,
+	// row 60
+	0x7406, 0x73FE, 0x740D, 0x74E0, 0x74F6, 0x74F7, 0x751C, 0x7522, 0x7565, 0x7566, 0x7562, 0x7570,
+	0x758F, 0x75D4, 0x75D5, 0x75B5, 0x75CA, 0x75CD, 0x768E, 0x76D4, 0x76D2, 0x76DB, 0x7737, 0x773E,
+	0x773C, 0x7736, 0x7738, 0x773A, 0x786B, 0x7843, 0x784E, 0x7965, 0x7968, 0x796D, 0x79FB, 0x7A92,
+	0x7A95, 0x7B20, 0x7B28, 0x7B1B, 0x7B2C, 0x7B26, 0x7B19, 0x7B1E, 0x7B2E, 0x7C92, 0x7C97, 0x7C95,
+	0x7D46, 0x7D43, 0x7D71, 0x7D2E, 0x7D39, 0x7D3C, 0x7D40, 0x7D30, 0x7D33, 0x7D44, 0x7D2F, 0x7D42,
+	0x7D32, 0x7D31, 0x7F3D, 0x7F9E, 0x7F9A, 0x7FCC, 0x7FCE, 0x7FD2, 0x801C, 0x804A, 0x8046, 0x812F,
+	0x8116, 0x8123, 0x812B, 0x8129, 0x8130, 0x8124, 0x8202, 0x8235, 0x8237, 0x8236, 0x8239, 0x838E,
+	0x839E, 0x8398, 0x8378, 0x83A2, 0x8396, 0x83BD, 0x83AB, 0x8392, 0x838A, 0x8393,
+	// row 61
+	0x8389, 0x83A0, 0x8377, 0x837B, 0x837C, 0x8386, 0x83A7, 0x8655, 0x5F6A, 0x86C7, 0x86C0, 0x86B6,
+	0x86C4, 0x86B5, 0x86C6, 0x86CB, 0x86B1, 0x86AF, 0x86C9, 0x8853, 0x889E, 0x8888, 0x88AB, 0x8892,
+	0x8896, 0x888D, 0x888B, 0x8993, 0x898F, 0x8A2A, 0x8A1D, 0x8A23, 0x8A25, 0x8A31, 0x8A2D, 0x8A1F,
+	0x8A1B, 0x8A22, 0x8C49, 0x8C5A, 0x8CA9, 0x8CAC, 0x8CAB, 0x8CA8, 0x8CAA, 0x8CA7, 0x8D67, 0x8D66,
+	0x8DBE, 0x8DBA, 0x8EDB, 0x8EDF, 0x9019, 0x900D, 0x901A, 0x9017, 0x9023, 0x901F, 0x901D, 0x9010,
+	0x9015, 0x901E, 0x9020, 0x900F, 0x9022, 0x9016, 0x901B, 0x9014, 0x90E8, 0x90ED, 0x90FD, 0x9157,
+	0x91CE, 0x91F5, 0x91E6, 0x91E3, 0x91E7, 0x91ED, 0x91E9, 0x9589, 0x966A, 0x9675, 0x9673, 0x9678,
+	0x9670, 0x9674, 0x9676, 0x9677, 0x966C, 0x96C0, 0x96EA, 0x96E9, 0x7AE0, 0x7ADF,
+	// row 62
+	0x9802, 0x9803, 0x9B5A, 0x9CE5, 0x9E75, 0x9E7F, 0x9EA5, 0x9EBB, 0x50A2, 0x508D, 0x5085, 0x5099,
+	0x5091, 0x5080, 0x5096, 0x5098, 0x509A, 0x6700, 0x51F1, 0x5272, 0x5274, 0x5275, 0x5269, 0x52DE,
+	0x52DD, 0x52DB, 0x535A, 0x53A5, 0x557B, 0x5580, 0x55A7, 0x557C, 0x558A, 0x559D, 0x5598, 0x5582,
+	0x559C, 0x55AA, 0x5594, 0x5587, 0x558B, 0x5583, 0x55B3, 0x55AE, 0x559F, 0x553E, 0x55B2, 0x559A,
+	0x55BB, 0x55AC, 0x55B1, 0x557E, 0x5589, 0x55AB, 0x5599, 0x570D, 0x582F, 0x582A, 0x5834, 0x5824,
+	0x5830, 0x5831, 0x5821, 0x581D, 0x5820, 0x58F9, 0x58FA, 0x5960, 0x5A77, 0x5A9A, 0x5A7F, 0x5A92,
+	0x5A9B, 0x5AA7, 0x5B73, 0x5B71, 0x5BD2, 0x5BCC, 0x5BD3, 0x5BD0, 0x5C0A, 0x5C0B, 0x5C31, 0x5D4C,
+	0x5D50, 0x5D34, 0x5D47, 0x5DFD, 0x5E45, 0x5E3D, 0x5E40, 0x5E43, 0x5E7E, 0x5ECA,
+	// row 63
+	0x5EC1, 0x5EC2, 0x5EC4, 0x5F3C, 0x5F6D, 0x5FA9, 0x5FAA, 0x5FA8, 0x60D1, 0x60E1, 0x60B2, 0x60B6,
+	0x60E0, 0x611C, 0x6123, 0x60FA, 0x6115, 0x60F0, 0x60FB, 0x60F4, 0x6168, 0x60F1, 0x610E, 0x60F6,
+	0x6109, 0x6100, 0x6112, 0x621F, 0x6249, 0x63A3, 0x638C, 0x63CF, 0x63C0, 0x63E9, 0x63C9, 0x63C6,
+	0x63CD, 0x63D2, 0x63E3, 0x63D0, 0x63E1, 0x63D6, 0x63ED, 0x63EE, 0x6376, 0x63F4, 0x63EA, 0x63DB,
+	0x6452, 0x63DA, 0x63F9, 0x655E, 0x6566, 0x6562, 0x6563, 0x6591, 0x6590, 0x65AF, 0x666E, 0x6670,
+	0x6674, 0x6676, 0x666F, 0x6691, 0x667A, 0x667E, 0x6677, 0x66FE, 0x66FF, 0x671F, 0x671D, 0x68FA,
+	0x68D5, 0x68E0, 0x68D8, 0x68D7, 0x6905, 0x68DF, 0x68F5, 0x68EE, 0x68E7, 0x68F9, 0x68D2, 0x68F2,
+	0x68E3, 0x68CB, 0x68CD, 0x690D, 0x6912, 0x690E, 0x68C9, 0x68DA, 0x696E, 0x68FB,
+	// row 64
+	0x6B3E, 0x6B3A, 0x6B3D, 0x6B98, 0x6B96, 0x6BBC, 0x6BEF, 0x6C2E, 0x6C2F, 0x6C2C, 0x6E2F, 0x6E38,
+	0x6E54, 0x6E21, 0x6E32, 0x6E67, 0x6E4A, 0x6E20, 0x6E25, 0x6E23, 0x6E1B, 0x6E5B, 0x6E58, 0x6E24,
+	0x6E56, 0x6E6E, 0x6E2D, 0x6E26, 0x6E6F, 0x6E34, 0x6E4D, 0x6E3A, 0x6E2C, 0x6E43, 0x6E1D, 0x6E3E,
+	0x6ECB, 0x6E89, 0x6E19, 0x6E4E, 0x6E63, 0x6E44, 0x6E72, 0x6E69, 0x6E5F, 0x7119, 0x711A, 0x7126,
+	0x7130, 0x7121, 0x7136, 0x716E, 0x711C, 0x724C, 0x7284, 0x7280, 0x7336, 0x7325, 0x7334, 0x7329,
+	0x743A, 0x742A, 0x7433, 0x7422, 0x7425, 0x7435, 0x7436, 0x7434, 0x742F, 0x741B, 0x7426, 0x7428,
+	0x7525, 0x7526, 0x756B, 0x756A, 0x75E2, 0x75DB, 0x75E3, 0x75D9, 0x75D8, 0x75DE, 0x75E0, 0x767B,
+	0x767C, 0x7696, 0x7693, 0x76B4, 0x76DC, 0x774F, 0x77ED, 0x785D, 0x786C, 0x786F,
+	// row 65
+	0x7A0D, 0x7A08, 0x7A0B, 0x7A05, 0x7A00, 0x7A98, 0x7A97, 0x7A96, 0x7AE5, 0x7AE3, 0x7B49, 0x7B56,
+	0x7B46, 0x7B50, 0x7B52, 0x7B54, 0x7B4D, 0x7B4B, 0x7B4F, 0x7B51, 0x7C9F, 0x7CA5, 0x7D5E, 0x7D50,
+	0x7D68, 0x7D55, 0x7D2B, 0x7D6E, 0x7D72, 0x7D61, 0x7D66, 0x7D62, 0x7D70, 0x7D73, 0x5584, 0x7FD4,
+	0x7FD5, 0x800B, 0x8052, 0x8085, 0x8155, 0x8154, 0x814B, 0x8151, 0x814E, 0x8139, 0x8146, 0x813E,
+	0x814C, 0x8153, 0x8174, 0x8212, 0x821C, 0x83E9, 0x8403, 0x83F8, 0x840D, 0x83E0, 0x83C5, 0x840B,
+	0x83C1, 0x83EF, 0x83F1, 0x83F4, 0x8457, 0x840A, 0x83F0, 0x840C, 0x83CC, 0x83FD, 0x83F2, 0x83CA,
+	0x8438, 0x840E, 0x8404, 0x83DC, 0x8407, 0x83D4, 0x83DF, 0x865B, 0x86DF, 0x86D9, 0x86ED, 0x86D4,
+	0x86DB, 0x86E4, 0x86D0, 0x86DE, 0x8857, 0x88C1, 0x88C2, 0x88B1, 0x8983, 0x8996,
+	// row 66
+	0x8A3B, 0x8A60, 0x8A55, 0x8A5E, 0x8A3C, 0x8A41, 0x8A54, 0x8A5B, 0x8A50, 0x8A46, 0x8A34, 0x8A3A,
+	0x8A36, 0x8A56, 0x8C61, 0x8C82, 0x8CAF, 0x8CBC, 0x8CB3, 0x8CBD, 0x8CC1, 0x8CBB, 0x8CC0, 0x8CB4,
+	0x8CB7, 0x8CB6, 0x8CBF, 0x8CB8, 0x8D8A, 0x8D85, 0x8D81, 0x8DCE, 0x8DDD, 0x8DCB, 0x8DDA, 0x8DD1,
+	0x8DCC, 0x8DDB, 0x8DC6, 0x8EFB, 0x8EF8, 0x8EFC, 0x8F9C, 0x902E, 0x9035, 0x9031, 0x9038, 0x9032,
+	0x9036, 0x9102, 0x90F5, 0x9109, 0x90FE, 0x9163, 0x9165, 0x91CF, 0x9214, 0x9215, 0x9223, 0x9209,
+	0x921E, 0x920D, 0x9210, 0x9207, 0x9211, 0x9594, 0x958F, 0x958B, 0x9591, 0x9593, 0x9592, 0x958E,
+	0x968A, 0x968E, 0x968B, 0x967D, 0x9685, 0x9686, 0x968D, 0x9672, 0x9684, 0x96C1, 0x96C5, 0x96C4,
+	0x96C6, 0x96C7, 0x96EF, 0x96F2, 0x97CC, 0x9805, 0x9806, 0x9808, 0x98E7, 0x98EA,
+	// row 67
+	0x98EF, 0x98E9, 0x98F2, 0x98ED, 0x99AE, 0x99AD, 0x9EC3, 0x9ECD, 0x9ED1, 0x4E82, 0x50AD, 0x50B5,
+	0x50B2, 0x50B3, 0x50C5, 0x50BE, 0x50AC, 0x50B7, 0x50BB, 0x50AF, 0x50C7, 0x527F, 0x5277, 0x527D,
+	0x52DF, 0x52E6, 0x52E4, 0x52E2, 0x52E3, 0x532F, 0x55DF, 0x55E8, 0x55D3, 0x55E6, 0x55CE, 0x55DC,
+	0x55C7, 0x55D1, 0x55E3, 0x55E4, 0x55EF, 0x55DA, 0x55E1, 0x55C5, 0x55C6, 0x55E5, 0x55C9, 0x5712,
+	0x5713, 0x585E, 0x5851, 0x5858, 0x5857, 0x585A, 0x5854, 0x586B, 0x584C, 0x586D, 0x584A, 0x5862,
+	0x5852, 0x584B, 0x5967, 0x5AC1, 0x5AC9, 0x5ACC, 0x5ABE, 0x5ABD, 0x5ABC, 0x5AB3, 0x5AC2, 0x5AB2,
+	0x5D69, 0x5D6F, 0x5E4C, 0x5E79, 0x5EC9, 0x5EC8, 0x5F12, 0x5F59, 0x5FAC, 0x5FAE, 0x611A, 0x610F,
+	0x6148, 0x611F, 0x60F3, 0x611B, 0x60F9, 0x6101, 0x6108, 0x614E, 0x614C, 0x6144,
+	// row 68
+	0x614D, 0x613E, 0x6134, 0x6127, 0x610D, 0x6106, 0x6137, 0x6221, 0x6222, 0x6413, 0x643E, 0x641E,
+	0x642A, 0x642D, 0x643D, 0x642C, 0x640F, 0x641C, 0x6414, 0x640D, 0x6436, 0x6416, 0x6417, 0x6406,
+	0x656C, 0x659F, 0x65B0, 0x6697, 0x6689, 0x6687, 0x6688, 0x6696, 0x6684, 0x6698, 0x668D, 0x6703,
+	0x6994, 0x696D, 0x695A, 0x6977, 0x6960, 0x6954, 0x6975, 0x6930, 0x6982, 0x694A, 0x6968, 0x696B,
+	0x695E, 0x6953, 0x6979, 0x6986, 0x695D, 0x6963, 0x695B, 0x6B47, 0x6B72, 0x6BC0, 0x6BBF, 0x6BD3,
+	0x6BFD, 0x6EA2, 0x6EAF, 0x6ED3, 0x6EB6, 0x6EC2, 0x6E90, 0x6E9D, 0x6EC7, 0x6EC5, 0x6EA5, 0x6E98,
+	0x6EBC, 0x6EBA, 0x6EAB, 0x6ED1, 0x6E96, 0x6E9C, 0x6EC4, 0x6ED4, 0x6EAA, 0x6EA7, 0x6EB4, 0x714E,
+	0x7159, 0x7169, 0x7164, 0x7149, 0x7167, 0x715C, 0x716C, 0x7166, 0x714C, 0x7165,
+	// row 69
+	0x715E, 0x7146, 0x7168, 0x7156, 0x723A, 0x7252, 0x7337, 0x7345, 0x733F, 0x733E, 0x746F, 0x745A,
+	0x7455, 0x745F, 0x745E, 0x7441, 0x743F, 0x7459, 0x745B, 0x745C, 0x7576, 0x7578, 0x7600, 0x75F0,
+	0x7601, 0x75F2, 0x75F1, 0x75FA, 0x75FF, 0x75F4, 0x75F3, 0x76DE, 0x76DF, 0x775B, 0x776B, 0x7766,
+	0x775E, 0x7763, 0x7779, 0x776A, 0x776C, 0x775C, 0x7765, 0x7768, 0x7762, 0x77EE, 0x788E, 0x78B0,
+	0x7897, 0x7898, 0x788C, 0x7889, 0x787C, 0x7891, 0x7893, 0x787F, 0x797A, 0x797F, 0x7981, 0x842C,
+	0x79BD, 0x7A1C, 0x7A1A, 0x7A20, 0x7A14, 0x7A1F, 0x7A1E, 0x7A9F, 0x7AA0, 0x7B77, 0x7BC0, 0x7B60,
+	0x7B6E, 0x7B67, 0x7CB1, 0x7CB3, 0x7CB5, 0x7D93, 0x7D79, 0x7D91, 0x7D81, 0x7D8F, 0x7D5B, 0x7F6E,
+	0x7F69, 0x7F6A, 0x7F72, 0x7FA9, 0x7FA8, 0x7FA4, 0x8056, 0x8058, 0x8086, 0x8084,
+	// row 70
+	0x8171, 0x8170, 0x8178, 0x8165, 0x816E, 0x8173, 0x816B, 0x8179, 0x817A, 0x8166, 0x8205, 0x8247,
+	0x8482, 0x8477, 0x843D, 0x8431, 0x8475, 0x8466, 0x846B, 0x8449, 0x846C, 0x845B, 0x843C, 0x8435,
+	0x8461, 0x8463, 0x8469, 0x846D, 0x8446, 0x865E, 0x865C, 0x865F, 0x86F9, 0x8713, 0x8708, 0x8707,
+	0x8700, 0x86FE, 0x86FB, 0x8702, 0x8703, 0x8706, 0x870A, 0x8859, 0x88DF, 0x88D4, 0x88D9, 0x88DC,
+	0x88D8, 0x88DD, 0x88E1, 0x88CA, 0x88D5, 0x88D2, 0x899C, 0x89E3, 0x8A6B, 0x8A72, 0x8A73, 0x8A66,
+	0x8A69, 0x8A70, 0x8A87, 0x8A7C, 0x8A63, 0x8AA0, 0x8A71, 0x8A85, 0x8A6D, 0x8A62, 0x8A6E, 0x8A6C,
+	0x8A79, 0x8A7B, 0x8A3E, 0x8A68, 0x8C62, 0x8C8A, 0x8C89, 0x8CCA, 0x8CC7, 0x8CC8, 0x8CC4, 0x8CB2,
+	0x8CC3, 0x8CC2, 0x8CC5, 0x8DE1, 0x8DDF, 0x8DE8, 0x8DEF, 0x8DF3, 0x8DFA, 0x8DEA,
+	// row 71
+	0x8DE4, 0x8DE6, 0x8EB2, 0x8F03, 0x8F09, 0x8EFE, 0x8F0A, 0x8F9F, 0x8FB2, 0x904B, 0x904A, 0x9053,
+	0x9042, 0x9054, 0x903C, 0x9055, 0x9050, 0x9047, 0x904F, 0x904E, 0x904D, 0x9051, 0x903E, 0x9041,
+	0x9112, 0x9117, 0x916C, 0x916A, 0x9169, 0x91C9, 0x9237, 0x9257, 0x9238, 0x923D, 0x9240, 0x923E,
+	0x925B, 0x924B, 0x9264, 0x9251, 0x9234, 0x9249, 0x924D, 0x9245, 0x9239, 0x923F, 0x925A, 0x9598,
+	0x9698, 0x9694, 0x9695, 0x96CD, 0x96CB, 0x96C9, 0x96CA, 0x96F7, 0x96FB, 0x96F9, 0x96F6, 0x9756,
+	0x9774, 0x9776, 0x9810, 0x9811, 0x9813, 0x980A, 0x9812, 0x980C, 0x98FC, 0x98F4, 0x98FD, 0x98FE,
+	0x99B3, 0x99B1, 0x99B4, 0x9AE1, 0x9CE9, 0x9E82, 0x9F0E, 0x9F13, 0x9F20, 0x50E7, 0x50EE, 0x50E5,
+	0x50D6, 0x50ED, 0x50DA, 0x50D5, 0x50CF, 0x50D1, 0x50F1, 0x50CE, 0x50E9, 0x5162,
+	// row 72
+	0x51F3, 0x5283, 0x5282, 0x5331, 0x53AD, 0x55FE, 0x5600, 0x561B, 0x5617, 0x55FD, 0x5614, 0x5606,
+	0x5609, 0x560D, 0x560E, 0x55F7, 0x5616, 0x561F, 0x5608, 0x5610, 0x55F6, 0x5718, 0x5716, 0x5875,
+	0x587E, 0x5883, 0x5893, 0x588A, 0x5879, 0x5885, 0x587D, 0x58FD, 0x5925, 0x5922, 0x5924, 0x596A,
+	0x5969, 0x5AE1, 0x5AE6, 0x5AE9, 0x5AD7, 0x5AD6, 0x5AD8, 0x5AE3, 0x5B75, 0x5BDE, 0x5BE7, 0x5BE1,
+	0x5BE5, 0x5BE6, 0x5BE8, 0x5BE2, 0x5BE4, 0x5BDF, 0x5C0D, 0x5C62, 0x5D84, 0x5D87, 0x5E5B, 0x5E63,
+	0x5E55, 0x5E57, 0x5E54, 0x5ED3, 0x5ED6, 0x5F0A, 0x5F46, 0x5F70, 0x5FB9, 0x6147, 0x613F, 0x614B,
+	0x6177, 0x6162, 0x6163, 0x615F, 0x615A, 0x6158, 0x6175, 0x622A, 0x6487, 0x6458, 0x6454, 0x64A4,
+	0x6478, 0x645F, 0x647A, 0x6451, 0x6467, 0x6434, 0x646D, 0x647B, 0x6572, 0x65A1,
+	// row 73
+	0x65D7, 0x65D6, 0x66A2, 0x66A8, 0x669D, 0x699C, 0x69A8, 0x6995, 0x69C1, 0x69AE, 0x69D3, 0x69CB,
+	0x699B, 0x69B7, 0x69BB, 0x69AB, 0x69B4, 0x69D0, 0x69CD, 0x69AD, 0x69CC, 0x69A6, 0x69C3, 0x69A3,
+	0x6B49, 0x6B4C, 0x6C33, 0x6F33, 0x6F14, 0x6EFE, 0x6F13, 0x6EF4, 0x6F29, 0x6F3E, 0x6F20, 0x6F2C,
+	0x6F0F, 0x6F02, 0x6F22, 0x6EFF, 0x6EEF, 0x6F06, 0x6F31, 0x6F38, 0x6F32, 0x6F23, 0x6F15, 0x6F2B,
+	0x6F2F, 0x6F88, 0x6F2A, 0x6EEC, 0x6F01, 0x6EF2, 0x6ECC, 0x6EF7, 0x7194, 0x7199, 0x717D, 0x718A,
+	0x7184, 0x7192, 0x723E, 0x7292, 0x7296, 0x7344, 0x7350, 0x7464, 0x7463, 0x746A, 0x7470, 0x746D,
+	0x7504, 0x7591, 0x7627, 0x760D, 0x760B, 0x7609, 0x7613, 0x76E1, 0x76E3, 0x7784, 0x777D, 0x777F,
+	0x7761, 0x78C1, 0x789F, 0x78A7, 0x78B3, 0x78A9, 0x78A3, 0x798E, 0x798F, 0x798D,
+	// row 74
+	0x7A2E, 0x7A31, 0x7AAA, 0x7AA9, 0x7AED, 0x7AEF, 0x7BA1, 0x7B95, 0x7B8B, 0x7B75, 0x7B97, 0x7B9D,
+	0x7B94, 0x7B8F, 0x7BB8, 0x7B87, 0x7B84, 0x7CB9, 0x7CBD, 0x7CBE, 0x7DBB, 0x7DB0, 0x7D9C, 0x7DBD,
+	0x7DBE, 0x7DA0, 0x7DCA, 0x7DB4, 0x7DB2, 0x7DB1, 0x7DBA, 0x7DA2, 0x7DBF, 0x7DB5, 0x7DB8, 0x7DAD,
+	0x7DD2, 0x7DC7, 0x7DAC, 0x7F70, 0x7FE0, 0x7FE1, 0x7FDF, 0x805E, 0x805A, 0x8087, 0x8150, 0x8180,
+	0x818F, 0x8188, 0x818A, 0x817F, 0x8182, 0x81E7, 0x81FA, 0x8207, 0x8214, 0x821E, 0x824B, 0x84C9,
+	0x84BF, 0x84C6, 0x84C4, 0x8499, 0x849E, 0x84B2, 0x849C, 0x84CB, 0x84B8, 0x84C0, 0x84D3, 0x8490,
+	0x84BC, 0x84D1, 0x84CA, 0x873F, 0x871C, 0x873B, 0x8722, 0x8725, 0x8734, 0x8718, 0x8755, 0x8737,
+	0x8729, 0x88F3, 0x8902, 0x88F4, 0x88F9, 0x88F8, 0x88FD, 0x88E8, 0x891A, 0x88EF,
+	// row 75
+	0x8AA6, 0x8A8C, 0x8A9E, 0x8AA3, 0x8A8D, 0x8AA1, 0x8A93, 0x8AA4, 0x8AAA, 0x8AA5, 0x8AA8, 0x8A98,
+	0x8A91, 0x8A9A, 0x8AA7, 0x8C6A, 0x8C8D, 0x8C8C, 0x8CD3, 0x8CD1, 0x8CD2, 0x8D6B, 0x8D99, 0x8D95,
+	0x8DFC, 0x8F14, 0x8F12, 0x8F15, 0x8F13, 0x8FA3, 0x9060, 0x9058, 0x905C, 0x9063, 0x9059, 0x905E,
+	0x9062, 0x905D, 0x905B, 0x9119, 0x9118, 0x911E, 0x9175, 0x9178, 0x9177, 0x9174, 0x9278, 0x92AC,
+	0x9280, 0x9285, 0x9298, 0x9296, 0x927B, 0x9293, 0x929C, 0x92A8, 0x927C, 0x9291, 0x95A1, 0x95A8,
+	0x95A9, 0x95A3, 0x95A5, 0x95A4, 0x9699, 0x969C, 0x969B, 0x96CC, 0x96D2, 0x9700, 0x977C, 0x9785,
+	0x97F6, 0x9817, 0x9818, 0x98AF, 0x98B1, 0x9903, 0x9905, 0x990C, 0x9909, 0x99C1, 0x9AAF, 0x9AB0,
+	0x9AE6, 0x9B41, 0x9B42, 0x9CF4, 0x9CF6, 0x9CF3, 0x9EBC, 0x9F3B, 0x9F4A, 0x5104,
+	// row 76
+	0x5100, 0x50FB, 0x50F5, 0x50F9, 0x5102, 0x5108, 0x5109, 0x5105, 0x51DC, 0x5287, 0x5288, 0x5289,
+	0x528D, 0x528A, 0x52F0, 0x53B2, 0x562E, 0x563B, 0x5639, 0x5632, 0x563F, 0x5634, 0x5629, 0x5653,
+	0x564E, 0x5657, 0x5674, 0x5636, 0x562F, 0x5630, 0x5880, 0x589F, 0x589E, 0x58B3, 0x589C, 0x58AE,
+	0x58A9, 0x58A6, 0x596D, 0x5B09, 0x5AFB, 0x5B0B, 0x5AF5, 0x5B0C, 0x5B08, 0x5BEE, 0x5BEC, 0x5BE9,
+	0x5BEB, 0x5C64, 0x5C65, 0x5D9D, 0x5D94, 0x5E62, 0x5E5F, 0x5E61, 0x5EE2, 0x5EDA, 0x5EDF, 0x5EDD,
+	0x5EE3, 0x5EE0, 0x5F48, 0x5F71, 0x5FB7, 0x5FB5, 0x6176, 0x6167, 0x616E, 0x615D, 0x6155, 0x6182,
+	0x617C, 0x6170, 0x616B, 0x617E, 0x61A7, 0x6190, 0x61AB, 0x618E, 0x61AC, 0x619A, 0x61A4, 0x6194,
+	0x61AE, 0x622E, 0x6469, 0x646F, 0x6479, 0x649E, 0x64B2, 0x6488, 0x6490, 0x64B0,
+	// row 77
+	0x64A5, 0x6493, 0x6495, 0x64A9, 0x6492, 0x64AE, 0x64AD, 0x64AB, 0x649A, 0x64AC, 0x6499, 0x64A2,
+	0x64B3, 0x6575, 0x6577, 0x6578, 0x66AE, 0x66AB, 0x66B4, 0x66B1, 0x6A23, 0x6A1F, 0x69E8, 0x6A01,
+	0x6A1E, 0x6A19, 0x69FD, 0x6A21, 0x6A13, 0x6A0A, 0x69F3, 0x6A02, 0x6A05, 0x69ED, 0x6A11, 0x6B50,
+	0x6B4E, 0x6BA4, 0x6BC5, 0x6BC6, 0x6F3F, 0x6F7C, 0x6F84, 0x6F51, 0x6F66, 0x6F54, 0x6F86, 0x6F6D,
+	0x6F5B, 0x6F78, 0x6F6E, 0x6F8E, 0x6F7A, 0x6F70, 0x6F64, 0x6F97, 0x6F58, 0x6ED5, 0x6F6F, 0x6F60,
+	0x6F5F, 0x719F, 0x71AC, 0x71B1, 0x71A8, 0x7256, 0x729B, 0x734E, 0x7357, 0x7469, 0x748B, 0x7483,
+	0x747E, 0x7480, 0x757F, 0x7620, 0x7629, 0x761F, 0x7624, 0x7626, 0x7621, 0x7622, 0x769A, 0x76BA,
+	0x76E4, 0x778E, 0x7787, 0x778C, 0x7791, 0x778B, 0x78CB, 0x78C5, 0x78BA, 0x78CA,
+	// row 78
+	0x78BE, 0x78D5, 0x78BC, 0x78D0, 0x7A3F, 0x7A3C, 0x7A40, 0x7A3D, 0x7A37, 0x7A3B, 0x7AAF, 0x7AAE,
+	0x7BAD, 0x7BB1, 0x7BC4, 0x7BB4, 0x7BC6, 0x7BC7, 0x7BC1, 0x7BA0, 0x7BCC, 0x7CCA, 0x7DE0, 0x7DF4,
+	0x7DEF, 0x7DFB, 0x7DD8, 0x7DEC, 0x7DDD, 0x7DE8, 0x7DE3, 0x7DDA, 0x7DDE, 0x7DE9, 0x7D9E, 0x7DD9,
+	0x7DF2, 0x7DF9, 0x7F75, 0x7F77, 0x7FAF, 0x7FE9, 0x8026, 0x819B, 0x819C, 0x819D, 0x81A0, 0x819A,
+	0x8198, 0x8517, 0x853D, 0x851A, 0x84EE, 0x852C, 0x852D, 0x8513, 0x8511, 0x8523, 0x8521, 0x8514,
+	0x84EC, 0x8525, 0x84FF, 0x8506, 0x8782, 0x8774, 0x8776, 0x8760, 0x8766, 0x8778, 0x8768, 0x8759,
+	0x8757, 0x874C, 0x8753, 0x885B, 0x885D, 0x8910, 0x8907, 0x8912, 0x8913, 0x8915, 0x890A, 0x8ABC,
+	0x8AD2, 0x8AC7, 0x8AC4, 0x8A95, 0x8ACB, 0x8AF8, 0x8AB2, 0x8AC9, 0x8AC2, 0x8ABF,
+	// row 79
+	0x8AB0, 0x8AD6, 0x8ACD, 0x8AB6, 0x8AB9, 0x8ADB, 0x8C4C, 0x8C4E, 0x8C6C, 0x8CE0, 0x8CDE, 0x8CE6,
+	0x8CE4, 0x8CEC, 0x8CED, 0x8CE2, 0x8CE3, 0x8CDC, 0x8CEA, 0x8CE1, 0x8D6D, 0x8D9F, 0x8DA3, 0x8E2B,
+	0x8E10, 0x8E1D, 0x8E22, 0x8E0F, 0x8E29, 0x8E1F, 0x8E21, 0x8E1E, 0x8EBA, 0x8F1D, 0x8F1B, 0x8F1F,
+	0x8F29, 0x8F26, 0x8F2A, 0x8F1C, 0x8F1E, 0x8F25, 0x9069, 0x906E, 0x9068, 0x906D, 0x9077, 0x9130,
+	0x912D, 0x9127, 0x9131, 0x9187, 0x9189, 0x918B, 0x9183, 0x92C5, 0x92BB, 0x92B7, 0x92EA, 0x92E4,
+	0x92C1, 0x92B3, 0x92BC, 0x92D2, 0x92C7, 0x92F0, 0x92B2, 0x95AD, 0x95B1, 0x9704, 0x9706, 0x9707,
+	0x9709, 0x9760, 0x978D, 0x978B, 0x978F, 0x9821, 0x982B, 0x981C, 0x98B3, 0x990A, 0x9913, 0x9912,
+	0x9918, 0x99DD, 0x99D0, 0x99DF, 0x99DB, 0x99D1, 0x99D5, 0x99D2, 0x99D9, 0x9AB7,
+	// row 80
+	0x9AEE, 0x9AEF, 0x9B27, 0x9B45, 0x9B44, 0x9B77, 0x9B6F, 0x9D06, 0x9D09, 0x9D03, 0x9EA9, 0x9EBE,
+	0x9ECE, 0x58A8, 0x9F52, 0x5112, 0x5118, 0x5114, 0x5110, 0x5115, 0x5180, 0x51AA, 0x51DD, 0x5291,
+	0x5293, 0x52F3, 0x5659, 0x566B, 0x5679, 0x5669, 0x5664, 0x5678, 0x566A, 0x5668, 0x5665, 0x5671,
+	0x566F, 0x566C, 0x5662, 0x5676, 0x58C1, 0x58BE, 0x58C7, 0x58C5, 0x596E, 0x5B1D, 0x5B34, 0x5B78,
+	0x5BF0, 0x5C0E, 0x5F4A, 0x61B2, 0x6191, 0x61A9, 0x618A, 0x61CD, 0x61B6, 0x61BE, 0x61CA, 0x61C8,
+	0x6230, 0x64C5, 0x64C1, 0x64CB, 0x64BB, 0x64BC, 0x64DA, 0x64C4, 0x64C7, 0x64C2, 0x64CD, 0x64BF,
+	0x64D2, 0x64D4, 0x64BE, 0x6574, 0x66C6, 0x66C9, 0x66B9, 0x66C4, 0x66C7, 0x66B8, 0x6A3D, 0x6A38,
+	0x6A3A, 0x6A59, 0x6A6B, 0x6A58, 0x6A39, 0x6A44, 0x6A62, 0x6A61, 0x6A4B, 0x6A47,
+	// row 81
+	0x6A35, 0x6A5F, 0x6A48, 0x6B59, 0x6B77, 0x6C05, 0x6FC2, 0x6FB1, 0x6FA1, 0x6FC3, 0x6FA4, 0x6FC1,
+	0x6FA7, 0x6FB3, 0x6FC0, 0x6FB9, 0x6FB6, 0x6FA6, 0x6FA0, 0x6FB4, 0x71BE, 0x71C9, 0x71D0, 0x71D2,
+	0x71C8, 0x71D5, 0x71B9, 0x71CE, 0x71D9, 0x71DC, 0x71C3, 0x71C4, 0x7368, 0x749C, 0x74A3, 0x7498,
+	0x749F, 0x749E, 0x74E2, 0x750C, 0x750D, 0x7634, 0x7638, 0x763A, 0x76E7, 0x76E5, 0x77A0, 0x779E,
+	0x779F, 0x77A5, 0x78E8, 0x78DA, 0x78EC, 0x78E7, 0x79A6, 0x7A4D, 0x7A4E, 0x7A46, 0x7A4C, 0x7A4B,
+	0x7ABA, 0x7BD9, 0x7C11, 0x7BC9, 0x7BE4, 0x7BDB, 0x7BE1, 0x7BE9, 0x7BE6, 0x7CD5, 0x7CD6, 0x7E0A,
+	0x7E11, 0x7E08, 0x7E1B, 0x7E23, 0x7E1E, 0x7E1D, 0x7E09, 0x7E10, 0x7F79, 0x7FB2, 0x7FF0, 0x7FF1,
+	0x7FEE, 0x8028, 0x81B3, 0x81A9, 0x81A8, 0x81FB, 0x8208, 0x8258, 0x8259, 0x854A,
+	// row 82
+	0x8559, 0x8548, 0x8568, 0x8569, 0x8543, 0x8549, 0x856D, 0x856A, 0x855E, 0x8783, 0x879F, 0x879E,
+	0x87A2, 0x878D, 0x8861, 0x892A, 0x8932, 0x8925, 0x892B, 0x8921, 0x89AA, 0x89A6, 0x8AE6, 0x8AFA,
+	0x8AEB, 0x8AF1, 0x8B00, 0x8ADC, 0x8AE7, 0x8AEE, 0x8AFE, 0x8B01, 0x8B02, 0x8AF7, 0x8AED, 0x8AF3,
+	0x8AF6, 0x8AFC, 0x8C6B, 0x8C6D, 0x8C93, 0x8CF4, 0x8E44, 0x8E31, 0x8E34, 0x8E42, 0x8E39, 0x8E35,
+	0x8F3B, 0x8F2F, 0x8F38, 0x8F33, 0x8FA8, 0x8FA6, 0x9075, 0x9074, 0x9078, 0x9072, 0x907C, 0x907A,
+	0x9134, 0x9192, 0x9320, 0x9336, 0x92F8, 0x9333, 0x932F, 0x9322, 0x92FC, 0x932B, 0x9304, 0x931A,
+	0x9310, 0x9326, 0x9321, 0x9315, 0x932E, 0x9319, 0x95BB, 0x96A7, 0x96A8, 0x96AA, 0x96D5, 0x970E,
+	0x9711, 0x9716, 0x970D, 0x9713, 0x970F, 0x975B, 0x975C, 0x9766, 0x9798, 0x9830,
+	// row 83
+	0x9838, 0x983B, 0x9837, 0x982D, 0x9839, 0x9824, 0x9910, 0x9928, 0x991E, 0x991B, 0x9921, 0x991A,
+	0x99ED, 0x99E2, 0x99F1, 0x9AB8, 0x9ABC, 0x9AFB, 0x9AED, 0x9B28, 0x9B91, 0x9D15, 0x9D23, 0x9D26,
+	0x9D28, 0x9D12, 0x9D1B, 0x9ED8, 0x9ED4, 0x9F8D, 0x9F9C, 0x512A, 0x511F, 0x5121, 0x5132, 0x52F5,
+	0x568E, 0x5680, 0x5690, 0x5685, 0x5687, 0x568F, 0x58D5, 0x58D3, 0x58D1, 0x58CE, 0x5B30, 0x5B2A,
+	0x5B24, 0x5B7A, 0x5C37, 0x5C68, 0x5DBC, 0x5DBA, 0x5DBD, 0x5DB8, 0x5E6B, 0x5F4C, 0x5FBD, 0x61C9,
+	0x61C2, 0x61C7, 0x61E6, 0x61CB, 0x6232, 0x6234, 0x64CE, 0x64CA, 0x64D8, 0x64E0, 0x64F0, 0x64E6,
+	0x64EC, 0x64F1, 0x64E2, 0x64ED, 0x6582, 0x6583, 0x66D9, 0x66D6, 0x6A80, 0x6A94, 0x6A84, 0x6AA2,
+	0x6A9C, 0x6ADB, 0x6AA3, 0x6A7E, 0x6A97, 0x6A90, 0x6AA0, 0x6B5C, 0x6BAE, 0x6BDA,
+	// row 84
+	0x6C08, 0x6FD8, 0x6FF1, 0x6FDF, 0x6FE0, 0x6FDB, 0x6FE4, 0x6FEB, 0x6FEF, 0x6F80, 0x6FEC, 0x6FE1,
+	0x6FE9, 0x6FD5, 0x6FEE, 0x6FF0, 0x71E7, 0x71DF, 0x71EE, 0x71E6, 0x71E5, 0x71ED, 0x71EC, 0x71F4,
+	0x71E0, 0x7235, 0x7246, 0x7370, 0x7372, 0x74A9, 0x74B0, 0x74A6, 0x74A8, 0x7646, 0x7642, 0x764C,
+	0x76EA, 0x77B3, 0x77AA, 0x77B0, 0x77AC, 0x77A7, 0x77AD, 0x77EF, 0x78F7, 0x78FA, 0x78F4, 0x78EF,
+	0x7901, 0x79A7, 0x79AA, 0x7A57, 0x7ABF, 0x7C07, 0x7C0D, 0x7BFE, 0x7BF7, 0x7C0C, 0x7BE0, 0x7CE0,
+	0x7CDC, 0x7CDE, 0x7CE2, 0x7CDF, 0x7CD9, 0x7CDD, 0x7E2E, 0x7E3E, 0x7E46, 0x7E37, 0x7E32, 0x7E43,
+	0x7E2B, 0x7E3D, 0x7E31, 0x7E45, 0x7E41, 0x7E34, 0x7E39, 0x7E48, 0x7E35, 0x7E3F, 0x7E2F, 0x7F44,
+	0x7FF3, 0x7FFC, 0x8071, 0x8072, 0x8070, 0x806F, 0x8073, 0x81C6, 0x81C3, 0x81BA,
+	// row 85
+	0x81C2, 0x81C0, 0x81BF, 0x81BD, 0x81C9, 0x81BE, 0x81E8, 0x8209, 0x8271, 0x85AA, 0x8584, 0x857E,
+	0x859C, 0x8591, 0x8594, 0x85AF, 0x859B, 0x8587, 0x85A8, 0x858A, 0x85A6, 0x8667, 0x87C0, 0x87D1,
+	0x87B3, 0x87D2, 0x87C6, 0x87AB, 0x87BB, 0x87BA, 0x87C8, 0x87CB, 0x893B, 0x8936, 0x8944, 0x8938,
+	0x893D, 0x89AC, 0x8B0E, 0x8B17, 0x8B19, 0x8B1B, 0x8B0A, 0x8B20, 0x8B1D, 0x8B04, 0x8B10, 0x8C41,
+	0x8C3F, 0x8C73, 0x8CFA, 0x8CFD, 0x8CFC, 0x8CF8, 0x8CFB, 0x8DA8, 0x8E49, 0x8E4B, 0x8E48, 0x8E4A,
+	0x8F44, 0x8F3E, 0x8F42, 0x8F45, 0x8F3F, 0x907F, 0x907D, 0x9084, 0x9081, 0x9082, 0x9080, 0x9139,
+	0x91A3, 0x919E, 0x919C, 0x934D, 0x9382, 0x9328, 0x9375, 0x934A, 0x9365, 0x934B, 0x9318, 0x937E,
+	0x936C, 0x935B, 0x9370, 0x935A, 0x9354, 0x95CA, 0x95CB, 0x95CC, 0x95C8, 0x95C6,
+	// row 86
+	0x96B1, 0x96B8, 0x96D6, 0x971C, 0x971E, 0x97A0, 0x97D3, 0x9846, 0x98B6, 0x9935, 0x9A01, 0x99FF,
+	0x9BAE, 0x9BAB, 0x9BAA, 0x9BAD, 0x9D3B, 0x9D3F, 0x9E8B, 0x9ECF, 0x9EDE, 0x9EDC, 0x9EDD, 0x9EDB,
+	0x9F3E, 0x9F4B, 0x53E2, 0x5695, 0x56AE, 0x58D9, 0x58D8, 0x5B38, 0x5F5E, 0x61E3, 0x6233, 0x64F4,
+	0x64F2, 0x64FE, 0x6506, 0x64FA, 0x64FB, 0x64F7, 0x65B7, 0x66DC, 0x6726, 0x6AB3, 0x6AAC, 0x6AC3,
+	0x6ABB, 0x6AB8, 0x6AC2, 0x6AAE, 0x6AAF, 0x6B5F, 0x6B78, 0x6BAF, 0x7009, 0x700B, 0x6FFE, 0x7006,
+	0x6FFA, 0x7011, 0x700F, 0x71FB, 0x71FC, 0x71FE, 0x71F8, 0x7377, 0x7375, 0x74A7, 0x74BF, 0x7515,
+	0x7656, 0x7658, 0x7652, 0x77BD, 0x77BF, 0x77BB, 0x77BC, 0x790E, 0x79AE, 0x7A61, 0x7A62, 0x7A60,
+	0x7AC4, 0x7AC5, 0x7C2B, 0x7C27, 0x7C2A, 0x7C1E, 0x7C23, 0x7C21, 0x7CE7, 0x7E54,
+	// row 87
+	0x7E55, 0x7E5E, 0x7E5A, 0x7E61, 0x7E52, 0x7E59, 0x7F48, 0x7FF9, 0x7FFB, 0x8077, 0x8076, 0x81CD,
+	0x81CF, 0x820A, 0x85CF, 0x85A9, 0x85CD, 0x85D0, 0x85C9, 0x85B0, 0x85BA, 0x85B9, 0x87EF, 0x87EC,
+	0x87F2, 0x87E0, 0x8986, 0x89B2, 0x89F4, 0x8B28, 0x8B39, 0x8B2C, 0x8B2B, 0x8C50, 0x8D05, 0x8E59,
+	0x8E63, 0x8E66, 0x8E64, 0x8E5F, 0x8E55, 0x8EC0, 0x8F49, 0x8F4D, 0x9087, 0x9083, 0x9088, 0x91AB,
+	0x91AC, 0x91D0, 0x9394, 0x938A, 0x9396, 0x93A2, 0x93B3, 0x93AE, 0x93AC, 0x93B0, 0x9398, 0x939A,
+	0x9397, 0x95D4, 0x95D6, 0x95D0, 0x95D5, 0x96E2, 0x96DC, 0x96D9, 0x96DB, 0x96DE, 0x9724, 0x97A3,
+	0x97A6, 0x97AD, 0x97F9, 0x984D, 0x984F, 0x984C, 0x984E, 0x9853, 0x98BA, 0x993E, 0x993F, 0x993D,
+	0x992E, 0x99A5, 0x9A0E, 0x9AC1, 0x9B03, 0x9B06, 0x9B4F, 0x9B4E, 0x9B4D, 0x9BCA,
+	// row 88
+	0x9BC9, 0x9BFD, 0x9BC8, 0x9BC0, 0x9D51, 0x9D5D, 0x9D60, 0x9EE0, 0x9F15, 0x9F2C, 0x5133, 0x56A5,
+	0x56A8, 0x58DE, 0x58DF, 0x58E2, 0x5BF5, 0x9F90, 0x5EEC, 0x61F2, 0x61F7, 0x61F6, 0x61F5, 0x6500,
+	0x650F, 0x66E0, 0x66DD, 0x6AE5, 0x6ADD, 0x6ADA, 0x6AD3, 0x701B, 0x701F, 0x7028, 0x701A, 0x701D,
+	0x7015, 0x7018, 0x7206, 0x720D, 0x7258, 0x72A2, 0x7378, 0x737A, 0x74BD, 0x74CA, 0x74E3, 0x7587,
+	0x7586, 0x765F, 0x7661, 0x77C7, 0x7919, 0x79B1, 0x7A6B, 0x7A69, 0x7C3E, 0x7C3F, 0x7C38, 0x7C3D,
+	0x7C37, 0x7C40, 0x7E6B, 0x7E6D, 0x7E79, 0x7E69, 0x7E6A, 0x7E73, 0x7F85, 0x7FB6, 0x7FB9, 0x7FB8,
+	0x81D8, 0x85E9, 0x85DD, 0x85EA, 0x85D5, 0x85E4, 0x85E5, 0x85F7, 0x87FB, 0x8805, 0x880D, 0x87F9,
+	0x87FE, 0x8960, 0x895F, 0x8956, 0x895E, 0x8B41, 0x8B5C, 0x8B58, 0x8B49, 0x8B5A,
+	// row 89
+	0x8B4E, 0x8B4F, 0x8B46, 0x8B59, 0x8D08, 0x8D0A, 0x8E7C, 0x8E72, 0x8E87, 0x8E76, 0x8E6C, 0x8E7A,
+	0x8E74, 0x8F54, 0x8F4E, 0x8FAD, 0x908A, 0x908B, 0x91B1, 0x91AE, 0x93E1, 0x93D1, 0x93DF, 0x93C3,
+	0x93C8, 0x93DC, 0x93DD, 0x93D6, 0x93E2, 0x93CD, 0x93D8, 0x93E4, 0x93D7, 0x93E8, 0x95DC, 0x96B4,
+	0x96E3, 0x972A, 0x9727, 0x9761, 0x97DC, 0x97FB, 0x985E, 0x9858, 0x985B, 0x98BC, 0x9945, 0x9949,
+	0x9A16, 0x9A19, 0x9B0D, 0x9BE8, 0x9BE7, 0x9BD6, 0x9BDB, 0x9D89, 0x9D61, 0x9D72, 0x9D6A, 0x9D6C,
+	0x9E92, 0x9E97, 0x9E93, 0x9EB4, 0x52F8, 0x56B7, 0x56B6, 0x56B4, 0x56BC, 0x58E4, 0x5B40, 0x5B43,
+	0x5B7D, 0x5BF6, 0x5DC9, 0x61F8, 0x61FA, 0x6518, 0x6514, 0x6519, 0x66E6, 0x6727, 0x6AEC, 0x703E,
+	0x7030, 0x7032, 0x7210, 0x737B, 0x74CF, 0x7662, 0x7665, 0x7926, 0x792A, 0x792C,
+	// row 90
+	0x792B, 0x7AC7, 0x7AF6, 0x7C4C, 0x7C43, 0x7C4D, 0x7CEF, 0x7CF0, 0x8FAE, 0x7E7D, 0x7E7C, 0x7E82,
+	0x7F4C, 0x8000, 0x81DA, 0x8266, 0x85FB, 0x85F9, 0x8611, 0x85FA, 0x8606, 0x860B, 0x8607, 0x860A,
+	0x8814, 0x8815, 0x8964, 0x89BA, 0x89F8, 0x8B70, 0x8B6C, 0x8B66, 0x8B6F, 0x8B5F, 0x8B6B, 0x8D0F,
+	0x8D0D, 0x8E89, 0x8E81, 0x8E85, 0x8E82, 0x91B4, 0x91CB, 0x9418, 0x9403, 0x93FD, 0x95E1, 0x9730,
+	0x98C4, 0x9952, 0x9951, 0x99A8, 0x9A2B, 0x9A30, 0x9A37, 0x9A35, 0x9C13, 0x9C0D, 0x9E79, 0x9EB5,
+	0x9EE8, 0x9F2F, 0x9F5F, 0x9F63, 0x9F61, 0x5137, 0x5138, 0x56C1, 0x56C0, 0x56C2, 0x5914, 0x5C6C,
+	0x5DCD, 0x61FC, 0x61FE, 0x651D, 0x651C, 0x6595, 0x66E9, 0x6AFB, 0x6B04, 0x6AFA, 0x6BB2, 0x704C,
+	0x721B, 0x72A7, 0x74D6, 0x74D4, 0x7669, 0x77D3, 0x7C50, 0x7E8F, 0x7E8C, 0x7FBC,
+	// row 91
+	0x8617, 0x862D, 0x861A, 0x8823, 0x8822, 0x8821, 0x881F, 0x896A, 0x896C, 0x89BD, 0x8B74, 0x8B77,
+	0x8B7D, 0x8D13, 0x8E8A, 0x8E8D, 0x8E8B, 0x8F5F, 0x8FAF, 0x91BA, 0x942E, 0x9433, 0x9435, 0x943A,
+	0x9438, 0x9432, 0x942B, 0x95E2, 0x9738, 0x9739, 0x9732, 0x97FF, 0x9867, 0x9865, 0x9957, 0x9A45,
+	0x9A43, 0x9A40, 0x9A3E, 0x9ACF, 0x9B54, 0x9B51, 0x9C2D, 0x9C25, 0x9DAF, 0x9DB4, 0x9DC2, 0x9DB8,
+	0x9E9D, 0x9EEF, 0x9F19, 0x9F5C, 0x9F66, 0x9F67, 0x513C, 0x513B, 0x56C8, 0x56CA, 0x56C9, 0x5B7F,
+	0x5DD4, 0x5DD2, 0x5F4E, 0x61FF, 0x6524, 0x6B0A, 0x6B61, 0x7051, 0x7058, 0x7380, 0x74E4, 0x758A,
+	0x766E, 0x766C, 0x79B3, 0x7C60, 0x7C5F, 0x807E, 0x807D, 0x81DF, 0x8972, 0x896F, 0x89FC, 0x8B80,
+	0x8D16, 0x8D17, 0x8E91, 0x8E93, 0x8F61, 0x9148, 0x9444, 0x9451, 0x9452, 0x973D,
+	// row 92
+	0x973E, 0x97C3, 0x97C1, 0x986B, 0x9955, 0x9A55, 0x9A4D, 0x9AD2, 0x9B1A, 0x9C49, 0x9C31, 0x9C3E,
+	0x9C3B, 0x9DD3, 0x9DD7, 0x9F34, 0x9F6C, 0x9F6A, 0x9F94, 0x56CC, 0x5DD6, 0x6200, 0x6523, 0x652B,
+	0x652A, 0x66EC, 0x6B10, 0x74DA, 0x7ACA, 0x7C64, 0x7C63, 0x7C65, 0x7E93, 0x7E96, 0x7E94, 0x81E2,
+	0x8638, 0x863F, 0x8831, 0x8B8A, 0x9090, 0x908F, 0x9463, 0x9460, 0x9464, 0x9768, 0x986F, 0x995C,
+	0x9A5A, 0x9A5B, 0x9A57, 0x9AD3, 0x9AD4, 0x9AD1, 0x9C54, 0x9C57, 0x9C56, 0x9DE5, 0x9E9F, 0x9EF4,
+	0x56D1, 0x58E9, 0x652C, 0x705E, 0x7671, 0x7672, 0x77D7, 0x7F50, 0x7F88, 0x8836, 0x8839, 0x8862,
+	0x8B93, 0x8B92, 0x8B96, 0x8277, 0x8D1B, 0x91C0, 0x946A, 0x9742, 0x9748, 0x9744, 0x97C6, 0x9870,
+	0x9A5F, 0x9B22, 0x9B58, 0x9C5F, 0x9DF9, 0x9DFA, 0x9E7C, 0x9E7D, 0x9F07, 0x9F77,
+	// row 93
+	0x9F72, 0x5EF3, 0x6B16, 0x7063, 0x7C6C, 0x7C6E, 0x883B, 0x89C0, 0x8EA1, 0x91C1, 0x9472, 0x9470,
+	0x9871, 0x995E, 0x9AD6, 0x9B23, 0x9ECC, 0x7064, 0x77DA, 0x8B9A, 0x9477, 0x97C9, 0x9A62, 0x9A65,
+	0x7E9C, 0x8B9C, 0x8EAA, 0x91C5, 0x947D, 0x947E, 0x947C, 0x9C77, 0x9C78, 0x9EF7, 0x8C54, 0x947F,
+	0x9E1A, 0x7228, 0x9A6A, 0x9B31, 0x9E1B, 0x9E1E, 0x7C72, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 94
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+}
+
+var plane2 = [94 * 94]uint16 {
+	// row 1
+	0x4E42, 0x4E5C, 0x51F5, 0x531A, 0x5382, 0x4E07, 0x4E0C, 0x4E47, 0x4E8D, 0x56D7, 0x5C6E, 0x5F73,
+	0x4E0F, 0x5187, 0x4E0E, 0x4E2E, 0x4E93, 0x4EC2, 0x4EC9, 0x4EC8, 0x5198, 0x52FC, 0x536C, 0x53B9,
+	0x5720, 0x5903, 0x592C, 0x5C10, 0x5DFF, 0x65E1, 0x6BB3, 0x6BCC, 0x6C14, 0x723F, 0x4E31, 0x4E3C,
+	0x4EE8, 0x4EDC, 0x4EE9, 0x4EE1, 0x4EDD, 0x4EDA, 0x520C, 0x5209, 0x531C, 0x534C, 0x5722, 0x5723,
+	0x5917, 0x592F, 0x5B81, 0x5B84, 0x5C12, 0x5C3B, 0x5C74, 0x5C73, 0x5E04, 0x5E80, 0x5E82, 0x5FC9,
+	0x6209, 0x6250, 0x6C15, 0x6C36, 0x6C43, 0x6C3F, 0x6C3B, 0x72AE, 0x72B0, 0x738A, 0x79B8, 0x808A,
+	0x961E, 0x4F0E, 0x4F18, 0x4F2C, 0x4EF5, 0x4F14, 0x4EF1, 0x4F00, 0x4EF7, 0x4F08, 0x4F1D, 0x4F02,
+	0x4F05, 0x4F22, 0x4F13, 0x4F04, 0x4EF4, 0x4F12, 0x51B1, 0x5213, 0x5210, 0x52A6,
+	// row 2
+	0x5322, 0x531F, 0x534D, 0x538A, 0x5407, 0x56E1, 0x56DF, 0x572E, 0x572A, 0x5734, 0x593C, 0x5980,
+	0x597C, 0x5985, 0x597B, 0x597E, 0x5977, 0x597F, 0x5B56, 0x5C15, 0x5C25, 0x5C7C, 0x5C7A, 0x5C7B,
+	0x5C7E, 0x5DDF, 0x5E75, 0x5E84, 0x5F02, 0x5F1A, 0x5F74, 0x5FD5, 0x5FD4, 0x5FCF, 0x625C, 0x625E,
+	0x6264, 0x6261, 0x6266, 0x6262, 0x6259, 0x6260, 0x625A, 0x6265, 0x6537, 0x65EF, 0x65EE, 0x673E,
+	0x6739, 0x6738, 0x673B, 0x673A, 0x673F, 0x673C, 0x6733, 0x6C18, 0x6C46, 0x6C52, 0x6C5C, 0x6C4F,
+	0x6C4A, 0x6C54, 0x6C4B, 0x6C4C, 0x7071, 0x725E, 0x72B4, 0x72B5, 0x738E, 0x752A, 0x767F, 0x7A75,
+	0x7F51, 0x8278, 0x827C, 0x8280, 0x827D, 0x827F, 0x864D, 0x897E, 0x9099, 0x9097, 0x9098, 0x909B,
+	0x9094, 0x9622, 0x9624, 0x9620, 0x9623, 0x4F56, 0x4F3B, 0x4F62, 0x4F49, 0x4F53,
+	// row 3
+	0x4F64, 0x4F3E, 0x4F67, 0x4F52, 0x4F5F, 0x4F41, 0x4F58, 0x4F2D, 0x4F33, 0x4F3F, 0x4F61, 0x518F,
+	0x51B9, 0x521C, 0x521E, 0x5221, 0x52AD, 0x52AE, 0x5309, 0x5363, 0x5372, 0x538E, 0x538F, 0x5430,
+	0x5437, 0x542A, 0x5454, 0x5445, 0x5419, 0x541C, 0x5425, 0x5418, 0x543D, 0x544F, 0x5441, 0x5428,
+	0x5424, 0x5447, 0x56EE, 0x56E7, 0x56E5, 0x5741, 0x5745, 0x574C, 0x5749, 0x574B, 0x5752, 0x5906,
+	0x5940, 0x59A6, 0x5998, 0x59A0, 0x5997, 0x598E, 0x59A2, 0x5990, 0x598F, 0x59A7, 0x59A1, 0x5B8E,
+	0x5B92, 0x5C28, 0x5C2A, 0x5C8D, 0x5C8F, 0x5C88, 0x5C8B, 0x5C89, 0x5C92, 0x5C8A, 0x5C86, 0x5C93,
+	0x5C95, 0x5DE0, 0x5E0A, 0x5E0E, 0x5E8B, 0x5E89, 0x5E8C, 0x5E88, 0x5E8D, 0x5F05, 0x5F1D, 0x5F78,
+	0x5F76, 0x5FD2, 0x5FD1, 0x5FD0, 0x5FED, 0x5FE8, 0x5FEE, 0x5FF3, 0x5FE1, 0x5FE4,
+	// row 4
+	0x5FE3, 0x5FFA, 0x5FEF, 0x5FF7, 0x5FFB, 0x6000, 0x5FF4, 0x623A, 0x6283, 0x628C, 0x628E, 0x628F,
+	0x6294, 0x6287, 0x6271, 0x627B, 0x627A, 0x6270, 0x6281, 0x6288, 0x6277, 0x627D, 0x6272, 0x6274,
+	0x65F0, 0x65F4, 0x65F3, 0x65F2, 0x65F5, 0x6745, 0x6747, 0x6759, 0x6755, 0x674C, 0x6748, 0x675D,
+	0x674D, 0x675A, 0x674B, 0x6BD0, 0x6C19, 0x6C1A, 0x6C78, 0x6C67, 0x6C6B, 0x6C84, 0x6C8B, 0x6C8F,
+	0x6C71, 0x6C6F, 0x6C69, 0x6C9A, 0x6C6D, 0x6C87, 0x6C95, 0x6C9C, 0x6C66, 0x6C73, 0x6C65, 0x6C7B,
+	0x6C8E, 0x7074, 0x707A, 0x7263, 0x72BF, 0x72BD, 0x72C3, 0x72C6, 0x72C1, 0x72BA, 0x72C5, 0x7395,
+	0x7397, 0x7393, 0x7394, 0x7392, 0x753A, 0x7539, 0x7594, 0x7595, 0x7681, 0x793D, 0x8034, 0x8095,
+	0x8099, 0x8090, 0x8092, 0x809C, 0x8290, 0x828F, 0x8285, 0x828E, 0x8291, 0x8293,
+	// row 5
+	0x828A, 0x8283, 0x8284, 0x8C78, 0x8FC9, 0x8FBF, 0x909F, 0x90A1, 0x90A5, 0x909E, 0x90A7, 0x90A0,
+	0x9630, 0x9628, 0x962F, 0x962D, 0x4E33, 0x4F98, 0x4F7C, 0x4F85, 0x4F7D, 0x4F80, 0x4F87, 0x4F76,
+	0x4F74, 0x4F89, 0x4F84, 0x4F77, 0x4F4C, 0x4F97, 0x4F6A, 0x4F9A, 0x4F79, 0x4F81, 0x4F78, 0x4F90,
+	0x4F9C, 0x4F94, 0x4F9E, 0x4F92, 0x4F82, 0x4F95, 0x4F6B, 0x4F6E, 0x519E, 0x51BC, 0x51BE, 0x5235,
+	0x5232, 0x5233, 0x5246, 0x5231, 0x52BC, 0x530A, 0x530B, 0x533C, 0x5392, 0x5394, 0x5487, 0x547F,
+	0x5481, 0x5491, 0x5482, 0x5488, 0x546B, 0x547A, 0x547E, 0x5465, 0x546C, 0x5474, 0x5466, 0x548D,
+	0x546F, 0x5461, 0x5460, 0x5498, 0x5463, 0x5467, 0x5464, 0x56F7, 0x56F9, 0x576F, 0x5772, 0x576D,
+	0x576B, 0x5771, 0x5770, 0x5776, 0x5780, 0x5775, 0x577B, 0x5773, 0x5774, 0x5762,
+	// row 6
+	0x5768, 0x577D, 0x590C, 0x5945, 0x59B5, 0x59BA, 0x59CF, 0x59CE, 0x59B2, 0x59CC, 0x59C1, 0x59B6,
+	0x59BC, 0x59C3, 0x59D6, 0x59B1, 0x59BD, 0x59C0, 0x59C8, 0x59B4, 0x59C7, 0x5B62, 0x5B65, 0x5B93,
+	0x5B95, 0x5C44, 0x5C47, 0x5CAE, 0x5CA4, 0x5CA0, 0x5CB5, 0x5CAF, 0x5CA8, 0x5CAC, 0x5C9F, 0x5CA3,
+	0x5CAD, 0x5CA2, 0x5CAA, 0x5CA7, 0x5C9D, 0x5CA5, 0x5CB6, 0x5CB0, 0x5CA6, 0x5E17, 0x5E14, 0x5E19,
+	0x5F28, 0x5F22, 0x5F23, 0x5F24, 0x5F54, 0x5F82, 0x5F7E, 0x5F7D, 0x5FDE, 0x5FE5, 0x602D, 0x6026,
+	0x6019, 0x6032, 0x600B, 0x6034, 0x600A, 0x6017, 0x6033, 0x601A, 0x601E, 0x602C, 0x6022, 0x600D,
+	0x6010, 0x602E, 0x6013, 0x6011, 0x600C, 0x6009, 0x601C, 0x6214, 0x623D, 0x62AD, 0x62B4, 0x62D1,
+	0x62BE, 0x62AA, 0x62B6, 0x62CA, 0x62AE, 0x62B3, 0x62AF, 0x62BB, 0x62A9, 0x62B0,
+	// row 7
+	0x62B8, 0x653D, 0x65A8, 0x65BB, 0x6609, 0x65FC, 0x6604, 0x6612, 0x6608, 0x65FB, 0x6603, 0x660B,
+	0x660D, 0x6605, 0x65FD, 0x6611, 0x6610, 0x66F6, 0x670A, 0x6785, 0x676C, 0x678E, 0x6792, 0x6776,
+	0x677B, 0x6798, 0x6786, 0x6784, 0x6774, 0x678D, 0x678C, 0x677A, 0x679F, 0x6791, 0x6799, 0x6783,
+	0x677D, 0x6781, 0x6778, 0x6779, 0x6794, 0x6B25, 0x6B80, 0x6B7E, 0x6BDE, 0x6C1D, 0x6C93, 0x6CEC,
+	0x6CEB, 0x6CEE, 0x6CD9, 0x6CB6, 0x6CD4, 0x6CAD, 0x6CE7, 0x6CB7, 0x6CD0, 0x6CC2, 0x6CBA, 0x6CC3,
+	0x6CC6, 0x6CED, 0x6CF2, 0x6CD2, 0x6CDD, 0x6CB4, 0x6C8A, 0x6C9D, 0x6C80, 0x6CDE, 0x6CC0, 0x6D30,
+	0x6CCD, 0x6CC7, 0x6CB0, 0x6CF9, 0x6CCF, 0x6CE9, 0x6CD1, 0x7094, 0x7098, 0x7085, 0x7093, 0x7086,
+	0x7084, 0x7091, 0x7096, 0x7082, 0x709A, 0x7083, 0x726A, 0x72D6, 0x72CB, 0x72D8,
+	// row 8
+	0x72C9, 0x72DC, 0x72D2, 0x72D4, 0x72DA, 0x72CC, 0x72D1, 0x73A4, 0x73A1, 0x73AD, 0x73A6, 0x73A2,
+	0x73A0, 0x73AC, 0x739D, 0x74DD, 0x74E8, 0x753F, 0x7540, 0x753E, 0x758C, 0x7598, 0x76AF, 0x76F3,
+	0x76F1, 0x76F0, 0x76F5, 0x77F8, 0x77FC, 0x77F9, 0x77FB, 0x77FA, 0x77F7, 0x7942, 0x793F, 0x79C5,
+	0x7A78, 0x7A7B, 0x7AFB, 0x7C75, 0x7CFD, 0x8035, 0x808F, 0x80AE, 0x80A3, 0x80B8, 0x80B5, 0x80AD,
+	0x8220, 0x82A0, 0x82C0, 0x82AB, 0x829A, 0x8298, 0x829B, 0x82B5, 0x82A7, 0x82AE, 0x82BC, 0x829E,
+	0x82BA, 0x82B4, 0x82A8, 0x82A1, 0x82A9, 0x82C2, 0x82A4, 0x82C3, 0x82B6, 0x82A2, 0x8670, 0x866F,
+	0x866D, 0x866E, 0x8C56, 0x8FD2, 0x8FCB, 0x8FD3, 0x8FCD, 0x8FD6, 0x8FD5, 0x8FD7, 0x90B2, 0x90B4,
+	0x90AF, 0x90B3, 0x90B0, 0x9639, 0x963D, 0x963C, 0x963A, 0x9643, 0x4FCD, 0x4FC5,
+	// row 9
+	0x4FD3, 0x4FB2, 0x4FC9, 0x4FCB, 0x4FC1, 0x4FD4, 0x4FDC, 0x4FD9, 0x4FBB, 0x4FB3, 0x4FDB, 0x4FC7,
+	0x4FD6, 0x4FBA, 0x4FC0, 0x4FB9, 0x4FEC, 0x5244, 0x5249, 0x52C0, 0x52C2, 0x533D, 0x537C, 0x5397,
+	0x5396, 0x5399, 0x5398, 0x54BA, 0x54A1, 0x54AD, 0x54A5, 0x54CF, 0x54C3, 0x830D, 0x54B7, 0x54AE,
+	0x54D6, 0x54B6, 0x54C5, 0x54C6, 0x54A0, 0x5470, 0x54BC, 0x54A2, 0x54BE, 0x5472, 0x54DE, 0x54B0,
+	0x57B5, 0x579E, 0x579F, 0x57A4, 0x578C, 0x5797, 0x579D, 0x579B, 0x5794, 0x5798, 0x578F, 0x5799,
+	0x57A5, 0x579A, 0x5795, 0x58F4, 0x590D, 0x5953, 0x59E1, 0x59DE, 0x59EE, 0x5A00, 0x59F1, 0x59DD,
+	0x59FA, 0x59FD, 0x59FC, 0x59F6, 0x59E4, 0x59F2, 0x59F7, 0x59DB, 0x59E9, 0x59F3, 0x59F5, 0x59E0,
+	0x59FE, 0x59F4, 0x59ED, 0x5BA8, 0x5C4C, 0x5CD0, 0x5CD8, 0x5CCC, 0x5CD7, 0x5CCB,
+	// row 10
+	0x5CDB, 0x5CDE, 0x5CDA, 0x5CC9, 0x5CC7, 0x5CCA, 0x5CD6, 0x5CD3, 0x5CD4, 0x5CCF, 0x5CC8, 0x5CC6,
+	0x5CCE, 0x5CDF, 0x5CF8, 0x5DF9, 0x5E21, 0x5E22, 0x5E23, 0x5E20, 0x5E24, 0x5EB0, 0x5EA4, 0x5EA2,
+	0x5E9B, 0x5EA3, 0x5EA5, 0x5F07, 0x5F2E, 0x5F56, 0x5F86, 0x6037, 0x6039, 0x6054, 0x6072, 0x605E,
+	0x6045, 0x6053, 0x6047, 0x6049, 0x605B, 0x604C, 0x6040, 0x6042, 0x605F, 0x6024, 0x6044, 0x6058,
+	0x6066, 0x606E, 0x6242, 0x6243, 0x62CF, 0x630D, 0x630B, 0x62F5, 0x630E, 0x6303, 0x62EB, 0x62F9,
+	0x630F, 0x630C, 0x62F8, 0x62F6, 0x6300, 0x6313, 0x6314, 0x62FA, 0x6315, 0x62FB, 0x62F0, 0x6541,
+	0x6543, 0x65AA, 0x65BF, 0x6636, 0x6621, 0x6632, 0x6635, 0x661C, 0x6626, 0x6622, 0x6633, 0x662B,
+	0x663A, 0x661D, 0x6634, 0x6639, 0x662E, 0x670F, 0x6710, 0x67C1, 0x67F2, 0x67C8,
+	// row 11
+	0x67BA, 0x67DC, 0x67BB, 0x67F8, 0x67D8, 0x67C0, 0x67B7, 0x67C5, 0x67EB, 0x67E4, 0x67DF, 0x67B5,
+	0x67CD, 0x67B3, 0x67F7, 0x67F6, 0x67EE, 0x67E3, 0x67C2, 0x67B9, 0x67CE, 0x67E7, 0x67F0, 0x67B2,
+	0x67FC, 0x67C6, 0x67ED, 0x67CC, 0x67AE, 0x67E6, 0x67DB, 0x67FA, 0x67C9, 0x67CA, 0x67C3, 0x67EA,
+	0x67CB, 0x6B28, 0x6B82, 0x6B84, 0x6BB6, 0x6BD6, 0x6BD8, 0x6BE0, 0x6C20, 0x6C21, 0x6D28, 0x6D34,
+	0x6D2D, 0x6D1F, 0x6D3C, 0x6D3F, 0x6D12, 0x6D0A, 0x6CDA, 0x6D33, 0x6D04, 0x6D19, 0x6D3A, 0x6D1A,
+	0x6D11, 0x6D00, 0x6D1D, 0x6D42, 0x6D01, 0x6D18, 0x6D37, 0x6D03, 0x6D0F, 0x6D40, 0x6D07, 0x6D20,
+	0x6D2C, 0x6D08, 0x6D22, 0x6D09, 0x6D10, 0x70B7, 0x709F, 0x70BE, 0x70B1, 0x70B0, 0x70A1, 0x70B4,
+	0x70B5, 0x70A9, 0x7241, 0x7249, 0x724A, 0x726C, 0x7270, 0x7273, 0x726E, 0x72CA,
+	// row 12
+	0x72E4, 0x72E8, 0x72EB, 0x72DF, 0x72EA, 0x72E6, 0x72E3, 0x7385, 0x73CC, 0x73C2, 0x73C8, 0x73C5,
+	0x73B9, 0x73B6, 0x73B5, 0x73B4, 0x73EB, 0x73BF, 0x73C7, 0x73BE, 0x73C3, 0x73C6, 0x73B8, 0x73CB,
+	0x74EC, 0x74EE, 0x752E, 0x7547, 0x7548, 0x75A7, 0x75AA, 0x7679, 0x76C4, 0x7708, 0x7703, 0x7704,
+	0x7705, 0x770A, 0x76F7, 0x76FB, 0x76FA, 0x77E7, 0x77E8, 0x7806, 0x7811, 0x7812, 0x7805, 0x7810,
+	0x780F, 0x780E, 0x7809, 0x7803, 0x7813, 0x794A, 0x794C, 0x794B, 0x7945, 0x7944, 0x79D5, 0x79CD,
+	0x79CF, 0x79D6, 0x79CE, 0x7A80, 0x7A7E, 0x7AD1, 0x7B00, 0x7B01, 0x7C7A, 0x7C78, 0x7C79, 0x7C7F,
+	0x7C80, 0x7C81, 0x7D03, 0x7D08, 0x7D01, 0x7F58, 0x7F91, 0x7F8D, 0x7FBE, 0x8007, 0x800E, 0x800F,
+	0x8014, 0x8037, 0x80D8, 0x80C7, 0x80E0, 0x80D1, 0x80C8, 0x80C2, 0x80D0, 0x80C5,
+	// row 13
+	0x80E3, 0x80D9, 0x80DC, 0x80CA, 0x80D5, 0x80C9, 0x80CF, 0x80D7, 0x80E6, 0x80CD, 0x81FF, 0x8221,
+	0x8294, 0x82D9, 0x82FE, 0x82F9, 0x8307, 0x82E8, 0x8300, 0x82D5, 0x833A, 0x82EB, 0x82D6, 0x82F4,
+	0x82EC, 0x82E1, 0x82F2, 0x82F5, 0x830C, 0x82FB, 0x82F6, 0x82F0, 0x82EA, 0x82E4, 0x82E0, 0x82FA,
+	0x82F3, 0x82ED, 0x8677, 0x8674, 0x867C, 0x8673, 0x8841, 0x884E, 0x8867, 0x886A, 0x8869, 0x89D3,
+	0x8A04, 0x8A07, 0x8D72, 0x8FE3, 0x8FE1, 0x8FEE, 0x8FE0, 0x90F1, 0x90BD, 0x90BF, 0x90D5, 0x90C5,
+	0x90BE, 0x90C7, 0x90CB, 0x90C8, 0x91D4, 0x91D3, 0x9654, 0x964F, 0x9651, 0x9653, 0x964A, 0x964E,
+	0x501E, 0x5005, 0x5007, 0x5013, 0x5022, 0x5030, 0x501B, 0x4FF5, 0x4FF4, 0x5033, 0x5037, 0x502C,
+	0x4FF6, 0x4FF7, 0x5017, 0x501C, 0x5020, 0x5027, 0x5035, 0x502F, 0x5031, 0x500E,
+	// row 14
+	0x515A, 0x5194, 0x5193, 0x51CA, 0x51C4, 0x51C5, 0x51C8, 0x51CE, 0x5261, 0x525A, 0x5252, 0x525E,
+	0x525F, 0x5255, 0x5262, 0x52CD, 0x530E, 0x539E, 0x5526, 0x54E2, 0x5517, 0x5512, 0x54E7, 0x54F3,
+	0x54E4, 0x551A, 0x54FF, 0x5504, 0x5508, 0x54EB, 0x5511, 0x5505, 0x54F1, 0x550A, 0x54FB, 0x54F7,
+	0x54F8, 0x54E0, 0x550E, 0x5503, 0x550B, 0x5701, 0x5702, 0x57CC, 0x5832, 0x57D5, 0x57D2, 0x57BA,
+	0x57C6, 0x57BD, 0x57BC, 0x57B8, 0x57B6, 0x57BF, 0x57C7, 0x57D0, 0x57B9, 0x57C1, 0x590E, 0x594A,
+	0x5A19, 0x5A16, 0x5A2D, 0x5A2E, 0x5A15, 0x5A0F, 0x5A17, 0x5A0A, 0x5A1E, 0x5A33, 0x5B6C, 0x5BA7,
+	0x5BAD, 0x5BAC, 0x5C03, 0x5C56, 0x5C54, 0x5CEC, 0x5CFF, 0x5CEE, 0x5CF1, 0x5CF7, 0x5D00, 0x5CF9,
+	0x5E29, 0x5E28, 0x5EA8, 0x5EAE, 0x5EAA, 0x5EAC, 0x5F33, 0x5F30, 0x5F67, 0x605D,
+	// row 15
+	0x605A, 0x6067, 0x6041, 0x60A2, 0x6088, 0x6080, 0x6092, 0x6081, 0x609D, 0x6083, 0x6095, 0x609B,
+	0x6097, 0x6087, 0x609C, 0x608E, 0x6219, 0x6246, 0x62F2, 0x6310, 0x6356, 0x632C, 0x6344, 0x6345,
+	0x6336, 0x6343, 0x63E4, 0x6339, 0x634B, 0x634A, 0x633C, 0x6329, 0x6341, 0x6334, 0x6358, 0x6354,
+	0x6359, 0x632D, 0x6347, 0x6333, 0x635A, 0x6351, 0x6338, 0x6357, 0x6340, 0x6348, 0x654A, 0x6546,
+	0x65C6, 0x65C3, 0x65C4, 0x65C2, 0x664A, 0x665F, 0x6647, 0x6651, 0x6712, 0x6713, 0x681F, 0x681A,
+	0x6849, 0x6832, 0x6833, 0x683B, 0x684B, 0x684F, 0x6816, 0x6831, 0x681C, 0x6835, 0x682B, 0x682D,
+	0x682F, 0x684E, 0x6844, 0x6834, 0x681D, 0x6812, 0x6814, 0x6826, 0x6828, 0x682E, 0x684D, 0x683A,
+	0x6825, 0x6820, 0x6B2C, 0x6B2F, 0x6B2D, 0x6B31, 0x6B34, 0x6B6D, 0x8082, 0x6B88,
+	// row 16
+	0x6BE6, 0x6BE4, 0x6BE8, 0x6BE3, 0x6BE2, 0x6BE7, 0x6C25, 0x6D7A, 0x6D63, 0x6D64, 0x6D76, 0x6D0D,
+	0x6D61, 0x6D92, 0x6D58, 0x6D62, 0x6D6D, 0x6D6F, 0x6D91, 0x6D8D, 0x6DEF, 0x6D7F, 0x6D86, 0x6D5E,
+	0x6D67, 0x6D60, 0x6D97, 0x6D70, 0x6D7C, 0x6D5F, 0x6D82, 0x6D98, 0x6D2F, 0x6D68, 0x6D8B, 0x6D7E,
+	0x6D80, 0x6D84, 0x6D16, 0x6D83, 0x6D7B, 0x6D7D, 0x6D75, 0x6D90, 0x70DC, 0x70D3, 0x70D1, 0x70DD,
+	0x70CB, 0x7F39, 0x70E2, 0x70D7, 0x70D2, 0x70DE, 0x70E0, 0x70D4, 0x70CD, 0x70C5, 0x70C6, 0x70C7,
+	0x70DA, 0x70CE, 0x70E1, 0x7242, 0x7278, 0x7277, 0x7276, 0x7300, 0x72FA, 0x72F4, 0x72FE, 0x72F6,
+	0x72F3, 0x72FB, 0x7301, 0x73D3, 0x73D9, 0x73E5, 0x73D6, 0x73BC, 0x73E7, 0x73E3, 0x73E9, 0x73DC,
+	0x73D2, 0x73DB, 0x73D4, 0x73DD, 0x73DA, 0x73D7, 0x73D8, 0x73E8, 0x74DE, 0x74DF,
+	// row 17
+	0x74F4, 0x74F5, 0x7521, 0x755B, 0x755F, 0x75B0, 0x75C1, 0x75BB, 0x75C4, 0x75C0, 0x75BF, 0x75B6,
+	0x75BA, 0x768A, 0x76C9, 0x771D, 0x771B, 0x7710, 0x7713, 0x7712, 0x7723, 0x7711, 0x7715, 0x7719,
+	0x771A, 0x7722, 0x7727, 0x7823, 0x782C, 0x7822, 0x7835, 0x782F, 0x7828, 0x782E, 0x782B, 0x7821,
+	0x7829, 0x7833, 0x782A, 0x7831, 0x7954, 0x795B, 0x794F, 0x795C, 0x7953, 0x7952, 0x7951, 0x79EB,
+	0x79EC, 0x79E0, 0x79EE, 0x79ED, 0x79EA, 0x79DC, 0x79DE, 0x79DD, 0x7A86, 0x7A89, 0x7A85, 0x7A8B,
+	0x7A8C, 0x7A8A, 0x7A87, 0x7AD8, 0x7B10, 0x7B04, 0x7B13, 0x7B05, 0x7B0F, 0x7B08, 0x7B0A, 0x7B0E,
+	0x7B09, 0x7B12, 0x7C84, 0x7C91, 0x7C8A, 0x7C8C, 0x7C88, 0x7C8D, 0x7C85, 0x7D1E, 0x7D1D, 0x7D11,
+	0x7D0E, 0x7D18, 0x7D16, 0x7D13, 0x7D1F, 0x7D12, 0x7D0F, 0x7D0C, 0x7F5C, 0x7F61,
+	// row 18
+	0x7F5E, 0x7F60, 0x7F5D, 0x7F5B, 0x7F96, 0x7F92, 0x7FC3, 0x7FC2, 0x7FC0, 0x8016, 0x803E, 0x8039,
+	0x80FA, 0x80F2, 0x80F9, 0x80F5, 0x8101, 0x80FB, 0x8100, 0x8201, 0x822F, 0x8225, 0x8333, 0x832D,
+	0x8344, 0x8319, 0x8351, 0x8325, 0x8356, 0x833F, 0x8341, 0x8326, 0x831C, 0x8322, 0x8342, 0x834E,
+	0x831B, 0x832A, 0x8308, 0x833C, 0x834D, 0x8316, 0x8324, 0x8320, 0x8337, 0x832F, 0x8329, 0x8347,
+	0x8345, 0x834C, 0x8353, 0x831E, 0x832C, 0x834B, 0x8327, 0x8348, 0x8653, 0x8652, 0x86A2, 0x86A8,
+	0x8696, 0x868D, 0x8691, 0x869E, 0x8687, 0x8697, 0x8686, 0x868B, 0x869A, 0x8685, 0x86A5, 0x8699,
+	0x86A1, 0x86A7, 0x8695, 0x8698, 0x868E, 0x869D, 0x8690, 0x8694, 0x8843, 0x8844, 0x886D, 0x8875,
+	0x8876, 0x8872, 0x8880, 0x8871, 0x887F, 0x886F, 0x8883, 0x887E, 0x8874, 0x887C,
+	// row 19
+	0x8A12, 0x8C47, 0x8C57, 0x8C7B, 0x8CA4, 0x8CA3, 0x8D76, 0x8D78, 0x8DB5, 0x8DB7, 0x8DB6, 0x8ED1,
+	0x8ED3, 0x8FFE, 0x8FF5, 0x9002, 0x8FFF, 0x8FFB, 0x9004, 0x8FFC, 0x8FF6, 0x90D6, 0x90E0, 0x90D9,
+	0x90DA, 0x90E3, 0x90DF, 0x90E5, 0x90D8, 0x90DB, 0x90D7, 0x90DC, 0x90E4, 0x9150, 0x914E, 0x914F,
+	0x91D5, 0x91E2, 0x91DA, 0x965C, 0x965F, 0x96BC, 0x98E3, 0x9ADF, 0x9B2F, 0x4E7F, 0x5070, 0x506A,
+	0x5061, 0x505E, 0x5060, 0x5053, 0x504B, 0x505D, 0x5072, 0x5048, 0x504D, 0x5041, 0x505B, 0x504A,
+	0x5062, 0x5015, 0x5045, 0x505F, 0x5069, 0x506B, 0x5063, 0x5064, 0x5046, 0x5040, 0x506E, 0x5073,
+	0x5057, 0x5051, 0x51D0, 0x526B, 0x526D, 0x526C, 0x526E, 0x52D6, 0x52D3, 0x532D, 0x539C, 0x5575,
+	0x5576, 0x553C, 0x554D, 0x5550, 0x5534, 0x552A, 0x5551, 0x5562, 0x5536, 0x5535,
+	// row 20
+	0x5530, 0x5552, 0x5545, 0x550C, 0x5532, 0x5565, 0x554E, 0x5539, 0x5548, 0x552D, 0x553B, 0x5540,
+	0x554B, 0x570A, 0x5707, 0x57FB, 0x5814, 0x57E2, 0x57F6, 0x57DC, 0x57F4, 0x5800, 0x57ED, 0x57FD,
+	0x5808, 0x57F8, 0x580B, 0x57F3, 0x57CF, 0x5807, 0x57EE, 0x57E3, 0x57F2, 0x57E5, 0x57EC, 0x57E1,
+	0x580E, 0x57FC, 0x5810, 0x57E7, 0x5801, 0x580C, 0x57F1, 0x57E9, 0x57F0, 0x580D, 0x5804, 0x595C,
+	0x5A60, 0x5A58, 0x5A55, 0x5A67, 0x5A5E, 0x5A38, 0x5A35, 0x5A6D, 0x5A50, 0x5A5F, 0x5A65, 0x5A6C,
+	0x5A53, 0x5A64, 0x5A57, 0x5A43, 0x5A5D, 0x5A52, 0x5A44, 0x5A5B, 0x5A48, 0x5A8E, 0x5A3E, 0x5A4D,
+	0x5A39, 0x5A4C, 0x5A70, 0x5A69, 0x5A47, 0x5A51, 0x5A56, 0x5A42, 0x5A5C, 0x5B72, 0x5B6E, 0x5BC1,
+	0x5BC0, 0x5C59, 0x5D1E, 0x5D0B, 0x5D1D, 0x5D1A, 0x5D20, 0x5D0C, 0x5D28, 0x5D0D,
+	// row 21
+	0x5D26, 0x5D25, 0x5D0F, 0x5D30, 0x5D12, 0x5D23, 0x5D1F, 0x5D2E, 0x5E3E, 0x5E34, 0x5EB1, 0x5EB4,
+	0x5EB9, 0x5EB2, 0x5EB3, 0x5F36, 0x5F38, 0x5F9B, 0x5F96, 0x5F9F, 0x608A, 0x6090, 0x6086, 0x60BE,
+	0x60B0, 0x60BA, 0x60D3, 0x60D4, 0x60CF, 0x60E4, 0x60D9, 0x60DD, 0x60C8, 0x60B1, 0x60DB, 0x60B7,
+	0x60CA, 0x60BF, 0x60C3, 0x60CD, 0x60C0, 0x6332, 0x6365, 0x638A, 0x6382, 0x637D, 0x63BD, 0x639E,
+	0x63AD, 0x639D, 0x6397, 0x63AB, 0x638E, 0x636F, 0x6387, 0x6390, 0x636E, 0x63AF, 0x6375, 0x639C,
+	0x636D, 0x63AE, 0x637C, 0x63A4, 0x633B, 0x639F, 0x6378, 0x6385, 0x6381, 0x6391, 0x638D, 0x6370,
+	0x6553, 0x65CD, 0x6665, 0x6661, 0x665B, 0x6659, 0x665C, 0x6662, 0x6718, 0x6879, 0x6887, 0x6890,
+	0x689C, 0x686D, 0x686E, 0x68AE, 0x68AB, 0x6956, 0x686F, 0x68A3, 0x68AC, 0x68A9,
+	// row 22
+	0x6875, 0x6874, 0x68B2, 0x688F, 0x6877, 0x6892, 0x687C, 0x686B, 0x6872, 0x68AA, 0x6880, 0x6871,
+	0x687E, 0x689B, 0x6896, 0x688B, 0x68A0, 0x6889, 0x68A4, 0x6878, 0x687B, 0x6891, 0x688C, 0x688A,
+	0x687D, 0x6B36, 0x6B33, 0x6B37, 0x6B38, 0x6B91, 0x6B8F, 0x6B8D, 0x6B8E, 0x6B8C, 0x6C2A, 0x6DC0,
+	0x6DAB, 0x6DB4, 0x6DB3, 0x6E74, 0x6DAC, 0x6DE9, 0x6DE2, 0x6DB7, 0x6DF6, 0x6DD4, 0x6E00, 0x6DC8,
+	0x6DE0, 0x6DDF, 0x6DD6, 0x6DBE, 0x6DE5, 0x6DDC, 0x6DDD, 0x6DDB, 0x6DF4, 0x6DCA, 0x6DBD, 0x6DED,
+	0x6DF0, 0x6DBA, 0x6DD5, 0x6DC2, 0x6DCF, 0x6DC9, 0x6DD0, 0x6DF2, 0x6DD3, 0x6DFD, 0x6DD7, 0x6DCD,
+	0x6DE3, 0x6DBB, 0x70FA, 0x710D, 0x70F7, 0x7117, 0x70F4, 0x710C, 0x70F0, 0x7104, 0x70F3, 0x7110,
+	0x70FC, 0x70FF, 0x7106, 0x7113, 0x7100, 0x70F8, 0x70F6, 0x710B, 0x7102, 0x710E,
+	// row 23
+	0x727E, 0x727B, 0x727C, 0x727F, 0x731D, 0x7317, 0x7307, 0x7311, 0x7318, 0x730A, 0x7308, 0x72FF,
+	0x730F, 0x731E, 0x7388, 0x73F6, 0x73F8, 0x73F5, 0x7404, 0x7401, 0x73FD, 0x7407, 0x7400, 0x73FA,
+	0x73FC, 0x73FF, 0x740C, 0x740B, 0x73F4, 0x7408, 0x7564, 0x7563, 0x75CE, 0x75D2, 0x75CF, 0x75CB,
+	0x75CC, 0x75D1, 0x75D0, 0x768F, 0x7689, 0x76D3, 0x7739, 0x772F, 0x772D, 0x7731, 0x7732, 0x7734,
+	0x7733, 0x773D, 0x7725, 0x773B, 0x7735, 0x7848, 0x7852, 0x7849, 0x784D, 0x784A, 0x784C, 0x7826,
+	0x7845, 0x7850, 0x7964, 0x7967, 0x7969, 0x796A, 0x7963, 0x796B, 0x7961, 0x79BB, 0x79FA, 0x79F8,
+	0x79F6, 0x79F7, 0x7A8F, 0x7A94, 0x7A90, 0x7B35, 0x7B3B, 0x7B34, 0x7B25, 0x7B30, 0x7B22, 0x7B24,
+	0x7B33, 0x7B18, 0x7B2A, 0x7B1D, 0x7B31, 0x7B2B, 0x7B2D, 0x7B2F, 0x7B32, 0x7B38,
+	// row 24
+	0x7B1A, 0x7B23, 0x7C94, 0x7C98, 0x7C96, 0x7CA3, 0x7D35, 0x7D3D, 0x7D38, 0x7D36, 0x7D3A, 0x7D45,
+	0x7D2C, 0x7D29, 0x7D41, 0x7D47, 0x7D3E, 0x7D3F, 0x7D4A, 0x7D3B, 0x7D28, 0x7F63, 0x7F95, 0x7F9C,
+	0x7F9D, 0x7F9B, 0x7FCA, 0x7FCB, 0x7FCD, 0x7FD0, 0x7FD1, 0x7FC7, 0x7FCF, 0x7FC9, 0x801F, 0x801E,
+	0x801B, 0x8047, 0x8043, 0x8048, 0x8118, 0x8125, 0x8119, 0x811B, 0x812D, 0x811F, 0x812C, 0x811E,
+	0x8121, 0x8115, 0x8127, 0x811D, 0x8122, 0x8211, 0x8238, 0x8233, 0x823A, 0x8234, 0x8232, 0x8274,
+	0x8390, 0x83A3, 0x83A8, 0x838D, 0x837A, 0x8373, 0x83A4, 0x8374, 0x838F, 0x8381, 0x8395, 0x8399,
+	0x8375, 0x8394, 0x83A9, 0x837D, 0x8383, 0x838C, 0x839D, 0x839B, 0x83AA, 0x838B, 0x837E, 0x83A5,
+	0x83AF, 0x8388, 0x8397, 0x83B0, 0x837F, 0x83A6, 0x8387, 0x83AE, 0x8376, 0x8659,
+	// row 25
+	0x8656, 0x86BF, 0x86B7, 0x86C2, 0x86C1, 0x86C5, 0x86BA, 0x86B0, 0x86C8, 0x86B9, 0x86B3, 0x86B8,
+	0x86CC, 0x86B4, 0x86BB, 0x86BC, 0x86C3, 0x86BD, 0x86BE, 0x8852, 0x8889, 0x8895, 0x88A8, 0x88A2,
+	0x88AA, 0x889A, 0x8891, 0x88A1, 0x889F, 0x8898, 0x88A7, 0x8899, 0x889B, 0x8897, 0x88A4, 0x88AC,
+	0x888C, 0x8893, 0x888E, 0x8982, 0x89D6, 0x89D9, 0x89D5, 0x8A30, 0x8A27, 0x8A2C, 0x8A1E, 0x8C39,
+	0x8C3B, 0x8C5C, 0x8C5D, 0x8C7D, 0x8CA5, 0x8D7D, 0x8D7B, 0x8D79, 0x8DBC, 0x8DC2, 0x8DB9, 0x8DBF,
+	0x8DC1, 0x8ED8, 0x8EDE, 0x8EDD, 0x8EDC, 0x8ED7, 0x8EE0, 0x8EE1, 0x9024, 0x900B, 0x9011, 0x901C,
+	0x900C, 0x9021, 0x90EF, 0x90EA, 0x90F0, 0x90F4, 0x90F2, 0x90F3, 0x90D4, 0x90EB, 0x90EC, 0x90E9,
+	0x9156, 0x9158, 0x915A, 0x9153, 0x9155, 0x91EC, 0x91F4, 0x91F1, 0x91F3, 0x91F8,
+	// row 26
+	0x91E4, 0x91F9, 0x91EA, 0x91EB, 0x91F7, 0x91E8, 0x91EE, 0x957A, 0x9586, 0x9588, 0x967C, 0x966D,
+	0x966B, 0x9671, 0x966F, 0x96BF, 0x976A, 0x9804, 0x98E5, 0x9997, 0x509B, 0x5095, 0x5094, 0x509E,
+	0x508B, 0x50A3, 0x5083, 0x508C, 0x508E, 0x509D, 0x5068, 0x509C, 0x5092, 0x5082, 0x5087, 0x515F,
+	0x51D4, 0x5312, 0x5311, 0x53A4, 0x53A7, 0x5591, 0x55A8, 0x55A5, 0x55AD, 0x5577, 0x5645, 0x55A2,
+	0x5593, 0x5588, 0x558F, 0x55B5, 0x5581, 0x55A3, 0x5592, 0x55A4, 0x557D, 0x558C, 0x55A6, 0x557F,
+	0x5595, 0x55A1, 0x558E, 0x570C, 0x5829, 0x5837, 0x5819, 0x581E, 0x5827, 0x5823, 0x5828, 0x57F5,
+	0x5848, 0x5825, 0x581C, 0x581B, 0x5833, 0x583F, 0x5836, 0x582E, 0x5839, 0x5838, 0x582D, 0x582C,
+	0x583B, 0x5961, 0x5AAF, 0x5A94, 0x5A9F, 0x5A7A, 0x5AA2, 0x5A9E, 0x5A78, 0x5AA6,
+	// row 27
+	0x5A7C, 0x5AA5, 0x5AAC, 0x5A95, 0x5AAE, 0x5A37, 0x5A84, 0x5A8A, 0x5A97, 0x5A83, 0x5A8B, 0x5AA9,
+	0x5A7B, 0x5A7D, 0x5A8C, 0x5A9C, 0x5A8F, 0x5A93, 0x5A9D, 0x5BEA, 0x5BCD, 0x5BCB, 0x5BD4, 0x5BD1,
+	0x5BCA, 0x5BCE, 0x5C0C, 0x5C30, 0x5D37, 0x5D43, 0x5D6B, 0x5D41, 0x5D4B, 0x5D3F, 0x5D35, 0x5D51,
+	0x5D4E, 0x5D55, 0x5D33, 0x5D3A, 0x5D52, 0x5D3D, 0x5D31, 0x5D59, 0x5D42, 0x5D39, 0x5D49, 0x5D38,
+	0x5D3C, 0x5D32, 0x5D36, 0x5D40, 0x5D45, 0x5E44, 0x5E41, 0x5F58, 0x5FA6, 0x5FA5, 0x5FAB, 0x60C9,
+	0x60B9, 0x60CC, 0x60E2, 0x60CE, 0x60C4, 0x6114, 0x60F2, 0x610A, 0x6116, 0x6105, 0x60F5, 0x6113,
+	0x60F8, 0x60FC, 0x60FE, 0x60C1, 0x6103, 0x6118, 0x611D, 0x6110, 0x60FF, 0x6104, 0x610B, 0x624A,
+	0x6394, 0x63B1, 0x63B0, 0x63CE, 0x63E5, 0x63E8, 0x63EF, 0x63C3, 0x649D, 0x63F3,
+	// row 28
+	0x63CA, 0x63E0, 0x63F6, 0x63D5, 0x63F2, 0x63F5, 0x6461, 0x63DF, 0x63BE, 0x63DD, 0x63DC, 0x63C4,
+	0x63D8, 0x63D3, 0x63C2, 0x63C7, 0x63CC, 0x63CB, 0x63C8, 0x63F0, 0x63D7, 0x63D9, 0x6532, 0x6567,
+	0x656A, 0x6564, 0x655C, 0x6568, 0x6565, 0x658C, 0x659D, 0x659E, 0x65AE, 0x65D0, 0x65D2, 0x667C,
+	0x666C, 0x667B, 0x6680, 0x6671, 0x6679, 0x666A, 0x6672, 0x6701, 0x690C, 0x68D3, 0x6904, 0x68DC,
+	0x692A, 0x68EC, 0x68EA, 0x68F1, 0x690F, 0x68D6, 0x68F7, 0x68EB, 0x68E4, 0x68F6, 0x6913, 0x6910,
+	0x68F3, 0x68E1, 0x6907, 0x68CC, 0x6908, 0x6970, 0x68B4, 0x6911, 0x68EF, 0x68C6, 0x6914, 0x68F8,
+	0x68D0, 0x68FD, 0x68FC, 0x68E8, 0x690B, 0x690A, 0x6917, 0x68CE, 0x68C8, 0x68DD, 0x68DE, 0x68E6,
+	0x68F4, 0x68D1, 0x6906, 0x68D4, 0x68E9, 0x6915, 0x6925, 0x68C7, 0x6B39, 0x6B3B,
+	// row 29
+	0x6B3F, 0x6B3C, 0x6B94, 0x6B97, 0x6B99, 0x6B95, 0x6BBD, 0x6BF0, 0x6BF2, 0x6BF3, 0x6C30, 0x6DFC,
+	0x6E46, 0x6E47, 0x6E1F, 0x6E49, 0x6E88, 0x6E3C, 0x6E3D, 0x6E45, 0x6E62, 0x6E2B, 0x6E3F, 0x6E41,
+	0x6E5D, 0x6E73, 0x6E1C, 0x6E33, 0x6E4B, 0x6E40, 0x6E51, 0x6E3B, 0x6E03, 0x6E2E, 0x6E5E, 0x6E68,
+	0x6E5C, 0x6E61, 0x6E31, 0x6E28, 0x6E60, 0x6E71, 0x6E6B, 0x6E39, 0x6E22, 0x6E30, 0x6E53, 0x6E65,
+	0x6E27, 0x6E78, 0x6E64, 0x6E77, 0x6E55, 0x6E79, 0x6E52, 0x6E66, 0x6E35, 0x6E36, 0x6E5A, 0x7120,
+	0x711E, 0x712F, 0x70FB, 0x712E, 0x7131, 0x7123, 0x7125, 0x7122, 0x7132, 0x711F, 0x7128, 0x713A,
+	0x711B, 0x724B, 0x725A, 0x7288, 0x7289, 0x7286, 0x7285, 0x728B, 0x7312, 0x730B, 0x7330, 0x7322,
+	0x7331, 0x7333, 0x7327, 0x7332, 0x732D, 0x7326, 0x7323, 0x7335, 0x730C, 0x742E,
+	// row 30
+	0x742C, 0x7430, 0x742B, 0x7416, 0x741A, 0x7421, 0x742D, 0x7431, 0x7424, 0x7423, 0x741D, 0x7429,
+	0x7420, 0x7432, 0x74FB, 0x752F, 0x756F, 0x756C, 0x75E7, 0x75DA, 0x75E1, 0x75E6, 0x75DD, 0x75DF,
+	0x75E4, 0x75D7, 0x7695, 0x7692, 0x76DA, 0x7746, 0x7747, 0x7744, 0x774D, 0x7745, 0x774A, 0x774E,
+	0x774B, 0x774C, 0x77DE, 0x77EC, 0x7860, 0x7864, 0x7865, 0x785C, 0x786D, 0x7871, 0x786A, 0x786E,
+	0x7870, 0x7869, 0x7868, 0x785E, 0x7862, 0x7974, 0x7973, 0x7972, 0x7970, 0x7A02, 0x7A0A, 0x7A03,
+	0x7A0C, 0x7A04, 0x7A99, 0x7AE6, 0x7AE4, 0x7B4A, 0x7B47, 0x7B44, 0x7B48, 0x7B4C, 0x7B4E, 0x7B40,
+	0x7B58, 0x7B45, 0x7CA2, 0x7C9E, 0x7CA8, 0x7CA1, 0x7D58, 0x7D6F, 0x7D63, 0x7D53, 0x7D56, 0x7D67,
+	0x7D6A, 0x7D4F, 0x7D6D, 0x7D5C, 0x7D6B, 0x7D52, 0x7D54, 0x7D69, 0x7D51, 0x7D5F,
+	// row 31
+	0x7D4E, 0x7F3E, 0x7F3F, 0x7F65, 0x7F66, 0x7FA2, 0x7FA0, 0x7FA1, 0x7FD7, 0x8051, 0x804F, 0x8050,
+	0x80FE, 0x80D4, 0x8143, 0x814A, 0x8152, 0x814F, 0x8147, 0x813D, 0x814D, 0x813A, 0x81E6, 0x81EE,
+	0x81F7, 0x81F8, 0x81F9, 0x8204, 0x823C, 0x823D, 0x823F, 0x8275, 0x833B, 0x83CF, 0x83F9, 0x8423,
+	0x83C0, 0x83E8, 0x8412, 0x83E7, 0x83E4, 0x83FC, 0x83F6, 0x8410, 0x83C6, 0x83C8, 0x83EB, 0x83E3,
+	0x83BF, 0x8401, 0x83DD, 0x83E5, 0x83D8, 0x83FF, 0x83E1, 0x83CB, 0x83CE, 0x83D6, 0x83F5, 0x83C9,
+	0x8409, 0x840F, 0x83DE, 0x8411, 0x8406, 0x83C2, 0x83F3, 0x83D5, 0x83FA, 0x83C7, 0x83D1, 0x83EA,
+	0x8413, 0x839A, 0x83C3, 0x83EC, 0x83EE, 0x83C4, 0x83FB, 0x83D7, 0x83E2, 0x841B, 0x83DB, 0x83FE,
+	0x86D8, 0x86E2, 0x86E6, 0x86D3, 0x86E3, 0x86DA, 0x86EA, 0x86DD, 0x86EB, 0x86DC,
+	// row 32
+	0x86EC, 0x86E9, 0x86D7, 0x86E8, 0x86D1, 0x8848, 0x8856, 0x8855, 0x88BA, 0x88D7, 0x88B9, 0x88B8,
+	0x88C0, 0x88BE, 0x88B6, 0x88BC, 0x88B7, 0x88BD, 0x88B2, 0x8901, 0x88C9, 0x8995, 0x8998, 0x8997,
+	0x89DD, 0x89DA, 0x89DB, 0x8A4E, 0x8A4D, 0x8A39, 0x8A59, 0x8A40, 0x8A57, 0x8A58, 0x8A44, 0x8A45,
+	0x8A52, 0x8A48, 0x8A51, 0x8A4A, 0x8A4C, 0x8A4F, 0x8C5F, 0x8C81, 0x8C80, 0x8CBA, 0x8CBE, 0x8CB0,
+	0x8CB9, 0x8CB5, 0x8D84, 0x8D80, 0x8D89, 0x8DD8, 0x8DD3, 0x8DCD, 0x8DC7, 0x8DD6, 0x8DDC, 0x8DCF,
+	0x8DD5, 0x8DD9, 0x8DC8, 0x8DD7, 0x8DC5, 0x8EEF, 0x8EF7, 0x8EFA, 0x8EF9, 0x8EE6, 0x8EEE, 0x8EE5,
+	0x8EF5, 0x8EE7, 0x8EE8, 0x8EF6, 0x8EEB, 0x8EF1, 0x8EEC, 0x8EF4, 0x8EE9, 0x902D, 0x9034, 0x902F,
+	0x9106, 0x912C, 0x9104, 0x90FF, 0x90FC, 0x9108, 0x90F9, 0x90FB, 0x9101, 0x9100,
+	// row 33
+	0x9107, 0x9105, 0x9103, 0x9161, 0x9164, 0x915F, 0x9162, 0x9160, 0x9201, 0x920A, 0x9225, 0x9203,
+	0x921A, 0x9226, 0x920F, 0x920C, 0x9200, 0x9212, 0x91FF, 0x91FD, 0x9206, 0x9204, 0x9227, 0x9202,
+	0x921C, 0x9224, 0x9219, 0x9217, 0x9205, 0x9216, 0x957B, 0x958D, 0x958C, 0x9590, 0x9687, 0x967E,
+	0x9688, 0x9689, 0x9683, 0x9680, 0x96C2, 0x96C8, 0x96C3, 0x96F1, 0x96F0, 0x976C, 0x9770, 0x976E,
+	0x9807, 0x98A9, 0x98EB, 0x9CE6, 0x9EF9, 0x4E83, 0x4E84, 0x4EB6, 0x50BD, 0x50BF, 0x50C6, 0x50AE,
+	0x50C4, 0x50CA, 0x50B4, 0x50C8, 0x50C2, 0x50B0, 0x50C1, 0x50BA, 0x50B1, 0x50CB, 0x50C9, 0x50B6,
+	0x50B8, 0x51D7, 0x527A, 0x5278, 0x527B, 0x527C, 0x55C3, 0x55DB, 0x55CC, 0x55D0, 0x55CB, 0x55CA,
+	0x55DD, 0x55C0, 0x55D4, 0x55C4, 0x55E9, 0x55BF, 0x55D2, 0x558D, 0x55CF, 0x55D5,
+	// row 34
+	0x55E2, 0x55D6, 0x55C8, 0x55F2, 0x55CD, 0x55D9, 0x55C2, 0x5714, 0x5853, 0x5868, 0x5864, 0x584F,
+	0x584D, 0x5849, 0x586F, 0x5855, 0x584E, 0x585D, 0x5859, 0x5865, 0x585B, 0x583D, 0x5863, 0x5871,
+	0x58FC, 0x5AC7, 0x5AC4, 0x5ACB, 0x5ABA, 0x5AB8, 0x5AB1, 0x5AB5, 0x5AB0, 0x5ABF, 0x5AC8, 0x5ABB,
+	0x5AC6, 0x5AB7, 0x5AC0, 0x5ACA, 0x5AB4, 0x5AB6, 0x5ACD, 0x5AB9, 0x5A90, 0x5BD6, 0x5BD8, 0x5BD9,
+	0x5C1F, 0x5C33, 0x5D71, 0x5D63, 0x5D4A, 0x5D65, 0x5D72, 0x5D6C, 0x5D5E, 0x5D68, 0x5D67, 0x5D62,
+	0x5DF0, 0x5E4F, 0x5E4E, 0x5E4A, 0x5E4D, 0x5E4B, 0x5EC5, 0x5ECC, 0x5EC6, 0x5ECB, 0x5EC7, 0x5F40,
+	0x5FAF, 0x5FAD, 0x60F7, 0x6149, 0x614A, 0x612B, 0x6145, 0x6136, 0x6132, 0x612E, 0x6146, 0x612F,
+	0x614F, 0x6129, 0x6140, 0x6220, 0x9168, 0x6223, 0x6225, 0x6224, 0x63C5, 0x63F1,
+	// row 35
+	0x63EB, 0x6410, 0x6412, 0x6409, 0x6420, 0x6424, 0x6433, 0x6443, 0x641F, 0x6415, 0x6418, 0x6439,
+	0x6437, 0x6422, 0x6423, 0x640C, 0x6426, 0x6430, 0x6428, 0x6441, 0x6435, 0x642F, 0x640A, 0x641A,
+	0x6440, 0x6425, 0x6427, 0x640B, 0x63E7, 0x641B, 0x642E, 0x6421, 0x640E, 0x656F, 0x6592, 0x65D3,
+	0x6686, 0x668C, 0x6695, 0x6690, 0x668B, 0x668A, 0x6699, 0x6694, 0x6678, 0x6720, 0x6966, 0x695F,
+	0x6938, 0x694E, 0x6962, 0x6971, 0x693F, 0x6945, 0x696A, 0x6939, 0x6942, 0x6957, 0x6959, 0x697A,
+	0x6948, 0x6949, 0x6935, 0x696C, 0x6933, 0x693D, 0x6965, 0x68F0, 0x6978, 0x6934, 0x6969, 0x6940,
+	0x696F, 0x6944, 0x6976, 0x6958, 0x6941, 0x6974, 0x694C, 0x693B, 0x694B, 0x6937, 0x695C, 0x694F,
+	0x6951, 0x6932, 0x6952, 0x692F, 0x697B, 0x693C, 0x6B46, 0x6B45, 0x6B43, 0x6B42,
+	// row 36
+	0x6B48, 0x6B41, 0x6B9B, 0x6BFB, 0x6BFC, 0x6BF9, 0x6BF7, 0x6BF8, 0x6E9B, 0x6ED6, 0x6EC8, 0x6E8F,
+	0x6EC0, 0x6E9F, 0x6E93, 0x6E94, 0x6EA0, 0x6EB1, 0x6EB9, 0x6EC6, 0x6ED2, 0x6EBD, 0x6EC1, 0x6E9E,
+	0x6EC9, 0x6EB7, 0x6EB0, 0x6ECD, 0x6EA6, 0x6ECF, 0x6EB2, 0x6EBE, 0x6EC3, 0x6EDC, 0x6ED8, 0x6E99,
+	0x6E92, 0x6E8E, 0x6E8D, 0x6EA4, 0x6EA1, 0x6EBF, 0x6EB3, 0x6ED0, 0x6ECA, 0x6E97, 0x6EAE, 0x6EA3,
+	0x7147, 0x7154, 0x7152, 0x7163, 0x7160, 0x7141, 0x715D, 0x7162, 0x7172, 0x7178, 0x716A, 0x7161,
+	0x7142, 0x7158, 0x7143, 0x714B, 0x7170, 0x715F, 0x7150, 0x7153, 0x7144, 0x714D, 0x715A, 0x724F,
+	0x728D, 0x728C, 0x7291, 0x7290, 0x728E, 0x733C, 0x7342, 0x733B, 0x733A, 0x7340, 0x734A, 0x7349,
+	0x7444, 0x744A, 0x744B, 0x7452, 0x7451, 0x7457, 0x7440, 0x744F, 0x7450, 0x744E,
+	// row 37
+	0x7442, 0x7446, 0x744D, 0x7454, 0x74E1, 0x74FF, 0x74FE, 0x74FD, 0x751D, 0x7579, 0x7577, 0x6983,
+	0x75EF, 0x760F, 0x7603, 0x75F7, 0x75FE, 0x75FC, 0x75F9, 0x75F8, 0x7610, 0x75FB, 0x75F6, 0x75ED,
+	0x75F5, 0x75FD, 0x7699, 0x76B5, 0x76DD, 0x7755, 0x775F, 0x7760, 0x7752, 0x7756, 0x775A, 0x7769,
+	0x7767, 0x7754, 0x7759, 0x776D, 0x77E0, 0x7887, 0x789A, 0x7894, 0x788F, 0x7884, 0x7895, 0x7885,
+	0x7886, 0x78A1, 0x7883, 0x7879, 0x7899, 0x7880, 0x7896, 0x787B, 0x797C, 0x7982, 0x797D, 0x7979,
+	0x7A11, 0x7A18, 0x7A19, 0x7A12, 0x7A17, 0x7A15, 0x7A22, 0x7A13, 0x7A1B, 0x7A10, 0x7AA3, 0x7AA2,
+	0x7A9E, 0x7AEB, 0x7B66, 0x7B64, 0x7B6D, 0x7B74, 0x7B69, 0x7B72, 0x7B65, 0x7B73, 0x7B71, 0x7B70,
+	0x7B61, 0x7B78, 0x7B76, 0x7B63, 0x7CB2, 0x7CB4, 0x7CAF, 0x7D88, 0x7D86, 0x7D80,
+	// row 38
+	0x7D8D, 0x7D7F, 0x7D85, 0x7D7A, 0x7D8E, 0x7D7B, 0x7D83, 0x7D7C, 0x7D8C, 0x7D94, 0x7D84, 0x7D7D,
+	0x7D92, 0x7F6D, 0x7F6B, 0x7F67, 0x7F68, 0x7F6C, 0x7FA6, 0x7FA5, 0x7FA7, 0x7FDB, 0x7FDC, 0x8021,
+	0x8164, 0x8160, 0x8177, 0x815C, 0x8169, 0x815B, 0x8162, 0x8172, 0x6721, 0x815E, 0x8176, 0x8167,
+	0x816F, 0x8144, 0x8161, 0x821D, 0x8249, 0x8244, 0x8240, 0x8242, 0x8245, 0x84F1, 0x843F, 0x8456,
+	0x8476, 0x8479, 0x848F, 0x848D, 0x8465, 0x8451, 0x8440, 0x8486, 0x8467, 0x8430, 0x844D, 0x847D,
+	0x845A, 0x8459, 0x8474, 0x8473, 0x845D, 0x8507, 0x845E, 0x8437, 0x843A, 0x8434, 0x847A, 0x8443,
+	0x8478, 0x8432, 0x8445, 0x8429, 0x83D9, 0x844B, 0x842F, 0x8442, 0x842D, 0x845F, 0x8470, 0x8439,
+	0x844E, 0x844C, 0x8452, 0x846F, 0x84C5, 0x848E, 0x843B, 0x8447, 0x8436, 0x8433,
+	// row 39
+	0x8468, 0x847E, 0x8444, 0x842B, 0x8460, 0x8454, 0x846E, 0x8450, 0x870B, 0x8704, 0x86F7, 0x870C,
+	0x86FA, 0x86D6, 0x86F5, 0x874D, 0x86F8, 0x870E, 0x8709, 0x8701, 0x86F6, 0x870D, 0x8705, 0x88D6,
+	0x88CB, 0x88CD, 0x88CE, 0x88DE, 0x88DB, 0x88DA, 0x88CC, 0x88D0, 0x8985, 0x899B, 0x89DF, 0x89E5,
+	0x89E4, 0x89E1, 0x89E0, 0x89E2, 0x89DC, 0x89E6, 0x8A76, 0x8A86, 0x8A7F, 0x8A61, 0x8A3F, 0x8A77,
+	0x8A82, 0x8A84, 0x8A75, 0x8A83, 0x8A81, 0x8A74, 0x8A7A, 0x8C3C, 0x8C4B, 0x8C4A, 0x8C65, 0x8C64,
+	0x8C66, 0x8C86, 0x8C84, 0x8C85, 0x8CCC, 0x8D68, 0x8D69, 0x8D91, 0x8D8C, 0x8D8E, 0x8D8F, 0x8D8D,
+	0x8D93, 0x8D94, 0x8D90, 0x8D92, 0x8DF0, 0x8DE0, 0x8DEC, 0x8DF1, 0x8DEE, 0x8DD0, 0x8DE9, 0x8DE3,
+	0x8DE2, 0x8DE7, 0x8DF2, 0x8DEB, 0x8DF4, 0x8F06, 0x8EFF, 0x8F01, 0x8F00, 0x8F05,
+	// row 40
+	0x8F07, 0x8F08, 0x8F02, 0x8F0B, 0x9052, 0x903F, 0x9044, 0x9049, 0x903D, 0x9110, 0x910D, 0x910F,
+	0x9111, 0x9116, 0x9114, 0x910B, 0x910E, 0x916E, 0x916F, 0x9248, 0x9252, 0x9230, 0x923A, 0x9266,
+	0x9233, 0x9265, 0x925E, 0x9283, 0x922E, 0x924A, 0x9246, 0x926D, 0x926C, 0x924F, 0x9260, 0x9267,
+	0x926F, 0x9236, 0x9261, 0x9270, 0x9231, 0x9254, 0x9263, 0x9250, 0x9272, 0x924E, 0x9253, 0x924C,
+	0x9256, 0x9232, 0x959F, 0x959C, 0x959E, 0x959B, 0x9692, 0x9693, 0x9691, 0x9697, 0x96CE, 0x96FA,
+	0x96FD, 0x96F8, 0x96F5, 0x9773, 0x9777, 0x9778, 0x9772, 0x980F, 0x980D, 0x980E, 0x98AC, 0x98F6,
+	0x98F9, 0x99AF, 0x99B2, 0x99B0, 0x99B5, 0x9AAD, 0x9AAB, 0x9B5B, 0x9CEA, 0x9CED, 0x9CE7, 0x9E80,
+	0x9EFD, 0x50E6, 0x50D4, 0x50D7, 0x50E8, 0x50F3, 0x50DB, 0x50EA, 0x50DD, 0x50E4,
+	// row 41
+	0x50D3, 0x50EC, 0x50F0, 0x50EF, 0x50E3, 0x50E0, 0x51D8, 0x5280, 0x5281, 0x52E9, 0x52EB, 0x5330,
+	0x53AC, 0x5627, 0x5615, 0x560C, 0x5612, 0x55FC, 0x560F, 0x561C, 0x5601, 0x5613, 0x5602, 0x55FA,
+	0x561D, 0x5604, 0x55FF, 0x55F9, 0x5889, 0x587C, 0x5890, 0x5898, 0x5886, 0x5881, 0x587F, 0x5874,
+	0x588B, 0x587A, 0x5887, 0x5891, 0x588E, 0x5876, 0x5882, 0x5888, 0x587B, 0x5894, 0x588F, 0x58FE,
+	0x596B, 0x5ADC, 0x5AEE, 0x5AE5, 0x5AD5, 0x5AEA, 0x5ADA, 0x5AED, 0x5AEB, 0x5AF3, 0x5AE2, 0x5AE0,
+	0x5ADB, 0x5AEC, 0x5ADE, 0x5ADD, 0x5AD9, 0x5AE8, 0x5ADF, 0x5B77, 0x5BE0, 0x5BE3, 0x5C63, 0x5D82,
+	0x5D80, 0x5D7D, 0x5D86, 0x5D7A, 0x5D81, 0x5D77, 0x5D8A, 0x5D89, 0x5D88, 0x5D7E, 0x5D7C, 0x5D8D,
+	0x5D79, 0x5D7F, 0x5E58, 0x5E59, 0x5E53, 0x5ED8, 0x5ED1, 0x5ED7, 0x5ECE, 0x5EDC,
+	// row 42
+	0x5ED5, 0x5ED9, 0x5ED2, 0x5ED4, 0x5F44, 0x5F43, 0x5F6F, 0x5FB6, 0x612C, 0x6128, 0x6141, 0x615E,
+	0x6171, 0x6173, 0x6152, 0x6153, 0x6172, 0x616C, 0x6180, 0x6174, 0x6154, 0x617A, 0x615B, 0x6165,
+	0x613B, 0x616A, 0x6161, 0x6156, 0x6229, 0x6227, 0x622B, 0x642B, 0x644D, 0x645B, 0x645D, 0x6474,
+	0x6476, 0x6472, 0x6473, 0x647D, 0x6475, 0x6466, 0x64A6, 0x644E, 0x6482, 0x645E, 0x645C, 0x644B,
+	0x6453, 0x6460, 0x6450, 0x647F, 0x643F, 0x646C, 0x646B, 0x6459, 0x6465, 0x6477, 0x6573, 0x65A0,
+	0x66A1, 0x66A0, 0x669F, 0x6705, 0x6704, 0x6722, 0x69B1, 0x69B6, 0x69C9, 0x69A0, 0x69CE, 0x6996,
+	0x69B0, 0x69AC, 0x69BC, 0x6991, 0x6999, 0x698E, 0x69A7, 0x698D, 0x69A9, 0x69BE, 0x69AF, 0x69BF,
+	0x69C4, 0x69BD, 0x69A4, 0x69D4, 0x69B9, 0x69CA, 0x699A, 0x69CF, 0x69B3, 0x6993,
+	// row 43
+	0x69AA, 0x69A1, 0x699E, 0x69D9, 0x6997, 0x6990, 0x69C2, 0x69B5, 0x69A5, 0x69C6, 0x6B4A, 0x6B4D,
+	0x6B4B, 0x6B9E, 0x6B9F, 0x6BA0, 0x6BC3, 0x6BC4, 0x6BFE, 0x6ECE, 0x6EF5, 0x6EF1, 0x6F03, 0x6F25,
+	0x6EF8, 0x6F37, 0x6EFB, 0x6F2E, 0x6F09, 0x6F4E, 0x6F19, 0x6F1A, 0x6F27, 0x6F18, 0x6F3B, 0x6F12,
+	0x6EED, 0x6F0A, 0x6F36, 0x6F73, 0x6EF9, 0x6EEE, 0x6F2D, 0x6F40, 0x6F30, 0x6F3C, 0x6F35, 0x6EEB,
+	0x6F07, 0x6F0E, 0x6F43, 0x6F05, 0x6EFD, 0x6EF6, 0x6F39, 0x6F1C, 0x6EFC, 0x6F3A, 0x6F1F, 0x6F0D,
+	0x6F1E, 0x6F08, 0x6F21, 0x7187, 0x7190, 0x7189, 0x7180, 0x7185, 0x7182, 0x718F, 0x717B, 0x7186,
+	0x7181, 0x7197, 0x7244, 0x7253, 0x7297, 0x7295, 0x7293, 0x7343, 0x734D, 0x7351, 0x734C, 0x7462,
+	0x7473, 0x7471, 0x7475, 0x7472, 0x7467, 0x746E, 0x7500, 0x7502, 0x7503, 0x757D,
+	// row 44
+	0x7590, 0x7616, 0x7608, 0x760C, 0x7615, 0x7611, 0x760A, 0x7614, 0x76B8, 0x7781, 0x777C, 0x7785,
+	0x7782, 0x776E, 0x7780, 0x776F, 0x777E, 0x7783, 0x78B2, 0x78AA, 0x78B4, 0x78AD, 0x78A8, 0x787E,
+	0x78AB, 0x789E, 0x78A5, 0x78A0, 0x78AC, 0x78A2, 0x78A4, 0x7998, 0x798A, 0x798B, 0x7996, 0x7995,
+	0x7994, 0x7993, 0x7997, 0x7988, 0x7992, 0x7990, 0x7A2B, 0x7A4A, 0x7A30, 0x7A2F, 0x7A28, 0x7A26,
+	0x7AA8, 0x7AAB, 0x7AAC, 0x7AEE, 0x7B88, 0x7B9C, 0x7B8A, 0x7B91, 0x7B90, 0x7B96, 0x7B8D, 0x7B8C,
+	0x7B9B, 0x7B8E, 0x7B85, 0x7B98, 0x5284, 0x7B99, 0x7BA4, 0x7B82, 0x7CBB, 0x7CBF, 0x7CBC, 0x7CBA,
+	0x7DA7, 0x7DB7, 0x7DC2, 0x7DA3, 0x7DAA, 0x7DC1, 0x7DC0, 0x7DC5, 0x7D9D, 0x7DCE, 0x7DC4, 0x7DC6,
+	0x7DCB, 0x7DCC, 0x7DAF, 0x7DB9, 0x7D96, 0x7DBC, 0x7D9F, 0x7DA6, 0x7DAE, 0x7DA9,
+	// row 45
+	0x7DA1, 0x7DC9, 0x7F73, 0x7FE2, 0x7FE3, 0x7FE5, 0x7FDE, 0x8024, 0x805D, 0x805C, 0x8189, 0x8186,
+	0x8183, 0x8187, 0x818D, 0x818C, 0x818B, 0x8215, 0x8497, 0x84A4, 0x84A1, 0x849F, 0x84BA, 0x84CE,
+	0x84C2, 0x84AC, 0x84AE, 0x84AB, 0x84B9, 0x84B4, 0x84C1, 0x84CD, 0x84AA, 0x849A, 0x84B1, 0x84D0,
+	0x849D, 0x84A7, 0x84BB, 0x84A2, 0x8494, 0x84C7, 0x84CC, 0x849B, 0x84A9, 0x84AF, 0x84A8, 0x84D6,
+	0x8498, 0x84B6, 0x84CF, 0x84A0, 0x84D7, 0x84D4, 0x84D2, 0x84DB, 0x84B0, 0x8491, 0x8661, 0x8733,
+	0x8723, 0x8728, 0x876B, 0x8740, 0x872E, 0x871E, 0x8721, 0x8719, 0x871B, 0x8743, 0x872C, 0x8741,
+	0x873E, 0x8746, 0x8720, 0x8732, 0x872A, 0x872D, 0x873C, 0x8712, 0x873A, 0x8731, 0x8735, 0x8742,
+	0x8726, 0x8727, 0x8738, 0x8724, 0x871A, 0x8730, 0x8711, 0x88F7, 0x88E7, 0x88F1,
+	// row 46
+	0x88F2, 0x88FA, 0x88FE, 0x88EE, 0x88FC, 0x88F6, 0x88FB, 0x88F0, 0x88EC, 0x88EB, 0x899D, 0x89A1,
+	0x899F, 0x899E, 0x89E9, 0x89EB, 0x89E8, 0x8AAB, 0x8A99, 0x8A8B, 0x8A92, 0x8A8F, 0x8A96, 0x8C3D,
+	0x8C68, 0x8C69, 0x8CD5, 0x8CCF, 0x8CD7, 0x8D96, 0x8E09, 0x8E02, 0x8DFF, 0x8E0D, 0x8DFD, 0x8E0A,
+	0x8E03, 0x8E07, 0x8E06, 0x8E05, 0x8DFE, 0x8E00, 0x8E04, 0x8F10, 0x8F11, 0x8F0E, 0x8F0D, 0x9123,
+	0x911C, 0x9120, 0x9122, 0x911F, 0x911D, 0x911A, 0x9124, 0x9121, 0x911B, 0x917A, 0x9172, 0x9179,
+	0x9173, 0x92A5, 0x92A4, 0x9276, 0x929B, 0x927A, 0x92A0, 0x9294, 0x92AA, 0x928D, 0x92A6, 0x929A,
+	0x92AB, 0x9279, 0x9297, 0x927F, 0x92A3, 0x92EE, 0x928E, 0x9282, 0x9295, 0x92A2, 0x927D, 0x9288,
+	0x92A1, 0x928A, 0x9286, 0x928C, 0x9299, 0x92A7, 0x927E, 0x9287, 0x92A9, 0x929D,
+	// row 47
+	0x928B, 0x922D, 0x969E, 0x96A1, 0x96FF, 0x9758, 0x977D, 0x977A, 0x977E, 0x9783, 0x9780, 0x9782,
+	0x977B, 0x9784, 0x9781, 0x977F, 0x97CE, 0x97CD, 0x9816, 0x98AD, 0x98AE, 0x9902, 0x9900, 0x9907,
+	0x999D, 0x999C, 0x99C3, 0x99B9, 0x99BB, 0x99BA, 0x99C2, 0x99BD, 0x99C7, 0x9AB1, 0x9AE3, 0x9AE7,
+	0x9B3E, 0x9B3F, 0x9B60, 0x9B61, 0x9B5F, 0x9CF1, 0x9CF2, 0x9CF5, 0x9EA7, 0x50FF, 0x5103, 0x5130,
+	0x50F8, 0x5106, 0x5107, 0x50F6, 0x50FE, 0x510B, 0x510C, 0x50FD, 0x510A, 0x528B, 0x528C, 0x52F1,
+	0x52EF, 0x5648, 0x5642, 0x564C, 0x5635, 0x5641, 0x564A, 0x5649, 0x5646, 0x5658, 0x565A, 0x5640,
+	0x5633, 0x563D, 0x562C, 0x563E, 0x5638, 0x562A, 0x563A, 0x571A, 0x58AB, 0x589D, 0x58B1, 0x58A0,
+	0x58A3, 0x58AF, 0x58AC, 0x58A5, 0x58A1, 0x58FF, 0x5AFF, 0x5AF4, 0x5AFD, 0x5AF7,
+	// row 48
+	0x5AF6, 0x5B03, 0x5AF8, 0x5B02, 0x5AF9, 0x5B01, 0x5B07, 0x5B05, 0x5B0F, 0x5C67, 0x5D99, 0x5D97,
+	0x5D9F, 0x5D92, 0x5DA2, 0x5D93, 0x5D95, 0x5DA0, 0x5D9C, 0x5DA1, 0x5D9A, 0x5D9E, 0x5E69, 0x5E5D,
+	0x5E60, 0x5E5C, 0x7DF3, 0x5EDB, 0x5EDE, 0x5EE1, 0x5F49, 0x5FB2, 0x618B, 0x6183, 0x6179, 0x61B1,
+	0x61B0, 0x61A2, 0x6189, 0x619B, 0x6193, 0x61AF, 0x61AD, 0x619F, 0x6192, 0x61AA, 0x61A1, 0x618D,
+	0x6166, 0x61B3, 0x622D, 0x646E, 0x6470, 0x6496, 0x64A0, 0x6485, 0x6497, 0x649C, 0x648F, 0x648B,
+	0x648A, 0x648C, 0x64A3, 0x649F, 0x6468, 0x64B1, 0x6498, 0x6576, 0x657A, 0x6579, 0x657B, 0x65B2,
+	0x65B3, 0x66B5, 0x66B0, 0x66A9, 0x66B2, 0x66B7, 0x66AA, 0x66AF, 0x6A00, 0x6A06, 0x6A17, 0x69E5,
+	0x69F8, 0x6A15, 0x69F1, 0x69E4, 0x6A20, 0x69FF, 0x69EC, 0x69E2, 0x6A1B, 0x6A1D,
+	// row 49
+	0x69FE, 0x6A27, 0x69F2, 0x69EE, 0x6A14, 0x69F7, 0x69E7, 0x6A40, 0x6A08, 0x69E6, 0x69FB, 0x6A0D,
+	0x69FC, 0x69EB, 0x6A09, 0x6A04, 0x6A18, 0x6A25, 0x6A0F, 0x69F6, 0x6A26, 0x6A07, 0x69F4, 0x6A16,
+	0x6B51, 0x6BA5, 0x6BA3, 0x6BA2, 0x6BA6, 0x6C01, 0x6C00, 0x6BFF, 0x6C02, 0x6F41, 0x6F26, 0x6F7E,
+	0x6F87, 0x6FC6, 0x6F92, 0x6F8D, 0x6F89, 0x6F8C, 0x6F62, 0x6F4F, 0x6F85, 0x6F5A, 0x6F96, 0x6F76,
+	0x6F6C, 0x6F82, 0x6F55, 0x6F72, 0x6F52, 0x6F50, 0x6F57, 0x6F94, 0x6F93, 0x6F5D, 0x6F00, 0x6F61,
+	0x6F6B, 0x6F7D, 0x6F67, 0x6F90, 0x6F53, 0x6F8B, 0x6F69, 0x6F7F, 0x6F95, 0x6F63, 0x6F77, 0x6F6A,
+	0x6F7B, 0x71B2, 0x71AF, 0x719B, 0x71B0, 0x71A0, 0x719A, 0x71A9, 0x71B5, 0x719D, 0x71A5, 0x719E,
+	0x71A4, 0x71A1, 0x71AA, 0x719C, 0x71A7, 0x71B3, 0x7298, 0x729A, 0x7358, 0x7352,
+	// row 50
+	0x735E, 0x735F, 0x7360, 0x735D, 0x735B, 0x7361, 0x735A, 0x7359, 0x7362, 0x7487, 0x7489, 0x748A,
+	0x7486, 0x7481, 0x747D, 0x7485, 0x7488, 0x747C, 0x7479, 0x7508, 0x7507, 0x757E, 0x7625, 0x761E,
+	0x7619, 0x761D, 0x761C, 0x7623, 0x761A, 0x7628, 0x761B, 0x769C, 0x769D, 0x769E, 0x769B, 0x778D,
+	0x778F, 0x7789, 0x7788, 0x78CD, 0x78BB, 0x78CF, 0x78CC, 0x78D1, 0x78CE, 0x78D4, 0x78C8, 0x78C3,
+	0x78C4, 0x78C9, 0x799A, 0x79A1, 0x79A0, 0x799C, 0x79A2, 0x799B, 0x6B76, 0x7A39, 0x7AB2, 0x7AB4,
+	0x7AB3, 0x7BB7, 0x7BCB, 0x7BBE, 0x7BAC, 0x7BCE, 0x7BAF, 0x7BB9, 0x7BCA, 0x7BB5, 0x7CC5, 0x7CC8,
+	0x7CCC, 0x7CCB, 0x7DF7, 0x7DDB, 0x7DEA, 0x7DE7, 0x7DD7, 0x7DE1, 0x7E03, 0x7DFA, 0x7DE6, 0x7DF6,
+	0x7DF1, 0x7DF0, 0x7DEE, 0x7DDF, 0x7F76, 0x7FAC, 0x7FB0, 0x7FAD, 0x7FED, 0x7FEB,
+	// row 51
+	0x7FEA, 0x7FEC, 0x7FE6, 0x7FE8, 0x8064, 0x8067, 0x81A3, 0x819F, 0x819E, 0x8195, 0x81A2, 0x8199,
+	0x8197, 0x8216, 0x824F, 0x8253, 0x8252, 0x8250, 0x824E, 0x8251, 0x8524, 0x853B, 0x850F, 0x8500,
+	0x8529, 0x850E, 0x8509, 0x850D, 0x851F, 0x850A, 0x8527, 0x851C, 0x84FB, 0x852B, 0x84FA, 0x8508,
+	0x850C, 0x84F4, 0x852A, 0x84F2, 0x8515, 0x84F7, 0x84EB, 0x84F3, 0x84FC, 0x8512, 0x84EA, 0x84E9,
+	0x8516, 0x84FE, 0x8528, 0x851D, 0x852E, 0x8502, 0x84FD, 0x851E, 0x84F6, 0x8531, 0x8526, 0x84E7,
+	0x84E8, 0x84F0, 0x84EF, 0x84F9, 0x8518, 0x8520, 0x8530, 0x850B, 0x8519, 0x852F, 0x8662, 0x8756,
+	0x8763, 0x8764, 0x8777, 0x87E1, 0x8773, 0x8758, 0x8754, 0x875B, 0x8752, 0x8761, 0x875A, 0x8751,
+	0x875E, 0x876D, 0x876A, 0x8750, 0x874E, 0x875F, 0x875D, 0x876F, 0x876C, 0x877A,
+	// row 52
+	0x876E, 0x875C, 0x8765, 0x874F, 0x877B, 0x8775, 0x8762, 0x8767, 0x8769, 0x885A, 0x8905, 0x890C,
+	0x8914, 0x890B, 0x8917, 0x8918, 0x8919, 0x8906, 0x8916, 0x8911, 0x890E, 0x8909, 0x89A2, 0x89A4,
+	0x89A3, 0x89ED, 0x89F0, 0x89EC, 0x8ACF, 0x8AC6, 0x8AB8, 0x8AD3, 0x8AD1, 0x8AD4, 0x8AD5, 0x8ABB,
+	0x8AD7, 0x8ABE, 0x8AC0, 0x8AC5, 0x8AD8, 0x8AC3, 0x8ABA, 0x8ABD, 0x8AD9, 0x8C3E, 0x8C4D, 0x8C8F,
+	0x8CE5, 0x8CDF, 0x8CD9, 0x8CE8, 0x8CDA, 0x8CDD, 0x8CE7, 0x8DA0, 0x8D9C, 0x8DA1, 0x8D9B, 0x8E20,
+	0x8E23, 0x8E25, 0x8E24, 0x8E2E, 0x8E15, 0x8E1B, 0x8E16, 0x8E11, 0x8E19, 0x8E26, 0x8E27, 0x8E14,
+	0x8E12, 0x8E18, 0x8E13, 0x8E1C, 0x8E17, 0x8E1A, 0x8F2C, 0x8F24, 0x8F18, 0x8F1A, 0x8F20, 0x8F23,
+	0x8F16, 0x8F17, 0x9073, 0x9070, 0x906F, 0x9067, 0x906B, 0x912F, 0x912B, 0x9129,
+	// row 53
+	0x912A, 0x9132, 0x9126, 0x912E, 0x9185, 0x9186, 0x918A, 0x9181, 0x9182, 0x9184, 0x9180, 0x92D0,
+	0x92C3, 0x92C4, 0x92C0, 0x92D9, 0x92B6, 0x92CF, 0x92F1, 0x92DF, 0x92D8, 0x92E9, 0x92D7, 0x92DD,
+	0x92CC, 0x92EF, 0x92C2, 0x92E8, 0x92CA, 0x92C8, 0x92CE, 0x92E6, 0x92CD, 0x92D5, 0x92C9, 0x92E0,
+	0x92DE, 0x92E7, 0x92D1, 0x92D3, 0x92B5, 0x92E1, 0x9325, 0x92C6, 0x92B4, 0x957C, 0x95AC, 0x95AB,
+	0x95AE, 0x95B0, 0x96A4, 0x96A2, 0x96D3, 0x9705, 0x9708, 0x9702, 0x975A, 0x978A, 0x978E, 0x9788,
+	0x97D0, 0x97CF, 0x981E, 0x981D, 0x9826, 0x9829, 0x9828, 0x9820, 0x981B, 0x9827, 0x98B2, 0x9908,
+	0x98FA, 0x9911, 0x9914, 0x9916, 0x9917, 0x9915, 0x99DC, 0x99CD, 0x99CF, 0x99D3, 0x99D4, 0x99CE,
+	0x99C9, 0x99D6, 0x99D8, 0x99CB, 0x99D7, 0x99CC, 0x9AB3, 0x9AEC, 0x9AEB, 0x9AF3,
+	// row 54
+	0x9AF2, 0x9AF1, 0x9B46, 0x9B43, 0x9B67, 0x9B74, 0x9B71, 0x9B66, 0x9B76, 0x9B75, 0x9B70, 0x9B68,
+	0x9B64, 0x9B6C, 0x9CFC, 0x9CFA, 0x9CFD, 0x9CFF, 0x9CF7, 0x9D07, 0x9D00, 0x9CF9, 0x9CFB, 0x9D08,
+	0x9D05, 0x9D04, 0x9E83, 0x9ED3, 0x9F0F, 0x9F10, 0x511C, 0x5113, 0x5117, 0x511A, 0x5111, 0x51DE,
+	0x5334, 0x53E1, 0x5670, 0x5660, 0x566E, 0x5673, 0x5666, 0x5663, 0x566D, 0x5672, 0x565E, 0x5677,
+	0x571C, 0x571B, 0x58C8, 0x58BD, 0x58C9, 0x58BF, 0x58BA, 0x58C2, 0x58BC, 0x58C6, 0x5B17, 0x5B19,
+	0x5B1B, 0x5B21, 0x5B14, 0x5B13, 0x5B10, 0x5B16, 0x5B28, 0x5B1A, 0x5B20, 0x5B1E, 0x5BEF, 0x5DAC,
+	0x5DB1, 0x5DA9, 0x5DA7, 0x5DB5, 0x5DB0, 0x5DAE, 0x5DAA, 0x5DA8, 0x5DB2, 0x5DAD, 0x5DAF, 0x5DB4,
+	0x5E67, 0x5E68, 0x5E66, 0x5E6F, 0x5EE9, 0x5EE7, 0x5EE6, 0x5EE8, 0x5EE5, 0x5F4B,
+	// row 55
+	0x5FBC, 0x5FBB, 0x619D, 0x61A8, 0x6196, 0x61C5, 0x61B4, 0x61C6, 0x61C1, 0x61CC, 0x61BA, 0x61BF,
+	0x61B8, 0x618C, 0x64D7, 0x64D6, 0x64D0, 0x64CF, 0x64C9, 0x64BD, 0x6489, 0x64C3, 0x64DB, 0x64F3,
+	0x64D9, 0x6533, 0x657F, 0x657C, 0x65A2, 0x66C8, 0x66BE, 0x66C0, 0x66CA, 0x66CB, 0x66CF, 0x66BD,
+	0x66BB, 0x66BA, 0x66CC, 0x6723, 0x6A34, 0x6A66, 0x6A49, 0x6A67, 0x6A32, 0x6A68, 0x6A3E, 0x6A5D,
+	0x6A6D, 0x6A76, 0x6A5B, 0x6A51, 0x6A28, 0x6A5A, 0x6A3B, 0x6A3F, 0x6A41, 0x6A6A, 0x6A64, 0x6A50,
+	0x6A4F, 0x6A54, 0x6A6F, 0x6A69, 0x6A60, 0x6A3C, 0x6A5E, 0x6A56, 0x6A55, 0x6A4D, 0x6A4E, 0x6A46,
+	0x6B55, 0x6B54, 0x6B56, 0x6BA7, 0x6BAA, 0x6BAB, 0x6BC8, 0x6BC7, 0x6C04, 0x6C03, 0x6C06, 0x6FAD,
+	0x6FCB, 0x6FA3, 0x6FC7, 0x6FBC, 0x6FCE, 0x6FC8, 0x6F5E, 0x6FC4, 0x6FBD, 0x6F9E,
+	// row 56
+	0x6FCA, 0x6FA8, 0x7004, 0x6FA5, 0x6FAE, 0x6FBA, 0x6FAC, 0x6FAA, 0x6FCF, 0x6FBF, 0x6FB8, 0x6FA2,
+	0x6FC9, 0x6FAB, 0x6FCD, 0x6FAF, 0x6FB2, 0x6FB0, 0x71C5, 0x71C2, 0x71BF, 0x71B8, 0x71D6, 0x71C0,
+	0x71C1, 0x71CB, 0x71D4, 0x71CA, 0x71C7, 0x71CF, 0x71BD, 0x71D8, 0x71BC, 0x71C6, 0x71DA, 0x71DB,
+	0x729D, 0x729E, 0x7369, 0x7366, 0x7367, 0x736C, 0x7365, 0x736B, 0x736A, 0x747F, 0x749A, 0x74A0,
+	0x7494, 0x7492, 0x7495, 0x74A1, 0x750B, 0x7580, 0x762F, 0x762D, 0x7631, 0x763D, 0x7633, 0x763C,
+	0x7635, 0x7632, 0x7630, 0x76BB, 0x76E6, 0x779A, 0x779D, 0x77A1, 0x779C, 0x779B, 0x77A2, 0x77A3,
+	0x7795, 0x7799, 0x7797, 0x78DD, 0x78E9, 0x78E5, 0x78EA, 0x78DE, 0x78E3, 0x78DB, 0x78E1, 0x78E2,
+	0x78ED, 0x78DF, 0x78E0, 0x79A4, 0x7A44, 0x7A48, 0x7A47, 0x7AB6, 0x7AB8, 0x7AB5,
+	// row 57
+	0x7AB1, 0x7AB7, 0x7BDE, 0x7BE3, 0x7BE7, 0x7BDD, 0x7BD5, 0x7BE5, 0x7BDA, 0x7BE8, 0x7BF9, 0x7BD4,
+	0x7BEA, 0x7BE2, 0x7BDC, 0x7BEB, 0x7BD8, 0x7BDF, 0x7CD2, 0x7CD4, 0x7CD7, 0x7CD0, 0x7CD1, 0x7E12,
+	0x7E21, 0x7E17, 0x7E0C, 0x7E1F, 0x7E20, 0x7E13, 0x7E0E, 0x7E1C, 0x7E15, 0x7E1A, 0x7E22, 0x7E0B,
+	0x7E0F, 0x7E16, 0x7E0D, 0x7E14, 0x7E25, 0x7E24, 0x7F43, 0x7F7B, 0x7F7C, 0x7F7A, 0x7FB1, 0x7FEF,
+	0x802A, 0x8029, 0x806C, 0x81B1, 0x81A6, 0x81AE, 0x81B9, 0x81B5, 0x81AB, 0x81B0, 0x81AC, 0x81B4,
+	0x81B2, 0x81B7, 0x81A7, 0x81F2, 0x8255, 0x8256, 0x8257, 0x8556, 0x8545, 0x856B, 0x854D, 0x8553,
+	0x8561, 0x8558, 0x8540, 0x8546, 0x8564, 0x8541, 0x8562, 0x8544, 0x8551, 0x8547, 0x8563, 0x853E,
+	0x855B, 0x8571, 0x854E, 0x856E, 0x8575, 0x8555, 0x8567, 0x8560, 0x858C, 0x8566,
+	// row 58
+	0x855D, 0x8554, 0x8565, 0x856C, 0x8663, 0x8665, 0x8664, 0x87A4, 0x879B, 0x878F, 0x8797, 0x8793,
+	0x8792, 0x8788, 0x8781, 0x8796, 0x8798, 0x8779, 0x8787, 0x87A3, 0x8785, 0x8790, 0x8791, 0x879D,
+	0x8784, 0x8794, 0x879C, 0x879A, 0x8789, 0x891E, 0x8926, 0x8930, 0x892D, 0x892E, 0x8927, 0x8931,
+	0x8922, 0x8929, 0x8923, 0x892F, 0x892C, 0x891F, 0x89F1, 0x8AE0, 0x8AE2, 0x8AF2, 0x8AF4, 0x8AF5,
+	0x8ADD, 0x8B14, 0x8AE4, 0x8ADF, 0x8AF0, 0x8AC8, 0x8ADE, 0x8AE1, 0x8AE8, 0x8AFF, 0x8AEF, 0x8AFB,
+	0x8C91, 0x8C92, 0x8C90, 0x8CF5, 0x8CEE, 0x8CF1, 0x8CF0, 0x8CF3, 0x8D6C, 0x8D6E, 0x8DA5, 0x8DA7,
+	0x8E33, 0x8E3E, 0x8E38, 0x8E40, 0x8E45, 0x8E36, 0x8E3C, 0x8E3D, 0x8E41, 0x8E30, 0x8E3F, 0x8EBD,
+	0x8F36, 0x8F2E, 0x8F35, 0x8F32, 0x8F39, 0x8F37, 0x8F34, 0x9076, 0x9079, 0x907B,
+	// row 59
+	0x9086, 0x90FA, 0x9133, 0x9135, 0x9136, 0x9193, 0x9190, 0x9191, 0x918D, 0x918F, 0x9327, 0x931E,
+	0x9308, 0x931F, 0x9306, 0x930F, 0x937A, 0x9338, 0x933C, 0x931B, 0x9323, 0x9312, 0x9301, 0x9346,
+	0x932D, 0x930E, 0x930D, 0x92CB, 0x931D, 0x92FA, 0x9313, 0x92F9, 0x92F7, 0x9334, 0x9302, 0x9324,
+	0x92FF, 0x9329, 0x9339, 0x9335, 0x932A, 0x9314, 0x930C, 0x930B, 0x92FE, 0x9309, 0x9300, 0x92FB,
+	0x9316, 0x95BC, 0x95CD, 0x95BE, 0x95B9, 0x95BA, 0x95B6, 0x95BF, 0x95B5, 0x95BD, 0x96A9, 0x96D4,
+	0x970B, 0x9712, 0x9710, 0x9799, 0x9797, 0x9794, 0x97F0, 0x97F8, 0x9835, 0x982F, 0x9832, 0x9924,
+	0x991F, 0x9927, 0x9929, 0x999E, 0x99EE, 0x99EC, 0x99E5, 0x99E4, 0x99F0, 0x99E3, 0x99EA, 0x99E9,
+	0x99E7, 0x9AB9, 0x9ABF, 0x9AB4, 0x9ABB, 0x9AF6, 0x9AFA, 0x9AF9, 0x9AF7, 0x9B33,
+	// row 60
+	0x9B80, 0x9B85, 0x9B87, 0x9B7C, 0x9B7E, 0x9B7B, 0x9B82, 0x9B93, 0x9B92, 0x9B90, 0x9B7A, 0x9B95,
+	0x9B7D, 0x9B88, 0x9D25, 0x9D17, 0x9D20, 0x9D1E, 0x9D14, 0x9D29, 0x9D1D, 0x9D18, 0x9D22, 0x9D10,
+	0x9D19, 0x9D1F, 0x9E88, 0x9E86, 0x9E87, 0x9EAE, 0x9EAD, 0x9ED5, 0x9ED6, 0x9EFA, 0x9F12, 0x9F3D,
+	0x5126, 0x5125, 0x5122, 0x5124, 0x5120, 0x5129, 0x52F4, 0x5693, 0x568C, 0x568D, 0x5686, 0x5684,
+	0x5683, 0x567E, 0x5682, 0x567F, 0x5681, 0x58D6, 0x58D4, 0x58CF, 0x58D2, 0x5B2D, 0x5B25, 0x5B32,
+	0x5B23, 0x5B2C, 0x5B27, 0x5B26, 0x5B2F, 0x5B2E, 0x5B7B, 0x5BF1, 0x5BF2, 0x5DB7, 0x5E6C, 0x5E6A,
+	0x5FBE, 0x61C3, 0x61B5, 0x61BC, 0x61E7, 0x61E0, 0x61E5, 0x61E4, 0x61E8, 0x61DE, 0x64EF, 0x64E9,
+	0x64E3, 0x64EB, 0x64E4, 0x64E8, 0x6581, 0x6580, 0x65B6, 0x65DA, 0x66D2, 0x6A8D,
+	// row 61
+	0x6A96, 0x6A81, 0x6AA5, 0x6A89, 0x6A9F, 0x6A9B, 0x6AA1, 0x6A9E, 0x6A87, 0x6A93, 0x6A8E, 0x6A95,
+	0x6A83, 0x6AA8, 0x6AA4, 0x6A91, 0x6A7F, 0x6AA6, 0x6A9A, 0x6A85, 0x6A8C, 0x6A92, 0x6B5B, 0x6BAD,
+	0x6C09, 0x6FCC, 0x6FA9, 0x6FF4, 0x6FD4, 0x6FE3, 0x6FDC, 0x6FED, 0x6FE7, 0x6FE6, 0x6FDE, 0x6FF2,
+	0x6FDD, 0x6FE2, 0x6FE8, 0x71E1, 0x71F1, 0x71E8, 0x71F2, 0x71E4, 0x71F0, 0x71E2, 0x7373, 0x736E,
+	0x736F, 0x7497, 0x74B2, 0x74AB, 0x7490, 0x74AA, 0x74AD, 0x74B1, 0x74A5, 0x74AF, 0x7510, 0x7511,
+	0x7512, 0x750F, 0x7584, 0x7643, 0x7648, 0x7649, 0x7647, 0x76A4, 0x76E9, 0x77B5, 0x77AB, 0x77B2,
+	0x77B7, 0x77B6, 0x77B4, 0x77B1, 0x77A8, 0x77F0, 0x78F3, 0x78FD, 0x7902, 0x78FB, 0x78FC, 0x78FF,
+	0x78F2, 0x7905, 0x78F9, 0x78FE, 0x7904, 0x79AB, 0x79A8, 0x7A5C, 0x7A5B, 0x7A56,
+	// row 62
+	0x7A58, 0x7A54, 0x7A5A, 0x7ABE, 0x7AC0, 0x7AC1, 0x7C05, 0x7C0F, 0x7BF2, 0x7C00, 0x7BFF, 0x7BFB,
+	0x7C0E, 0x7BF4, 0x7C0B, 0x7BF3, 0x7C02, 0x7C09, 0x7C03, 0x7C01, 0x7BF8, 0x7BFD, 0x7C06, 0x7BF0,
+	0x7BF1, 0x7C10, 0x7C0A, 0x7CE8, 0x7E2D, 0x7E3C, 0x7E42, 0x7E33, 0x9848, 0x7E38, 0x7E2A, 0x7E49,
+	0x7E40, 0x7E47, 0x7E29, 0x7E4C, 0x7E30, 0x7E3B, 0x7E36, 0x7E44, 0x7E3A, 0x7F45, 0x7F7F, 0x7F7E,
+	0x7F7D, 0x7FF4, 0x7FF2, 0x802C, 0x81BB, 0x81C4, 0x81CC, 0x81CA, 0x81C5, 0x81C7, 0x81BC, 0x81E9,
+	0x825B, 0x825A, 0x825C, 0x8583, 0x8580, 0x858F, 0x85A7, 0x8595, 0x85A0, 0x858B, 0x85A3, 0x857B,
+	0x85A4, 0x859A, 0x859E, 0x8577, 0x857C, 0x8589, 0x85A1, 0x857A, 0x8578, 0x8557, 0x858E, 0x8596,
+	0x8586, 0x858D, 0x8599, 0x859D, 0x8581, 0x85A2, 0x8582, 0x8588, 0x8585, 0x8579,
+	// row 63
+	0x8576, 0x8598, 0x8590, 0x859F, 0x8668, 0x87BE, 0x87AA, 0x87AD, 0x87C5, 0x87B0, 0x87AC, 0x87B9,
+	0x87B5, 0x87BC, 0x87AE, 0x87C9, 0x87C3, 0x87C2, 0x87CC, 0x87B7, 0x87AF, 0x87C4, 0x87CA, 0x87B4,
+	0x87B6, 0x87BF, 0x87B8, 0x87BD, 0x87DE, 0x87B2, 0x8935, 0x8933, 0x893C, 0x893E, 0x8941, 0x8952,
+	0x8937, 0x8942, 0x89AD, 0x89AF, 0x89AE, 0x89F2, 0x89F3, 0x8B1E, 0x8B18, 0x8B16, 0x8B11, 0x8B05,
+	0x8B0B, 0x8B22, 0x8B0F, 0x8B12, 0x8B15, 0x8B07, 0x8B0D, 0x8B08, 0x8B06, 0x8B1C, 0x8B13, 0x8B1A,
+	0x8C4F, 0x8C70, 0x8C72, 0x8C71, 0x8C6F, 0x8C95, 0x8C94, 0x8CF9, 0x8D6F, 0x8E4E, 0x8E4D, 0x8E53,
+	0x8E50, 0x8E4C, 0x8E47, 0x8F43, 0x8F40, 0x9085, 0x907E, 0x9138, 0x919A, 0x91A2, 0x919B, 0x9199,
+	0x919F, 0x91A1, 0x919D, 0x91A0, 0x93A1, 0x9383, 0x93AF, 0x9364, 0x9356, 0x9347,
+	// row 64
+	0x937C, 0x9358, 0x935C, 0x9376, 0x9349, 0x9350, 0x9351, 0x9360, 0x936D, 0x938F, 0x934C, 0x936A,
+	0x9379, 0x9357, 0x9355, 0x9352, 0x934F, 0x9371, 0x9377, 0x937B, 0x9361, 0x935E, 0x9363, 0x9367,
+	0x934E, 0x9359, 0x95C7, 0x95C0, 0x95C9, 0x95C3, 0x95C5, 0x95B7, 0x96AE, 0x96B0, 0x96AC, 0x9720,
+	0x971F, 0x9718, 0x971D, 0x9719, 0x979A, 0x97A1, 0x979C, 0x979E, 0x979D, 0x97D5, 0x97D4, 0x97F1,
+	0x9841, 0x9844, 0x984A, 0x9849, 0x9845, 0x9843, 0x9925, 0x992B, 0x992C, 0x992A, 0x9933, 0x9932,
+	0x992F, 0x992D, 0x9931, 0x9930, 0x9998, 0x99A3, 0x99A1, 0x9A02, 0x99FA, 0x99F4, 0x99F7, 0x99F9,
+	0x99F8, 0x99F6, 0x99FB, 0x99FD, 0x99FE, 0x99FC, 0x9A03, 0x9ABE, 0x9AFE, 0x9AFD, 0x9B01, 0x9AFC,
+	0x9B48, 0x9B9A, 0x9BA8, 0x9B9E, 0x9B9B, 0x9BA6, 0x9BA1, 0x9BA5, 0x9BA4, 0x9B86,
+	// row 65
+	0x9BA2, 0x9BA0, 0x9BAF, 0x9D33, 0x9D41, 0x9D67, 0x9D36, 0x9D2E, 0x9D2F, 0x9D31, 0x9D38, 0x9D30,
+	0x9D45, 0x9D42, 0x9D43, 0x9D3E, 0x9D37, 0x9D40, 0x9D3D, 0x7FF5, 0x9D2D, 0x9E8A, 0x9E89, 0x9E8D,
+	0x9EB0, 0x9EC8, 0x9EDA, 0x9EFB, 0x9EFF, 0x9F24, 0x9F23, 0x9F22, 0x9F54, 0x9FA0, 0x5131, 0x512D,
+	0x512E, 0x5698, 0x569C, 0x5697, 0x569A, 0x569D, 0x5699, 0x5970, 0x5B3C, 0x5C69, 0x5C6A, 0x5DC0,
+	0x5E6D, 0x5E6E, 0x61D8, 0x61DF, 0x61ED, 0x61EE, 0x61F1, 0x61EA, 0x61F0, 0x61EB, 0x61D6, 0x61E9,
+	0x64FF, 0x6504, 0x64FD, 0x64F8, 0x6501, 0x6503, 0x64FC, 0x6594, 0x65DB, 0x66DA, 0x66DB, 0x66D8,
+	0x6AC5, 0x6AB9, 0x6ABD, 0x6AE1, 0x6AC6, 0x6ABA, 0x6AB6, 0x6AB7, 0x6AC7, 0x6AB4, 0x6AAD, 0x6B5E,
+	0x6BC9, 0x6C0B, 0x7007, 0x700C, 0x700D, 0x7001, 0x7005, 0x7014, 0x700E, 0x6FFF,
+	// row 66
+	0x7000, 0x6FFB, 0x7026, 0x6FFC, 0x6FF7, 0x700A, 0x7201, 0x71FF, 0x71F9, 0x7203, 0x71FD, 0x7376,
+	0x74B8, 0x74C0, 0x74B5, 0x74C1, 0x74BE, 0x74B6, 0x74BB, 0x74C2, 0x7514, 0x7513, 0x765C, 0x7664,
+	0x7659, 0x7650, 0x7653, 0x7657, 0x765A, 0x76A6, 0x76BD, 0x76EC, 0x77C2, 0x77BA, 0x790C, 0x7913,
+	0x7914, 0x7909, 0x7910, 0x7912, 0x7911, 0x79AD, 0x79AC, 0x7A5F, 0x7C1C, 0x7C29, 0x7C19, 0x7C20,
+	0x7C1F, 0x7C2D, 0x7C1D, 0x7C26, 0x7C28, 0x7C22, 0x7C25, 0x7C30, 0x7E5C, 0x7E50, 0x7E56, 0x7E63,
+	0x7E58, 0x7E62, 0x7E5F, 0x7E51, 0x7E60, 0x7E57, 0x7E53, 0x7FB5, 0x7FB3, 0x7FF7, 0x7FF8, 0x8075,
+	0x81D1, 0x81D2, 0x81D0, 0x825F, 0x825E, 0x85B4, 0x85C6, 0x85C0, 0x85C3, 0x85C2, 0x85B3, 0x85B5,
+	0x85BD, 0x85C7, 0x85C4, 0x85BF, 0x85CB, 0x85CE, 0x85C8, 0x85C5, 0x85B1, 0x85B6,
+	// row 67
+	0x85D2, 0x8624, 0x85B8, 0x85B7, 0x85BE, 0x8669, 0x87E7, 0x87E6, 0x87E2, 0x87DB, 0x87EB, 0x87EA,
+	0x87E5, 0x87DF, 0x87F3, 0x87E4, 0x87D4, 0x87DC, 0x87D3, 0x87ED, 0x87D8, 0x87E3, 0x87D7, 0x87D9,
+	0x8801, 0x87F4, 0x87E8, 0x87DD, 0x8953, 0x894B, 0x894F, 0x894C, 0x8946, 0x8950, 0x8951, 0x8949,
+	0x8B2A, 0x8B27, 0x8B23, 0x8B33, 0x8B30, 0x8B35, 0x8B47, 0x8B2F, 0x8B3C, 0x8B3E, 0x8B31, 0x8B25,
+	0x8B37, 0x8B26, 0x8B36, 0x8B2E, 0x8B24, 0x8B3B, 0x8B3D, 0x8B3A, 0x8C42, 0x8C75, 0x8C99, 0x8C98,
+	0x8C97, 0x8CFE, 0x8D04, 0x8D02, 0x8D00, 0x8E5C, 0x8E62, 0x8E60, 0x8E57, 0x8E56, 0x8E5E, 0x8E65,
+	0x8E67, 0x8E5B, 0x8E5A, 0x8E61, 0x8E5D, 0x8E69, 0x8E54, 0x8F46, 0x8F47, 0x8F48, 0x8F4B, 0x9128,
+	0x913A, 0x913B, 0x913E, 0x91A8, 0x91A5, 0x91A7, 0x91AF, 0x91AA, 0x93B5, 0x938C,
+	// row 68
+	0x9392, 0x93B7, 0x939B, 0x939D, 0x9389, 0x93A7, 0x938E, 0x93AA, 0x939E, 0x93A6, 0x9395, 0x9388,
+	0x9399, 0x939F, 0x9380, 0x938D, 0x93B1, 0x9391, 0x93B2, 0x93A4, 0x93A8, 0x93B4, 0x93A3, 0x95D2,
+	0x95D3, 0x95D1, 0x96B3, 0x96D7, 0x96DA, 0x5DC2, 0x96DF, 0x96D8, 0x96DD, 0x9723, 0x9722, 0x9725,
+	0x97AC, 0x97AE, 0x97A8, 0x97AB, 0x97A4, 0x97AA, 0x97A2, 0x97A5, 0x97D7, 0x97D9, 0x97D6, 0x97D8,
+	0x97FA, 0x9850, 0x9851, 0x9852, 0x98B8, 0x9941, 0x993C, 0x993A, 0x9A0F, 0x9A0B, 0x9A09, 0x9A0D,
+	0x9A04, 0x9A11, 0x9A0A, 0x9A05, 0x9A07, 0x9A06, 0x9AC0, 0x9ADC, 0x9B08, 0x9B04, 0x9B05, 0x9B29,
+	0x9B35, 0x9B4A, 0x9B4C, 0x9B4B, 0x9BC7, 0x9BC6, 0x9BC3, 0x9BBF, 0x9BC1, 0x9BB5, 0x9BB8, 0x9BD3,
+	0x9BB6, 0x9BC4, 0x9BB9, 0x9BBD, 0x9D5C, 0x9D53, 0x9D4F, 0x9D4A, 0x9D5B, 0x9D4B,
+	// row 69
+	0x9D59, 0x9D56, 0x9D4C, 0x9D57, 0x9D52, 0x9D54, 0x9D5F, 0x9D58, 0x9D5A, 0x9E8E, 0x9E8C, 0x9EDF,
+	0x9F01, 0x9F00, 0x9F16, 0x9F25, 0x9F2B, 0x9F2A, 0x9F29, 0x9F28, 0x9F4C, 0x9F55, 0x5134, 0x5135,
+	0x5296, 0x52F7, 0x53B4, 0x56AB, 0x56AD, 0x56A6, 0x56A7, 0x56AA, 0x56AC, 0x58DA, 0x58DD, 0x58DB,
+	0x5912, 0x5B3D, 0x5B3E, 0x5B3F, 0x5DC3, 0x5E70, 0x5FBF, 0x61FB, 0x6507, 0x6510, 0x650D, 0x6509,
+	0x650C, 0x650E, 0x6584, 0x65DE, 0x65DD, 0x66DE, 0x6AE7, 0x6AE0, 0x6ACC, 0x6AD1, 0x6AD9, 0x6ACB,
+	0x6ADF, 0x6ADC, 0x6AD0, 0x6AEB, 0x6ACF, 0x6ACD, 0x6ADE, 0x6B60, 0x6BB0, 0x6C0C, 0x7019, 0x7027,
+	0x7020, 0x7016, 0x702B, 0x7021, 0x7022, 0x7023, 0x7029, 0x7017, 0x7024, 0x701C, 0x720C, 0x720A,
+	0x7207, 0x7202, 0x7205, 0x72A5, 0x72A6, 0x72A4, 0x72A3, 0x72A1, 0x74CB, 0x74C5,
+	// row 70
+	0x74B7, 0x74C3, 0x7516, 0x7660, 0x77C9, 0x77CA, 0x77C4, 0x77F1, 0x791D, 0x791B, 0x7921, 0x791C,
+	0x7917, 0x791E, 0x79B0, 0x7A67, 0x7A68, 0x7C33, 0x7C3C, 0x7C39, 0x7C2C, 0x7C3B, 0x7CEC, 0x7CEA,
+	0x7E76, 0x7E75, 0x7E78, 0x7E70, 0x7E77, 0x7E6F, 0x7E7A, 0x7E72, 0x7E74, 0x7E68, 0x7F4B, 0x7F4A,
+	0x7F83, 0x7F86, 0x7FB7, 0x7FFD, 0x7FFE, 0x8078, 0x81D7, 0x81D5, 0x820B, 0x8264, 0x8261, 0x8263,
+	0x85EB, 0x85F1, 0x85ED, 0x85D9, 0x85E1, 0x85E8, 0x85DA, 0x85D7, 0x85EC, 0x85F2, 0x85F8, 0x85D8,
+	0x85DF, 0x85E3, 0x85DC, 0x85D1, 0x85F0, 0x85E6, 0x85EF, 0x85DE, 0x85E2, 0x8800, 0x87FA, 0x8803,
+	0x87F6, 0x87F7, 0x8809, 0x880C, 0x880B, 0x8806, 0x87FC, 0x8808, 0x87FF, 0x880A, 0x8802, 0x8962,
+	0x895A, 0x895B, 0x8957, 0x8961, 0x895C, 0x8958, 0x895D, 0x8959, 0x8988, 0x89B7,
+	// row 71
+	0x89B6, 0x89F6, 0x8B50, 0x8B48, 0x8B4A, 0x8B40, 0x8B53, 0x8B56, 0x8B54, 0x8B4B, 0x8B55, 0x8B51,
+	0x8B42, 0x8B52, 0x8B57, 0x8C43, 0x8C77, 0x8C76, 0x8C9A, 0x8D06, 0x8D07, 0x8D09, 0x8DAC, 0x8DAA,
+	0x8DAD, 0x8DAB, 0x8E6D, 0x8E78, 0x8E73, 0x8E6A, 0x8E6F, 0x8E7B, 0x8EC2, 0x8F52, 0x8F51, 0x8F4F,
+	0x8F50, 0x8F53, 0x8FB4, 0x9140, 0x913F, 0x91B0, 0x91AD, 0x93DE, 0x93C7, 0x93CF, 0x93C2, 0x93DA,
+	0x93D0, 0x93F9, 0x93EC, 0x93CC, 0x93D9, 0x93A9, 0x93E6, 0x93CA, 0x93D4, 0x93EE, 0x93E3, 0x93D5,
+	0x93C4, 0x93CE, 0x93C0, 0x93D2, 0x93A5, 0x93E7, 0x957D, 0x95DA, 0x95DB, 0x96E1, 0x9729, 0x972B,
+	0x972C, 0x9728, 0x9726, 0x97B3, 0x97B7, 0x97B6, 0x97DD, 0x97DE, 0x97DF, 0x985C, 0x9859, 0x985D,
+	0x9857, 0x98BF, 0x98BD, 0x98BB, 0x98BE, 0x9948, 0x9947, 0x9943, 0x99A6, 0x99A7,
+	// row 72
+	0x9A1A, 0x9A15, 0x9A25, 0x9A1D, 0x9A24, 0x9A1B, 0x9A22, 0x9A20, 0x9A27, 0x9A23, 0x9A1E, 0x9A1C,
+	0x9A14, 0x9AC2, 0x9B0B, 0x9B0A, 0x9B0E, 0x9B0C, 0x9B37, 0x9BEA, 0x9BEB, 0x9BE0, 0x9BDE, 0x9BE4,
+	0x9BE6, 0x9BE2, 0x9BF0, 0x9BD4, 0x9BD7, 0x9BEC, 0x9BDC, 0x9BD9, 0x9BE5, 0x9BD5, 0x9BE1, 0x9BDA,
+	0x9D77, 0x9D81, 0x9D8A, 0x9D84, 0x9D88, 0x9D71, 0x9D80, 0x9D78, 0x9D86, 0x9D8B, 0x9D8C, 0x9D7D,
+	0x9D6B, 0x9D74, 0x9D75, 0x9D70, 0x9D69, 0x9D85, 0x9D73, 0x9D7B, 0x9D82, 0x9D6F, 0x9D79, 0x9D7F,
+	0x9D87, 0x9D68, 0x9E94, 0x9E91, 0x9EC0, 0x9EFC, 0x9F2D, 0x9F40, 0x9F41, 0x9F4D, 0x9F56, 0x9F57,
+	0x9F58, 0x5337, 0x56B2, 0x56B5, 0x56B3, 0x58E3, 0x5B45, 0x5DC6, 0x5DC7, 0x5EEE, 0x5EEF, 0x5FC0,
+	0x5FC1, 0x61F9, 0x6517, 0x6516, 0x6515, 0x6513, 0x65DF, 0x66E8, 0x66E3, 0x66E4,
+	// row 73
+	0x6AF3, 0x6AF0, 0x6AEA, 0x6AE8, 0x6AF9, 0x6AF1, 0x6AEE, 0x6AEF, 0x703C, 0x7035, 0x702F, 0x7037,
+	0x7034, 0x7031, 0x7042, 0x7038, 0x703F, 0x703A, 0x7039, 0x702A, 0x7040, 0x703B, 0x7033, 0x7041,
+	0x7213, 0x7214, 0x72A8, 0x737D, 0x737C, 0x74BA, 0x76AB, 0x76AA, 0x76BE, 0x76ED, 0x77CC, 0x77CE,
+	0x77CF, 0x77CD, 0x77F2, 0x7925, 0x7923, 0x7927, 0x7928, 0x7924, 0x7929, 0x79B2, 0x7A6E, 0x7A6C,
+	0x7A6D, 0x7AF7, 0x7C49, 0x7C48, 0x7C4A, 0x7C47, 0x7C45, 0x7CEE, 0x7E7B, 0x7E7E, 0x7E81, 0x7E80,
+	0x7FBA, 0x7FFF, 0x8079, 0x81DB, 0x81D9, 0x8268, 0x8269, 0x8622, 0x85FF, 0x8601, 0x85FE, 0x861B,
+	0x8600, 0x85F6, 0x8604, 0x8609, 0x8605, 0x860C, 0x85FD, 0x8819, 0x8810, 0x8811, 0x8817, 0x8813,
+	0x8816, 0x8963, 0x8966, 0x89B9, 0x89F7, 0x8B60, 0x8B6A, 0x8B5D, 0x8B68, 0x8B63,
+	// row 74
+	0x8B65, 0x8B67, 0x8B6D, 0x8DAE, 0x8E86, 0x8E88, 0x8E84, 0x8F59, 0x8F56, 0x8F57, 0x8F55, 0x8F58,
+	0x8F5A, 0x908D, 0x9143, 0x9141, 0x91B7, 0x91B5, 0x91B2, 0x91B3, 0x940B, 0x9413, 0x93FB, 0x9420,
+	0x940F, 0x9414, 0x93FE, 0x9415, 0x9410, 0x9428, 0x9419, 0x940D, 0x93F5, 0x9400, 0x93F7, 0x9407,
+	0x940E, 0x9416, 0x9412, 0x93FA, 0x9409, 0x93F8, 0x943C, 0x940A, 0x93FF, 0x93FC, 0x940C, 0x93F6,
+	0x9411, 0x9406, 0x95DE, 0x95E0, 0x95DF, 0x972E, 0x972F, 0x97B9, 0x97BB, 0x97FD, 0x97FE, 0x9860,
+	0x9862, 0x9863, 0x985F, 0x98C1, 0x98C2, 0x9950, 0x994E, 0x9959, 0x994C, 0x994B, 0x9953, 0x9A32,
+	0x9A34, 0x9A31, 0x9A2C, 0x9A2A, 0x9A36, 0x9A29, 0x9A2E, 0x9A38, 0x9A2D, 0x9AC7, 0x9ACA, 0x9AC6,
+	0x9B10, 0x9B12, 0x9B11, 0x9C0B, 0x9C08, 0x9BF7, 0x9C05, 0x9C12, 0x9BF8, 0x9C40,
+	// row 75
+	0x9C07, 0x9C0E, 0x9C06, 0x9C17, 0x9C14, 0x9C09, 0x9D9F, 0x9D99, 0x9DA4, 0x9D9D, 0x9D92, 0x9D98,
+	0x9D90, 0x9D9B, 0x9DA0, 0x9D94, 0x9D9C, 0x9DAA, 0x9D97, 0x9DA1, 0x9D9A, 0x9DA2, 0x9DA8, 0x9D9E,
+	0x9DA3, 0x9DBF, 0x9DA9, 0x9D96, 0x9DA6, 0x9DA7, 0x9E99, 0x9E9B, 0x9E9A, 0x9EE5, 0x9EE4, 0x9EE7,
+	0x9EE6, 0x9F30, 0x9F2E, 0x9F5B, 0x9F60, 0x9F5E, 0x9F5D, 0x9F59, 0x9F91, 0x513A, 0x5139, 0x5298,
+	0x5297, 0x56C3, 0x56BD, 0x56BE, 0x5B48, 0x5B47, 0x5DCB, 0x5DCF, 0x5EF1, 0x61FD, 0x651B, 0x6B02,
+	0x6AFC, 0x6B03, 0x6AF8, 0x6B00, 0x7043, 0x7044, 0x704A, 0x7048, 0x7049, 0x7045, 0x7046, 0x721D,
+	0x721A, 0x7219, 0x737E, 0x7517, 0x766A, 0x77D0, 0x792D, 0x7931, 0x792F, 0x7C54, 0x7C53, 0x7CF2,
+	0x7E8A, 0x7E87, 0x7E88, 0x7E8B, 0x7E86, 0x7E8D, 0x7F4D, 0x7FBB, 0x8030, 0x81DD,
+	// row 76
+	0x8618, 0x862A, 0x8626, 0x861F, 0x8623, 0x861C, 0x8619, 0x8627, 0x862E, 0x8621, 0x8620, 0x8629,
+	0x861E, 0x8625, 0x8829, 0x881D, 0x881B, 0x8820, 0x8824, 0x881C, 0x882B, 0x884A, 0x896D, 0x8969,
+	0x896E, 0x896B, 0x89FA, 0x8B79, 0x8B78, 0x8B45, 0x8B7A, 0x8B7B, 0x8D10, 0x8D14, 0x8DAF, 0x8E8E,
+	0x8E8C, 0x8F5E, 0x8F5B, 0x8F5D, 0x9146, 0x9144, 0x9145, 0x91B9, 0x943F, 0x943B, 0x9436, 0x9429,
+	0x943D, 0x9430, 0x9439, 0x942A, 0x9437, 0x942C, 0x9440, 0x9431, 0x95E5, 0x95E4, 0x95E3, 0x9735,
+	0x973A, 0x97BF, 0x97E1, 0x9864, 0x98C9, 0x98C6, 0x98C0, 0x9958, 0x9956, 0x9A39, 0x9A3D, 0x9A46,
+	0x9A44, 0x9A42, 0x9A41, 0x9A3A, 0x9A3F, 0x9ACD, 0x9B15, 0x9B17, 0x9B18, 0x9B16, 0x9B3A, 0x9B52,
+	0x9C2B, 0x9C1D, 0x9C1C, 0x9C2C, 0x9C23, 0x9C28, 0x9C29, 0x9C24, 0x9C21, 0x9DB7,
+	// row 77
+	0x9DB6, 0x9DBC, 0x9DC1, 0x9DC7, 0x9DCA, 0x9DCF, 0x9DBE, 0x9DC5, 0x9DC3, 0x9DBB, 0x9DB5, 0x9DCE,
+	0x9DB9, 0x9DBA, 0x9DAC, 0x9DC8, 0x9DB1, 0x9DAD, 0x9DCC, 0x9DB3, 0x9DCD, 0x9DB2, 0x9E7A, 0x9E9C,
+	0x9EEB, 0x9EEE, 0x9EED, 0x9F1B, 0x9F18, 0x9F1A, 0x9F31, 0x9F4E, 0x9F65, 0x9F64, 0x9F92, 0x4EB9,
+	0x56C6, 0x56C5, 0x56CB, 0x5971, 0x5B4B, 0x5B4C, 0x5DD5, 0x5DD1, 0x5EF2, 0x6521, 0x6520, 0x6526,
+	0x6522, 0x6B0B, 0x6B08, 0x6B09, 0x6C0D, 0x7055, 0x7056, 0x7057, 0x7052, 0x721E, 0x721F, 0x72A9,
+	0x737F, 0x74D8, 0x74D5, 0x74D9, 0x74D7, 0x766D, 0x76AD, 0x7935, 0x79B4, 0x7A70, 0x7A71, 0x7C57,
+	0x7C5C, 0x7C59, 0x7C5B, 0x7C5A, 0x7CF4, 0x7CF1, 0x7E91, 0x7F4F, 0x7F87, 0x81DE, 0x826B, 0x8634,
+	0x8635, 0x8633, 0x862C, 0x8632, 0x8636, 0x882C, 0x8828, 0x8826, 0x882A, 0x8825,
+	// row 78
+	0x8971, 0x89BF, 0x89BE, 0x89FB, 0x8B7E, 0x8B84, 0x8B82, 0x8B86, 0x8B85, 0x8B7F, 0x8D15, 0x8E95,
+	0x8E94, 0x8E9A, 0x8E92, 0x8E90, 0x8E96, 0x8E97, 0x8F60, 0x8F62, 0x9147, 0x944C, 0x9450, 0x944A,
+	0x944B, 0x944F, 0x9447, 0x9445, 0x9448, 0x9449, 0x9446, 0x973F, 0x97E3, 0x986A, 0x9869, 0x98CB,
+	0x9954, 0x995B, 0x9A4E, 0x9A53, 0x9A54, 0x9A4C, 0x9A4F, 0x9A48, 0x9A4A, 0x9A49, 0x9A52, 0x9A50,
+	0x9AD0, 0x9B19, 0x9B2B, 0x9B3B, 0x9B56, 0x9B55, 0x9C46, 0x9C48, 0x9C3F, 0x9C44, 0x9C39, 0x9C33,
+	0x9C41, 0x9C3C, 0x9C37, 0x9C34, 0x9C32, 0x9C3D, 0x9C36, 0x9DDB, 0x9DD2, 0x9DDE, 0x9DDA, 0x9DCB,
+	0x9DD0, 0x9DDC, 0x9DD1, 0x9DDF, 0x9DE9, 0x9DD9, 0x9DD8, 0x9DD6, 0x9DF5, 0x9DD5, 0x9DDD, 0x9EB6,
+	0x9EF0, 0x9F35, 0x9F33, 0x9F32, 0x9F42, 0x9F6B, 0x9F95, 0x9FA2, 0x513D, 0x5299,
+	// row 79
+	0x58E8, 0x58E7, 0x5972, 0x5B4D, 0x5DD8, 0x882F, 0x5F4F, 0x6201, 0x6203, 0x6204, 0x6529, 0x6525,
+	0x6596, 0x66EB, 0x6B11, 0x6B12, 0x6B0F, 0x6BCA, 0x705B, 0x705A, 0x7222, 0x7382, 0x7381, 0x7383,
+	0x7670, 0x77D4, 0x7C67, 0x7C66, 0x7E95, 0x826C, 0x863A, 0x8640, 0x8639, 0x863C, 0x8631, 0x863B,
+	0x863E, 0x8830, 0x8832, 0x882E, 0x8833, 0x8976, 0x8974, 0x8973, 0x89FE, 0x8B8C, 0x8B8E, 0x8B8B,
+	0x8B88, 0x8C45, 0x8D19, 0x8E98, 0x8F64, 0x8F63, 0x91BC, 0x9462, 0x9455, 0x945D, 0x9457, 0x945E,
+	0x97C4, 0x97C5, 0x9800, 0x9A56, 0x9A59, 0x9B1E, 0x9B1F, 0x9B20, 0x9C52, 0x9C58, 0x9C50, 0x9C4A,
+	0x9C4D, 0x9C4B, 0x9C55, 0x9C59, 0x9C4C, 0x9C4E, 0x9DFB, 0x9DF7, 0x9DEF, 0x9DE3, 0x9DEB, 0x9DF8,
+	0x9DE4, 0x9DF6, 0x9DE1, 0x9DEE, 0x9DE6, 0x9DF2, 0x9DF0, 0x9DE2, 0x9DEC, 0x9DF4,
+	// row 80
+	0x9DF3, 0x9DE8, 0x9DED, 0x9EC2, 0x9ED0, 0x9EF2, 0x9EF3, 0x9F06, 0x9F1C, 0x9F38, 0x9F37, 0x9F36,
+	0x9F43, 0x9F4F, 0x9F71, 0x9F70, 0x9F6E, 0x9F6F, 0x56D3, 0x56CD, 0x5B4E, 0x5C6D, 0x652D, 0x66ED,
+	0x66EE, 0x6B13, 0x705F, 0x7061, 0x705D, 0x7060, 0x7223, 0x74DB, 0x74E5, 0x77D5, 0x7938, 0x79B7,
+	0x79B6, 0x7C6A, 0x7E97, 0x7F89, 0x826D, 0x8643, 0x8838, 0x8837, 0x8835, 0x884B, 0x8B94, 0x8B95,
+	0x8E9E, 0x8E9F, 0x8EA0, 0x8E9D, 0x91BE, 0x91BD, 0x91C2, 0x946B, 0x9468, 0x9469, 0x96E5, 0x9746,
+	0x9743, 0x9747, 0x97C7, 0x97E5, 0x9A5E, 0x9AD5, 0x9B59, 0x9C63, 0x9C67, 0x9C66, 0x9C62, 0x9C5E,
+	0x9C60, 0x9E02, 0x9DFE, 0x9E07, 0x9E03, 0x9E06, 0x9E05, 0x9E00, 0x9E01, 0x9E09, 0x9DFF, 0x9DFD,
+	0x9E04, 0x9EA0, 0x9F1E, 0x9F46, 0x9F74, 0x9F75, 0x9F76, 0x56D4, 0x652E, 0x65B8,
+	// row 81
+	0x6B18, 0x6B19, 0x6B17, 0x6B1A, 0x7062, 0x7226, 0x72AA, 0x77D8, 0x77D9, 0x7939, 0x7C69, 0x7C6B,
+	0x7CF6, 0x7E9A, 0x7E98, 0x7E9B, 0x7E99, 0x81E0, 0x81E1, 0x8646, 0x8647, 0x8648, 0x8979, 0x897A,
+	0x897C, 0x897B, 0x89FF, 0x8B98, 0x8B99, 0x8EA5, 0x8EA4, 0x8EA3, 0x946E, 0x946D, 0x946F, 0x9471,
+	0x9473, 0x9749, 0x9872, 0x995F, 0x9C68, 0x9C6E, 0x9C6D, 0x9E0B, 0x9E0D, 0x9E10, 0x9E0F, 0x9E12,
+	0x9E11, 0x9EA1, 0x9EF5, 0x9F09, 0x9F47, 0x9F78, 0x9F7B, 0x9F7A, 0x9F79, 0x571E, 0x7066, 0x7C6F,
+	0x883C, 0x8DB2, 0x8EA6, 0x91C3, 0x9474, 0x9478, 0x9476, 0x9475, 0x9A60, 0x9B2E, 0x9C74, 0x9C73,
+	0x9C71, 0x9C75, 0x9E14, 0x9E13, 0x9EF6, 0x9F0A, 0x9FA4, 0x7068, 0x7065, 0x7CF7, 0x866A, 0x883E,
+	0x883D, 0x883F, 0x8B9E, 0x8C9C, 0x8EA9, 0x8EC9, 0x974B, 0x9873, 0x9874, 0x98CC,
+	// row 82
+	0x9961, 0x99AB, 0x9A64, 0x9A66, 0x9A67, 0x9B24, 0x9E15, 0x9E17, 0x9F48, 0x6207, 0x6B1E, 0x7227,
+	0x864C, 0x8EA8, 0x9482, 0x9480, 0x9481, 0x9A69, 0x9A68, 0x9E19, 0x864B, 0x8B9F, 0x9483, 0x9C79,
+	0x9EB7, 0x7675, 0x9A6B, 0x9C7A, 0x9E1D, 0x7069, 0x706A, 0x7229, 0x9EA4, 0x9F7E, 0x9F49, 0x9F98,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 83
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 84
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 85
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 86
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 87
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 88
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 89
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 90
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 91
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 92
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 93
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	// row 94
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+}
+
+var upperPlanes = [5 * 94 * 94]uint32 {
+	// plane 3, row 1
+	0x04E28, 0x04E36, 0x04E3F, 0x04E85, 0x04E05, 0x04E04, 0x05182, 0x05196, 0x05338, 0x05369, 0x053B6, 0x04E2A,
+	0x04E87, 0x04E49, 0x051E2, 0x04E46, 0x04E8F, 0x04EBC, 0x04EBE, 0x05166, 0x051E3, 0x05204, 0x0529C, 0x05344,
+	0x05902, 0x0590A, 0x05B80, 0x05DDB, 0x05E7A, 0x05E7F, 0x05EF4, 0x05F50, 0x05F51, 0x05F61, 0x0961D, 0x2000B,
+	0x04E63, 0x04E62, 0x04EA3, 0x05185, 0x04EC5, 0x04ECF, 0x04ECE, 0x04ECC, 0x05184, 0x05186, 0x2F817, 0x034C5,
+	0x051E4, 0x05205, 0x0529E, 0x0529D, 0x052FD, 0x05300, 0x0533A, 0x03539, 0x05346, 0x0535D, 0x05386, 0x053B7,
+	0x03555, 0x053CC, 0x0355B, 0x053CE, 0x05721, 0x037A2, 0x05E00, 0x05F0C, 0x06237, 0x06238, 0x06534, 0x06535,
+	0x065E0, 0x03E26, 0x0738D, 0x04E97, 0x04EE0, 0x03432, 0x201A9, 0x04EE7, 0x03433, 0x04EE6, 0x03434, 0x036A2,
+	0x03431, 0x034B0, 0x056D8, 0x0518B, 0x0518C, 0x05199, 0x051E5, 0x2F850, 0x0520B, 0x034DC,
+	// plane 3, row 2
+	0x0361E, 0x05304, 0x05303, 0x05307, 0x2F82A, 0x0531E, 0x0535F, 0x0536D, 0x05389, 0x053BA, 0x053D0, 0x03565,
+	0x053F6, 0x053F7, 0x053F9, 0x03564, 0x053F4, 0x0361D, 0x03626, 0x05724, 0x05904, 0x05918, 0x05932, 0x05930,
+	0x05934, 0x0368E, 0x05975, 0x0374A, 0x05B82, 0x05BF9, 0x05C14, 0x0378B, 0x037A6, 0x037A4, 0x037A5, 0x037A7,
+	0x0382F, 0x03832, 0x05E81, 0x05E83, 0x05F0D, 0x05F52, 0x038D4, 0x05FCA, 0x05FC7, 0x06239, 0x039C5, 0x0624F,
+	0x065E7, 0x0672F, 0x06B7A, 0x06C39, 0x03CBA, 0x03CB9, 0x06C37, 0x06C44, 0x06C45, 0x0738C, 0x07592, 0x07676,
+	0x09093, 0x09092, 0x048B3, 0x049BA, 0x04E21, 0x04E20, 0x04E22, 0x04E68, 0x04E89, 0x04E98, 0x04EF9, 0x04EEF,
+	0x0343B, 0x0343C, 0x04EF8, 0x04F06, 0x04F03, 0x04EFC, 0x04EEE, 0x04F16, 0x03439, 0x04F28, 0x04F1C, 0x04F07,
+	0x04F1A, 0x04EFA, 0x04F17, 0x0514A, 0x034B2, 0x05172, 0x2F815, 0x051B4, 0x051B3, 0x051B2,
+	// plane 3, row 3
+	0x034C7, 0x051E8, 0x0342B, 0x05214, 0x0520F, 0x05215, 0x05218, 0x052A8, 0x2F82C, 0x0534B, 0x0534F, 0x0353B,
+	0x05350, 0x03544, 0x0538B, 0x03542, 0x053BE, 0x0355C, 0x053D2, 0x05416, 0x053FF, 0x03567, 0x05400, 0x03566,
+	0x05405, 0x05413, 0x05415, 0x2F83B, 0x0361F, 0x056E3, 0x05735, 0x05736, 0x05731, 0x05732, 0x058EE, 0x05905,
+	0x04E54, 0x0368F, 0x05936, 0x03690, 0x036A8, 0x036A4, 0x0597A, 0x036A3, 0x05986, 0x0373D, 0x0374C, 0x05B86,
+	0x05F53, 0x05C18, 0x0378C, 0x05C3D, 0x05C78, 0x037A8, 0x037AD, 0x037AF, 0x21D46, 0x05C80, 0x03829, 0x05E08,
+	0x03836, 0x03871, 0x03870, 0x0386F, 0x05EF5, 0x05F0E, 0x038A9, 0x038AA, 0x038FB, 0x05FD3, 0x05FDA, 0x038FC,
+	0x05FDB, 0x039AE, 0x0620F, 0x0625D, 0x0625F, 0x06267, 0x06257, 0x09F50, 0x03AC3, 0x065EB, 0x065EA, 0x03B30,
+	0x06737, 0x03B41, 0x06732, 0x06736, 0x06B22, 0x06BCE, 0x03C8C, 0x06C58, 0x06C51, 0x06C77,
+	// plane 3, row 4
+	0x06C3C, 0x03CBB, 0x06C5A, 0x23C86, 0x06C53, 0x0706F, 0x07072, 0x0706E, 0x2F835, 0x03DA1, 0x07073, 0x072B1,
+	0x072B2, 0x03EA8, 0x0738F, 0x03EAA, 0x03EAB, 0x04096, 0x0793C, 0x041C2, 0x0808D, 0x0808E, 0x04493, 0x0827B,
+	0x04494, 0x08D71, 0x08FB9, 0x09096, 0x0909A, 0x049BB, 0x04E24, 0x04E71, 0x2F81B, 0x04E9C, 0x04F45, 0x04F4A,
+	0x04F39, 0x04F37, 0x03443, 0x04F32, 0x04F42, 0x03442, 0x04F44, 0x04F4B, 0x03444, 0x04F40, 0x04F35, 0x04F31,
+	0x05151, 0x2F80E, 0x05150, 0x0514E, 0x034B3, 0x034B7, 0x0519D, 0x034C8, 0x051B5, 0x051B8, 0x051EC, 0x05223,
+	0x05227, 0x05226, 0x0521F, 0x0522B, 0x05220, 0x052B4, 0x052B3, 0x03518, 0x05325, 0x0533B, 0x05374, 0x03547,
+	0x03546, 0x03545, 0x0356B, 0x03569, 0x0544D, 0x03572, 0x03571, 0x0543A, 0x0356C, 0x0356F, 0x05444, 0x0544C,
+	0x05423, 0x0541A, 0x05432, 0x0544B, 0x05421, 0x03573, 0x05434, 0x05449, 0x05450, 0x05422,
+	// plane 3, row 5
+	0x0543F, 0x05451, 0x0545A, 0x0542F, 0x03576, 0x056E9, 0x056F2, 0x056F3, 0x056EF, 0x056ED, 0x056EC, 0x056E6,
+	0x05748, 0x03627, 0x05744, 0x0573F, 0x0573C, 0x05753, 0x05756, 0x03630, 0x0575F, 0x05743, 0x05758, 0x05757,
+	0x03629, 0x0362A, 0x0362F, 0x05746, 0x0362C, 0x0573D, 0x0362D, 0x05742, 0x05754, 0x05755, 0x058F1, 0x058F2,
+	0x058F0, 0x0590B, 0x09EA6, 0x056F1, 0x0593D, 0x03693, 0x05994, 0x0598C, 0x036AD, 0x0599C, 0x036AC, 0x036AB,
+	0x0599F, 0x036A9, 0x0599B, 0x036AE, 0x05989, 0x0599A, 0x036AA, 0x06588, 0x0374E, 0x05B8D, 0x03750, 0x05BFE,
+	0x05BFF, 0x05BFD, 0x05C2B, 0x037B2, 0x05C84, 0x05C8E, 0x05C9C, 0x037B5, 0x037B6, 0x05C85, 0x05DF5, 0x05E09,
+	0x03839, 0x0383B, 0x05E0B, 0x03872, 0x05E92, 0x05E90, 0x05F03, 0x038AC, 0x05F1E, 0x05F63, 0x03908, 0x05FE7,
+	0x05FFE, 0x05FE6, 0x05FDC, 0x05FCE, 0x03903, 0x05FFC, 0x05FDF, 0x05FEC, 0x05FF6, 0x225D7,
+	// plane 3, row 6
+	0x05FF2, 0x05FF0, 0x05FF9, 0x0390B, 0x06213, 0x039AF, 0x2F8B2, 0x0623B, 0x0623C, 0x06282, 0x039CE, 0x039CB,
+	0x039CC, 0x06278, 0x0628B, 0x039CD, 0x0629E, 0x062A5, 0x0629B, 0x0629C, 0x06299, 0x0628D, 0x06285, 0x0629D,
+	0x06275, 0x03A80, 0x03AAF, 0x03AD3, 0x065F6, 0x03AD5, 0x03AD4, 0x03AD7, 0x066F5, 0x0675B, 0x03B42, 0x06754,
+	0x06752, 0x03B44, 0x06758, 0x06744, 0x0674A, 0x06761, 0x03CC6, 0x06C7F, 0x06C91, 0x06C9E, 0x03CC0, 0x06C6E,
+	0x06C7C, 0x06C9F, 0x06C75, 0x03CBE, 0x06C56, 0x06CA2, 0x06C79, 0x03CCA, 0x06CA1, 0x03CC4, 0x06CAA, 0x06CA0,
+	0x03CC2, 0x07079, 0x07077, 0x0707E, 0x03DA4, 0x07075, 0x0707B, 0x07264, 0x03E29, 0x072BB, 0x072BC, 0x072C7,
+	0x072B9, 0x072BE, 0x072B6, 0x03E60, 0x03E5E, 0x07398, 0x03EAD, 0x03EAE, 0x03EAC, 0x03F57, 0x07593, 0x07680,
+	0x03FDD, 0x07683, 0x076C0, 0x076C1, 0x0400E, 0x04097, 0x077F4, 0x077F5, 0x04127, 0x07ACC,
+	// plane 3, row 7
+	0x07ACD, 0x07CFA, 0x0809F, 0x08091, 0x08097, 0x08094, 0x04495, 0x08286, 0x0828C, 0x2F98F, 0x08295, 0x04498,
+	0x0866C, 0x0459D, 0x08FB5, 0x08FBE, 0x08FC7, 0x0488A, 0x08FC1, 0x090A9, 0x090A4, 0x048B5, 0x048B6, 0x048B7,
+	0x090A8, 0x09627, 0x09626, 0x0962B, 0x09633, 0x09634, 0x09629, 0x04E3D, 0x03428, 0x04E9D, 0x04F93, 0x04F8A,
+	0x0344D, 0x03449, 0x04F6D, 0x04F8E, 0x04FA0, 0x04FA2, 0x04FA1, 0x04F9F, 0x04FA3, 0x20209, 0x04F72, 0x03451,
+	0x04F8C, 0x05156, 0x2F80F, 0x2F811, 0x05190, 0x034CB, 0x034CA, 0x034CC, 0x051ED, 0x051FE, 0x0522F, 0x206EC,
+	0x0523C, 0x05234, 0x05239, 0x052B9, 0x052B5, 0x052BF, 0x05355, 0x0353D, 0x05376, 0x0537A, 0x05393, 0x03548,
+	0x053C1, 0x053C2, 0x053D5, 0x05485, 0x03578, 0x0545F, 0x05493, 0x05489, 0x05479, 0x09EFE, 0x0548F, 0x05469,
+	0x0546D, 0x0357A, 0x05494, 0x0546A, 0x0548A, 0x03577, 0x056FD, 0x056FB, 0x056F8, 0x03621,
+	// plane 3, row 8
+	0x056FC, 0x056F6, 0x05765, 0x05781, 0x05763, 0x05767, 0x03631, 0x0576E, 0x05778, 0x0577F, 0x03633, 0x03634,
+	0x058F3, 0x0594B, 0x0594C, 0x036C1, 0x036B0, 0x036B4, 0x059AD, 0x036B8, 0x059C4, 0x036BC, 0x059C2, 0x059B0,
+	0x036BF, 0x036B5, 0x036B1, 0x036BD, 0x059BF, 0x036BB, 0x059C9, 0x059B8, 0x059AC, 0x036B3, 0x036B6, 0x036BA,
+	0x059B7, 0x059D7, 0x036B7, 0x05B60, 0x03740, 0x05B96, 0x05B9E, 0x05B94, 0x05B9F, 0x05B9D, 0x03752, 0x05C00,
+	0x05C19, 0x03790, 0x03791, 0x05C49, 0x05C4A, 0x037BE, 0x05CBB, 0x05CC1, 0x037C0, 0x037C1, 0x037B9, 0x05CB9,
+	0x05C9E, 0x05CB4, 0x05CBA, 0x05DF6, 0x05E13, 0x05E12, 0x05E77, 0x03879, 0x05E98, 0x0387B, 0x05E99, 0x05E9D,
+	0x05EF8, 0x038A0, 0x05EF9, 0x03429, 0x05F06, 0x05F21, 0x038AE, 0x05F25, 0x05F55, 0x038CD, 0x038CB, 0x038D9,
+	0x05F84, 0x05F83, 0x06030, 0x06007, 0x0390C, 0x06036, 0x03901, 0x03905, 0x03902, 0x05FE9,
+	// plane 3, row 9
+	0x0603D, 0x06008, 0x03913, 0x03911, 0x062BA, 0x062B2, 0x039E4, 0x062B7, 0x062E4, 0x062A7, 0x039DA, 0x039D5,
+	0x039D3, 0x062D5, 0x062E1, 0x062DD, 0x062A6, 0x062C1, 0x062C5, 0x062C0, 0x062DF, 0x062E0, 0x062DE, 0x039D6,
+	0x06589, 0x03AB4, 0x065A6, 0x065BA, 0x03AD9, 0x065FF, 0x03AD8, 0x06617, 0x06618, 0x06601, 0x065FE, 0x03B33,
+	0x0670C, 0x03B48, 0x0676B, 0x06796, 0x06782, 0x0678A, 0x03B47, 0x067A3, 0x03B4B, 0x067A2, 0x0678F, 0x03B4A,
+	0x067F9, 0x06780, 0x06B26, 0x06B27, 0x06B68, 0x06B69, 0x03C5A, 0x06B81, 0x06BB4, 0x06BD1, 0x03C8E, 0x03CB4,
+	0x06C1C, 0x03CCD, 0x03CCC, 0x03CCF, 0x03CCB, 0x03CCE, 0x06C97, 0x06C6C, 0x06CDF, 0x03CD2, 0x06CEA, 0x03CD1,
+	0x06CE4, 0x06CD8, 0x06CB2, 0x06CCE, 0x06CC8, 0x03DA6, 0x0708B, 0x07088, 0x07090, 0x0708F, 0x03DAA, 0x07087,
+	0x07089, 0x0708D, 0x07081, 0x03DA8, 0x0708C, 0x03E13, 0x03E1A, 0x07240, 0x03E1D, 0x03E1E,
+	// plane 3, row 10
+	0x07265, 0x07266, 0x07268, 0x03E65, 0x03E66, 0x072CD, 0x072D3, 0x072DB, 0x03E64, 0x072CF, 0x073A7, 0x073A3,
+	0x0739E, 0x03EB0, 0x073AF, 0x03EB3, 0x03EB5, 0x073AA, 0x0739C, 0x03F19, 0x07542, 0x07544, 0x0753B, 0x07541,
+	0x2233F, 0x0759B, 0x0759E, 0x03F75, 0x079C4, 0x079C3, 0x079C6, 0x0412B, 0x0412C, 0x079C7, 0x0412D, 0x079CA,
+	0x2592E, 0x041C3, 0x07ACF, 0x07C76, 0x07C74, 0x07CFF, 0x07CFC, 0x034BA, 0x04350, 0x07F59, 0x080A8, 0x043D3,
+	0x043D0, 0x080B0, 0x043DC, 0x080B3, 0x043D2, 0x080A4, 0x080B6, 0x080A7, 0x080AC, 0x043DB, 0x080A6, 0x05367,
+	0x0820E, 0x082C4, 0x0833E, 0x0829C, 0x044A5, 0x0449F, 0x0449A, 0x0449C, 0x044A2, 0x082AA, 0x0449B, 0x082C9,
+	0x044A3, 0x0449D, 0x082A6, 0x082B2, 0x04588, 0x0461A, 0x0488D, 0x08FCC, 0x08FD9, 0x08FCA, 0x08FD8, 0x08FCF,
+	0x090B7, 0x048B8, 0x090AD, 0x090B9, 0x09637, 0x049C3, 0x09641, 0x0963E, 0x096B6, 0x09751,
+	// plane 3, row 11
+	0x09763, 0x04E57, 0x04E79, 0x04EB2, 0x04EB0, 0x04EAF, 0x04EB1, 0x04FD2, 0x04FD5, 0x0345D, 0x04FBE, 0x04FB8,
+	0x04FB0, 0x04FB1, 0x04FC8, 0x0345A, 0x03457, 0x04FC6, 0x04FCC, 0x04FE5, 0x04FE3, 0x04FB4, 0x0516A, 0x034B8,
+	0x0519F, 0x034C2, 0x051C1, 0x034CF, 0x051C2, 0x051C3, 0x05245, 0x05248, 0x034E7, 0x034E9, 0x0524F, 0x04452,
+	0x034E8, 0x052C5, 0x052CA, 0x052C4, 0x05327, 0x05358, 0x0537D, 0x0354A, 0x053DD, 0x053DC, 0x053DA, 0x053D9,
+	0x054B9, 0x03580, 0x054D0, 0x054B4, 0x054CA, 0x03587, 0x054A3, 0x054DA, 0x054A4, 0x03584, 0x054B2, 0x0549E,
+	0x0549F, 0x054B5, 0x03582, 0x03581, 0x054CD, 0x03583, 0x054CC, 0x03622, 0x05700, 0x057AC, 0x05791, 0x0578E,
+	0x0578D, 0x05792, 0x057A1, 0x05790, 0x057A6, 0x057A8, 0x0363B, 0x0579C, 0x05796, 0x057A7, 0x0363A, 0x03638,
+	0x03639, 0x03636, 0x058F5, 0x03685, 0x05909, 0x05908, 0x03C54, 0x05952, 0x0369A, 0x036C4,
+	// plane 3, row 12
+	0x059DF, 0x036C5, 0x059EB, 0x059EF, 0x059F0, 0x059D5, 0x05A0D, 0x05A04, 0x059F9, 0x05A02, 0x059F8, 0x059E2,
+	0x059D9, 0x059E7, 0x05B6A, 0x03754, 0x03755, 0x05BAB, 0x03756, 0x05C1B, 0x05C2F, 0x03796, 0x0663C, 0x03795,
+	0x03794, 0x037C4, 0x05CD1, 0x05CDC, 0x05CE6, 0x05CE1, 0x05CCD, 0x2F87A, 0x05CE2, 0x05CDD, 0x05CE5, 0x05DFB,
+	0x05DFA, 0x05E1E, 0x03844, 0x05EA1, 0x0387D, 0x0387E, 0x05EFC, 0x05EFB, 0x05F2F, 0x038B2, 0x038B6, 0x05F66,
+	0x2F899, 0x038DC, 0x038DF, 0x0605C, 0x03928, 0x0604E, 0x06051, 0x03919, 0x03910, 0x06023, 0x06031, 0x0607C,
+	0x06052, 0x0392C, 0x06060, 0x0604A, 0x06061, 0x0391B, 0x06218, 0x039C2, 0x039EF, 0x039E3, 0x039E5, 0x039EA,
+	0x039E6, 0x039EE, 0x0631F, 0x06317, 0x062EA, 0x06321, 0x06304, 0x06305, 0x039E8, 0x06531, 0x06544, 0x06540,
+	0x03A85, 0x06542, 0x065BE, 0x03AE0, 0x06629, 0x0661B, 0x03ADD, 0x06623, 0x0662C, 0x0661A,
+	// plane 3, row 13
+	0x06630, 0x0663B, 0x0661E, 0x06637, 0x06638, 0x03AE1, 0x0670E, 0x03B51, 0x03B55, 0x067E8, 0x067D6, 0x03B52,
+	0x067C7, 0x067BC, 0x06852, 0x067BF, 0x067D5, 0x067FE, 0x08363, 0x067FB, 0x2F8DF, 0x067B1, 0x06801, 0x06805,
+	0x06800, 0x067D7, 0x0409E, 0x06B2A, 0x06B6B, 0x03C52, 0x03C5E, 0x03C60, 0x03C5F, 0x06BE1, 0x03C92, 0x03CD6,
+	0x06D23, 0x06CFF, 0x06D14, 0x06D05, 0x06D13, 0x06D06, 0x06D21, 0x03CDE, 0x06D15, 0x06CAF, 0x06CF4, 0x06D02,
+	0x06D45, 0x2F8FE, 0x06D26, 0x03CD9, 0x06D44, 0x03CDD, 0x06D24, 0x070A5, 0x03DAC, 0x070A3, 0x03DB0, 0x070A2,
+	0x070BB, 0x070A0, 0x070AA, 0x03DAF, 0x03DAE, 0x070A8, 0x070B6, 0x070B2, 0x070A7, 0x03DAD, 0x03DAB, 0x070B9,
+	0x0722E, 0x03E16, 0x0723C, 0x03E30, 0x0726D, 0x03E33, 0x03E31, 0x072E7, 0x072ED, 0x03E6E, 0x072EC, 0x072E5,
+	0x072E2, 0x03EB1, 0x073C4, 0x073BD, 0x073CF, 0x073C9, 0x073C1, 0x073D0, 0x03EB7, 0x073CE,
+	// plane 3, row 14
+	0x074ED, 0x074EB, 0x03F1A, 0x074EF, 0x07549, 0x07550, 0x07546, 0x0754A, 0x03F59, 0x0754D, 0x075A6, 0x03F7A,
+	0x03F78, 0x03F7B, 0x075A8, 0x03FDE, 0x03FEC, 0x076C7, 0x076FF, 0x0401E, 0x076FD, 0x077E6, 0x0780A, 0x0409B,
+	0x07804, 0x0780B, 0x07807, 0x0409D, 0x07815, 0x07808, 0x040FD, 0x079D3, 0x079D4, 0x079D0, 0x079D7, 0x07A7C,
+	0x04194, 0x04193, 0x07A7D, 0x07A83, 0x07A82, 0x041C6, 0x07AD4, 0x07AD5, 0x07AD3, 0x07AD0, 0x07AD2, 0x07AFE,
+	0x07AFC, 0x07C77, 0x07C7C, 0x07C7B, 0x042B8, 0x2F96A, 0x042B7, 0x042B9, 0x04353, 0x25133, 0x04352, 0x04351,
+	0x07F8F, 0x080D3, 0x043E3, 0x080CB, 0x080D2, 0x043E2, 0x08109, 0x080E2, 0x080DF, 0x080C6, 0x04463, 0x08224,
+	0x082F7, 0x082D8, 0x082DD, 0x044AA, 0x044A6, 0x082F8, 0x082FC, 0x044A8, 0x044A9, 0x082E9, 0x044AB, 0x082EE,
+	0x044AC, 0x082D0, 0x0830E, 0x082E2, 0x0830B, 0x082FD, 0x05179, 0x08676, 0x0459E, 0x08678,
+	// plane 3, row 15
+	0x0459F, 0x045A0, 0x08675, 0x0867D, 0x0460F, 0x08842, 0x08866, 0x0461C, 0x0898C, 0x08A05, 0x046AE, 0x08A06,
+	0x046B0, 0x08C9F, 0x047D4, 0x08FF1, 0x08FE7, 0x08FE9, 0x08FEF, 0x090C2, 0x090BC, 0x048BB, 0x090C6, 0x090C0,
+	0x048C1, 0x048C2, 0x090CD, 0x090C9, 0x048BE, 0x090C4, 0x048E5, 0x09581, 0x049C6, 0x09CEC, 0x05032, 0x04FF9,
+	0x0501D, 0x04FFF, 0x05004, 0x04FF0, 0x05003, 0x0462E, 0x05002, 0x04FFC, 0x04FF2, 0x05024, 0x05008, 0x05036,
+	0x0502E, 0x03465, 0x05010, 0x05038, 0x05039, 0x04FFD, 0x05056, 0x04FFB, 0x051A3, 0x051A6, 0x051A1, 0x034D1,
+	0x034D0, 0x051C7, 0x051C9, 0x05260, 0x05264, 0x05259, 0x05265, 0x05267, 0x05257, 0x05263, 0x034EE, 0x05253,
+	0x034EF, 0x052CF, 0x0351E, 0x052CE, 0x052D0, 0x052D1, 0x052CC, 0x0354B, 0x0354D, 0x03556, 0x0550D, 0x054F4,
+	0x03592, 0x05513, 0x054EF, 0x054F5, 0x054F9, 0x05502, 0x05500, 0x03593, 0x03590, 0x05518,
+	// plane 3, row 16
+	0x054F0, 0x054F6, 0x2F841, 0x03597, 0x05519, 0x03623, 0x05705, 0x057C9, 0x0363F, 0x057B7, 0x057CD, 0x03643,
+	0x03642, 0x03644, 0x057BE, 0x057BB, 0x03645, 0x057DB, 0x057C8, 0x057C4, 0x057C5, 0x057D1, 0x057CA, 0x057C0,
+	0x036D9, 0x036DE, 0x05A21, 0x05A2A, 0x036CF, 0x05A1D, 0x036CD, 0x05A0B, 0x036DD, 0x036CE, 0x036D3, 0x036D6,
+	0x05A22, 0x036DC, 0x036D1, 0x05A24, 0x036D0, 0x05A14, 0x05A31, 0x036D5, 0x05A2F, 0x05A1A, 0x05A12, 0x036D4,
+	0x036DB, 0x05A26, 0x2172E, 0x03743, 0x05BBC, 0x05BBB, 0x05BB7, 0x05C05, 0x05C06, 0x05C52, 0x05C53, 0x037CD,
+	0x037D1, 0x05CFA, 0x05CEB, 0x037CA, 0x05CF3, 0x05CF5, 0x05CE9, 0x05CEF, 0x037D4, 0x05E2A, 0x05E30, 0x05E2E,
+	0x05E2C, 0x05E2F, 0x05EAF, 0x05EA9, 0x03886, 0x05EFD, 0x05F32, 0x05F8E, 0x05F93, 0x05F8F, 0x0604F, 0x06099,
+	0x03933, 0x0607E, 0x03937, 0x06074, 0x0604B, 0x06073, 0x06075, 0x0392A, 0x0391F, 0x06056,
+	// plane 3, row 17
+	0x060A9, 0x0608B, 0x060A6, 0x03939, 0x06093, 0x060AE, 0x0609E, 0x060A7, 0x06245, 0x039F2, 0x039F8, 0x0632E,
+	0x039F7, 0x06352, 0x06330, 0x0635B, 0x039F4, 0x06319, 0x0631B, 0x039F1, 0x06331, 0x0635D, 0x06337, 0x06335,
+	0x06353, 0x039F5, 0x0635C, 0x0633F, 0x0654B, 0x03A87, 0x04369, 0x0658B, 0x03AB6, 0x0659A, 0x06650, 0x06646,
+	0x0664E, 0x06640, 0x03AE9, 0x0664B, 0x06648, 0x03AEB, 0x06660, 0x06644, 0x0664D, 0x03B34, 0x06837, 0x06824,
+	0x03B62, 0x03B5C, 0x0681B, 0x06836, 0x03B60, 0x0682C, 0x06819, 0x06856, 0x06847, 0x0683E, 0x0681E, 0x2F8E1,
+	0x06815, 0x06822, 0x06827, 0x06859, 0x06858, 0x06855, 0x06830, 0x06823, 0x06B2E, 0x06B2B, 0x06B30, 0x06B6C,
+	0x03C61, 0x06B8B, 0x03C7F, 0x06BE9, 0x06BEA, 0x06BE5, 0x06D6B, 0x03CE5, 0x03CE6, 0x06D73, 0x06D57, 0x03CE9,
+	0x03CF3, 0x06D5D, 0x06D56, 0x06D8F, 0x06D5B, 0x06D1C, 0x06D9A, 0x06D9B, 0x06D99, 0x03CEE,
+	// plane 3, row 18
+	0x06D81, 0x06D71, 0x03CED, 0x03CEC, 0x06D72, 0x06D5C, 0x06D96, 0x070C4, 0x070DB, 0x070CC, 0x070D0, 0x070E3,
+	0x070DF, 0x03DB3, 0x070D6, 0x070EE, 0x070D5, 0x03DB5, 0x03E27, 0x03E35, 0x03E36, 0x0727A, 0x03E71, 0x072F5,
+	0x07302, 0x03EB8, 0x03EC2, 0x073E2, 0x073EC, 0x073D5, 0x073F9, 0x073DF, 0x073E6, 0x03EC8, 0x03EC0, 0x03EC1,
+	0x03EC4, 0x073E4, 0x073E1, 0x074F3, 0x03F1F, 0x03F1C, 0x03F1D, 0x03F4D, 0x07556, 0x07555, 0x07558, 0x07557,
+	0x0755E, 0x075C3, 0x03F87, 0x03F82, 0x075B4, 0x03F7D, 0x075B1, 0x03FDF, 0x04000, 0x076CB, 0x076CC, 0x0772A,
+	0x04020, 0x07716, 0x0770F, 0x04022, 0x04024, 0x0773F, 0x0772B, 0x0770E, 0x07724, 0x04021, 0x07721, 0x07718,
+	0x077DD, 0x040A4, 0x040A5, 0x07824, 0x07836, 0x04101, 0x07958, 0x07959, 0x04103, 0x07962, 0x079DA, 0x079D9,
+	0x04137, 0x079E1, 0x079E5, 0x079E8, 0x079DB, 0x04138, 0x079E2, 0x079F0, 0x04199, 0x04198,
+	// plane 3, row 19
+	0x04197, 0x041C9, 0x07ADA, 0x07ADD, 0x041C7, 0x07ADB, 0x07ADC, 0x041D9, 0x041DB, 0x07B0D, 0x07B0B, 0x07B14,
+	0x07C8E, 0x07C86, 0x0427B, 0x07C87, 0x07C83, 0x07C8B, 0x0427C, 0x042BD, 0x042BC, 0x042C3, 0x07D24, 0x042C1,
+	0x042BF, 0x042C4, 0x07D25, 0x07F62, 0x07F93, 0x07F99, 0x07F97, 0x0437E, 0x0437F, 0x07FC4, 0x07FC6, 0x0800A,
+	0x043B4, 0x043B3, 0x08040, 0x0803C, 0x0803B, 0x080F6, 0x080FF, 0x080EE, 0x08104, 0x08103, 0x08107, 0x2F983,
+	0x043E6, 0x080F7, 0x04459, 0x0445A, 0x0822D, 0x04464, 0x08227, 0x08229, 0x0831F, 0x08357, 0x044B4, 0x044B9,
+	0x044B7, 0x044B5, 0x08321, 0x044C1, 0x044B1, 0x08318, 0x08358, 0x044B3, 0x044BA, 0x0458C, 0x0458B, 0x0458D,
+	0x08684, 0x0869F, 0x0869B, 0x08689, 0x086A6, 0x08692, 0x0868F, 0x086A0, 0x0884F, 0x08878, 0x0887A, 0x0886E,
+	0x0887B, 0x08884, 0x08873, 0x04678, 0x04677, 0x08A0D, 0x08A0B, 0x08A19, 0x046B2, 0x047D6,
+	// plane 3, row 20
+	0x08ED0, 0x04845, 0x04892, 0x04895, 0x08FF9, 0x09009, 0x09008, 0x048C6, 0x090DE, 0x09151, 0x048E7, 0x048E8,
+	0x091DB, 0x091DF, 0x091DE, 0x091D6, 0x091E0, 0x09585, 0x09660, 0x09659, 0x049CB, 0x09656, 0x049CD, 0x049F1,
+	0x096BD, 0x04B22, 0x03421, 0x05042, 0x05059, 0x0346F, 0x05044, 0x05066, 0x05052, 0x05054, 0x05071, 0x05050,
+	0x0507B, 0x0507C, 0x05058, 0x03470, 0x03464, 0x05079, 0x0506C, 0x05078, 0x051A8, 0x051D1, 0x051CF, 0x05268,
+	0x05276, 0x052D4, 0x0352D, 0x053A0, 0x053C4, 0x03558, 0x05558, 0x0554C, 0x05568, 0x035A6, 0x05549, 0x035A4,
+	0x0359F, 0x0555D, 0x05529, 0x20DAE, 0x05554, 0x05553, 0x035A3, 0x0555A, 0x035A0, 0x0553A, 0x0553F, 0x0552B,
+	0x057EA, 0x0364A, 0x057EF, 0x03647, 0x03648, 0x057DD, 0x057FE, 0x2F855, 0x057DE, 0x057E6, 0x03649, 0x057E8,
+	0x057FF, 0x05803, 0x058F7, 0x068A6, 0x0591F, 0x0369E, 0x0595B, 0x0595D, 0x0595E, 0x21637,
+	// plane 3, row 21
+	0x036E8, 0x05A2B, 0x036EC, 0x05A3B, 0x036ED, 0x036E6, 0x05A61, 0x05A3A, 0x05A6E, 0x05A4B, 0x05A6B, 0x036EB,
+	0x036E7, 0x05A45, 0x05A4E, 0x05A68, 0x05A3D, 0x05A71, 0x05A3F, 0x05A6F, 0x05A75, 0x036E9, 0x05A73, 0x05A2C,
+	0x05A59, 0x05A54, 0x05A4F, 0x05A63, 0x0375C, 0x0375D, 0x05BC8, 0x03760, 0x05BC3, 0x0375B, 0x05C5B, 0x05C61,
+	0x03799, 0x05D21, 0x05D0A, 0x05D09, 0x037D8, 0x05D2C, 0x05D08, 0x037DA, 0x037DD, 0x05D2A, 0x05D15, 0x037E0,
+	0x05D10, 0x05D13, 0x037E5, 0x05D2F, 0x05D18, 0x037D7, 0x05DE3, 0x05E39, 0x05E35, 0x05E3A, 0x05E32, 0x0384E,
+	0x0388C, 0x03888, 0x2F88D, 0x05EBB, 0x05EBA, 0x05F34, 0x05F39, 0x038CE, 0x2F89C, 0x038E5, 0x038E6, 0x06098,
+	0x03932, 0x060D0, 0x03940, 0x03947, 0x0394C, 0x060D7, 0x060AA, 0x03935, 0x060A1, 0x060A4, 0x03930, 0x060EE,
+	0x03943, 0x060E7, 0x0394D, 0x060E8, 0x060DE, 0x039B7, 0x039F3, 0x0637E, 0x0638B, 0x03A02,
+	// plane 3, row 22
+	0x03A0B, 0x06379, 0x06386, 0x06393, 0x03A04, 0x06373, 0x0636A, 0x2F8BA, 0x0636C, 0x03A08, 0x0637F, 0x039FC,
+	0x063B2, 0x063BA, 0x039FF, 0x03A00, 0x06366, 0x06374, 0x03A8B, 0x0655A, 0x03A8D, 0x0654E, 0x0654D, 0x0658D,
+	0x0658E, 0x065AD, 0x03ACA, 0x065C7, 0x065CA, 0x03ACB, 0x065C9, 0x2F8CB, 0x065E3, 0x06657, 0x03AF3, 0x06663,
+	0x06667, 0x0671A, 0x06719, 0x06716, 0x03B36, 0x03B6A, 0x0689E, 0x068B6, 0x06898, 0x06873, 0x03B6B, 0x0689A,
+	0x0688E, 0x068B7, 0x068DB, 0x068A5, 0x0686C, 0x068C1, 0x06884, 0x03B71, 0x03B68, 0x06895, 0x0687A, 0x06899,
+	0x03B72, 0x068B8, 0x068B9, 0x06870, 0x03C2E, 0x06B35, 0x03C62, 0x06B90, 0x06BBB, 0x06BED, 0x03C98, 0x03CB5,
+	0x03CEB, 0x06DC1, 0x06DC3, 0x06DCE, 0x03CFB, 0x03CF8, 0x06DAD, 0x06E04, 0x03CF5, 0x06DB9, 0x03D08, 0x06DE7,
+	0x2F907, 0x06E08, 0x06E06, 0x03D0A, 0x06E0A, 0x06DB0, 0x03D06, 0x06DF8, 0x06E0C, 0x03CFD,
+	// plane 3, row 23
+	0x06DB1, 0x03CFA, 0x06E02, 0x06E07, 0x06E09, 0x06E01, 0x06E17, 0x06DFF, 0x06E12, 0x03DBA, 0x03DB9, 0x07103,
+	0x07107, 0x07101, 0x070F5, 0x070F1, 0x07108, 0x070F2, 0x0710F, 0x03DBB, 0x070FE, 0x03E18, 0x03E40, 0x03E3D,
+	0x0731A, 0x07310, 0x0730E, 0x07402, 0x073F3, 0x03ECD, 0x03EC9, 0x073FB, 0x03ECB, 0x03ECA, 0x03ECE, 0x0751B,
+	0x07523, 0x07561, 0x07568, 0x03F5E, 0x07567, 0x075D3, 0x03F91, 0x03F8C, 0x07690, 0x03FE1, 0x04002, 0x076D5,
+	0x076D7, 0x076D6, 0x07730, 0x0402B, 0x07726, 0x0402A, 0x07740, 0x03E14, 0x0771E, 0x040AD, 0x040A3, 0x040AB,
+	0x07847, 0x040AF, 0x0784B, 0x07851, 0x0784F, 0x07842, 0x07846, 0x04104, 0x0796E, 0x0796C, 0x079F2, 0x04144,
+	0x079F1, 0x079F5, 0x079F3, 0x079F9, 0x0413D, 0x04147, 0x0419C, 0x07A9A, 0x07A93, 0x07A91, 0x07AE1, 0x041E0,
+	0x041E4, 0x07B21, 0x07B1C, 0x07B16, 0x07B17, 0x07B36, 0x07B1F, 0x04280, 0x07C93, 0x07C99,
+	// plane 3, row 24
+	0x07C9A, 0x07C9C, 0x042CA, 0x07D49, 0x042D4, 0x07D34, 0x07D37, 0x042D2, 0x07D2D, 0x042CB, 0x07D4C, 0x042CE,
+	0x042D3, 0x07D48, 0x04344, 0x04348, 0x07F3B, 0x04345, 0x04381, 0x04386, 0x04385, 0x08008, 0x0801A, 0x043A3,
+	0x0801D, 0x043B5, 0x08049, 0x08045, 0x08044, 0x07C9B, 0x043FA, 0x043F9, 0x0812A, 0x0812E, 0x043FB, 0x043F2,
+	0x08131, 0x043EF, 0x0811A, 0x08134, 0x08117, 0x0445B, 0x04466, 0x044CE, 0x0831D, 0x08371, 0x08384, 0x08380,
+	0x08372, 0x083A1, 0x035B4, 0x08379, 0x08391, 0x044C8, 0x0839F, 0x083AD, 0x044D1, 0x044C5, 0x08323, 0x044D2,
+	0x08385, 0x0839C, 0x083B7, 0x08658, 0x0865A, 0x0458F, 0x08657, 0x086B2, 0x045A7, 0x086AE, 0x045A5, 0x045A4,
+	0x04611, 0x08845, 0x0889C, 0x08894, 0x088A3, 0x0888F, 0x088A5, 0x088A9, 0x088A6, 0x0888A, 0x088A0, 0x08890,
+	0x08992, 0x08991, 0x08994, 0x046B5, 0x08A26, 0x08A32, 0x08A28, 0x046B4, 0x046BD, 0x08A1C,
+	// plane 3, row 25
+	0x046BB, 0x08A2B, 0x08A20, 0x046B9, 0x08A29, 0x046C2, 0x046BE, 0x046BA, 0x08A21, 0x08C3A, 0x03AB7, 0x08C5B,
+	0x08C58, 0x08C7C, 0x04758, 0x08CA6, 0x08CAE, 0x08CAD, 0x08D65, 0x0479B, 0x08D7E, 0x0479C, 0x08D7C, 0x08D7F,
+	0x08D7A, 0x08DBD, 0x047DA, 0x047DE, 0x08DC0, 0x08DBB, 0x08EAD, 0x08EAF, 0x08ED6, 0x0484D, 0x04846, 0x04847,
+	0x0484B, 0x0484C, 0x08ED9, 0x04848, 0x04899, 0x09012, 0x0900E, 0x09025, 0x0489B, 0x09013, 0x090EE, 0x048CE,
+	0x090AB, 0x090F7, 0x048EB, 0x09159, 0x09154, 0x091F2, 0x091F0, 0x091E5, 0x091F6, 0x0491C, 0x0498C, 0x09587,
+	0x049D1, 0x0965A, 0x049D6, 0x049D3, 0x0966E, 0x049D4, 0x049D0, 0x049D5, 0x09679, 0x04A0B, 0x098E1, 0x098E6,
+	0x04BC6, 0x09EC4, 0x09ED2, 0x04E80, 0x03424, 0x04E81, 0x0508F, 0x05097, 0x05088, 0x05089, 0x03474, 0x0347A,
+	0x05081, 0x05160, 0x20564, 0x034C3, 0x05E42, 0x051D3, 0x034D4, 0x034D5, 0x051D2, 0x051D6,
+	// plane 3, row 26
+	0x05273, 0x034FB, 0x05270, 0x034F7, 0x03532, 0x2F833, 0x053A8, 0x053A6, 0x053C5, 0x05597, 0x055DE, 0x035BA,
+	0x035BF, 0x05596, 0x055B4, 0x035C7, 0x05585, 0x035B7, 0x0559B, 0x055A0, 0x035B9, 0x05559, 0x035C3, 0x05586,
+	0x035BD, 0x035D0, 0x055AF, 0x0557A, 0x035C1, 0x035BE, 0x035CD, 0x0559E, 0x035CB, 0x055A9, 0x0570F, 0x0570E,
+	0x0581A, 0x0364F, 0x0581F, 0x03653, 0x0583C, 0x05818, 0x0583E, 0x05826, 0x03655, 0x0583A, 0x21364, 0x05822,
+	0x03651, 0x058FB, 0x05963, 0x05964, 0x0369F, 0x05AA8, 0x05AA3, 0x05A82, 0x05A88, 0x05AA1, 0x05A85, 0x05A98,
+	0x036FE, 0x05A99, 0x036FB, 0x05A89, 0x05A81, 0x05A96, 0x05A80, 0x036F1, 0x036F5, 0x05A91, 0x036EF, 0x03704,
+	0x03703, 0x036F4, 0x05ACF, 0x036F3, 0x03702, 0x036F7, 0x036FA, 0x036FD, 0x036EE, 0x05A87, 0x05AA0, 0x036F0,
+	0x05A79, 0x036F2, 0x05A86, 0x05AAB, 0x05AAA, 0x05AA4, 0x05A8D, 0x05A7E, 0x03744, 0x05BD5,
+	// plane 3, row 27
+	0x03762, 0x03777, 0x03DC9, 0x05C1E, 0x05C5F, 0x05C5E, 0x05D44, 0x05D3E, 0x037E8, 0x05D48, 0x05D1C, 0x037EF,
+	0x05D5B, 0x05D4D, 0x037E6, 0x037ED, 0x05D57, 0x037E7, 0x05D53, 0x05D4F, 0x037EB, 0x05D3B, 0x05D46, 0x0382D,
+	0x03855, 0x05E46, 0x05E47, 0x03853, 0x05E48, 0x05EC0, 0x05EBD, 0x05EBF, 0x03890, 0x05F11, 0x038BE, 0x05F3E,
+	0x05F3B, 0x038BD, 0x05F3A, 0x038CF, 0x038D0, 0x038EC, 0x05FA7, 0x0394B, 0x060EA, 0x03948, 0x06107, 0x06122,
+	0x0610C, 0x03955, 0x03951, 0x060B3, 0x060D6, 0x060D2, 0x0394E, 0x060E3, 0x060E5, 0x060E9, 0x0396B, 0x0395E,
+	0x06111, 0x060FD, 0x03960, 0x03967, 0x0611E, 0x06120, 0x06121, 0x0621E, 0x039B8, 0x063E2, 0x063DE, 0x063E6,
+	0x03A14, 0x03A0F, 0x03A07, 0x03A13, 0x063F8, 0x03A17, 0x063FE, 0x063C1, 0x063BF, 0x063F7, 0x063D1, 0x0655F,
+	0x06560, 0x06561, 0x03A9A, 0x03AB8, 0x065D1, 0x03AF7, 0x03AF8, 0x0667D, 0x0666B, 0x0667F,
+	// plane 3, row 28
+	0x03AFD, 0x03AF5, 0x06673, 0x06681, 0x0666D, 0x06669, 0x03AFA, 0x03B38, 0x0671E, 0x068ED, 0x03B87, 0x03B80,
+	0x03B88, 0x03B79, 0x06903, 0x03B7C, 0x068FE, 0x068E5, 0x0691E, 0x06902, 0x03B83, 0x03B85, 0x06909, 0x068CA,
+	0x06900, 0x2F8E5, 0x06901, 0x06918, 0x068E2, 0x068CF, 0x03B7B, 0x0692E, 0x068C5, 0x068FF, 0x03B86, 0x0691C,
+	0x068C3, 0x03C34, 0x06B6F, 0x03C55, 0x06B6E, 0x03C68, 0x06BBE, 0x03C9C, 0x06BF4, 0x06C2D, 0x03CFC, 0x06DB6,
+	0x06E75, 0x06E1E, 0x03D1A, 0x06E18, 0x03D17, 0x06E48, 0x03D1B, 0x06E4F, 0x03D13, 0x06E42, 0x06E6A, 0x06E70,
+	0x06DFE, 0x03D05, 0x03D07, 0x06E6D, 0x03D1C, 0x06E7B, 0x06E7E, 0x06E59, 0x03D11, 0x06E57, 0x03D16, 0x06E80,
+	0x06E50, 0x03D15, 0x06E29, 0x06E76, 0x06E2A, 0x06E4C, 0x0712A, 0x03DCB, 0x07135, 0x0712C, 0x07137, 0x0711D,
+	0x03DC5, 0x03DC2, 0x07138, 0x03DCD, 0x07134, 0x0712B, 0x07133, 0x07127, 0x07124, 0x03DCA,
+	// plane 3, row 29
+	0x0712D, 0x07232, 0x07283, 0x07282, 0x07287, 0x07306, 0x07324, 0x07338, 0x0732A, 0x0732C, 0x0732B, 0x03E83,
+	0x0732F, 0x07328, 0x07417, 0x03ED6, 0x03ED5, 0x07419, 0x07438, 0x03ED1, 0x0741F, 0x07414, 0x0743C, 0x073F7,
+	0x0741C, 0x07415, 0x07418, 0x07439, 0x074F9, 0x07524, 0x2F934, 0x03F52, 0x03F5F, 0x0756E, 0x0756D, 0x07571,
+	0x0758E, 0x03F95, 0x075E5, 0x03F9D, 0x03F98, 0x03F9E, 0x03F96, 0x07694, 0x076B3, 0x04003, 0x076D9, 0x0402F,
+	0x07748, 0x07749, 0x07743, 0x04031, 0x04033, 0x07742, 0x077DF, 0x040B4, 0x07863, 0x07876, 0x040B0, 0x0785F,
+	0x07866, 0x07966, 0x07971, 0x04108, 0x04107, 0x07976, 0x07984, 0x07975, 0x079FF, 0x07A07, 0x0414E, 0x07A0E,
+	0x07A09, 0x04150, 0x04152, 0x041A1, 0x041A3, 0x041A5, 0x041CC, 0x07AE7, 0x07AE2, 0x07B55, 0x041EF, 0x041EA,
+	0x07B43, 0x07B57, 0x07B6C, 0x07B42, 0x07B53, 0x041ED, 0x07B41, 0x04285, 0x04284, 0x07CA7,
+	// plane 3, row 30
+	0x07CA0, 0x07CA6, 0x07CA4, 0x07D74, 0x042DB, 0x07D59, 0x042D9, 0x07D60, 0x07D57, 0x07D6C, 0x07D7E, 0x07D64,
+	0x042D7, 0x07D5A, 0x07D5D, 0x042DA, 0x042DE, 0x042D8, 0x07D76, 0x07D4D, 0x07D75, 0x042D5, 0x07FD3, 0x07FD6,
+	0x0439C, 0x0439D, 0x08060, 0x0804E, 0x08145, 0x0813B, 0x043FE, 0x08148, 0x08142, 0x08149, 0x08140, 0x08114,
+	0x08141, 0x04407, 0x081EF, 0x081F6, 0x08203, 0x0446A, 0x083ED, 0x044E7, 0x083DA, 0x08418, 0x083D2, 0x08408,
+	0x044E2, 0x08400, 0x044DF, 0x044E1, 0x044E5, 0x08417, 0x08346, 0x08414, 0x083D3, 0x08405, 0x0841F, 0x08402,
+	0x08416, 0x083CD, 0x083E6, 0x04591, 0x0865D, 0x086D5, 0x086E1, 0x045B4, 0x045B0, 0x045B5, 0x045AE, 0x086EE,
+	0x08847, 0x08846, 0x0462D, 0x0462C, 0x088BB, 0x0462B, 0x088BF, 0x088B4, 0x04629, 0x088B5, 0x0467F, 0x0899A,
+	0x08A43, 0x046C9, 0x046CB, 0x08A5A, 0x046C5, 0x046C6, 0x046CA, 0x08A35, 0x08A38, 0x08A42,
+	// plane 3, row 31
+	0x08A49, 0x08A5D, 0x08A4B, 0x08A3D, 0x046D2, 0x046D0, 0x0472D, 0x04735, 0x08C60, 0x08C5E, 0x08C7F, 0x08C7E,
+	0x08C83, 0x0476C, 0x08CB1, 0x08D87, 0x0479D, 0x047A0, 0x08D88, 0x08D83, 0x047A2, 0x0479F, 0x08D86, 0x08D8B,
+	0x08D82, 0x08DCA, 0x08DD2, 0x047EB, 0x047E2, 0x08DD4, 0x08DC9, 0x08EB0, 0x04836, 0x04832, 0x04850, 0x08EF2,
+	0x08EE4, 0x08EF3, 0x08EEA, 0x0484F, 0x08EFD, 0x04852, 0x08F9D, 0x0902B, 0x0902A, 0x0489E, 0x09028, 0x09029,
+	0x0902C, 0x048A0, 0x0489C, 0x0903A, 0x09030, 0x09037, 0x0903B, 0x048D1, 0x0910A, 0x048EF, 0x048F0, 0x048F1,
+	0x091FE, 0x09220, 0x0491D, 0x0920B, 0x0491F, 0x09218, 0x09222, 0x0491E, 0x0921B, 0x09208, 0x04920, 0x0920E,
+	0x09213, 0x0498E, 0x04991, 0x09595, 0x28CDD, 0x04990, 0x049D7, 0x0968C, 0x0967B, 0x0967F, 0x09681, 0x049D9,
+	0x09682, 0x049F4, 0x049F6, 0x03560, 0x049F5, 0x049F3, 0x096EE, 0x096ED, 0x04A0C, 0x096EC,
+	// plane 3, row 32
+	0x0975F, 0x0976F, 0x04A51, 0x0976D, 0x04AA6, 0x04AA7, 0x04AA8, 0x04B27, 0x04B24, 0x04B25, 0x098F0, 0x04B2A,
+	0x04B74, 0x04BC7, 0x09AA9, 0x04BE7, 0x04BED, 0x09AE0, 0x04EB7, 0x0342E, 0x0347B, 0x050CC, 0x050BC, 0x0347C,
+	0x050AA, 0x050B9, 0x0347D, 0x050AB, 0x050C3, 0x050CD, 0x0517E, 0x0527E, 0x05279, 0x034FD, 0x2F823, 0x052E1,
+	0x052E0, 0x052E7, 0x05380, 0x053AB, 0x053AA, 0x053A9, 0x053E0, 0x055EA, 0x035DA, 0x055D7, 0x035D6, 0x035DB,
+	0x055C1, 0x05715, 0x0365B, 0x0586C, 0x0365C, 0x0585C, 0x05850, 0x05861, 0x0586A, 0x05869, 0x05856, 0x05860,
+	0x05866, 0x0585F, 0x05923, 0x05966, 0x05968, 0x03706, 0x0370B, 0x05ACE, 0x0370D, 0x05AC5, 0x05AC3, 0x0370A,
+	0x03713, 0x05AD0, 0x03710, 0x03712, 0x03709, 0x03708, 0x03711, 0x0370F, 0x05B74, 0x05B76, 0x05BDC, 0x05BD7,
+	0x05BDA, 0x05BDB, 0x03767, 0x05C20, 0x05D6D, 0x05D66, 0x037F6, 0x05D64, 0x05D6E, 0x2F87E,
+	// plane 3, row 33
+	0x05D60, 0x05F42, 0x05F5A, 0x05F6E, 0x03964, 0x0396C, 0x06130, 0x0613A, 0x0612A, 0x06143, 0x06119, 0x06131,
+	0x0396D, 0x0613D, 0x0397A, 0x03975, 0x03A0D, 0x06408, 0x06432, 0x06438, 0x03A1E, 0x06431, 0x03A1B, 0x06419,
+	0x03A2A, 0x06411, 0x03A1F, 0x03A22, 0x06429, 0x0641D, 0x03A25, 0x03A27, 0x03A29, 0x0643C, 0x03A24, 0x06446,
+	0x06447, 0x03A28, 0x03A26, 0x0643A, 0x06407, 0x03A23, 0x0656B, 0x03A9F, 0x06570, 0x0656D, 0x03AB1, 0x065E4,
+	0x06693, 0x03B03, 0x03B07, 0x03B0C, 0x03B06, 0x0668F, 0x03B04, 0x03B09, 0x06692, 0x03B05, 0x0668E, 0x03B08,
+	0x06946, 0x03B96, 0x03B9C, 0x03B9F, 0x03B9B, 0x03B98, 0x03B99, 0x03B94, 0x06931, 0x03B8D, 0x03BA3, 0x0693E,
+	0x03B93, 0x0697C, 0x06943, 0x03B92, 0x06973, 0x2F8E8, 0x06955, 0x03B8E, 0x03B8C, 0x06985, 0x0694D, 0x06950,
+	0x06947, 0x06967, 0x06936, 0x06964, 0x06961, 0x03B9A, 0x0697D, 0x06B44, 0x06B40, 0x06B71,
+	// plane 3, row 34
+	0x06B73, 0x06B9C, 0x03C6A, 0x03C6D, 0x03C84, 0x06BC1, 0x03CA0, 0x06BFA, 0x06C31, 0x06C32, 0x03D1D, 0x03D26,
+	0x06EB8, 0x06EA8, 0x03D33, 0x06E91, 0x06EBB, 0x03D38, 0x06E9A, 0x03D30, 0x03D28, 0x06EA9, 0x03D27, 0x03D2A,
+	0x06EB5, 0x06E6C, 0x06EE8, 0x03D31, 0x06EDD, 0x06EDA, 0x06EE6, 0x06EAC, 0x03D34, 0x03D2E, 0x03D3B, 0x06ED9,
+	0x06EE3, 0x06EE9, 0x06EDB, 0x03D29, 0x0716F, 0x03DD2, 0x03DD8, 0x07148, 0x03DCF, 0x0714A, 0x0716B, 0x03DD9,
+	0x0714F, 0x07157, 0x07174, 0x03DCE, 0x03DD3, 0x03DD0, 0x07145, 0x07151, 0x0716D, 0x03BA1, 0x07251, 0x07250,
+	0x0724E, 0x03E47, 0x07341, 0x03E8B, 0x0732E, 0x07346, 0x03ED4, 0x07427, 0x03EDE, 0x07448, 0x07453, 0x0743D,
+	0x03EDF, 0x0745D, 0x07456, 0x03ED7, 0x0741E, 0x07447, 0x07443, 0x07458, 0x07449, 0x03EE1, 0x0744C, 0x07445,
+	0x0743E, 0x03F2F, 0x07501, 0x0751E, 0x03F62, 0x03F63, 0x0757A, 0x075EE, 0x07602, 0x07697,
+	// plane 3, row 35
+	0x07698, 0x03FE2, 0x04004, 0x04043, 0x0775D, 0x07764, 0x07753, 0x07758, 0x07882, 0x07890, 0x0788A, 0x040BE,
+	0x0787A, 0x0787D, 0x040BA, 0x0788B, 0x07878, 0x040BC, 0x2F94E, 0x0788D, 0x07888, 0x07892, 0x07881, 0x0797E,
+	0x07983, 0x0410D, 0x0410E, 0x04111, 0x07980, 0x0410F, 0x04112, 0x04155, 0x07A0F, 0x04159, 0x0415B, 0x07A1D,
+	0x04157, 0x07AA1, 0x07AA4, 0x041CE, 0x07AE9, 0x07AEA, 0x041FE, 0x07B62, 0x07B6B, 0x041FC, 0x07B5E, 0x041F5,
+	0x07B79, 0x041F9, 0x041FA, 0x07B6F, 0x07B68, 0x04288, 0x04289, 0x07CAE, 0x0428A, 0x04287, 0x0428B, 0x07CB0,
+	0x042E6, 0x07D90, 0x042ED, 0x07D8A, 0x042E5, 0x07D8B, 0x07D99, 0x07D95, 0x042E0, 0x07D87, 0x07D78, 0x07D97,
+	0x07D89, 0x07D98, 0x042E1, 0x0435B, 0x0435C, 0x07FA3, 0x0438F, 0x0438B, 0x0438D, 0x07FDD, 0x08057, 0x043B9,
+	0x08163, 0x0816A, 0x0816C, 0x0440F, 0x04419, 0x04413, 0x0815D, 0x08175, 0x04418, 0x0815F,
+	// plane 3, row 36
+	0x04416, 0x0817D, 0x0816D, 0x04453, 0x2F98D, 0x08241, 0x0844F, 0x08484, 0x044F6, 0x0847F, 0x044F5, 0x08448,
+	0x0842A, 0x0847B, 0x08472, 0x08464, 0x0842E, 0x0845C, 0x08453, 0x044F7, 0x08441, 0x084C8, 0x044F0, 0x08462,
+	0x08480, 0x0843E, 0x08483, 0x08471, 0x044F9, 0x0844A, 0x08455, 0x08458, 0x04592, 0x04595, 0x04596, 0x086FC,
+	0x086FD, 0x08715, 0x045B9, 0x08716, 0x086FF, 0x045BD, 0x045B8, 0x04612, 0x08858, 0x088CF, 0x088E0, 0x04680,
+	0x04681, 0x0469A, 0x04698, 0x089E7, 0x08A6A, 0x08A80, 0x046D4, 0x08A6F, 0x08A65, 0x046DA, 0x08A78, 0x08A7D,
+	0x08A88, 0x046D6, 0x046DB, 0x08A64, 0x08A7E, 0x046DC, 0x08A67, 0x08C63, 0x08C88, 0x04771, 0x08CCD, 0x04772,
+	0x08CC9, 0x047A8, 0x08DED, 0x047F0, 0x2F9DB, 0x047F1, 0x047FD, 0x04838, 0x04837, 0x04839, 0x08EB1, 0x04855,
+	0x04853, 0x08F04, 0x08F9E, 0x08FA0, 0x09043, 0x09046, 0x09048, 0x09045, 0x09040, 0x0904C,
+	// plane 3, row 37
+	0x048D5, 0x048BD, 0x0910C, 0x09113, 0x09115, 0x048F5, 0x0916B, 0x09167, 0x0925D, 0x09255, 0x09235, 0x04921,
+	0x09259, 0x0922F, 0x0923C, 0x0928F, 0x0925C, 0x0926A, 0x09262, 0x0925F, 0x0926B, 0x0926E, 0x0923B, 0x09244,
+	0x09241, 0x0959A, 0x04992, 0x09599, 0x049DE, 0x049DB, 0x049DA, 0x0968F, 0x049DF, 0x09696, 0x049F9, 0x049F8,
+	0x049FA, 0x096F4, 0x096FC, 0x04A0E, 0x09755, 0x04A43, 0x09779, 0x04A56, 0x04A53, 0x04A9E, 0x097EE, 0x097F5,
+	0x04AA9, 0x0980B, 0x04AFA, 0x098F3, 0x04B31, 0x04B30, 0x098F7, 0x098FF, 0x098F5, 0x04B32, 0x098EC, 0x098F1,
+	0x04B29, 0x04B2E, 0x0999A, 0x04B76, 0x09AE2, 0x09B3D, 0x09B5D, 0x09CE8, 0x04CA5, 0x09CEB, 0x09CEF, 0x09CEE,
+	0x09E81, 0x09F14, 0x050D0, 0x050D9, 0x050DC, 0x050D8, 0x0348C, 0x050E1, 0x050EB, 0x0348B, 0x03489, 0x050F4,
+	0x050E2, 0x050DE, 0x0348D, 0x03486, 0x034D7, 0x051F4, 0x03504, 0x03507, 0x03503, 0x052ED,
+	// plane 3, row 38
+	0x052EA, 0x03522, 0x05332, 0x03551, 0x053AE, 0x053B0, 0x03561, 0x055FB, 0x05603, 0x0560B, 0x035E9, 0x05607,
+	0x035E5, 0x055F8, 0x035E4, 0x05628, 0x0561E, 0x035E3, 0x05618, 0x05611, 0x05651, 0x05605, 0x05717, 0x05892,
+	0x03665, 0x0588C, 0x03663, 0x05878, 0x05884, 0x05873, 0x058AD, 0x05897, 0x05895, 0x05877, 0x05872, 0x05896,
+	0x0588D, 0x05910, 0x0368C, 0x0596C, 0x0371A, 0x05AE7, 0x03715, 0x05AE4, 0x03720, 0x03721, 0x05AEF, 0x05626,
+	0x0371C, 0x0371B, 0x05AF0, 0x05D7B, 0x037FE, 0x05D83, 0x03804, 0x03801, 0x05D8B, 0x05D8C, 0x03800, 0x05D78,
+	0x05E52, 0x0386D, 0x03893, 0x05ED0, 0x05ECF, 0x038A1, 0x05FB3, 0x05FB4, 0x03976, 0x03979, 0x03972, 0x0617B,
+	0x03983, 0x0616F, 0x06181, 0x0613C, 0x06142, 0x06138, 0x06133, 0x2F8A6, 0x06160, 0x06169, 0x0617D, 0x06186,
+	0x0622C, 0x06228, 0x03A38, 0x0644C, 0x03A30, 0x06457, 0x0647C, 0x03A34, 0x03A3A, 0x06455,
+	// plane 3, row 39
+	0x06462, 0x06471, 0x0646A, 0x06456, 0x0643B, 0x06481, 0x03A35, 0x0644F, 0x0647E, 0x06464, 0x03A3F, 0x03A40,
+	0x03A32, 0x03A31, 0x03A36, 0x06571, 0x230BA, 0x03B0F, 0x066A5, 0x0669A, 0x0669C, 0x03B10, 0x066A6, 0x03B0D,
+	0x066A4, 0x0698F, 0x069C5, 0x069C8, 0x06992, 0x069B2, 0x03BA9, 0x03BB4, 0x03BAC, 0x069E3, 0x069C0, 0x069D6,
+	0x069D1, 0x0699F, 0x069A2, 0x069D2, 0x03BB8, 0x03BAE, 0x235F3, 0x069E1, 0x069D5, 0x0699D, 0x03BB3, 0x03BBA,
+	0x06998, 0x03C3F, 0x06B74, 0x06BA1, 0x03D3C, 0x06EF0, 0x06EF3, 0x03D42, 0x03D40, 0x06F1B, 0x06F0C, 0x06F1D,
+	0x06F34, 0x06F28, 0x06F17, 0x03D3E, 0x06F44, 0x06F42, 0x06F04, 0x06F11, 0x06EFA, 0x06F4A, 0x07191, 0x0718E,
+	0x03DE1, 0x0718B, 0x0718D, 0x0717F, 0x0718C, 0x0717E, 0x0717C, 0x07183, 0x03DE6, 0x07188, 0x03DE0, 0x03E15,
+	0x07294, 0x03E93, 0x07355, 0x07353, 0x0734F, 0x07354, 0x0746C, 0x07465, 0x07466, 0x07461,
+	// plane 3, row 40
+	0x0746B, 0x07468, 0x07476, 0x03EE7, 0x07460, 0x24A0F, 0x07474, 0x07506, 0x0760E, 0x03FAD, 0x07607, 0x03FAE,
+	0x03FE3, 0x076B9, 0x03FF5, 0x076B7, 0x076E2, 0x04006, 0x07774, 0x07777, 0x07776, 0x07775, 0x0404F, 0x07778,
+	0x07771, 0x04054, 0x0777A, 0x0715B, 0x0777B, 0x078A6, 0x078AE, 0x078B8, 0x040CB, 0x040E3, 0x040C9, 0x078B1,
+	0x078AF, 0x04113, 0x07989, 0x07987, 0x04115, 0x04161, 0x07A29, 0x04166, 0x07A2A, 0x04164, 0x07A2D, 0x07A2C,
+	0x04160, 0x07A32, 0x04163, 0x07AEC, 0x07AF0, 0x07B81, 0x07B9E, 0x07B83, 0x0420A, 0x07B92, 0x04204, 0x07BA3,
+	0x07B9F, 0x07B93, 0x04207, 0x07B86, 0x07CB8, 0x07CB7, 0x0428D, 0x0428F, 0x04290, 0x04292, 0x042EC, 0x07DC8,
+	0x07DB6, 0x2F96C, 0x07DD1, 0x042E7, 0x07DA8, 0x07DAB, 0x042F2, 0x07DB3, 0x07DCD, 0x042EE, 0x07DCF, 0x07DA4,
+	0x042EF, 0x0434C, 0x07F41, 0x07F6F, 0x07F71, 0x0435E, 0x0435F, 0x04376, 0x04374, 0x04372,
+	// plane 3, row 41
+	0x04390, 0x08023, 0x0805B, 0x043BE, 0x08061, 0x0805F, 0x08181, 0x04426, 0x04425, 0x08184, 0x08213, 0x04474,
+	0x0824A, 0x0824C, 0x044FD, 0x04505, 0x04501, 0x084BD, 0x08495, 0x04509, 0x08492, 0x084C3, 0x0450C, 0x08496,
+	0x084A5, 0x084B5, 0x084B3, 0x084A3, 0x084E4, 0x084D8, 0x084D5, 0x0450D, 0x084B7, 0x084AD, 0x084DA, 0x08493,
+	0x08736, 0x045C0, 0x045C5, 0x045C9, 0x0873D, 0x0872B, 0x08747, 0x08739, 0x045D5, 0x08745, 0x0871D, 0x04641,
+	0x088FF, 0x088EA, 0x04633, 0x088F5, 0x0463A, 0x08900, 0x088ED, 0x08903, 0x088E9, 0x04640, 0x04642, 0x089EA,
+	0x046E8, 0x08A9B, 0x08A8E, 0x08AA2, 0x046E4, 0x08A9C, 0x08A94, 0x08A90, 0x08AA9, 0x08AAC, 0x046E7, 0x08A9F,
+	0x046E6, 0x046E1, 0x08A9D, 0x04739, 0x08C67, 0x0475C, 0x04775, 0x08CD0, 0x08CD6, 0x08CD4, 0x08D98, 0x08D9A,
+	0x08D97, 0x047AE, 0x047B0, 0x047FA, 0x08E0B, 0x08E08, 0x08E01, 0x08EB4, 0x08EB3, 0x0485B,
+	// plane 3, row 42
+	0x08FA1, 0x08FA2, 0x048A5, 0x0905A, 0x048A2, 0x09061, 0x0905F, 0x048DB, 0x048DA, 0x09125, 0x0917B, 0x09176,
+	0x0917C, 0x04924, 0x09289, 0x092F6, 0x092B1, 0x092AD, 0x09292, 0x09281, 0x09284, 0x04926, 0x092AE, 0x09290,
+	0x0929E, 0x04998, 0x04996, 0x0499A, 0x095A2, 0x095A7, 0x04997, 0x049E1, 0x049E0, 0x049E3, 0x049E2, 0x096A0,
+	0x0969D, 0x0969F, 0x096D0, 0x049FB, 0x096D1, 0x04A12, 0x04A14, 0x09759, 0x04A45, 0x09764, 0x04A5C, 0x04A5D,
+	0x04AB8, 0x09819, 0x04ABA, 0x09814, 0x09815, 0x0981A, 0x04B03, 0x04B35, 0x04B36, 0x04B39, 0x09906, 0x04B2D,
+	0x098F8, 0x09901, 0x04B7A, 0x099BE, 0x099BC, 0x099B7, 0x099B6, 0x099C0, 0x04B78, 0x099B8, 0x04B7B, 0x04B7C,
+	0x04B7E, 0x099C4, 0x04B7D, 0x099BF, 0x04BC9, 0x09ADA, 0x09AE4, 0x09AE9, 0x09AE8, 0x09AEA, 0x09AE5, 0x04BF3,
+	0x09B26, 0x04C1A, 0x04C19, 0x09B40, 0x04C1F, 0x04CA6, 0x04CA7, 0x04CA8, 0x04CAB, 0x04CA9,
+	// plane 3, row 43
+	0x04D2E, 0x09EBD, 0x04D5E, 0x03495, 0x03493, 0x03492, 0x0510E, 0x03496, 0x050F7, 0x03497, 0x050FC, 0x0510D,
+	0x05101, 0x051DA, 0x051D9, 0x051DB, 0x05286, 0x0528E, 0x052EE, 0x05333, 0x053B1, 0x035F5, 0x05647, 0x0562D,
+	0x05654, 0x035EA, 0x0564B, 0x05652, 0x05631, 0x05644, 0x05656, 0x05650, 0x0562B, 0x035F3, 0x0564D, 0x05637,
+	0x0564F, 0x058A2, 0x058B7, 0x03669, 0x058B2, 0x0366B, 0x058AA, 0x058B5, 0x058B0, 0x0366C, 0x058B4, 0x058A4,
+	0x058A7, 0x03668, 0x05926, 0x05AFE, 0x03728, 0x05B04, 0x03726, 0x05AFC, 0x03725, 0x05B06, 0x05B0A, 0x05AFA,
+	0x05B0D, 0x05B00, 0x05B0E, 0x0376B, 0x0380F, 0x03808, 0x05D91, 0x0380C, 0x05D8F, 0x05D90, 0x05D98, 0x05DA4,
+	0x05D9B, 0x05DA3, 0x05D96, 0x05DE4, 0x05E5A, 0x03860, 0x03862, 0x05E5E, 0x03898, 0x05FB8, 0x06157, 0x0615C,
+	0x061A6, 0x06195, 0x06188, 0x0398A, 0x061A3, 0x0618F, 0x03984, 0x06164, 0x0397F, 0x06159,
+	// plane 3, row 44
+	0x06178, 0x03982, 0x06185, 0x06187, 0x0619E, 0x03996, 0x03989, 0x06198, 0x0619C, 0x0398D, 0x039BC, 0x0622F,
+	0x06480, 0x0649B, 0x0648E, 0x0648D, 0x06494, 0x064C6, 0x03A44, 0x064A8, 0x06483, 0x03A3C, 0x064B9, 0x06486,
+	0x064B4, 0x064AF, 0x06491, 0x03A4E, 0x064AA, 0x064A1, 0x064A7, 0x066B6, 0x066B3, 0x03B14, 0x066BC, 0x066AC,
+	0x03B15, 0x066AD, 0x06A0E, 0x03BCE, 0x06A1C, 0x06A1A, 0x03BE0, 0x03BC2, 0x06A0B, 0x03BBF, 0x069EF, 0x06A0C,
+	0x069F0, 0x06A22, 0x03BC4, 0x069D8, 0x03BCF, 0x06A12, 0x069FA, 0x03BC8, 0x06A2A, 0x03BCC, 0x06A10, 0x03BCD,
+	0x03BC7, 0x06A29, 0x069F9, 0x069EA, 0x06A2C, 0x06A24, 0x04CB7, 0x069E9, 0x06B52, 0x06B4F, 0x06B53, 0x03C43,
+	0x03CB6, 0x06F10, 0x06F65, 0x06F75, 0x03D51, 0x03D4A, 0x03D4D, 0x03D56, 0x06FD0, 0x03D53, 0x06F5C, 0x06F3D,
+	0x06F71, 0x03D59, 0x06F91, 0x06F0B, 0x06F79, 0x06F81, 0x06F8F, 0x03D4E, 0x06F59, 0x06F74,
+	// plane 3, row 45
+	0x03DEE, 0x071AE, 0x03DEC, 0x071A3, 0x071AD, 0x03DEB, 0x03DEF, 0x071AB, 0x071A6, 0x071A2, 0x03DED, 0x052F2,
+	0x07257, 0x07255, 0x07299, 0x0734B, 0x0747A, 0x03EF2, 0x03EEF, 0x03EF1, 0x0748C, 0x07484, 0x03EED, 0x03EF0,
+	0x07482, 0x07493, 0x0747B, 0x03EEE, 0x07509, 0x04C1B, 0x03F50, 0x03F66, 0x03684, 0x03FB8, 0x03FF6, 0x0778A,
+	0x04057, 0x07790, 0x0405E, 0x078C6, 0x078D3, 0x078C0, 0x078D2, 0x078C7, 0x078C2, 0x04119, 0x0799F, 0x0799D,
+	0x0799E, 0x04170, 0x07A41, 0x0416E, 0x07A38, 0x07A3A, 0x07A42, 0x04172, 0x04176, 0x07A3E, 0x07AB0, 0x07BAE,
+	0x07BB3, 0x04212, 0x0421F, 0x07BBF, 0x04211, 0x04216, 0x07BCD, 0x04219, 0x07BB2, 0x04224, 0x04214, 0x04225,
+	0x04295, 0x04296, 0x04293, 0x04294, 0x07CC4, 0x07CCD, 0x07CC2, 0x07CC6, 0x07CC3, 0x07CC9, 0x07CC7, 0x042A0,
+	0x07DF8, 0x042FB, 0x07DED, 0x07DE2, 0x042FC, 0x04300, 0x042F8, 0x07DDC, 0x07E02, 0x07E01,
+	// plane 3, row 46
+	0x042F9, 0x07DD6, 0x04304, 0x07DE4, 0x07DFE, 0x04303, 0x07E00, 0x07DFC, 0x07DFD, 0x042F3, 0x07DF5, 0x07DFF,
+	0x042FA, 0x07DEB, 0x07DE5, 0x07F78, 0x07FAE, 0x07FE7, 0x043BF, 0x08065, 0x0806A, 0x08066, 0x08068, 0x0806B,
+	0x08194, 0x081A1, 0x08192, 0x08196, 0x08193, 0x04479, 0x04510, 0x08501, 0x04514, 0x084F8, 0x0450E, 0x084F5,
+	0x0451A, 0x08504, 0x04519, 0x04521, 0x04523, 0x0451F, 0x0851B, 0x08503, 0x08533, 0x08534, 0x084ED, 0x04525,
+	0x0452B, 0x08535, 0x04516, 0x08505, 0x04522, 0x0451B, 0x045CE, 0x045CF, 0x0877D, 0x045CB, 0x045D1, 0x045CC,
+	0x08771, 0x04617, 0x0885C, 0x088E6, 0x0890F, 0x0891B, 0x04651, 0x089A9, 0x089A5, 0x089EE, 0x08AB1, 0x046ED,
+	0x08ACC, 0x08ACE, 0x046F4, 0x08AB7, 0x046F1, 0x08AB5, 0x08AE9, 0x08AB4, 0x046F8, 0x08AB3, 0x08AC1, 0x08AAF,
+	0x08ACA, 0x08AD0, 0x0472F, 0x0475E, 0x0475D, 0x08C8E, 0x04776, 0x04777, 0x08CE9, 0x08CDB,
+	// plane 3, row 47
+	0x0477E, 0x08CEB, 0x08DA4, 0x047B6, 0x08DA2, 0x08D9D, 0x047B3, 0x047FC, 0x04803, 0x04800, 0x08E2A, 0x08E28,
+	0x0480A, 0x04802, 0x08EB8, 0x08EB6, 0x08EB9, 0x08EB7, 0x08F22, 0x08F2B, 0x08F27, 0x08F19, 0x08FA4, 0x04887,
+	0x08FB3, 0x048A6, 0x09071, 0x0906A, 0x048A9, 0x048DE, 0x09188, 0x0918C, 0x092BF, 0x092B8, 0x092BE, 0x092DC,
+	0x092E5, 0x0492E, 0x0492D, 0x092D4, 0x092D6, 0x04930, 0x092DA, 0x092ED, 0x092F3, 0x092DB, 0x0492B, 0x092B9,
+	0x092E2, 0x092EB, 0x095AF, 0x0499E, 0x095B2, 0x095B3, 0x0499F, 0x049E5, 0x049E4, 0x096A3, 0x096A5, 0x049FD,
+	0x049FC, 0x04A17, 0x04A19, 0x0970A, 0x04A18, 0x09787, 0x09789, 0x0978C, 0x097EF, 0x0982A, 0x09822, 0x04ABF,
+	0x0981F, 0x04B3C, 0x09919, 0x04B6B, 0x099CA, 0x099DA, 0x04B83, 0x04B81, 0x04B80, 0x099DE, 0x099C8, 0x099E0,
+	0x04BCA, 0x09AB6, 0x09AB5, 0x04BCE, 0x09AF4, 0x04BF6, 0x09B6B, 0x09B69, 0x09B72, 0x09B63,
+	// plane 3, row 48
+	0x04C39, 0x09D0D, 0x04CAE, 0x09D01, 0x09D0C, 0x04CB5, 0x09CF8, 0x04CB3, 0x04CB4, 0x09CFE, 0x09D02, 0x09E84,
+	0x04D22, 0x09EAB, 0x09EAA, 0x0511D, 0x05116, 0x03499, 0x0512B, 0x0511E, 0x0511B, 0x05290, 0x05294, 0x05314,
+	0x20B89, 0x03602, 0x05667, 0x03601, 0x0567B, 0x036A1, 0x0565F, 0x05661, 0x035FD, 0x03673, 0x03674, 0x03670,
+	0x03676, 0x03675, 0x03672, 0x058C3, 0x058CA, 0x058BB, 0x058C0, 0x058C4, 0x05901, 0x05B1F, 0x05B18, 0x05B11,
+	0x05B15, 0x03729, 0x05B12, 0x05B1C, 0x0372A, 0x05B22, 0x05B79, 0x05DA6, 0x03816, 0x05DB3, 0x05DAB, 0x05EEA,
+	0x03899, 0x05F5B, 0x038D3, 0x038F5, 0x061B7, 0x061CE, 0x061B9, 0x061BD, 0x061CF, 0x061C0, 0x06199, 0x06197,
+	0x03994, 0x061BB, 0x061D0, 0x061C4, 0x06231, 0x03A56, 0x064D3, 0x064C0, 0x03A59, 0x03A58, 0x03A55, 0x03A52,
+	0x064DC, 0x064D1, 0x064C8, 0x03A57, 0x064D5, 0x066C3, 0x03B1B, 0x03B1C, 0x066BF, 0x066C5,
+	// plane 3, row 49
+	0x03B19, 0x066CD, 0x066C1, 0x06706, 0x03B3F, 0x06724, 0x06A63, 0x06A42, 0x06A52, 0x03BDB, 0x06A43, 0x06A33,
+	0x03BE2, 0x06A6C, 0x06A57, 0x03BD7, 0x06A4C, 0x06A6E, 0x03BDE, 0x03BE5, 0x03BE4, 0x03BE6, 0x03BD6, 0x06A37,
+	0x03BDF, 0x06A71, 0x06A4A, 0x06A36, 0x03BDC, 0x06A53, 0x03BDA, 0x06A45, 0x06A70, 0x03BD3, 0x03BD0, 0x06A5C,
+	0x06B58, 0x06B57, 0x03C86, 0x03C87, 0x03CAD, 0x03CB7, 0x03D58, 0x03D6A, 0x06FBB, 0x03D62, 0x03D61, 0x06FBE,
+	0x03D69, 0x03D6C, 0x03D65, 0x06FB5, 0x06FD3, 0x06F9F, 0x03D66, 0x06FB7, 0x06FF5, 0x071B7, 0x03DF5, 0x071BB,
+	0x03DF4, 0x071D1, 0x03DF7, 0x071BA, 0x03DF8, 0x071B6, 0x071CC, 0x03DFB, 0x03DFC, 0x071D3, 0x0749B, 0x03EF5,
+	0x03EF8, 0x07496, 0x074A2, 0x0749D, 0x0750A, 0x0750E, 0x03F3C, 0x07581, 0x0762C, 0x07637, 0x07636, 0x0763B,
+	0x03FC5, 0x076A1, 0x04062, 0x04063, 0x07798, 0x04067, 0x07796, 0x04066, 0x040D9, 0x040DB,
+	// plane 3, row 50
+	0x078D6, 0x078EB, 0x040D8, 0x078DC, 0x0411B, 0x079A5, 0x079A9, 0x09834, 0x07A53, 0x07A45, 0x04179, 0x07A4F,
+	0x0417D, 0x07ABD, 0x07ABB, 0x07AF1, 0x0422C, 0x04237, 0x07BEC, 0x07BED, 0x04230, 0x0429A, 0x07CD3, 0x04A00,
+	0x07CE1, 0x04305, 0x07E19, 0x04307, 0x04309, 0x0430A, 0x07E27, 0x07E26, 0x04379, 0x043C2, 0x0806E, 0x081AF,
+	0x04438, 0x04437, 0x081AD, 0x04421, 0x081AA, 0x08218, 0x0445E, 0x0453D, 0x04537, 0x04540, 0x0856F, 0x0854C,
+	0x0451D, 0x08542, 0x04533, 0x0855C, 0x08570, 0x0855F, 0x04535, 0x0855A, 0x0854B, 0x0853F, 0x0878A, 0x045D8,
+	0x0878B, 0x087A1, 0x0878E, 0x045DC, 0x045DE, 0x08799, 0x0885E, 0x0885F, 0x08924, 0x089A7, 0x08AEA, 0x08AFD,
+	0x08AF9, 0x08AE3, 0x08AE5, 0x046FA, 0x046FB, 0x08AEC, 0x0473D, 0x0473B, 0x0473F, 0x0475F, 0x08CF2, 0x0477F,
+	0x08CEF, 0x04784, 0x08DA6, 0x047BC, 0x04814, 0x0480F, 0x08E3B, 0x08E43, 0x0480E, 0x08E32,
+	// plane 3, row 51
+	0x08F31, 0x08F30, 0x04860, 0x08F2D, 0x08F3C, 0x08FA7, 0x08FA5, 0x048AB, 0x048AC, 0x048AA, 0x09137, 0x09195,
+	0x0918E, 0x04904, 0x09196, 0x04908, 0x09345, 0x0930A, 0x04933, 0x04934, 0x092FD, 0x09317, 0x0931C, 0x09307,
+	0x09331, 0x09332, 0x0932C, 0x09330, 0x09303, 0x09305, 0x049A2, 0x095C2, 0x049A4, 0x095B8, 0x049A5, 0x095C1,
+	0x049A7, 0x049A6, 0x049E7, 0x096AB, 0x096B7, 0x049FF, 0x049FE, 0x09715, 0x09714, 0x04A1D, 0x04A1C, 0x0970C,
+	0x09717, 0x04A67, 0x09793, 0x04A94, 0x097D2, 0x04AC5, 0x04AC8, 0x09836, 0x09831, 0x09833, 0x0983C, 0x0982E,
+	0x0983A, 0x04AC9, 0x0983D, 0x04AC7, 0x098B5, 0x09922, 0x09923, 0x09920, 0x0991C, 0x0991D, 0x04B6C, 0x099A0,
+	0x04B8A, 0x099EF, 0x099E8, 0x099EB, 0x04B88, 0x04B87, 0x04B86, 0x099E1, 0x099E6, 0x04BCF, 0x04BD0, 0x09AF8,
+	0x09AF5, 0x04C1C, 0x04C23, 0x09B83, 0x09B94, 0x09B84, 0x04C49, 0x09B8B, 0x09B8F, 0x04C43,
+	// plane 3, row 52
+	0x09B8C, 0x04C48, 0x09B89, 0x04C47, 0x09B8E, 0x04C46, 0x04C3F, 0x04C44, 0x09D24, 0x09D0F, 0x04CBE, 0x09D13,
+	0x09D0A, 0x04CC2, 0x04CBA, 0x04CBC, 0x04CC6, 0x09D2A, 0x09D1A, 0x04CC8, 0x09D27, 0x09D16, 0x09D21, 0x04D23,
+	0x09E85, 0x09EAC, 0x09EC6, 0x09EC5, 0x09ED7, 0x09F53, 0x0349D, 0x05128, 0x05127, 0x051DF, 0x03524, 0x05335,
+	0x053B3, 0x03607, 0x0568A, 0x0567D, 0x05689, 0x03679, 0x058CD, 0x058D0, 0x03678, 0x05B2B, 0x05B33, 0x05B29,
+	0x05B35, 0x05B31, 0x05B37, 0x05C36, 0x05DBE, 0x03819, 0x05DB9, 0x0381C, 0x05DBB, 0x03818, 0x061E2, 0x061DB,
+	0x061DD, 0x061DC, 0x061DA, 0x2F8AF, 0x061D9, 0x039BD, 0x03A5D, 0x064DF, 0x03A5A, 0x03A5E, 0x064E1, 0x03A5C,
+	0x064EE, 0x03A5B, 0x065B5, 0x066D4, 0x066D5, 0x03B21, 0x066D0, 0x066D1, 0x066CE, 0x066D7, 0x03B20, 0x03B32,
+	0x06A7D, 0x06A8A, 0x03BF2, 0x06AA7, 0x03BF5, 0x06A99, 0x06A82, 0x06A88, 0x03BEE, 0x03BEC,
+	// plane 3, row 53
+	0x06A86, 0x03BEA, 0x06A98, 0x06A9D, 0x03BED, 0x03BF3, 0x06A8F, 0x03BF6, 0x06AAA, 0x03C48, 0x06B5D, 0x03C49,
+	0x06C0A, 0x03D75, 0x06FD7, 0x06FD6, 0x06FE5, 0x03D6F, 0x03D7B, 0x03D73, 0x06FD9, 0x06FDA, 0x06FEA, 0x03D70,
+	0x06FF6, 0x24039, 0x03D78, 0x071E3, 0x03DFE, 0x071E9, 0x03E00, 0x071EB, 0x071EF, 0x071F3, 0x071EA, 0x03E01,
+	0x2F921, 0x03E55, 0x03E56, 0x03E9D, 0x07371, 0x03EF9, 0x074AE, 0x03EFF, 0x074B3, 0x03EFD, 0x074AC, 0x03F43,
+	0x03F41, 0x07583, 0x07645, 0x0764E, 0x07644, 0x076A3, 0x076A5, 0x077A6, 0x077A4, 0x0406F, 0x077A9, 0x077AF,
+	0x0408A, 0x040E5, 0x040E6, 0x078F0, 0x078F8, 0x078F1, 0x0417F, 0x07A49, 0x041B5, 0x041B6, 0x041BB, 0x07AC2,
+	0x07AF2, 0x07AF3, 0x07BFA, 0x04240, 0x07BF6, 0x07BFC, 0x07C18, 0x07C08, 0x07C12, 0x0429D, 0x0429C, 0x07CDB,
+	0x07CDA, 0x0430F, 0x04311, 0x0430D, 0x07E2C, 0x07E4D, 0x04314, 0x04313, 0x07F46, 0x07FF6,
+	// plane 3, row 54
+	0x0802B, 0x08074, 0x081B8, 0x081C8, 0x04482, 0x04483, 0x0454D, 0x08592, 0x08593, 0x0454F, 0x0857F, 0x085AB,
+	0x08597, 0x0454C, 0x04551, 0x085AC, 0x045EE, 0x045E8, 0x04CCB, 0x087CE, 0x045EB, 0x087CD, 0x045E2, 0x045E6,
+	0x087C1, 0x087B1, 0x087C7, 0x045EC, 0x08940, 0x04659, 0x0893F, 0x08939, 0x0465D, 0x08943, 0x04657, 0x0465B,
+	0x04656, 0x089AB, 0x046FE, 0x08B1F, 0x08B09, 0x08B0C, 0x04700, 0x04701, 0x08C40, 0x04742, 0x08C96, 0x04760,
+	0x08CF6, 0x08CF7, 0x0481D, 0x08E46, 0x08E4F, 0x0483E, 0x04869, 0x04865, 0x08F3D, 0x08F41, 0x09366, 0x09378,
+	0x0935D, 0x09369, 0x09374, 0x0937D, 0x0936E, 0x09372, 0x09373, 0x09362, 0x09348, 0x09353, 0x0935F, 0x09368,
+	0x04938, 0x0937F, 0x0936B, 0x049AE, 0x095C4, 0x049AD, 0x096AF, 0x096AD, 0x096B2, 0x04A02, 0x04A1F, 0x0971A,
+	0x0971B, 0x04A22, 0x04A20, 0x2F9F5, 0x04A6C, 0x0979B, 0x0979F, 0x04A68, 0x04A6D, 0x04A6E,
+	// plane 3, row 55
+	0x04AA0, 0x04ACE, 0x04AD0, 0x04AD1, 0x04ACB, 0x09840, 0x04AD2, 0x09847, 0x04AD3, 0x098B7, 0x04B20, 0x04B4E,
+	0x04B4B, 0x04B72, 0x04B70, 0x099A2, 0x04B92, 0x04B8F, 0x09A00, 0x099F3, 0x04B90, 0x29937, 0x099F5, 0x04BD9,
+	0x04BD5, 0x09ABD, 0x09B00, 0x09B02, 0x04BFA, 0x09B34, 0x09B49, 0x09B9F, 0x04C4B, 0x09BA3, 0x09BCD, 0x09B99,
+	0x09B9D, 0x04CD0, 0x04CCE, 0x09D39, 0x04CCF, 0x09D44, 0x04CC4, 0x04CCC, 0x09D35, 0x04CD2, 0x04D35, 0x09EAF,
+	0x03E03, 0x0512F, 0x0349E, 0x034AF, 0x09F8E, 0x0360C, 0x0569F, 0x0569B, 0x0569E, 0x05696, 0x05694, 0x056A0,
+	0x0367C, 0x05B3B, 0x03730, 0x03731, 0x05B3A, 0x05DC1, 0x05F4D, 0x05F5D, 0x061F3, 0x039A1, 0x0399E, 0x03A68,
+	0x03A61, 0x064F6, 0x064E5, 0x064EA, 0x064E7, 0x06505, 0x03A65, 0x064F9, 0x03A66, 0x03A6A, 0x03AAB, 0x06AAB,
+	0x06AED, 0x06AB2, 0x06AB0, 0x06AB5, 0x06ABE, 0x06AC1, 0x06AC8, 0x03BF9, 0x06AC0, 0x06ABC,
+	// plane 3, row 56
+	0x06AB1, 0x06AC4, 0x06ABF, 0x03C58, 0x03C8A, 0x07008, 0x07003, 0x06FFD, 0x07010, 0x07002, 0x07013, 0x03E04,
+	0x071FA, 0x07200, 0x074B9, 0x074BC, 0x03F02, 0x0765B, 0x07651, 0x0764F, 0x076EB, 0x077B8, 0x04079, 0x077B9,
+	0x077C1, 0x077C0, 0x077BE, 0x0790B, 0x040EB, 0x07907, 0x0790A, 0x07908, 0x040E9, 0x0790D, 0x07906, 0x07915,
+	0x079AF, 0x04120, 0x04121, 0x04181, 0x07AF5, 0x0424D, 0x04259, 0x07C2E, 0x04258, 0x07C1B, 0x25CD1, 0x07C1A,
+	0x07C24, 0x042A5, 0x042A9, 0x07CE6, 0x07CE3, 0x0431A, 0x04319, 0x07E5D, 0x07E4F, 0x07E66, 0x07E5B, 0x07F47,
+	0x07FB4, 0x04396, 0x04398, 0x04397, 0x07FFA, 0x0802E, 0x265DF, 0x043C8, 0x081CE, 0x04443, 0x04445, 0x08219,
+	0x04552, 0x04557, 0x085CC, 0x085B2, 0x04555, 0x085BB, 0x085C1, 0x04556, 0x04558, 0x045F2, 0x087E9, 0x087EE,
+	0x087F0, 0x087D6, 0x0880E, 0x087DA, 0x08948, 0x0894A, 0x0894E, 0x0894D, 0x089B1, 0x089B0,
+	// plane 3, row 57
+	0x089B3, 0x04707, 0x08B38, 0x08B32, 0x04708, 0x08B2D, 0x0470A, 0x08B34, 0x0431B, 0x08B29, 0x08C74, 0x04761,
+	0x04762, 0x08D03, 0x047C2, 0x047C6, 0x08DA9, 0x08E58, 0x0481E, 0x04825, 0x08EBF, 0x08EC1, 0x08F4A, 0x08FAC,
+	0x048B0, 0x09089, 0x0913D, 0x0913C, 0x091A9, 0x093A0, 0x0493D, 0x09390, 0x0493E, 0x09393, 0x0938B, 0x093AD,
+	0x093BB, 0x093B8, 0x04946, 0x04945, 0x0939C, 0x095D8, 0x095D7, 0x04A03, 0x04A26, 0x04A27, 0x0975D, 0x097A9,
+	0x097DA, 0x04A98, 0x04AAD, 0x04AD5, 0x04ADA, 0x09854, 0x04AD9, 0x09855, 0x0984B, 0x04ADD, 0x0983F, 0x098B9,
+	0x04B15, 0x04B16, 0x04B17, 0x04B21, 0x09938, 0x09936, 0x09940, 0x04B4C, 0x0993B, 0x09939, 0x099A4, 0x04B96,
+	0x04B98, 0x09A08, 0x09A0C, 0x04B9B, 0x09A10, 0x04BFF, 0x09B07, 0x04C25, 0x09BD2, 0x04C4F, 0x09BC2, 0x09BBB,
+	0x09BCC, 0x09BCB, 0x04C56, 0x04C54, 0x09D4D, 0x09D63, 0x09D4E, 0x04CD8, 0x09D50, 0x09D55,
+	// plane 3, row 58
+	0x04CD7, 0x09D5E, 0x04D26, 0x09E90, 0x09EB2, 0x09EB1, 0x04D38, 0x09ECA, 0x09F02, 0x09F27, 0x09F26, 0x04D8A,
+	0x056AF, 0x058E0, 0x058DC, 0x03734, 0x05B39, 0x03735, 0x2F86A, 0x05B7C, 0x05BF3, 0x2F870, 0x037A1, 0x05C6B,
+	0x05DC4, 0x0650B, 0x06508, 0x0650A, 0x03A6C, 0x03A6D, 0x065DC, 0x03B29, 0x03B2A, 0x066E1, 0x066DF, 0x06ACE,
+	0x06AD4, 0x06AE3, 0x06AD7, 0x06AE2, 0x03C00, 0x03C08, 0x03C06, 0x03C05, 0x06AD8, 0x06AD5, 0x06AD2, 0x03CB1,
+	0x03D88, 0x0701E, 0x0702C, 0x07025, 0x06FF3, 0x07204, 0x07208, 0x07215, 0x03E09, 0x074C4, 0x074C9, 0x074C7,
+	0x074C8, 0x076A9, 0x077C6, 0x077C5, 0x07918, 0x0791A, 0x07920, 0x04122, 0x07A66, 0x07A64, 0x07A6A, 0x041D5,
+	0x04261, 0x0425D, 0x04262, 0x0424F, 0x04260, 0x07C35, 0x07C34, 0x042AA, 0x04322, 0x07E6C, 0x04321, 0x07E6E,
+	0x07E71, 0x04446, 0x081D4, 0x081D6, 0x0821A, 0x08262, 0x08265, 0x08276, 0x085DB, 0x085D6,
+	// plane 3, row 59
+	0x04562, 0x085E7, 0x04560, 0x04564, 0x085F4, 0x2F9B6, 0x087FD, 0x087D5, 0x08807, 0x045F6, 0x0880F, 0x087F8,
+	0x2F9C1, 0x04619, 0x08987, 0x04691, 0x089B5, 0x089F5, 0x0470D, 0x08B3F, 0x08B43, 0x08B4C, 0x04765, 0x08D0B,
+	0x08E6B, 0x08E68, 0x08E70, 0x08E75, 0x08E77, 0x0483F, 0x08EC3, 0x0494B, 0x093E9, 0x093EA, 0x093CB, 0x093C5,
+	0x093C6, 0x04948, 0x093ED, 0x093D3, 0x04952, 0x093E5, 0x0494A, 0x04951, 0x093DB, 0x093EB, 0x093E0, 0x093C1,
+	0x04950, 0x0494C, 0x095DD, 0x049EE, 0x04A04, 0x04A06, 0x04A2D, 0x04A2E, 0x04A2F, 0x04A7B, 0x04A78, 0x04A77,
+	0x097B2, 0x097B4, 0x097B1, 0x097B5, 0x097F2, 0x04AA2, 0x04AA1, 0x04AE3, 0x09856, 0x04B1A, 0x04B19, 0x04B57,
+	0x09944, 0x04B9E, 0x09A26, 0x09A1F, 0x09A18, 0x09A21, 0x09A17, 0x04BDD, 0x09B09, 0x04C05, 0x04C28, 0x09BC5,
+	0x09BDF, 0x04C60, 0x09BE3, 0x04C66, 0x09BE9, 0x09BEE, 0x04C67, 0x04C68, 0x09D66, 0x09D7A,
+	// plane 3, row 60
+	0x04CDE, 0x09D6E, 0x09D91, 0x09D83, 0x09D76, 0x09D7E, 0x09D6D, 0x04CE1, 0x09E95, 0x09EE3, 0x04D69, 0x04D77,
+	0x09F03, 0x09F04, 0x2FA19, 0x09F17, 0x034A6, 0x05136, 0x034A5, 0x05336, 0x03614, 0x05B42, 0x03736, 0x03738,
+	0x05B44, 0x05B46, 0x05B7E, 0x05DCA, 0x05DC8, 0x05DCC, 0x05EF0, 0x03A70, 0x06585, 0x066E5, 0x066E7, 0x03B2B,
+	0x03C11, 0x03C0A, 0x06AF4, 0x03C0D, 0x06AE9, 0x03C16, 0x03C10, 0x03C09, 0x03C0E, 0x03C7A, 0x0703D, 0x03D8C,
+	0x07036, 0x03D91, 0x07216, 0x03E0A, 0x07212, 0x0720F, 0x07217, 0x07211, 0x0720B, 0x03E08, 0x03E0B, 0x074CD,
+	0x074D0, 0x074CC, 0x074CE, 0x074D1, 0x03F07, 0x07589, 0x040F2, 0x07A6F, 0x07C4B, 0x07C44, 0x07C55, 0x042AE,
+	0x04324, 0x04326, 0x04327, 0x07E7F, 0x08B71, 0x04399, 0x0802F, 0x0807A, 0x0807B, 0x0807C, 0x0455F, 0x0456A,
+	0x04571, 0x085FC, 0x08610, 0x08602, 0x0456C, 0x0456F, 0x085EE, 0x08603, 0x04568, 0x0860D,
+	// plane 3, row 61
+	0x08613, 0x08608, 0x0860F, 0x08818, 0x08812, 0x04601, 0x04668, 0x08967, 0x08965, 0x089BB, 0x08B69, 0x08B62,
+	0x04713, 0x08B6E, 0x04716, 0x08B61, 0x04718, 0x08B64, 0x08B4D, 0x08C51, 0x04789, 0x047C8, 0x08E83, 0x08EC6,
+	0x04884, 0x0941F, 0x04954, 0x09404, 0x09417, 0x09408, 0x09405, 0x04956, 0x093F3, 0x0941E, 0x09402, 0x0941A,
+	0x0941B, 0x09427, 0x0941C, 0x0495A, 0x096B5, 0x04A05, 0x04A07, 0x09733, 0x04A31, 0x09734, 0x09731, 0x097B8,
+	0x097BA, 0x04AA3, 0x097FC, 0x04AEB, 0x04B1C, 0x098C3, 0x04B5A, 0x0994D, 0x04B5B, 0x09A2F, 0x04BA6, 0x04BAA,
+	0x04BA5, 0x09AC9, 0x04BE1, 0x09AC8, 0x09AC4, 0x09B2A, 0x09B38, 0x09B50, 0x04C2A, 0x09C0A, 0x09BFB, 0x09C04,
+	0x09BFC, 0x09BFE, 0x04C72, 0x04C6F, 0x04C73, 0x09C02, 0x09BF6, 0x09C1B, 0x09BF9, 0x09C15, 0x09C10, 0x09BFF,
+	0x09C00, 0x09C0C, 0x04C6B, 0x04CE6, 0x09D95, 0x09DA5, 0x04CE9, 0x04CEC, 0x04CE8, 0x04CF0,
+	// plane 3, row 62
+	0x09E98, 0x09EC1, 0x04D8C, 0x09F5A, 0x05164, 0x056BB, 0x03615, 0x058E6, 0x05B49, 0x05BF7, 0x03771, 0x03826,
+	0x05DD0, 0x038C6, 0x05FC2, 0x039A8, 0x06511, 0x03A73, 0x06AFF, 0x06AFE, 0x06AFD, 0x03C15, 0x06B01, 0x03D98,
+	0x03D97, 0x0704B, 0x0704D, 0x07047, 0x074D3, 0x07668, 0x07667, 0x03FD7, 0x04080, 0x077D1, 0x07930, 0x07932,
+	0x0792E, 0x04188, 0x09F9D, 0x07AC9, 0x07AC8, 0x04269, 0x07C56, 0x07C51, 0x0426B, 0x04329, 0x04328, 0x07E85,
+	0x07E89, 0x07E8E, 0x07E84, 0x0445F, 0x0826A, 0x0862B, 0x0862F, 0x08628, 0x04574, 0x08616, 0x08615, 0x0861D,
+	0x0881A, 0x04602, 0x0466A, 0x04694, 0x089BC, 0x08B75, 0x08B7C, 0x0478A, 0x08D11, 0x08D12, 0x08F5C, 0x091BB,
+	0x04964, 0x093F4, 0x0495E, 0x04961, 0x0942D, 0x04965, 0x04966, 0x096E4, 0x09737, 0x09736, 0x09767, 0x097BE,
+	0x097BD, 0x097E2, 0x09868, 0x09866, 0x098C8, 0x098CA, 0x098C7, 0x098DC, 0x04B5F, 0x0994F,
+	// plane 3, row 63
+	0x099A9, 0x09A3C, 0x04BAF, 0x09A3B, 0x09ACE, 0x04C0D, 0x09B14, 0x09B53, 0x04C7C, 0x09C2E, 0x04C7A, 0x09C1F,
+	0x04C76, 0x04C79, 0x04C7D, 0x04C77, 0x09DB0, 0x09DBD, 0x04CF6, 0x04CF1, 0x09DAE, 0x09DC4, 0x09E7B, 0x0400B,
+	0x04D29, 0x09E9E, 0x04D6F, 0x09F05, 0x04D9A, 0x09F69, 0x09FA1, 0x056C7, 0x0571D, 0x05B4A, 0x05DD3, 0x03869,
+	0x05F72, 0x06202, 0x039AB, 0x06235, 0x06527, 0x0651E, 0x0651F, 0x03B2C, 0x03B2D, 0x06B07, 0x06B06, 0x03C17,
+	0x03D9A, 0x07054, 0x0721C, 0x07220, 0x07AF8, 0x0426E, 0x07C5D, 0x07C58, 0x0432C, 0x07E92, 0x07F4E, 0x043CA,
+	0x04578, 0x04606, 0x08827, 0x04607, 0x08B81, 0x08B83, 0x04720, 0x08C44, 0x04753, 0x047CE, 0x0487A, 0x04879,
+	0x09442, 0x0944D, 0x09454, 0x0944E, 0x0496B, 0x09443, 0x04967, 0x0496D, 0x0973C, 0x09740, 0x097C0, 0x04A85,
+	0x04AB0, 0x04AF3, 0x04B63, 0x0995A, 0x09A51, 0x04BB6, 0x09ADD, 0x04C82, 0x04C7F, 0x09C38,
+	// plane 3, row 64
+	0x04C86, 0x09C45, 0x09C3A, 0x04C84, 0x09C35, 0x04CFC, 0x04CFD, 0x04CFA, 0x09EF1, 0x04D87, 0x09F93, 0x0529A,
+	0x0361A, 0x03619, 0x08641, 0x05DD7, 0x03A75, 0x06528, 0x03C1A, 0x03C1B, 0x03C19, 0x07053, 0x07059, 0x03D9C,
+	0x07221, 0x03E10, 0x0766F, 0x07937, 0x079B5, 0x07C62, 0x07C5E, 0x07CF5, 0x0457B, 0x0457C, 0x0863D, 0x04608,
+	0x0882D, 0x08989, 0x08B8D, 0x08B87, 0x08B90, 0x08D1A, 0x08E99, 0x04841, 0x048E3, 0x04972, 0x0945F, 0x04973,
+	0x04968, 0x09456, 0x09461, 0x0945B, 0x0945A, 0x0945C, 0x09465, 0x04A35, 0x09741, 0x04A88, 0x04A9D, 0x0986E,
+	0x0986C, 0x0986D, 0x04275, 0x099AA, 0x09A5C, 0x09A58, 0x09ADE, 0x04C8F, 0x09C4F, 0x09C51, 0x04C8E, 0x09C53,
+	0x04D05, 0x04D04, 0x04CFF, 0x09DFC, 0x09F39, 0x04D9E, 0x0513E, 0x03554, 0x056D2, 0x03681, 0x05B4F, 0x06B14,
+	0x040FA, 0x07A72, 0x07A73, 0x04332, 0x04670, 0x0466E, 0x08B91, 0x2F9D6, 0x0487C, 0x091BF,
+	// plane 3, row 65
+	0x04975, 0x0946C, 0x04974, 0x04977, 0x096E6, 0x09745, 0x04A37, 0x097C8, 0x097E4, 0x0995D, 0x04BBA, 0x09B21,
+	0x04C11, 0x09B2C, 0x09B57, 0x04C92, 0x04C99, 0x09C5D, 0x09C61, 0x09C65, 0x09E08, 0x04D0A, 0x04D2A, 0x04D2B,
+	0x04D44, 0x04D79, 0x09F45, 0x034AA, 0x03748, 0x06205, 0x066EF, 0x06B1B, 0x06B1D, 0x07225, 0x07224, 0x07C6D,
+	0x042B4, 0x08642, 0x08649, 0x0460D, 0x08978, 0x0898A, 0x08B97, 0x04754, 0x08C9B, 0x08D1C, 0x04830, 0x08EA2,
+	0x04A09, 0x04A38, 0x04A36, 0x04A8B, 0x04AF7, 0x04B66, 0x04BBD, 0x04C1E, 0x09C6C, 0x04C96, 0x09C6F, 0x04D0D,
+	0x09E0E, 0x04D73, 0x09F08, 0x09F1D, 0x09FA3, 0x0373B, 0x0373C, 0x05F60, 0x06B1C, 0x03DA0, 0x040FB, 0x00000,
+	0x07CF3, 0x04581, 0x08B9B, 0x08EA7, 0x091C4, 0x04978, 0x0947A, 0x04A8D, 0x04B73, 0x09A61, 0x09A63, 0x09AD7,
+	0x09C76, 0x04DA6, 0x09FA5, 0x039AD, 0x07067, 0x03E11, 0x072AB, 0x0864A, 0x0897D, 0x08B9D,
+	// plane 3, row 66
+	0x08C53, 0x08F65, 0x0947B, 0x04A39, 0x098CD, 0x098DD, 0x04BBF, 0x09B30, 0x09E16, 0x04D0F, 0x04DA7, 0x04DB5,
+	0x03FDC, 0x04831, 0x096E7, 0x09E18, 0x09EA2, 0x04DA8, 0x09F7C, 0x04125, 0x07E9E, 0x09484, 0x04BC1, 0x09E1C,
+	0x04190, 0x07C71, 0x097CA, 0x04696, 0x0487F, 0x04D10, 0x09EA3, 0x04A0A, 0x09C7B, 0x09F97, 0x04D12, 0x04A3A,
+	0x09750, 0x04A3B, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 67
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 68
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x04F66, 0x04F68, 0x04FE7, 0x0503F, 0x00000, 0x050A6, 0x0510F, 0x0523E, 0x05324,
+	0x05365, 0x0539B, 0x0517F, 0x054CB, 0x05573, 0x05571, 0x0556B, 0x055F4, 0x05622, 0x05620, 0x05692, 0x056BA,
+	0x05691, 0x056B0, 0x05759, 0x0578A, 0x0580F, 0x05812, 0x05813, 0x05847, 0x0589B, 0x05900, 0x0594D, 0x05AD1,
+	0x05AD3, 0x05B67, 0x05C57, 0x05C77, 0x05CD5, 0x05D75, 0x05D8E, 0x05DA5, 0x05DB6, 0x05DBF, 0x05E65, 0x05ECD,
+	0x05EED, 0x05F94, 0x05F9A, 0x05FBA, 0x06125, 0x06150, 0x062A3, 0x06360, 0x06364, 0x063B6,
+	// plane 3, row 69
+	0x06403, 0x064B6, 0x0651A, 0x07A25, 0x05C21, 0x066E2, 0x06702, 0x067A4, 0x067AC, 0x06810, 0x06806, 0x0685E,
+	0x0685A, 0x0692C, 0x06929, 0x06A2D, 0x06A77, 0x06A7A, 0x06ACA, 0x06AE6, 0x06AF5, 0x06B0D, 0x06B0E, 0x06BDC,
+	0x06BDD, 0x06BF6, 0x06C1E, 0x06C63, 0x06DA5, 0x06E0F, 0x06E8A, 0x06E84, 0x06E8B, 0x06E7C, 0x06F4C, 0x06F48,
+	0x06F49, 0x06F9D, 0x06F99, 0x06FF8, 0x0702E, 0x0702D, 0x0705C, 0x079CC, 0x070BF, 0x070EA, 0x070E5, 0x07111,
+	0x07112, 0x0713F, 0x07139, 0x0713B, 0x0713D, 0x07177, 0x07175, 0x07176, 0x07171, 0x07196, 0x07193, 0x071B4,
+	0x071DD, 0x071DE, 0x0720E, 0x05911, 0x07218, 0x07347, 0x07348, 0x073EF, 0x07412, 0x0743B, 0x074A4, 0x0748D,
+	0x074B4, 0x07673, 0x07677, 0x076BC, 0x07819, 0x0781B, 0x0783D, 0x07853, 0x07854, 0x07858, 0x078B7, 0x078D8,
+	0x078EE, 0x07922, 0x0794D, 0x07986, 0x07999, 0x079A3, 0x079BC, 0x07AA7, 0x07B37, 0x07B59,
+	// plane 3, row 70
+	0x07BD0, 0x07C2F, 0x07C32, 0x07C42, 0x07C4E, 0x07C68, 0x07CA9, 0x07CED, 0x07DD0, 0x07E07, 0x07DD3, 0x07E64,
+	0x07F40, 0x00000, 0x08041, 0x08063, 0x080BB, 0x06711, 0x06725, 0x08248, 0x08310, 0x08362, 0x08312, 0x08421,
+	0x0841E, 0x084E2, 0x084DE, 0x084E1, 0x08573, 0x085D4, 0x085F5, 0x08637, 0x08645, 0x08672, 0x0874A, 0x087A9,
+	0x087A5, 0x087F5, 0x08834, 0x08850, 0x08887, 0x08954, 0x08984, 0x08B03, 0x08C52, 0x08CD8, 0x08D0C, 0x08D18,
+	0x08DB0, 0x08EBC, 0x08ED5, 0x08FAA, 0x0909C, 0x00000, 0x0915C, 0x0922B, 0x09221, 0x09273, 0x092F4, 0x092F5,
+	0x0933F, 0x09342, 0x09386, 0x093BE, 0x093BC, 0x093BD, 0x093F1, 0x093F2, 0x093EF, 0x09422, 0x09423, 0x09424,
+	0x09467, 0x09466, 0x09597, 0x095CE, 0x095E7, 0x0973B, 0x0974D, 0x098E4, 0x09942, 0x09B1D, 0x09B98, 0x00000,
+	0x09D49, 0x06449, 0x05E71, 0x05E85, 0x061D3, 0x0990E, 0x08002, 0x0781E, 0x00000, 0x00000,
+	// plane 3, row 71
+	0x05528, 0x05572, 0x055BA, 0x055F0, 0x055EE, 0x056B8, 0x056B9, 0x056C4, 0x08053, 0x092B0, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 72
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 73
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 74
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 75
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 76
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 77
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 78
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 79
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 80
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 81
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 82
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 83
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 84
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 85
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 86
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 87
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 88
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 89
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 90
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 91
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 92
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 93
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 3, row 94
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 1
+	0x20086, 0x04E40, 0x04E41, 0x04E5A, 0x21FE8, 0x04E02, 0x04E29, 0x2010E, 0x20627, 0x05202, 0x0353E, 0x05DDC,
+	0x2053C, 0x20675, 0x2F828, 0x05342, 0x0536A, 0x05B52, 0x2193C, 0x2F875, 0x2F878, 0x05FC4, 0x0624C, 0x072AD,
+	0x04E12, 0x04E2F, 0x04E96, 0x04ED0, 0x05142, 0x05183, 0x2F819, 0x206A5, 0x206AA, 0x2090F, 0x20A0E, 0x05383,
+	0x053B8, 0x20B9C, 0x20B9B, 0x2123C, 0x05928, 0x21BC2, 0x05C23, 0x05E01, 0x05F00, 0x233B4, 0x03CB8, 0x0706C,
+	0x0722B, 0x05188, 0x08279, 0x08FB6, 0x04E17, 0x20065, 0x0340C, 0x201B2, 0x03430, 0x04EE2, 0x04EDB, 0x20477,
+	0x20542, 0x051AD, 0x20633, 0x051F7, 0x034DA, 0x206AF, 0x20836, 0x03513, 0x0531B, 0x05388, 0x05387, 0x20B22,
+	0x053CF, 0x053FD, 0x03563, 0x053E7, 0x056DC, 0x211A3, 0x056D9, 0x05725, 0x05727, 0x05933, 0x05C13, 0x21C25,
+	0x21C24, 0x05C75, 0x22052, 0x22189, 0x224BF, 0x039C4, 0x039C3, 0x066F1, 0x233B5, 0x2574C,
+	// plane 4, row 2
+	0x07F52, 0x28E14, 0x28E12, 0x03401, 0x200A3, 0x200A2, 0x04E51, 0x04E6A, 0x201B6, 0x04F0C, 0x201B5, 0x201B4,
+	0x04EFE, 0x04F1B, 0x201C2, 0x201B8, 0x0343A, 0x20479, 0x034AB, 0x05173, 0x20508, 0x0518E, 0x2057A, 0x205B6,
+	0x206BD, 0x206B7, 0x206BC, 0x034DD, 0x206C4, 0x206C9, 0x052A5, 0x03515, 0x052A7, 0x052A4, 0x20930, 0x20983,
+	0x20985, 0x20A12, 0x20A34, 0x053BD, 0x20AE4, 0x20ADE, 0x20B2A, 0x05402, 0x20BAF, 0x20BB4, 0x20BB3, 0x20BB1,
+	0x0572B, 0x0591B, 0x05935, 0x215DE, 0x036A7, 0x036A5, 0x216B8, 0x216B0, 0x036A6, 0x219C2, 0x00000, 0x05C17,
+	0x0377C, 0x21C2A, 0x05C70, 0x05C7D, 0x037A9, 0x21FE9, 0x05DE9, 0x22057, 0x03834, 0x03835, 0x221B6, 0x221D7,
+	0x221DE, 0x038A8, 0x05F19, 0x05F1C, 0x05F75, 0x224C2, 0x225AB, 0x038FF, 0x05FC8, 0x2298F, 0x039C7, 0x039C6,
+	0x039C8, 0x22A6D, 0x22EB5, 0x03AD0, 0x03AD1, 0x233C0, 0x233BF, 0x03C59, 0x239B9, 0x23B1C,
+	// plane 4, row 3
+	0x06C12, 0x03CBD, 0x23C80, 0x24184, 0x24185, 0x03E28, 0x072B3, 0x24724, 0x03EA9, 0x07390, 0x07536, 0x2634C,
+	0x043CC, 0x26AF6, 0x08281, 0x08FB8, 0x28670, 0x048B4, 0x28675, 0x28E17, 0x04E23, 0x03416, 0x0342C, 0x201F1,
+	0x04F2E, 0x201DA, 0x0514F, 0x205B9, 0x051BA, 0x034DF, 0x034E0, 0x05222, 0x206CE, 0x206D2, 0x206D0, 0x03517,
+	0x20843, 0x052AF, 0x052B0, 0x052B1, 0x2084D, 0x20934, 0x0352F, 0x209D9, 0x05364, 0x20A18, 0x053D3, 0x20B38,
+	0x0356A, 0x20BD7, 0x03570, 0x0356D, 0x20BDC, 0x20BCB, 0x20BD6, 0x20BD8, 0x0356E, 0x20BD1, 0x2F83E, 0x2F83C,
+	0x20BD2, 0x0362B, 0x03628, 0x21259, 0x00000, 0x0593F, 0x215E5, 0x215E6, 0x03692, 0x215E8, 0x0598B, 0x216BD,
+	0x05991, 0x05995, 0x216BE, 0x21949, 0x0373F, 0x219CD, 0x05B8A, 0x0374F, 0x03774, 0x21B5E, 0x21BD0, 0x0377D,
+	0x21D06, 0x037B7, 0x037A3, 0x037B0, 0x037B1, 0x05C87, 0x037AB, 0x21D7C, 0x21D71, 0x21FEF,
+	// plane 4, row 4
+	0x0383A, 0x03837, 0x05E0D, 0x03838, 0x03840, 0x2218D, 0x221B7, 0x05E8E, 0x0389F, 0x22333, 0x2237F, 0x05F7A,
+	0x225F2, 0x03904, 0x03909, 0x03906, 0x038FD, 0x0390A, 0x03907, 0x22A2A, 0x22A8B, 0x039CA, 0x22A83, 0x22A8A,
+	0x06290, 0x039C9, 0x22A8E, 0x0629A, 0x22EB6, 0x0653C, 0x0653A, 0x03A7F, 0x06598, 0x230D8, 0x03AD2, 0x23156,
+	0x233D2, 0x06765, 0x2F8DB, 0x03B43, 0x233C2, 0x23887, 0x23881, 0x239C2, 0x239BC, 0x23B23, 0x03CC1, 0x23C93,
+	0x03CC5, 0x03DA3, 0x2418D, 0x2418E, 0x03E2A, 0x03E5F, 0x2473B, 0x2474D, 0x03E5D, 0x24734, 0x24731, 0x248F2,
+	0x248F0, 0x03F17, 0x24C15, 0x24D28, 0x03F71, 0x03F72, 0x24F25, 0x250E7, 0x0400F, 0x250E9, 0x25417, 0x079C2,
+	0x04191, 0x2626C, 0x26280, 0x2634B, 0x043B2, 0x043CF, 0x043CE, 0x0809E, 0x268DE, 0x268FB, 0x081EB, 0x26951,
+	0x08289, 0x04496, 0x26B01, 0x26B02, 0x08296, 0x26B05, 0x08287, 0x26B03, 0x04497, 0x28451,
+	// plane 4, row 5
+	0x08FC0, 0x0488B, 0x08FC3, 0x28453, 0x2867A, 0x28678, 0x28676, 0x28679, 0x2868F, 0x2867C, 0x09578, 0x28E1B,
+	0x28E1C, 0x09625, 0x23D92, 0x04E75, 0x04E74, 0x2012E, 0x2012C, 0x0342D, 0x2020C, 0x04F99, 0x2020B, 0x03450,
+	0x0344B, 0x20205, 0x0344F, 0x0344C, 0x2F805, 0x04F71, 0x05153, 0x051BF, 0x205C2, 0x205C3, 0x051C0, 0x20648,
+	0x051EE, 0x20646, 0x034E4, 0x034E3, 0x206EA, 0x034E1, 0x206EB, 0x034E2, 0x206EE, 0x0523D, 0x03519, 0x052BD,
+	0x0530C, 0x208D8, 0x24C1E, 0x209DF, 0x209DE, 0x03541, 0x07F37, 0x20A4F, 0x053C0, 0x0355E, 0x20C0D, 0x20C08,
+	0x03579, 0x20C09, 0x0546E, 0x05483, 0x20C12, 0x20C65, 0x0545E, 0x0545D, 0x0577E, 0x05779, 0x21289, 0x0577A,
+	0x0576C, 0x21284, 0x2129E, 0x2128B, 0x03632, 0x05787, 0x21562, 0x0591D, 0x03694, 0x05946, 0x03697, 0x215F9,
+	0x05943, 0x215F7, 0x03696, 0x03698, 0x21606, 0x216DD, 0x036B2, 0x216D9, 0x216DF, 0x216E2,
+	// plane 4, row 6
+	0x036B9, 0x05B61, 0x05B66, 0x200F1, 0x05B90, 0x03775, 0x0377F, 0x0377E, 0x05C29, 0x0378F, 0x21C3F, 0x21C3E,
+	0x21D0B, 0x037BD, 0x05CB2, 0x21D83, 0x037BB, 0x037BC, 0x21D86, 0x05CC0, 0x2207C, 0x0383D, 0x0383E, 0x03874,
+	0x221F7, 0x0387A, 0x03876, 0x03878, 0x03875, 0x221FB, 0x2233B, 0x038AF, 0x038B0, 0x038C7, 0x038CC, 0x225F9,
+	0x225CA, 0x03916, 0x225FF, 0x03912, 0x0391D, 0x22609, 0x22603, 0x03915, 0x0390F, 0x03914, 0x0601F, 0x05FE2,
+	0x22610, 0x2299A, 0x2299F, 0x039B0, 0x039BF, 0x039C0, 0x22A87, 0x039D2, 0x039D9, 0x22EB7, 0x03A7A, 0x22F08,
+	0x22EF9, 0x03A81, 0x03A82, 0x22EF4, 0x06616, 0x065F9, 0x03ADA, 0x26657, 0x06788, 0x233D6, 0x0679B, 0x2F8DE,
+	0x0676E, 0x0679E, 0x233D4, 0x03C22, 0x03C1F, 0x23890, 0x2388D, 0x03C21, 0x06B24, 0x23960, 0x239CE, 0x239CF,
+	0x03C5C, 0x06B7D, 0x239D1, 0x03C7D, 0x03C8D, 0x03C8F, 0x06CE6, 0x23CCA, 0x06CCB, 0x03CD0,
+	// plane 4, row 7
+	0x23CB7, 0x03CD8, 0x23CB2, 0x23CBB, 0x23CB9, 0x06CB5, 0x03DA7, 0x2419E, 0x07097, 0x241B2, 0x0709B, 0x03E12,
+	0x2456F, 0x245AC, 0x03E2F, 0x24623, 0x0726B, 0x03E2E, 0x03E2C, 0x03E5C, 0x2476C, 0x072D5, 0x24732, 0x2475E,
+	0x03E62, 0x03E67, 0x03EB4, 0x248FB, 0x24B29, 0x24BBD, 0x24C1F, 0x07543, 0x22341, 0x24C1D, 0x0759C, 0x24D31,
+	0x24D2F, 0x03FEA, 0x25043, 0x03FFB, 0x25041, 0x04014, 0x250F5, 0x04013, 0x04012, 0x04010, 0x04011, 0x250F2,
+	0x04086, 0x077E4, 0x04098, 0x2541E, 0x25754, 0x0412A, 0x25756, 0x25929, 0x25927, 0x25928, 0x07ACE, 0x042B5,
+	0x26279, 0x2627E, 0x264B6, 0x264B7, 0x08013, 0x043D6, 0x043D8, 0x080B7, 0x043D9, 0x043D4, 0x043D7, 0x2F981,
+	0x080B9, 0x00000, 0x2664D, 0x081E4, 0x081FD, 0x0820F, 0x04460, 0x26A09, 0x26A08, 0x0449E, 0x044A1, 0x26B1E,
+	0x26B1A, 0x26B23, 0x26B15, 0x26B19, 0x26B16, 0x082BF, 0x082CA, 0x26B14, 0x26B18, 0x082C1,
+	// plane 4, row 8
+	0x044A0, 0x2721F, 0x275DD, 0x275DF, 0x28469, 0x08FD0, 0x00000, 0x2846C, 0x048B9, 0x28693, 0x090AE, 0x28695,
+	0x28694, 0x28691, 0x28697, 0x28E30, 0x049C1, 0x049C2, 0x09638, 0x2FA18, 0x0341C, 0x20231, 0x0345E, 0x04FBC,
+	0x03459, 0x0345C, 0x20236, 0x0345F, 0x04FE9, 0x04FBD, 0x04FE2, 0x05158, 0x205C9, 0x205CA, 0x034CE, 0x20718,
+	0x20712, 0x20719, 0x20710, 0x20716, 0x052C6, 0x2085F, 0x052C8, 0x208DF, 0x20917, 0x05328, 0x2093C, 0x05329,
+	0x209EA, 0x209E9, 0x20A1C, 0x20A58, 0x20A64, 0x20AF3, 0x0355F, 0x20C97, 0x03585, 0x20C5A, 0x20C59, 0x03586,
+	0x20C8E, 0x057B4, 0x212BC, 0x057A9, 0x03687, 0x2160D, 0x215FC, 0x2160C, 0x21707, 0x2170A, 0x036CA, 0x21702,
+	0x036C3, 0x21726, 0x21708, 0x2171D, 0x036C2, 0x05B68, 0x2194E, 0x03741, 0x219ED, 0x219EE, 0x219EF, 0x03780,
+	0x03781, 0x21C50, 0x21C4C, 0x03793, 0x03792, 0x21C4F, 0x037C5, 0x21DD4, 0x00000, 0x21DB0,
+	// plane 4, row 9
+	0x03846, 0x03841, 0x03845, 0x03842, 0x0383F, 0x220A5, 0x22209, 0x03882, 0x03881, 0x0387F, 0x038A5, 0x05F2B,
+	0x038B3, 0x038B5, 0x223F3, 0x223D7, 0x05F8D, 0x038DA, 0x224DC, 0x038DB, 0x0390D, 0x06018, 0x0390E, 0x2260B,
+	0x0391E, 0x03925, 0x03926, 0x0391C, 0x03921, 0x06057, 0x06048, 0x03927, 0x0391A, 0x2263A, 0x2260D, 0x22611,
+	0x06038, 0x22620, 0x22601, 0x03924, 0x22637, 0x2F8A3, 0x06071, 0x229AA, 0x039C1, 0x039E1, 0x22AE7, 0x22AE8,
+	0x06312, 0x039EB, 0x22AF5, 0x039E2, 0x039D7, 0x039E9, 0x22B35, 0x22AE6, 0x0630A, 0x22AF8, 0x06323, 0x22AF1,
+	0x03A84, 0x22F14, 0x22F15, 0x22F09, 0x03AB5, 0x03ABC, 0x03ADC, 0x03ADE, 0x03ADF, 0x2317B, 0x0662A, 0x2317E,
+	0x23366, 0x23367, 0x2340A, 0x03B54, 0x067E0, 0x067BE, 0x03B53, 0x03C24, 0x03C25, 0x06B29, 0x03C28, 0x03C27,
+	0x2389C, 0x23962, 0x239E1, 0x239DE, 0x239DF, 0x239E9, 0x03C8B, 0x23CE4, 0x23CF7, 0x03CDC,
+	// plane 4, row 10
+	0x06D43, 0x23CEA, 0x23CF6, 0x23D12, 0x2F901, 0x070A6, 0x03DB2, 0x070C0, 0x241D9, 0x0722F, 0x2455F, 0x03E1B,
+	0x24573, 0x03E32, 0x07271, 0x2463F, 0x24778, 0x03E6B, 0x2477D, 0x03E6C, 0x03E6D, 0x24912, 0x03EB9, 0x03EBA,
+	0x03F09, 0x03F0A, 0x24B2F, 0x074EA, 0x03F1B, 0x24B30, 0x24BDB, 0x07520, 0x03F58, 0x24C29, 0x03F5A, 0x2F936,
+	0x24D40, 0x03F77, 0x24D3D, 0x24D3E, 0x03F79, 0x075A9, 0x24D38, 0x07685, 0x24F48, 0x03FEB, 0x24FD0, 0x03FFD,
+	0x03FFC, 0x07706, 0x04015, 0x04018, 0x076F6, 0x04016, 0x04017, 0x04019, 0x07700, 0x0401B, 0x2510E, 0x25109,
+	0x07702, 0x25107, 0x04087, 0x2535F, 0x25360, 0x0409C, 0x2542A, 0x0409A, 0x25429, 0x040FF, 0x040FE, 0x2564D,
+	0x04131, 0x0412E, 0x04130, 0x04132, 0x2576C, 0x00000, 0x0412F, 0x25767, 0x04195, 0x04196, 0x25939, 0x25934,
+	0x25A59, 0x041C5, 0x0427A, 0x26223, 0x04342, 0x26282, 0x04354, 0x26283, 0x262A8, 0x26355,
+	// plane 4, row 11
+	0x263F9, 0x263F8, 0x263FC, 0x263FA, 0x08009, 0x2F97A, 0x0439F, 0x043A0, 0x043A2, 0x043E0, 0x26674, 0x043E1,
+	0x2666B, 0x26671, 0x043DF, 0x26676, 0x26A0E, 0x04462, 0x04461, 0x26A10, 0x26A0F, 0x044A7, 0x26B38, 0x26B39,
+	0x26B3A, 0x082DA, 0x26B37, 0x26B3E, 0x0830A, 0x04589, 0x2719C, 0x2722A, 0x2723A, 0x2722B, 0x27228, 0x275AA,
+	0x2760F, 0x0461D, 0x27610, 0x277E6, 0x0472A, 0x27D25, 0x27E8E, 0x047D3, 0x04842, 0x04843, 0x2848B, 0x04891,
+	0x2848C, 0x2849A, 0x04890, 0x048BF, 0x286B3, 0x048BC, 0x286B2, 0x048C0, 0x049C7, 0x049C5, 0x09655, 0x28E44,
+	0x09652, 0x04E35, 0x20158, 0x202A7, 0x05034, 0x05001, 0x20263, 0x0500A, 0x03466, 0x2026A, 0x20265, 0x2026B,
+	0x034AD, 0x204ED, 0x205D9, 0x2073B, 0x20732, 0x05258, 0x20735, 0x20731, 0x2073E, 0x20743, 0x20736, 0x2086D,
+	0x0351D, 0x208E4, 0x03531, 0x0532B, 0x209F4, 0x0354C, 0x20A69, 0x20A6B, 0x03595, 0x03591,
+	// plane 4, row 12
+	0x03594, 0x20CBF, 0x20CC3, 0x20CAE, 0x0358F, 0x054EC, 0x20CB1, 0x05515, 0x054FE, 0x20CBB, 0x20CAF, 0x20CB0,
+	0x054E3, 0x05516, 0x03640, 0x212EF, 0x03641, 0x212ED, 0x057D3, 0x212F0, 0x21593, 0x21634, 0x2162B, 0x05959,
+	0x05A27, 0x036D8, 0x036D2, 0x036DA, 0x05A28, 0x05A10, 0x036D7, 0x05A0E, 0x03742, 0x03757, 0x21A04, 0x21A03,
+	0x21A02, 0x05BAF, 0x21A05, 0x05BBA, 0x05BB1, 0x21A00, 0x21B74, 0x03778, 0x03782, 0x03797, 0x21C65, 0x21C63,
+	0x037C9, 0x21DD7, 0x037C8, 0x037D6, 0x037CC, 0x21DE8, 0x037D0, 0x21DDB, 0x21DD8, 0x037CE, 0x037C7, 0x05CFC,
+	0x21E09, 0x037CF, 0x037CB, 0x05CF2, 0x05CFE, 0x21DE5, 0x21DE7, 0x05DF8, 0x2203F, 0x03847, 0x220C0, 0x03848,
+	0x220B9, 0x220BA, 0x03883, 0x03885, 0x03884, 0x22220, 0x2234F, 0x05F2C, 0x038B8, 0x038BC, 0x22491, 0x22490,
+	0x224EE, 0x224F3, 0x224EC, 0x224F1, 0x224EB, 0x224F2, 0x224F4, 0x224ED, 0x22641, 0x22648,
+	// plane 4, row 13
+	0x22645, 0x03931, 0x03934, 0x03936, 0x06082, 0x2267F, 0x2268B, 0x03923, 0x2263B, 0x2264E, 0x0393A, 0x06091,
+	0x0608F, 0x2267C, 0x039B4, 0x039B5, 0x039ED, 0x039EC, 0x039D8, 0x22AF0, 0x22B36, 0x22B3F, 0x22B43, 0x22B31,
+	0x22B34, 0x22B46, 0x039F6, 0x039E7, 0x22B2F, 0x22EC3, 0x03A7C, 0x03A7B, 0x22F36, 0x03A88, 0x06547, 0x0654C,
+	0x22F21, 0x2F8C8, 0x0658A, 0x23073, 0x03ABE, 0x230E6, 0x03AE7, 0x03AE5, 0x03AEE, 0x067E1, 0x0684A, 0x2343F,
+	0x03B59, 0x03B5E, 0x2346B, 0x03B5A, 0x0683F, 0x03B61, 0x03B58, 0x03B5B, 0x067BD, 0x23471, 0x03B5F, 0x2F8E2,
+	0x03C2A, 0x03C2D, 0x238B0, 0x03C23, 0x03C2B, 0x03C2C, 0x239F3, 0x03C7E, 0x2F8F5, 0x03C93, 0x03C99, 0x23B61,
+	0x23B5F, 0x03CB3, 0x03CE7, 0x03CEA, 0x23D28, 0x23D1E, 0x23D29, 0x2F902, 0x23CEB, 0x23D66, 0x03DB4, 0x241F6,
+	0x070C9, 0x03E17, 0x24560, 0x24577, 0x245BB, 0x03E21, 0x245BC, 0x03E38, 0x03E37, 0x03E74,
+	// plane 4, row 14
+	0x03E73, 0x03E75, 0x247A2, 0x247B2, 0x2479E, 0x03E76, 0x03E78, 0x2493A, 0x24938, 0x073BA, 0x03F0C, 0x03F20,
+	0x03F1E, 0x24C4D, 0x03F5B, 0x24C5E, 0x03F5C, 0x24D17, 0x03F83, 0x075C6, 0x03F80, 0x03F81, 0x03F7E, 0x03F88,
+	0x03F85, 0x03F89, 0x03F7F, 0x03F8E, 0x24D64, 0x24D67, 0x03F84, 0x075B7, 0x0768C, 0x24F59, 0x0768D, 0x24FDA,
+	0x03FEE, 0x03FED, 0x03FFE, 0x25051, 0x03FFF, 0x25055, 0x2513B, 0x25134, 0x04023, 0x25137, 0x07717, 0x25131,
+	0x0771C, 0x0401F, 0x2513F, 0x25159, 0x07714, 0x25361, 0x0408F, 0x04090, 0x25458, 0x25462, 0x25450, 0x040A0,
+	0x25451, 0x040A6, 0x0409F, 0x25446, 0x040A7, 0x040A1, 0x2562D, 0x04102, 0x04136, 0x25948, 0x25951, 0x2594A,
+	0x2594F, 0x25950, 0x25949, 0x25A63, 0x041C8, 0x25A66, 0x25A60, 0x041D8, 0x07B0C, 0x041DD, 0x041DC, 0x25AF1,
+	0x041D7, 0x25B07, 0x041DA, 0x042BA, 0x25F9B, 0x25FA3, 0x25F9D, 0x042BE, 0x042C2, 0x042BB,
+	// plane 4, row 15
+	0x042C0, 0x25FA9, 0x25FA7, 0x25FA4, 0x07D23, 0x25FBA, 0x221C1, 0x26226, 0x04343, 0x04355, 0x26294, 0x26293,
+	0x04357, 0x04368, 0x07F98, 0x07F90, 0x26407, 0x2640D, 0x26402, 0x2640C, 0x26409, 0x26408, 0x26413, 0x043A1,
+	0x264D2, 0x0803A, 0x2653E, 0x2653C, 0x26667, 0x2669E, 0x043EA, 0x266AD, 0x043E7, 0x266D9, 0x26642, 0x266A3,
+	0x266A0, 0x043E8, 0x043E9, 0x2667C, 0x26904, 0x04454, 0x26958, 0x26960, 0x269C9, 0x269C8, 0x08226, 0x04465,
+	0x26A1C, 0x26A1A, 0x0448A, 0x26B74, 0x044B0, 0x26B6E, 0x26B6F, 0x26B79, 0x044BC, 0x0832E, 0x26B77, 0x08355,
+	0x0831A, 0x044B8, 0x0833D, 0x26B7C, 0x044B2, 0x26B70, 0x08330, 0x044BD, 0x26B7A, 0x26B82, 0x0458A, 0x08651,
+	0x045A1, 0x27244, 0x045A2, 0x27243, 0x08688, 0x27245, 0x04615, 0x27625, 0x2761F, 0x04620, 0x04673, 0x277E8,
+	0x0898E, 0x0898D, 0x27809, 0x278B7, 0x278B9, 0x27964, 0x27963, 0x08A09, 0x08A14, 0x046B1,
+	// plane 4, row 16
+	0x27962, 0x27965, 0x0472B, 0x27BAC, 0x04745, 0x27D2A, 0x04797, 0x04798, 0x27E96, 0x047D5, 0x27FC2, 0x2F9DE,
+	0x04893, 0x04896, 0x09007, 0x04894, 0x284AA, 0x284B0, 0x048C7, 0x048C5, 0x286D4, 0x286CD, 0x286D6, 0x048C4,
+	0x287F0, 0x09579, 0x09584, 0x049CE, 0x049CA, 0x049CC, 0x09657, 0x049C9, 0x096BA, 0x00000, 0x29D4B, 0x20162,
+	0x0346E, 0x202B7, 0x05067, 0x202AC, 0x202AB, 0x03471, 0x202C1, 0x034BB, 0x034D3, 0x205E8, 0x20769, 0x2075D,
+	0x2075F, 0x034F3, 0x034ED, 0x034F5, 0x20764, 0x20762, 0x034F1, 0x034F2, 0x034F6, 0x03520, 0x208F9, 0x03528,
+	0x208EE, 0x05318, 0x0532C, 0x05359, 0x209AB, 0x209AC, 0x209AA, 0x05368, 0x0537E, 0x20A86, 0x20A7A, 0x053A1,
+	0x20D28, 0x035A1, 0x0555B, 0x035AA, 0x035A9, 0x20D31, 0x035B5, 0x035A5, 0x035A8, 0x05542, 0x035A7, 0x05547,
+	0x20D2D, 0x2F843, 0x0553D, 0x20D2B, 0x20D32, 0x05560, 0x057EB, 0x21329, 0x21326, 0x21353,
+	// plane 4, row 17
+	0x0364D, 0x21333, 0x21334, 0x2159D, 0x0369C, 0x0595F, 0x21641, 0x036EA, 0x036E5, 0x21791, 0x21769, 0x05B6F,
+	0x21A22, 0x0375E, 0x21A34, 0x2F86D, 0x21B2E, 0x03786, 0x03784, 0x21BF4, 0x05C5A, 0x21E28, 0x037D9, 0x21E1C,
+	0x037DE, 0x21E11, 0x21E24, 0x21E15, 0x037DB, 0x21E16, 0x21E54, 0x21E23, 0x21FFF, 0x03831, 0x220CF, 0x220D0,
+	0x0384B, 0x220CE, 0x03849, 0x0384A, 0x0384C, 0x221C7, 0x2225C, 0x0388A, 0x22236, 0x03889, 0x0388B, 0x22243,
+	0x038BB, 0x05FA2, 0x05F9D, 0x038E4, 0x2252A, 0x22501, 0x05FA3, 0x2250B, 0x2250F, 0x0393B, 0x0392E, 0x0393E,
+	0x03946, 0x03953, 0x226DF, 0x03944, 0x226D2, 0x0393F, 0x03942, 0x0394F, 0x226CD, 0x03952, 0x0394A, 0x060C2,
+	0x226DB, 0x0395A, 0x060A5, 0x03949, 0x226CF, 0x0621C, 0x229C0, 0x0621D, 0x03A03, 0x22B81, 0x22B82, 0x22B8A,
+	0x22BA8, 0x22B8C, 0x06395, 0x0639A, 0x03A01, 0x03A06, 0x039FB, 0x039F9, 0x22B8E, 0x03A05,
+	// plane 4, row 18
+	0x039FA, 0x22B8B, 0x063A6, 0x22B96, 0x039FE, 0x22BCC, 0x03A7D, 0x03A8C, 0x22F4F, 0x22F4A, 0x06550, 0x03A8E,
+	0x22F4B, 0x22F3D, 0x06552, 0x22F44, 0x22F49, 0x2309E, 0x230F3, 0x065C8, 0x230F5, 0x03AF0, 0x03AF2, 0x231B3,
+	0x06658, 0x03AF1, 0x03AE6, 0x231E5, 0x231B6, 0x2F8D8, 0x06888, 0x23486, 0x03B6F, 0x03B6D, 0x03B69, 0x234B6,
+	0x23485, 0x03B6E, 0x234AB, 0x03C2F, 0x03C30, 0x03C63, 0x23A0D, 0x23A8B, 0x23A8C, 0x06BB8, 0x03C80, 0x06BB9,
+	0x03C9A, 0x03C94, 0x03C96, 0x03C95, 0x03C97, 0x23B72, 0x03CF4, 0x03CFE, 0x23D8F, 0x03D01, 0x23D79, 0x03D02,
+	0x23D77, 0x03CF9, 0x23D90, 0x23D88, 0x23D80, 0x23D9E, 0x03CF6, 0x03CF7, 0x23D82, 0x23D34, 0x23D89, 0x03CFF,
+	0x23D85, 0x23D7E, 0x23D7A, 0x23DA6, 0x06E0B, 0x23DB5, 0x241F4, 0x03DBF, 0x03DBC, 0x07105, 0x24229, 0x24226,
+	0x2422A, 0x03DBE, 0x03DC0, 0x2457E, 0x03E3B, 0x03E39, 0x2465B, 0x24661, 0x24660, 0x03E3C,
+	// plane 4, row 19
+	0x2479B, 0x07314, 0x07304, 0x247C3, 0x03E7D, 0x247CD, 0x03E7F, 0x03E7A, 0x03E7C, 0x07305, 0x03E7E, 0x07315,
+	0x0730D, 0x03E80, 0x03EBF, 0x03EC3, 0x24954, 0x24959, 0x03ECC, 0x03F0E, 0x03F0D, 0x24AF5, 0x03F26, 0x03F24,
+	0x03F25, 0x03F23, 0x03F21, 0x03F29, 0x24C69, 0x24C65, 0x03F8F, 0x03F8D, 0x24D7C, 0x03F8B, 0x03F92, 0x24D80,
+	0x03F90, 0x24F65, 0x03FEF, 0x03FF0, 0x24FDF, 0x25060, 0x04001, 0x2505E, 0x0402E, 0x0402D, 0x0772E, 0x04028,
+	0x04029, 0x0402C, 0x2518F, 0x25161, 0x2515A, 0x07741, 0x04088, 0x25367, 0x04092, 0x04091, 0x077EA, 0x253BB,
+	0x07844, 0x040A9, 0x040AC, 0x040AE, 0x040AA, 0x04106, 0x04105, 0x0414A, 0x0413E, 0x0413C, 0x0413B, 0x2579B,
+	0x04142, 0x04141, 0x04143, 0x257A9, 0x04145, 0x25966, 0x0419A, 0x0419B, 0x0419F, 0x0419E, 0x25A72, 0x25A76,
+	0x25B14, 0x041DE, 0x041E2, 0x041E6, 0x25B0F, 0x07B29, 0x041E3, 0x07B27, 0x041DF, 0x25E41,
+	// plane 4, row 20
+	0x25E46, 0x07C9D, 0x25E4B, 0x25E43, 0x0427E, 0x042C9, 0x042CC, 0x25FC6, 0x042D1, 0x25FC7, 0x042D0, 0x25FCE,
+	0x042CF, 0x042C8, 0x25FC9, 0x042CD, 0x25FCB, 0x25FC5, 0x04349, 0x04347, 0x04358, 0x0436B, 0x0436C, 0x0436A,
+	0x26369, 0x04380, 0x04382, 0x04384, 0x07FC8, 0x04383, 0x2641E, 0x264BA, 0x043B6, 0x26552, 0x26551, 0x26553,
+	0x2669F, 0x266DB, 0x08126, 0x043F1, 0x266E3, 0x043F6, 0x043F3, 0x043F0, 0x0811C, 0x266D7, 0x266E9, 0x08128,
+	0x043F5, 0x043F4, 0x043F7, 0x266DE, 0x266DC, 0x2693C, 0x2F98B, 0x04455, 0x26A22, 0x26A32, 0x26A23, 0x0448B,
+	0x044CB, 0x044C2, 0x26BD4, 0x26BC8, 0x26BBC, 0x044CA, 0x044CC, 0x26BCD, 0x044C7, 0x044C9, 0x08370, 0x26BBE,
+	0x044C6, 0x26C40, 0x26BD6, 0x044C3, 0x08382, 0x26BBD, 0x083AC, 0x26BBA, 0x044C4, 0x26BC0, 0x2F99B, 0x2F99D,
+	0x26BC1, 0x271A6, 0x271A5, 0x271A8, 0x045A9, 0x086AD, 0x045A8, 0x045A6, 0x2725F, 0x27270,
+	// plane 4, row 21
+	0x2726A, 0x27265, 0x27267, 0x2726F, 0x27287, 0x086CA, 0x08851, 0x2763D, 0x27643, 0x04622, 0x27640, 0x04626,
+	0x04624, 0x04625, 0x0889D, 0x0462A, 0x04674, 0x27818, 0x04679, 0x08990, 0x0467A, 0x27819, 0x278C1, 0x089D8,
+	0x089D7, 0x04697, 0x278BC, 0x278C8, 0x08A2E, 0x27971, 0x046BC, 0x2796E, 0x046B3, 0x27978, 0x046BF, 0x046B7,
+	0x2F9CD, 0x2797A, 0x27BB1, 0x27BAF, 0x27BB0, 0x04734, 0x04746, 0x04748, 0x08C59, 0x27CA8, 0x27CA6, 0x04756,
+	0x04767, 0x27D48, 0x27D45, 0x04768, 0x04799, 0x0479A, 0x27EA0, 0x27EA4, 0x047D8, 0x27FD4, 0x047DB, 0x047DC,
+	0x047DD, 0x047D7, 0x27FD2, 0x28222, 0x04849, 0x0484A, 0x08EDA, 0x282B7, 0x282CC, 0x09033, 0x284E6, 0x09018,
+	0x0489A, 0x284C8, 0x048CD, 0x048CA, 0x286F4, 0x048CB, 0x286ED, 0x048CF, 0x2867E, 0x2F9E3, 0x048CC, 0x048EA,
+	0x048ED, 0x287F9, 0x287FD, 0x048E9, 0x28807, 0x0491A, 0x091EF, 0x0498D, 0x049D2, 0x28E78,
+	// plane 4, row 22
+	0x28E7A, 0x28E79, 0x28F8A, 0x049F2, 0x2907B, 0x291D5, 0x291E9, 0x04A3D, 0x291EB, 0x04A3E, 0x04AF8, 0x04B23,
+	0x09AD9, 0x04EB4, 0x2016C, 0x050A0, 0x05090, 0x03475, 0x05086, 0x05084, 0x202FA, 0x0508A, 0x03476, 0x03473,
+	0x0509F, 0x050A1, 0x20318, 0x05093, 0x034BD, 0x205F6, 0x051D5, 0x2078A, 0x20789, 0x034F9, 0x2077F, 0x20788,
+	0x034FA, 0x20783, 0x20784, 0x20798, 0x20763, 0x20787, 0x03521, 0x2F827, 0x03529, 0x208F5, 0x20950, 0x03538,
+	0x0354E, 0x20A87, 0x20A8A, 0x20B65, 0x20DB7, 0x20DC1, 0x20DC7, 0x035C8, 0x035BC, 0x20DC5, 0x20DCB, 0x05590,
+	0x20DD1, 0x035BB, 0x035C2, 0x035C0, 0x20DB8, 0x20DCA, 0x035CA, 0x20DDE, 0x035C9, 0x20DCE, 0x035B8, 0x05710,
+	0x05817, 0x21368, 0x0364E, 0x2136B, 0x05844, 0x03650, 0x0582B, 0x2136A, 0x05845, 0x21366, 0x21377, 0x21396,
+	0x2136E, 0x2F856, 0x0368A, 0x21657, 0x05965, 0x21656, 0x2179C, 0x2179E, 0x036FC, 0x036F9,
+	// plane 4, row 23
+	0x217A1, 0x03763, 0x21A42, 0x05BCF, 0x21A46, 0x21A3E, 0x21B33, 0x03787, 0x03788, 0x21BFA, 0x0379A, 0x21C97,
+	0x21C9B, 0x21CAA, 0x05D56, 0x21E73, 0x21E7C, 0x037E9, 0x21E86, 0x037EA, 0x05D54, 0x03850, 0x220F3, 0x220F0,
+	0x03856, 0x03852, 0x0384F, 0x03854, 0x03851, 0x220EC, 0x222AF, 0x0388E, 0x0388F, 0x22264, 0x22268, 0x2235A,
+	0x05F3D, 0x2251F, 0x22525, 0x038ED, 0x038EB, 0x05FA4, 0x226C6, 0x226D6, 0x226C3, 0x03962, 0x2272C, 0x0395D,
+	0x2272E, 0x2275E, 0x22733, 0x03961, 0x03965, 0x0395C, 0x2272D, 0x22746, 0x0395F, 0x226C1, 0x2273A, 0x229CC,
+	0x22B9D, 0x22B83, 0x22BF6, 0x063EC, 0x03A16, 0x03A0A, 0x22BF8, 0x03A0E, 0x03A12, 0x22BFE, 0x22BF3, 0x03A11,
+	0x22BEB, 0x22BFA, 0x03A10, 0x22C07, 0x22BFC, 0x03A09, 0x063FA, 0x03A15, 0x063D4, 0x22F72, 0x22F73, 0x22F74,
+	0x03A91, 0x03A95, 0x03A93, 0x03A92, 0x03A8F, 0x22F5F, 0x22F60, 0x230AA, 0x23134, 0x03AF6,
+	// plane 4, row 24
+	0x231B4, 0x03AFB, 0x231F0, 0x06675, 0x03AF9, 0x2320D, 0x231F3, 0x2320F, 0x0671C, 0x03B7D, 0x234C9, 0x03B7A,
+	0x03B7F, 0x234C5, 0x03B78, 0x234D7, 0x234CC, 0x068D9, 0x234E7, 0x03B70, 0x03B82, 0x234C1, 0x03B84, 0x234E8,
+	0x03C33, 0x238CB, 0x03C32, 0x03C36, 0x238C8, 0x2397A, 0x03C56, 0x23979, 0x2397E, 0x03C67, 0x23A1B, 0x03C65,
+	0x03C64, 0x03C66, 0x23A1F, 0x23A19, 0x03C81, 0x03C82, 0x03C83, 0x03C9E, 0x23B89, 0x06BF1, 0x03C9D, 0x23B8B,
+	0x03D0F, 0x03D12, 0x23E09, 0x23DFE, 0x23E00, 0x03D10, 0x03D18, 0x23E0B, 0x03D14, 0x03D19, 0x06E37, 0x00000,
+	0x23E4A, 0x06E7D, 0x06E86, 0x03DC8, 0x03DC4, 0x03DC6, 0x24279, 0x03DC7, 0x03DC3, 0x2426C, 0x24299, 0x2F91B,
+	0x24262, 0x24514, 0x03E19, 0x03E1C, 0x245C8, 0x245D7, 0x2460C, 0x03E41, 0x24670, 0x03E42, 0x03E43, 0x24671,
+	0x247DF, 0x247E4, 0x03E82, 0x247E7, 0x03E81, 0x03E94, 0x03E84, 0x24990, 0x03ED2, 0x03F0F,
+	// plane 4, row 25
+	0x03F22, 0x24B4C, 0x03F27, 0x03F2A, 0x074FA, 0x03F28, 0x24B4F, 0x03F60, 0x24C7D, 0x24C7E, 0x24C7C, 0x07572,
+	0x24C8D, 0x24D19, 0x03F9B, 0x03F9C, 0x24DC0, 0x03F93, 0x03F94, 0x075DC, 0x03FA0, 0x03F99, 0x24DB6, 0x03FA1,
+	0x24DA8, 0x03FF1, 0x24FEB, 0x24FE7, 0x2506F, 0x251C7, 0x04036, 0x2519F, 0x04037, 0x0403F, 0x0403C, 0x251C4,
+	0x04034, 0x04039, 0x0403B, 0x04035, 0x04030, 0x04032, 0x04038, 0x0403E, 0x0403A, 0x2519B, 0x25197, 0x251C5,
+	0x2536B, 0x253CE, 0x040B6, 0x07867, 0x254B1, 0x254AE, 0x254B0, 0x040B3, 0x04109, 0x07977, 0x2573E, 0x257B5,
+	0x257BA, 0x0414C, 0x257B2, 0x257B4, 0x04153, 0x0414D, 0x04151, 0x0414F, 0x07A9B, 0x25977, 0x041A2, 0x25986,
+	0x2597B, 0x041CD, 0x25A7E, 0x25B2E, 0x041E7, 0x25B2F, 0x041F0, 0x25B37, 0x041E9, 0x041EC, 0x25B32, 0x041E8,
+	0x041EE, 0x04202, 0x25B36, 0x25B39, 0x25B35, 0x25E5C, 0x04282, 0x04283, 0x04286, 0x25E7B,
+	// plane 4, row 26
+	0x25E61, 0x25FEE, 0x25FEB, 0x25FEF, 0x26020, 0x07D2A, 0x07D65, 0x0434A, 0x26230, 0x0435A, 0x07F64, 0x262BE,
+	0x0436E, 0x26367, 0x2637C, 0x04370, 0x0436F, 0x26424, 0x0438A, 0x04387, 0x04388, 0x26429, 0x264EF, 0x08020,
+	0x043B7, 0x043FD, 0x08120, 0x2670D, 0x04405, 0x0813C, 0x04408, 0x04403, 0x04402, 0x04404, 0x03B39, 0x04409,
+	0x043FF, 0x26764, 0x0813F, 0x26715, 0x043FC, 0x04401, 0x0440A, 0x081F0, 0x081F5, 0x0446B, 0x0446C, 0x26A35,
+	0x26A36, 0x26A34, 0x26A37, 0x26C47, 0x26C2B, 0x044DE, 0x26C41, 0x26C43, 0x044DB, 0x26C42, 0x044DD, 0x044E3,
+	0x26C32, 0x044E0, 0x044D9, 0x044D8, 0x044E4, 0x26C44, 0x26C4A, 0x044DA, 0x044EF, 0x2F9A0, 0x08415, 0x083BE,
+	0x26C54, 0x26C6E, 0x26C52, 0x044D7, 0x045B3, 0x045BB, 0x086E5, 0x045B2, 0x086D2, 0x045AD, 0x27292, 0x045AF,
+	0x2F9B8, 0x086E0, 0x272BF, 0x04616, 0x04628, 0x04623, 0x088B3, 0x27641, 0x27669, 0x27665,
+	// plane 4, row 27
+	0x04675, 0x0467E, 0x0467C, 0x27822, 0x2781D, 0x278D2, 0x278DA, 0x278DB, 0x046CE, 0x046CD, 0x046CF, 0x08A53,
+	0x279A4, 0x2799E, 0x08A37, 0x08A47, 0x08A5C, 0x2799D, 0x046C4, 0x046CC, 0x046C8, 0x046C7, 0x279AD, 0x279A6,
+	0x279A7, 0x27BB3, 0x27BE0, 0x27C35, 0x27C37, 0x0475A, 0x0476A, 0x27D4A, 0x0476B, 0x0476D, 0x0476F, 0x27E5E,
+	0x0479E, 0x27EC0, 0x047A4, 0x047A3, 0x047E4, 0x047E8, 0x047E9, 0x047E0, 0x047E3, 0x27FF9, 0x047EA, 0x047E1,
+	0x047ED, 0x04834, 0x04835, 0x04851, 0x08EF0, 0x284F0, 0x0489D, 0x284F3, 0x2851B, 0x284F2, 0x284FB, 0x2871C,
+	0x28713, 0x048D0, 0x2870F, 0x048EE, 0x28816, 0x28811, 0x28812, 0x048F2, 0x2880E, 0x0921D, 0x04988, 0x28C67,
+	0x28CF2, 0x28CDA, 0x0498F, 0x28CDC, 0x049D8, 0x28F94, 0x28F9B, 0x28F92, 0x28F9A, 0x29088, 0x29086, 0x04A3F,
+	0x291EF, 0x04A52, 0x0976B, 0x2925E, 0x04A50, 0x29458, 0x04AB1, 0x29456, 0x29454, 0x04AF9,
+	// plane 4, row 28
+	0x2959B, 0x29696, 0x296A4, 0x04B26, 0x04B28, 0x2969A, 0x29A12, 0x03480, 0x050C0, 0x03481, 0x2036B, 0x0347E,
+	0x0347F, 0x20337, 0x2033C, 0x2F80A, 0x204F7, 0x034BE, 0x20566, 0x034D6, 0x207A9, 0x207AE, 0x207AD, 0x207C8,
+	0x207A5, 0x207B0, 0x20895, 0x052E5, 0x20925, 0x03534, 0x20A99, 0x053AF, 0x20B74, 0x20B70, 0x20B6F, 0x20E41,
+	0x20E54, 0x035D5, 0x035D8, 0x20E40, 0x20E38, 0x035D4, 0x055D8, 0x035D9, 0x20E52, 0x20E3A, 0x20E57, 0x2F84A,
+	0x20E59, 0x05711, 0x05867, 0x213B4, 0x213C0, 0x0365D, 0x05843, 0x0365E, 0x03659, 0x21375, 0x0365A, 0x036A0,
+	0x21660, 0x21647, 0x03705, 0x217F0, 0x03707, 0x217EF, 0x0370E, 0x0370C, 0x217EC, 0x21983, 0x03745, 0x21984,
+	0x2198F, 0x03764, 0x21A61, 0x03765, 0x21A69, 0x05BDD, 0x03766, 0x21A62, 0x03789, 0x037EC, 0x037F1, 0x05D70,
+	0x05D6A, 0x037F0, 0x037F8, 0x05D74, 0x05D5F, 0x21EAE, 0x05D61, 0x05D73, 0x21EB2, 0x037F2,
+	// plane 4, row 29
+	0x037F4, 0x2210B, 0x03858, 0x22110, 0x2210D, 0x0385A, 0x03859, 0x03857, 0x0385B, 0x05E50, 0x2227F, 0x22281,
+	0x038A6, 0x038C2, 0x038C1, 0x05F3F, 0x2240B, 0x2254E, 0x038EF, 0x05FB0, 0x227EA, 0x22745, 0x03968, 0x06135,
+	0x0612D, 0x03973, 0x0396E, 0x03974, 0x06102, 0x03966, 0x22728, 0x2275D, 0x229DC, 0x039B9, 0x06226, 0x229D4,
+	0x03A0C, 0x22C64, 0x22C68, 0x03A20, 0x22C62, 0x03A1D, 0x22C61, 0x03A1C, 0x22C66, 0x03A21, 0x03A1A, 0x03A19,
+	0x22BF2, 0x22C69, 0x22C67, 0x03A7E, 0x22F9A, 0x22F9B, 0x22F85, 0x22F9C, 0x03A9D, 0x22FA4, 0x03A9E, 0x03A9C,
+	0x0656E, 0x22F99, 0x22F86, 0x22F90, 0x23081, 0x230AE, 0x065B1, 0x065D4, 0x03ACD, 0x23138, 0x03B0B, 0x03B0A,
+	0x06685, 0x2353B, 0x03B8F, 0x06972, 0x03B95, 0x03B90, 0x03B91, 0x2354C, 0x0693A, 0x03BB9, 0x23547, 0x23535,
+	0x03B97, 0x03B9E, 0x23533, 0x03B8B, 0x23582, 0x23540, 0x03C3B, 0x03C3A, 0x03C3C, 0x03C3D,
+	// plane 4, row 30
+	0x03C39, 0x03C3E, 0x03C6B, 0x03C6C, 0x23A36, 0x23B0E, 0x03CA2, 0x03CA1, 0x03C9F, 0x23BAD, 0x23BAA, 0x23E17,
+	0x03D2D, 0x23E67, 0x03D36, 0x03D2B, 0x23E72, 0x03D37, 0x2F90A, 0x23E61, 0x23EB0, 0x06EAD, 0x03D25, 0x03D2F,
+	0x03D2C, 0x23E6E, 0x23E64, 0x03D32, 0x23E8C, 0x23E66, 0x06E95, 0x23E6B, 0x23E5F, 0x23E74, 0x23E65, 0x242BB,
+	0x242BE, 0x03DD5, 0x242B9, 0x03DD4, 0x03DD6, 0x242EF, 0x03DD1, 0x07243, 0x245DA, 0x03E46, 0x0728F, 0x24690,
+	0x247E9, 0x03E8C, 0x03E8A, 0x03E88, 0x24811, 0x2480D, 0x247ED, 0x24821, 0x03EDD, 0x24981, 0x249B1, 0x24B01,
+	0x03F2D, 0x24B5E, 0x24B62, 0x03F2E, 0x03F2C, 0x03F2B, 0x03F30, 0x24B5B, 0x03F4E, 0x24C96, 0x24C93, 0x03F64,
+	0x03F61, 0x24C92, 0x07575, 0x2F939, 0x03F70, 0x03FA6, 0x03FA4, 0x24DC4, 0x24DC7, 0x24DC3, 0x03FA8, 0x03FA2,
+	0x24DC8, 0x03FA7, 0x075EC, 0x03FA5, 0x24DCA, 0x03FA9, 0x24DC5, 0x24DCF, 0x24DDC, 0x24F7C,
+	// plane 4, row 31
+	0x25001, 0x0403D, 0x251D3, 0x251DC, 0x04044, 0x04045, 0x04046, 0x251D4, 0x07757, 0x251CC, 0x04047, 0x04048,
+	0x04042, 0x251D6, 0x251DB, 0x04041, 0x251D5, 0x253D9, 0x04094, 0x253DD, 0x253DC, 0x253E0, 0x254CC, 0x040C0,
+	0x040B8, 0x040C1, 0x040C2, 0x040BB, 0x040BD, 0x040BF, 0x040B9, 0x040B7, 0x254D2, 0x040C7, 0x254D3, 0x0410C,
+	0x0410B, 0x0797B, 0x04110, 0x2568D, 0x0415D, 0x07A21, 0x0415A, 0x04158, 0x04156, 0x257D8, 0x04154, 0x07A16,
+	0x257BC, 0x041A8, 0x041A7, 0x041CF, 0x041D0, 0x25A8A, 0x07AE8, 0x25A8B, 0x25A8D, 0x041D1, 0x041EB, 0x25B53,
+	0x041FB, 0x07B6A, 0x25B59, 0x041FD, 0x041F8, 0x041F7, 0x04200, 0x25B56, 0x25B57, 0x041F6, 0x07B5F, 0x25E73,
+	0x2601B, 0x042DF, 0x26021, 0x26016, 0x26018, 0x042E2, 0x042E4, 0x26044, 0x07D82, 0x26026, 0x042E3, 0x26236,
+	0x04359, 0x04371, 0x0438E, 0x0438C, 0x2643A, 0x043A4, 0x264F4, 0x08055, 0x04414, 0x26762,
+	// plane 4, row 32
+	0x2675B, 0x2676A, 0x04411, 0x26768, 0x0441B, 0x04412, 0x0440E, 0x04415, 0x08168, 0x04410, 0x26781, 0x04417,
+	0x08246, 0x08243, 0x04470, 0x044ED, 0x26CE7, 0x044EE, 0x26CE6, 0x26CD1, 0x26D11, 0x08481, 0x26CD0, 0x26CD9,
+	0x26D0A, 0x044F4, 0x26CDA, 0x26CDD, 0x26CC8, 0x26CE2, 0x26CE9, 0x26CCB, 0x0847C, 0x26CCC, 0x26CC1, 0x0846A,
+	0x26CDE, 0x08488, 0x044F2, 0x044F8, 0x044F3, 0x26D51, 0x26D12, 0x044FA, 0x26CE0, 0x26D2B, 0x2F9B4, 0x272D8,
+	0x272D1, 0x08710, 0x272D2, 0x272CD, 0x0871F, 0x045B6, 0x045B7, 0x2733D, 0x0870F, 0x272CA, 0x045BA, 0x272CB,
+	0x045BC, 0x272D7, 0x275F4, 0x0463B, 0x088D3, 0x0462F, 0x27687, 0x2768B, 0x27683, 0x04637, 0x04699, 0x279C5,
+	0x279D2, 0x279C6, 0x279D3, 0x046D9, 0x046D8, 0x046D7, 0x279F0, 0x279CD, 0x279CC, 0x279DC, 0x279D6, 0x04736,
+	0x27BE6, 0x27C41, 0x27C3F, 0x08C87, 0x27CC5, 0x27CC0, 0x27CC6, 0x27CBE, 0x27CBF, 0x08CC6,
+	// plane 4, row 33
+	0x04770, 0x27D63, 0x27ED3, 0x27EDA, 0x27ED5, 0x047A5, 0x047A6, 0x047A9, 0x047EE, 0x04854, 0x282EE, 0x04857,
+	0x282FC, 0x28414, 0x048A1, 0x28523, 0x28522, 0x28530, 0x286B5, 0x048D3, 0x28738, 0x048D4, 0x2873F, 0x048D7,
+	0x090CC, 0x0916D, 0x09170, 0x048F7, 0x048F6, 0x048F9, 0x048F8, 0x09258, 0x09242, 0x09268, 0x09269, 0x28968,
+	0x28967, 0x09243, 0x28965, 0x09247, 0x0498A, 0x28C75, 0x28CF7, 0x28CF3, 0x28CF6, 0x04994, 0x28D00, 0x04993,
+	0x28CFF, 0x28CF5, 0x0959D, 0x049DD, 0x049DC, 0x049F7, 0x096CF, 0x29092, 0x29206, 0x04A42, 0x29207, 0x2926B,
+	0x04A54, 0x2926C, 0x04A55, 0x29393, 0x04A8F, 0x29394, 0x097F4, 0x04AB4, 0x04AB3, 0x29462, 0x29463, 0x09809,
+	0x2945F, 0x29464, 0x2945B, 0x29459, 0x04AFB, 0x04AFD, 0x295A4, 0x295AC, 0x098AB, 0x04AFC, 0x296B5, 0x04B2C,
+	0x04B2F, 0x296AE, 0x04B2B, 0x296C5, 0x04B33, 0x04B34, 0x098FB, 0x2983B, 0x09AAC, 0x09AAE,
+	// plane 4, row 34
+	0x09AAA, 0x04BE8, 0x29ABF, 0x29C2B, 0x29C84, 0x29C80, 0x29D4D, 0x29D4F, 0x09B5C, 0x29D4C, 0x29F92, 0x04D5D,
+	0x050D2, 0x03485, 0x03488, 0x203AE, 0x20379, 0x0348E, 0x03484, 0x20371, 0x050DF, 0x203B3, 0x03483, 0x2032C,
+	0x20377, 0x2052F, 0x207C3, 0x03502, 0x207CD, 0x03506, 0x03505, 0x207C9, 0x034FE, 0x03501, 0x03500, 0x207CE,
+	0x208A5, 0x208A0, 0x208FE, 0x20959, 0x20A9A, 0x035E7, 0x05619, 0x20EE4, 0x20ED7, 0x20EDC, 0x035E6, 0x20EE7,
+	0x035ED, 0x035E2, 0x035EB, 0x20EE5, 0x035E8, 0x035EC, 0x0560A, 0x03624, 0x0589A, 0x21414, 0x03662, 0x213F1,
+	0x03661, 0x03660, 0x03664, 0x0368B, 0x215B6, 0x2166E, 0x2166C, 0x21681, 0x21842, 0x03719, 0x03716, 0x03718,
+	0x03722, 0x2181A, 0x0371D, 0x03717, 0x0371E, 0x21827, 0x21825, 0x21817, 0x2181C, 0x2183D, 0x21820, 0x03769,
+	0x0376A, 0x21A81, 0x0376C, 0x0377A, 0x0378A, 0x21C09, 0x21CC6, 0x21CC4, 0x0379C, 0x21CC5,
+	// plane 4, row 35
+	0x037FD, 0x037F9, 0x21EEF, 0x037FF, 0x21EDF, 0x21EDE, 0x21EEE, 0x21EF5, 0x21EEC, 0x037FC, 0x21EDD, 0x05D85,
+	0x037FB, 0x03802, 0x0385F, 0x05E56, 0x0385E, 0x0385D, 0x0385C, 0x22122, 0x05E51, 0x03892, 0x22297, 0x22295,
+	0x03894, 0x03895, 0x038D1, 0x224A9, 0x038F1, 0x22553, 0x05FB1, 0x22556, 0x22555, 0x03977, 0x0396F, 0x227E3,
+	0x227E2, 0x03987, 0x0397D, 0x0397C, 0x0397E, 0x03985, 0x0398B, 0x03986, 0x03980, 0x00000, 0x227A9, 0x03978,
+	0x2F8AB, 0x22833, 0x2280A, 0x039BA, 0x22A59, 0x03A33, 0x22CBC, 0x03A2D, 0x22CC4, 0x22CC7, 0x22CC6, 0x03A37,
+	0x0645A, 0x06463, 0x22CB8, 0x22CDA, 0x03A2E, 0x22CB7, 0x22CC0, 0x03A3D, 0x22CCD, 0x03AA0, 0x22FBC, 0x22FAB,
+	0x03AA3, 0x23086, 0x0669B, 0x2325A, 0x066A3, 0x03B0E, 0x0669E, 0x23343, 0x03BB6, 0x235AC, 0x03BAB, 0x03BAD,
+	0x03BA6, 0x235BE, 0x069B8, 0x03BAA, 0x069BA, 0x03BB1, 0x235AB, 0x03BA8, 0x03BAF, 0x03BB0,
+	// plane 4, row 36
+	0x03BA7, 0x03BB2, 0x03B9D, 0x03BA5, 0x03BB5, 0x21B3E, 0x069C7, 0x069D7, 0x238F9, 0x03C41, 0x238F3, 0x238F7,
+	0x06B70, 0x23A45, 0x23A48, 0x03C72, 0x06B9D, 0x03C6F, 0x03C71, 0x23A44, 0x03C85, 0x23AAF, 0x23AB1, 0x03CA4,
+	0x03CA5, 0x03CA6, 0x23BCF, 0x03CA8, 0x23BCB, 0x23BCD, 0x03CA3, 0x23C50, 0x23EEC, 0x23F1A, 0x06F16, 0x06F24,
+	0x23ED8, 0x03D43, 0x23ED0, 0x23ED1, 0x03D3D, 0x03D45, 0x23E7B, 0x23ED2, 0x03D44, 0x23ED4, 0x23EE7, 0x23EDF,
+	0x23EDE, 0x06F45, 0x23ED9, 0x23ECF, 0x2430D, 0x03DE3, 0x07179, 0x00000, 0x03DDF, 0x03DE4, 0x0717A, 0x03DE5,
+	0x24338, 0x07254, 0x03E22, 0x03E4A, 0x246A9, 0x03E49, 0x03E44, 0x03E4B, 0x03E87, 0x03E89, 0x03E92, 0x03E91,
+	0x03E90, 0x03E8E, 0x2483E, 0x24846, 0x249ED, 0x2F930, 0x03F12, 0x03F10, 0x03F11, 0x24B6C, 0x03F32, 0x03F34,
+	0x03F37, 0x03F33, 0x03F36, 0x03F35, 0x03F65, 0x24CAC, 0x0757C, 0x0757B, 0x24DC9, 0x07612,
+	// plane 4, row 37
+	0x03FB0, 0x24E12, 0x03FAF, 0x03FAA, 0x24DFD, 0x24E01, 0x24E11, 0x03FAB, 0x24E89, 0x24E05, 0x24DFE, 0x24E0B,
+	0x24E20, 0x24E04, 0x24F88, 0x03FF3, 0x25002, 0x03FF4, 0x25003, 0x076B6, 0x076E0, 0x04008, 0x0404E, 0x04055,
+	0x0404B, 0x25229, 0x2523B, 0x0404D, 0x07773, 0x25206, 0x04052, 0x07772, 0x0404C, 0x07770, 0x04050, 0x04053,
+	0x25203, 0x04051, 0x25378, 0x25379, 0x2537D, 0x04089, 0x2537F, 0x04095, 0x040CC, 0x040C8, 0x040CE, 0x25532,
+	0x040CA, 0x25500, 0x0789D, 0x25522, 0x040CD, 0x256A9, 0x0415C, 0x04167, 0x04169, 0x04165, 0x04162, 0x25804,
+	0x07A27, 0x07A35, 0x2F959, 0x041AA, 0x259B8, 0x25A9B, 0x041D2, 0x07BA2, 0x04203, 0x0420C, 0x25B92, 0x04209,
+	0x04206, 0x04205, 0x07B89, 0x25B8F, 0x0420B, 0x04208, 0x25B98, 0x07BA5, 0x25BAE, 0x0428E, 0x25E9D, 0x07CB6,
+	0x042E8, 0x26049, 0x26050, 0x26046, 0x042EA, 0x2604E, 0x07DA5, 0x07DC3, 0x26055, 0x042E9,
+	// plane 4, row 38
+	0x042EB, 0x26064, 0x042F0, 0x26238, 0x0434B, 0x07FAB, 0x04373, 0x04375, 0x04392, 0x04391, 0x04393, 0x08025,
+	0x043A7, 0x043A6, 0x043A8, 0x043AA, 0x264FE, 0x043A9, 0x08059, 0x043BB, 0x043BC, 0x043BA, 0x043BD, 0x04427,
+	0x08185, 0x267A6, 0x04424, 0x0441E, 0x0441F, 0x0441D, 0x04420, 0x04423, 0x04429, 0x04422, 0x2679C, 0x26799,
+	0x0441C, 0x0818E, 0x04428, 0x267B5, 0x267A3, 0x267A0, 0x267A7, 0x2679B, 0x269DF, 0x269E1, 0x04471, 0x04473,
+	0x04472, 0x26A4D, 0x04502, 0x26DF3, 0x26D6F, 0x26D69, 0x044FE, 0x084BE, 0x26D6B, 0x26D78, 0x26D87, 0x04508,
+	0x26D85, 0x26D82, 0x26D90, 0x04507, 0x04504, 0x26D80, 0x26D67, 0x04500, 0x044FC, 0x26D61, 0x04544, 0x26D93,
+	0x044F1, 0x26DF2, 0x26D86, 0x084A6, 0x04506, 0x271CD, 0x271C4, 0x045C6, 0x045C3, 0x045C1, 0x045C2, 0x2730F,
+	0x045C4, 0x045C7, 0x2730D, 0x045BF, 0x045D2, 0x27373, 0x045CA, 0x2F9BA, 0x2730A, 0x0872F,
+	// plane 4, row 39
+	0x27308, 0x27313, 0x04613, 0x275F8, 0x04630, 0x0463E, 0x04639, 0x276AB, 0x276A8, 0x0463C, 0x0463F, 0x276A5,
+	0x04634, 0x0463D, 0x276C3, 0x276A4, 0x04638, 0x276D4, 0x276BA, 0x277F1, 0x089A0, 0x04682, 0x2783F, 0x04683,
+	0x278EA, 0x0469B, 0x279F7, 0x046E0, 0x046DD, 0x279FA, 0x279F5, 0x046DE, 0x279FE, 0x046E3, 0x046E5, 0x27A00,
+	0x08A97, 0x046E2, 0x27A02, 0x279FB, 0x27A07, 0x046DF, 0x27A1A, 0x0472E, 0x27BB8, 0x04737, 0x04738, 0x27BE9,
+	0x27BEB, 0x27C50, 0x27C4F, 0x08C8B, 0x27D86, 0x03562, 0x27D8E, 0x04794, 0x04793, 0x047AB, 0x047AD, 0x27EF0,
+	0x27EFB, 0x047F5, 0x047F7, 0x28042, 0x047F6, 0x047F8, 0x28085, 0x047FB, 0x047F9, 0x04858, 0x0485A, 0x28305,
+	0x04859, 0x08F0F, 0x04885, 0x048A4, 0x048D8, 0x048D9, 0x2874B, 0x048DD, 0x048C8, 0x28763, 0x048FA, 0x048FB,
+	0x09275, 0x04927, 0x0929F, 0x0492A, 0x04925, 0x28999, 0x04928, 0x28C81, 0x28D17, 0x28D10,
+	// plane 4, row 40
+	0x28D12, 0x095A6, 0x04995, 0x0969A, 0x28EFA, 0x28EF3, 0x28EF2, 0x28EF5, 0x28EF6, 0x28FBB, 0x28FC2, 0x290A7,
+	0x04A0F, 0x04A11, 0x290A8, 0x290A3, 0x04A10, 0x290AA, 0x04A15, 0x04A13, 0x09757, 0x291DC, 0x04A47, 0x04A46,
+	0x2920F, 0x04A59, 0x04A5B, 0x29279, 0x04A5E, 0x2927F, 0x29285, 0x04A5A, 0x04A91, 0x04A92, 0x04A90, 0x04A93,
+	0x2941B, 0x097F7, 0x04ABE, 0x29477, 0x29476, 0x29498, 0x04ABC, 0x04ABB, 0x04AB7, 0x04AB9, 0x2947A, 0x04B01,
+	0x04AFE, 0x295BC, 0x295BA, 0x04B02, 0x295B6, 0x04AFF, 0x098B0, 0x295B4, 0x04B00, 0x296CF, 0x04B37, 0x04B3A,
+	0x04B6F, 0x04B77, 0x04B79, 0x099C6, 0x2987B, 0x04BC8, 0x29A1C, 0x29A21, 0x29A1D, 0x29AC0, 0x04BF2, 0x29AFF,
+	0x04BF1, 0x04BF0, 0x09B62, 0x29D56, 0x04C34, 0x29FA1, 0x29FA2, 0x29FA6, 0x2A256, 0x2A257, 0x04D2C, 0x04D2D,
+	0x2A301, 0x2A3ED, 0x2FA1C, 0x2A5F2, 0x050FA, 0x203B8, 0x03491, 0x203C0, 0x03494, 0x203B7,
+	// plane 4, row 41
+	0x203E1, 0x034C4, 0x0350A, 0x207E8, 0x05285, 0x208B2, 0x03552, 0x20AAE, 0x03559, 0x0366F, 0x20F7E, 0x035F2,
+	0x035F4, 0x05643, 0x20F76, 0x035F1, 0x0563C, 0x20F96, 0x0366A, 0x21420, 0x21421, 0x21423, 0x21429, 0x21677,
+	0x21851, 0x03724, 0x21856, 0x03723, 0x21888, 0x21859, 0x21855, 0x03727, 0x21997, 0x21998, 0x0376D, 0x05BED,
+	0x0376E, 0x0376F, 0x21AA1, 0x21AA3, 0x05C35, 0x0379F, 0x0380A, 0x03806, 0x0380E, 0x21F0A, 0x0380D, 0x03805,
+	0x21F3D, 0x21F0C, 0x0380B, 0x03810, 0x0382E, 0x2213D, 0x22139, 0x222B0, 0x222B2, 0x222AE, 0x03896, 0x03897,
+	0x038C4, 0x05F47, 0x038C5, 0x22420, 0x038D2, 0x2256E, 0x2256D, 0x2256A, 0x03981, 0x22832, 0x0398E, 0x03990,
+	0x0398F, 0x22839, 0x03991, 0x03995, 0x03993, 0x227A3, 0x0616D, 0x2287F, 0x22835, 0x2283D, 0x229F4, 0x229F5,
+	0x03A3B, 0x03A48, 0x22D48, 0x22D28, 0x03A46, 0x03A47, 0x22D27, 0x22D32, 0x22D2C, 0x03A4C,
+	// plane 4, row 42
+	0x22D2E, 0x03A4A, 0x03A50, 0x03A43, 0x22D23, 0x22D31, 0x2F8C5, 0x03A49, 0x03AA6, 0x03AA5, 0x03AA4, 0x22FC9,
+	0x23089, 0x03AB9, 0x230BB, 0x03ACE, 0x03ACF, 0x03B13, 0x23397, 0x23393, 0x23628, 0x03BC6, 0x03BC5, 0x03BCA,
+	0x03BD9, 0x03BC1, 0x23618, 0x2363B, 0x069F5, 0x23627, 0x23624, 0x2361B, 0x23631, 0x03BCB, 0x23626, 0x236A3,
+	0x2363F, 0x23622, 0x23619, 0x06A03, 0x23641, 0x2362B, 0x06A65, 0x03C42, 0x2390C, 0x06B75, 0x03C74, 0x03C73,
+	0x23A4E, 0x23AB9, 0x23AFA, 0x23BE9, 0x23BE8, 0x23BE4, 0x06F8A, 0x06F56, 0x03D52, 0x23F5F, 0x23F5D, 0x23F52,
+	0x03D50, 0x23F74, 0x03D4B, 0x23F46, 0x03D4C, 0x03D6D, 0x23FAA, 0x06F98, 0x23F4A, 0x23F59, 0x23F4B, 0x03D4F,
+	0x06F68, 0x03DF0, 0x24350, 0x07234, 0x07245, 0x03E4D, 0x03E4C, 0x246D0, 0x03E4F, 0x03E4E, 0x03E50, 0x246CC,
+	0x0735C, 0x03E96, 0x07356, 0x24864, 0x24865, 0x03E97, 0x03E95, 0x03E98, 0x2485C, 0x24A15,
+	// plane 4, row 43
+	0x03EEC, 0x03EEB, 0x03F13, 0x03F14, 0x03F38, 0x03F3A, 0x03F39, 0x24B79, 0x03F68, 0x03F67, 0x24CC5, 0x24CB8,
+	0x24E2C, 0x03FBE, 0x03FBC, 0x24E37, 0x24E35, 0x24E31, 0x03FBB, 0x24E2F, 0x03FBA, 0x24E2B, 0x03FB9, 0x03FB7,
+	0x24E2D, 0x24E2A, 0x03FC1, 0x24F95, 0x03FF7, 0x2523E, 0x04060, 0x25247, 0x25245, 0x04059, 0x0405C, 0x0405A,
+	0x04058, 0x25252, 0x0405B, 0x25270, 0x25250, 0x25258, 0x25251, 0x2523D, 0x0405D, 0x25241, 0x2520C, 0x2523C,
+	0x25386, 0x25383, 0x25389, 0x253F3, 0x040D4, 0x040D3, 0x078BF, 0x040D2, 0x078BD, 0x2552D, 0x040D7, 0x040D1,
+	0x078E4, 0x040D5, 0x256C5, 0x2F955, 0x0416D, 0x0416F, 0x07A34, 0x04175, 0x0416C, 0x04174, 0x25843, 0x04173,
+	0x25837, 0x25845, 0x07A36, 0x041AC, 0x259E5, 0x25AA6, 0x25BDB, 0x04210, 0x25BDA, 0x25BE6, 0x07BBA, 0x07BBC,
+	0x0420F, 0x07BC8, 0x04223, 0x07BC3, 0x0421D, 0x07BB6, 0x0420E, 0x25BF8, 0x25BE9, 0x04215,
+	// plane 4, row 44
+	0x07BC2, 0x04213, 0x25BE8, 0x25BF6, 0x0421B, 0x07BC5, 0x04222, 0x04226, 0x25BE7, 0x07BBD, 0x07BB0, 0x04221,
+	0x0421C, 0x04217, 0x25BD5, 0x0421A, 0x07BBB, 0x25ED3, 0x25EC7, 0x25ED1, 0x04299, 0x25EC3, 0x04297, 0x26080,
+	0x26098, 0x042FD, 0x26084, 0x042F6, 0x042FE, 0x042F5, 0x042FF, 0x042F7, 0x26097, 0x26083, 0x26081, 0x04301,
+	0x07E04, 0x2608C, 0x26242, 0x263AB, 0x04377, 0x263A3, 0x263A6, 0x2645C, 0x26469, 0x26467, 0x043AB, 0x2658B,
+	0x265A8, 0x043C0, 0x267D8, 0x04431, 0x0442E, 0x2F989, 0x267DC, 0x0442C, 0x267E0, 0x267E5, 0x04432, 0x0442F,
+	0x0442B, 0x0442D, 0x04433, 0x26918, 0x26919, 0x081F1, 0x04457, 0x0445C, 0x0447B, 0x26A5F, 0x26A5E, 0x0447A,
+	0x26E02, 0x08522, 0x04513, 0x0451E, 0x04517, 0x04520, 0x0452A, 0x04511, 0x04515, 0x0450F, 0x04518, 0x08538,
+	0x26E12, 0x26E36, 0x0452C, 0x08532, 0x2F9A8, 0x08510, 0x26E23, 0x26E03, 0x0451C, 0x26E00,
+	// plane 4, row 45
+	0x04529, 0x26E46, 0x26E61, 0x04512, 0x0854F, 0x04597, 0x27384, 0x08772, 0x2736B, 0x27362, 0x27356, 0x2736A,
+	0x27352, 0x27355, 0x045D4, 0x045D0, 0x0877C, 0x27361, 0x2F9BB, 0x27358, 0x27377, 0x045D3, 0x04614, 0x276D7,
+	0x04646, 0x04645, 0x276DE, 0x04643, 0x276DF, 0x0890D, 0x04644, 0x04648, 0x276D1, 0x04647, 0x276E2, 0x276E1,
+	0x276DD, 0x08908, 0x04649, 0x04685, 0x2784B, 0x04684, 0x2784E, 0x0469D, 0x278FC, 0x0469E, 0x046A0, 0x278FA,
+	0x0469C, 0x278FB, 0x0469F, 0x278FE, 0x046F7, 0x046EA, 0x27A31, 0x046EF, 0x046E9, 0x046F3, 0x046F0, 0x046EB,
+	0x27A38, 0x046EC, 0x046F2, 0x046F5, 0x046EE, 0x27A3A, 0x27BBB, 0x0473A, 0x0474B, 0x27C59, 0x0474A, 0x0474C,
+	0x27CDB, 0x27CDF, 0x27CE2, 0x04779, 0x0477B, 0x04778, 0x27D9E, 0x27DA1, 0x047B5, 0x27F10, 0x047B4, 0x047B7,
+	0x08D9E, 0x04809, 0x047FE, 0x04808, 0x04807, 0x28076, 0x2807F, 0x2807D, 0x04806, 0x04804,
+	// plane 4, row 46
+	0x04805, 0x047FF, 0x0480B, 0x28082, 0x28257, 0x0483B, 0x0485D, 0x0485C, 0x0485F, 0x0485E, 0x08F28, 0x2832E,
+	0x08F21, 0x04883, 0x2858B, 0x2858D, 0x048A7, 0x09066, 0x0906C, 0x28590, 0x048A8, 0x286EC, 0x2876F, 0x2876B,
+	0x28771, 0x28778, 0x090F6, 0x048E0, 0x048DF, 0x048FE, 0x048FC, 0x048FF, 0x048FD, 0x289AD, 0x0492C, 0x092EC,
+	0x092BA, 0x092E3, 0x092BD, 0x0499D, 0x28D2F, 0x095B4, 0x28F78, 0x04A40, 0x291F8, 0x29219, 0x04A5F, 0x2929B,
+	0x29294, 0x29297, 0x29299, 0x293A6, 0x293A4, 0x293A7, 0x097D1, 0x29495, 0x04AC0, 0x09823, 0x29490, 0x04AC1,
+	0x04AC6, 0x2949B, 0x295C4, 0x04B04, 0x04B05, 0x295C6, 0x0990B, 0x04B3E, 0x04B3D, 0x04B40, 0x04B3F, 0x296E3,
+	0x04B42, 0x296DF, 0x296DD, 0x296E7, 0x04B84, 0x04B82, 0x04B7F, 0x04B85, 0x29A2D, 0x29A2C, 0x04BCC, 0x09AB2,
+	0x04BCB, 0x04BCD, 0x29A34, 0x29A38, 0x09ADB, 0x29B1D, 0x04BF5, 0x29B1A, 0x29B1B, 0x29B14,
+	// plane 4, row 47
+	0x09AF0, 0x29B17, 0x29C21, 0x04C20, 0x04C21, 0x29CAA, 0x29CA1, 0x04C37, 0x04C3E, 0x09B73, 0x04C3D, 0x09B6E,
+	0x29D63, 0x29D79, 0x29D60, 0x09B65, 0x29D62, 0x04C3C, 0x29D61, 0x04C38, 0x09B6A, 0x29D70, 0x09B6D, 0x29D6A,
+	0x04C3B, 0x29FC8, 0x04CB0, 0x29FC5, 0x29FBE, 0x29FC2, 0x04CAD, 0x04CB2, 0x04CB8, 0x09D0B, 0x29FC7, 0x04CAF,
+	0x29FB0, 0x29FCA, 0x04D1A, 0x09E76, 0x04D20, 0x04D21, 0x04D30, 0x09EA8, 0x04D2F, 0x2A30D, 0x2A307, 0x2A396,
+	0x2A3EF, 0x04D5F, 0x04D60, 0x2A41E, 0x2A41D, 0x09F11, 0x2A590, 0x203EB, 0x0348A, 0x05119, 0x0349C, 0x203EE,
+	0x0349A, 0x203EF, 0x203EC, 0x207FA, 0x0350C, 0x0350B, 0x0350D, 0x05292, 0x20904, 0x20FD3, 0x035FE, 0x20FD1,
+	0x20FFA, 0x035FF, 0x035FB, 0x035FC, 0x03609, 0x20FC8, 0x03600, 0x20FD5, 0x05675, 0x20FCD, 0x20FD2, 0x20FFB,
+	0x2122A, 0x21488, 0x21461, 0x03671, 0x21463, 0x21462, 0x0596F, 0x20057, 0x218C8, 0x21898,
+	// plane 4, row 48
+	0x0372B, 0x21AC1, 0x21F3F, 0x21F41, 0x03814, 0x03811, 0x03812, 0x22152, 0x03863, 0x2215E, 0x0386E, 0x0389A,
+	0x222C7, 0x0389B, 0x22370, 0x22427, 0x038C8, 0x22471, 0x224B0, 0x038F6, 0x22842, 0x22843, 0x061A5, 0x0398C,
+	0x03997, 0x039A2, 0x061A0, 0x22857, 0x2289D, 0x22D89, 0x22D8D, 0x22D8B, 0x22D80, 0x22D92, 0x22D8A, 0x22DC8,
+	0x03A54, 0x22D8F, 0x22D93, 0x22D91, 0x03AA8, 0x22FF2, 0x22FDE, 0x03AA9, 0x230C8, 0x065B4, 0x065D8, 0x066C2,
+	0x03B18, 0x03B17, 0x03B1D, 0x232A7, 0x232A1, 0x03B31, 0x2368C, 0x2367F, 0x03BD8, 0x03BD5, 0x2367B, 0x23695,
+	0x23699, 0x03BE1, 0x2368E, 0x03BD4, 0x236DA, 0x2368A, 0x2369C, 0x03BE3, 0x2367E, 0x03C44, 0x03C45, 0x2391A,
+	0x23A55, 0x03C76, 0x03C75, 0x23A60, 0x06BA8, 0x03C88, 0x03CAA, 0x23BFB, 0x03CAB, 0x03CAC, 0x23BFD, 0x03D57,
+	0x06F83, 0x03D60, 0x03D5D, 0x03D6B, 0x23FD0, 0x03D63, 0x03D67, 0x23FC5, 0x03D5E, 0x23FD2,
+	// plane 4, row 49
+	0x24011, 0x06FC5, 0x071CD, 0x03DF9, 0x03DF3, 0x243AD, 0x243A4, 0x243A9, 0x243B0, 0x2439D, 0x03DF6, 0x24598,
+	0x0729C, 0x03E51, 0x03E53, 0x03E52, 0x24892, 0x03E9B, 0x2488A, 0x03E9C, 0x2488D, 0x24896, 0x03EF7, 0x07499,
+	0x0748F, 0x07491, 0x24A45, 0x24A4B, 0x24B0F, 0x24B16, 0x03F3B, 0x24B8A, 0x03F3E, 0x03F3D, 0x24CC9, 0x03F69,
+	0x24CC8, 0x24CCA, 0x24E64, 0x03FC3, 0x03FC4, 0x03FC7, 0x24E5D, 0x24E63, 0x07639, 0x03FC6, 0x0762E, 0x03FC8,
+	0x24E88, 0x24F3A, 0x24F39, 0x0769F, 0x076A0, 0x03FE6, 0x03FF8, 0x25017, 0x25093, 0x04007, 0x25095, 0x04064,
+	0x04068, 0x25276, 0x07794, 0x04065, 0x077AE, 0x25280, 0x2527B, 0x04069, 0x25248, 0x252A8, 0x25288, 0x2538B,
+	0x2538A, 0x2538C, 0x253FC, 0x040DA, 0x040E0, 0x078E6, 0x25558, 0x040DE, 0x25551, 0x25555, 0x25553, 0x0411C,
+	0x0411D, 0x0411A, 0x256DC, 0x0417B, 0x0417A, 0x0417C, 0x2585C, 0x04178, 0x04177, 0x25865,
+	// plane 4, row 50
+	0x2F95A, 0x041B1, 0x259EE, 0x041B2, 0x041B0, 0x259F0, 0x07ABC, 0x259F2, 0x04236, 0x25C35, 0x0422E, 0x25C22,
+	0x07BD6, 0x25C20, 0x04234, 0x25C2D, 0x25C28, 0x25C26, 0x25C3C, 0x0422A, 0x25C38, 0x04233, 0x0422D, 0x0422F,
+	0x04231, 0x0422B, 0x04232, 0x25C2A, 0x04235, 0x25C1A, 0x25C30, 0x25BE3, 0x25C19, 0x25EE9, 0x25EE7, 0x07CCF,
+	0x260D6, 0x260C4, 0x04308, 0x26087, 0x04306, 0x260C7, 0x260D9, 0x07E18, 0x260DF, 0x0434D, 0x04361, 0x2630A,
+	0x26301, 0x263B1, 0x04378, 0x263C1, 0x04394, 0x04395, 0x26476, 0x2650D, 0x2650E, 0x043C1, 0x043C3, 0x0806D,
+	0x26807, 0x04439, 0x26809, 0x26816, 0x0443A, 0x0443B, 0x04435, 0x04436, 0x2680E, 0x0443C, 0x08190, 0x26806,
+	0x2691E, 0x2694A, 0x04458, 0x0447C, 0x0447D, 0x0448D, 0x0448C, 0x26EA6, 0x26E91, 0x0453B, 0x0453F, 0x26EB8,
+	0x26E96, 0x04532, 0x0452D, 0x26EC5, 0x0452F, 0x04539, 0x0452E, 0x0453A, 0x26F0A, 0x04536,
+	// plane 4, row 51
+	0x04531, 0x0453E, 0x04538, 0x08552, 0x04534, 0x26EB2, 0x04541, 0x26EB7, 0x26EB4, 0x26E89, 0x26E8D, 0x04530,
+	0x26E87, 0x26EC2, 0x26E9C, 0x26E92, 0x04543, 0x26ECA, 0x08550, 0x26E8A, 0x271E0, 0x04598, 0x271E6, 0x271E5,
+	0x087A0, 0x273A5, 0x273B3, 0x08786, 0x045DA, 0x045D7, 0x273B5, 0x273AF, 0x08795, 0x273B0, 0x273CC, 0x0878C,
+	0x273A2, 0x273BE, 0x273C6, 0x273AC, 0x273AE, 0x04618, 0x08860, 0x2F9C3, 0x27708, 0x27705, 0x04652, 0x08928,
+	0x276FE, 0x0464E, 0x08920, 0x27700, 0x0464F, 0x04650, 0x27864, 0x27868, 0x089A8, 0x04686, 0x04687, 0x04689,
+	0x2786A, 0x27869, 0x2790D, 0x27912, 0x046A2, 0x046A3, 0x2790F, 0x046A1, 0x27A67, 0x27A79, 0x27A72, 0x27A66,
+	0x27A7C, 0x046F9, 0x046FD, 0x27A68, 0x27A85, 0x27A76, 0x27A74, 0x27A71, 0x27A64, 0x0473E, 0x0473C, 0x27BF8,
+	0x0474D, 0x27C6C, 0x0474E, 0x27CEB, 0x04781, 0x04783, 0x04782, 0x27DB8, 0x04780, 0x04788,
+	// plane 4, row 52
+	0x27F2D, 0x27F2E, 0x27F28, 0x27F29, 0x27F31, 0x047B9, 0x27F2F, 0x27F2A, 0x08E3A, 0x280A4, 0x280B7, 0x280BF,
+	0x04811, 0x0480D, 0x04810, 0x04813, 0x28263, 0x0483C, 0x04862, 0x04863, 0x28367, 0x285BE, 0x2F9E0, 0x28795,
+	0x2879C, 0x04903, 0x04906, 0x04902, 0x04901, 0x28871, 0x28872, 0x04905, 0x2886F, 0x09194, 0x09311, 0x289F1,
+	0x09337, 0x04936, 0x289E8, 0x04935, 0x09343, 0x2F9EA, 0x049A1, 0x049A3, 0x28D49, 0x28D64, 0x049A0, 0x28D48,
+	0x049EA, 0x096A6, 0x049E8, 0x28F2C, 0x28FE1, 0x28FEF, 0x290C0, 0x04A21, 0x04A1B, 0x290D7, 0x290D4, 0x04A49,
+	0x04A48, 0x292AD, 0x09795, 0x04A62, 0x04A61, 0x04A64, 0x04A60, 0x04A63, 0x292AE, 0x292AC, 0x09796, 0x04A66,
+	0x04AAC, 0x04AAB, 0x29427, 0x294AE, 0x04AC3, 0x294B0, 0x04AC4, 0x09825, 0x294C0, 0x294C4, 0x04B08, 0x04B09,
+	0x04B0A, 0x04B06, 0x04B07, 0x04B41, 0x29707, 0x04B45, 0x296FD, 0x04B43, 0x04B44, 0x09926,
+	// plane 4, row 53
+	0x09934, 0x04B47, 0x2FA04, 0x2981B, 0x2981C, 0x04B71, 0x298B2, 0x298B4, 0x298B7, 0x298B1, 0x298B3, 0x298AE,
+	0x04B8B, 0x04BD4, 0x04BD3, 0x04BD1, 0x09ABA, 0x04BD2, 0x29A3F, 0x29B36, 0x04BF7, 0x04BF8, 0x29B31, 0x29B3B,
+	0x29B35, 0x29B3A, 0x29B37, 0x29C22, 0x29C36, 0x29C32, 0x04C22, 0x29CC0, 0x04C45, 0x04C41, 0x09B81, 0x04C40,
+	0x09B8A, 0x29D85, 0x09B7F, 0x04C42, 0x29D89, 0x04CC1, 0x04CC5, 0x29FE1, 0x04CBB, 0x04CB9, 0x04CBD, 0x04CC9,
+	0x2A00A, 0x09D11, 0x2A009, 0x04CBF, 0x04CC7, 0x29FE8, 0x04CC3, 0x04D24, 0x04D31, 0x04D33, 0x2A318, 0x04D32,
+	0x04D34, 0x04D52, 0x04D61, 0x09ED9, 0x04D7A, 0x04D82, 0x09F3C, 0x2A5C5, 0x05123, 0x2040D, 0x2040C, 0x20421,
+	0x0512C, 0x20616, 0x2080E, 0x0350F, 0x05295, 0x20817, 0x03523, 0x03525, 0x21048, 0x03606, 0x03608, 0x05688,
+	0x21055, 0x21049, 0x2104C, 0x0568B, 0x2104F, 0x2103D, 0x0367A, 0x03677, 0x2148A, 0x214A8,
+	// plane 4, row 54
+	0x2168A, 0x0372E, 0x218CB, 0x0372F, 0x218D5, 0x221D4, 0x0381B, 0x03813, 0x21F5C, 0x21F75, 0x03866, 0x22161,
+	0x03865, 0x21696, 0x038F7, 0x2258A, 0x228D0, 0x061E1, 0x061D7, 0x22849, 0x228CF, 0x0399C, 0x22DD6, 0x22D7F,
+	0x03A53, 0x22DD2, 0x22DCF, 0x23006, 0x23009, 0x03ABA, 0x065A3, 0x03B22, 0x066D3, 0x23706, 0x236F1, 0x23704,
+	0x06A8B, 0x236FA, 0x236F4, 0x03BEB, 0x03BDD, 0x236F9, 0x03BEF, 0x2368B, 0x23703, 0x03C47, 0x03C46, 0x239A2,
+	0x03C78, 0x06BAC, 0x03C89, 0x23C0C, 0x23C0B, 0x03D68, 0x03D76, 0x03D74, 0x03D79, 0x24024, 0x03D7A, 0x03D77,
+	0x2F914, 0x03D71, 0x24025, 0x03D72, 0x243F6, 0x03DFF, 0x243FB, 0x03E05, 0x24532, 0x245FB, 0x03E54, 0x246F2,
+	0x246F3, 0x03E9E, 0x03E9F, 0x07374, 0x2489C, 0x24A72, 0x03EFA, 0x24A74, 0x03F44, 0x03F3F, 0x03F40, 0x24B86,
+	0x03F42, 0x24B8E, 0x24B90, 0x03F51, 0x24BCD, 0x24BF7, 0x24CD6, 0x24E84, 0x24E95, 0x24E8A,
+	// plane 4, row 55
+	0x07640, 0x03FCA, 0x24E97, 0x07641, 0x03FCE, 0x03FC9, 0x24FA0, 0x24FA1, 0x25022, 0x250A6, 0x250A4, 0x04009,
+	0x076E8, 0x0406C, 0x0406E, 0x04070, 0x0406D, 0x0406B, 0x04071, 0x04072, 0x252AF, 0x252B0, 0x252BD, 0x0408C,
+	0x040E4, 0x25576, 0x040E1, 0x2557B, 0x25579, 0x078F6, 0x040E7, 0x07900, 0x040E2, 0x0411F, 0x256EE, 0x256F1,
+	0x0417E, 0x25894, 0x04180, 0x07A59, 0x07A55, 0x25891, 0x041B9, 0x041B7, 0x041B8, 0x25A10, 0x041BA, 0x07AF4,
+	0x25AAF, 0x041D3, 0x0423F, 0x07C04, 0x04245, 0x04241, 0x07C15, 0x04242, 0x04243, 0x0423B, 0x04238, 0x25C7B,
+	0x25C77, 0x0423A, 0x07BF5, 0x25C80, 0x25CC6, 0x0423C, 0x25C7C, 0x25C90, 0x0423E, 0x25CA3, 0x25C7D, 0x25CBD,
+	0x0429E, 0x0429F, 0x042A1, 0x25F13, 0x0429B, 0x04312, 0x26111, 0x26144, 0x2610D, 0x04318, 0x0430C, 0x26314,
+	0x04362, 0x263B8, 0x0437A, 0x263B7, 0x26483, 0x043AE, 0x043AF, 0x26514, 0x043AD, 0x2651C,
+	// plane 4, row 56
+	0x043C4, 0x043C7, 0x043C6, 0x043C5, 0x265D4, 0x265D5, 0x081C1, 0x04440, 0x26843, 0x26842, 0x0443F, 0x04441,
+	0x2F98A, 0x26841, 0x2694E, 0x0447F, 0x26A87, 0x04486, 0x04481, 0x04480, 0x0448E, 0x0454A, 0x26F39, 0x04547,
+	0x26F8F, 0x26F2A, 0x0454B, 0x04546, 0x0454E, 0x0857D, 0x26F2B, 0x085A5, 0x26F50, 0x04548, 0x26F6E, 0x04545,
+	0x26F3B, 0x26F53, 0x26F5F, 0x26F2F, 0x26F30, 0x26F38, 0x26F4C, 0x271F1, 0x045DB, 0x045E7, 0x045E4, 0x27407,
+	0x27416, 0x045E1, 0x27414, 0x045E9, 0x273FB, 0x045E5, 0x045E0, 0x045E3, 0x273F8, 0x27410, 0x2741D, 0x273FF,
+	0x2740B, 0x27404, 0x045EA, 0x273FE, 0x275FF, 0x0893A, 0x04654, 0x04658, 0x0465C, 0x27723, 0x04655, 0x0468B,
+	0x0468C, 0x046A6, 0x046A5, 0x2792A, 0x27AA0, 0x27A98, 0x27A9C, 0x046FF, 0x27A9E, 0x27AA6, 0x27AB5, 0x27AB0,
+	0x04730, 0x04740, 0x04741, 0x27CF9, 0x27CF5, 0x04786, 0x27DD2, 0x047BF, 0x047BD, 0x27F50,
+	// plane 4, row 57
+	0x27F4E, 0x27F4B, 0x27F52, 0x27F4D, 0x047BE, 0x04819, 0x280E4, 0x0481C, 0x280DA, 0x0481B, 0x04817, 0x04818,
+	0x08E51, 0x280DF, 0x280E8, 0x0483D, 0x0486A, 0x04866, 0x28370, 0x28372, 0x04867, 0x28377, 0x04868, 0x048AD,
+	0x048AE, 0x285E6, 0x287AA, 0x048D6, 0x04909, 0x2888C, 0x09198, 0x28889, 0x0490C, 0x0490A, 0x28A32, 0x0493B,
+	0x0493A, 0x09384, 0x09381, 0x28A7A, 0x0936F, 0x28C9E, 0x28CA0, 0x28D70, 0x049AF, 0x049AA, 0x049AB, 0x28D6E,
+	0x28D66, 0x049B1, 0x28D65, 0x049AC, 0x049EC, 0x28F7F, 0x28FE0, 0x04A01, 0x28FFF, 0x290F6, 0x290F7, 0x290F5,
+	0x04A23, 0x290FC, 0x04A24, 0x04A1E, 0x290F9, 0x04A4A, 0x04A65, 0x04A6A, 0x292CA, 0x292C3, 0x292C6, 0x04A69,
+	0x293B8, 0x293BD, 0x04A95, 0x293BC, 0x29405, 0x294E0, 0x294E9, 0x09842, 0x294DF, 0x294EC, 0x04ACC, 0x294E5,
+	0x294DE, 0x2FA00, 0x04ACF, 0x294F0, 0x294E3, 0x04B0F, 0x295EC, 0x04B0E, 0x04B0B, 0x04B10,
+	// plane 4, row 58
+	0x04B0D, 0x04B0C, 0x295F2, 0x295EF, 0x295E9, 0x296FB, 0x04B46, 0x04B48, 0x09937, 0x04B49, 0x29737, 0x298DE,
+	0x04B91, 0x04B8E, 0x298DA, 0x04BD8, 0x04BD6, 0x29A4B, 0x04BDA, 0x29A49, 0x04BD7, 0x29AD5, 0x09AFF, 0x04BF9,
+	0x29B52, 0x29B47, 0x04BFC, 0x29B48, 0x29CCC, 0x29CD0, 0x09BA9, 0x04C4A, 0x09BA7, 0x04C4E, 0x09BB3, 0x09BAC,
+	0x09BB0, 0x29D86, 0x29DA7, 0x29DA3, 0x09B9C, 0x29DB6, 0x29DAD, 0x2A013, 0x09D3C, 0x09D1C, 0x09D3A, 0x04CD3,
+	0x04CCD, 0x04CD1, 0x2A017, 0x2A022, 0x09D32, 0x09D34, 0x2A049, 0x2A026, 0x2FA0C, 0x2A03C, 0x2A028, 0x2A2A8,
+	0x09EC7, 0x2A3FB, 0x04D62, 0x2A432, 0x2A4D6, 0x2A548, 0x04D83, 0x09F3F, 0x2A5C6, 0x04D92, 0x0349F, 0x034A0,
+	0x20425, 0x20426, 0x20424, 0x20433, 0x20570, 0x03527, 0x20B90, 0x0360B, 0x21095, 0x210A1, 0x21092, 0x2108F,
+	0x2109F, 0x21096, 0x21098, 0x2109D, 0x210A0, 0x0368D, 0x21697, 0x218EB, 0x03770, 0x21F87,
+	// plane 4, row 59
+	0x05EEB, 0x22432, 0x0399A, 0x0399F, 0x0399D, 0x228D8, 0x228FB, 0x22906, 0x0399B, 0x228D1, 0x061D5, 0x2F8B0,
+	0x03A60, 0x03A64, 0x03A69, 0x03A63, 0x03A67, 0x03A62, 0x22DF6, 0x22E04, 0x22DFE, 0x06502, 0x22DFF, 0x22DF7,
+	0x23018, 0x03AAC, 0x23014, 0x230CD, 0x23120, 0x232DE, 0x03B26, 0x03B23, 0x03B25, 0x23745, 0x23753, 0x03BF8,
+	0x2374B, 0x23755, 0x23741, 0x03BF7, 0x03BFB, 0x03BFA, 0x2375C, 0x23754, 0x23A71, 0x23AD0, 0x03CB0, 0x03CAF,
+	0x23C53, 0x24029, 0x2407E, 0x03D7E, 0x24079, 0x03D7D, 0x03D80, 0x24070, 0x2406A, 0x03D7F, 0x03D86, 0x24085,
+	0x24064, 0x07012, 0x24078, 0x03D81, 0x24432, 0x24427, 0x2442F, 0x03E24, 0x03E58, 0x03E57, 0x03EA0, 0x248BA,
+	0x03EFE, 0x24A71, 0x24A8C, 0x03F15, 0x24B1A, 0x03F47, 0x03F46, 0x24B98, 0x24CE4, 0x03F6B, 0x03F6C, 0x07585,
+	0x07654, 0x24EC2, 0x03FCC, 0x24EBA, 0x07655, 0x24EC8, 0x03FCB, 0x076A7, 0x076A8, 0x03FF9,
+	// plane 4, row 60
+	0x250B9, 0x250B8, 0x250A5, 0x252E2, 0x04078, 0x0407A, 0x04075, 0x252D9, 0x04076, 0x04077, 0x252AC, 0x252DD,
+	0x040EA, 0x040EE, 0x040ED, 0x2559D, 0x040EC, 0x0790F, 0x25711, 0x25703, 0x04184, 0x04185, 0x04183, 0x258EE,
+	0x041BC, 0x041BD, 0x041D4, 0x25CA4, 0x25CD8, 0x25CDD, 0x25CDE, 0x04255, 0x25CE7, 0x04250, 0x0424C, 0x04248,
+	0x25CD4, 0x04253, 0x25CCE, 0x04257, 0x04254, 0x0424E, 0x0424A, 0x04251, 0x25CF1, 0x25CD3, 0x04249, 0x0424B,
+	0x04263, 0x25CCA, 0x25CE9, 0x042A7, 0x042A6, 0x042A4, 0x25F1A, 0x25F41, 0x2F968, 0x07CE4, 0x07CE5, 0x2613D,
+	0x26140, 0x07E65, 0x07E4E, 0x04317, 0x26143, 0x04316, 0x2613F, 0x2613C, 0x04363, 0x26321, 0x26320, 0x07F82,
+	0x263C6, 0x0437B, 0x0437C, 0x263D1, 0x263CA, 0x2648E, 0x26491, 0x043B0, 0x0802D, 0x265E3, 0x26888, 0x04442,
+	0x26870, 0x04444, 0x2699D, 0x26A9D, 0x26A91, 0x26A98, 0x26A99, 0x04488, 0x0448F, 0x04553,
+	// plane 4, row 61
+	0x0455B, 0x26FBF, 0x04559, 0x26FAE, 0x26FB1, 0x26FCC, 0x27004, 0x085CA, 0x27016, 0x26FCD, 0x04554, 0x085BC,
+	0x26FE0, 0x26FCB, 0x26FD4, 0x26FC9, 0x271FF, 0x04599, 0x271FD, 0x27457, 0x27452, 0x27450, 0x27445, 0x2744D,
+	0x045F1, 0x27453, 0x045EF, 0x27482, 0x27444, 0x275CE, 0x275CF, 0x275D2, 0x27602, 0x2774F, 0x27758, 0x04662,
+	0x27743, 0x04663, 0x27752, 0x04660, 0x04661, 0x0465F, 0x27749, 0x27753, 0x2774D, 0x0468D, 0x27884, 0x0468E,
+	0x27883, 0x27932, 0x27AE2, 0x04709, 0x27AE4, 0x27AD3, 0x04705, 0x27AD5, 0x27ADD, 0x04703, 0x04706, 0x27AEC,
+	0x27AE6, 0x27AD2, 0x27AFA, 0x27ADA, 0x04731, 0x27C06, 0x27C04, 0x0474F, 0x27C7B, 0x27D04, 0x27D02, 0x04766,
+	0x08CFF, 0x047C4, 0x27F60, 0x047C3, 0x047C1, 0x047C5, 0x28107, 0x28105, 0x2810C, 0x04821, 0x2815A, 0x0481F,
+	0x04822, 0x2811A, 0x04827, 0x04820, 0x2838A, 0x0486D, 0x0486C, 0x0486B, 0x0486F, 0x04870,
+	// plane 4, row 62
+	0x2838C, 0x28609, 0x288A6, 0x091A6, 0x288AC, 0x288A9, 0x04942, 0x28A8B, 0x093B6, 0x28A8C, 0x04944, 0x04940,
+	0x28A88, 0x28A89, 0x0493F, 0x28A93, 0x093AB, 0x0498B, 0x28D83, 0x28F4F, 0x04A25, 0x04A28, 0x29120, 0x09721,
+	0x29236, 0x292E2, 0x04A75, 0x04A72, 0x292EE, 0x04A6F, 0x292E7, 0x292E9, 0x04A76, 0x04A71, 0x097A7, 0x292DF,
+	0x04A97, 0x293C7, 0x04AD7, 0x29509, 0x04AD6, 0x29501, 0x04AD8, 0x04ADC, 0x04ADB, 0x04AD4, 0x0983E, 0x04B13,
+	0x04B11, 0x04B14, 0x29605, 0x2960C, 0x29778, 0x2975D, 0x04B51, 0x04B50, 0x04B53, 0x04B54, 0x04B52, 0x2975E,
+	0x29760, 0x29767, 0x04B6D, 0x2FA05, 0x298F4, 0x04B95, 0x04B99, 0x298F1, 0x04B9A, 0x298FA, 0x04B93, 0x04B97,
+	0x298F8, 0x298F9, 0x29909, 0x298FD, 0x298F7, 0x04BDC, 0x29A59, 0x04BFD, 0x29B60, 0x29B68, 0x04BFE, 0x04C00,
+	0x04C02, 0x04C01, 0x04C03, 0x29B6A, 0x29C14, 0x29C3E, 0x29CE4, 0x04C27, 0x04C26, 0x04C24,
+	// plane 4, row 63
+	0x04C4C, 0x09BBC, 0x04C50, 0x04C55, 0x04C53, 0x09BB7, 0x04C52, 0x29DD2, 0x04C57, 0x09BBE, 0x04C58, 0x04CD6,
+	0x2A058, 0x2A050, 0x04CD4, 0x2A05C, 0x04CDA, 0x04CD9, 0x28119, 0x09D62, 0x04CD5, 0x04CE4, 0x2FA0E, 0x04CDC,
+	0x04D1B, 0x09E8F, 0x04D37, 0x04D36, 0x04D4B, 0x09ECB, 0x04D66, 0x04D76, 0x2A4DF, 0x04D7E, 0x04D7D, 0x04D7F,
+	0x04D84, 0x04D8B, 0x2A5D9, 0x04D94, 0x034A1, 0x03511, 0x20966, 0x03610, 0x056A9, 0x210E1, 0x210EF, 0x210E8,
+	0x21233, 0x05913, 0x218FE, 0x03732, 0x05BF4, 0x21AEF, 0x21AEC, 0x21CEC, 0x21F9C, 0x03820, 0x21F99, 0x22170,
+	0x2216E, 0x0389D, 0x22441, 0x061EC, 0x061EF, 0x22902, 0x22901, 0x039A5, 0x22A1B, 0x22E23, 0x22E25, 0x22E24,
+	0x22E26, 0x22E33, 0x22E2F, 0x2308F, 0x23356, 0x03C02, 0x03BFE, 0x03C01, 0x06AD6, 0x03C03, 0x03BFF, 0x23799,
+	0x03C04, 0x2F8ED, 0x03C4A, 0x23AD9, 0x03D87, 0x240B7, 0x03D84, 0x240B6, 0x03D85, 0x07209,
+	// plane 4, row 64
+	0x24454, 0x24457, 0x2447A, 0x03E59, 0x07379, 0x24A8E, 0x074C6, 0x24A90, 0x24AB9, 0x03F04, 0x03F49, 0x03F48,
+	0x24CEF, 0x03F6D, 0x03FD2, 0x03FD3, 0x24EE2, 0x03FD1, 0x24ED6, 0x24ED8, 0x24EDA, 0x24EDE, 0x03FE7, 0x0400A,
+	0x077C3, 0x25308, 0x25304, 0x2530A, 0x2530B, 0x25302, 0x040F0, 0x255AA, 0x255C1, 0x0791F, 0x258D7, 0x258D9,
+	0x07A65, 0x258DE, 0x258DA, 0x041BE, 0x041BF, 0x25A2A, 0x07AC6, 0x07C3A, 0x25D31, 0x07C36, 0x25D2B, 0x25D2C,
+	0x25D29, 0x25D2E, 0x0425E, 0x25D27, 0x25D28, 0x0425B, 0x25D5F, 0x25D30, 0x25D24, 0x07CEB, 0x042AB, 0x25F3A,
+	0x042AC, 0x25F39, 0x25F40, 0x26180, 0x26184, 0x0431F, 0x0431D, 0x26175, 0x26176, 0x0431C, 0x2617C, 0x0431E,
+	0x26178, 0x2619B, 0x2617E, 0x2625A, 0x04364, 0x2632C, 0x07F84, 0x2649C, 0x26847, 0x2688A, 0x04448, 0x04447,
+	0x26927, 0x2704A, 0x0455E, 0x04561, 0x27027, 0x085E0, 0x085F3, 0x2702E, 0x27026, 0x27208,
+	// plane 4, row 65
+	0x045F7, 0x274BD, 0x27496, 0x045F4, 0x045F8, 0x0881E, 0x274BE, 0x2748E, 0x27774, 0x04664, 0x27780, 0x04692,
+	0x0468F, 0x04690, 0x089B4, 0x04693, 0x046A8, 0x2793C, 0x2793D, 0x046A9, 0x2793A, 0x27942, 0x089F9, 0x27B24,
+	0x27B06, 0x08B44, 0x0470E, 0x27B15, 0x0470F, 0x27B02, 0x27B0C, 0x0470B, 0x27B08, 0x27B0A, 0x27B05, 0x27B1C,
+	0x04710, 0x04751, 0x27C82, 0x04750, 0x27C86, 0x04763, 0x27E03, 0x27F7B, 0x047C7, 0x27F7A, 0x08E71, 0x04824,
+	0x04826, 0x08E6E, 0x28180, 0x08E79, 0x28158, 0x08EC4, 0x04874, 0x04873, 0x04872, 0x283AA, 0x283AB, 0x28436,
+	0x2844A, 0x048B1, 0x0908C, 0x287D6, 0x0490E, 0x04911, 0x04910, 0x0490F, 0x04912, 0x04949, 0x093C9, 0x0494F,
+	0x0494D, 0x28B03, 0x28ACF, 0x04955, 0x28D9F, 0x28DA2, 0x2902A, 0x2902F, 0x29144, 0x29140, 0x04A2C, 0x04A2B,
+	0x29311, 0x2930F, 0x04A79, 0x2930D, 0x29307, 0x29303, 0x04A7A, 0x097B0, 0x04A99, 0x04A9A,
+	// plane 4, row 66
+	0x29435, 0x04AAE, 0x04AAF, 0x29504, 0x04AE4, 0x04AE1, 0x04ADE, 0x04AE6, 0x04ADF, 0x29526, 0x04AE7, 0x04AE2,
+	0x04AE0, 0x2951E, 0x04AE5, 0x0985A, 0x2960E, 0x04B18, 0x2961D, 0x2961E, 0x2961F, 0x04B56, 0x29788, 0x09946,
+	0x2978D, 0x29791, 0x29780, 0x04B6E, 0x29854, 0x29855, 0x04B9D, 0x04BA0, 0x04B9C, 0x29925, 0x2991A, 0x04BA1,
+	0x04BA2, 0x04B9F, 0x04BDF, 0x04BDE, 0x09AC3, 0x04BEA, 0x29B88, 0x04C06, 0x29B7A, 0x04C04, 0x09B0F, 0x29B80,
+	0x29D1E, 0x29DFC, 0x29E25, 0x04C5F, 0x09BF4, 0x09BFA, 0x04C5C, 0x29E0B, 0x04C5E, 0x29E06, 0x29E04, 0x09BDD,
+	0x04C59, 0x29DF9, 0x29E00, 0x04C64, 0x04C5D, 0x04C62, 0x29E02, 0x04C65, 0x29E07, 0x09BED, 0x04C5B, 0x09BEF,
+	0x2A0B5, 0x04CDD, 0x2A087, 0x04CDF, 0x2A093, 0x2FA0F, 0x04CE2, 0x2A0BE, 0x2A266, 0x04D27, 0x2A2C7, 0x2A2CF,
+	0x09E96, 0x04D3A, 0x04D3C, 0x2A33D, 0x04D39, 0x2A33C, 0x2A347, 0x04D3D, 0x04D3B, 0x09EB3,
+	// plane 4, row 67
+	0x04D4C, 0x2A3C3, 0x04D68, 0x09EE2, 0x2A51B, 0x04D80, 0x04D85, 0x2A5C9, 0x04D95, 0x2A5DD, 0x04D96, 0x2A693,
+	0x09F8F, 0x2043D, 0x034A4, 0x03512, 0x056B1, 0x03625, 0x214E6, 0x05B41, 0x03737, 0x21AF7, 0x21FB0, 0x21FAE,
+	0x00000, 0x03868, 0x03867, 0x0389E, 0x2259F, 0x2259E, 0x22930, 0x039AA, 0x2294F, 0x039A9, 0x039A4, 0x22927,
+	0x22951, 0x03A71, 0x03A6F, 0x22E51, 0x22E54, 0x22E56, 0x23027, 0x03AAD, 0x23024, 0x06AF6, 0x03C0C, 0x06AF2,
+	0x03C0B, 0x237EC, 0x237C4, 0x03C0F, 0x03C79, 0x240F8, 0x240F6, 0x240F7, 0x240ED, 0x03D8D, 0x03D8F, 0x240F4,
+	0x240EF, 0x03D8E, 0x03E0C, 0x2447F, 0x244A2, 0x2447E, 0x03EA6, 0x248C5, 0x03EA3, 0x03EA4, 0x03EA5, 0x07588,
+	0x03F6E, 0x24EF1, 0x24EF2, 0x03FFA, 0x2502F, 0x0407C, 0x0407E, 0x0407B, 0x0407D, 0x25323, 0x25329, 0x0408D,
+	0x040F4, 0x040F3, 0x2571B, 0x258EB, 0x04189, 0x258EA, 0x25A33, 0x041C0, 0x25D63, 0x04265,
+	// plane 4, row 68
+	0x25D92, 0x25D65, 0x042AD, 0x04325, 0x261A0, 0x2F970, 0x2625E, 0x043C9, 0x268AA, 0x0444A, 0x268A9, 0x08267,
+	0x04489, 0x2709C, 0x04566, 0x04570, 0x27092, 0x0456D, 0x04569, 0x04567, 0x27086, 0x04572, 0x0860E, 0x0456E,
+	0x27083, 0x0459C, 0x045FC, 0x045FD, 0x04604, 0x045FF, 0x2F9C2, 0x045FE, 0x04600, 0x274CE, 0x04666, 0x04669,
+	0x27793, 0x046AA, 0x046AB, 0x04717, 0x27B2E, 0x27B27, 0x27B28, 0x04715, 0x08B5E, 0x04712, 0x08D0E, 0x27E18,
+	0x27E16, 0x27F8D, 0x047CA, 0x27F8E, 0x047C9, 0x047CB, 0x27F90, 0x27F8F, 0x28181, 0x04829, 0x04828, 0x2818A,
+	0x2818C, 0x2828D, 0x04840, 0x04875, 0x04876, 0x283B2, 0x04888, 0x287D9, 0x091B6, 0x04957, 0x09401, 0x28B0D,
+	0x0495F, 0x28B13, 0x0941D, 0x04958, 0x0495B, 0x28B1B, 0x0942F, 0x28DAC, 0x049B3, 0x28DB3, 0x049EF, 0x2915E,
+	0x04A30, 0x29160, 0x29168, 0x29163, 0x29169, 0x29167, 0x04A41, 0x04A4B, 0x29328, 0x04A7D,
+	// plane 4, row 69
+	0x2932E, 0x29330, 0x04A7C, 0x29326, 0x29331, 0x29341, 0x097E0, 0x293DA, 0x097DB, 0x2F9FA, 0x2940B, 0x09861,
+	0x29534, 0x29533, 0x04AE8, 0x04AEA, 0x04AE9, 0x29539, 0x2953B, 0x29540, 0x29630, 0x04B1B, 0x29632, 0x29637,
+	0x04B55, 0x0994A, 0x04B59, 0x04B58, 0x29781, 0x2979F, 0x297A7, 0x04BA4, 0x04BA3, 0x29944, 0x29947, 0x29948,
+	0x2993D, 0x09A33, 0x04BA7, 0x29949, 0x04BE0, 0x29A80, 0x29BA0, 0x29B9D, 0x04C08, 0x04C0A, 0x04C09, 0x29B9C,
+	0x29C47, 0x29D07, 0x04C71, 0x09C0F, 0x04C6C, 0x29E49, 0x09C11, 0x29E44, 0x09C03, 0x09C01, 0x04C6E, 0x29EDF,
+	0x09C16, 0x29E4C, 0x00000, 0x29E4F, 0x04CE0, 0x04CEE, 0x2A0C1, 0x04CEB, 0x2A0B9, 0x2A0CB, 0x2A0CF, 0x2A0C4,
+	0x09D93, 0x04CEA, 0x04CEF, 0x04CE7, 0x2A0CA, 0x2A0C3, 0x2A2D0, 0x2A351, 0x04D48, 0x04D49, 0x2A3A8, 0x2A3C7,
+	0x2A3C6, 0x04D4D, 0x2A3CA, 0x2A402, 0x04D55, 0x2A45D, 0x2A45A, 0x04D6A, 0x04D6C, 0x2A459,
+	// plane 4, row 70
+	0x04D6B, 0x2A4CC, 0x2A51C, 0x2A5CC, 0x04D98, 0x04D99, 0x04D97, 0x2A5E8, 0x2A5EC, 0x2A5EA, 0x2A6AD, 0x2A6B0,
+	0x2045F, 0x20458, 0x20457, 0x0535B, 0x20B93, 0x03616, 0x2113B, 0x056BF, 0x21134, 0x214F2, 0x03739, 0x21AFD,
+	0x21C1D, 0x03825, 0x05DCE, 0x22304, 0x22448, 0x22958, 0x22E4F, 0x03A74, 0x22E6E, 0x22E72, 0x03AAE, 0x2302E,
+	0x237FC, 0x237F4, 0x23C36, 0x2410E, 0x03D92, 0x03D94, 0x24114, 0x03D95, 0x24119, 0x03E0D, 0x244A6, 0x03E25,
+	0x24AC9, 0x24AC0, 0x24ACC, 0x24B1B, 0x24BAA, 0x24BA8, 0x24F03, 0x03FD5, 0x03FD6, 0x076AC, 0x03FE8, 0x25035,
+	0x0407F, 0x077D2, 0x040F5, 0x040F6, 0x040F7, 0x255D9, 0x04124, 0x0418D, 0x0418A, 0x25A3F, 0x25A3D, 0x0426C,
+	0x04266, 0x0426A, 0x25D8B, 0x04267, 0x0426D, 0x04268, 0x07C52, 0x25D68, 0x25D8A, 0x25F58, 0x25F57, 0x261CE,
+	0x261BC, 0x261C0, 0x261C1, 0x261BF, 0x261AB, 0x2625F, 0x04365, 0x264A6, 0x0439A, 0x26529,
+	// plane 4, row 71
+	0x043B1, 0x0444B, 0x0444D, 0x0444C, 0x0444E, 0x268B8, 0x04573, 0x04575, 0x270DD, 0x270D6, 0x2F9B1, 0x270D5,
+	0x270E7, 0x270D8, 0x274EC, 0x04603, 0x27500, 0x27507, 0x274FD, 0x274F1, 0x274FF, 0x277AA, 0x277B0, 0x27B48,
+	0x0471E, 0x27B53, 0x08B73, 0x27B4D, 0x04719, 0x0471C, 0x0471A, 0x0471D, 0x08B76, 0x27B43, 0x27B50, 0x04743,
+	0x04752, 0x27C8C, 0x27E27, 0x04795, 0x27F99, 0x047CC, 0x281B1, 0x0482B, 0x281B0, 0x281AA, 0x281AC, 0x0482A,
+	0x08EC7, 0x04877, 0x283C8, 0x283CA, 0x28642, 0x287E0, 0x288E7, 0x288E8, 0x288E6, 0x04913, 0x04914, 0x09434,
+	0x28B5B, 0x28B56, 0x0495D, 0x28B5A, 0x04960, 0x0943E, 0x04962, 0x28CB8, 0x28DC5, 0x049B2, 0x049F0, 0x29048,
+	0x2917D, 0x2917C, 0x29181, 0x29182, 0x29162, 0x04A4C, 0x29345, 0x04A82, 0x097BC, 0x04A81, 0x04A9B, 0x293E3,
+	0x04AA4, 0x04AEE, 0x04AEC, 0x29550, 0x04AED, 0x2954F, 0x04AF0, 0x04AEF, 0x29639, 0x04B1D,
+	// plane 4, row 72
+	0x2963A, 0x04B60, 0x04B5E, 0x04B5D, 0x297C1, 0x2994E, 0x2996E, 0x04BB1, 0x04BAB, 0x04BAC, 0x04BAD, 0x29971,
+	0x04BAE, 0x29A8C, 0x29A89, 0x04BE2, 0x29AE5, 0x29BB3, 0x29BB6, 0x29BB4, 0x29C4D, 0x09B39, 0x29D13, 0x29E78,
+	0x09C2A, 0x04C7B, 0x09C26, 0x04C78, 0x04C75, 0x09C27, 0x29E72, 0x04CF2, 0x04CF4, 0x04CF3, 0x09DC0, 0x09DC9,
+	0x2A275, 0x2A2DA, 0x2A2D7, 0x04D3F, 0x04D3E, 0x04D40, 0x04D4E, 0x04D57, 0x04D59, 0x04D58, 0x2FA16, 0x2A469,
+	0x2A467, 0x04D6E, 0x2A466, 0x2A46F, 0x2A471, 0x09EEC, 0x2A4CF, 0x2A523, 0x04D81, 0x04D86, 0x2A5A5, 0x04D8F,
+	0x2A5FE, 0x2A5FB, 0x2A5FD, 0x09F68, 0x04D9B, 0x04DB1, 0x04DB3, 0x2116D, 0x0373A, 0x21B05, 0x21C20, 0x03827,
+	0x21FC8, 0x21FC9, 0x0386A, 0x039AC, 0x23127, 0x03C18, 0x2381E, 0x23936, 0x03C4C, 0x2413D, 0x03D96, 0x248D7,
+	0x03F4A, 0x24BAD, 0x24D00, 0x04081, 0x2533A, 0x04083, 0x040F9, 0x040F8, 0x25726, 0x0418E,
+	// plane 4, row 73
+	0x0418F, 0x041C1, 0x25DB7, 0x25DB6, 0x25DC0, 0x04270, 0x25D9B, 0x04271, 0x261D8, 0x0432A, 0x0432D, 0x0437D,
+	0x08032, 0x08031, 0x268C0, 0x0444F, 0x268BF, 0x26ABF, 0x04490, 0x27120, 0x270D0, 0x2710E, 0x04579, 0x2711D,
+	0x2711E, 0x27115, 0x27114, 0x2751E, 0x2752C, 0x04605, 0x277B9, 0x277B8, 0x277B6, 0x2789E, 0x2789C, 0x2794D,
+	0x089FD, 0x27B6E, 0x27B60, 0x04721, 0x27B64, 0x27B62, 0x261E5, 0x04732, 0x27FA3, 0x047CD, 0x047CF, 0x283D2,
+	0x283D5, 0x0908E, 0x04916, 0x04915, 0x049B5, 0x04A08, 0x29055, 0x04A32, 0x29193, 0x04A33, 0x04A34, 0x04A3C,
+	0x29356, 0x097C2, 0x293E9, 0x04A9C, 0x29445, 0x04AF4, 0x04AF2, 0x2967C, 0x04B62, 0x297D3, 0x04B61, 0x04B64,
+	0x04BB5, 0x09A4B, 0x04BB4, 0x2998E, 0x29A97, 0x04BE3, 0x29A9B, 0x29A99, 0x09B1C, 0x04C0E, 0x29BCF, 0x09B1B,
+	0x29C59, 0x04C2C, 0x04C2B, 0x29D20, 0x29D23, 0x29D2A, 0x04C85, 0x04C81, 0x04C7E, 0x04C83,
+	// plane 4, row 74
+	0x04C80, 0x29EB0, 0x09C42, 0x2A12F, 0x09DD4, 0x04CFB, 0x04CF7, 0x2A132, 0x2A143, 0x2A13F, 0x2A139, 0x04CF8,
+	0x2A130, 0x2A2DD, 0x2A3DA, 0x2A3DB, 0x04D5A, 0x2A484, 0x2A47F, 0x2A472, 0x2A480, 0x2A4EE, 0x04D78, 0x2A52A,
+	0x2A522, 0x2A571, 0x2A5CD, 0x04D9D, 0x04D9C, 0x2A60F, 0x2A618, 0x2046A, 0x034A9, 0x034BF, 0x056D0, 0x056CF,
+	0x21B0C, 0x05DDA, 0x225A6, 0x03A77, 0x03A76, 0x23037, 0x03ABB, 0x066EA, 0x23AE2, 0x03D9B, 0x244BC, 0x03E0F,
+	0x03E5B, 0x24AD5, 0x03F4C, 0x03F6F, 0x03FD9, 0x24F12, 0x04082, 0x2534B, 0x25341, 0x253A1, 0x255EC, 0x04274,
+	0x04272, 0x25DD4, 0x25DD8, 0x25DD9, 0x04273, 0x25DDA, 0x25F6C, 0x25F6D, 0x042B1, 0x0432E, 0x261E7, 0x261EB,
+	0x261EC, 0x26262, 0x0434E, 0x2652B, 0x2660D, 0x268CD, 0x26AC3, 0x2713F, 0x2713C, 0x2713E, 0x2713D, 0x2713A,
+	0x27138, 0x27544, 0x27545, 0x0460B, 0x27548, 0x27550, 0x0466C, 0x08B89, 0x27B78, 0x27B79,
+	// plane 4, row 75
+	0x0478B, 0x27E3E, 0x047D0, 0x0482D, 0x28654, 0x048E4, 0x04971, 0x28BB9, 0x09458, 0x0496F, 0x2905F, 0x04A87,
+	0x04AA5, 0x29572, 0x29575, 0x04B1E, 0x04B65, 0x04BB9, 0x04BB7, 0x04BB8, 0x04BE4, 0x29AA3, 0x29AA5, 0x29BDC,
+	0x29BDD, 0x29C5A, 0x04C8C, 0x04C89, 0x04C8A, 0x29EDB, 0x29EDC, 0x04C8B, 0x2A1AB, 0x2A184, 0x2A176, 0x04D01,
+	0x04CFE, 0x09DE7, 0x04D03, 0x04D06, 0x2A183, 0x09DEA, 0x09DF1, 0x2A27F, 0x04D1D, 0x04D43, 0x2A373, 0x2A3AD,
+	0x2A3B0, 0x04D4F, 0x2A40F, 0x2A40C, 0x04D5B, 0x04D70, 0x2A579, 0x04D88, 0x2A577, 0x2A57A, 0x04D89, 0x09F44,
+	0x2A632, 0x2A627, 0x2A62A, 0x2A62C, 0x09F6D, 0x2A628, 0x2A629, 0x2A638, 0x2082F, 0x2117B, 0x21B0D, 0x05DD9,
+	0x21FD6, 0x21FD5, 0x22EA1, 0x2384C, 0x03D9E, 0x03D9F, 0x03EA7, 0x03F4B, 0x03FDB, 0x03FDA, 0x24FC0, 0x077D6,
+	0x0408E, 0x04276, 0x25DF4, 0x04330, 0x0432F, 0x261F0, 0x04366, 0x2633F, 0x0457E, 0x2755D,
+	// plane 4, row 76
+	0x27572, 0x27562, 0x0883A, 0x27566, 0x08975, 0x0466F, 0x27B88, 0x047D1, 0x0482F, 0x281E8, 0x281E4, 0x048B2,
+	0x04918, 0x04917, 0x288FF, 0x04976, 0x291AF, 0x291AE, 0x04A4F, 0x04A89, 0x293F2, 0x29448, 0x29581, 0x2957E,
+	0x04AF5, 0x04B1F, 0x29652, 0x297EF, 0x09A5D, 0x04BE5, 0x29AAD, 0x29BE6, 0x04C10, 0x29BED, 0x04C0F, 0x29BE9,
+	0x29C61, 0x29C60, 0x29D33, 0x04C2F, 0x04C30, 0x09C64, 0x29F0B, 0x29F08, 0x04C93, 0x04C94, 0x29F07, 0x04D07,
+	0x04D09, 0x04D08, 0x2A1CA, 0x04D0B, 0x2A1C6, 0x09E0A, 0x2A284, 0x2A2EB, 0x2A37D, 0x04D50, 0x04D71, 0x2A49B,
+	0x2A4A2, 0x2A4A1, 0x2A4A0, 0x2A49C, 0x04D7B, 0x04D7C, 0x2A580, 0x09F73, 0x2A640, 0x04DA1, 0x2A639, 0x2A63C,
+	0x04DA0, 0x04DA2, 0x208CA, 0x0361B, 0x21189, 0x03682, 0x2303C, 0x23940, 0x24163, 0x24169, 0x03FE9, 0x25353,
+	0x04084, 0x077E1, 0x25917, 0x2591A, 0x25E00, 0x042B3, 0x04334, 0x04333, 0x04580, 0x2756F,
+	// plane 4, row 77
+	0x278AB, 0x046AD, 0x27B91, 0x04744, 0x04755, 0x27FB1, 0x047D2, 0x281EF, 0x28DF1, 0x28DF2, 0x291B7, 0x291B5,
+	0x04A8A, 0x29586, 0x2965A, 0x04B67, 0x299C6, 0x299CB, 0x04BE6, 0x29AB2, 0x04C13, 0x29BF3, 0x09B2D, 0x29F27,
+	0x04C97, 0x09E0C, 0x2A1D5, 0x2A1D8, 0x04D0C, 0x2A1EC, 0x2A287, 0x2A2F2, 0x04D46, 0x04D5C, 0x04D74, 0x04D72,
+	0x2A4AD, 0x2A4B0, 0x2A4FD, 0x09F1F, 0x2A587, 0x2A64A, 0x04DA4, 0x04DA3, 0x2A64E, 0x2A649, 0x2A651, 0x2A64D,
+	0x04DB4, 0x20472, 0x03536, 0x21B16, 0x2386D, 0x23941, 0x03CB2, 0x24171, 0x24174, 0x03F16, 0x07C70, 0x04277,
+	0x25F7C, 0x0457F, 0x2718A, 0x27956, 0x0487D, 0x09479, 0x28BFA, 0x0974A, 0x04A8C, 0x2965B, 0x04B68, 0x04BBE,
+	0x04C15, 0x2A1F5, 0x2A1F0, 0x2A2F3, 0x2A37F, 0x2A413, 0x04D75, 0x04DA5, 0x21B19, 0x22985, 0x22EB0, 0x24177,
+	0x255FF, 0x04278, 0x04335, 0x07E9D, 0x04582, 0x27187, 0x04583, 0x2718B, 0x04671, 0x27B9E,
+	// plane 4, row 78
+	0x0487E, 0x04A8E, 0x2958F, 0x09960, 0x04B69, 0x29839, 0x299D4, 0x29AF1, 0x29C02, 0x29C6B, 0x29D40, 0x04C9A,
+	0x04C9B, 0x2A210, 0x2A4BE, 0x2A4B9, 0x04D90, 0x2A664, 0x09F9E, 0x24AE9, 0x27190, 0x04586, 0x04585, 0x2721C,
+	0x0460E, 0x27592, 0x278AE, 0x27BA3, 0x04919, 0x291C9, 0x04BC0, 0x299D8, 0x29D44, 0x2A224, 0x09EF8, 0x09F3A,
+	0x09F7D, 0x2A670, 0x2A6D3, 0x0400D, 0x04C16, 0x2A4C3, 0x04DA9, 0x04DAA, 0x04085, 0x25E21, 0x26ACA, 0x2759C,
+	0x29C73, 0x2A386, 0x2A5C1, 0x29C09, 0x09F96, 0x2A6D5, 0x04BC2, 0x04C31, 0x04D11, 0x04DAB, 0x04C9C, 0x291D4,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 79
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 80
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 81
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 82
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 83
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 84
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 85
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 86
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 87
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 88
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 89
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 90
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 91
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 92
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 93
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 4, row 94
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 5, row 1
+	0x200D1, 0x200CB, 0x200C9, 0x2010C, 0x20000, 0x20087, 0x2010D, 0x2010F, 0x2F81D, 0x22398, 0x200D2, 0x20111,
+	0x2007E, 0x20AD3, 0x21552, 0x21CFE, 0x225A9, 0x23942, 0x20009, 0x200D6, 0x204DD, 0x204DC, 0x20502, 0x20573,
+	0x20676, 0x206A7, 0x206A8, 0x20833, 0x208CC, 0x20A0D, 0x20A2C, 0x20B1B, 0x0355A, 0x20B1D, 0x20B99, 0x2123E,
+	0x21D2D, 0x21D2F, 0x22399, 0x233B3, 0x239B6, 0x23B1B, 0x244EF, 0x248E9, 0x20014, 0x20094, 0x200E1, 0x20506,
+	0x2054A, 0x20544, 0x20631, 0x2067A, 0x206AD, 0x206AE, 0x206B1, 0x20838, 0x20837, 0x2097D, 0x2097E, 0x20A2D,
+	0x20A32, 0x20B23, 0x20B26, 0x2F83A, 0x20BA0, 0x216A8, 0x2193F, 0x219BC, 0x21BC4, 0x21D3B, 0x22019, 0x22018,
+	0x221B3, 0x223AA, 0x224BE, 0x225AC, 0x22A25, 0x22A66, 0x22A6B, 0x23943, 0x239B5, 0x23AEC, 0x23C76, 0x03CBC,
+	0x24614, 0x215DC, 0x2471C, 0x2471D, 0x264D0, 0x26612, 0x26AF3, 0x28669, 0x049B9, 0x28E11,
+	// plane 5, row 2
+	0x28E15, 0x28E10, 0x28E13, 0x200A4, 0x200A5, 0x201D0, 0x201BA, 0x201CB, 0x2057B, 0x20686, 0x206BB, 0x206BA,
+	0x206C3, 0x206B9, 0x206C0, 0x034DE, 0x20839, 0x2083B, 0x20841, 0x208D1, 0x208CF, 0x208D2, 0x2092E, 0x2096E,
+	0x2098C, 0x20988, 0x20A35, 0x03543, 0x20A3A, 0x20A3B, 0x20A38, 0x20A39, 0x20B2E, 0x20B2C, 0x20BC8, 0x20BB5,
+	0x20BAD, 0x20BB0, 0x20BAB, 0x211B3, 0x211A9, 0x216BB, 0x216B9, 0x21B5A, 0x21BCD, 0x21C2B, 0x21D05, 0x037AC,
+	0x037AA, 0x21D51, 0x21D4B, 0x21FEA, 0x21FED, 0x22036, 0x22062, 0x22056, 0x22055, 0x22064, 0x22058, 0x05E07,
+	0x221DA, 0x221D8, 0x223AD, 0x223AB, 0x224C0, 0x224C3, 0x225B3, 0x225B7, 0x225BA, 0x05FCB, 0x038FE, 0x225C7,
+	0x225B5, 0x2298E, 0x22A73, 0x22A6C, 0x22A74, 0x2F8B4, 0x22A72, 0x22A71, 0x22A82, 0x22A70, 0x2313F, 0x233BA,
+	0x233B8, 0x239B7, 0x24723, 0x24721, 0x2472B, 0x24726, 0x24722, 0x24D25, 0x250E4, 0x25415,
+	// plane 5, row 3
+	0x264E4, 0x26633, 0x2662A, 0x26632, 0x26629, 0x26AFB, 0x2795B, 0x2795C, 0x27C28, 0x2866F, 0x2866D, 0x2866B,
+	0x2866E, 0x28E16, 0x20022, 0x20116, 0x20148, 0x201D8, 0x20200, 0x03441, 0x20480, 0x204E4, 0x034B4, 0x204E7,
+	0x2050C, 0x20553, 0x205B7, 0x20AE8, 0x206D1, 0x206E5, 0x206D8, 0x206D6, 0x206DA, 0x206D9, 0x206D5, 0x206E6,
+	0x206D4, 0x206D3, 0x20844, 0x208D6, 0x208D7, 0x20913, 0x00000, 0x20970, 0x20992, 0x209DB, 0x209DA, 0x20A41,
+	0x20A43, 0x20B33, 0x20BF6, 0x20BD4, 0x20BDD, 0x20BD0, 0x20C04, 0x20BD9, 0x20BDB, 0x20C05, 0x20BD3, 0x20BE1,
+	0x21280, 0x21277, 0x21281, 0x21282, 0x21283, 0x2F85C, 0x21561, 0x21560, 0x216D8, 0x216D6, 0x216D7, 0x24605,
+	0x2194D, 0x21948, 0x21BD6, 0x21BCF, 0x21BD9, 0x21BD8, 0x21C3D, 0x037B3, 0x21D54, 0x21D5B, 0x21D5C, 0x037B4,
+	0x21D59, 0x21D58, 0x21D5A, 0x21D55, 0x21D56, 0x21D82, 0x21FF0, 0x2201C, 0x2206A, 0x22069,
+	// plane 5, row 4
+	0x22071, 0x22067, 0x2206C, 0x2206E, 0x22079, 0x2218B, 0x221B8, 0x221E7, 0x221E8, 0x221E4, 0x221E6, 0x221E5,
+	0x221F0, 0x22383, 0x223B7, 0x223BB, 0x223B9, 0x223C5, 0x22453, 0x224C4, 0x224C6, 0x224CB, 0x225C8, 0x2F89F,
+	0x225C9, 0x225DB, 0x22994, 0x22A95, 0x22A88, 0x22A8D, 0x22EEC, 0x22EEE, 0x22EF1, 0x2312E, 0x23149, 0x2335F,
+	0x233D1, 0x233CB, 0x23885, 0x03C1D, 0x2394A, 0x23947, 0x2394B, 0x239BB, 0x239C4, 0x03C7C, 0x23B02, 0x23C82,
+	0x23C91, 0x23C8F, 0x23C92, 0x2418F, 0x24191, 0x241B4, 0x24192, 0x244F4, 0x245AA, 0x24735, 0x24737, 0x2473D,
+	0x2473C, 0x2472F, 0x24730, 0x248F8, 0x248EF, 0x24B26, 0x03F55, 0x24D26, 0x24D27, 0x24F41, 0x24FC6, 0x24FC7,
+	0x250EB, 0x250E8, 0x250EA, 0x2541A, 0x25419, 0x04126, 0x25923, 0x25924, 0x25E25, 0x26635, 0x26634, 0x26A07,
+	0x2721D, 0x277E1, 0x27BAB, 0x28460, 0x28677, 0x2867D, 0x049BC, 0x28E1E, 0x28E1A, 0x28E1D,
+	// plane 5, row 5
+	0x2006B, 0x2012D, 0x2014C, 0x2022A, 0x0344A, 0x20215, 0x2022C, 0x20206, 0x20208, 0x2020A, 0x0344E, 0x20556,
+	0x20584, 0x034C9, 0x205C6, 0x206F5, 0x20709, 0x206F8, 0x206E8, 0x206FB, 0x206E9, 0x206F6, 0x052B7, 0x052B8,
+	0x20852, 0x052B6, 0x052BA, 0x20854, 0x20851, 0x20939, 0x2093A, 0x20998, 0x20A4E, 0x0357B, 0x20C36, 0x20C0C,
+	0x20C10, 0x20C32, 0x20C11, 0x20C18, 0x20C1C, 0x20C1A, 0x20C19, 0x20C0B, 0x20C0F, 0x20C1D, 0x20C21, 0x20C13,
+	0x20C1B, 0x20C15, 0x03620, 0x211D1, 0x211C8, 0x2128D, 0x212B5, 0x03689, 0x03695, 0x215F8, 0x216E0, 0x216E1,
+	0x036BE, 0x216DE, 0x216FE, 0x216DC, 0x21701, 0x216FD, 0x21700, 0x219D7, 0x219D6, 0x219D9, 0x21B65, 0x21BD2,
+	0x21BDC, 0x21BE1, 0x21BDE, 0x21C42, 0x21C4B, 0x037C3, 0x21D8E, 0x21D91, 0x21D8B, 0x21D90, 0x21D88, 0x21D89,
+	0x21D84, 0x21DAA, 0x2208D, 0x2208F, 0x2207D, 0x2207F, 0x2207B, 0x22080, 0x2207E, 0x22083,
+	// plane 5, row 6
+	0x22081, 0x22086, 0x2208A, 0x221BD, 0x221BE, 0x03877, 0x221F4, 0x221F3, 0x22202, 0x221F6, 0x221F5, 0x221F2,
+	0x22204, 0x2233D, 0x223C6, 0x22489, 0x224D4, 0x224D6, 0x224D2, 0x225FA, 0x22606, 0x2260C, 0x2260A, 0x2260F,
+	0x22A2F, 0x039D4, 0x22AB7, 0x22ABE, 0x22ABC, 0x22AE2, 0x22F06, 0x22EF7, 0x22F07, 0x22EF6, 0x23B35, 0x2315F,
+	0x23165, 0x23164, 0x23161, 0x2315A, 0x2315D, 0x2F8D7, 0x233D7, 0x233D5, 0x233DE, 0x233E1, 0x233E0, 0x233D9,
+	0x03B4C, 0x233DA, 0x233DF, 0x233F6, 0x23403, 0x03C20, 0x23893, 0x2388B, 0x23891, 0x23892, 0x2388A, 0x23889,
+	0x2388F, 0x23956, 0x23959, 0x239CA, 0x239CC, 0x03C5B, 0x239D0, 0x239CD, 0x239D6, 0x239CB, 0x23A83, 0x23A82,
+	0x23B06, 0x23B2A, 0x23B2C, 0x23B2B, 0x23B29, 0x23CBD, 0x23CB3, 0x23CBE, 0x23CC0, 0x23CB5, 0x23CB6, 0x2419D,
+	0x2419F, 0x24620, 0x03E2D, 0x2461E, 0x2461C, 0x24632, 0x2461F, 0x2462B, 0x24621, 0x24626,
+	// plane 5, row 7
+	0x24624, 0x2461D, 0x24627, 0x2475B, 0x24759, 0x24780, 0x24755, 0x24750, 0x2475A, 0x24756, 0x24763, 0x24752,
+	0x24758, 0x03E63, 0x24739, 0x248F9, 0x24B28, 0x03F18, 0x24BBC, 0x24BD6, 0x24C05, 0x24C20, 0x03F74, 0x24D33,
+	0x24F46, 0x24F42, 0x24F43, 0x24F47, 0x24FCB, 0x25042, 0x25045, 0x250FD, 0x250F4, 0x250F9, 0x250FA, 0x2535E,
+	0x2541D, 0x04128, 0x25758, 0x25755, 0x25A57, 0x25A58, 0x25AD9, 0x25ADC, 0x25ADB, 0x25E27, 0x25F85, 0x25F87,
+	0x25F8A, 0x2628B, 0x2634F, 0x26530, 0x2652F, 0x26668, 0x26649, 0x043DA, 0x26648, 0x2663F, 0x2664B, 0x2665C,
+	0x26646, 0x26640, 0x26A0D, 0x26B1B, 0x26B1D, 0x26B17, 0x044A4, 0x26B22, 0x275A7, 0x27608, 0x27806, 0x2847D,
+	0x0488E, 0x28463, 0x28464, 0x2847F, 0x2846B, 0x2847C, 0x28468, 0x28465, 0x2846A, 0x28698, 0x28699, 0x286AE,
+	0x28E2B, 0x28E2D, 0x28E31, 0x28E2E, 0x28E2F, 0x2006D, 0x20131, 0x2022D, 0x20239, 0x20238,
+	// plane 5, row 8
+	0x0345B, 0x2023A, 0x20235, 0x20262, 0x204EA, 0x204EB, 0x20515, 0x205CC, 0x205C8, 0x205CD, 0x205DB, 0x205CB,
+	0x20711, 0x20726, 0x20862, 0x2085E, 0x20861, 0x20941, 0x2093F, 0x20975, 0x23965, 0x20B49, 0x209EB, 0x20A1A,
+	0x20A57, 0x20C6B, 0x20CA9, 0x20C54, 0x20C62, 0x20CAA, 0x20C58, 0x20C72, 0x20C63, 0x20C73, 0x20C8B, 0x20C8C,
+	0x20C5C, 0x20C51, 0x20C16, 0x20C60, 0x20C61, 0x20C5E, 0x20C5D, 0x20C64, 0x20C53, 0x20C5F, 0x20C5B, 0x20C6E,
+	0x20C55, 0x20C52, 0x20C68, 0x211D2, 0x211D6, 0x211D3, 0x212B8, 0x212BB, 0x212EB, 0x212E8, 0x212B6, 0x212EA,
+	0x212E7, 0x212E9, 0x21591, 0x21610, 0x2160E, 0x2160F, 0x2170B, 0x21703, 0x2172B, 0x21709, 0x21728, 0x2172C,
+	0x21725, 0x21727, 0x2170F, 0x219E9, 0x219EC, 0x03753, 0x21B27, 0x21B73, 0x21BE2, 0x21BE6, 0x2F876, 0x21C61,
+	0x21C51, 0x21D12, 0x21D0E, 0x21DAD, 0x21DAF, 0x21DB1, 0x21DD3, 0x22094, 0x22092, 0x22090,
+	// plane 5, row 9
+	0x2209D, 0x22093, 0x22095, 0x22098, 0x22097, 0x03880, 0x2F88A, 0x22207, 0x2220B, 0x22208, 0x223D9, 0x223D5,
+	0x224D7, 0x224DF, 0x038DD, 0x224DE, 0x224E3, 0x224E2, 0x038DE, 0x22647, 0x03922, 0x2263D, 0x22638, 0x22A44,
+	0x22A3A, 0x22AB8, 0x22B2A, 0x22B00, 0x22AF3, 0x22AF2, 0x22AEB, 0x22AFA, 0x22AED, 0x22AEC, 0x22AFF, 0x22AFB,
+	0x06306, 0x22B4E, 0x22AEF, 0x22AF7, 0x22F10, 0x22F11, 0x22F0C, 0x22F0B, 0x230DD, 0x23130, 0x03ADB, 0x2317A,
+	0x23177, 0x23328, 0x23327, 0x23365, 0x2340E, 0x2340B, 0x23441, 0x23413, 0x2340F, 0x23412, 0x23430, 0x238A0,
+	0x238A3, 0x2389E, 0x23897, 0x238A1, 0x2389D, 0x2389B, 0x23963, 0x239E3, 0x239E4, 0x239E6, 0x239E7, 0x239DD,
+	0x06B85, 0x239EC, 0x239E5, 0x239F1, 0x23B3B, 0x23B39, 0x23B3A, 0x23B3C, 0x23B3D, 0x23B47, 0x23B3F, 0x23B44,
+	0x23C48, 0x23C49, 0x23C60, 0x23CE3, 0x23CE9, 0x23CF0, 0x03CDA, 0x03CDB, 0x23CF2, 0x23CED,
+	// plane 5, row 10
+	0x23CEC, 0x23CE6, 0x23D16, 0x03CD7, 0x241BC, 0x241BE, 0x241C0, 0x241E0, 0x24500, 0x24502, 0x244FE, 0x2455E,
+	0x245B3, 0x245B7, 0x2463A, 0x24639, 0x2463E, 0x24638, 0x2463D, 0x2464F, 0x2476E, 0x2475F, 0x24781, 0x24782,
+	0x24771, 0x2477B, 0x24779, 0x24777, 0x24773, 0x2476F, 0x24797, 0x2477E, 0x248FC, 0x24911, 0x24932, 0x24931,
+	0x24910, 0x24AEC, 0x24AEB, 0x24B2C, 0x24B2D, 0x24BDA, 0x24C2E, 0x24C2C, 0x24C2A, 0x24C33, 0x24C4B, 0x24D18,
+	0x24D42, 0x24D3B, 0x24D3F, 0x24D41, 0x24D43, 0x24D3C, 0x24F4C, 0x24F4A, 0x24F49, 0x24F56, 0x24FCF, 0x25047,
+	0x25048, 0x25046, 0x2511B, 0x25111, 0x25114, 0x2511D, 0x25117, 0x2511E, 0x2510B, 0x2511C, 0x25112, 0x25116,
+	0x2510D, 0x25115, 0x25113, 0x25118, 0x0401A, 0x253AC, 0x25444, 0x2542C, 0x25445, 0x25766, 0x2576E, 0x25765,
+	0x25768, 0x25784, 0x25938, 0x2593B, 0x2593A, 0x2593F, 0x25ADF, 0x25ADD, 0x25ADE, 0x25AE2,
+	// plane 5, row 11
+	0x041D6, 0x25E2C, 0x25E2F, 0x25F8F, 0x25F90, 0x25F8C, 0x25F93, 0x26225, 0x26288, 0x262A7, 0x263F7, 0x264BB,
+	0x26538, 0x26539, 0x26537, 0x26614, 0x2666E, 0x2667B, 0x043DE, 0x2666C, 0x2667F, 0x26678, 0x2667A, 0x2666F,
+	0x043E5, 0x268FE, 0x26B48, 0x26B4B, 0x26B41, 0x26B49, 0x26B3B, 0x2722D, 0x275E3, 0x2761A, 0x2760D, 0x27613,
+	0x27611, 0x2761C, 0x04672, 0x278B5, 0x046AF, 0x27C29, 0x27CA1, 0x27D24, 0x27FBB, 0x282A1, 0x28483, 0x2848D,
+	0x28487, 0x28485, 0x2848A, 0x284A3, 0x28489, 0x28484, 0x284A2, 0x286B1, 0x286B0, 0x286AF, 0x286B6, 0x286C9,
+	0x286CA, 0x286C8, 0x286B4, 0x28C59, 0x28CC9, 0x28E41, 0x28E4C, 0x049C4, 0x28E45, 0x28E5A, 0x200B9, 0x20294,
+	0x2026E, 0x202A2, 0x20267, 0x03463, 0x202A3, 0x20276, 0x2026C, 0x20264, 0x202A5, 0x20230, 0x202A6, 0x20269,
+	0x202A4, 0x20490, 0x2055F, 0x20560, 0x205DA, 0x205D8, 0x205DD, 0x034EC, 0x20759, 0x2075B,
+	// plane 5, row 12
+	0x2075A, 0x20739, 0x20734, 0x20744, 0x20733, 0x2073C, 0x20758, 0x2076A, 0x2086F, 0x20872, 0x20870, 0x20871,
+	0x2086E, 0x208F0, 0x208EB, 0x20949, 0x2094C, 0x209F5, 0x20A20, 0x20A6A, 0x20A72, 0x20B4B, 0x20B5D, 0x20C50,
+	0x20CF9, 0x20CFA, 0x20CFB, 0x20D23, 0x20CB7, 0x20D22, 0x20CB5, 0x20CC4, 0x20CB4, 0x20CEF, 0x03598, 0x20CCD,
+	0x20CF0, 0x20CB8, 0x20CCB, 0x20CF1, 0x20CBA, 0x20D03, 0x20CF8, 0x20CB6, 0x20CBC, 0x20CB3, 0x20D21, 0x20CF7,
+	0x20CC2, 0x20CBE, 0x20CC9, 0x20CBD, 0x20CCE, 0x20CB9, 0x20CC7, 0x20CCA, 0x20CCC, 0x20D26, 0x211E4, 0x211E0,
+	0x211F0, 0x212EC, 0x21324, 0x21318, 0x2132F, 0x21325, 0x21528, 0x21522, 0x2156A, 0x20157, 0x21594, 0x21592,
+	0x2159C, 0x21622, 0x21630, 0x2161D, 0x21623, 0x21735, 0x21733, 0x2172F, 0x2174D, 0x21731, 0x2175B, 0x2175A,
+	0x21732, 0x2175C, 0x21759, 0x2175D, 0x21969, 0x2196D, 0x22232, 0x03758, 0x21A0A, 0x21A20,
+	// plane 5, row 13
+	0x21A1F, 0x21A0D, 0x21BE8, 0x21BEC, 0x21C77, 0x21C70, 0x21C71, 0x21DF8, 0x21DD6, 0x21DD9, 0x21DDE, 0x21DD5,
+	0x21DE1, 0x037D3, 0x21DE2, 0x21DDD, 0x21DE0, 0x21E0E, 0x21E08, 0x21DDC, 0x21E0A, 0x21E0C, 0x220B4, 0x220B1,
+	0x220B6, 0x220BC, 0x2221D, 0x22224, 0x22219, 0x2221B, 0x22222, 0x2221A, 0x2221C, 0x22221, 0x223E6, 0x223E4,
+	0x223E7, 0x223EA, 0x038E2, 0x2F89B, 0x224F0, 0x224F5, 0x224EF, 0x22642, 0x2266E, 0x2263C, 0x22697, 0x2268C,
+	0x22681, 0x22671, 0x226CE, 0x226BA, 0x2267A, 0x2267E, 0x03954, 0x22672, 0x226BB, 0x0392F, 0x22673, 0x22682,
+	0x039B6, 0x229BA, 0x229B8, 0x22A4B, 0x22A48, 0x22B2B, 0x22AF4, 0x22B2C, 0x22B42, 0x22B48, 0x22B44, 0x22B45,
+	0x22B3C, 0x22B37, 0x22B33, 0x22B41, 0x22B32, 0x22B87, 0x22F27, 0x22F23, 0x22F2D, 0x22F1F, 0x22F2C, 0x22F28,
+	0x23075, 0x23074, 0x230E7, 0x23132, 0x23131, 0x23199, 0x23196, 0x23198, 0x2319D, 0x23192,
+	// plane 5, row 14
+	0x23194, 0x23195, 0x23197, 0x266B9, 0x03B35, 0x23411, 0x2343B, 0x23479, 0x23442, 0x23443, 0x03B5D, 0x23478,
+	0x238AC, 0x03C29, 0x238B1, 0x238B4, 0x238B3, 0x238AF, 0x238AA, 0x238B2, 0x23968, 0x239F2, 0x239FE, 0x239F8,
+	0x239F9, 0x239FF, 0x239F5, 0x239F7, 0x239FD, 0x23A00, 0x23A88, 0x23B6E, 0x23B5D, 0x23B63, 0x23B60, 0x23C61,
+	0x23D37, 0x23D71, 0x23D23, 0x23D22, 0x23D1F, 0x23D25, 0x241FD, 0x24211, 0x24223, 0x241F0, 0x241EF, 0x2450E,
+	0x2450C, 0x245BA, 0x03E1F, 0x24652, 0x24655, 0x24653, 0x24654, 0x24651, 0x24658, 0x247B0, 0x2479F, 0x03E72,
+	0x247A1, 0x2479A, 0x247BE, 0x247A0, 0x24937, 0x03F0B, 0x24AF1, 0x24AF2, 0x24B34, 0x24B37, 0x24B35, 0x2238C,
+	0x24BDF, 0x24C4C, 0x24C4E, 0x24C64, 0x24C63, 0x24C36, 0x24D58, 0x24D68, 0x24D5B, 0x24D5F, 0x24D59, 0x24D65,
+	0x24D60, 0x24D5E, 0x24FD5, 0x24FD6, 0x2504E, 0x25052, 0x25135, 0x25139, 0x25158, 0x25141,
+	// plane 5, row 15
+	0x25157, 0x25138, 0x2513D, 0x25132, 0x25144, 0x2F944, 0x25363, 0x25362, 0x253B8, 0x253B0, 0x253B1, 0x2544D,
+	0x25463, 0x25470, 0x2544B, 0x2544F, 0x2544E, 0x25453, 0x25447, 0x040A2, 0x25454, 0x25452, 0x25459, 0x2547E,
+	0x2562B, 0x2562E, 0x2577F, 0x2577E, 0x25781, 0x25783, 0x25947, 0x25954, 0x2594D, 0x2594C, 0x2594B, 0x25957,
+	0x25A65, 0x25A64, 0x25A61, 0x25A62, 0x25AF0, 0x25AF3, 0x25AF9, 0x25AF4, 0x25AF5, 0x25AEF, 0x25AF8, 0x25AFC,
+	0x25AF7, 0x25AFD, 0x25B17, 0x25E34, 0x25E36, 0x25E38, 0x25FAF, 0x25FAE, 0x25FAC, 0x25FA2, 0x26228, 0x22EC2,
+	0x2629C, 0x262B3, 0x26290, 0x26406, 0x26404, 0x2640B, 0x26405, 0x26540, 0x2654F, 0x266AB, 0x2669D, 0x266B8,
+	0x266B2, 0x266D2, 0x266CF, 0x266A2, 0x043EC, 0x266A5, 0x266A9, 0x266A7, 0x266D0, 0x26902, 0x269CF, 0x269D0,
+	0x26A16, 0x26A19, 0x26B75, 0x26B71, 0x26B91, 0x26B72, 0x26B8E, 0x26B6D, 0x26B88, 0x26B73,
+	// plane 5, row 16
+	0x044BB, 0x26B81, 0x26B76, 0x26B7B, 0x26B7F, 0x26B90, 0x26B78, 0x2724B, 0x27248, 0x2725B, 0x27251, 0x2723D,
+	0x27240, 0x27246, 0x2724D, 0x2723B, 0x2724A, 0x27241, 0x2723E, 0x2724E, 0x275AC, 0x275E6, 0x275E9, 0x27629,
+	0x04621, 0x27633, 0x2762E, 0x2761E, 0x0461F, 0x2F9C5, 0x27622, 0x27627, 0x277E7, 0x2780B, 0x27966, 0x27BAD,
+	0x27BAE, 0x08C38, 0x27C2B, 0x27C2A, 0x27D2C, 0x27D2D, 0x04791, 0x04796, 0x27E8F, 0x27E93, 0x27FC6, 0x27FC5,
+	0x28215, 0x28213, 0x282B1, 0x282A9, 0x08ECE, 0x284A8, 0x284AB, 0x284BE, 0x284AC, 0x284A9, 0x284A6, 0x284C1,
+	0x286CC, 0x286D1, 0x286D3, 0x286E4, 0x286CB, 0x286E1, 0x286D2, 0x286E3, 0x286CF, 0x286D0, 0x286E5, 0x2870E,
+	0x28CCC, 0x28CCA, 0x28CCB, 0x28E5C, 0x28E61, 0x28E5D, 0x28E59, 0x29077, 0x29684, 0x20041, 0x200FD, 0x2011A,
+	0x20163, 0x202B6, 0x202B2, 0x202E9, 0x202E7, 0x202D6, 0x20330, 0x202EC, 0x202DD, 0x202E8,
+	// plane 5, row 17
+	0x202B0, 0x202B5, 0x202E1, 0x20498, 0x20499, 0x034BC, 0x20593, 0x205E5, 0x034D8, 0x20760, 0x2075E, 0x034F4,
+	0x2087B, 0x208F1, 0x208F3, 0x20A7F, 0x2F838, 0x20B57, 0x20B58, 0x20D39, 0x20DA7, 0x20D35, 0x20D60, 0x20D51,
+	0x20D62, 0x20D42, 0x20D3C, 0x20D43, 0x20DAB, 0x20DA9, 0x20DB4, 0x20D79, 0x20D6A, 0x20D3B, 0x20DAA, 0x20D33,
+	0x20D37, 0x20D27, 0x20DA8, 0x20D70, 0x20D2F, 0x20D36, 0x20DB5, 0x20D30, 0x20D3A, 0x20D38, 0x20DB1, 0x20DAC,
+	0x20D2E, 0x20D75, 0x20DB0, 0x211F7, 0x21200, 0x211F1, 0x211F3, 0x21360, 0x21361, 0x21332, 0x2132D, 0x2134A,
+	0x2132A, 0x21567, 0x215A2, 0x215A3, 0x2159E, 0x215A4, 0x21644, 0x21632, 0x2179B, 0x21798, 0x21799, 0x2179A,
+	0x21766, 0x21762, 0x2176B, 0x24562, 0x21767, 0x21A3B, 0x21A23, 0x21A29, 0x21B7E, 0x21BF3, 0x03785, 0x21BF5,
+	0x03783, 0x21C80, 0x21C7E, 0x21C7C, 0x21C7F, 0x21C7D, 0x21C81, 0x21E25, 0x21E29, 0x21E17,
+	// plane 5, row 18
+	0x21E63, 0x21E67, 0x21E65, 0x21E2A, 0x21E68, 0x21E26, 0x21E64, 0x21E1B, 0x21E62, 0x21E69, 0x21FFE, 0x22044,
+	0x22042, 0x220B7, 0x220D2, 0x220D4, 0x220D7, 0x220DC, 0x220D1, 0x220D5, 0x220CD, 0x220D8, 0x2219A, 0x22242,
+	0x22235, 0x22258, 0x22234, 0x2223C, 0x2223B, 0x2223E, 0x2223D, 0x2225A, 0x22241, 0x22238, 0x22245, 0x22233,
+	0x22326, 0x038BA, 0x223F7, 0x223EF, 0x223F0, 0x223EE, 0x2249D, 0x22506, 0x22511, 0x22508, 0x22502, 0x2250A,
+	0x22507, 0x22691, 0x2268E, 0x22685, 0x226E8, 0x22717, 0x226D4, 0x03969, 0x226C4, 0x226D5, 0x03945, 0x226D8,
+	0x22680, 0x226DE, 0x22742, 0x229C7, 0x229BF, 0x22B8D, 0x22B89, 0x22B90, 0x22B9A, 0x22BC8, 0x22B92, 0x22BA1,
+	0x22B84, 0x22F57, 0x22F47, 0x22F77, 0x22F3A, 0x22F50, 0x22F42, 0x22F43, 0x22FA3, 0x23077, 0x231B2, 0x231E2,
+	0x231BD, 0x23374, 0x23482, 0x234B8, 0x03B6C, 0x234BA, 0x234B9, 0x234BB, 0x23487, 0x2F8E4,
+	// plane 5, row 19
+	0x23483, 0x234B7, 0x20043, 0x238CE, 0x238BA, 0x23973, 0x23A09, 0x23A0A, 0x23A0B, 0x23A07, 0x23A05, 0x23A0E,
+	0x23A97, 0x23B77, 0x23B7B, 0x23B7A, 0x23B74, 0x23B79, 0x23D86, 0x23DF5, 0x23DA5, 0x23D9D, 0x23DBE, 0x23D96,
+	0x23DE1, 0x23DA4, 0x23DA1, 0x23DF6, 0x03D04, 0x23D8D, 0x23D8B, 0x23D78, 0x23D98, 0x23E0A, 0x2425D, 0x24227,
+	0x2425F, 0x245C0, 0x245C1, 0x2466D, 0x2465E, 0x2468A, 0x24662, 0x24664, 0x03E3A, 0x247C0, 0x247CA, 0x247D1,
+	0x03E79, 0x247C7, 0x247CE, 0x07309, 0x247C5, 0x24936, 0x24957, 0x24977, 0x24AF6, 0x24AF7, 0x24B55, 0x24B44,
+	0x24B3E, 0x24B42, 0x24B52, 0x24BE1, 0x24C08, 0x24C68, 0x24C67, 0x03F5D, 0x2F937, 0x24D7E, 0x24D93, 0x24D79,
+	0x24D81, 0x24D7D, 0x24D7B, 0x24D94, 0x03F8A, 0x24F66, 0x24FD9, 0x24FE0, 0x2F93E, 0x2505F, 0x2515F, 0x2515E,
+	0x25168, 0x04027, 0x25188, 0x2516A, 0x2516C, 0x25166, 0x25167, 0x25189, 0x25160, 0x25185,
+	// plane 5, row 20
+	0x2032A, 0x25368, 0x253B9, 0x253BA, 0x253BE, 0x2546F, 0x25480, 0x25474, 0x25481, 0x2547A, 0x2549C, 0x25473,
+	0x25482, 0x2547F, 0x254A7, 0x25479, 0x25478, 0x2547D, 0x254A8, 0x2547C, 0x25668, 0x2564E, 0x2579E, 0x04146,
+	0x04140, 0x25798, 0x0413F, 0x25785, 0x2579C, 0x257C5, 0x2579A, 0x25968, 0x25965, 0x25967, 0x25961, 0x25960,
+	0x0419D, 0x041CB, 0x25A7B, 0x041E1, 0x25B19, 0x25B0E, 0x25B31, 0x25B12, 0x25B10, 0x25B15, 0x25B3F, 0x25B1D,
+	0x25B1E, 0x25E4D, 0x25E48, 0x25E45, 0x25E42, 0x25E49, 0x25E4A, 0x25E47, 0x0427F, 0x25E4C, 0x25FCD, 0x25FD5,
+	0x25FC4, 0x25FCA, 0x25FC3, 0x04346, 0x26229, 0x262B4, 0x2636D, 0x26366, 0x2636A, 0x26415, 0x264D3, 0x264EC,
+	0x26555, 0x0441A, 0x266D6, 0x266D8, 0x26751, 0x266E0, 0x26703, 0x26704, 0x266DA, 0x266EA, 0x26702, 0x266D3,
+	0x266E4, 0x266E5, 0x2693B, 0x26966, 0x26A2C, 0x26A21, 0x26A26, 0x26AD4, 0x26AD5, 0x26AD9,
+	// plane 5, row 21
+	0x26BD5, 0x26BEB, 0x26BC5, 0x044D3, 0x26C27, 0x26BF0, 0x26BB9, 0x26C18, 0x26BEE, 0x044D0, 0x26BB7, 0x26BBB,
+	0x26BEF, 0x26BB8, 0x26BDF, 0x26BDA, 0x26BE3, 0x26BC9, 0x26BEC, 0x271A4, 0x0458E, 0x271A3, 0x2725E, 0x27261,
+	0x27262, 0x27263, 0x2728E, 0x27269, 0x2726B, 0x27285, 0x2728D, 0x27264, 0x2726D, 0x2764C, 0x27649, 0x27647,
+	0x27648, 0x27642, 0x27645, 0x2765B, 0x2765D, 0x27646, 0x27813, 0x2781A, 0x2780E, 0x2780F, 0x2781B, 0x27812,
+	0x278C3, 0x278BE, 0x278BD, 0x27979, 0x2797C, 0x046C3, 0x046B6, 0x27980, 0x27975, 0x2799B, 0x08A2F, 0x27974,
+	0x046C0, 0x046B8, 0x22041, 0x22494, 0x27C30, 0x27CA7, 0x27CA5, 0x27CA4, 0x27D3A, 0x27E5B, 0x27E9F, 0x27EA8,
+	0x27EB3, 0x27EA1, 0x27EA7, 0x27EB2, 0x27E9D, 0x27EA3, 0x27EA2, 0x27FEF, 0x27FD9, 0x047D9, 0x27FD5, 0x27FDD,
+	0x27FDA, 0x27FEE, 0x27FD7, 0x2821A, 0x28219, 0x28218, 0x282B3, 0x282BF, 0x282BB, 0x282C0,
+	// plane 5, row 22
+	0x282B9, 0x282B8, 0x282D5, 0x28411, 0x28410, 0x284C3, 0x284C2, 0x284E9, 0x284CD, 0x284E5, 0x284CA, 0x284C7,
+	0x284E8, 0x286F1, 0x2870C, 0x286EF, 0x286EE, 0x286F3, 0x2870D, 0x286F6, 0x286F0, 0x048EC, 0x287F5, 0x287F4,
+	0x287F8, 0x287FE, 0x28911, 0x28922, 0x28942, 0x28C5C, 0x28C5A, 0x28C5D, 0x28CD1, 0x28CD0, 0x28E75, 0x28E7B,
+	0x28F89, 0x2944D, 0x29689, 0x29692, 0x2968C, 0x29688, 0x29867, 0x29868, 0x20321, 0x202F2, 0x20309, 0x202F8,
+	0x202F0, 0x202F3, 0x202F5, 0x202FB, 0x202F7, 0x202EF, 0x2030B, 0x204A2, 0x205F5, 0x205F3, 0x205F4, 0x205F2,
+	0x20698, 0x20768, 0x20780, 0x20785, 0x2078E, 0x2078F, 0x202F4, 0x20786, 0x207A4, 0x20886, 0x20889, 0x20887,
+	0x20885, 0x052DC, 0x20888, 0x20AFF, 0x20B66, 0x035CC, 0x20DC3, 0x035A2, 0x20DC4, 0x20E3B, 0x20DE7, 0x20DF8,
+	0x20DFB, 0x035B6, 0x20DBE, 0x20DC6, 0x035C5, 0x035C6, 0x20DFC, 0x20DC0, 0x20E33, 0x20DC9,
+	// plane 5, row 23
+	0x20DFE, 0x20DDA, 0x20DCC, 0x20DBB, 0x20DFD, 0x20DDF, 0x20DCD, 0x20DC2, 0x20DC8, 0x20E32, 0x20E2D, 0x20DD2,
+	0x20E31, 0x20DD3, 0x20E2E, 0x20DCF, 0x211FF, 0x21209, 0x211FC, 0x21372, 0x213AA, 0x213AB, 0x213A7, 0x2137B,
+	0x21376, 0x213A8, 0x213AC, 0x03683, 0x05921, 0x215AA, 0x20A03, 0x215AC, 0x215AD, 0x215AB, 0x21650, 0x21658,
+	0x217DD, 0x036F8, 0x217AE, 0x217A2, 0x217AB, 0x217E3, 0x217AF, 0x217E0, 0x217E4, 0x036F6, 0x217A7, 0x217AA,
+	0x217E2, 0x217E1, 0x217DF, 0x217AD, 0x217E5, 0x217D1, 0x217AC, 0x217D5, 0x217A3, 0x2179F, 0x21979, 0x21976,
+	0x21A43, 0x21A3D, 0x21A47, 0x21A44, 0x21A3F, 0x21A5B, 0x21B7F, 0x21C9A, 0x21C9C, 0x0379B, 0x21D1E, 0x21E88,
+	0x21E6A, 0x21E76, 0x21E72, 0x21E70, 0x21E7E, 0x21E6C, 0x21E6F, 0x21E75, 0x21E77, 0x21E78, 0x21E8A, 0x21E79,
+	0x21E94, 0x22001, 0x22105, 0x220F1, 0x220EE, 0x220EF, 0x22104, 0x05E3F, 0x220FA, 0x22107,
+	// plane 5, row 24
+	0x220F4, 0x220F5, 0x2219C, 0x2226D, 0x22269, 0x22266, 0x22262, 0x22246, 0x0388D, 0x22265, 0x2225D, 0x2225E,
+	0x2225F, 0x2238E, 0x22402, 0x22406, 0x22404, 0x22403, 0x22462, 0x22527, 0x22521, 0x22520, 0x22524, 0x22529,
+	0x22531, 0x22523, 0x22522, 0x226DC, 0x2274D, 0x226E5, 0x226D3, 0x226EE, 0x226E6, 0x03956, 0x22736, 0x22740,
+	0x2283F, 0x2273D, 0x22744, 0x0395B, 0x2275F, 0x22729, 0x22749, 0x2278A, 0x2272A, 0x22787, 0x2274C, 0x22731,
+	0x22748, 0x2272B, 0x2273B, 0x22741, 0x226C9, 0x22734, 0x22753, 0x22735, 0x22747, 0x22738, 0x229D1, 0x229D2,
+	0x22A51, 0x22A55, 0x22A52, 0x22A53, 0x22B8F, 0x22BF4, 0x22C47, 0x22C0D, 0x22C1C, 0x22BFB, 0x22C19, 0x22BF7,
+	0x22BF9, 0x22BF5, 0x22BE9, 0x22C0A, 0x22BEE, 0x22C0B, 0x22BFD, 0x22C06, 0x22C02, 0x22C16, 0x22C18, 0x22BF0,
+	0x22ED4, 0x22F66, 0x22F6A, 0x22F75, 0x22F76, 0x22F80, 0x22F6D, 0x03A96, 0x22F69, 0x22F67,
+	// plane 5, row 25
+	0x22F68, 0x22F5D, 0x230A4, 0x230FE, 0x230FD, 0x231EC, 0x231E9, 0x23221, 0x231EB, 0x231E7, 0x231F2, 0x23220,
+	0x2337A, 0x2352D, 0x2352E, 0x234CA, 0x2352F, 0x234C8, 0x03B7E, 0x234CB, 0x234C3, 0x23484, 0x234D2, 0x234C6,
+	0x03B81, 0x234CF, 0x234D5, 0x234D4, 0x234DD, 0x2352B, 0x235A3, 0x234DB, 0x2352C, 0x23513, 0x03C35, 0x238D1,
+	0x238CC, 0x238D5, 0x238C9, 0x238CF, 0x238D2, 0x2397B, 0x2397C, 0x23A23, 0x23A1D, 0x23A1C, 0x23A1E, 0x23A22,
+	0x23A24, 0x23A20, 0x23A18, 0x23A21, 0x23B88, 0x23B8A, 0x23B94, 0x23B8F, 0x23B8E, 0x23B90, 0x03C9B, 0x23BA7,
+	0x23C64, 0x03D00, 0x23E18, 0x23E14, 0x23E1D, 0x23E05, 0x23DFF, 0x23E1B, 0x23E1A, 0x23E19, 0x23E03, 0x23E0E,
+	0x23E47, 0x23E04, 0x23E5A, 0x23E07, 0x23E20, 0x2426A, 0x24266, 0x2427A, 0x24265, 0x242AF, 0x245CD, 0x245CF,
+	0x245D1, 0x245CE, 0x2465F, 0x24677, 0x24674, 0x24688, 0x24675, 0x24689, 0x24672, 0x247C6,
+	// plane 5, row 26
+	0x247EA, 0x247E0, 0x24807, 0x247E6, 0x247E5, 0x247DD, 0x247E3, 0x247DA, 0x249AE, 0x2497E, 0x2497D, 0x2497F,
+	0x03ED3, 0x249D9, 0x24AFA, 0x24AFB, 0x24B4D, 0x24B54, 0x24B51, 0x24B50, 0x24D98, 0x24D95, 0x24D9D, 0x24DC1,
+	0x24D9A, 0x24D9B, 0x03F9F, 0x24D9C, 0x24DD7, 0x24DA4, 0x24D96, 0x24DA0, 0x24F31, 0x24FE9, 0x2506E, 0x2519A,
+	0x251A5, 0x25194, 0x251A6, 0x25199, 0x2518B, 0x25198, 0x25191, 0x2518C, 0x251A1, 0x251A3, 0x251C6, 0x2536F,
+	0x2536D, 0x2536A, 0x2536C, 0x25371, 0x2536E, 0x253DF, 0x254AC, 0x254AA, 0x040B1, 0x254B5, 0x254AD, 0x254B2,
+	0x254B3, 0x254AB, 0x254B6, 0x254C7, 0x254C6, 0x2566C, 0x25670, 0x257B3, 0x0414B, 0x2598C, 0x25981, 0x25983,
+	0x2597D, 0x25985, 0x25990, 0x259A2, 0x2598D, 0x2598F, 0x2597E, 0x2598A, 0x2597F, 0x25976, 0x25987, 0x25978,
+	0x25A80, 0x25A82, 0x25A81, 0x25A83, 0x25B5A, 0x25B34, 0x25B2C, 0x25B2A, 0x25B40, 0x25B2D,
+	// plane 5, row 27
+	0x25B33, 0x25B2B, 0x25B4B, 0x07B3F, 0x25B3C, 0x25E5A, 0x25E77, 0x25E60, 0x25FFA, 0x25FF9, 0x25FFC, 0x042D6,
+	0x25FF5, 0x25FE8, 0x26009, 0x25FE7, 0x25FE6, 0x25FE9, 0x26232, 0x2622C, 0x262BD, 0x262BC, 0x2637A, 0x2637E,
+	0x26379, 0x04389, 0x26423, 0x26430, 0x26428, 0x264D4, 0x26ACE, 0x264F1, 0x264F0, 0x26560, 0x26565, 0x266A8,
+	0x2670A, 0x2671B, 0x26712, 0x26707, 0x2674F, 0x2670E, 0x26752, 0x26716, 0x04400, 0x26753, 0x26754, 0x2F984,
+	0x26756, 0x2696D, 0x26972, 0x26A2F, 0x26C2A, 0x044DC, 0x26C63, 0x26C84, 0x26CAF, 0x26C45, 0x26C70, 0x26C56,
+	0x26C16, 0x26C3D, 0x26C38, 0x26C6F, 0x26C77, 0x26C2E, 0x26C31, 0x26C4C, 0x26C5F, 0x26C75, 0x26C39, 0x26C3A,
+	0x26C2C, 0x26C2D, 0x26C3F, 0x26C2F, 0x26C30, 0x26C3E, 0x083D0, 0x04590, 0x271B2, 0x272B7, 0x2728F, 0x045B1,
+	0x27296, 0x2729E, 0x27297, 0x27295, 0x272A5, 0x27298, 0x086E7, 0x272A3, 0x2729A, 0x272AD,
+	// plane 5, row 28
+	0x272A6, 0x272B6, 0x27299, 0x272AF, 0x272AC, 0x045AA, 0x2729F, 0x27294, 0x275EB, 0x2766F, 0x2766D, 0x2767E,
+	0x2766C, 0x27661, 0x27663, 0x2766B, 0x2765E, 0x2763E, 0x27664, 0x276AD, 0x0467D, 0x27820, 0x278D1, 0x278D5,
+	0x279AE, 0x279AF, 0x2799F, 0x279A8, 0x279CA, 0x27BE1, 0x27C39, 0x27C38, 0x27C3B, 0x27CAD, 0x27CB5, 0x27CAF,
+	0x27CB1, 0x27CAE, 0x27CB4, 0x27CB0, 0x27D4B, 0x27D51, 0x27D4C, 0x04769, 0x27D60, 0x27EBE, 0x27EB9, 0x27EB7,
+	0x27EB6, 0x047A1, 0x27EBD, 0x27EB8, 0x27EC1, 0x27FF7, 0x27FF3, 0x27FF4, 0x27FFB, 0x27FFD, 0x27FF5, 0x047E7,
+	0x27FFA, 0x28014, 0x047EC, 0x27FF2, 0x27FFC, 0x28000, 0x047DF, 0x2822B, 0x28229, 0x2822E, 0x04833, 0x282D7,
+	0x282DB, 0x282D9, 0x282D8, 0x282E6, 0x282D6, 0x282D1, 0x282E7, 0x282D0, 0x282DC, 0x284ED, 0x2851A, 0x28519,
+	0x28516, 0x284EC, 0x284EF, 0x284EE, 0x284F4, 0x2852F, 0x28730, 0x28719, 0x28718, 0x28711,
+	// plane 5, row 29
+	0x28716, 0x28712, 0x28710, 0x28714, 0x2880C, 0x2880D, 0x28910, 0x28929, 0x28928, 0x28C65, 0x28C66, 0x28CDE,
+	0x28CDF, 0x28CE0, 0x28CD9, 0x28CDB, 0x28CD8, 0x28CE3, 0x28CD7, 0x28EA6, 0x28EA7, 0x28EBF, 0x28EA8, 0x28E9F,
+	0x28EA1, 0x28EA2, 0x28EAD, 0x28EA3, 0x28EA0, 0x29083, 0x29085, 0x29082, 0x29084, 0x291EE, 0x2925D, 0x29392,
+	0x29455, 0x29453, 0x2F9FC, 0x29451, 0x29452, 0x29450, 0x2959D, 0x29687, 0x29697, 0x2969B, 0x2969D, 0x29869,
+	0x29A11, 0x04BEE, 0x29C7A, 0x04C32, 0x23244, 0x200C6, 0x20340, 0x2032E, 0x20368, 0x2032D, 0x20334, 0x20332,
+	0x20369, 0x20331, 0x2036A, 0x2033E, 0x2036F, 0x20375, 0x204F6, 0x20520, 0x20528, 0x20567, 0x205FB, 0x205FE,
+	0x205FC, 0x205FD, 0x205FF, 0x205FA, 0x207AC, 0x207AF, 0x207B1, 0x034FC, 0x207BF, 0x207BE, 0x207A7, 0x207AA,
+	0x20894, 0x20893, 0x20899, 0x0352A, 0x208FA, 0x20956, 0x20A22, 0x20A91, 0x20A92, 0x20B08,
+	// plane 5, row 30
+	0x20B06, 0x20DD6, 0x20E37, 0x20E4B, 0x20ECE, 0x20ED0, 0x20E39, 0x20E64, 0x20ECF, 0x20E46, 0x20E66, 0x20E56,
+	0x20E43, 0x20E4A, 0x20E58, 0x20E65, 0x20E5A, 0x20E5B, 0x20E55, 0x20E50, 0x20E4C, 0x20ECD, 0x20E51, 0x20E47,
+	0x035D3, 0x20E53, 0x20E62, 0x20ECC, 0x035D7, 0x20E7F, 0x20E4D, 0x2120A, 0x2120C, 0x2120F, 0x213BB, 0x213B9,
+	0x213EB, 0x213AE, 0x213C1, 0x213EA, 0x213BE, 0x213BF, 0x213BA, 0x213B7, 0x213B3, 0x213E9, 0x2156E, 0x215AF,
+	0x215B3, 0x215B2, 0x21668, 0x21669, 0x2166A, 0x217DE, 0x217EB, 0x21811, 0x217ED, 0x217E9, 0x217F7, 0x217F4,
+	0x217EA, 0x21812, 0x217E8, 0x21813, 0x2198E, 0x21986, 0x21A63, 0x21A65, 0x21B99, 0x21CC2, 0x21CAE, 0x21CB0,
+	0x21CAD, 0x21EB4, 0x21ED1, 0x21EAD, 0x037F3, 0x21EBD, 0x21EB7, 0x21EAB, 0x21EAF, 0x21EC1, 0x21EB5, 0x2210F,
+	0x2210E, 0x2211C, 0x22113, 0x2210A, 0x2211E, 0x22114, 0x2211A, 0x221CB, 0x22287, 0x22293,
+	// plane 5, row 31
+	0x03891, 0x22284, 0x22285, 0x22292, 0x22280, 0x2232B, 0x22363, 0x2240A, 0x038C0, 0x22466, 0x22534, 0x22533,
+	0x2254D, 0x22789, 0x22757, 0x06117, 0x2274A, 0x03963, 0x22739, 0x2273F, 0x22790, 0x2278F, 0x227B2, 0x2279F,
+	0x22795, 0x227A1, 0x2279C, 0x2278E, 0x227A0, 0x227AE, 0x22796, 0x227CA, 0x227AC, 0x03970, 0x227A6, 0x22797,
+	0x22793, 0x203B6, 0x229D6, 0x229E0, 0x229D8, 0x22A56, 0x22C6E, 0x22C7E, 0x22C74, 0x22C77, 0x22C80, 0x22C7A,
+	0x22C6B, 0x22C5F, 0x22C76, 0x22CB0, 0x22C63, 0x22F92, 0x22F82, 0x22F9D, 0x22F94, 0x22F83, 0x22F8B, 0x22F84,
+	0x23057, 0x23084, 0x230B5, 0x23109, 0x231E6, 0x03B02, 0x23225, 0x23251, 0x2333E, 0x2F8DA, 0x23385, 0x2353F,
+	0x23542, 0x23538, 0x2353A, 0x2354E, 0x23545, 0x23539, 0x23537, 0x23544, 0x2F8E7, 0x235A4, 0x2353D, 0x23541,
+	0x238DF, 0x238DE, 0x238CA, 0x238E9, 0x238E2, 0x238E0, 0x23989, 0x23987, 0x23A32, 0x23A33,
+	// plane 5, row 32
+	0x23AAC, 0x23AF9, 0x23B0D, 0x23BB9, 0x23E5E, 0x23E6A, 0x03D35, 0x23E81, 0x23E69, 0x23EAC, 0x23E80, 0x23E75,
+	0x23E71, 0x23E76, 0x23E6F, 0x242B6, 0x24303, 0x242BF, 0x24301, 0x242B2, 0x242C4, 0x245DB, 0x245DC, 0x245D8,
+	0x29672, 0x246B5, 0x246A8, 0x2468F, 0x2469E, 0x2468E, 0x247DE, 0x247E2, 0x24820, 0x2481D, 0x2481A, 0x24819,
+	0x2482B, 0x24818, 0x24810, 0x2480E, 0x24824, 0x2481F, 0x24813, 0x24835, 0x24983, 0x03EE0, 0x249D7, 0x24B02,
+	0x24B03, 0x24B5C, 0x24B5F, 0x24BC7, 0x24C9A, 0x24C97, 0x24CA3, 0x24DF7, 0x24DC6, 0x24DCC, 0x24DD6, 0x24DCD,
+	0x24DF9, 0x24DD9, 0x24DD8, 0x03F9A, 0x24DD1, 0x24DCE, 0x24DD5, 0x03FA3, 0x24DD4, 0x24DF8, 0x24F7A, 0x24F79,
+	0x24F7F, 0x24FF3, 0x24FF5, 0x24FF6, 0x24FF4, 0x24FF7, 0x04005, 0x25079, 0x2519C, 0x251DF, 0x251F5, 0x251FF,
+	0x251D1, 0x251DA, 0x251CF, 0x251CD, 0x251DE, 0x251D8, 0x23AAD, 0x251E0, 0x251FE, 0x2519E,
+	// plane 5, row 33
+	0x25375, 0x25373, 0x25372, 0x253D8, 0x254D0, 0x254D6, 0x254D7, 0x254CA, 0x254CD, 0x2568A, 0x25689, 0x2568B,
+	0x257D3, 0x257DA, 0x257CE, 0x257D2, 0x0415E, 0x257D4, 0x257D7, 0x257D5, 0x257FB, 0x257CD, 0x257FA, 0x257D6,
+	0x041A6, 0x2599B, 0x2599E, 0x2599D, 0x259A4, 0x2599F, 0x259A5, 0x259A8, 0x25A8F, 0x25A8C, 0x25A95, 0x25B60,
+	0x25B55, 0x25B5C, 0x25B6F, 0x25B51, 0x25B61, 0x25BA7, 0x25B5E, 0x25B62, 0x25B54, 0x25B6E, 0x25B52, 0x25B58,
+	0x25B6D, 0x25B5F, 0x25B6C, 0x25B50, 0x25B5D, 0x25B8E, 0x25E72, 0x25E78, 0x25E75, 0x25E76, 0x25E7C, 0x25E7E,
+	0x25E7D, 0x26015, 0x26014, 0x2601D, 0x2601C, 0x26023, 0x26057, 0x262C8, 0x0435D, 0x262C9, 0x262C5, 0x26387,
+	0x2638A, 0x26394, 0x26439, 0x26440, 0x2643E, 0x26438, 0x264D6, 0x043B8, 0x26577, 0x26578, 0x2657D, 0x266DD,
+	0x2675E, 0x26777, 0x267D4, 0x26766, 0x2675F, 0x26773, 0x26772, 0x26797, 0x2675A, 0x26796,
+	// plane 5, row 34
+	0x2675D, 0x26765, 0x26770, 0x26775, 0x26774, 0x2675C, 0x26760, 0x04434, 0x2676D, 0x26941, 0x26A3C, 0x26A3E,
+	0x0446F, 0x26A49, 0x26BE4, 0x26D0C, 0x26D15, 0x26D0E, 0x26CD4, 0x26CC7, 0x26D14, 0x26CDB, 0x26D5F, 0x26CED,
+	0x26D09, 0x26CCF, 0x26CFF, 0x26D1A, 0x26CF5, 0x26CEE, 0x26CE5, 0x26D0D, 0x26CDF, 0x26CEC, 0x26CD7, 0x26D2C,
+	0x26CCD, 0x26CE3, 0x26D00, 0x26CEB, 0x26CFD, 0x26D0F, 0x26D2D, 0x26D50, 0x26CFE, 0x26CC4, 0x26CE1, 0x26CF9,
+	0x271BB, 0x271BC, 0x04594, 0x04593, 0x271BA, 0x272D0, 0x272D6, 0x272CE, 0x272E1, 0x272D5, 0x272CF, 0x272D3,
+	0x08714, 0x272D4, 0x275B5, 0x27690, 0x276A1, 0x27681, 0x27685, 0x2766E, 0x27688, 0x27682, 0x27686, 0x2768F,
+	0x27689, 0x27680, 0x27684, 0x088D1, 0x27829, 0x2782B, 0x2782A, 0x27830, 0x278E2, 0x278E3, 0x278E1, 0x279D5,
+	0x279C4, 0x279C9, 0x279E0, 0x279DF, 0x279CB, 0x279DD, 0x279E8, 0x279D4, 0x27A1C, 0x27BB5,
+	// plane 5, row 35
+	0x27BB6, 0x27BE5, 0x27C45, 0x27C49, 0x27C40, 0x27C46, 0x27C44, 0x27C4A, 0x27CC9, 0x27CC4, 0x27CC2, 0x27D68,
+	0x08CCB, 0x27D82, 0x27D6B, 0x27D67, 0x27D6C, 0x27D6D, 0x27D71, 0x04792, 0x27ED7, 0x27ED2, 0x27ED9, 0x047AA,
+	0x27EBC, 0x27EDD, 0x27ED6, 0x047A7, 0x27ED8, 0x28020, 0x28016, 0x28018, 0x28015, 0x28019, 0x28027, 0x28050,
+	0x2801D, 0x2802C, 0x2801C, 0x28029, 0x2802B, 0x28024, 0x047EF, 0x28028, 0x28239, 0x282F2, 0x282F0, 0x08EED,
+	0x282ED, 0x282EF, 0x282EA, 0x28301, 0x284BC, 0x2855C, 0x2855B, 0x2855A, 0x28526, 0x28743, 0x28733, 0x2873A,
+	0x28737, 0x28736, 0x2873C, 0x28734, 0x2874A, 0x2873B, 0x28735, 0x2873D, 0x28740, 0x2873E, 0x28717, 0x28826,
+	0x28824, 0x28810, 0x28836, 0x28825, 0x04922, 0x28966, 0x28C7F, 0x28C7A, 0x28CFB, 0x28CFD, 0x28CFC, 0x28CFA,
+	0x28ED6, 0x28ED2, 0x28EC8, 0x28EC2, 0x28EC7, 0x28ECD, 0x28EC1, 0x28EC6, 0x28ECC, 0x28FA1,
+	// plane 5, row 36
+	0x29095, 0x29094, 0x29097, 0x29096, 0x29093, 0x290A1, 0x29209, 0x29208, 0x29267, 0x29265, 0x29262, 0x29261,
+	0x29397, 0x29401, 0x29419, 0x29418, 0x2945C, 0x29467, 0x2946C, 0x04AB5, 0x2F9FD, 0x29466, 0x29461, 0x2946E,
+	0x2945D, 0x2945A, 0x295A2, 0x295A9, 0x295A5, 0x295A6, 0x295B2, 0x295A3, 0x296AC, 0x296AA, 0x296AF, 0x296AB,
+	0x296B1, 0x296C6, 0x296AD, 0x296B9, 0x29870, 0x04B75, 0x29C0C, 0x29C81, 0x29C83, 0x29C7E, 0x29D51, 0x29F99,
+	0x29F94, 0x29F95, 0x2A2FE, 0x2A418, 0x20105, 0x20372, 0x03482, 0x20399, 0x204AD, 0x20606, 0x207C2, 0x207CB,
+	0x207D5, 0x207D2, 0x207CC, 0x207D7, 0x207C5, 0x207CA, 0x052EC, 0x208A2, 0x208A3, 0x208A1, 0x052E8, 0x208A6,
+	0x208A4, 0x208FF, 0x20900, 0x03535, 0x20A06, 0x20A25, 0x20A9F, 0x20B7F, 0x20EEA, 0x20EEB, 0x20EDE, 0x20F18,
+	0x20EE0, 0x20EE8, 0x20EDF, 0x20EF1, 0x20EEC, 0x20F1A, 0x20EE6, 0x20EE1, 0x20F5D, 0x20077,
+	// plane 5, row 37
+	0x20F1E, 0x035F0, 0x20F80, 0x21217, 0x2141D, 0x213F7, 0x213EF, 0x213EE, 0x213ED, 0x213F5, 0x2141E, 0x215BD,
+	0x257FF, 0x215BE, 0x2166D, 0x21676, 0x2184C, 0x21819, 0x21848, 0x2181E, 0x2184A, 0x21849, 0x21826, 0x21995,
+	0x21A85, 0x21A7E, 0x21A97, 0x21A7D, 0x21B42, 0x21B9E, 0x21C0C, 0x21C0B, 0x21C0E, 0x21CCF, 0x21ED8, 0x21EE0,
+	0x21EE8, 0x21EE9, 0x21EE2, 0x21EE1, 0x21EDA, 0x21EE3, 0x21ED9, 0x21EF0, 0x21EE7, 0x21EE4, 0x22123, 0x22131,
+	0x22133, 0x2210C, 0x269E0, 0x22126, 0x22124, 0x22132, 0x22127, 0x22121, 0x22130, 0x221A2, 0x221CC, 0x22296,
+	0x2F88F, 0x22298, 0x2229C, 0x2229B, 0x2236B, 0x22412, 0x22414, 0x2255A, 0x22554, 0x22551, 0x2255E, 0x22558,
+	0x038F0, 0x22559, 0x2279A, 0x227F2, 0x227AD, 0x22820, 0x227F1, 0x227F0, 0x227F3, 0x227F4, 0x227E9, 0x227FE,
+	0x2282E, 0x227E8, 0x227E7, 0x227FF, 0x227F5, 0x229E5, 0x229E7, 0x229E6, 0x229E9, 0x22A58,
+	// plane 5, row 38
+	0x22C6C, 0x22CD9, 0x03A3E, 0x22CCB, 0x03A39, 0x22CBE, 0x22CB5, 0x22CD1, 0x22CC2, 0x22CB6, 0x22CD3, 0x2F8C2,
+	0x22EDA, 0x03AA2, 0x22FAE, 0x03AA1, 0x22FAC, 0x22FA9, 0x22FBA, 0x22FA7, 0x22FB3, 0x22FBD, 0x22FB1, 0x2310E,
+	0x23111, 0x2310F, 0x23252, 0x2326A, 0x23254, 0x23255, 0x23253, 0x23257, 0x2F8D0, 0x2325B, 0x23258, 0x23259,
+	0x03B3B, 0x235B8, 0x235B5, 0x235BF, 0x235C1, 0x235BB, 0x03BB7, 0x235C3, 0x235AD, 0x235A8, 0x235AE, 0x235AA,
+	0x235D0, 0x235B1, 0x235BC, 0x235B7, 0x235B0, 0x235FA, 0x235FB, 0x235B9, 0x23907, 0x238FC, 0x238FF, 0x238F2,
+	0x238F8, 0x238F4, 0x03C57, 0x03C70, 0x23AAE, 0x23B0F, 0x23B10, 0x29AC1, 0x23BC7, 0x23BC6, 0x23BCE, 0x03CA7,
+	0x23BDC, 0x23ED5, 0x23ED3, 0x23F20, 0x23EE2, 0x23EF1, 0x23EDB, 0x23EEA, 0x23EEB, 0x23EE1, 0x23EFF, 0x23ED6,
+	0x23EE0, 0x23ED7, 0x23EE5, 0x03D54, 0x2431B, 0x24310, 0x2430F, 0x2430E, 0x24339, 0x2430A,
+	// plane 5, row 39
+	0x24314, 0x2430C, 0x24523, 0x2455A, 0x245E2, 0x24693, 0x246B0, 0x246AB, 0x246BD, 0x246B1, 0x2468D, 0x246AD,
+	0x246AA, 0x246AC, 0x246BC, 0x246C9, 0x24847, 0x24862, 0x24840, 0x24844, 0x2483F, 0x24843, 0x2483D, 0x24860,
+	0x2485F, 0x2483A, 0x24842, 0x2485E, 0x2485D, 0x249BC, 0x249E0, 0x249EE, 0x249EB, 0x24A0D, 0x24B09, 0x24B0C,
+	0x24B69, 0x24B74, 0x24B67, 0x03F31, 0x07527, 0x24CAD, 0x24DFF, 0x24E16, 0x24E08, 0x24E09, 0x24E00, 0x24DFC,
+	0x24E13, 0x24E14, 0x24E0A, 0x2983E, 0x24E29, 0x24E17, 0x24E3B, 0x24DFB, 0x03FBF, 0x24E0E, 0x03FE4, 0x25008,
+	0x2521B, 0x2521A, 0x25219, 0x25202, 0x0404A, 0x25217, 0x25207, 0x25213, 0x25209, 0x2520A, 0x25212, 0x252AA,
+	0x25238, 0x2537B, 0x2537C, 0x2537A, 0x253EA, 0x253EB, 0x253EF, 0x253F0, 0x25509, 0x25502, 0x25501, 0x2552B,
+	0x25507, 0x040CF, 0x25528, 0x254FB, 0x2552A, 0x254FE, 0x25504, 0x25529, 0x2550A, 0x0798C,
+	// plane 5, row 40
+	0x256C2, 0x07991, 0x256A6, 0x04114, 0x22FC6, 0x21C07, 0x2580A, 0x257FD, 0x25806, 0x2580D, 0x25809, 0x2580B,
+	0x25800, 0x25805, 0x2583D, 0x259D5, 0x259BF, 0x259BA, 0x259C7, 0x25A98, 0x25A9A, 0x0420D, 0x25BA4, 0x25B9C,
+	0x25B9D, 0x25BA2, 0x25B96, 0x25B95, 0x25BA5, 0x25BAA, 0x25BAD, 0x25B9A, 0x25BA3, 0x25B97, 0x25B90, 0x25BAF,
+	0x25B8D, 0x04201, 0x25BA8, 0x25BEE, 0x25EA3, 0x25E99, 0x25EA1, 0x25E9C, 0x25EA4, 0x25ECB, 0x26056, 0x26048,
+	0x26059, 0x2604A, 0x2605C, 0x042F1, 0x2604F, 0x2604D, 0x2605D, 0x2623A, 0x262E3, 0x262E1, 0x262DE, 0x26397,
+	0x2639A, 0x26448, 0x2644A, 0x2644C, 0x2644E, 0x2644F, 0x2644B, 0x2644D, 0x26447, 0x26451, 0x264DA, 0x264F9,
+	0x264FC, 0x264FB, 0x264FD, 0x264F8, 0x264FA, 0x2658A, 0x2658E, 0x26588, 0x26589, 0x2658D, 0x26590, 0x2F97E,
+	0x2671C, 0x267AB, 0x267A4, 0x267A8, 0x267AD, 0x2679F, 0x2679A, 0x267B0, 0x267A5, 0x267D5,
+	// plane 5, row 41
+	0x267A2, 0x267B2, 0x2679D, 0x267A1, 0x26915, 0x26942, 0x26973, 0x26984, 0x269DD, 0x26A5C, 0x26A4C, 0x04476,
+	0x26A4B, 0x26C67, 0x26DA8, 0x26D7F, 0x26D8D, 0x26D8B, 0x26DF7, 0x26DA9, 0x26DF4, 0x26D9A, 0x26D8C, 0x26D95,
+	0x26D7E, 0x26D77, 0x0450A, 0x04503, 0x26D71, 0x26DFD, 0x26D88, 0x26D99, 0x26D6D, 0x26DD4, 0x26D91, 0x26D7D,
+	0x26D63, 0x26D75, 0x26DF9, 0x26D8E, 0x26D74, 0x26DD9, 0x26D66, 0x26DFA, 0x26DF5, 0x08660, 0x271C1, 0x271C9,
+	0x27303, 0x2732C, 0x27334, 0x27317, 0x27309, 0x27304, 0x27306, 0x2731A, 0x2730E, 0x27327, 0x27305, 0x27307,
+	0x27319, 0x27314, 0x2730C, 0x2731D, 0x27322, 0x27323, 0x275BB, 0x275BC, 0x275BA, 0x276A9, 0x276B5, 0x276AC,
+	0x276AA, 0x276AF, 0x276B7, 0x04635, 0x04636, 0x2783D, 0x27845, 0x27848, 0x2783C, 0x2783E, 0x278EC, 0x278ED,
+	0x278E9, 0x27A0A, 0x27A0B, 0x279F8, 0x27A04, 0x279F6, 0x279F9, 0x27C53, 0x27C52, 0x27C60,
+	// plane 5, row 42
+	0x27C63, 0x27CCE, 0x27CCD, 0x27CD0, 0x27CCC, 0x27D87, 0x27D8D, 0x27D9D, 0x04773, 0x27E63, 0x27EF4, 0x27EF1,
+	0x27EFF, 0x27EF5, 0x27EFC, 0x27EF2, 0x27EF6, 0x047B1, 0x27EFA, 0x047AF, 0x2802E, 0x28045, 0x28043, 0x28051,
+	0x2804C, 0x28073, 0x28047, 0x2804B, 0x2804F, 0x047F4, 0x28044, 0x2804D, 0x2804E, 0x2804A, 0x28041, 0x28052,
+	0x2809F, 0x28054, 0x28059, 0x280A2, 0x2824B, 0x28248, 0x2830D, 0x2831F, 0x28308, 0x2830E, 0x2831D, 0x28304,
+	0x28418, 0x2841B, 0x28566, 0x28562, 0x28583, 0x28567, 0x28762, 0x2874F, 0x2874E, 0x28767, 0x28753, 0x2874D,
+	0x2874C, 0x28768, 0x28751, 0x048DC, 0x28758, 0x2875B, 0x28750, 0x2876A, 0x28839, 0x28838, 0x28982, 0x28984,
+	0x28986, 0x28C80, 0x28C86, 0x28D25, 0x28D11, 0x04999, 0x28D0E, 0x28D15, 0x28D0F, 0x28D18, 0x28D0D, 0x28D13,
+	0x28D16, 0x28D19, 0x28F08, 0x28EF7, 0x28EFB, 0x28EFC, 0x28FB4, 0x28FBE, 0x28FC1, 0x290A6,
+	// plane 5, row 43
+	0x290A5, 0x290A2, 0x290A4, 0x29212, 0x2920E, 0x2927A, 0x29278, 0x2927D, 0x2927E, 0x29281, 0x29283, 0x29280,
+	0x29282, 0x2939B, 0x29399, 0x2939C, 0x2939A, 0x293A1, 0x2941A, 0x2941C, 0x2948A, 0x29479, 0x29473, 0x29475,
+	0x2947C, 0x29478, 0x29460, 0x29483, 0x29470, 0x04AB6, 0x04ABD, 0x29487, 0x29484, 0x2947B, 0x2947E, 0x29474,
+	0x295B9, 0x295B8, 0x295B7, 0x295B5, 0x296CC, 0x296CE, 0x296D1, 0x09904, 0x296CB, 0x0999B, 0x2987E, 0x2987A,
+	0x29877, 0x2988C, 0x298E1, 0x29A20, 0x29A1E, 0x29AC2, 0x29B0F, 0x29B00, 0x04BF4, 0x29B10, 0x29C0D, 0x29C0E,
+	0x29C8E, 0x29C8D, 0x29C8A, 0x29C8B, 0x29C8C, 0x29C8F, 0x29D57, 0x04C35, 0x29D55, 0x29D5B, 0x29FAC, 0x29FA7,
+	0x29FA0, 0x29F9E, 0x04CAA, 0x2A28D, 0x04D1F, 0x2A393, 0x2A394, 0x2A392, 0x20181, 0x203D6, 0x203C3, 0x203E4,
+	0x203E2, 0x20409, 0x203BF, 0x203C8, 0x203C7, 0x204B5, 0x204B2, 0x2060C, 0x2060B, 0x2060A,
+	// plane 5, row 44
+	0x20605, 0x207D3, 0x207E6, 0x207E9, 0x207F0, 0x207EA, 0x207E7, 0x208B1, 0x208B3, 0x208B4, 0x208B0, 0x2095C,
+	0x2095D, 0x2095E, 0x209C0, 0x20AAF, 0x03550, 0x20B86, 0x20B83, 0x20B84, 0x20F5C, 0x20F70, 0x20F8C, 0x20F7B,
+	0x20F66, 0x20F79, 0x20F63, 0x20FC5, 0x20F6B, 0x20F6D, 0x20F72, 0x20F69, 0x20F75, 0x20F89, 0x20FC6, 0x20FA5,
+	0x203E7, 0x20F6A, 0x20F97, 0x2145A, 0x2145C, 0x21459, 0x21424, 0x21425, 0x21430, 0x21458, 0x21431, 0x21576,
+	0x215C1, 0x21685, 0x2167D, 0x21865, 0x21887, 0x21852, 0x21854, 0x2188A, 0x21850, 0x21886, 0x2184F, 0x21868,
+	0x2199D, 0x21AA0, 0x21ABA, 0x21ABD, 0x21AB8, 0x21BA6, 0x0377B, 0x21CDE, 0x21CD8, 0x21CD1, 0x21F13, 0x03809,
+	0x21F0E, 0x21F1B, 0x21F3A, 0x03807, 0x21F1C, 0x21F12, 0x21F16, 0x21F1A, 0x21448, 0x2214B, 0x22137, 0x22136,
+	0x22138, 0x2213A, 0x22149, 0x2213C, 0x2214A, 0x222B1, 0x222C2, 0x222B5, 0x222C4, 0x222B6,
+	// plane 5, row 45
+	0x2241E, 0x2241F, 0x224AF, 0x2256B, 0x2256F, 0x22792, 0x227E4, 0x2282C, 0x227EF, 0x06184, 0x2284F, 0x22852,
+	0x22850, 0x2283A, 0x22837, 0x22847, 0x22864, 0x22840, 0x2283C, 0x22845, 0x22841, 0x229F3, 0x039BB, 0x22CC1,
+	0x22CB4, 0x03A4B, 0x22D2A, 0x22D22, 0x22D29, 0x03A4D, 0x22D3E, 0x22D3C, 0x22D30, 0x22FC7, 0x22FD5, 0x22FD6,
+	0x22FD3, 0x22FCE, 0x22FC8, 0x2305C, 0x2305F, 0x23062, 0x03AC2, 0x230BD, 0x230BF, 0x2325C, 0x232B6, 0x03B1A,
+	0x2329D, 0x2327F, 0x03B12, 0x23294, 0x23281, 0x23347, 0x23346, 0x03B3C, 0x23394, 0x23675, 0x2361A, 0x2362A,
+	0x23632, 0x23617, 0x23623, 0x23674, 0x03BC3, 0x23676, 0x2362E, 0x23625, 0x23620, 0x23671, 0x23616, 0x23670,
+	0x2362C, 0x2362F, 0x2361F, 0x23664, 0x03BC0, 0x23908, 0x23914, 0x2390A, 0x2390B, 0x23A4F, 0x23A59, 0x23A54,
+	0x23A51, 0x23AB6, 0x23BEC, 0x23BED, 0x23BEA, 0x23EEE, 0x23F5A, 0x23F73, 0x23F65, 0x23F61,
+	// plane 5, row 46
+	0x23F55, 0x23F6B, 0x23F64, 0x23F5B, 0x23F4C, 0x23F6F, 0x23F84, 0x23F70, 0x2435D, 0x24357, 0x24366, 0x24353,
+	0x24398, 0x24397, 0x24528, 0x245EB, 0x245EA, 0x245E8, 0x245EC, 0x245EF, 0x246D1, 0x246CA, 0x0729F, 0x246CF,
+	0x246CD, 0x246CE, 0x24870, 0x24867, 0x24873, 0x2486D, 0x24876, 0x24879, 0x2486A, 0x2488B, 0x24872, 0x24871,
+	0x2486E, 0x2487A, 0x24A16, 0x24A39, 0x24A3B, 0x24B10, 0x24B96, 0x24B7B, 0x24B7C, 0x24B7D, 0x24CBE, 0x24E5C,
+	0x24E38, 0x24E39, 0x24E2E, 0x24E34, 0x24E32, 0x24E5B, 0x24E33, 0x24E3C, 0x03FE5, 0x2500E, 0x2500F, 0x25010,
+	0x25087, 0x2525B, 0x25253, 0x0405F, 0x2525C, 0x2523F, 0x25259, 0x2524A, 0x25244, 0x2524C, 0x25240, 0x2525F,
+	0x2525E, 0x2524E, 0x25254, 0x25243, 0x25287, 0x25382, 0x25385, 0x25531, 0x25547, 0x2552E, 0x2552F, 0x25548,
+	0x25534, 0x04118, 0x2059F, 0x25832, 0x25836, 0x25833, 0x25831, 0x25840, 0x25841, 0x259DD,
+	// plane 5, row 47
+	0x259D2, 0x259D9, 0x041AD, 0x259DF, 0x259DB, 0x259D8, 0x259D3, 0x259DE, 0x259E0, 0x259D4, 0x259D7, 0x259DA,
+	0x25AA7, 0x25BFE, 0x25BDD, 0x25C40, 0x25BE2, 0x0421E, 0x25BD6, 0x25BDE, 0x25BEF, 0x04227, 0x25BEB, 0x25BEA,
+	0x25BE4, 0x25BD1, 0x04218, 0x25BEC, 0x25BFA, 0x25BD9, 0x25BF3, 0x25BE1, 0x25BDC, 0x25BE5, 0x25BDF, 0x25BD4,
+	0x04220, 0x25C05, 0x25ED6, 0x25ED5, 0x25ED0, 0x25EC8, 0x25EC4, 0x25EC9, 0x25ECD, 0x25ED2, 0x25ECC, 0x25ECA,
+	0x260A0, 0x26092, 0x042F4, 0x04302, 0x2608B, 0x260A1, 0x26095, 0x26088, 0x26086, 0x2608D, 0x26085, 0x26091,
+	0x26089, 0x260A2, 0x262F0, 0x262F3, 0x262F4, 0x262EF, 0x26306, 0x263A2, 0x2645E, 0x26463, 0x26460, 0x2645B,
+	0x2647B, 0x26458, 0x26459, 0x2645D, 0x26505, 0x26506, 0x26502, 0x26503, 0x26504, 0x265A2, 0x265A7, 0x265A6,
+	0x265A4, 0x265AC, 0x265A9, 0x267E7, 0x26801, 0x267DF, 0x267D9, 0x267E3, 0x2680F, 0x2691A,
+	// plane 5, row 48
+	0x26945, 0x26948, 0x2697B, 0x26985, 0x26986, 0x269FE, 0x269FF, 0x26A64, 0x26A71, 0x26A72, 0x26A61, 0x26A62,
+	0x26A6C, 0x26A60, 0x26A5D, 0x26A63, 0x26A67, 0x26E01, 0x26E2A, 0x26E30, 0x26E06, 0x26E7D, 0x26E22, 0x26EB9,
+	0x26E0A, 0x26E10, 0x26D8F, 0x26E80, 0x26E13, 0x26E2E, 0x26E18, 0x26E17, 0x26E1A, 0x26E14, 0x26E47, 0x26E63,
+	0x26E38, 0x04524, 0x26E7F, 0x26E21, 0x26E37, 0x26E31, 0x26E1B, 0x26EA5, 0x26F6C, 0x271D3, 0x27388, 0x2736E,
+	0x2735C, 0x27374, 0x27354, 0x27383, 0x08770, 0x27365, 0x27360, 0x27370, 0x2736F, 0x2736D, 0x27372, 0x2736C,
+	0x27376, 0x27375, 0x27359, 0x273C7, 0x275C1, 0x275F9, 0x276E3, 0x276E7, 0x276D6, 0x276CF, 0x276DA, 0x276E9,
+	0x276D2, 0x27855, 0x2784F, 0x2784D, 0x27851, 0x27856, 0x278F9, 0x278F8, 0x27906, 0x27903, 0x27909, 0x278F7,
+	0x278F5, 0x27A47, 0x27A45, 0x27A3E, 0x27A46, 0x27A29, 0x27A34, 0x27A3F, 0x27A44, 0x27A35,
+	// plane 5, row 49
+	0x27A5A, 0x27A2A, 0x27A33, 0x27A37, 0x27A43, 0x27A39, 0x27A30, 0x27A5D, 0x27A32, 0x27A27, 0x27BBD, 0x27BBA,
+	0x27BBC, 0x27BF1, 0x27BF0, 0x27C61, 0x27C5C, 0x27C58, 0x27C5D, 0x27CDA, 0x27CD9, 0x27CDD, 0x27CDC, 0x27CDE,
+	0x0477D, 0x27DA0, 0x27DA2, 0x27F0F, 0x27F13, 0x27F12, 0x27F11, 0x27F14, 0x27F19, 0x27F0E, 0x27F17, 0x27F21,
+	0x27F20, 0x27F16, 0x28078, 0x08E37, 0x28087, 0x28079, 0x28080, 0x28077, 0x28081, 0x28046, 0x2807A, 0x2809C,
+	0x28083, 0x28084, 0x280AD, 0x2809D, 0x2809E, 0x28255, 0x28336, 0x2832B, 0x28327, 0x28346, 0x2832C, 0x28345,
+	0x28333, 0x2832D, 0x28334, 0x28322, 0x04864, 0x2841F, 0x285B3, 0x2858C, 0x2858F, 0x285AF, 0x285AD, 0x2858E,
+	0x285AC, 0x285B0, 0x285B1, 0x285AE, 0x28599, 0x286EB, 0x28774, 0x28775, 0x2878E, 0x2876D, 0x28770, 0x2878C,
+	0x2884F, 0x28851, 0x28858, 0x2884C, 0x2884E, 0x28915, 0x289BE, 0x289DB, 0x289B3, 0x289AE,
+	// plane 5, row 50
+	0x28C87, 0x28C8A, 0x28C88, 0x28C8B, 0x28C8C, 0x28D44, 0x28D2D, 0x28D2A, 0x28D31, 0x28D2C, 0x28D45, 0x28D30,
+	0x28D29, 0x28D46, 0x28EF4, 0x28F14, 0x28F10, 0x28F0F, 0x28F12, 0x28F0B, 0x28F0C, 0x28F0A, 0x28F13, 0x28F0E,
+	0x28FD9, 0x28FD0, 0x09703, 0x290C1, 0x290BF, 0x290BD, 0x290BC, 0x290BA, 0x290BB, 0x290D1, 0x290BE, 0x290D0,
+	0x290B9, 0x2921A, 0x2921C, 0x2921B, 0x29291, 0x29296, 0x2929F, 0x2929C, 0x2929A, 0x2929D, 0x293AD, 0x293A5,
+	0x293AE, 0x29403, 0x29426, 0x29420, 0x29423, 0x29424, 0x29421, 0x29428, 0x29425, 0x2941E, 0x29494, 0x29493,
+	0x2948F, 0x2949A, 0x294AD, 0x04AC2, 0x295CA, 0x295C5, 0x296BB, 0x296E1, 0x296EA, 0x296E4, 0x296ED, 0x296E6,
+	0x296E0, 0x296E8, 0x296E5, 0x2981A, 0x29894, 0x29896, 0x2989E, 0x29895, 0x298A1, 0x2988E, 0x2989B, 0x29892,
+	0x29897, 0x29899, 0x29893, 0x29A32, 0x29A2F, 0x29A2E, 0x29A33, 0x29B1C, 0x29B1E, 0x29B11,
+	// plane 5, row 51
+	0x29B20, 0x29B1F, 0x29B19, 0x29B16, 0x29CAC, 0x29CB9, 0x29CB3, 0x29CA6, 0x29CA2, 0x29CA9, 0x29CA7, 0x29D6C,
+	0x29D6D, 0x29D69, 0x29D80, 0x29D66, 0x29D65, 0x29D71, 0x29D6B, 0x29FBD, 0x29FC1, 0x29FD3, 0x29FB6, 0x04CAC,
+	0x29FB9, 0x29FD4, 0x29FB7, 0x29FDB, 0x29FB8, 0x29FC0, 0x29FBC, 0x29FD5, 0x29FBF, 0x29FC3, 0x29FC9, 0x2A258,
+	0x2A290, 0x2A295, 0x2A297, 0x2A309, 0x2A308, 0x2A306, 0x2A305, 0x2A310, 0x2A397, 0x2A395, 0x2A3EE, 0x2A41F,
+	0x2FA1A, 0x21B4B, 0x2018E, 0x203E8, 0x203F0, 0x203F4, 0x20406, 0x203ED, 0x0349B, 0x203E9, 0x20400, 0x204B8,
+	0x2056D, 0x20804, 0x20801, 0x20803, 0x20802, 0x052F6, 0x03526, 0x20962, 0x20ABA, 0x20B13, 0x20FDE, 0x20FD7,
+	0x20FE4, 0x20FCE, 0x20FE3, 0x20FDD, 0x2103A, 0x20FDB, 0x20FD6, 0x20FE0, 0x20FD4, 0x20FCB, 0x20FE1, 0x20FC9,
+	0x20FDF, 0x2103C, 0x20FFC, 0x20FCF, 0x21479, 0x21476, 0x21478, 0x21687, 0x2189C, 0x218B1,
+	// plane 5, row 52
+	0x218C1, 0x2189A, 0x21892, 0x041AF, 0x218C2, 0x21896, 0x218C0, 0x21891, 0x21895, 0x219A2, 0x21AC3, 0x21AC0,
+	0x21AC2, 0x20B8A, 0x21BB0, 0x21C16, 0x21F46, 0x21F4A, 0x21F3E, 0x21F45, 0x21F42, 0x03815, 0x21F5B, 0x21F44,
+	0x22004, 0x24022, 0x22155, 0x22157, 0x22151, 0x2214E, 0x2215A, 0x222C6, 0x222C3, 0x0389C, 0x222C5, 0x222CC,
+	0x22371, 0x22426, 0x224B2, 0x2257A, 0x22584, 0x2257B, 0x22874, 0x2288C, 0x22851, 0x22848, 0x2288D, 0x22892,
+	0x22898, 0x22893, 0x228B0, 0x2284E, 0x22896, 0x22897, 0x2289C, 0x228CA, 0x22D33, 0x22D83, 0x22D84, 0x22D9A,
+	0x22D8C, 0x064CC, 0x22D99, 0x22EE0, 0x22FE4, 0x22FF8, 0x22FED, 0x22FF3, 0x22FF4, 0x22FF5, 0x22FFD, 0x23119,
+	0x2329E, 0x232C4, 0x2329F, 0x2339B, 0x2339F, 0x2339A, 0x236AA, 0x2369D, 0x23692, 0x236A2, 0x236AF, 0x236EB,
+	0x236A0, 0x236A1, 0x23694, 0x23698, 0x2368F, 0x23687, 0x23684, 0x236A9, 0x2367C, 0x23918,
+	// plane 5, row 53
+	0x23A5F, 0x23A62, 0x23A61, 0x23AC0, 0x23B15, 0x23BFC, 0x23BF9, 0x23FC8, 0x23FC9, 0x23FDE, 0x23FCA, 0x23FE2,
+	0x03D64, 0x03D6E, 0x24004, 0x23FCC, 0x23FDD, 0x23FE4, 0x23FD3, 0x23FC7, 0x23FC6, 0x03D5F, 0x24037, 0x243D8,
+	0x243EE, 0x243B2, 0x243A3, 0x243B3, 0x243ED, 0x243F8, 0x24531, 0x2455B, 0x24566, 0x24569, 0x24596, 0x2459B,
+	0x245F7, 0x245F3, 0x245F4, 0x245F5, 0x246E2, 0x246E7, 0x246E5, 0x246E9, 0x246E6, 0x246E3, 0x24874, 0x2487F,
+	0x2488F, 0x24890, 0x24897, 0x248A3, 0x2488E, 0x24898, 0x2488C, 0x24A42, 0x24A44, 0x24A69, 0x24A43, 0x24A68,
+	0x03EF6, 0x24B8D, 0x24B88, 0x24B8B, 0x24B89, 0x24CCC, 0x24D1F, 0x24E80, 0x24E5E, 0x24E67, 0x24E68, 0x24E65,
+	0x0764A, 0x24E74, 0x24E69, 0x24E61, 0x24E62, 0x24E6C, 0x24E93, 0x24E86, 0x24F9D, 0x24F9B, 0x2501B, 0x25016,
+	0x25019, 0x25014, 0x25018, 0x25015, 0x25099, 0x25098, 0x2525D, 0x25289, 0x2527A, 0x2527D,
+	// plane 5, row 54
+	0x2524B, 0x04073, 0x25278, 0x2527F, 0x25277, 0x2527E, 0x25279, 0x252AB, 0x2527C, 0x25274, 0x25275, 0x252A7,
+	0x2538D, 0x2554E, 0x25566, 0x25561, 0x2554D, 0x2554F, 0x040DD, 0x25554, 0x2557C, 0x256DA, 0x256DE, 0x256D8,
+	0x256DD, 0x256DF, 0x25866, 0x25862, 0x2585F, 0x25864, 0x25863, 0x25860, 0x25888, 0x2586A, 0x25867, 0x25887,
+	0x2583F, 0x2586C, 0x2586E, 0x258AD, 0x259F1, 0x259F4, 0x259F6, 0x259F5, 0x259F8, 0x259FB, 0x259EC, 0x259EF,
+	0x259ED, 0x07AB9, 0x259F7, 0x259F9, 0x259FD, 0x25AB1, 0x25C36, 0x25C1B, 0x25C32, 0x25C42, 0x25C4D, 0x25C1F,
+	0x25C21, 0x25C1C, 0x25C31, 0x25C2E, 0x25C47, 0x25C3B, 0x25C41, 0x25C18, 0x25C39, 0x25C1D, 0x25C27, 0x25C23,
+	0x07BD7, 0x25EEE, 0x25EE8, 0x25EE5, 0x25EEF, 0x25EE4, 0x25EEC, 0x25EF0, 0x260D7, 0x260D8, 0x260D4, 0x260CA,
+	0x260D2, 0x260CB, 0x260D3, 0x260E6, 0x260E2, 0x26249, 0x26248, 0x262FF, 0x26309, 0x263B0,
+	// plane 5, row 55
+	0x263AF, 0x2647C, 0x26478, 0x2647A, 0x26472, 0x26479, 0x2647E, 0x2650C, 0x2650B, 0x265CD, 0x265BE, 0x265BC,
+	0x265BF, 0x265C0, 0x265BD, 0x26838, 0x26808, 0x26805, 0x2683B, 0x26810, 0x2680C, 0x2680D, 0x26804, 0x2683A,
+	0x26813, 0x26837, 0x26839, 0x2691F, 0x2694B, 0x269EE, 0x26A75, 0x26A78, 0x26A7C, 0x26A74, 0x26A76, 0x26AEA,
+	0x26AEB, 0x26DFB, 0x26E19, 0x26EA7, 0x26E8C, 0x26EDC, 0x26E98, 0x26EBE, 0x26E9E, 0x26F0F, 0x26E9F, 0x26EDD,
+	0x26E93, 0x26EBB, 0x26EB6, 0x26E90, 0x26EA1, 0x26EBD, 0x26EDE, 0x26E3A, 0x26F22, 0x26E97, 0x26E94, 0x26EC3,
+	0x26E8E, 0x26EA8, 0x26E99, 0x26EAD, 0x26E9B, 0x26EA2, 0x26F21, 0x26EAC, 0x26F0E, 0x26F31, 0x271E1, 0x271DE,
+	0x271DF, 0x271DC, 0x271DD, 0x271EC, 0x271E7, 0x27369, 0x273B8, 0x273A1, 0x273A8, 0x273BA, 0x273C2, 0x273A6,
+	0x273A4, 0x273A3, 0x273AB, 0x273BC, 0x273B7, 0x273BF, 0x273AD, 0x273B1, 0x273CA, 0x273C4,
+	// plane 5, row 56
+	0x273B9, 0x275C8, 0x275C6, 0x275C7, 0x2770A, 0x2770D, 0x276FB, 0x27703, 0x27702, 0x276FC, 0x276F9, 0x276F8,
+	0x2786E, 0x27863, 0x27862, 0x27861, 0x2786B, 0x04688, 0x2786F, 0x27866, 0x2786C, 0x2790E, 0x27915, 0x27916,
+	0x27910, 0x27917, 0x27911, 0x27A6E, 0x27A6C, 0x27A87, 0x27A83, 0x27A63, 0x27ADC, 0x27BC0, 0x27BF7, 0x27BFA,
+	0x27C70, 0x27C6A, 0x27C68, 0x27C69, 0x27C84, 0x27CEC, 0x27CE7, 0x27CEE, 0x27DBA, 0x27DB2, 0x27DB5, 0x27DCB,
+	0x2080B, 0x27DD0, 0x27E6D, 0x27E6C, 0x047BB, 0x27F45, 0x27F46, 0x27F34, 0x27F2C, 0x27F35, 0x27F44, 0x047BA,
+	0x27F76, 0x280B1, 0x280AA, 0x280A1, 0x280B2, 0x04812, 0x280A6, 0x280B5, 0x280B4, 0x280B8, 0x280AF, 0x280B0,
+	0x280A3, 0x28262, 0x28264, 0x28356, 0x28351, 0x2834F, 0x28368, 0x2834C, 0x28350, 0x04861, 0x28348, 0x2834A,
+	0x28421, 0x285E3, 0x285B9, 0x285DE, 0x285B7, 0x285E1, 0x285B6, 0x285B5, 0x285DF, 0x048E1,
+	// plane 5, row 57
+	0x28797, 0x2879A, 0x2879B, 0x28798, 0x28792, 0x28793, 0x287D7, 0x28773, 0x2886B, 0x28874, 0x28878, 0x2886D,
+	0x28918, 0x289E9, 0x289F5, 0x289EA, 0x28A2E, 0x289E7, 0x289FE, 0x289E5, 0x28A36, 0x289F0, 0x289E6, 0x28A2C,
+	0x28C89, 0x28C95, 0x28C93, 0x28D4D, 0x28D4A, 0x28D4F, 0x28D50, 0x28D4B, 0x28F2A, 0x28F2B, 0x28F2F, 0x28F2E,
+	0x28F7C, 0x28FED, 0x28FE2, 0x290E0, 0x290DC, 0x290DA, 0x290D6, 0x290F4, 0x290D9, 0x290D5, 0x29222, 0x29221,
+	0x29224, 0x29225, 0x29226, 0x29223, 0x292AA, 0x292AF, 0x292B0, 0x292AB, 0x293AF, 0x293B7, 0x293B5, 0x293B2,
+	0x293B3, 0x2942B, 0x294D8, 0x294C2, 0x294AF, 0x294BC, 0x294B8, 0x294BE, 0x294B7, 0x294B4, 0x294BF, 0x294B3,
+	0x294B1, 0x294BB, 0x294BD, 0x294D6, 0x294DD, 0x295D8, 0x295D3, 0x295D5, 0x295E3, 0x295E2, 0x295D9, 0x295DE,
+	0x295DF, 0x295DA, 0x295D4, 0x296F3, 0x296E2, 0x2970D, 0x29701, 0x29705, 0x2971A, 0x29703,
+	// plane 5, row 58
+	0x2971F, 0x29716, 0x296FA, 0x296FC, 0x2970A, 0x0999F, 0x298BC, 0x298CA, 0x298B6, 0x298C7, 0x298BF, 0x04B89,
+	0x298B9, 0x04B8C, 0x298B0, 0x298B8, 0x298BD, 0x29891, 0x298BB, 0x298BE, 0x29A3E, 0x29A3D, 0x29B38, 0x29B3D,
+	0x29B39, 0x29B33, 0x29C33, 0x29CBB, 0x29CC6, 0x29CC5, 0x29CC7, 0x29CCB, 0x29CA8, 0x29CC8, 0x29CBE, 0x29CC1,
+	0x29CBD, 0x29D82, 0x29D9E, 0x29D81, 0x29D84, 0x09B8D, 0x29D96, 0x29D8E, 0x29D88, 0x29D87, 0x29FE0, 0x2A00D,
+	0x29FDF, 0x29FE4, 0x29FE2, 0x29FDD, 0x29FEC, 0x29FDE, 0x29FE7, 0x29FEA, 0x29FE3, 0x2A25C, 0x09E77, 0x2A25D,
+	0x2A29C, 0x2A31D, 0x2A39D, 0x2A39E, 0x2A39B, 0x2A3B5, 0x2A3B9, 0x2A3B6, 0x2A3F3, 0x2A3F2, 0x2A3F4, 0x2A426,
+	0x2A427, 0x2A425, 0x2A506, 0x2A50B, 0x2A53A, 0x2A53C, 0x2040A, 0x2040B, 0x204BF, 0x204FD, 0x20618, 0x291DF,
+	0x25390, 0x2069E, 0x207FC, 0x20810, 0x2080F, 0x2080D, 0x208B9, 0x208B7, 0x208BA, 0x2090A,
+	// plane 5, row 59
+	0x21041, 0x2108B, 0x21046, 0x21053, 0x210E2, 0x2103F, 0x20F7C, 0x2104B, 0x2104E, 0x2108A, 0x21047, 0x21493,
+	0x27DCE, 0x2148C, 0x214AF, 0x214C9, 0x215C6, 0x215C8, 0x21691, 0x218CF, 0x218D4, 0x218CE, 0x21ADD, 0x21AD4,
+	0x21F49, 0x21F63, 0x21F5D, 0x0381A, 0x21F67, 0x21FBB, 0x21F60, 0x21F80, 0x2215F, 0x22160, 0x222DA, 0x222D2,
+	0x222DD, 0x2258E, 0x22588, 0x2256C, 0x2289E, 0x228C9, 0x228A4, 0x03998, 0x228D9, 0x228D2, 0x228DA, 0x228DD,
+	0x228CE, 0x228FC, 0x22A14, 0x22A60, 0x22DD7, 0x22DCB, 0x22DCD, 0x22DD5, 0x22EE7, 0x23000, 0x2300A, 0x2300F,
+	0x23002, 0x23001, 0x2311D, 0x232CA, 0x232CB, 0x232CD, 0x2334F, 0x2339C, 0x23680, 0x2370D, 0x23702, 0x03BF1,
+	0x23707, 0x236F7, 0x236F8, 0x236FD, 0x23724, 0x236FB, 0x23739, 0x2373A, 0x23922, 0x06BA9, 0x23A6A, 0x23A6D,
+	0x23A74, 0x23C0E, 0x23FDF, 0x2402B, 0x23FE3, 0x24026, 0x2402A, 0x24023, 0x24035, 0x2404A,
+	// plane 5, row 60
+	0x243FD, 0x24400, 0x2441E, 0x243FF, 0x2459E, 0x245FA, 0x246F1, 0x24895, 0x248A8, 0x248A6, 0x24A6E, 0x24A83,
+	0x24A6D, 0x24B8F, 0x03F6A, 0x24CDF, 0x24CD5, 0x24CE0, 0x24CD3, 0x24CD8, 0x24E8C, 0x0764B, 0x24E94, 0x24E96,
+	0x24E85, 0x24E8F, 0x24EA9, 0x24E8E, 0x24E90, 0x24EB8, 0x24EC3, 0x24EBB, 0x24FA5, 0x24FA2, 0x076A2, 0x24FA3,
+	0x250B5, 0x250AC, 0x250A8, 0x252AD, 0x252B8, 0x252B4, 0x252AE, 0x252B6, 0x04485, 0x252C1, 0x252BF, 0x25392,
+	0x25400, 0x25401, 0x25575, 0x25572, 0x25578, 0x25570, 0x2557E, 0x2557D, 0x2557F, 0x256EF, 0x2586D, 0x2588E,
+	0x2588F, 0x2588A, 0x2588D, 0x25895, 0x2588B, 0x2588C, 0x258B0, 0x25893, 0x259FC, 0x25A15, 0x25A0E, 0x25A18,
+	0x25A11, 0x25A0D, 0x25ABB, 0x25AB3, 0x04239, 0x25CA1, 0x25C9A, 0x25C84, 0x25CA0, 0x25C86, 0x25C9D, 0x25CAA,
+	0x25C78, 0x25C8D, 0x25C8A, 0x25CA6, 0x25C7A, 0x25C97, 0x25C88, 0x25C8E, 0x0423D, 0x04244,
+	// plane 5, row 61
+	0x25C76, 0x25C81, 0x25C85, 0x25C75, 0x25CA8, 0x25C8F, 0x25C91, 0x25CA2, 0x25C9C, 0x25C89, 0x25C7F, 0x25C96,
+	0x25C79, 0x25C9F, 0x25CA7, 0x04246, 0x25C87, 0x25C9B, 0x25CA5, 0x25C8B, 0x25CC9, 0x25EFF, 0x25F03, 0x25F00,
+	0x25F02, 0x25F04, 0x25F05, 0x25EE6, 0x25F1F, 0x26114, 0x0430E, 0x2610C, 0x2610F, 0x26119, 0x2610B, 0x26113,
+	0x260D5, 0x04310, 0x2611C, 0x26250, 0x2624E, 0x26312, 0x263B9, 0x26485, 0x26488, 0x26490, 0x26513, 0x265D0,
+	0x265D1, 0x265D2, 0x265D7, 0x265D6, 0x265E5, 0x26846, 0x2686B, 0x26845, 0x2683F, 0x2683E, 0x0443E, 0x2686A,
+	0x26868, 0x2684C, 0x26923, 0x26922, 0x26997, 0x26991, 0x25AB5, 0x26998, 0x2699C, 0x26A89, 0x04484, 0x26A8B,
+	0x26A8A, 0x26A8D, 0x26A8E, 0x26A88, 0x26F4E, 0x26F44, 0x26F37, 0x26F75, 0x26F54, 0x26F76, 0x26F34, 0x26F6B,
+	0x26F32, 0x26F57, 0x26F52, 0x26F45, 0x04549, 0x26EB1, 0x26F4B, 0x26F47, 0x26F33, 0x26F40,
+	// plane 5, row 62
+	0x26F3C, 0x26F43, 0x26F4F, 0x26F55, 0x26F41, 0x26FB2, 0x26FC0, 0x27434, 0x273F9, 0x27419, 0x2740D, 0x273FA,
+	0x2741E, 0x2741F, 0x2740E, 0x27440, 0x27408, 0x045DF, 0x27413, 0x2744C, 0x045F0, 0x275CC, 0x275CB, 0x27724,
+	0x27725, 0x2773D, 0x27720, 0x27727, 0x27726, 0x2771D, 0x2771E, 0x27732, 0x2776C, 0x27759, 0x0468A, 0x2787A,
+	0x27879, 0x2791B, 0x2792E, 0x27923, 0x27920, 0x2791F, 0x27AB6, 0x27AB9, 0x27AA1, 0x27AA3, 0x27AA8, 0x27AAF,
+	0x27A9A, 0x27A99, 0x27AA2, 0x27A9D, 0x04702, 0x27AAB, 0x27BC9, 0x27BFE, 0x27C00, 0x27BFC, 0x27C07, 0x27C75,
+	0x27C72, 0x27C73, 0x27C74, 0x27CFA, 0x27CFC, 0x27CF8, 0x27CF6, 0x27CFB, 0x27DCD, 0x27DD1, 0x27DCF, 0x27E74,
+	0x27E72, 0x27E73, 0x27F54, 0x27F51, 0x047C0, 0x27F53, 0x27F49, 0x27F4C, 0x27F4A, 0x27F4F, 0x27F56, 0x28100,
+	0x280D5, 0x280D7, 0x280FF, 0x280D9, 0x280E3, 0x280D3, 0x04815, 0x280D8, 0x280D4, 0x280DE,
+	// plane 5, row 63
+	0x28271, 0x28374, 0x28388, 0x2837F, 0x2836B, 0x28387, 0x28379, 0x28378, 0x28389, 0x28380, 0x28376, 0x28429,
+	0x28428, 0x2842F, 0x285E5, 0x28604, 0x28603, 0x285F0, 0x285E8, 0x285EA, 0x285F1, 0x28601, 0x28602, 0x287A9,
+	0x287AB, 0x287B7, 0x287B6, 0x048E2, 0x288A0, 0x2888E, 0x28886, 0x28887, 0x28885, 0x2888B, 0x28888, 0x28890,
+	0x2891A, 0x28934, 0x28A37, 0x28A2F, 0x28A30, 0x28A39, 0x28A34, 0x28A33, 0x28A85, 0x28A84, 0x28A3A, 0x28C9F,
+	0x28D69, 0x28D6C, 0x28D6A, 0x28D67, 0x28D6B, 0x28F3F, 0x28F40, 0x2900B, 0x290D2, 0x290F8, 0x29101, 0x290FA,
+	0x29116, 0x2F9F6, 0x2922C, 0x2922D, 0x2922F, 0x2922E, 0x292C1, 0x292F5, 0x292C4, 0x292DE, 0x292CC, 0x292D2,
+	0x292C2, 0x292CD, 0x292CF, 0x292DD, 0x292C8, 0x293BA, 0x293BE, 0x293BF, 0x2942E, 0x29430, 0x294E7, 0x294EE,
+	0x294E8, 0x294E6, 0x294E2, 0x294E4, 0x294FB, 0x294FC, 0x294EA, 0x295ED, 0x295F6, 0x295F3,
+	// plane 5, row 64
+	0x295F4, 0x295F1, 0x295F7, 0x295F5, 0x295EA, 0x295EB, 0x29700, 0x2972C, 0x29712, 0x2973D, 0x29733, 0x04B4D,
+	0x29730, 0x29740, 0x29731, 0x29757, 0x04B4A, 0x29735, 0x29732, 0x2972D, 0x29736, 0x29738, 0x29762, 0x2975F,
+	0x2978A, 0x298D6, 0x298D8, 0x298DD, 0x298E4, 0x298E3, 0x29A4D, 0x29AD7, 0x29B4A, 0x29B4C, 0x29B50, 0x29B4B,
+	0x29B4E, 0x29B4F, 0x29C39, 0x29CD2, 0x29CCD, 0x29CD3, 0x29CE1, 0x29CCE, 0x29CD5, 0x29CDD, 0x29CD4, 0x29CCF,
+	0x29DCB, 0x29DA6, 0x29DC2, 0x29DA5, 0x29DA9, 0x29DA2, 0x29DA4, 0x29DB0, 0x29DCC, 0x29DAF, 0x29DBF, 0x2A024,
+	0x2A01A, 0x2A014, 0x2A03A, 0x2A020, 0x2A01B, 0x2A021, 0x2A025, 0x2A01E, 0x2A03F, 0x2A040, 0x2A018, 0x2A02C,
+	0x2A015, 0x2A02D, 0x2A01F, 0x2A029, 0x2A04B, 0x2A2B3, 0x2A2A5, 0x2A2A7, 0x2A2AB, 0x2A2A6, 0x2A2AA, 0x2A322,
+	0x2A323, 0x2A3A1, 0x2A3A3, 0x2A3A0, 0x2A3BD, 0x2A3BA, 0x2A3FA, 0x2A3F8, 0x2A3FC, 0x2A3F6,
+	// plane 5, row 65
+	0x2A434, 0x2A443, 0x04D63, 0x2A4D4, 0x2A50D, 0x2A543, 0x2A541, 0x2A53D, 0x2A540, 0x2A53E, 0x2A53F, 0x2A546,
+	0x04D93, 0x2A5D4, 0x20435, 0x20436, 0x20432, 0x2043A, 0x204C8, 0x20536, 0x2061E, 0x2061D, 0x2061F, 0x2081C,
+	0x2081D, 0x20820, 0x0350E, 0x208C0, 0x2109A, 0x21093, 0x210DD, 0x0360A, 0x210A3, 0x210A9, 0x2109C, 0x2109B,
+	0x0360D, 0x21097, 0x214B1, 0x214CA, 0x214B3, 0x214CD, 0x218EA, 0x218EE, 0x218EF, 0x21ADF, 0x21B50, 0x21BBB,
+	0x21F88, 0x21F89, 0x21F8C, 0x21F85, 0x21F5E, 0x21F94, 0x21F95, 0x2216B, 0x2216A, 0x22169, 0x222E3, 0x222F1,
+	0x22437, 0x22433, 0x22591, 0x2258F, 0x22597, 0x228D4, 0x228DE, 0x228D3, 0x228E0, 0x22943, 0x2290B, 0x228FF,
+	0x22904, 0x22907, 0x22DF9, 0x22DFA, 0x22DFB, 0x22DF8, 0x23012, 0x23010, 0x2311F, 0x232DF, 0x232E3, 0x23352,
+	0x233A9, 0x23765, 0x23787, 0x23742, 0x23752, 0x2374C, 0x2369F, 0x23701, 0x23748, 0x2374F,
+	// plane 5, row 66
+	0x2377E, 0x2374D, 0x23758, 0x23747, 0x2375E, 0x23749, 0x2374E, 0x2375D, 0x2375A, 0x23786, 0x23751, 0x23929,
+	0x239A6, 0x239A7, 0x23A70, 0x23A6F, 0x23AD3, 0x23AD2, 0x23C28, 0x23C1B, 0x23C1A, 0x23C1C, 0x23C21, 0x24032,
+	0x24066, 0x2407D, 0x24073, 0x2407F, 0x24065, 0x24080, 0x24061, 0x24075, 0x2406E, 0x24067, 0x24071, 0x2406C,
+	0x24063, 0x24062, 0x24083, 0x240B2, 0x24081, 0x2406F, 0x2406B, 0x24082, 0x2408A, 0x24429, 0x24430, 0x24431,
+	0x24597, 0x24600, 0x245FF, 0x248B6, 0x248B5, 0x248C3, 0x24A8D, 0x24B18, 0x24B9C, 0x24B9A, 0x24B99, 0x24EB7,
+	0x24EC4, 0x24EC6, 0x24EC7, 0x24EBC, 0x24EC0, 0x24EC5, 0x24ECD, 0x24EC1, 0x24EBE, 0x24FA7, 0x24FB8, 0x25023,
+	0x04074, 0x252E8, 0x252DE, 0x252E3, 0x252EF, 0x252DC, 0x252E4, 0x252E1, 0x252E5, 0x25395, 0x25394, 0x25393,
+	0x2538E, 0x25598, 0x2559C, 0x25599, 0x255A0, 0x2559E, 0x25704, 0x258D3, 0x258B4, 0x258BB,
+	// plane 5, row 67
+	0x258B7, 0x258B8, 0x258BD, 0x258B6, 0x258B9, 0x258B5, 0x25A22, 0x25A21, 0x25AC3, 0x25ABC, 0x25CFF, 0x25CE5,
+	0x25CF7, 0x25CD2, 0x25D00, 0x25CDB, 0x25CF0, 0x25CE2, 0x25CCD, 0x04256, 0x25CE1, 0x25CF2, 0x25CDC, 0x25CCC,
+	0x25CD6, 0x25CF3, 0x25CFA, 0x25CF6, 0x25CEC, 0x25CEA, 0x25CE3, 0x25CD0, 0x0425A, 0x25C95, 0x25CD5, 0x25CF4,
+	0x25CCB, 0x25CDA, 0x25CC8, 0x25CDF, 0x25CF5, 0x25CCF, 0x25CC7, 0x25CD7, 0x25F24, 0x042A3, 0x25F1C, 0x25F21,
+	0x25F1E, 0x25F18, 0x25F1B, 0x042A8, 0x26146, 0x2614B, 0x26148, 0x26147, 0x26167, 0x26154, 0x26315, 0x26322,
+	0x263C5, 0x26329, 0x263C7, 0x2648D, 0x2651B, 0x26520, 0x265E2, 0x265E7, 0x265E8, 0x265E1, 0x26872, 0x2687B,
+	0x26874, 0x26871, 0x26879, 0x26875, 0x26890, 0x26877, 0x2687D, 0x2694F, 0x26950, 0x269A3, 0x269A2, 0x269F4,
+	0x26A94, 0x26A9A, 0x26A9B, 0x26AA7, 0x26A97, 0x26A95, 0x26A92, 0x26A9C, 0x26A96, 0x26FB6,
+	// plane 5, row 68
+	0x26FB8, 0x26FB0, 0x27018, 0x26FC5, 0x26FB5, 0x26FC2, 0x27006, 0x27019, 0x26FB9, 0x27015, 0x26FD6, 0x26FAC,
+	0x0453C, 0x26FC6, 0x26FB3, 0x26FC3, 0x26FCA, 0x26FCF, 0x26FBD, 0x26FCE, 0x27014, 0x26FBA, 0x2701A, 0x26FC1,
+	0x26FBB, 0x0459B, 0x0459A, 0x27201, 0x271FC, 0x2745A, 0x27454, 0x27461, 0x2745C, 0x27455, 0x2744A, 0x2744B,
+	0x27451, 0x27469, 0x2746B, 0x087F1, 0x27466, 0x27458, 0x2745D, 0x27467, 0x27456, 0x27488, 0x27464, 0x2744E,
+	0x27757, 0x2775A, 0x27751, 0x2774A, 0x2774B, 0x0465E, 0x27747, 0x2776F, 0x2776A, 0x2776B, 0x27746, 0x2776D,
+	0x27754, 0x2776E, 0x2774C, 0x27878, 0x27886, 0x27882, 0x046A7, 0x27AE1, 0x27AE5, 0x27ADB, 0x27ADE, 0x27AD7,
+	0x27C03, 0x27C7C, 0x27C7E, 0x27D05, 0x27D07, 0x27DE6, 0x27DE1, 0x27DFB, 0x27DE5, 0x27DE7, 0x27DDF, 0x27DFF,
+	0x27E76, 0x27F63, 0x27F66, 0x27F65, 0x27F5E, 0x27F64, 0x27F6B, 0x27F5F, 0x27F67, 0x27F68,
+	// plane 5, row 69
+	0x28108, 0x28109, 0x28117, 0x28115, 0x2811B, 0x2810B, 0x28128, 0x2810E, 0x28118, 0x28153, 0x28145, 0x2810D,
+	0x2810A, 0x28113, 0x2814A, 0x28279, 0x283A1, 0x2838D, 0x283A2, 0x28390, 0x0486E, 0x28431, 0x28430, 0x2861F,
+	0x28619, 0x2860C, 0x2861E, 0x2861D, 0x28607, 0x28766, 0x287C5, 0x287BA, 0x287BD, 0x287C2, 0x287C3, 0x287BF,
+	0x0490D, 0x288A1, 0x288A2, 0x288A8, 0x288A3, 0x288AA, 0x288AF, 0x288B9, 0x28937, 0x28A8F, 0x04943, 0x28A8E,
+	0x28A87, 0x28A8A, 0x28A92, 0x28A97, 0x28A9F, 0x28B05, 0x28CA9, 0x28CA7, 0x28D8A, 0x28D82, 0x28D85, 0x28D8B,
+	0x28D89, 0x28D81, 0x28D80, 0x28D87, 0x28D86, 0x28F4D, 0x2901C, 0x2901F, 0x29121, 0x2911D, 0x29122, 0x290FE,
+	0x2911B, 0x2913A, 0x29137, 0x29117, 0x29138, 0x29126, 0x29118, 0x29234, 0x29235, 0x29232, 0x04A73, 0x292E1,
+	0x292FD, 0x292E3, 0x292E8, 0x292F9, 0x292FF, 0x292FE, 0x04A74, 0x292E0, 0x29300, 0x04A70,
+	// plane 5, row 70
+	0x292EC, 0x292E4, 0x292EF, 0x292FA, 0x293CA, 0x29431, 0x29432, 0x29434, 0x29441, 0x29500, 0x29506, 0x29508,
+	0x29505, 0x29503, 0x2950B, 0x29502, 0x2950A, 0x295F0, 0x29613, 0x2960A, 0x2960F, 0x29611, 0x29608, 0x2960B,
+	0x29612, 0x2960D, 0x2975B, 0x29763, 0x29761, 0x29768, 0x2975A, 0x2984E, 0x2984D, 0x29850, 0x298FC, 0x29912,
+	0x298F5, 0x2991E, 0x298F0, 0x298F3, 0x298F2, 0x29901, 0x298EF, 0x298EE, 0x29916, 0x298F6, 0x298FB, 0x2991C,
+	0x04BDB, 0x29A5D, 0x29A60, 0x29A66, 0x29A5F, 0x29A61, 0x29A5B, 0x29A62, 0x29A57, 0x29B69, 0x29B6B, 0x29B61,
+	0x29B77, 0x29B5F, 0x29B63, 0x29B62, 0x29B65, 0x29CEF, 0x29CE2, 0x29D00, 0x29CE8, 0x29CF2, 0x29CE7, 0x04C29,
+	0x29CE5, 0x29DD5, 0x29DD0, 0x29DDA, 0x29DD3, 0x04C51, 0x29DD9, 0x29DCF, 0x29DD6, 0x29DD8, 0x29DF5, 0x29DCE,
+	0x29DD7, 0x29DF4, 0x29DCD, 0x29E01, 0x09BBA, 0x2A052, 0x2A055, 0x2A05A, 0x2A04D, 0x2A054,
+	// plane 5, row 71
+	0x2A053, 0x2A05E, 0x2A067, 0x2A065, 0x2A04E, 0x04CDB, 0x2A04F, 0x2A061, 0x2A06E, 0x2A051, 0x2A05B, 0x2A263,
+	0x2A2B7, 0x2A2B6, 0x2A2C3, 0x2A2BB, 0x2A2B5, 0x2A32E, 0x2A330, 0x2A333, 0x2A331, 0x2A3A4, 0x2A3C1, 0x2A3BB,
+	0x2A3BE, 0x2A400, 0x2A3FF, 0x2A440, 0x04D65, 0x2A43F, 0x2A444, 0x04D64, 0x04D67, 0x2A4DB, 0x2A50F, 0x2A511,
+	0x2A54E, 0x2A54D, 0x2A54B, 0x2A54C, 0x2A595, 0x2043B, 0x20445, 0x20444, 0x2043E, 0x2043C, 0x2043F, 0x204CC,
+	0x204CE, 0x20622, 0x20623, 0x2069F, 0x208C5, 0x208C4, 0x210E9, 0x21099, 0x03611, 0x210E6, 0x210E7, 0x210F7,
+	0x214D2, 0x03733, 0x218FD, 0x2190C, 0x2190B, 0x21CED, 0x03821, 0x03822, 0x21F9B, 0x2200A, 0x2216F, 0x22175,
+	0x222F2, 0x222F8, 0x2243E, 0x22924, 0x2290E, 0x22916, 0x22918, 0x22910, 0x22931, 0x22944, 0x039A6, 0x22929,
+	0x2292F, 0x2294B, 0x22936, 0x039A3, 0x22E34, 0x22E00, 0x22E2B, 0x23020, 0x23021, 0x2301E,
+	// plane 5, row 72
+	0x2301D, 0x232F5, 0x232F2, 0x232F6, 0x23790, 0x2379D, 0x2379C, 0x23792, 0x23794, 0x237D1, 0x23793, 0x237B7,
+	0x23797, 0x237B0, 0x2379F, 0x237C9, 0x2392D, 0x2392C, 0x23A77, 0x23C2C, 0x240C0, 0x240B9, 0x24453, 0x24540,
+	0x24702, 0x246FB, 0x03EA1, 0x24A8B, 0x24AAE, 0x24AAB, 0x24BA1, 0x2A2CD, 0x24CEA, 0x24EDD, 0x24EDC, 0x24ED9,
+	0x24FAB, 0x24FAC, 0x24FAD, 0x24FB2, 0x2502C, 0x2502B, 0x250C2, 0x25300, 0x2530D, 0x25306, 0x252FF, 0x25303,
+	0x25301, 0x25310, 0x2530F, 0x25305, 0x25398, 0x25397, 0x25396, 0x25399, 0x255AC, 0x255AB, 0x25712, 0x258D8,
+	0x258DF, 0x258D6, 0x25A2D, 0x25A32, 0x25D3F, 0x25D2F, 0x25D26, 0x25D3A, 0x25D39, 0x0425F, 0x25D3B, 0x25D35,
+	0x25D2A, 0x25D21, 0x25D38, 0x25D37, 0x25D34, 0x0425C, 0x25D22, 0x25D36, 0x25D44, 0x25F45, 0x25F3B, 0x25F36,
+	0x25F42, 0x2617A, 0x26186, 0x2618B, 0x2617F, 0x26181, 0x2632A, 0x263D5, 0x2649F, 0x2649D,
+	// plane 5, row 73
+	0x26526, 0x265F4, 0x265F5, 0x26815, 0x2688E, 0x2688F, 0x26926, 0x269A7, 0x269AF, 0x26AAD, 0x26AAC, 0x26AAB,
+	0x26AAA, 0x2702F, 0x27021, 0x27023, 0x270A3, 0x27049, 0x2703A, 0x27048, 0x2702D, 0x27025, 0x27029, 0x27032,
+	0x27034, 0x27024, 0x2702C, 0x2704B, 0x2703B, 0x27020, 0x27028, 0x27498, 0x045F5, 0x27497, 0x08804, 0x2749D,
+	0x274A8, 0x275D5, 0x27777, 0x27778, 0x27772, 0x27773, 0x27802, 0x2793B, 0x27AFE, 0x27B0B, 0x27AFF, 0x27B07,
+	0x04711, 0x0470C, 0x27B30, 0x27BCD, 0x27BCF, 0x27C10, 0x27C0A, 0x27C83, 0x27D15, 0x27D0E, 0x27D0C, 0x27E02,
+	0x27DFE, 0x27E05, 0x27E15, 0x27E08, 0x27F7F, 0x27F77, 0x27F7C, 0x27F82, 0x27F7E, 0x27F78, 0x27F7D, 0x27F79,
+	0x27F81, 0x2814B, 0x28163, 0x28164, 0x28156, 0x28154, 0x2814E, 0x28110, 0x2814F, 0x28157, 0x2815E, 0x28151,
+	0x2816A, 0x28169, 0x283AD, 0x283A4, 0x283AC, 0x283A9, 0x283AE, 0x2044C, 0x28650, 0x28635,
+	// plane 5, row 74
+	0x28632, 0x287D8, 0x287D1, 0x287CF, 0x287BE, 0x288D5, 0x288C1, 0x288C6, 0x288C3, 0x288C2, 0x288C0, 0x288C5,
+	0x288C7, 0x288BF, 0x288C4, 0x288D4, 0x28A90, 0x28AD2, 0x0494E, 0x28AD4, 0x28CAF, 0x28CAE, 0x28CB2, 0x28CAD,
+	0x28D9C, 0x28DA0, 0x28DB6, 0x28F3D, 0x28F56, 0x28F82, 0x29028, 0x2902B, 0x2913C, 0x2913E, 0x2913F, 0x29142,
+	0x2913D, 0x29141, 0x2913B, 0x29149, 0x29143, 0x29239, 0x29238, 0x29322, 0x29308, 0x2930C, 0x29306, 0x29313,
+	0x29304, 0x29320, 0x2931D, 0x29305, 0x2930A, 0x293D6, 0x293D7, 0x29436, 0x29437, 0x29523, 0x29522, 0x29520,
+	0x2951A, 0x2951D, 0x2961C, 0x29620, 0x29677, 0x2977F, 0x2978B, 0x2977D, 0x29799, 0x29784, 0x29789, 0x29785,
+	0x29783, 0x29786, 0x2979E, 0x29853, 0x29917, 0x04B94, 0x29919, 0x29920, 0x2991F, 0x29923, 0x29918, 0x29921,
+	0x29929, 0x29924, 0x29926, 0x29A5E, 0x29A6F, 0x29A6E, 0x29A74, 0x29A72, 0x29A73, 0x29B7D,
+	// plane 5, row 75
+	0x29B7E, 0x29B85, 0x29B7F, 0x29B84, 0x29C44, 0x29CF5, 0x29CF8, 0x29D03, 0x29CF6, 0x29E28, 0x04C63, 0x29E39,
+	0x29DFB, 0x29E0E, 0x29DFD, 0x29E1F, 0x04C61, 0x29E03, 0x09BD8, 0x29E10, 0x04C5A, 0x29E0D, 0x29E27, 0x29E41,
+	0x2A072, 0x2A078, 0x2A080, 0x04CE3, 0x2A085, 0x2A07B, 0x09D7C, 0x2A07D, 0x2A091, 0x2A088, 0x2A0AA, 0x2A08D,
+	0x2A089, 0x2A095, 0x2A09B, 0x2A08C, 0x2A09E, 0x2A07C, 0x2A086, 0x2A084, 0x2A268, 0x2A2C5, 0x2A2C4, 0x2A2C9,
+	0x2A2C6, 0x2A342, 0x2A345, 0x2A341, 0x2A344, 0x2A3A6, 0x2A3C2, 0x2A442, 0x2A449, 0x2A448, 0x2A44A, 0x2A44C,
+	0x2A44B, 0x2A517, 0x2A518, 0x2A515, 0x2A552, 0x2A554, 0x2A553, 0x2A59A, 0x2A59B, 0x2A5DC, 0x2A5E4, 0x2A691,
+	0x04DAF, 0x2A6BA, 0x20451, 0x2045B, 0x204CF, 0x23305, 0x21113, 0x21115, 0x21114, 0x21123, 0x03613, 0x21111,
+	0x21112, 0x03680, 0x214F0, 0x2169B, 0x21912, 0x21916, 0x21917, 0x219B5, 0x21CF4, 0x21FB1,
+	// plane 5, row 76
+	0x22179, 0x222FF, 0x222FC, 0x222FB, 0x2243F, 0x22444, 0x2259D, 0x22932, 0x2294C, 0x2292B, 0x2295A, 0x22A1F,
+	0x22E01, 0x22E2D, 0x22E27, 0x22E59, 0x22E5A, 0x22E4D, 0x22E58, 0x23023, 0x23025, 0x2302B, 0x232FF, 0x233AE,
+	0x237CB, 0x237CA, 0x237D0, 0x237CE, 0x237CC, 0x237D8, 0x237C6, 0x237D2, 0x237CF, 0x237C8, 0x23B17, 0x04DB0,
+	0x240C4, 0x29240, 0x240E9, 0x240F2, 0x240FC, 0x240EA, 0x240EB, 0x240FD, 0x24478, 0x24477, 0x24473, 0x2449E,
+	0x24479, 0x245A2, 0x24603, 0x24704, 0x24705, 0x248CE, 0x24ABF, 0x24EF6, 0x24EF7, 0x25031, 0x25030, 0x250C9,
+	0x250C7, 0x2531C, 0x25328, 0x2531A, 0x2531E, 0x2531B, 0x2531F, 0x255BF, 0x255BB, 0x255BC, 0x255C0, 0x2571A,
+	0x258EF, 0x258EC, 0x258E9, 0x258F0, 0x258FE, 0x25A34, 0x25D6A, 0x25D95, 0x25D6C, 0x25D72, 0x25D67, 0x25D60,
+	0x25D6B, 0x25D5E, 0x25D6F, 0x25D66, 0x07C46, 0x25D62, 0x25D5D, 0x25D5C, 0x2F965, 0x25F50,
+	// plane 5, row 77
+	0x2619F, 0x04323, 0x2619E, 0x261A6, 0x26335, 0x26338, 0x26336, 0x2633A, 0x263DC, 0x2687C, 0x268AB, 0x04449,
+	0x268A8, 0x268A7, 0x2692B, 0x2692C, 0x26928, 0x2092B, 0x269A9, 0x269AA, 0x269AB, 0x269F8, 0x26AB1, 0x26AF0,
+	0x26AEF, 0x270A8, 0x2708B, 0x27094, 0x2709E, 0x2708F, 0x27088, 0x2707E, 0x27081, 0x27030, 0x2709B, 0x27082,
+	0x27090, 0x27085, 0x2707F, 0x270A9, 0x270DE, 0x2720D, 0x045FA, 0x274CF, 0x274CB, 0x274D8, 0x274DD, 0x274D3,
+	0x274D0, 0x274D5, 0x274D6, 0x275D6, 0x27792, 0x27795, 0x2A5CB, 0x2778D, 0x2779B, 0x2779D, 0x2778F, 0x2779E,
+	0x277A6, 0x27896, 0x27892, 0x27B16, 0x27B2A, 0x27B29, 0x27B2C, 0x27C15, 0x27C12, 0x27C11, 0x27C13, 0x27C88,
+	0x27C8B, 0x27C8A, 0x27C87, 0x27D17, 0x27D16, 0x27D1A, 0x27E19, 0x27E17, 0x27F91, 0x27F94, 0x2818B, 0x28190,
+	0x2818F, 0x28186, 0x28183, 0x2818E, 0x28187, 0x281A8, 0x28185, 0x281A6, 0x28182, 0x281A7,
+	// plane 5, row 78
+	0x283B9, 0x283B7, 0x283B4, 0x283B6, 0x283B3, 0x283C6, 0x2863C, 0x28640, 0x28638, 0x28791, 0x287A6, 0x287DA,
+	0x288DA, 0x288D8, 0x288D9, 0x288DB, 0x288D7, 0x28B16, 0x28B12, 0x28B1F, 0x04959, 0x28B14, 0x28B1A, 0x28B10,
+	0x28CB3, 0x28DAE, 0x28DC1, 0x28DB0, 0x28DAF, 0x28DB1, 0x28DAD, 0x28DB2, 0x28DC4, 0x2903C, 0x2903F, 0x29161,
+	0x29166, 0x2923C, 0x2923B, 0x2932C, 0x04A7E, 0x2932A, 0x2933E, 0x2932F, 0x29332, 0x29327, 0x29329, 0x29340,
+	0x293DF, 0x293DE, 0x2943C, 0x2943B, 0x2943E, 0x29521, 0x29546, 0x2953C, 0x29536, 0x29538, 0x29535, 0x29631,
+	0x29636, 0x2962D, 0x29633, 0x2962F, 0x2962E, 0x29635, 0x297AC, 0x297A9, 0x297A6, 0x04BA8, 0x2994C, 0x29943,
+	0x29941, 0x2994F, 0x29942, 0x29951, 0x04BA9, 0x29940, 0x29950, 0x29945, 0x2994A, 0x2994B, 0x29A83, 0x29A82,
+	0x29A81, 0x29AE0, 0x29B98, 0x29B9F, 0x29B9B, 0x29B9A, 0x29B99, 0x29B96, 0x29BAE, 0x29B9E,
+	// plane 5, row 79
+	0x29D09, 0x29D0D, 0x29E4E, 0x29E4A, 0x29E4D, 0x29E40, 0x29E3E, 0x29E48, 0x29E42, 0x29E62, 0x29E45, 0x29E51,
+	0x2A0C6, 0x2A0D0, 0x2A0C0, 0x2A0B7, 0x2A0C2, 0x2A0BC, 0x2A0C5, 0x2A0DC, 0x2A0DB, 0x2A0D2, 0x2A0C7, 0x2A0B6,
+	0x2A0C9, 0x2A0CC, 0x2A0D1, 0x2A0CD, 0x2A0DA, 0x2A0BA, 0x2A0D3, 0x2A0CE, 0x2A0F6, 0x2A0BD, 0x2A0DD, 0x2A0C8,
+	0x2A10D, 0x2A135, 0x2A271, 0x2A26E, 0x2A26F, 0x2A2D6, 0x2A34C, 0x2A34F, 0x2A354, 0x2A353, 0x2A3C9, 0x2A3C8,
+	0x2A403, 0x04D54, 0x2A404, 0x04D56, 0x2A457, 0x2A452, 0x2A453, 0x04D6D, 0x2A456, 0x2A45C, 0x2A455, 0x2A564,
+	0x2A55D, 0x2A55E, 0x2A5A2, 0x2A5A3, 0x04D8E, 0x2A5E7, 0x2A5E6, 0x2A698, 0x2A6AC, 0x2A6AF, 0x2A6AE, 0x20459,
+	0x205A9, 0x2082A, 0x208C7, 0x21141, 0x21137, 0x21135, 0x21133, 0x21139, 0x21132, 0x214FF, 0x21501, 0x214F8,
+	0x2191B, 0x21919, 0x21BBF, 0x21FBC, 0x21FBE, 0x21FBD, 0x2217D, 0x22446, 0x22447, 0x225A4,
+	// plane 5, row 80
+	0x22A21, 0x22A62, 0x22E86, 0x03A72, 0x2302F, 0x23031, 0x03C14, 0x237F8, 0x237F5, 0x237F9, 0x237F2, 0x237FA,
+	0x237F3, 0x23814, 0x237FD, 0x2380F, 0x2380E, 0x23801, 0x23937, 0x23935, 0x23934, 0x23931, 0x23A7A, 0x23A7B,
+	0x23C37, 0x2412B, 0x240FB, 0x24116, 0x24113, 0x03D93, 0x24111, 0x2410F, 0x2411B, 0x24138, 0x244A4, 0x24709,
+	0x24707, 0x2470B, 0x248D3, 0x248D1, 0x248D8, 0x24B1D, 0x24BA9, 0x24BD0, 0x27215, 0x24F02, 0x24F05, 0x24F01,
+	0x24F00, 0x2532E, 0x25330, 0x2532F, 0x25331, 0x255D2, 0x255D3, 0x25902, 0x25A40, 0x25A42, 0x25A3B, 0x25ACE,
+	0x25D98, 0x25D8F, 0x25D94, 0x25D91, 0x0426F, 0x25DBA, 0x25D90, 0x25D86, 0x25D9A, 0x25D8C, 0x25D93, 0x25D87,
+	0x25D88, 0x25D97, 0x25D8D, 0x25D9C, 0x25DBD, 0x25F3C, 0x25F59, 0x042B0, 0x261D1, 0x261BB, 0x261BE, 0x2625D,
+	0x263E2, 0x26605, 0x268BA, 0x0352E, 0x268F4, 0x269B2, 0x269F9, 0x26AB7, 0x26AB6, 0x27089,
+	// plane 5, row 81
+	0x270F9, 0x270D9, 0x270E8, 0x270D4, 0x270DB, 0x270E2, 0x270DF, 0x270D1, 0x270E9, 0x270EA, 0x27213, 0x27211,
+	0x274FA, 0x274F8, 0x274F4, 0x274FB, 0x2750E, 0x27502, 0x2750F, 0x27790, 0x277AD, 0x277A9, 0x27948, 0x27B5A,
+	0x27B4F, 0x27B4E, 0x27B55, 0x27B54, 0x27B4A, 0x27BD5, 0x27C18, 0x27C8D, 0x27D1D, 0x27D19, 0x27E26, 0x27E28,
+	0x27E2B, 0x27E7D, 0x27FA0, 0x27F9A, 0x27F9B, 0x281B5, 0x281AD, 0x281B2, 0x28294, 0x283C9, 0x2864E, 0x2864F,
+	0x28644, 0x28652, 0x288E9, 0x28939, 0x04969, 0x0957E, 0x28DC7, 0x28DD3, 0x28DC6, 0x28DC3, 0x049B4, 0x28DD2,
+	0x28F66, 0x2917F, 0x29180, 0x29184, 0x29185, 0x291E3, 0x04A4D, 0x29241, 0x29244, 0x29243, 0x2934E, 0x2934F,
+	0x04A83, 0x29349, 0x2934A, 0x2934B, 0x29343, 0x293E0, 0x293E5, 0x293E1, 0x293E6, 0x293E2, 0x2940C, 0x29440,
+	0x29549, 0x2954A, 0x29554, 0x2954C, 0x29555, 0x29556, 0x2963B, 0x2963D, 0x297A4, 0x297A8,
+	// plane 5, row 82
+	0x297C3, 0x297BF, 0x297C8, 0x297C2, 0x297CA, 0x297CC, 0x297C9, 0x297BE, 0x297CD, 0x297C7, 0x297C5, 0x2985D,
+	0x04BB0, 0x2996C, 0x2996B, 0x29970, 0x2996D, 0x2996F, 0x29989, 0x29984, 0x29A8D, 0x29A8A, 0x29A8E, 0x29A91,
+	0x29BB5, 0x29BB1, 0x29BAF, 0x29BB9, 0x29BB7, 0x29BB0, 0x04C0C, 0x29C17, 0x04C18, 0x29C4F, 0x29D19, 0x29D10,
+	0x29D18, 0x29D11, 0x29D1C, 0x29D12, 0x29E76, 0x29E71, 0x09C20, 0x29E7A, 0x29E7F, 0x09C22, 0x29E73, 0x29EAB,
+	0x09C1E, 0x29E77, 0x29E74, 0x29E7E, 0x29E9B, 0x29E84, 0x29E7C, 0x2A129, 0x2A12B, 0x2A10E, 0x2A100, 0x2A10B,
+	0x2A0FE, 0x2A0FA, 0x2A117, 0x2A0FF, 0x2A10C, 0x2A10F, 0x04CF5, 0x2A102, 0x2A101, 0x2A0FC, 0x2A149, 0x2A106,
+	0x2A112, 0x2A113, 0x2A278, 0x2A35B, 0x2A362, 0x2A35F, 0x2A35D, 0x2A3AB, 0x2A3D5, 0x2A3D4, 0x2A3D3, 0x2A407,
+	0x2A46C, 0x2A470, 0x2A46E, 0x2A468, 0x2A46D, 0x2A477, 0x2A46A, 0x2A4CE, 0x2A4EC, 0x2A569,
+	// plane 5, row 83
+	0x2A568, 0x2A5A6, 0x2A5A9, 0x2A5AA, 0x2A600, 0x2A60D, 0x2A5F8, 0x2A5FC, 0x2A60A, 0x2A5F7, 0x2A601, 0x2A6B6,
+	0x2A6BB, 0x2A6B7, 0x2A6B9, 0x2A6CA, 0x20469, 0x20625, 0x21159, 0x21507, 0x21509, 0x21922, 0x21B07, 0x21B04,
+	0x2330F, 0x21CF8, 0x21FC7, 0x21FD1, 0x2217E, 0x22308, 0x2244A, 0x2F898, 0x224B9, 0x22E88, 0x22E91, 0x22E84,
+	0x22E73, 0x22E89, 0x22E85, 0x23033, 0x23313, 0x2381D, 0x2381F, 0x2381C, 0x23820, 0x2381A, 0x2381B, 0x23939,
+	0x239AF, 0x23AE5, 0x23C3C, 0x24137, 0x2413A, 0x244BB, 0x03E0E, 0x24710, 0x2470D, 0x24BAF, 0x24C11, 0x03FD8,
+	0x24F0D, 0x24F0C, 0x24F0B, 0x250D4, 0x2533D, 0x2533E, 0x2533B, 0x25343, 0x25340, 0x25346, 0x25411, 0x255DD,
+	0x255DF, 0x255EA, 0x2574A, 0x25906, 0x25DC1, 0x25DB9, 0x25DC6, 0x25DB8, 0x25DBB, 0x25DC8, 0x25DC5, 0x25DBF,
+	0x25DC7, 0x25DC4, 0x25F65, 0x25F67, 0x261D7, 0x261DB, 0x261D4, 0x261D6, 0x263E8, 0x2660A,
+	// plane 5, row 84
+	0x26ABD, 0x26ABE, 0x2711A, 0x2710D, 0x2710F, 0x2711B, 0x27110, 0x27111, 0x27113, 0x27218, 0x27520, 0x2751F,
+	0x27523, 0x2751D, 0x27537, 0x27525, 0x27524, 0x2752A, 0x27527, 0x27533, 0x27528, 0x27534, 0x277BA, 0x08970,
+	0x2789D, 0x2794C, 0x27B5C, 0x27B6A, 0x27B5D, 0x27B65, 0x27B63, 0x27B5E, 0x27C19, 0x27C97, 0x27E3F, 0x27E33,
+	0x27E32, 0x27FA1, 0x27FA5, 0x27FA4, 0x27FA2, 0x281C1, 0x0482C, 0x281C7, 0x281C4, 0x281C6, 0x281C5, 0x281D4,
+	0x281CA, 0x28653, 0x288F1, 0x28921, 0x0496E, 0x28BB7, 0x28B92, 0x28DD4, 0x28F44, 0x29198, 0x2919B, 0x29191,
+	0x29195, 0x2919A, 0x29192, 0x29353, 0x29357, 0x04A86, 0x2935C, 0x2935D, 0x29364, 0x293EA, 0x293ED, 0x29442,
+	0x29443, 0x29564, 0x29561, 0x29560, 0x2967D, 0x297D4, 0x297D5, 0x297D9, 0x29987, 0x29999, 0x2998C, 0x2998A,
+	0x2998F, 0x04BB3, 0x2998B, 0x29982, 0x2999B, 0x04BEB, 0x29BC8, 0x29BC4, 0x29BCC, 0x29BC7,
+	// plane 5, row 85
+	0x29BC3, 0x29BC6, 0x29BCB, 0x29BCA, 0x29BC9, 0x29BCD, 0x29C53, 0x29D21, 0x29D29, 0x29D1D, 0x29D24, 0x29D28,
+	0x09C43, 0x29EB1, 0x29EB2, 0x29EB5, 0x29ED6, 0x29EAF, 0x29ECA, 0x29EB8, 0x2FA12, 0x2FA11, 0x2A145, 0x2A147,
+	0x2A134, 0x2A16E, 0x2A142, 0x2A131, 0x2A12E, 0x2A156, 0x2A138, 0x2A137, 0x2A14B, 0x2A12D, 0x2A133, 0x2A136,
+	0x09DE0, 0x2A148, 0x2A2DC, 0x04D42, 0x2A366, 0x2A36D, 0x2A363, 0x2A364, 0x04D41, 0x2A367, 0x2A3D9, 0x2A40B,
+	0x2A47E, 0x2A48B, 0x2A526, 0x2A52C, 0x2A529, 0x2A56F, 0x2A56B, 0x2A56D, 0x2A56E, 0x2A61C, 0x2A611, 0x2A610,
+	0x2A624, 0x2A612, 0x2A615, 0x2A617, 0x04DAC, 0x2046F, 0x205AB, 0x2082E, 0x208C9, 0x20B96, 0x21185, 0x216A0,
+	0x2192B, 0x2230D, 0x23036, 0x00000, 0x03B2F, 0x03B2E, 0x233B1, 0x2384A, 0x23837, 0x2383C, 0x23838, 0x2383A,
+	0x2383E, 0x23849, 0x03C4D, 0x03C7B, 0x23A80, 0x23A7F, 0x23AE3, 0x23C3F, 0x24152, 0x2414E,
+	// plane 5, row 86
+	0x2414A, 0x2414B, 0x244D5, 0x24AD8, 0x24B20, 0x24BB3, 0x24BB1, 0x24BB0, 0x24F17, 0x250D9, 0x25349, 0x25413,
+	0x255ED, 0x255EB, 0x255EE, 0x2590A, 0x2590B, 0x25A4A, 0x25DD5, 0x25DD7, 0x25DDE, 0x25DDC, 0x25DEE, 0x25F70,
+	0x042B2, 0x261D9, 0x263D7, 0x268CB, 0x268C7, 0x269FC, 0x2713B, 0x27139, 0x2714B, 0x27143, 0x27140, 0x27146,
+	0x2754D, 0x27543, 0x27547, 0x2754B, 0x27555, 0x27552, 0x27B5F, 0x04722, 0x27B7C, 0x27B7B, 0x27FA8, 0x27FA9,
+	0x281DE, 0x281D7, 0x281DD, 0x281D6, 0x281D8, 0x2829B, 0x283E0, 0x283E8, 0x283E6, 0x288F8, 0x288FB, 0x28BBB,
+	0x09459, 0x28BB8, 0x04970, 0x28CC1, 0x28CC0, 0x291A4, 0x291AB, 0x2924D, 0x29365, 0x29367, 0x2936A, 0x29366,
+	0x29369, 0x29573, 0x29580, 0x2956F, 0x29571, 0x297E4, 0x297E6, 0x297E7, 0x299A0, 0x299A4, 0x29AA2, 0x29AA7,
+	0x29AA4, 0x29BDE, 0x29BDB, 0x29C58, 0x29C5C, 0x29D2F, 0x29D2E, 0x29EDD, 0x29EE4, 0x29ED8,
+	// plane 5, row 87
+	0x29EE7, 0x29EDA, 0x29E75, 0x04C95, 0x2A179, 0x2A180, 0x2A17F, 0x2A17C, 0x2A175, 0x2A17B, 0x2A182, 0x04D00,
+	0x2A189, 0x2A174, 0x2A17D, 0x2A17A, 0x2A186, 0x2A1A8, 0x2A172, 0x04D02, 0x2A18B, 0x2A191, 0x2A1B3, 0x2A181,
+	0x2A282, 0x2A2E1, 0x2A2E3, 0x2A2E2, 0x2A376, 0x2A374, 0x2A372, 0x2A375, 0x2A40E, 0x2A40D, 0x2A494, 0x2A492,
+	0x2A493, 0x2A491, 0x2A48F, 0x2A495, 0x2A4D0, 0x2A4F7, 0x2A576, 0x2A5AF, 0x04D9F, 0x2A626, 0x2A625, 0x2A62D,
+	0x2A6A0, 0x2A6C3, 0x204D7, 0x20ACC, 0x21179, 0x2117A, 0x21515, 0x21FDB, 0x22185, 0x22970, 0x2297B, 0x22EA5,
+	0x22E9F, 0x23317, 0x233B2, 0x23839, 0x23840, 0x2384E, 0x2393E, 0x23AE8, 0x23AE7, 0x24166, 0x24161, 0x244DA,
+	0x24714, 0x248DF, 0x24F18, 0x25039, 0x2503A, 0x25730, 0x25732, 0x2590E, 0x25A4C, 0x25DE8, 0x25DF1, 0x25DEB,
+	0x25DEC, 0x25F74, 0x25F73, 0x261F1, 0x26342, 0x2633E, 0x26341, 0x2652C, 0x268D2, 0x269BB,
+	// plane 5, row 88
+	0x27158, 0x27157, 0x27156, 0x2715A, 0x0457D, 0x27561, 0x27568, 0x27565, 0x2755F, 0x27564, 0x2755E, 0x2755B,
+	0x27567, 0x277C3, 0x278A2, 0x27953, 0x27B7D, 0x27C20, 0x27FAE, 0x27FAF, 0x27FB0, 0x281E7, 0x281E6, 0x281E9,
+	0x283F1, 0x283EB, 0x283EA, 0x283E9, 0x28663, 0x28902, 0x288FE, 0x28BDE, 0x28CC2, 0x28DE6, 0x28F5D, 0x291AD,
+	0x29375, 0x29372, 0x29377, 0x04AF6, 0x29651, 0x297E8, 0x297ED, 0x297EE, 0x299B9, 0x299A1, 0x299B6, 0x29AAE,
+	0x29BE8, 0x04C12, 0x29C1E, 0x29D31, 0x29D32, 0x04C91, 0x29F0E, 0x29F12, 0x29F09, 0x29F05, 0x04C90, 0x29F03,
+	0x29F1F, 0x29F0D, 0x29F0C, 0x29F04, 0x29F0A, 0x2A1C2, 0x2A1BF, 0x2A1C9, 0x2A1B2, 0x2A1C1, 0x2A1AF, 0x2A1B4,
+	0x2A1B0, 0x2A1B6, 0x2A1B7, 0x2A1BB, 0x2A1B1, 0x2A2F0, 0x2A378, 0x2A37A, 0x2A379, 0x2A3E4, 0x2A3E6, 0x2A49F,
+	0x2A49D, 0x2A498, 0x2A499, 0x2A4F9, 0x2A530, 0x2A582, 0x2A581, 0x2A5B3, 0x2A57F, 0x2A63A,
+	// plane 5, row 89
+	0x2A63E, 0x2A648, 0x21186, 0x21936, 0x21B13, 0x21C22, 0x21FDD, 0x225A7, 0x2297D, 0x03A79, 0x2331C, 0x23865,
+	0x23860, 0x23867, 0x03C4E, 0x23B1A, 0x24AE3, 0x25734, 0x25918, 0x25A52, 0x25DFC, 0x25F79, 0x25F78, 0x25F76,
+	0x261FA, 0x261F8, 0x2652D, 0x268D6, 0x269BD, 0x269BF, 0x269BE, 0x27144, 0x27170, 0x27162, 0x2716E, 0x2756E,
+	0x2757C, 0x27574, 0x27578, 0x27570, 0x27579, 0x27571, 0x277CC, 0x278A7, 0x278A6, 0x27B93, 0x27C21, 0x27C9C,
+	0x2829D, 0x283F4, 0x283F3, 0x28DF0, 0x291B8, 0x291B6, 0x291BD, 0x29373, 0x29382, 0x29587, 0x29656, 0x29659,
+	0x297F6, 0x299C9, 0x299C5, 0x299C7, 0x299CA, 0x299C2, 0x299C4, 0x29BF2, 0x29BF0, 0x29D3B, 0x29D3A, 0x29F26,
+	0x29F28, 0x29F34, 0x29F2D, 0x2A1D7, 0x2A1D2, 0x2A1D6, 0x2A1DC, 0x2A1D3, 0x2A1D1, 0x2A286, 0x04D1E, 0x2A2EF,
+	0x2A2EE, 0x2A3E8, 0x2A4AC, 0x2A4A9, 0x2A4AA, 0x2A4AB, 0x2A4FB, 0x2A533, 0x2A588, 0x2A5B6,
+	// plane 5, row 90
+	0x2A5B7, 0x2A5D0, 0x2A5CF, 0x2A64F, 0x2A659, 0x2A64C, 0x21B18, 0x21FE0, 0x23869, 0x24173, 0x24172, 0x24AE8,
+	0x255FB, 0x2591C, 0x25E09, 0x25E0A, 0x25E08, 0x25F7D, 0x25F7F, 0x26267, 0x2717A, 0x2717B, 0x277D1, 0x2F9CB,
+	0x27B9D, 0x27C9D, 0x281FC, 0x281FB, 0x281FD, 0x283F5, 0x283F6, 0x28C0F, 0x28C1D, 0x291C7, 0x291C1, 0x291BF,
+	0x29254, 0x2937F, 0x293F5, 0x2958A, 0x2958C, 0x2965C, 0x29865, 0x299CF, 0x299D0, 0x29AB8, 0x29BFA, 0x29C66,
+	0x29F40, 0x29F41, 0x09C72, 0x2A1F7, 0x2A1F3, 0x2A1EF, 0x2A1F4, 0x2A1ED, 0x2A1F2, 0x2A1F1, 0x2A1F9, 0x2A4B4,
+	0x2A4FC, 0x2A5BC, 0x2A65E, 0x2A65B, 0x2A65F, 0x2A65D, 0x2A6CF, 0x2A6CE, 0x2193A, 0x21FE1, 0x22315, 0x22314,
+	0x24178, 0x24179, 0x244E6, 0x24BB8, 0x24F22, 0x255FE, 0x268DB, 0x26A06, 0x26AC8, 0x27189, 0x27586, 0x278AD,
+	0x27B99, 0x27BD8, 0x27C23, 0x27D23, 0x27FB5, 0x283F9, 0x28907, 0x28C1E, 0x28DFB, 0x28F72,
+	// plane 5, row 91
+	0x28F73, 0x29387, 0x29449, 0x2965D, 0x297F8, 0x29C03, 0x29F4E, 0x29F4D, 0x29F50, 0x29F55, 0x2A209, 0x2A207,
+	0x2A20C, 0x2A203, 0x2A206, 0x2A20B, 0x2A20A, 0x2A289, 0x2A4BB, 0x2A4FF, 0x2A536, 0x2A58B, 0x2A666, 0x2A669,
+	0x2A667, 0x2A6C2, 0x2A6D2, 0x21FE2, 0x03828, 0x22988, 0x2335B, 0x23876, 0x2417D, 0x245A6, 0x2535A, 0x25E17,
+	0x268DC, 0x2718F, 0x27594, 0x27595, 0x27FB6, 0x28204, 0x287EE, 0x2938A, 0x293F9, 0x29C07, 0x29C1F, 0x29C2A,
+	0x29D45, 0x29F5C, 0x29F5B, 0x29F61, 0x29F5D, 0x2A218, 0x2A21F, 0x2A383, 0x2A416, 0x2A414, 0x2A4BF, 0x2A4C0,
+	0x2A673, 0x2A6C0, 0x21517, 0x22989, 0x2591E, 0x2591F, 0x25A54, 0x25E18, 0x26205, 0x27195, 0x27598, 0x2759B,
+	0x27959, 0x28208, 0x28401, 0x291CE, 0x293FA, 0x29ABE, 0x29D47, 0x29F6A, 0x29F69, 0x29F68, 0x29F67, 0x2A225,
+	0x2A228, 0x2A4C4, 0x2A537, 0x2A58D, 0x2A58C, 0x04D91, 0x2F920, 0x25E22, 0x25F82, 0x277D9,
+	// plane 5, row 92
+	0x28C38, 0x293FB, 0x299E1, 0x29C72, 0x29D48, 0x29F6C, 0x29F70, 0x2A231, 0x2A230, 0x2A679, 0x25E23, 0x268F8,
+	0x29C74, 0x29F73, 0x2A234, 0x2A387, 0x2A3B2, 0x2A5C2, 0x2A67D, 0x21FE5, 0x2938C, 0x29F77, 0x29F75, 0x2A238,
+	0x2A23A, 0x2A683, 0x2A681, 0x24180, 0x27199, 0x04587, 0x2944A, 0x29D4A, 0x2A23C, 0x2A6C8, 0x25604, 0x278AF,
+	0x2A689, 0x2A2FA, 0x29661, 0x2A23F, 0x27193, 0x2A4C9, 0x277DE, 0x29391, 0x2A68E, 0x2A68D, 0x27198, 0x269C5,
+	0x2A6A5, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 5, row 93
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 5, row 94
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 1
+	0x2F802, 0x20062, 0x03405, 0x20088, 0x200D0, 0x200CF, 0x2011E, 0x2011F, 0x20120, 0x2090E, 0x2092C, 0x20061,
+	0x20004, 0x20005, 0x20003, 0x03404, 0x2F801, 0x2007D, 0x2008D, 0x201A3, 0x201A4, 0x204DB, 0x20500, 0x2F81E,
+	0x206A3, 0x20832, 0x0353F, 0x20AD4, 0x20AD5, 0x21C23, 0x2232C, 0x2237A, 0x20503, 0x20007, 0x20008, 0x2008F,
+	0x2009C, 0x200D5, 0x20112, 0x20113, 0x20114, 0x20121, 0x201A8, 0x201A6, 0x20476, 0x20501, 0x2053D, 0x2F814,
+	0x2053E, 0x20629, 0x20677, 0x20678, 0x206A6, 0x206A9, 0x206AC, 0x20834, 0x20504, 0x2097B, 0x20A10, 0x2062F,
+	0x20AD7, 0x20B1C, 0x20B1F, 0x20B1A, 0x2F836, 0x20B1E, 0x20B9E, 0x20B9A, 0x211A0, 0x211A1, 0x215D2, 0x215D3,
+	0x219B9, 0x21B1D, 0x21B55, 0x21CFF, 0x21FE7, 0x0382A, 0x22011, 0x22013, 0x22012, 0x221AF, 0x221B1, 0x2F890,
+	0x2232D, 0x2237B, 0x2239D, 0x2239A, 0x038A7, 0x2239F, 0x223A0, 0x2239C, 0x224BD, 0x038FA,
+	// plane 6, row 2
+	0x225AA, 0x2306C, 0x23091, 0x23C74, 0x2456A, 0x2F929, 0x25605, 0x2626B, 0x2626A, 0x200E0, 0x20012, 0x03400,
+	0x2007F, 0x200A0, 0x20099, 0x20095, 0x2009E, 0x200DF, 0x200DE, 0x201AF, 0x201AD, 0x201B0, 0x204DF, 0x20543,
+	0x20541, 0x20548, 0x20575, 0x2F81A, 0x20632, 0x2067B, 0x20679, 0x206B3, 0x034DB, 0x20BA7, 0x206B2, 0x206B0,
+	0x206B4, 0x2F829, 0x208CD, 0x208CE, 0x2F82B, 0x20910, 0x20980, 0x209D3, 0x20A30, 0x20098, 0x20A2F, 0x20A31,
+	0x20ADA, 0x20AD9, 0x20B27, 0x20B28, 0x20B21, 0x20B25, 0x20BA6, 0x20BA2, 0x20BA1, 0x20BA4, 0x2F839, 0x20BAA,
+	0x20BA5, 0x20BA3, 0x20064, 0x2123F, 0x21244, 0x2151B, 0x21553, 0x21584, 0x21586, 0x215D5, 0x2F860, 0x2193E,
+	0x21940, 0x21BCA, 0x21BC9, 0x21C26, 0x21D00, 0x21D31, 0x21D3C, 0x21D30, 0x21D33, 0x21D32, 0x21D38, 0x22034,
+	0x22053, 0x22054, 0x221B4, 0x221B2, 0x2232F, 0x223A5, 0x223A7, 0x223A4, 0x223A8, 0x223A9,
+	// plane 6, row 3
+	0x223A2, 0x223A3, 0x223A6, 0x22481, 0x22480, 0x225AD, 0x22A24, 0x22A65, 0x22A68, 0x22A69, 0x2313C, 0x2313B,
+	0x2335D, 0x23944, 0x24182, 0x244F1, 0x2456B, 0x2471B, 0x248EB, 0x24BBA, 0x24BD3, 0x24D13, 0x25922, 0x26AF4,
+	0x2820F, 0x28210, 0x20507, 0x29C0B, 0x29C0A, 0x20019, 0x2001A, 0x20018, 0x20066, 0x20082, 0x200A8, 0x200E5,
+	0x200E7, 0x200E4, 0x20115, 0x20123, 0x20124, 0x20143, 0x201C3, 0x201BC, 0x201BB, 0x03438, 0x201B7, 0x201B9,
+	0x201CF, 0x2F80D, 0x2047A, 0x2047B, 0x204E1, 0x204E0, 0x20509, 0x2054D, 0x2F816, 0x2054B, 0x20579, 0x205B1,
+	0x20636, 0x20635, 0x20637, 0x20638, 0x2067F, 0x20680, 0x20681, 0x26953, 0x2067E, 0x206CD, 0x206BF, 0x206BE,
+	0x206C2, 0x206B8, 0x206C1, 0x06530, 0x20931, 0x2092F, 0x2096F, 0x20982, 0x0353A, 0x20987, 0x20986, 0x2098D,
+	0x20994, 0x209D7, 0x209D4, 0x209D8, 0x20A16, 0x20A14, 0x20A2E, 0x20A36, 0x20A37, 0x21FEE,
+	// plane 6, row 4
+	0x20AE0, 0x20AE5, 0x20ADF, 0x20B2B, 0x20B29, 0x20B2D, 0x20B2F, 0x20BB7, 0x20BC9, 0x20BC1, 0x20BCA, 0x20BB2,
+	0x20BAC, 0x20BAE, 0x211B2, 0x211A8, 0x211B0, 0x211A7, 0x2F851, 0x21252, 0x2124A, 0x21556, 0x21555, 0x2155F,
+	0x2155E, 0x21588, 0x2F85D, 0x215DD, 0x215E2, 0x215E1, 0x215DF, 0x215E0, 0x216AF, 0x216B1, 0x216BA, 0x21945,
+	0x21943, 0x219BD, 0x219C8, 0x2F86C, 0x219BE, 0x219BF, 0x2F874, 0x21B59, 0x21C29, 0x21C2C, 0x21C2F, 0x21C2D,
+	0x21C2E, 0x21D04, 0x21D02, 0x21D01, 0x21D40, 0x21D4A, 0x21D3F, 0x21D4F, 0x21D41, 0x21D4E, 0x21FEB, 0x21FEC,
+	0x200AC, 0x2205B, 0x22063, 0x2205A, 0x22059, 0x221DC, 0x221DB, 0x221D9, 0x221E0, 0x221DD, 0x20020, 0x223B0,
+	0x223B1, 0x223B2, 0x223B6, 0x22451, 0x22452, 0x22450, 0x22484, 0x038C9, 0x22483, 0x225C3, 0x225B8, 0x225B9,
+	0x225B2, 0x22990, 0x22A26, 0x22A29, 0x22A6E, 0x23140, 0x2313E, 0x23141, 0x2335E, 0x233BB,
+	// plane 6, row 5
+	0x233BC, 0x233B9, 0x2F8EF, 0x23880, 0x03C4F, 0x23945, 0x239BA, 0x239BD, 0x23C56, 0x23C55, 0x23C75, 0x23C77,
+	0x23C81, 0x2F8FA, 0x23C83, 0x23C8B, 0x23C84, 0x24186, 0x24183, 0x2418B, 0x2456D, 0x24616, 0x24728, 0x248EA,
+	0x24BD4, 0x24C03, 0x24D15, 0x24F3D, 0x24F3E, 0x25416, 0x2F94C, 0x25606, 0x2574D, 0x26222, 0x26273, 0x2626D,
+	0x2626E, 0x264B1, 0x2054E, 0x2662D, 0x043CD, 0x268F9, 0x26952, 0x26B00, 0x26AFC, 0x26AFA, 0x04492, 0x26AF7,
+	0x26AF8, 0x2F990, 0x2F9C4, 0x27C27, 0x28211, 0x200AE, 0x28450, 0x2844F, 0x2844D, 0x28671, 0x2F9E2, 0x28672,
+	0x28E18, 0x20A44, 0x20021, 0x20067, 0x200AF, 0x200B0, 0x20029, 0x20117, 0x20122, 0x2F803, 0x20025, 0x20128,
+	0x201FF, 0x201D9, 0x201DB, 0x201DE, 0x201F2, 0x201FE, 0x201F4, 0x201DD, 0x03445, 0x2F804, 0x20201, 0x201DC,
+	0x2047D, 0x20481, 0x2047E, 0x2047F, 0x204E5, 0x2050F, 0x034B5, 0x2050A, 0x034B6, 0x2050B,
+	// plane 6, row 6
+	0x20551, 0x20552, 0x20550, 0x205B8, 0x2063D, 0x2063E, 0x2063C, 0x20687, 0x206D7, 0x206DB, 0x206CF, 0x206E0,
+	0x2084C, 0x20842, 0x208D4, 0x20911, 0x20914, 0x20915, 0x20912, 0x20933, 0x20935, 0x03537, 0x20971, 0x03530,
+	0x20991, 0x20993, 0x20995, 0x209DC, 0x20A42, 0x20A40, 0x253A6, 0x20AE6, 0x2128F, 0x20B32, 0x2F837, 0x20B31,
+	0x20BF5, 0x20BCD, 0x20BDE, 0x20BD5, 0x20BDA, 0x20BEE, 0x20BEC, 0x20BCF, 0x20BCE, 0x2F83D, 0x20BCC, 0x20BF3,
+	0x211C1, 0x211C4, 0x211C6, 0x211B4, 0x211C3, 0x211B8, 0x211BB, 0x211B7, 0x211BC, 0x211BF, 0x211C7, 0x211BE,
+	0x21260, 0x2125D, 0x21276, 0x2126C, 0x21275, 0x2125B, 0x21271, 0x21273, 0x21261, 0x2125A, 0x21262, 0x21272,
+	0x2125F, 0x21258, 0x2151E, 0x21558, 0x2158B, 0x2158A, 0x215F4, 0x215F0, 0x216D2, 0x216CD, 0x216CE, 0x216BC,
+	0x216D0, 0x21946, 0x21947, 0x0373E, 0x0374D, 0x03751, 0x219CE, 0x219CF, 0x219CC, 0x219CB,
+	// plane 6, row 7
+	0x2F872, 0x21B60, 0x21B5F, 0x21B5D, 0x21BD4, 0x21C3A, 0x21C38, 0x21C3C, 0x21C36, 0x21D08, 0x21D7E, 0x21D5E,
+	0x21D6E, 0x21D53, 0x21D70, 0x21D57, 0x21D5D, 0x21D63, 0x21D7A, 0x21D79, 0x21D93, 0x2F881, 0x2201D, 0x22068,
+	0x22073, 0x22074, 0x22066, 0x2206F, 0x22075, 0x2207A, 0x22070, 0x2206D, 0x22065, 0x2218A, 0x221ED, 0x221E9,
+	0x221E3, 0x221EF, 0x03873, 0x221EA, 0x22331, 0x2F891, 0x22338, 0x26B20, 0x22335, 0x2233A, 0x22381, 0x22380,
+	0x223B8, 0x223C1, 0x223BA, 0x22488, 0x224CF, 0x224CA, 0x224C7, 0x224C5, 0x224C8, 0x224D0, 0x224C9, 0x225BB,
+	0x225B6, 0x225BD, 0x2F89D, 0x225B4, 0x225C1, 0x225D1, 0x225D2, 0x225CC, 0x225D4, 0x225CB, 0x225CE, 0x225D5,
+	0x225D8, 0x22995, 0x22993, 0x22999, 0x22996, 0x039BE, 0x22A89, 0x22A94, 0x22A91, 0x22A8F, 0x22A84, 0x22A97,
+	0x22A86, 0x22A85, 0x22A8C, 0x22EF2, 0x22EED, 0x22EEF, 0x230D9, 0x23146, 0x23147, 0x2314B,
+	// plane 6, row 8
+	0x23150, 0x2314A, 0x2314E, 0x2314C, 0x23148, 0x23363, 0x23361, 0x23362, 0x23360, 0x233C3, 0x233C5, 0x233C1,
+	0x233D0, 0x2F8DC, 0x23882, 0x03C1E, 0x23886, 0x23884, 0x2394C, 0x23948, 0x239B8, 0x239BF, 0x239C1, 0x239C6,
+	0x239C5, 0x239C7, 0x239C0, 0x239C3, 0x23AED, 0x23B03, 0x23B22, 0x23B21, 0x23C9F, 0x23C90, 0x23C9E, 0x03CC8,
+	0x23CA0, 0x23C94, 0x23C95, 0x23CA1, 0x03CC3, 0x03CC7, 0x2419A, 0x24190, 0x244F2, 0x244F3, 0x2456E, 0x245AB,
+	0x245A9, 0x2461A, 0x24618, 0x2461B, 0x24760, 0x2474C, 0x24742, 0x24733, 0x2473F, 0x2473E, 0x24738, 0x24743,
+	0x24746, 0x24736, 0x248EE, 0x248F5, 0x248F4, 0x03F56, 0x24C1B, 0x03540, 0x24D2B, 0x24D29, 0x24D2A, 0x24D2E,
+	0x2503F, 0x250E6, 0x253A7, 0x253A8, 0x25418, 0x25608, 0x25750, 0x25753, 0x25A56, 0x25A55, 0x2626F, 0x2627F,
+	0x264B3, 0x2663A, 0x26638, 0x26637, 0x26636, 0x20554, 0x2F980, 0x268DD, 0x268FC, 0x268FA,
+	// plane 6, row 9
+	0x26933, 0x26B04, 0x26B07, 0x26B08, 0x26B06, 0x26B09, 0x2F991, 0x2F993, 0x2721E, 0x2F9D2, 0x2840B, 0x28443,
+	0x28452, 0x28458, 0x2845E, 0x28668, 0x2868D, 0x28682, 0x2867B, 0x28C58, 0x049BD, 0x2F800, 0x2002C, 0x2002E,
+	0x2160B, 0x2002D, 0x20069, 0x200B2, 0x200B6, 0x200A9, 0x200ED, 0x20119, 0x2014F, 0x20216, 0x20203, 0x2020E,
+	0x20204, 0x20207, 0x20210, 0x20227, 0x20228, 0x2020D, 0x20229, 0x2020F, 0x20222, 0x20211, 0x20485, 0x20484,
+	0x20483, 0x2048A, 0x20486, 0x20510, 0x20558, 0x2055A, 0x2070C, 0x205C4, 0x205C5, 0x20647, 0x20649, 0x2064A,
+	0x2064B, 0x221BB, 0x2068D, 0x2068B, 0x20707, 0x20706, 0x2F820, 0x206EF, 0x206F0, 0x206ED, 0x206F9, 0x20702,
+	0x206E7, 0x206F4, 0x206F7, 0x206F1, 0x20853, 0x20855, 0x0351A, 0x208DA, 0x208D9, 0x0352C, 0x20938, 0x20999,
+	0x209A2, 0x2099C, 0x2099A, 0x2099B, 0x209E5, 0x209E3, 0x209E1, 0x209E0, 0x209E2, 0x209E4,
+	// plane 6, row 10
+	0x00000, 0x209E8, 0x2F82F, 0x20A13, 0x20A56, 0x20A4B, 0x20A4A, 0x20A4D, 0x20A4C, 0x03549, 0x20AEA, 0x20AE9,
+	0x20AEB, 0x20AEC, 0x20B3A, 0x20B3C, 0x20B39, 0x20B3B, 0x20C14, 0x20C0E, 0x20C35, 0x20C0A, 0x20C3F, 0x20C38,
+	0x0357C, 0x20C4E, 0x20C17, 0x2F83F, 0x20C1E, 0x211B6, 0x211CE, 0x211CA, 0x211CB, 0x211CC, 0x211C9, 0x212B4,
+	0x212AC, 0x21288, 0x21286, 0x2128C, 0x21293, 0x2128A, 0x212A4, 0x21287, 0x212A5, 0x212A6, 0x21285, 0x212A3,
+	0x2128E, 0x2151F, 0x21563, 0x2158D, 0x215FA, 0x215FB, 0x21609, 0x215FE, 0x215FF, 0x21602, 0x21604, 0x215FD,
+	0x21605, 0x216F9, 0x216F7, 0x216DB, 0x216DA, 0x21951, 0x21950, 0x21957, 0x219E2, 0x219D8, 0x219DA, 0x219DC,
+	0x21B20, 0x21B1F, 0x21B69, 0x21BDD, 0x21C40, 0x21C41, 0x21C43, 0x21D0D, 0x21D0C, 0x21D87, 0x21DA9, 0x21D92,
+	0x21D85, 0x037BF, 0x21D8C, 0x21DA2, 0x21D8F, 0x21D8A, 0x037BA, 0x21D8D, 0x21FF1, 0x22037,
+	// plane 6, row 11
+	0x2208B, 0x22087, 0x22082, 0x2218E, 0x221BC, 0x221F8, 0x221FA, 0x221EB, 0x221F9, 0x221FC, 0x22205, 0x2231B,
+	0x2231D, 0x2231E, 0x2F892, 0x2233C, 0x2233E, 0x22384, 0x223C8, 0x223C9, 0x223D3, 0x223C7, 0x223D2, 0x22455,
+	0x2F896, 0x2248C, 0x2248A, 0x224D5, 0x224D3, 0x038D8, 0x224D8, 0x225D0, 0x225EE, 0x225F1, 0x225FB, 0x225D3,
+	0x225EC, 0x225CD, 0x22615, 0x22626, 0x2260E, 0x22627, 0x2262A, 0x22600, 0x22604, 0x22628, 0x22608, 0x22614,
+	0x2299B, 0x039B2, 0x229A1, 0x2299D, 0x229A0, 0x2299E, 0x229A2, 0x2299C, 0x22A32, 0x22A34, 0x22A93, 0x22A96,
+	0x22A90, 0x22AB4, 0x22AB5, 0x2F8B6, 0x22AB6, 0x22AC2, 0x2F8B5, 0x22ABA, 0x22A92, 0x22EFB, 0x22F05, 0x22EF3,
+	0x22EFE, 0x22EFD, 0x2455D, 0x22EFA, 0x23043, 0x23095, 0x23094, 0x03AC4, 0x2316F, 0x23173, 0x2315C, 0x2315B,
+	0x23166, 0x23174, 0x23163, 0x23167, 0x23160, 0x23162, 0x23171, 0x23172, 0x2315E, 0x23323,
+	// plane 6, row 12
+	0x23326, 0x23364, 0x2F8D6, 0x233F2, 0x23401, 0x233DD, 0x233DC, 0x233DB, 0x233F3, 0x233FD, 0x233D8, 0x233F8,
+	0x233FB, 0x23883, 0x2388C, 0x2388E, 0x23949, 0x23954, 0x23952, 0x2394E, 0x23958, 0x23951, 0x23955, 0x23953,
+	0x23957, 0x23950, 0x2394F, 0x2394D, 0x239D2, 0x239D3, 0x239C9, 0x239D4, 0x23AEF, 0x2F8F8, 0x200B1, 0x23B05,
+	0x23B04, 0x23B2E, 0x23B2D, 0x23C45, 0x23C5B, 0x23C5A, 0x23C59, 0x23C9D, 0x23C8E, 0x23CBC, 0x23CDD, 0x23CBA,
+	0x2F8FD, 0x23CD8, 0x23CCB, 0x23CD9, 0x23CDA, 0x23CC4, 0x23CB8, 0x23CBF, 0x23CDB, 0x23CC1, 0x2F8FC, 0x23CC5,
+	0x2F919, 0x241B1, 0x241AF, 0x2F918, 0x244F7, 0x244F6, 0x244F8, 0x24550, 0x24551, 0x24571, 0x24570, 0x245B0,
+	0x245AF, 0x245AE, 0x245AD, 0x24625, 0x2462C, 0x24622, 0x24633, 0x24634, 0x03E2B, 0x2473A, 0x24740, 0x24768,
+	0x03E61, 0x24764, 0x2475C, 0x24757, 0x24753, 0x2474F, 0x24751, 0x24754, 0x24766, 0x072DD,
+	// plane 6, row 13
+	0x03EB2, 0x2490D, 0x24908, 0x2F92B, 0x248FA, 0x24AEA, 0x24B2A, 0x24BBB, 0x24BD5, 0x24BD7, 0x21DA4, 0x200F0,
+	0x200B5, 0x24C06, 0x24C28, 0x24C22, 0x24C21, 0x22456, 0x24C23, 0x211CD, 0x24D30, 0x24D34, 0x24FC9, 0x24FCA,
+	0x24FC8, 0x2F93D, 0x25044, 0x250F3, 0x2F941, 0x250F8, 0x250FC, 0x250F6, 0x250FB, 0x250F7, 0x25100, 0x25102,
+	0x2F940, 0x2F94D, 0x25424, 0x2560D, 0x04129, 0x25762, 0x25930, 0x2592B, 0x2592A, 0x04192, 0x2592D, 0x25ADA,
+	0x25E28, 0x25F86, 0x26277, 0x2627C, 0x26350, 0x2634E, 0x263F2, 0x264B2, 0x264CE, 0x264CF, 0x264E5, 0x23407,
+	0x26535, 0x26531, 0x26613, 0x2662B, 0x2662C, 0x26645, 0x26660, 0x26641, 0x26658, 0x26647, 0x2665B, 0x26650,
+	0x2F982, 0x2665F, 0x2664A, 0x26656, 0x26643, 0x26644, 0x26651, 0x268FD, 0x26934, 0x26935, 0x26954, 0x26955,
+	0x26A0C, 0x26A0B, 0x26A0A, 0x26AF9, 0x26B35, 0x26B24, 0x26B13, 0x26B1C, 0x26B69, 0x26B25,
+	// plane 6, row 14
+	0x26B21, 0x2F995, 0x2F996, 0x2F998, 0x27220, 0x275A9, 0x275A8, 0x275DE, 0x27609, 0x2760A, 0x2760B, 0x277E3,
+	0x27807, 0x278B2, 0x278B3, 0x2795D, 0x27E58, 0x27E86, 0x27E87, 0x27FB9, 0x27FB8, 0x2840C, 0x28444, 0x28466,
+	0x28480, 0x28475, 0x2846D, 0x2847E, 0x28467, 0x28481, 0x28477, 0x286A8, 0x286A2, 0x286A5, 0x2869B, 0x28C57,
+	0x28E0F, 0x28E32, 0x28E2C, 0x28E33, 0x291E7, 0x2006E, 0x200B7, 0x200F6, 0x200F2, 0x200F3, 0x20517, 0x20130,
+	0x20154, 0x20153, 0x20252, 0x2023D, 0x2023C, 0x20234, 0x20233, 0x20254, 0x2022F, 0x03458, 0x20255, 0x2023F,
+	0x20237, 0x20256, 0x20261, 0x20258, 0x2F806, 0x2023B, 0x20232, 0x2022E, 0x2023E, 0x2003B, 0x2048C, 0x2048D,
+	0x20513, 0x20516, 0x20512, 0x2055B, 0x2058A, 0x205D7, 0x205CE, 0x20650, 0x20651, 0x2064F, 0x2068E, 0x2068F,
+	0x206F2, 0x2F821, 0x20728, 0x2071A, 0x20725, 0x2071D, 0x20714, 0x20720, 0x2071F, 0x2071B,
+	// plane 6, row 15
+	0x20717, 0x20715, 0x2071C, 0x20713, 0x2F825, 0x20860, 0x0351C, 0x20866, 0x208E3, 0x208DE, 0x208E0, 0x208E1,
+	0x2091A, 0x2091B, 0x20918, 0x2091C, 0x20919, 0x20942, 0x20940, 0x2093E, 0x20944, 0x20974, 0x20943, 0x2F82D,
+	0x209F2, 0x20A1B, 0x20A19, 0x20A1E, 0x2F830, 0x20A5E, 0x20A66, 0x20A5D, 0x20A5A, 0x20A5F, 0x20A60, 0x20A5B,
+	0x20A5C, 0x20A59, 0x20AF0, 0x20AF1, 0x20B43, 0x20B40, 0x20B42, 0x20B41, 0x20B3F, 0x20C57, 0x20C69, 0x20C6F,
+	0x20CAB, 0x20C71, 0x20C93, 0x20C56, 0x20C90, 0x20C6D, 0x20C6C, 0x20C70, 0x20C66, 0x20C67, 0x211D7, 0x211B5,
+	0x211DB, 0x211D8, 0x211D5, 0x211D4, 0x212BA, 0x212B7, 0x212BE, 0x212B9, 0x03637, 0x212E0, 0x212DD, 0x212DE,
+	0x24918, 0x212D8, 0x212BD, 0x212DB, 0x2151D, 0x21525, 0x21721, 0x2170C, 0x21729, 0x2172D, 0x2171B, 0x2170E,
+	0x2171F, 0x21704, 0x2171C, 0x21705, 0x21706, 0x21720, 0x2170D, 0x2172A, 0x21723, 0x21711,
+	// plane 6, row 16
+	0x2195C, 0x21966, 0x2195B, 0x2194F, 0x2195E, 0x2195D, 0x219F1, 0x219EA, 0x219F4, 0x219EB, 0x219F0, 0x219FA,
+	0x219FB, 0x21B28, 0x21B2C, 0x21B6B, 0x21B2A, 0x21B6A, 0x21B6F, 0x21BDB, 0x21BE0, 0x24FD1, 0x21BE3, 0x21BE5,
+	0x21BE7, 0x21C4D, 0x21C55, 0x21C54, 0x21C53, 0x21C52, 0x21C4E, 0x21C60, 0x221C0, 0x21D0F, 0x21D11, 0x21D13,
+	0x21DC6, 0x21DB3, 0x21DC7, 0x21DD2, 0x21DB8, 0x21DAC, 0x21DAE, 0x21DCF, 0x21DC5, 0x2F87B, 0x21DCC, 0x21DAB,
+	0x21DC9, 0x21DB9, 0x21FFB, 0x21FF8, 0x21FF7, 0x21FF9, 0x22020, 0x2203B, 0x22039, 0x2209E, 0x2209A, 0x220AA,
+	0x220AB, 0x220AF, 0x22096, 0x220A9, 0x220A6, 0x22091, 0x03843, 0x220AE, 0x2209F, 0x220AC, 0x220A0, 0x22192,
+	0x22191, 0x221BF, 0x22217, 0x2220A, 0x2220C, 0x2234A, 0x22346, 0x22334, 0x22345, 0x22343, 0x22344, 0x2F893,
+	0x22387, 0x22386, 0x2238A, 0x223DA, 0x223D8, 0x038B4, 0x20518, 0x20134, 0x223D6, 0x223D4,
+	// plane 6, row 17
+	0x22454, 0x22459, 0x2245A, 0x22457, 0x038E0, 0x224DD, 0x224E9, 0x224E0, 0x22605, 0x22612, 0x22613, 0x22607,
+	0x22616, 0x22623, 0x22602, 0x2F8A1, 0x2264A, 0x22636, 0x22640, 0x22656, 0x22643, 0x2F8A0, 0x2264B, 0x22646,
+	0x2263E, 0x22649, 0x229A7, 0x229B6, 0x229A6, 0x229A8, 0x229AC, 0x229A9, 0x229AB, 0x22A38, 0x22A37, 0x22A39,
+	0x22A41, 0x22A3E, 0x22AC0, 0x22ABB, 0x22ABF, 0x22ABD, 0x22AFE, 0x22B1E, 0x22AEE, 0x22AFC, 0x2F8B7, 0x22AF9,
+	0x22B06, 0x22AE4, 0x22AE9, 0x22AE5, 0x22B03, 0x22AFD, 0x22B49, 0x22EBE, 0x22F0A, 0x25626, 0x22F18, 0x22F0D,
+	0x22F0F, 0x22F0E, 0x22F20, 0x23071, 0x23099, 0x230E2, 0x230DF, 0x230DE, 0x23178, 0x23179, 0x03AE4, 0x2317C,
+	0x2317D, 0x23184, 0x2318B, 0x2318A, 0x2F8D2, 0x23189, 0x2332C, 0x23329, 0x2336C, 0x06804, 0x23409, 0x23408,
+	0x2340C, 0x2340D, 0x23410, 0x03C26, 0x2389F, 0x23898, 0x238A2, 0x2389A, 0x239D5, 0x239E2,
+	// plane 6, row 18
+	0x239F0, 0x239EA, 0x239EB, 0x239ED, 0x239E8, 0x239E0, 0x23A85, 0x23A86, 0x23AF0, 0x21E46, 0x23B45, 0x23B38,
+	0x23B3E, 0x23B42, 0x23B40, 0x23C47, 0x23C5C, 0x23C5E, 0x23CB4, 0x23CC2, 0x23D14, 0x23CE5, 0x23D15, 0x23D11,
+	0x2F8FF, 0x23CEE, 0x23CE7, 0x23CF5, 0x23CF4, 0x23CE8, 0x23D01, 0x23CEF, 0x23CF1, 0x2F900, 0x241DB, 0x241BF,
+	0x241DA, 0x241C7, 0x241DD, 0x2F91A, 0x241EB, 0x241E1, 0x241C1, 0x241BD, 0x24507, 0x244FD, 0x24501, 0x2450A,
+	0x24503, 0x24572, 0x24574, 0x24575, 0x245B2, 0x245B1, 0x245B4, 0x2464C, 0x24642, 0x24640, 0x24649, 0x2463C,
+	0x2464D, 0x2464A, 0x2463B, 0x24761, 0x24774, 0x2F926, 0x2477F, 0x2477A, 0x24788, 0x2477C, 0x03E6F, 0x24770,
+	0x2490F, 0x24928, 0x24913, 0x2492A, 0x24AED, 0x24AEF, 0x24B2E, 0x24BC1, 0x24BDD, 0x20B47, 0x24C2D, 0x24C2B,
+	0x24C35, 0x24C2F, 0x24C31, 0x24C34, 0x2F935, 0x24C30, 0x24D3A, 0x24D39, 0x24D37, 0x24D4B,
+	// plane 6, row 19
+	0x24D54, 0x24D4D, 0x24D51, 0x24D47, 0x24F27, 0x24F50, 0x24F4D, 0x24F4E, 0x24F54, 0x24FD2, 0x24FCE, 0x2504B,
+	0x25049, 0x25105, 0x2510F, 0x25119, 0x2F943, 0x25106, 0x2510C, 0x25129, 0x25104, 0x25108, 0x25125, 0x0401C,
+	0x25103, 0x25127, 0x25110, 0x2510A, 0x2F942, 0x26B5D, 0x253AA, 0x253AB, 0x253A9, 0x25441, 0x2543A, 0x2543C,
+	0x2542B, 0x25449, 0x25615, 0x04100, 0x25616, 0x2F953, 0x25631, 0x23C4A, 0x2573C, 0x2577C, 0x2576D, 0x2576A,
+	0x25763, 0x2576B, 0x2577B, 0x25764, 0x2577A, 0x25769, 0x2576F, 0x25937, 0x25935, 0x2593C, 0x25936, 0x2593D,
+	0x2593E, 0x25AE1, 0x25AE0, 0x25E2D, 0x25E2B, 0x25F8D, 0x25F92, 0x042B6, 0x25F8E, 0x2F96B, 0x25F91, 0x25F96,
+	0x2627B, 0x26278, 0x26281, 0x26296, 0x262A3, 0x26295, 0x26297, 0x26354, 0x26352, 0x263F5, 0x26400, 0x263FB,
+	0x263F4, 0x263F6, 0x264E8, 0x264EA, 0x2663E, 0x2663D, 0x2663B, 0x26680, 0x043E4, 0x26688,
+	// plane 6, row 20
+	0x26681, 0x26682, 0x266CE, 0x26683, 0x26677, 0x26679, 0x26673, 0x2666D, 0x26670, 0x2668D, 0x26675, 0x2668C,
+	0x2666A, 0x26691, 0x26689, 0x2668E, 0x212DC, 0x268FF, 0x26959, 0x269C7, 0x26A12, 0x26A14, 0x26A13, 0x26ACB,
+	0x26B42, 0x044AD, 0x26B3D, 0x082FF, 0x26B40, 0x26B44, 0x26B62, 0x26B43, 0x26B3F, 0x26B45, 0x26B3C, 0x2F997,
+	0x26B46, 0x26B47, 0x2F9B3, 0x2719E, 0x2719D, 0x2722C, 0x27229, 0x2722F, 0x2722E, 0x27230, 0x275E1, 0x275E2,
+	0x2760E, 0x27619, 0x27612, 0x278B4, 0x27BDA, 0x27D26, 0x27E59, 0x27E5A, 0x27E88, 0x27FC0, 0x27FBA, 0x282A0,
+	0x2F9DD, 0x282A2, 0x28486, 0x08FEC, 0x2849D, 0x28488, 0x2848F, 0x2848E, 0x2849B, 0x286C1, 0x286C3, 0x286C4,
+	0x28696, 0x286C7, 0x286C6, 0x286BF, 0x048C3, 0x2890F, 0x28E55, 0x28E42, 0x28E46, 0x28E4B, 0x28E43, 0x28E53,
+	0x28E48, 0x28E49, 0x28E4A, 0x28E4E, 0x24BDE, 0x20691, 0x2944B, 0x29680, 0x29681, 0x29683,
+	// plane 6, row 21
+	0x2028A, 0x29682, 0x22342, 0x20922, 0x20070, 0x20071, 0x200BC, 0x21D18, 0x200BE, 0x200BA, 0x200F8, 0x20137,
+	0x20135, 0x20AFC, 0x20156, 0x20159, 0x2015E, 0x2026D, 0x20291, 0x20292, 0x20268, 0x20266, 0x20273, 0x03467,
+	0x20296, 0x2028B, 0x2028C, 0x20496, 0x204EE, 0x2051C, 0x2051A, 0x20519, 0x2051B, 0x2055D, 0x2055E, 0x2F818,
+	0x205DC, 0x205E2, 0x20652, 0x20692, 0x20730, 0x20752, 0x20742, 0x20741, 0x20745, 0x20737, 0x20740, 0x2073F,
+	0x2073D, 0x20738, 0x2073A, 0x20749, 0x2086B, 0x20878, 0x20879, 0x2F826, 0x2086C, 0x208E9, 0x208E6, 0x208E5,
+	0x208EA, 0x208E7, 0x208E8, 0x2091F, 0x2094B, 0x2094A, 0x20953, 0x20976, 0x209A3, 0x209A4, 0x209F6, 0x209F3,
+	0x209F9, 0x209F7, 0x209FC, 0x20A1D, 0x20A6D, 0x20A71, 0x20A6C, 0x20A6E, 0x20A70, 0x20A6F, 0x20A67, 0x20A68,
+	0x20AFA, 0x20AF9, 0x20B4E, 0x053DE, 0x20B4D, 0x20B4F, 0x20B4A, 0x20B4C, 0x03596, 0x2F842,
+	// plane 6, row 22
+	0x20CEE, 0x20CB2, 0x20CC0, 0x20CC1, 0x20CF4, 0x20CC8, 0x20CC5, 0x20CC6, 0x20CAD, 0x211E2, 0x211EA, 0x211E3,
+	0x211E1, 0x212F7, 0x21301, 0x21312, 0x212F6, 0x212F1, 0x2131F, 0x212EE, 0x2F852, 0x212F3, 0x21315, 0x21316,
+	0x21317, 0x212F8, 0x21319, 0x212F2, 0x212F4, 0x212F5, 0x21313, 0x21306, 0x21526, 0x21524, 0x2155A, 0x22EC8,
+	0x21597, 0x21595, 0x2159A, 0x2161F, 0x20AFB, 0x21629, 0x21620, 0x2F862, 0x2F863, 0x2174C, 0x21730, 0x21738,
+	0x2173D, 0x21751, 0x2174F, 0x2174A, 0x21734, 0x21736, 0x05A30, 0x2196A, 0x21968, 0x21A1C, 0x21A0E, 0x21A1E,
+	0x03759, 0x21A09, 0x21A08, 0x21A13, 0x21A01, 0x21A0F, 0x21A14, 0x21A06, 0x21A07, 0x05BB2, 0x2F873, 0x03776,
+	0x21B79, 0x21BEA, 0x21BED, 0x21BE9, 0x21BEE, 0x21C68, 0x21C64, 0x21C67, 0x21C72, 0x21C62, 0x21C74, 0x21C79,
+	0x21D19, 0x21D17, 0x21D15, 0x21D16, 0x21DE6, 0x262A4, 0x21DEE, 0x037D2, 0x21DDF, 0x21DE4,
+	// plane 6, row 23
+	0x21DDA, 0x21DEA, 0x21DED, 0x21DE3, 0x21DE9, 0x21FFD, 0x20657, 0x22021, 0x220C6, 0x220B8, 0x220CB, 0x2F885,
+	0x220BD, 0x220B5, 0x220BB, 0x220BF, 0x220BE, 0x220B2, 0x220C1, 0x220C2, 0x22199, 0x221C6, 0x2222C, 0x2222D,
+	0x22225, 0x2221E, 0x2221F, 0x22223, 0x22350, 0x2234E, 0x2234D, 0x22352, 0x223E9, 0x223EC, 0x223E8, 0x22458,
+	0x2245C, 0x2245B, 0x2248F, 0x23972, 0x224F6, 0x22500, 0x224FC, 0x224F8, 0x224EA, 0x224FE, 0x224F7, 0x224FD,
+	0x22670, 0x22662, 0x22644, 0x03920, 0x2264D, 0x2264C, 0x2263F, 0x22666, 0x22635, 0x03929, 0x22634, 0x2268D,
+	0x22684, 0x03938, 0x22686, 0x22689, 0x22687, 0x22683, 0x22675, 0x22679, 0x226AF, 0x226B0, 0x229B7, 0x229BB,
+	0x229B9, 0x22A46, 0x22A47, 0x22A45, 0x22AEA, 0x22AF6, 0x22B68, 0x22B39, 0x2F8B9, 0x22B3D, 0x22B3B, 0x22B4D,
+	0x22B30, 0x22B4A, 0x22B3E, 0x22B40, 0x22B4C, 0x22B47, 0x22B38, 0x22B52, 0x22B3A, 0x22B53,
+	// plane 6, row 24
+	0x22EC4, 0x22EC1, 0x22F1C, 0x22F1D, 0x22F2A, 0x22F1E, 0x22F2F, 0x22F22, 0x22F2E, 0x22F25, 0x03A89, 0x03AB0,
+	0x2304A, 0x2304B, 0x23076, 0x03ABF, 0x230E8, 0x230EF, 0x230E9, 0x03AC5, 0x230EA, 0x2F8CC, 0x03AEA, 0x2319B,
+	0x2319E, 0x23193, 0x231A7, 0x2319C, 0x231A0, 0x2319A, 0x231AB, 0x231BE, 0x231A9, 0x2332D, 0x2336E, 0x23444,
+	0x2343D, 0x2343A, 0x23468, 0x2343C, 0x2346A, 0x2F8E0, 0x23438, 0x23465, 0x23439, 0x2346D, 0x23436, 0x2F8E3,
+	0x2343E, 0x2347E, 0x23437, 0x23899, 0x238A9, 0x238AD, 0x238A7, 0x238A8, 0x23966, 0x23969, 0x2396D, 0x23967,
+	0x2396B, 0x2396A, 0x239EE, 0x23A01, 0x23A03, 0x239F4, 0x239FB, 0x03C37, 0x239F6, 0x239FC, 0x23AF4, 0x23B08,
+	0x23B0A, 0x23B09, 0x23B6D, 0x23B62, 0x23B41, 0x23B5E, 0x23B5C, 0x23CF3, 0x23D26, 0x03CE4, 0x23D39, 0x2F904,
+	0x23D6C, 0x23D2B, 0x23D2E, 0x23D3B, 0x23D5E, 0x23DFB, 0x23D27, 0x23D24, 0x23D69, 0x23D30,
+	// plane 6, row 25
+	0x2F905, 0x23D62, 0x23D38, 0x23D35, 0x23D2A, 0x23D2C, 0x23D68, 0x23D31, 0x23D2F, 0x23D2D, 0x23D3A, 0x23D36,
+	0x2F903, 0x23D21, 0x23D3C, 0x23D20, 0x23D64, 0x23D3E, 0x03CE8, 0x241F7, 0x24212, 0x241F1, 0x241F5, 0x24222,
+	0x241F2, 0x241DF, 0x24215, 0x24216, 0x2457A, 0x24576, 0x245BE, 0x03E20, 0x245BD, 0x24609, 0x24608, 0x24657,
+	0x247A3, 0x247BF, 0x247B8, 0x247AF, 0x2479C, 0x247A5, 0x24772, 0x24775, 0x2479D, 0x24799, 0x247B9, 0x2494E,
+	0x24939, 0x2493B, 0x24935, 0x2493C, 0x24955, 0x24AF0, 0x24AF3, 0x24AF4, 0x24B3B, 0x24B3C, 0x24B3A, 0x24B36,
+	0x24C07, 0x20CEB, 0x24C55, 0x24C50, 0x24C4F, 0x24C52, 0x24C56, 0x200BD, 0x24C32, 0x24D63, 0x24D6B, 0x24D66,
+	0x24D57, 0x24D5D, 0x03F86, 0x24D6D, 0x24D61, 0x24D69, 0x24D5A, 0x24D5C, 0x24D62, 0x24F2A, 0x24F29, 0x24F58,
+	0x24F5A, 0x24FD7, 0x24FDB, 0x24FDC, 0x24FDD, 0x24FD8, 0x25054, 0x2505B, 0x2505C, 0x25053,
+	// plane 6, row 26
+	0x2504F, 0x25056, 0x25050, 0x2505A, 0x2506B, 0x25136, 0x25153, 0x2513A, 0x2513C, 0x2513E, 0x25149, 0x25140,
+	0x2F946, 0x2F947, 0x25364, 0x25365, 0x253B5, 0x253B6, 0x253B2, 0x25448, 0x2544A, 0x25472, 0x25469, 0x2545A,
+	0x2544C, 0x2562C, 0x25630, 0x2564B, 0x25649, 0x25642, 0x25644, 0x2564A, 0x2564F, 0x25792, 0x2F957, 0x25797,
+	0x25780, 0x25782, 0x25786, 0x25953, 0x2595E, 0x25952, 0x2595B, 0x2594E, 0x25A6D, 0x25A6E, 0x25AFA, 0x25AF6,
+	0x25AFB, 0x25E33, 0x25E3D, 0x25E37, 0x25E3E, 0x25E35, 0x25F9A, 0x25FAB, 0x25FA6, 0x25FB0, 0x25F99, 0x25FA0,
+	0x25F9E, 0x25FA8, 0x25FA1, 0x25FAA, 0x25FAD, 0x25FBB, 0x25F9C, 0x25FA5, 0x200B3, 0x26227, 0x2628D, 0x2628E,
+	0x2628F, 0x26292, 0x04356, 0x26291, 0x262AD, 0x26357, 0x26358, 0x2635E, 0x2635D, 0x2635C, 0x2635B, 0x04367,
+	0x2640A, 0x26403, 0x2640E, 0x264B8, 0x2654D, 0x2653F, 0x26547, 0x2654B, 0x2654A, 0x2653D,
+	// plane 6, row 27
+	0x08038, 0x26541, 0x2654C, 0x08081, 0x26662, 0x26669, 0x26661, 0x043D1, 0x266AA, 0x266A6, 0x266AC, 0x266BD,
+	0x266BB, 0x266A4, 0x266BA, 0x2669A, 0x043EB, 0x266A1, 0x266C1, 0x268E0, 0x2690A, 0x26903, 0x26906, 0x26939,
+	0x2693A, 0x26936, 0x2695B, 0x2695F, 0x2695E, 0x26967, 0x26961, 0x26962, 0x2695D, 0x269CA, 0x269CC, 0x269CE,
+	0x26A18, 0x26A1D, 0x26A1F, 0x26A20, 0x26A17, 0x26A15, 0x26B1F, 0x26B27, 0x26B26, 0x21E10, 0x26B8B, 0x26BAE,
+	0x26B8A, 0x2F999, 0x26B92, 0x26B89, 0x26B87, 0x044B6, 0x26B8F, 0x26B84, 0x26B83, 0x26B8C, 0x26B93, 0x26B8D,
+	0x26B98, 0x26B7D, 0x26B7E, 0x26BD2, 0x26B80, 0x26B99, 0x271AC, 0x27250, 0x27255, 0x27242, 0x2723F, 0x2723C,
+	0x045A3, 0x2724C, 0x27249, 0x27257, 0x27258, 0x2724F, 0x2725C, 0x27247, 0x2F9B7, 0x275AB, 0x04610, 0x275AF,
+	0x275AD, 0x275E8, 0x275E7, 0x27630, 0x27626, 0x2762F, 0x27628, 0x2762B, 0x2761D, 0x2762D,
+	// plane 6, row 28
+	0x27620, 0x2762A, 0x2762C, 0x27635, 0x27621, 0x27623, 0x27624, 0x27636, 0x27637, 0x277E9, 0x278BA, 0x278B8,
+	0x2F9CC, 0x2796D, 0x2796A, 0x27968, 0x27969, 0x2796B, 0x27961, 0x27BDC, 0x27BDB, 0x27C2D, 0x27C2C, 0x27CA2,
+	0x27D2B, 0x27D32, 0x2F9D7, 0x27E94, 0x27E92, 0x27E90, 0x27FC9, 0x27FC4, 0x27FC1, 0x27FC3, 0x27FCD, 0x28214,
+	0x282A7, 0x282AF, 0x282AA, 0x2840D, 0x28454, 0x2845B, 0x28461, 0x284AE, 0x284B3, 0x284C0, 0x284C4, 0x284BF,
+	0x284CB, 0x284AD, 0x284A7, 0x284A4, 0x284BD, 0x284AF, 0x284B2, 0x284A5, 0x286E7, 0x286E0, 0x286CE, 0x286DE,
+	0x286D5, 0x286DF, 0x286D9, 0x287F2, 0x28923, 0x28940, 0x0491B, 0x2893E, 0x04987, 0x28CCF, 0x20B54, 0x28E5B,
+	0x28E58, 0x28E62, 0x28E5F, 0x28E67, 0x28E63, 0x28E5E, 0x28E60, 0x28F85, 0x28F86, 0x28F87, 0x291E8, 0x291E6,
+	0x29671, 0x29685, 0x2FA02, 0x29686, 0x29810, 0x209F8, 0x200BF, 0x20B61, 0x200FE, 0x200FC,
+	// plane 6, row 29
+	0x20139, 0x20161, 0x20160, 0x202E2, 0x202EA, 0x202E3, 0x202B4, 0x202AE, 0x202BE, 0x202B8, 0x202A8, 0x202AA,
+	0x202A9, 0x202B3, 0x202D5, 0x202AD, 0x202B9, 0x202BB, 0x202B1, 0x202C2, 0x2F808, 0x202EB, 0x202BA, 0x202D2,
+	0x202D4, 0x204F1, 0x2051D, 0x2F812, 0x20562, 0x2058C, 0x205E6, 0x205E7, 0x2065A, 0x20658, 0x20659, 0x20696,
+	0x20697, 0x20761, 0x20767, 0x20771, 0x20765, 0x2077D, 0x2077E, 0x2087D, 0x20884, 0x2087C, 0x2087E, 0x2087F,
+	0x20880, 0x208EF, 0x208F4, 0x2091E, 0x2094E, 0x209FB, 0x209FA, 0x209FD, 0x2F831, 0x2F832, 0x20A79, 0x20A7C,
+	0x20A7D, 0x20A84, 0x20A7B, 0x20A78, 0x03557, 0x20B5E, 0x20B5A, 0x20B5C, 0x20B59, 0x20B55, 0x20B63, 0x20B56,
+	0x20B5F, 0x20B60, 0x20B5B, 0x20D4A, 0x20D65, 0x20DB3, 0x20D2C, 0x20D77, 0x20D3D, 0x20D52, 0x20D61, 0x20D2A,
+	0x20D3E, 0x20D34, 0x20D29, 0x20DB2, 0x20DAD, 0x20D40, 0x20D53, 0x2F844, 0x20D3F, 0x20D41,
+	// plane 6, row 30
+	0x20D72, 0x211F6, 0x211F5, 0x211F4, 0x211F2, 0x211F9, 0x21327, 0x21354, 0x21355, 0x2132E, 0x2F854, 0x2F853,
+	0x2132C, 0x21338, 0x21339, 0x21331, 0x2134F, 0x21373, 0x21330, 0x2132B, 0x21351, 0x2152C, 0x2155B, 0x2155C,
+	0x21568, 0x2156C, 0x2156B, 0x21569, 0x2159F, 0x21638, 0x2163C, 0x2163A, 0x21635, 0x0369D, 0x21639, 0x21636,
+	0x2163B, 0x21760, 0x21761, 0x21763, 0x21764, 0x21794, 0x21793, 0x2175E, 0x21768, 0x2176A, 0x21765, 0x2F866,
+	0x21790, 0x2175F, 0x21772, 0x2F865, 0x21A3C, 0x21A27, 0x21A24, 0x21A26, 0x21A25, 0x0375F, 0x21A28, 0x21A36,
+	0x21B31, 0x21B30, 0x21B34, 0x21B81, 0x21B7D, 0x21B82, 0x21B80, 0x03779, 0x202D3, 0x21BF2, 0x21C66, 0x21C8C,
+	0x21C7B, 0x21C83, 0x03798, 0x21C8E, 0x21C7A, 0x21C92, 0x21C91, 0x21C82, 0x21D1B, 0x21D1C, 0x21E27, 0x21E21,
+	0x037DC, 0x05D2B, 0x21E43, 0x037DF, 0x21E18, 0x21E7B, 0x21E1A, 0x21E4B, 0x21E4A, 0x21E4D,
+	// plane 6, row 31
+	0x21E4F, 0x21E19, 0x21E35, 0x21E13, 0x21E52, 0x21E14, 0x21E1E, 0x21E2C, 0x21E20, 0x21E22, 0x21E12, 0x21E1F,
+	0x22000, 0x22023, 0x22040, 0x22043, 0x220E4, 0x220DB, 0x220EA, 0x220DD, 0x220CC, 0x220D9, 0x220E8, 0x220F6,
+	0x220E3, 0x220D3, 0x220DA, 0x220D6, 0x220E7, 0x2223A, 0x2223F, 0x22240, 0x22248, 0x22259, 0x22237, 0x22244,
+	0x2F88C, 0x2F88B, 0x22255, 0x22239, 0x22354, 0x22355, 0x22356, 0x22357, 0x22358, 0x22359, 0x2238D, 0x223F2,
+	0x223F8, 0x223F5, 0x223F6, 0x223FC, 0x223FE, 0x223F1, 0x223FD, 0x2245E, 0x22496, 0x22497, 0x2249C, 0x2249B,
+	0x22495, 0x2F89A, 0x2251A, 0x22509, 0x22504, 0x2250E, 0x2251C, 0x22518, 0x2250D, 0x22510, 0x2250C, 0x22503,
+	0x2267B, 0x226A6, 0x22677, 0x22688, 0x22674, 0x226DA, 0x22676, 0x22678, 0x2268A, 0x2268F, 0x2267D, 0x22690,
+	0x226ED, 0x226D9, 0x226D0, 0x2271A, 0x226D7, 0x226E2, 0x226E1, 0x226C5, 0x226E0, 0x226CA,
+	// plane 6, row 32
+	0x22725, 0x226CC, 0x2F8B3, 0x229C6, 0x229C1, 0x22A4D, 0x22B4B, 0x22B64, 0x22B95, 0x22B99, 0x2F8BC, 0x22B94,
+	0x22BA2, 0x22BAE, 0x22B9E, 0x22BA7, 0x22B86, 0x039FD, 0x22BA4, 0x22B91, 0x22B93, 0x2F8BB, 0x22B88, 0x22ECD,
+	0x22ECA, 0x22F3F, 0x22F40, 0x22F46, 0x22F41, 0x22F45, 0x22F58, 0x22F3B, 0x22F48, 0x2304E, 0x23052, 0x2304F,
+	0x2307B, 0x2307A, 0x230A0, 0x2309F, 0x230FB, 0x230F7, 0x231B8, 0x231B9, 0x231BB, 0x231B7, 0x03AF4, 0x231BA,
+	0x03AEF, 0x231DA, 0x231B5, 0x231BF, 0x231BC, 0x231C0, 0x2F8D3, 0x2F8D9, 0x23375, 0x23379, 0x23376, 0x23435,
+	0x23440, 0x234C0, 0x23481, 0x234AD, 0x234AF, 0x234AC, 0x2348F, 0x234A8, 0x234AA, 0x234A9, 0x23488, 0x2347F,
+	0x23480, 0x234BC, 0x238AE, 0x238BB, 0x238BD, 0x03C31, 0x23978, 0x23974, 0x23A0C, 0x23A11, 0x23A08, 0x23A06,
+	0x23A10, 0x23A8F, 0x23A90, 0x23A8D, 0x23A8E, 0x23A96, 0x23A95, 0x23B0B, 0x23B0C, 0x23B7C,
+	// plane 6, row 33
+	0x23B73, 0x23B75, 0x23B76, 0x23B7D, 0x23B78, 0x23B71, 0x23C4B, 0x23C4E, 0x23D33, 0x23D32, 0x03D03, 0x23D91,
+	0x23DE7, 0x23DE9, 0x23DA2, 0x23D94, 0x23D87, 0x23DA3, 0x23DDD, 0x23D7B, 0x23D83, 0x23D81, 0x23DDF, 0x23D7C,
+	0x23DE4, 0x23DE2, 0x23D93, 0x23D7D, 0x23DBF, 0x23D9B, 0x23D8E, 0x23D9F, 0x03D09, 0x23D8C, 0x23D7F, 0x23D9C,
+	0x23D84, 0x23D42, 0x23DE6, 0x24251, 0x2424A, 0x24264, 0x24225, 0x2422F, 0x2422E, 0x2422B, 0x24228, 0x24232,
+	0x2422D, 0x24231, 0x24239, 0x2422C, 0x24261, 0x24511, 0x24510, 0x24512, 0x24553, 0x24555, 0x2457B, 0x24581,
+	0x2457D, 0x2457C, 0x245C2, 0x245C5, 0x2F922, 0x245C4, 0x2F923, 0x2466B, 0x24668, 0x03E3E, 0x2465C, 0x2465D,
+	0x2466A, 0x2F924, 0x24C76, 0x24776, 0x03E77, 0x247C4, 0x247CB, 0x247C8, 0x247D4, 0x247D5, 0x247C9, 0x247D7,
+	0x03E7B, 0x24978, 0x2495A, 0x2495B, 0x2495C, 0x24956, 0x24958, 0x24971, 0x269D4, 0x24B40,
+	// plane 6, row 34
+	0x2F933, 0x24B3F, 0x24B43, 0x24B41, 0x24BE2, 0x24BE0, 0x24BE3, 0x24C66, 0x24C73, 0x24C6C, 0x24C71, 0x24C6A,
+	0x24C6D, 0x24C6E, 0x24C6B, 0x24D8C, 0x24D77, 0x2F93A, 0x24D7F, 0x24D89, 0x24D7A, 0x24D85, 0x24D78, 0x24D8A,
+	0x24D86, 0x24F2C, 0x24F67, 0x24F5B, 0x24FE5, 0x24FE1, 0x25061, 0x25069, 0x2506A, 0x25165, 0x2516D, 0x25163,
+	0x25186, 0x2515C, 0x25162, 0x2F948, 0x25179, 0x25169, 0x25170, 0x25176, 0x2515D, 0x25187, 0x2516E, 0x25171,
+	0x2517C, 0x25173, 0x2515B, 0x2516B, 0x253BF, 0x253C1, 0x253BD, 0x253C9, 0x253BC, 0x253C2, 0x253C0, 0x25492,
+	0x254A9, 0x2548F, 0x25476, 0x2547B, 0x25475, 0x254A4, 0x25664, 0x2573D, 0x257AF, 0x0413A, 0x2F958, 0x2579D,
+	0x25799, 0x257B1, 0x25963, 0x25962, 0x25964, 0x25969, 0x041A0, 0x25A75, 0x25A73, 0x25A71, 0x25A74, 0x25B0C,
+	0x25B16, 0x041E5, 0x25B11, 0x25B1F, 0x25B1A, 0x25B0D, 0x25B1B, 0x25B13, 0x25E4E, 0x25E55,
+	// plane 6, row 35
+	0x25E50, 0x0427D, 0x25FD2, 0x25FD3, 0x25FD1, 0x25FF1, 0x25FDC, 0x25FC8, 0x25FCC, 0x25FD0, 0x25FCF, 0x25FDF,
+	0x2622B, 0x2622E, 0x2622D, 0x2629D, 0x2629E, 0x2629F, 0x262A9, 0x262A0, 0x26298, 0x262A1, 0x262AB, 0x262AF,
+	0x2636E, 0x2635F, 0x2635A, 0x0436D, 0x26365, 0x26368, 0x26372, 0x26417, 0x26416, 0x26418, 0x26419, 0x2641A,
+	0x26422, 0x2641B, 0x2641C, 0x264BE, 0x264EE, 0x2655A, 0x26550, 0x26558, 0x26554, 0x26557, 0x26556, 0x26615,
+	0x2669B, 0x26693, 0x26692, 0x26672, 0x26698, 0x26699, 0x266DF, 0x043F8, 0x266D5, 0x08158, 0x266D4, 0x266F3,
+	0x266F4, 0x266E7, 0x266E1, 0x266E6, 0x266EB, 0x266EC, 0x202DB, 0x04450, 0x2690D, 0x2690C, 0x21B2F, 0x26968,
+	0x26965, 0x2696B, 0x26969, 0x269D1, 0x269D3, 0x26A27, 0x26A28, 0x04468, 0x04467, 0x26A24, 0x26AD7, 0x26BCA,
+	0x26BC7, 0x2F99E, 0x26BD8, 0x26BE1, 0x26BC6, 0x26BF8, 0x26BC3, 0x26BF4, 0x26C17, 0x26BEA,
+	// plane 6, row 36
+	0x26BCB, 0x26B86, 0x26BC4, 0x26BD9, 0x26C19, 0x26BC2, 0x26BE2, 0x044CF, 0x26BDE, 0x26BED, 0x2F99C, 0x044CD,
+	0x26C1D, 0x26BDD, 0x26BDB, 0x26BE8, 0x26BE9, 0x26BBF, 0x26BE0, 0x26BD1, 0x26BDC, 0x26BCE, 0x26C1E, 0x26BCC,
+	0x26BF2, 0x26BF3, 0x2F99F, 0x2F9A2, 0x2F9A3, 0x2F9A1, 0x271AB, 0x271AA, 0x271A7, 0x271A9, 0x27288, 0x27275,
+	0x27260, 0x2726C, 0x27273, 0x045AB, 0x2726E, 0x27274, 0x27276, 0x27268, 0x27277, 0x27286, 0x275EA, 0x27651,
+	0x27650, 0x27658, 0x2764D, 0x2764F, 0x2764E, 0x2765C, 0x27652, 0x27644, 0x2764A, 0x2764B, 0x277EA, 0x27810,
+	0x27811, 0x278CD, 0x278BF, 0x278C4, 0x278D0, 0x278CE, 0x278C0, 0x278C2, 0x278CF, 0x278C9, 0x278BB, 0x27997,
+	0x27992, 0x2796F, 0x2797E, 0x2799A, 0x046C1, 0x27986, 0x27973, 0x08A24, 0x27977, 0x2798F, 0x27970, 0x27981,
+	0x27982, 0x27999, 0x2797D, 0x2797F, 0x2797B, 0x27987, 0x27BB2, 0x27C2F, 0x27C34, 0x27C2E,
+	// plane 6, row 37
+	0x27C32, 0x04747, 0x27CAA, 0x04757, 0x27CA9, 0x27D38, 0x27D36, 0x27D37, 0x27D47, 0x27D33, 0x27D39, 0x27D35,
+	0x27D44, 0x27EA5, 0x27EA6, 0x27E9E, 0x27FE3, 0x27FDF, 0x27FD3, 0x27FF1, 0x27FE4, 0x27FE0, 0x27FD6, 0x08DC3,
+	0x27FE6, 0x27FD8, 0x27FDE, 0x27FDB, 0x27FDC, 0x2821B, 0x2821F, 0x2821D, 0x2821C, 0x2821E, 0x28220, 0x28221,
+	0x0484E, 0x282B6, 0x282BC, 0x282C6, 0x282C7, 0x282BA, 0x282BE, 0x282BD, 0x282B5, 0x282B4, 0x2840F, 0x28462,
+	0x284EB, 0x284D9, 0x284E4, 0x284D7, 0x284D8, 0x284D6, 0x284CE, 0x284DD, 0x284E7, 0x284D2, 0x284C5, 0x284C9,
+	0x284DB, 0x28692, 0x2868A, 0x2868B, 0x28689, 0x28708, 0x28703, 0x28706, 0x28705, 0x286F5, 0x2870B, 0x286FB,
+	0x287FC, 0x28801, 0x28802, 0x287FA, 0x28808, 0x287F7, 0x28800, 0x287FF, 0x28806, 0x287F6, 0x287FB, 0x2880A,
+	0x28925, 0x28943, 0x28944, 0x28C64, 0x28C62, 0x28C5E, 0x28C5B, 0x28CD6, 0x20072, 0x200C2,
+	// plane 6, row 38
+	0x28E77, 0x28E82, 0x28E7C, 0x28E7D, 0x28E86, 0x28E81, 0x28E84, 0x28E76, 0x28E83, 0x28E7F, 0x28E7E, 0x28F88,
+	0x28F8D, 0x28F8B, 0x28F91, 0x28F8F, 0x2907C, 0x29079, 0x2907A, 0x291EA, 0x2944C, 0x29599, 0x202D1, 0x2968B,
+	0x2968D, 0x2968A, 0x2968E, 0x29811, 0x22256, 0x2FA15, 0x20074, 0x200C3, 0x200C4, 0x2011B, 0x2015F, 0x2016A,
+	0x20169, 0x2016B, 0x2030C, 0x202F6, 0x202ED, 0x20329, 0x202FE, 0x202F1, 0x20317, 0x202FF, 0x202EE, 0x202FD,
+	0x2031C, 0x202FC, 0x20300, 0x20320, 0x03477, 0x202F9, 0x20367, 0x20308, 0x2049E, 0x204F3, 0x20525, 0x20527,
+	0x2051F, 0x20565, 0x20563, 0x20594, 0x20597, 0x205F1, 0x2065F, 0x20662, 0x051F2, 0x20660, 0x2F822, 0x2078C,
+	0x20782, 0x20790, 0x2078B, 0x2078D, 0x20781, 0x2079D, 0x2088E, 0x2088F, 0x20892, 0x20923, 0x20952, 0x2F82E,
+	0x20A00, 0x20A01, 0x20A02, 0x20A1F, 0x20A8C, 0x20A89, 0x20A8B, 0x20A88, 0x20A8D, 0x20A8F,
+	// plane 6, row 39
+	0x26385, 0x20B00, 0x20B05, 0x20B01, 0x20B68, 0x20B6E, 0x20B67, 0x20B75, 0x055B6, 0x2F845, 0x20DD7, 0x2F846,
+	0x20048, 0x20DD4, 0x20DD8, 0x2F847, 0x2F848, 0x20DBA, 0x2F849, 0x20DDB, 0x20DBF, 0x20E35, 0x20DBC, 0x20DD9,
+	0x035C4, 0x20DDD, 0x20E00, 0x20DD5, 0x20E30, 0x20DBD, 0x20DDC, 0x211FD, 0x211FE, 0x21207, 0x24517, 0x2136F,
+	0x21369, 0x21370, 0x21367, 0x213A9, 0x21395, 0x21390, 0x2136C, 0x21397, 0x21371, 0x03652, 0x21374, 0x2136D,
+	0x2138E, 0x2152F, 0x2A31B, 0x215A9, 0x2164E, 0x2F85F, 0x2165A, 0x21648, 0x21655, 0x2164C, 0x21649, 0x2164F,
+	0x2164A, 0x217D6, 0x217A0, 0x217A9, 0x2F867, 0x2179D, 0x217D4, 0x217A4, 0x217A8, 0x217A6, 0x217E6, 0x2197D,
+	0x21977, 0x2197A, 0x21A41, 0x21A49, 0x21A59, 0x21A45, 0x21A48, 0x21A40, 0x21B8E, 0x21B95, 0x21B90, 0x21BF7,
+	0x21BF8, 0x21BF6, 0x21BFB, 0x21C9E, 0x21C9D, 0x21C99, 0x2F877, 0x21CA3, 0x21CA9, 0x21C98,
+	// plane 6, row 40
+	0x21CA0, 0x21C96, 0x21C94, 0x21C95, 0x21C9F, 0x21CA1, 0x21D21, 0x21D1D, 0x21D1F, 0x21E6D, 0x2F87C, 0x21E9A,
+	0x21E92, 0x21E7A, 0x21E7D, 0x21EA1, 0x21E9D, 0x21E99, 0x21E6B, 0x21E6E, 0x2F87D, 0x22045, 0x2F884, 0x220FB,
+	0x220EB, 0x220F2, 0x220F9, 0x2F886, 0x220F8, 0x220ED, 0x22101, 0x220F7, 0x22106, 0x2219B, 0x221CA, 0x0386B,
+	0x05EC3, 0x2226B, 0x2226C, 0x22274, 0x22267, 0x2225B, 0x22260, 0x22276, 0x22263, 0x22261, 0x22328, 0x2235B,
+	0x2235E, 0x22360, 0x2235D, 0x2235C, 0x223F4, 0x22400, 0x22408, 0x22407, 0x22401, 0x22405, 0x22464, 0x22463,
+	0x2249E, 0x224A0, 0x224A1, 0x2249F, 0x22526, 0x2252D, 0x22528, 0x2251D, 0x226EC, 0x226E3, 0x226EB, 0x22716,
+	0x226C8, 0x2661B, 0x226E9, 0x226E4, 0x22724, 0x226D1, 0x03941, 0x226DD, 0x226C2, 0x226CB, 0x226C7, 0x226E7,
+	0x03950, 0x226EA, 0x2274B, 0x2F8A5, 0x22760, 0x2277D, 0x2273E, 0x2F8A4, 0x22752, 0x2274E,
+	// plane 6, row 41
+	0x2273C, 0x22732, 0x22730, 0x22723, 0x229CA, 0x229CB, 0x229C9, 0x229C8, 0x229CD, 0x22B98, 0x22BA0, 0x22B9F,
+	0x22B9C, 0x22BA3, 0x22B97, 0x2F8BE, 0x22BF1, 0x22C09, 0x22C03, 0x22BEA, 0x22C45, 0x22BED, 0x22C05, 0x22C1A,
+	0x22C15, 0x22C01, 0x22BEC, 0x22C0E, 0x22C17, 0x22C42, 0x22C12, 0x22C10, 0x22BEF, 0x22BFF, 0x22C00, 0x22C0C,
+	0x22C0F, 0x22C04, 0x22C08, 0x22C14, 0x22C43, 0x2F8BD, 0x22C1B, 0x22C11, 0x22C13, 0x22ECF, 0x22ECE, 0x22F6F,
+	0x22F6E, 0x22F7A, 0x22F70, 0x22F64, 0x22F5E, 0x22F6C, 0x2F8C9, 0x22F5B, 0x22F61, 0x22F65, 0x2307F, 0x23080,
+	0x2307C, 0x230A7, 0x230A6, 0x230A1, 0x03AC0, 0x230A8, 0x230A3, 0x230A2, 0x230AD, 0x230A5, 0x23101, 0x230FF,
+	0x230FC, 0x23100, 0x23135, 0x231EE, 0x231EF, 0x231F6, 0x231E8, 0x231EA, 0x231E3, 0x2321F, 0x03AFC, 0x231E4,
+	0x231FA, 0x231F1, 0x231FB, 0x2F8D4, 0x2333D, 0x2333C, 0x03479, 0x23378, 0x23377, 0x0440B,
+	// plane 6, row 42
+	0x234D1, 0x234C7, 0x234DF, 0x234D0, 0x234E0, 0x234D6, 0x234D8, 0x23516, 0x2350E, 0x234D9, 0x2350F, 0x23511,
+	0x234CD, 0x23489, 0x234CE, 0x23514, 0x234DA, 0x23512, 0x234D3, 0x234C2, 0x234E1, 0x234E9, 0x234EA, 0x234DE,
+	0x23515, 0x238D9, 0x238D6, 0x238CD, 0x238D0, 0x238D3, 0x2F8F0, 0x23982, 0x23985, 0x2397F, 0x2397D, 0x23981,
+	0x23983, 0x23984, 0x284A0, 0x23A28, 0x23A0F, 0x23A17, 0x23A1A, 0x23A25, 0x2F8F7, 0x23A9B, 0x23A99, 0x23B92,
+	0x23B8C, 0x23B95, 0x23B8D, 0x23BA3, 0x23B93, 0x23B91, 0x23DDB, 0x23D99, 0x23D9A, 0x23E08, 0x23E4C, 0x23E0D,
+	0x23E01, 0x23E4E, 0x23E02, 0x23E4D, 0x23E21, 0x23DFC, 0x2F909, 0x23D8A, 0x2F908, 0x23D95, 0x23E11, 0x23E12,
+	0x23E46, 0x23E1C, 0x23E49, 0x23E0C, 0x03D1E, 0x23E13, 0x23E16, 0x23DFD, 0x23E0F, 0x23E1F, 0x24230, 0x2426E,
+	0x2426B, 0x2429B, 0x2427B, 0x24263, 0x24297, 0x2426D, 0x2429C, 0x24298, 0x2426F, 0x24267,
+	// plane 6, row 43
+	0x24269, 0x24515, 0x24563, 0x24586, 0x2458A, 0x24587, 0x24588, 0x24585, 0x24589, 0x245CA, 0x245C7, 0x245CB,
+	0x245CC, 0x245C9, 0x2460D, 0x24683, 0x24684, 0x24678, 0x24682, 0x24673, 0x24679, 0x2468C, 0x247D0, 0x247CF,
+	0x247D2, 0x247D9, 0x247CC, 0x247EB, 0x247FD, 0x247EC, 0x247E8, 0x247F8, 0x247FA, 0x2F927, 0x03E85, 0x247E1,
+	0x247FB, 0x248E7, 0x2F92E, 0x249A5, 0x24991, 0x249A6, 0x2497C, 0x24992, 0x249A2, 0x249A0, 0x24AFC, 0x24AFE,
+	0x24B57, 0x24B53, 0x24B58, 0x24BE7, 0x24C8E, 0x2F938, 0x24C83, 0x24C8B, 0x24C84, 0x03F97, 0x24DA2, 0x24DB7,
+	0x24DA9, 0x24DA5, 0x24D9F, 0x24DAA, 0x24D97, 0x24DA1, 0x24D9E, 0x24DAB, 0x24D99, 0x24DA3, 0x24F30, 0x24F32,
+	0x24F2F, 0x24F70, 0x24F6C, 0x24F6F, 0x24FE8, 0x24FEE, 0x24FEA, 0x2506D, 0x25076, 0x25070, 0x25071, 0x2506C,
+	0x251EB, 0x251B5, 0x25196, 0x25190, 0x2518D, 0x2F949, 0x251A2, 0x251B0, 0x25192, 0x251A0,
+	// plane 6, row 44
+	0x25193, 0x251C3, 0x2518E, 0x251B6, 0x2519D, 0x25195, 0x251B3, 0x251A4, 0x25370, 0x253D4, 0x04093, 0x253CF,
+	0x20170, 0x25494, 0x07861, 0x254C0, 0x254B4, 0x254C1, 0x040B2, 0x254BD, 0x254AF, 0x25677, 0x25678, 0x2566B,
+	0x2566D, 0x2566E, 0x25672, 0x2566F, 0x25671, 0x230AB, 0x2568C, 0x2573F, 0x257B6, 0x257B7, 0x07A06, 0x257BB,
+	0x257B8, 0x2079C, 0x257B9, 0x07A01, 0x2598B, 0x2597A, 0x25984, 0x25988, 0x25991, 0x25979, 0x2598E, 0x25980,
+	0x25982, 0x2597C, 0x041A4, 0x25A84, 0x25A7F, 0x041F2, 0x25B3B, 0x25B71, 0x25B3D, 0x25B30, 0x041F1, 0x25B3E,
+	0x25B38, 0x25E5E, 0x25E64, 0x04281, 0x25E5D, 0x25E6D, 0x25E4F, 0x25E62, 0x25E5F, 0x25FEC, 0x25FF2, 0x25FF4,
+	0x25FF7, 0x25FF6, 0x26007, 0x25FED, 0x25FEA, 0x25FF0, 0x25FF8, 0x25FF3, 0x042DC, 0x2F972, 0x26231, 0x2622F,
+	0x262B6, 0x262AE, 0x262AA, 0x262BF, 0x262CC, 0x262C7, 0x26C83, 0x26380, 0x2637B, 0x2637F,
+	// plane 6, row 45
+	0x2637D, 0x26383, 0x2F978, 0x26446, 0x2642D, 0x26425, 0x26426, 0x2642C, 0x26437, 0x26431, 0x26433, 0x26432,
+	0x26427, 0x2642A, 0x2642E, 0x2642F, 0x264C0, 0x26571, 0x26561, 0x26562, 0x26566, 0x26618, 0x266C5, 0x266C3,
+	0x266C4, 0x266C2, 0x266AE, 0x26710, 0x26708, 0x2671F, 0x2673A, 0x2673B, 0x26736, 0x2670C, 0x26706, 0x2672A,
+	0x26757, 0x26750, 0x26720, 0x2F985, 0x2672C, 0x26721, 0x2670B, 0x26719, 0x26735, 0x26718, 0x2670F, 0x26713,
+	0x26755, 0x26739, 0x2671A, 0x0440C, 0x26717, 0x268E4, 0x268E9, 0x2458B, 0x26910, 0x26912, 0x2693F, 0x2696E,
+	0x24518, 0x2F98C, 0x269D6, 0x269D5, 0x269D7, 0x20B02, 0x0446D, 0x26A31, 0x26A30, 0x26A3A, 0x26B85, 0x26B6B,
+	0x26C48, 0x26C4B, 0x26C37, 0x26C7B, 0x26C6C, 0x26C85, 0x26C65, 0x26C36, 0x26C86, 0x26C34, 0x26C68, 0x26C5A,
+	0x26C58, 0x26C72, 0x26C6A, 0x26BD3, 0x26C3C, 0x26C33, 0x26C3B, 0x26C4D, 0x26C4F, 0x26C7C,
+	// plane 6, row 46
+	0x26CB0, 0x26C5B, 0x26C55, 0x26C64, 0x26C6B, 0x26C53, 0x044E6, 0x26C57, 0x26C5E, 0x26C6D, 0x26C35, 0x26C69,
+	0x26C59, 0x26C66, 0x26C50, 0x26C51, 0x26C5C, 0x26D5D, 0x26C87, 0x26C78, 0x26C49, 0x26C4E, 0x26BF1, 0x26C73,
+	0x26C88, 0x26BCF, 0x2F9A4, 0x271B1, 0x271B3, 0x2729C, 0x272A4, 0x27290, 0x272B8, 0x272A0, 0x2729D, 0x272A8,
+	0x272A9, 0x2F9B9, 0x272B1, 0x27293, 0x2729B, 0x272A2, 0x272A1, 0x272B0, 0x272A7, 0x275B3, 0x08849, 0x275B4,
+	0x275F1, 0x275ED, 0x275EC, 0x27668, 0x27675, 0x2766A, 0x27662, 0x27667, 0x27660, 0x27677, 0x2765F, 0x27679,
+	0x27823, 0x27821, 0x2781C, 0x08999, 0x2781F, 0x2781E, 0x278D6, 0x278D3, 0x278D9, 0x278D7, 0x278D4, 0x278DC,
+	0x278D8, 0x279A3, 0x046D1, 0x279BE, 0x279A9, 0x279A1, 0x279A0, 0x279AB, 0x279A2, 0x279BA, 0x279C2, 0x2799C,
+	0x279BB, 0x279AA, 0x279AC, 0x279A5, 0x279C1, 0x0472C, 0x27BE2, 0x27C36, 0x27C3D, 0x27C3A,
+	// plane 6, row 47
+	0x22461, 0x27C48, 0x27C3C, 0x27CB7, 0x27CAC, 0x27CB3, 0x27CB6, 0x27CB2, 0x0476E, 0x27D5D, 0x27D49, 0x27D4E,
+	0x27D4F, 0x27D4D, 0x27D5C, 0x27E5D, 0x27EBB, 0x27ECE, 0x27EBF, 0x27EBA, 0x27EC3, 0x047E5, 0x28006, 0x27FF8,
+	0x27FFE, 0x28013, 0x27FF6, 0x047E6, 0x28234, 0x2822D, 0x2822A, 0x28235, 0x2822C, 0x282D4, 0x282DA, 0x282D3,
+	0x282D2, 0x282CE, 0x282CF, 0x0489F, 0x284FD, 0x2850B, 0x284FE, 0x284F8, 0x284F7, 0x28517, 0x284FA, 0x284F9,
+	0x28500, 0x284F5, 0x284F1, 0x28503, 0x28505, 0x2851C, 0x286AC, 0x2869A, 0x286AD, 0x286A0, 0x2871B, 0x28725,
+	0x28724, 0x28726, 0x28727, 0x28728, 0x2872A, 0x2871A, 0x2872E, 0x28715, 0x28815, 0x28814, 0x28817, 0x28818,
+	0x2880F, 0x28813, 0x2880B, 0x28822, 0x2137A, 0x28926, 0x0921F, 0x28951, 0x2894D, 0x2894E, 0x2894A, 0x2894B,
+	0x2894C, 0x28950, 0x28962, 0x2894F, 0x28952, 0x28C68, 0x28C69, 0x28CE5, 0x28CF0, 0x28CE1,
+	// plane 6, row 48
+	0x28CE2, 0x2F9F2, 0x28EA5, 0x28EA9, 0x28EA4, 0x28EAF, 0x28EAC, 0x28EAE, 0x28EAA, 0x28F95, 0x28F99, 0x28F93,
+	0x28F9D, 0x28F98, 0x28F9C, 0x28F97, 0x04A0D, 0x29089, 0x29087, 0x291D7, 0x291ED, 0x291F1, 0x291F0, 0x29205,
+	0x29203, 0x29204, 0x2925F, 0x29457, 0x2959C, 0x295A1, 0x2959A, 0x2968F, 0x29693, 0x2969E, 0x296A3, 0x29698,
+	0x29699, 0x29695, 0x2986E, 0x2986A, 0x29AF4, 0x29AF7, 0x29C79, 0x29F8F, 0x29F91, 0x2A00B, 0x2A502, 0x20084,
+	0x20104, 0x20180, 0x2032F, 0x2033F, 0x2033B, 0x20362, 0x20344, 0x20333, 0x2035F, 0x2032B, 0x2F809, 0x20339,
+	0x20336, 0x20348, 0x20335, 0x2036C, 0x20358, 0x2033A, 0x204A8, 0x204F4, 0x20529, 0x2059A, 0x20600, 0x207BB,
+	0x207BD, 0x207B7, 0x207B2, 0x034F8, 0x207A8, 0x207AB, 0x207A6, 0x207BC, 0x20897, 0x20896, 0x208F8, 0x05313,
+	0x20951, 0x03533, 0x209B5, 0x209B4, 0x209B6, 0x0353C, 0x209B7, 0x20A05, 0x20A23, 0x20A95,
+	// plane 6, row 49
+	0x20A98, 0x0354F, 0x20A94, 0x20A93, 0x20B07, 0x20B73, 0x20B71, 0x20B72, 0x20B78, 0x20E5F, 0x20E6A, 0x20E67,
+	0x20E6B, 0x20E69, 0x20E8E, 0x20E49, 0x20E80, 0x035DC, 0x20E44, 0x20E8F, 0x20E45, 0x2F84C, 0x20E4F, 0x20E63,
+	0x20E36, 0x20E48, 0x20E5C, 0x20E93, 0x20E61, 0x20E60, 0x20E4E, 0x20E5E, 0x20E3F, 0x20EA4, 0x055E0, 0x20E68,
+	0x2120B, 0x21211, 0x2120D, 0x2120E, 0x213C2, 0x2F857, 0x213B0, 0x213C3, 0x213C8, 0x21365, 0x213D7, 0x213BD,
+	0x213B8, 0x213B6, 0x213C7, 0x213BC, 0x213B1, 0x213AF, 0x2F85B, 0x21535, 0x21530, 0x2155D, 0x20B76, 0x2156F,
+	0x215B0, 0x2F85E, 0x215B4, 0x2165C, 0x2165D, 0x21808, 0x217EE, 0x2180B, 0x217F2, 0x217FD, 0x217F1, 0x21810,
+	0x2F868, 0x21814, 0x2198B, 0x2198C, 0x21985, 0x21A7A, 0x21A6C, 0x21A60, 0x21A67, 0x21A66, 0x21A6A, 0x21A5F,
+	0x21A6D, 0x21A68, 0x21A64, 0x21B3A, 0x21B3B, 0x21B39, 0x21C02, 0x21C04, 0x21C03, 0x21CB4,
+	// plane 6, row 50
+	0x21CB3, 0x21CBB, 0x21CAC, 0x21CB6, 0x21CB1, 0x21CAF, 0x21CB5, 0x21CBE, 0x21CB2, 0x21D24, 0x21EB6, 0x21EB9,
+	0x21EAC, 0x21EB0, 0x21ED7, 0x21EBB, 0x21EFE, 0x21ECB, 0x2F87F, 0x037F5, 0x21EB3, 0x21EBE, 0x21ECD, 0x21EBC,
+	0x21EBA, 0x21EC7, 0x22116, 0x2211B, 0x22117, 0x22115, 0x2219F, 0x2F88E, 0x22282, 0x22283, 0x2228E, 0x2226A,
+	0x22289, 0x22294, 0x22286, 0x22290, 0x22362, 0x22390, 0x2240C, 0x2240F, 0x038C3, 0x224A5, 0x224A7, 0x224A6,
+	0x224A4, 0x22535, 0x22538, 0x22536, 0x22543, 0x22547, 0x22537, 0x22743, 0x227A2, 0x22751, 0x22772, 0x2276D,
+	0x2272F, 0x22754, 0x2276E, 0x22755, 0x22737, 0x2274F, 0x22750, 0x06139, 0x2279E, 0x2F8A8, 0x227B3, 0x227A7,
+	0x03971, 0x227F9, 0x22791, 0x2F8A7, 0x227B6, 0x227DD, 0x22799, 0x229DF, 0x229D5, 0x247F5, 0x22C28, 0x22C40,
+	0x22C71, 0x22C98, 0x22C95, 0x22C65, 0x22C78, 0x22C7F, 0x22C60, 0x22C7C, 0x22C96, 0x22C6A,
+	// plane 6, row 51
+	0x22C79, 0x22C73, 0x22C72, 0x22C7B, 0x22C70, 0x22ED5, 0x22ED7, 0x22F8F, 0x22F89, 0x22F9E, 0x22F87, 0x22FA0,
+	0x22F8D, 0x22F88, 0x22F7F, 0x22F8C, 0x22F93, 0x23059, 0x295B0, 0x230B1, 0x251F4, 0x230AF, 0x230B2, 0x23108,
+	0x2310A, 0x23136, 0x23137, 0x2F8CF, 0x03B01, 0x23236, 0x23229, 0x2324A, 0x23226, 0x23228, 0x23224, 0x2322A,
+	0x23248, 0x23344, 0x23384, 0x2338C, 0x234C4, 0x234DC, 0x23587, 0x23553, 0x2357F, 0x23531, 0x23551, 0x2354B,
+	0x23552, 0x23580, 0x235A5, 0x23581, 0x23543, 0x23534, 0x23536, 0x23532, 0x23548, 0x23549, 0x2353C, 0x2354D,
+	0x2354A, 0x2F8E6, 0x2358A, 0x23546, 0x2353E, 0x23583, 0x23550, 0x235B4, 0x238F0, 0x238E4, 0x238E3, 0x238E5,
+	0x238E6, 0x238E7, 0x238E1, 0x238EF, 0x238E8, 0x238DD, 0x23903, 0x23988, 0x23A26, 0x23A16, 0x23A3B, 0x23A2F,
+	0x23A39, 0x23A34, 0x2F8F4, 0x23A35, 0x23A31, 0x23A38, 0x20B81, 0x23AAA, 0x23AA3, 0x23AA4,
+	// plane 6, row 52
+	0x23AA0, 0x23AA1, 0x23AA9, 0x23AA5, 0x23BAF, 0x23BB1, 0x23BAB, 0x23BAE, 0x23BB0, 0x23BB3, 0x23BAC, 0x23BA9,
+	0x23BB2, 0x23BA8, 0x23BB4, 0x23BC2, 0x23C4F, 0x23C66, 0x23E1E, 0x23E15, 0x23E10, 0x23E7F, 0x23E7E, 0x23E60,
+	0x23ECC, 0x23EB2, 0x23E62, 0x23E8D, 0x23E8E, 0x23E77, 0x23E7C, 0x23E8F, 0x23E5D, 0x23E6D, 0x23E63, 0x23EAF,
+	0x23E90, 0x23E7D, 0x23E7A, 0x23E06, 0x2F90B, 0x23E68, 0x23EB4, 0x23E78, 0x23EB1, 0x06ED7, 0x2F90C, 0x03D41,
+	0x242E8, 0x242E9, 0x242C0, 0x03DD7, 0x242EA, 0x242B7, 0x242BA, 0x242B5, 0x2F91D, 0x242B4, 0x242BC, 0x242C6,
+	0x242B8, 0x242BD, 0x242C2, 0x2434D, 0x242F0, 0x242C7, 0x242C1, 0x242C3, 0x242F1, 0x242EC, 0x03DE2, 0x2451C,
+	0x24520, 0x24558, 0x24565, 0x24564, 0x2458C, 0x2458D, 0x245D9, 0x245E0, 0x24610, 0x24694, 0x24692, 0x24696,
+	0x24695, 0x246BF, 0x246A0, 0x03E45, 0x247F4, 0x247F6, 0x247DC, 0x0733D, 0x24816, 0x24815,
+	// plane 6, row 53
+	0x2481C, 0x2480F, 0x2482C, 0x24814, 0x24825, 0x24817, 0x24812, 0x2481E, 0x24980, 0x249A8, 0x249AF, 0x249D6,
+	0x249E2, 0x249B4, 0x249B3, 0x249B0, 0x249B2, 0x249A1, 0x24B60, 0x24B66, 0x24B61, 0x24B4E, 0x24B5D, 0x24B63,
+	0x24BE6, 0x24BEF, 0x24BEC, 0x24C98, 0x24CA7, 0x24C94, 0x24C95, 0x24C91, 0x24C9D, 0x24C99, 0x24C9B, 0x24C9C,
+	0x24D1D, 0x24D1C, 0x24DD0, 0x24DE0, 0x24DCB, 0x24DDB, 0x24DDA, 0x24DC2, 0x24DD3, 0x24DE5, 0x24F7D, 0x24F7B,
+	0x24FFF, 0x03FF2, 0x24FF9, 0x25077, 0x2507C, 0x25078, 0x2507B, 0x2507A, 0x251D2, 0x251CB, 0x251C9, 0x251CE,
+	0x251E4, 0x251CA, 0x251D0, 0x22FA5, 0x04049, 0x251D9, 0x251EE, 0x251DD, 0x25200, 0x251E1, 0x253DE, 0x253E2,
+	0x253DA, 0x254CE, 0x2F94F, 0x254CF, 0x254DA, 0x254D1, 0x254D4, 0x254ED, 0x254CB, 0x254D5, 0x254F1, 0x2569C,
+	0x25688, 0x25741, 0x257D0, 0x257F7, 0x257CF, 0x257D1, 0x257DB, 0x257DE, 0x257F8, 0x257DC,
+	// plane 6, row 54
+	0x257D9, 0x259A0, 0x259B2, 0x259A1, 0x259AA, 0x259A9, 0x041A9, 0x259A6, 0x2599C, 0x259B5, 0x259A7, 0x25A8E,
+	0x25A90, 0x25A91, 0x25B85, 0x25B5B, 0x25B70, 0x25B64, 0x25B67, 0x25B63, 0x25B77, 0x25B68, 0x25B65, 0x25B6A,
+	0x25B78, 0x25B66, 0x25E88, 0x25E9E, 0x25E74, 0x25E7A, 0x25E79, 0x25E8B, 0x25E7F, 0x26013, 0x2601E, 0x26017,
+	0x2601A, 0x26022, 0x26043, 0x26019, 0x2601F, 0x26027, 0x26012, 0x26024, 0x26025, 0x2035E, 0x26235, 0x26234,
+	0x262D1, 0x262C4, 0x262CA, 0x262C6, 0x262CB, 0x262CD, 0x262E2, 0x26389, 0x2638B, 0x26386, 0x26388, 0x2638D,
+	0x2643B, 0x2643C, 0x2643D, 0x264F5, 0x26579, 0x26575, 0x26582, 0x2657F, 0x26585, 0x26576, 0x2657C, 0x2657E,
+	0x2657B, 0x26580, 0x2657A, 0x22548, 0x043CB, 0x2661D, 0x266F6, 0x266F7, 0x266F9, 0x26763, 0x2676C, 0x2676E,
+	0x26714, 0x0440D, 0x26767, 0x2676F, 0x26769, 0x26776, 0x26795, 0x26771, 0x26761, 0x26778,
+	// plane 6, row 55
+	0x2676B, 0x26785, 0x26784, 0x26914, 0x26976, 0x259B6, 0x26975, 0x26974, 0x269E2, 0x26A3F, 0x26A44, 0x26A3D,
+	0x26A47, 0x26A48, 0x26ADB, 0x26ADC, 0x26ADD, 0x26BE5, 0x26BE6, 0x26CC2, 0x26D2E, 0x26D1D, 0x26CF8, 0x26CF0,
+	0x26CF6, 0x26CC5, 0x26CC6, 0x26CFC, 0x26D52, 0x26D2F, 0x26D10, 0x26CF3, 0x26CD2, 0x26CEA, 0x26CDC, 0x26D1B,
+	0x26CFB, 0x26CC3, 0x26D16, 0x26D07, 0x26CC9, 0x26CD8, 0x26D30, 0x26D13, 0x26D31, 0x26CFA, 0x26CF2, 0x26DE3,
+	0x26CD5, 0x26D01, 0x26CF1, 0x26D1C, 0x26CD6, 0x26D08, 0x26D0B, 0x26D17, 0x26D20, 0x26CCA, 0x26D32, 0x26D05,
+	0x26CE4, 0x26CCE, 0x26D33, 0x26D02, 0x26D19, 0x26D1E, 0x26CD3, 0x26CF7, 0x26CE8, 0x26D1F, 0x26CF4, 0x26DD8,
+	0x271BF, 0x271BE, 0x271BD, 0x272DC, 0x272DD, 0x272AB, 0x272C9, 0x272C8, 0x272DF, 0x272D9, 0x272DB, 0x272CC,
+	0x272E0, 0x272EF, 0x272F3, 0x272AE, 0x27301, 0x275B7, 0x275B9, 0x275B6, 0x275B8, 0x275F6,
+	// plane 6, row 56
+	0x275F3, 0x275F5, 0x275F2, 0x27691, 0x2769D, 0x2769B, 0x27692, 0x2768D, 0x2769E, 0x2768C, 0x04631, 0x2F9C7,
+	0x27695, 0x2F9C6, 0x2768A, 0x2768E, 0x2769C, 0x277EF, 0x2782D, 0x27852, 0x27835, 0x27828, 0x2782E, 0x278E5,
+	0x279EA, 0x279F1, 0x279EB, 0x279D8, 0x279D0, 0x279F3, 0x279DB, 0x279CE, 0x046D5, 0x279DA, 0x279D7, 0x279E1,
+	0x279F2, 0x279C8, 0x279D9, 0x279DE, 0x279D1, 0x279E7, 0x279CF, 0x27BB7, 0x27C47, 0x27C42, 0x27C43, 0x2F9D3,
+	0x27CC3, 0x27CC1, 0x27CC7, 0x27D64, 0x27D6A, 0x27D66, 0x27D50, 0x27D6E, 0x27D65, 0x238EC, 0x27D7F, 0x2F9D5,
+	0x27D9A, 0x27D69, 0x27D72, 0x27D6F, 0x27D7D, 0x27D70, 0x27E60, 0x27EC2, 0x27EE7, 0x27ED1, 0x27EEB, 0x27ED4,
+	0x27EDC, 0x27EDB, 0x28037, 0x28025, 0x2801F, 0x2801E, 0x28021, 0x2801B, 0x28017, 0x28022, 0x2802A, 0x2801A,
+	0x2802D, 0x28023, 0x28026, 0x28036, 0x27FFF, 0x2823A, 0x28240, 0x28242, 0x28238, 0x2823B,
+	// plane 6, row 57
+	0x2823C, 0x28243, 0x282E8, 0x04856, 0x282F9, 0x282EB, 0x282F1, 0x282E9, 0x282EC, 0x28415, 0x28447, 0x28446,
+	0x28445, 0x28524, 0x28556, 0x28521, 0x28527, 0x2854D, 0x28531, 0x2851E, 0x2852C, 0x2854F, 0x2852B, 0x28553,
+	0x28551, 0x28554, 0x28529, 0x28550, 0x2851F, 0x28532, 0x2852A, 0x2851D, 0x28528, 0x2852E, 0x2852D, 0x286BC,
+	0x286BB, 0x286BD, 0x2F9E4, 0x28747, 0x28741, 0x2F9E5, 0x28749, 0x048F4, 0x2882E, 0x28827, 0x2896A, 0x2897B,
+	0x28973, 0x28975, 0x28969, 0x28979, 0x28972, 0x28C76, 0x28C77, 0x28C74, 0x28C73, 0x28D02, 0x28CFE, 0x28D01,
+	0x28CF9, 0x28CF4, 0x28ED3, 0x28ED5, 0x28ED8, 0x28EC3, 0x28ECA, 0x28ED0, 0x28ECB, 0x28ECE, 0x28EC5, 0x28EE6,
+	0x28EC4, 0x28EC0, 0x28ED4, 0x28EE8, 0x28F76, 0x28FA2, 0x28FAE, 0x28FA8, 0x28FA3, 0x28FA7, 0x28F96, 0x28FA9,
+	0x28FA5, 0x28FAF, 0x28FA4, 0x28FAB, 0x28FAA, 0x28FA6, 0x28FA0, 0x29098, 0x291DB, 0x291F6,
+	// plane 6, row 58
+	0x291F5, 0x2920C, 0x2920A, 0x09775, 0x29268, 0x29263, 0x29266, 0x04A58, 0x29264, 0x2926A, 0x29269, 0x29395,
+	0x29402, 0x2946A, 0x2945E, 0x29468, 0x29469, 0x29465, 0x2F9FE, 0x295A7, 0x295AE, 0x295A8, 0x295B3, 0x2969C,
+	0x296A9, 0x296B6, 0x296B3, 0x296B2, 0x2FA03, 0x296B8, 0x296C0, 0x296BF, 0x296BA, 0x296A8, 0x2983C, 0x29872,
+	0x29871, 0x29875, 0x29873, 0x29A17, 0x29A15, 0x29A16, 0x29A1B, 0x29AFA, 0x29AF9, 0x29C2C, 0x29C85, 0x29C82,
+	0x29C7F, 0x29C7D, 0x29C88, 0x242F3, 0x29D4E, 0x29D50, 0x29F93, 0x29F97, 0x29F98, 0x29F9B, 0x29F9C, 0x29F96,
+	0x2A28B, 0x2A2FD, 0x2A2FC, 0x2FA17, 0x2398B, 0x20108, 0x20107, 0x20373, 0x203A2, 0x203AF, 0x20382, 0x2037B,
+	0x20374, 0x203B0, 0x20376, 0x203B9, 0x2039E, 0x203B1, 0x203A1, 0x203B2, 0x2036E, 0x2F80B, 0x03487, 0x20378,
+	0x2037A, 0x20383, 0x2039A, 0x204F8, 0x20531, 0x20569, 0x20568, 0x2059C, 0x20604, 0x20699,
+	// plane 6, row 59
+	0x207C7, 0x207C6, 0x207DC, 0x207C4, 0x207D8, 0x207D4, 0x207DF, 0x207D1, 0x207D0, 0x207D6, 0x207CF, 0x208AD,
+	0x208AF, 0x208A7, 0x208FD, 0x2095B, 0x2095A, 0x20A07, 0x20A9D, 0x20A9B, 0x20AAB, 0x20AA4, 0x20A9C, 0x20A9E,
+	0x20AA5, 0x20AAA, 0x20AA6, 0x20B0E, 0x20B7E, 0x20B7C, 0x20EEE, 0x20ED5, 0x20EE9, 0x20E42, 0x20EE2, 0x20F23,
+	0x20ED9, 0x20ED4, 0x20EE3, 0x20F15, 0x20EEF, 0x20EF0, 0x20ED6, 0x20EDD, 0x20EF6, 0x20F1C, 0x20ED8, 0x20EDB,
+	0x20EDA, 0x20EED, 0x21411, 0x21215, 0x21218, 0x2121A, 0x2121F, 0x21216, 0x2F84D, 0x21219, 0x2F84B, 0x213F0,
+	0x21409, 0x2141B, 0x27BE7, 0x213F6, 0x213F4, 0x213B5, 0x21410, 0x213F2, 0x21415, 0x213F3, 0x213F8, 0x21539,
+	0x2153B, 0x21536, 0x2140E, 0x21572, 0x21574, 0x215B9, 0x215B7, 0x215B8, 0x21672, 0x2166B, 0x2181D, 0x21837,
+	0x21822, 0x21843, 0x2184D, 0x21838, 0x2185B, 0x21879, 0x2181B, 0x217F3, 0x21991, 0x21A7B,
+	// plane 6, row 60
+	0x21A94, 0x2F86F, 0x21A96, 0x21A7F, 0x21A8F, 0x21A84, 0x21A7C, 0x21A8E, 0x21A90, 0x21A98, 0x21A83, 0x21A80,
+	0x21A93, 0x21A82, 0x00000, 0x21B3D, 0x21B41, 0x21BA1, 0x21B9F, 0x21C0A, 0x21C0D, 0x21CC8, 0x21CC9, 0x21CC7,
+	0x21CCD, 0x21D25, 0x21EB1, 0x21EDC, 0x2F880, 0x21EE5, 0x21EF4, 0x21EBF, 0x21EDB, 0x21EEA, 0x21EF2, 0x037FA,
+	0x21EF1, 0x21EED, 0x21EE6, 0x22002, 0x2F882, 0x22125, 0x22118, 0x2211F, 0x22120, 0x221CF, 0x2229D, 0x22299,
+	0x222A8, 0x22368, 0x22366, 0x22367, 0x22391, 0x22413, 0x22415, 0x2241D, 0x22416, 0x22419, 0x2246B, 0x22468,
+	0x2246A, 0x2246D, 0x22469, 0x224AA, 0x22557, 0x22552, 0x22550, 0x2255F, 0x22567, 0x2254F, 0x038F2, 0x2255B,
+	0x2255C, 0x2255D, 0x2281F, 0x2279D, 0x227B1, 0x227B0, 0x22794, 0x227C3, 0x227AF, 0x227A8, 0x227DC, 0x22798,
+	0x227C4, 0x227A4, 0x227AB, 0x227AA, 0x227A5, 0x22821, 0x227EB, 0x227E6, 0x227F7, 0x227F8,
+	// plane 6, row 61
+	0x227FC, 0x227FA, 0x227E0, 0x2F8AA, 0x227F6, 0x2F8A9, 0x227E1, 0x229EC, 0x229E2, 0x229E4, 0x229F9, 0x22C6F,
+	0x23A4C, 0x22CBB, 0x22CE1, 0x22D00, 0x22CD8, 0x03A2F, 0x22CD6, 0x22CE2, 0x22CC3, 0x22CB3, 0x22CD2, 0x2F8C1,
+	0x22CCE, 0x22CD0, 0x22CD5, 0x22CB9, 0x22CBA, 0x22CCF, 0x22CBD, 0x22EDB, 0x22FAA, 0x22FAD, 0x22FB8, 0x22FB6,
+	0x22FB5, 0x22FAF, 0x22FB4, 0x22FB7, 0x22FA8, 0x22FB9, 0x22FBE, 0x23082, 0x230BC, 0x230B8, 0x230B6, 0x230B9,
+	0x03AC1, 0x23110, 0x23227, 0x23269, 0x23270, 0x23256, 0x2326B, 0x2327A, 0x2326C, 0x2326D, 0x2F8D5, 0x267C1,
+	0x2338D, 0x23390, 0x235B6, 0x23610, 0x23612, 0x235BA, 0x235BD, 0x23605, 0x235C2, 0x23607, 0x235F5, 0x2F8E9,
+	0x235AF, 0x235F4, 0x235F7, 0x235F8, 0x23611, 0x238F6, 0x238F5, 0x238FB, 0x23901, 0x23900, 0x23902, 0x238FE,
+	0x238FA, 0x238FD, 0x03C40, 0x23A37, 0x23A49, 0x23A4B, 0x23A46, 0x23A47, 0x23AB3, 0x23AB2,
+	// plane 6, row 62
+	0x23AB0, 0x23AB7, 0x23B11, 0x23BCC, 0x23BDF, 0x23BD3, 0x23BD5, 0x23BDB, 0x23BC5, 0x23BC8, 0x23BC9, 0x23BE2,
+	0x23BCA, 0x23BD1, 0x23BD2, 0x23BDD, 0x23E6C, 0x23E73, 0x23F21, 0x23EF0, 0x23F1F, 0x23F3B, 0x23F22, 0x23F23,
+	0x23EE8, 0x23EDD, 0x03D3F, 0x23F1B, 0x23EED, 0x2F90E, 0x23EF2, 0x03D46, 0x23EDC, 0x23EE9, 0x23F1D, 0x23EDA,
+	0x23EE6, 0x24313, 0x24315, 0x24316, 0x2433C, 0x2430B, 0x2431C, 0x2433A, 0x2433D, 0x2439A, 0x2431D, 0x24309,
+	0x24308, 0x2433B, 0x24522, 0x24526, 0x24525, 0x24524, 0x2039B, 0x2458F, 0x24590, 0x245E6, 0x245E3, 0x245E5,
+	0x24611, 0x2F925, 0x246AE, 0x246BE, 0x246B4, 0x246B3, 0x246AF, 0x24691, 0x246C2, 0x246B6, 0x246B2, 0x24857,
+	0x2483B, 0x24858, 0x24851, 0x24841, 0x24839, 0x03E8F, 0x24859, 0x24845, 0x24861, 0x248E8, 0x249FA, 0x249EA,
+	0x249EF, 0x249F2, 0x249F0, 0x24B08, 0x24B70, 0x24B6A, 0x24B73, 0x24B68, 0x24BC8, 0x24BF2,
+	// plane 6, row 63
+	0x20B7B, 0x24CAE, 0x24CAB, 0x24CB5, 0x24CAF, 0x24CB2, 0x24CB6, 0x24CB0, 0x24D1E, 0x24E03, 0x24E06, 0x24E1F,
+	0x03FAC, 0x24E0F, 0x24E02, 0x24E19, 0x24E18, 0x24E22, 0x24E15, 0x24E07, 0x24E0D, 0x24E24, 0x24E0C, 0x24E1E,
+	0x24F89, 0x24F8A, 0x2500A, 0x2500B, 0x25007, 0x25004, 0x25009, 0x25084, 0x25083, 0x25218, 0x25214, 0x25205,
+	0x25216, 0x2520E, 0x25211, 0x25208, 0x2520B, 0x25215, 0x25085, 0x25237, 0x2522A, 0x2520D, 0x2520F, 0x2537E,
+	0x25376, 0x25377, 0x253EC, 0x254FC, 0x25508, 0x254FF, 0x25503, 0x25510, 0x25505, 0x25506, 0x254FA, 0x256C7,
+	0x256C0, 0x256C3, 0x256A7, 0x256A8, 0x256AB, 0x256C1, 0x256AA, 0x256C8, 0x25743, 0x25802, 0x2580E, 0x25801,
+	0x257FE, 0x25803, 0x04168, 0x25822, 0x25821, 0x25807, 0x25808, 0x2580C, 0x259CA, 0x259BC, 0x259BE, 0x259BD,
+	0x2F95C, 0x259BB, 0x259B9, 0x041AB, 0x259C5, 0x25A99, 0x25B6B, 0x25B93, 0x25B94, 0x25BA9,
+	// plane 6, row 64
+	0x25BA0, 0x25BA6, 0x2F961, 0x25BAB, 0x25B9E, 0x25B9B, 0x25B91, 0x25B99, 0x25EB6, 0x25EB8, 0x25E9A, 0x04291,
+	0x25E98, 0x25E9B, 0x25EB3, 0x25EA2, 0x222AA, 0x25EA0, 0x25E9F, 0x2605B, 0x26070, 0x26054, 0x26071, 0x26065,
+	0x26051, 0x2609D, 0x26061, 0x2605A, 0x26074, 0x2604C, 0x2604B, 0x2605E, 0x26058, 0x26053, 0x26052, 0x2623B,
+	0x26239, 0x262D6, 0x262E7, 0x262D7, 0x262D8, 0x262D9, 0x262DA, 0x262DB, 0x262DC, 0x262E0, 0x262E4, 0x262DD,
+	0x262F5, 0x262F1, 0x26398, 0x2639D, 0x26399, 0x26450, 0x26449, 0x07FE4, 0x26462, 0x264D7, 0x26501, 0x264F7,
+	0x2F97D, 0x2658C, 0x2659C, 0x08088, 0x2661F, 0x2661E, 0x2673D, 0x2673F, 0x26711, 0x26759, 0x2673E, 0x26758,
+	0x26800, 0x2679E, 0x267B6, 0x267AA, 0x267AF, 0x267AC, 0x04430, 0x267C0, 0x267A9, 0x20B10, 0x268EE, 0x26977,
+	0x26979, 0x2697A, 0x2697D, 0x2697F, 0x26983, 0x26978, 0x2697E, 0x269E4, 0x269E6, 0x269E5,
+	// plane 6, row 65
+	0x0445D, 0x26A4E, 0x26A59, 0x04475, 0x2F98E, 0x26A4F, 0x26A4A, 0x26AE3, 0x26ADE, 0x26AE2, 0x26C74, 0x26CAC,
+	0x26C61, 0x26C62, 0x26C76, 0x26C7A, 0x26C79, 0x26C60, 0x26D64, 0x26E81, 0x26DDF, 0x26D84, 0x26D8A, 0x26D92,
+	0x26D79, 0x26DDE, 0x26D98, 0x26D6C, 0x26DE1, 0x2F9A5, 0x26D7C, 0x26D72, 0x26D81, 0x26DE0, 0x26D65, 0x044FF,
+	0x26D6A, 0x26D97, 0x26DAA, 0x26DD3, 0x26DAB, 0x26D6E, 0x26DAC, 0x26D76, 0x26D7B, 0x26DAD, 0x2F9A6, 0x26D94,
+	0x26DD7, 0x26D70, 0x26DD5, 0x26DF1, 0x26D7A, 0x26D68, 0x26D96, 0x0450B, 0x26D73, 0x26DAE, 0x26DDD, 0x26DDA,
+	0x26DAF, 0x26DB0, 0x26DDB, 0x26D62, 0x26DF8, 0x271C2, 0x271C7, 0x271C8, 0x271C5, 0x271C3, 0x271C6, 0x272DE,
+	0x045C8, 0x27311, 0x27315, 0x27328, 0x27321, 0x2732D, 0x27351, 0x2732B, 0x27316, 0x27324, 0x27335, 0x2731F,
+	0x27312, 0x27310, 0x27380, 0x2733B, 0x27329, 0x2732A, 0x2731B, 0x27318, 0x27320, 0x2733F,
+	// plane 6, row 66
+	0x2731C, 0x27326, 0x2730B, 0x275BE, 0x275C4, 0x275BD, 0x275FA, 0x275FB, 0x276B1, 0x276B2, 0x276B0, 0x276B9,
+	0x276A6, 0x276BD, 0x276B6, 0x276B8, 0x276B4, 0x276B3, 0x276A7, 0x276AE, 0x276BC, 0x277F2, 0x277F3, 0x277F4,
+	0x2783B, 0x27840, 0x27846, 0x278F0, 0x278EE, 0x278E8, 0x278F1, 0x278EB, 0x278EF, 0x279FC, 0x27A20, 0x27A09,
+	0x27A06, 0x27A03, 0x2F9CF, 0x27A19, 0x27A24, 0x27A1B, 0x27A1D, 0x279FD, 0x27A1E, 0x279F4, 0x27A01, 0x27A08,
+	0x27A05, 0x27A23, 0x279FF, 0x27BEA, 0x27C4D, 0x27C4E, 0x27C56, 0x27C57, 0x27C51, 0x27C55, 0x27C54, 0x27CCB,
+	0x27CD4, 0x27CD1, 0x27CCF, 0x27CD2, 0x27CCA, 0x27CD6, 0x27D8B, 0x27D88, 0x27D85, 0x27D89, 0x21A9B, 0x27DBB,
+	0x27D8C, 0x04774, 0x27D99, 0x27D8A, 0x27EEC, 0x27EEF, 0x27EF9, 0x27F09, 0x27EF8, 0x27EF3, 0x27F00, 0x27F1D,
+	0x047AC, 0x27EFD, 0x28048, 0x2805C, 0x28055, 0x2805E, 0x28049, 0x28063, 0x28060, 0x28053,
+	// plane 6, row 67
+	0x28062, 0x28040, 0x28249, 0x2824A, 0x2824C, 0x2824D, 0x2830A, 0x28306, 0x2832F, 0x28321, 0x28307, 0x28309,
+	0x28302, 0x28316, 0x28303, 0x2830B, 0x2830F, 0x28560, 0x28568, 0x2855E, 0x2855D, 0x28563, 0x2855F, 0x28564,
+	0x28578, 0x28561, 0x28569, 0x28565, 0x286DA, 0x286E6, 0x286DB, 0x286DC, 0x28739, 0x28757, 0x28755, 0x28765,
+	0x28761, 0x28754, 0x28845, 0x28841, 0x2883E, 0x28837, 0x28912, 0x28913, 0x2892C, 0x28996, 0x2F9E9, 0x2899C,
+	0x2899D, 0x28985, 0x2F9E8, 0x2899F, 0x289A3, 0x28C82, 0x28C83, 0x2F9EE, 0x28D1D, 0x28D14, 0x28D1F, 0x28D20,
+	0x28E47, 0x28E80, 0x28EC9, 0x28EF9, 0x28F06, 0x28EF0, 0x28EF8, 0x28EEF, 0x28EFD, 0x28EF1, 0x28EFE, 0x28FB8,
+	0x28FC0, 0x28FC3, 0x28FB5, 0x28FB6, 0x28FC9, 0x2F9F3, 0x28FBD, 0x28FBA, 0x28FBF, 0x28FB3, 0x28FC6, 0x28FB2,
+	0x28FBC, 0x28FB7, 0x28FB9, 0x28FC8, 0x290B5, 0x290B3, 0x04A16, 0x290AC, 0x290A9, 0x290AD,
+	// plane 6, row 68
+	0x29211, 0x2920D, 0x29216, 0x29289, 0x2927C, 0x2928B, 0x2927B, 0x29288, 0x29284, 0x2939D, 0x29398, 0x29488,
+	0x29486, 0x29482, 0x2948B, 0x2F9FF, 0x29471, 0x29472, 0x29481, 0x2948C, 0x29480, 0x29489, 0x295BB, 0x295C1,
+	0x295BE, 0x2FA01, 0x295BD, 0x296C1, 0x296B4, 0x296B7, 0x296C8, 0x04B3B, 0x296D3, 0x296D0, 0x296B0, 0x296CA,
+	0x296CD, 0x29815, 0x29819, 0x29817, 0x2983F, 0x29840, 0x29844, 0x2987C, 0x29878, 0x29879, 0x29888, 0x2FA06,
+	0x29889, 0x2987D, 0x29A23, 0x29A24, 0x29A26, 0x29A22, 0x29A27, 0x29A1F, 0x29AC9, 0x29AC3, 0x29B0A, 0x29B0B,
+	0x29B04, 0x04BEF, 0x29B03, 0x29B01, 0x29B09, 0x29C0F, 0x29C2E, 0x29C2D, 0x29C91, 0x29C99, 0x29C9E, 0x29C90,
+	0x29C94, 0x29C9D, 0x29C98, 0x29C9B, 0x29C9C, 0x29C9A, 0x29D54, 0x29F9D, 0x29F9F, 0x29FA3, 0x29FA4, 0x29FA5,
+	0x2A302, 0x2A417, 0x2A41B, 0x2A419, 0x2A4D1, 0x21537, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 69
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 70
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 71
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 72
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 73
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 74
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 75
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 76
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 77
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 78
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 79
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 80
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 81
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 82
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 83
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 84
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 85
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 86
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 87
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 88
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 89
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 90
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 91
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 92
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 93
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 6, row 94
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 1
+	0x20055, 0x20182, 0x20189, 0x20187, 0x203BE, 0x203BD, 0x203CA, 0x203D4, 0x203BC, 0x203C4, 0x203C1, 0x203C2,
+	0x203D7, 0x20370, 0x203BA, 0x203E3, 0x203BB, 0x204B1, 0x204B6, 0x204B0, 0x2056C, 0x2060D, 0x20607, 0x207F1,
+	0x207F5, 0x207ED, 0x207EF, 0x207EB, 0x207EC, 0x207EE, 0x20807, 0x209C4, 0x20A08, 0x20AB3, 0x20AAD, 0x20AB0,
+	0x20B11, 0x2018A, 0x20F83, 0x20F7A, 0x20F82, 0x20F68, 0x20F71, 0x20FB0, 0x20FAF, 0x035F6, 0x20F6C, 0x20F6F,
+	0x20F62, 0x20F65, 0x20F81, 0x20F7F, 0x20F64, 0x20F73, 0x20F6E, 0x20F67, 0x20F74, 0x20F7D, 0x20F78, 0x20F85,
+	0x20FAA, 0x20F88, 0x20FA6, 0x2F84E, 0x20F8A, 0x20F84, 0x05655, 0x21224, 0x21222, 0x21223, 0x21432, 0x2145B,
+	0x2144F, 0x03667, 0x21426, 0x2143E, 0x21442, 0x21447, 0x21422, 0x2069B, 0x2144A, 0x2141F, 0x21449, 0x2142B,
+	0x21433, 0x2F858, 0x2142A, 0x21428, 0x2153D, 0x2153C, 0x215BF, 0x21679, 0x21680, 0x2167C,
+	// plane 7, row 2
+	0x2167A, 0x21678, 0x21882, 0x2185C, 0x2185A, 0x2184B, 0x2187C, 0x2187F, 0x2181F, 0x21889, 0x2188B, 0x21857,
+	0x2187E, 0x2187D, 0x21853, 0x21A9F, 0x21ABC, 0x21AA5, 0x21A86, 0x21ADC, 0x21AA4, 0x21AB6, 0x21AA2, 0x21AB4,
+	0x21B48, 0x21B46, 0x21BA7, 0x21C14, 0x21C12, 0x21C11, 0x21CE1, 0x21CD0, 0x21D26, 0x21D28, 0x21D29, 0x21F2F,
+	0x21F0D, 0x21F25, 0x21F14, 0x21F3C, 0x21F0B, 0x21F11, 0x21F30, 0x21F18, 0x21F19, 0x21F36, 0x21F17, 0x21F10,
+	0x21F15, 0x22003, 0x22029, 0x2204C, 0x2213F, 0x2213E, 0x22134, 0x22142, 0x22135, 0x207F3, 0x221CD, 0x221D1,
+	0x221D2, 0x222AD, 0x222BA, 0x222C1, 0x222BB, 0x222B4, 0x222BC, 0x2236C, 0x22392, 0x22421, 0x22424, 0x23F19,
+	0x224AD, 0x224AE, 0x224AB, 0x224AC, 0x22570, 0x22576, 0x22571, 0x22577, 0x0617F, 0x227EC, 0x2281C, 0x227FB,
+	0x227EE, 0x228E2, 0x227FD, 0x2281E, 0x2283B, 0x2284A, 0x2F8AE, 0x03992, 0x2287D, 0x22856,
+	// plane 7, row 3
+	0x22844, 0x2284B, 0x229FA, 0x229E8, 0x229F8, 0x229FF, 0x229F7, 0x229F6, 0x229FE, 0x22A07, 0x22A5D, 0x22CD4,
+	0x2F8C3, 0x22CC8, 0x22CC5, 0x2F8C0, 0x22CC9, 0x22D4D, 0x22D20, 0x22D36, 0x22D35, 0x22D88, 0x22D25, 0x22D21,
+	0x22D26, 0x03A45, 0x22D3F, 0x22D3B, 0x22D24, 0x22D43, 0x22D4E, 0x22D4F, 0x22D44, 0x22D40, 0x22D41, 0x22D39,
+	0x22D2B, 0x22EDD, 0x22EDE, 0x22FCD, 0x22FCB, 0x22FDB, 0x22FD8, 0x22FD9, 0x22FD2, 0x22FCA, 0x22FD1, 0x22FD4,
+	0x22FF9, 0x22FD0, 0x2305E, 0x2305D, 0x2308A, 0x23114, 0x23283, 0x23284, 0x2329B, 0x23282, 0x2327D, 0x2327C,
+	0x23299, 0x2327E, 0x2329A, 0x23295, 0x2327B, 0x23286, 0x23348, 0x23392, 0x235B2, 0x23642, 0x23672, 0x23615,
+	0x23630, 0x23643, 0x2365B, 0x2361D, 0x23673, 0x2362D, 0x03BC9, 0x23621, 0x2361C, 0x2F8EA, 0x23629, 0x2361E,
+	0x23633, 0x23910, 0x2390E, 0x2F8F1, 0x2390D, 0x23911, 0x23912, 0x23917, 0x23909, 0x2390F,
+	// plane 7, row 4
+	0x23998, 0x23994, 0x23996, 0x23999, 0x23995, 0x23997, 0x23A50, 0x23A52, 0x23A4D, 0x23A57, 0x23A53, 0x23A56,
+	0x23AB8, 0x23B12, 0x23BF1, 0x23BF0, 0x23BEE, 0x23BEF, 0x23BE5, 0x23BEB, 0x23BE7, 0x23BF2, 0x23BE6, 0x23BF7,
+	0x23C09, 0x23C6B, 0x23C6A, 0x23E79, 0x23ECD, 0x23EEF, 0x23EE4, 0x23EE3, 0x23F6C, 0x23F67, 0x23F62, 0x23F58,
+	0x23F69, 0x23FAB, 0x23F57, 0x23FAD, 0x23F54, 0x23FAE, 0x23F76, 0x23F49, 0x23F45, 0x23F4F, 0x2F90F, 0x23F50,
+	0x23F7D, 0x23F44, 0x23F66, 0x23F48, 0x23FA3, 0x23F51, 0x23F53, 0x2F910, 0x23F60, 0x23F47, 0x23F5E, 0x23F85,
+	0x23FAC, 0x23F6D, 0x23F75, 0x23FA8, 0x03D55, 0x23F43, 0x23F4E, 0x23F5C, 0x23F56, 0x23F6E, 0x23F63, 0x23F6A,
+	0x24356, 0x24312, 0x2435C, 0x24358, 0x24355, 0x2437E, 0x243A8, 0x24311, 0x24359, 0x2435A, 0x24367, 0x24354,
+	0x2435B, 0x243F0, 0x24529, 0x2452C, 0x2452A, 0x24527, 0x24567, 0x24568, 0x24593, 0x24595,
+	// plane 7, row 5
+	0x2459A, 0x245EE, 0x245F1, 0x245ED, 0x245E9, 0x246CB, 0x246DF, 0x246DB, 0x2483C, 0x2484E, 0x2486C, 0x2487B,
+	0x2487C, 0x24883, 0x2486B, 0x24866, 0x24863, 0x248A0, 0x24885, 0x24868, 0x248A2, 0x249EC, 0x03EE8, 0x249E7,
+	0x24A10, 0x24A18, 0x24A3A, 0x24A1A, 0x24A38, 0x24A41, 0x24A1B, 0x24A14, 0x24A1C, 0x24A1D, 0x24B12, 0x24B0D,
+	0x24B07, 0x24B7E, 0x24B7F, 0x20AB9, 0x24B80, 0x24B7A, 0x24BF3, 0x24CB9, 0x24CBB, 0x24CBA, 0x24CBD, 0x24CB7,
+	0x24CBF, 0x24D21, 0x24E3A, 0x24E3D, 0x24E36, 0x24E49, 0x24E81, 0x03FB6, 0x24E3F, 0x24E4D, 0x24E3E, 0x03FBD,
+	0x24E30, 0x24E4F, 0x24F37, 0x24F98, 0x24F99, 0x25011, 0x2500D, 0x25088, 0x2508A, 0x2521C, 0x25255, 0x25256,
+	0x25262, 0x25257, 0x25242, 0x25210, 0x2524D, 0x2525A, 0x25266, 0x25246, 0x2287E, 0x2524F, 0x262F9, 0x25387,
+	0x25384, 0x253F4, 0x253F5, 0x253F2, 0x253F7, 0x25545, 0x2F950, 0x25549, 0x25535, 0x254FD,
+	// plane 7, row 6
+	0x25530, 0x25543, 0x25546, 0x040D6, 0x25533, 0x256C6, 0x2F956, 0x256D4, 0x271D8, 0x256E0, 0x20FA7, 0x25744,
+	0x20398, 0x25854, 0x2583E, 0x25835, 0x2583A, 0x04171, 0x25834, 0x25839, 0x25846, 0x2585A, 0x2585B, 0x2583C,
+	0x259DC, 0x259D6, 0x259D0, 0x2F95E, 0x25AAC, 0x2F95D, 0x25AA5, 0x25BF4, 0x25C24, 0x25BE0, 0x25BFD, 0x25BF2,
+	0x25BFC, 0x25C02, 0x25BF1, 0x25BFF, 0x25BD2, 0x25BD8, 0x25BD3, 0x25BF9, 0x2F963, 0x25BED, 0x25BF7, 0x25BF0,
+	0x25BD7, 0x25C09, 0x25BF5, 0x25EC2, 0x25EC5, 0x25ECF, 0x04298, 0x25EC1, 0x25EC6, 0x25ED4, 0x25ECE, 0x2609B,
+	0x26099, 0x2608F, 0x260B7, 0x26082, 0x26093, 0x260B4, 0x260C1, 0x26090, 0x2609A, 0x26094, 0x2609C, 0x260B5,
+	0x2F96F, 0x2F96E, 0x2609F, 0x2608E, 0x2609E, 0x2623C, 0x26247, 0x26241, 0x262EB, 0x262EC, 0x262ED, 0x262EE,
+	0x262DF, 0x262F2, 0x2630D, 0x2630E, 0x20186, 0x263A9, 0x263A4, 0x263A5, 0x2645F, 0x2645A,
+	// plane 7, row 7
+	0x26466, 0x26468, 0x2646A, 0x26464, 0x26465, 0x264C1, 0x264D8, 0x2F97B, 0x264D9, 0x26509, 0x265AA, 0x265A3,
+	0x265B9, 0x259E2, 0x265A5, 0x265AB, 0x26620, 0x26789, 0x2678B, 0x267DB, 0x267E4, 0x267EF, 0x267E2, 0x267E9,
+	0x267F0, 0x267F3, 0x267DD, 0x2681E, 0x2691D, 0x26946, 0x2697C, 0x26980, 0x26981, 0x26982, 0x26987, 0x26988,
+	0x2698A, 0x2698C, 0x269E7, 0x269EB, 0x269EA, 0x26A68, 0x26A6B, 0x26A69, 0x26A66, 0x26A6A, 0x26A65, 0x26AE5,
+	0x26AE4, 0x26AE6, 0x26D18, 0x26E24, 0x26E48, 0x26E2F, 0x26E7E, 0x26D89, 0x26E1E, 0x26E49, 0x26E26, 0x26E09,
+	0x26E34, 0x26E2D, 0x26E4A, 0x26E15, 0x2F9A9, 0x26E33, 0x26E28, 0x26E27, 0x26E20, 0x26E2B, 0x26E29, 0x26E6A,
+	0x26E0B, 0x26E0E, 0x26E1C, 0x26DFF, 0x2F9AA, 0x26E35, 0x26E11, 0x26E4B, 0x26E4C, 0x26E1F, 0x26E0F, 0x26E39,
+	0x26E68, 0x26E1D, 0x26E08, 0x26E0C, 0x26E0D, 0x26E62, 0x26EAF, 0x26E32, 0x26E2C, 0x26E64,
+	// plane 7, row 8
+	0x26E04, 0x26E16, 0x26E4D, 0x26E07, 0x26EAE, 0x271D4, 0x271D1, 0x271D5, 0x271D0, 0x27371, 0x2735D, 0x2735B,
+	0x2737A, 0x2F9BD, 0x2737F, 0x27379, 0x27367, 0x2739E, 0x27394, 0x27364, 0x2735E, 0x27381, 0x2735A, 0x27357,
+	0x2737C, 0x27398, 0x27366, 0x2737E, 0x27382, 0x27368, 0x2735F, 0x27363, 0x27397, 0x045CD, 0x27353, 0x2737B,
+	0x2737D, 0x27378, 0x27406, 0x275C0, 0x275C2, 0x275C3, 0x276DC, 0x276E6, 0x276EC, 0x276F2, 0x276E8, 0x276EB,
+	0x276EA, 0x276E5, 0x276E0, 0x276D0, 0x276D8, 0x276D5, 0x276D3, 0x276E4, 0x276F3, 0x276DB, 0x2772F, 0x277F9,
+	0x2785D, 0x2785B, 0x2784C, 0x27858, 0x27860, 0x27853, 0x27850, 0x27907, 0x27908, 0x278F4, 0x278F6, 0x27904,
+	0x278FD, 0x27905, 0x27A28, 0x27A56, 0x27A42, 0x27A5C, 0x27A3D, 0x27A2F, 0x27A2B, 0x27A58, 0x27A61, 0x27A2C,
+	0x27A51, 0x27A50, 0x27A3C, 0x27A36, 0x046F6, 0x27A60, 0x27A5B, 0x27A2E, 0x27A40, 0x27A5F,
+	// plane 7, row 9
+	0x27A26, 0x27A2D, 0x27A5E, 0x27A3B, 0x27BEF, 0x27BF3, 0x27BEE, 0x27BED, 0x27C5A, 0x27C62, 0x27C5B, 0x27C5F,
+	0x27C5E, 0x27C64, 0x27CE0, 0x27CE1, 0x27CE5, 0x27DA5, 0x27D9B, 0x27D9F, 0x27D9C, 0x0477A, 0x27DAA, 0x27DA4,
+	0x27DA3, 0x27E67, 0x27F26, 0x27F1A, 0x047B8, 0x27F1C, 0x27F15, 0x27F1B, 0x27F18, 0x27F1E, 0x27F25, 0x2F9DC,
+	0x2807B, 0x28075, 0x28093, 0x2808F, 0x2807C, 0x2809B, 0x28088, 0x28091, 0x2807E, 0x04801, 0x28086, 0x2825B,
+	0x28254, 0x28335, 0x28340, 0x28337, 0x28329, 0x28326, 0x28323, 0x28330, 0x28320, 0x28328, 0x28332, 0x2832A,
+	0x28331, 0x28324, 0x28341, 0x28342, 0x28343, 0x28325, 0x28338, 0x2841C, 0x2841D, 0x2841E, 0x284F6, 0x285A5,
+	0x28589, 0x2859D, 0x2858A, 0x28587, 0x285AB, 0x28586, 0x2859E, 0x28593, 0x285A1, 0x28588, 0x285A3, 0x2859F,
+	0x285A0, 0x286FF, 0x28700, 0x28702, 0x286F8, 0x286F7, 0x28701, 0x28787, 0x28789, 0x28776,
+	// plane 7, row 10
+	0x2876E, 0x28777, 0x28782, 0x28785, 0x28783, 0x28784, 0x28857, 0x2884B, 0x28856, 0x2885D, 0x28860, 0x2884D,
+	0x28855, 0x28854, 0x28853, 0x28850, 0x28863, 0x28862, 0x28917, 0x28916, 0x2892D, 0x289D9, 0x289BF, 0x0492F,
+	0x289B2, 0x289AC, 0x289B0, 0x289CF, 0x289B8, 0x04931, 0x289D3, 0x289B1, 0x289AF, 0x289CE, 0x289B4, 0x289B7,
+	0x289D5, 0x0499C, 0x28D38, 0x28D28, 0x28D3F, 0x28D40, 0x28D41, 0x28D33, 0x28D2B, 0x28D2E, 0x28F11, 0x28F18,
+	0x28F17, 0x28F19, 0x28F16, 0x049E6, 0x28F0D, 0x28F26, 0x28F79, 0x28FD8, 0x28FF3, 0x28FD7, 0x28FCC, 0x28FCF,
+	0x28FCB, 0x28FD1, 0x28FCE, 0x28FD4, 0x28FD5, 0x28FD3, 0x28FD6, 0x28FCD, 0x28FDA, 0x28FD2, 0x28FDE, 0x290CC,
+	0x290B8, 0x290B7, 0x290C2, 0x290C3, 0x291FA, 0x291F9, 0x2921F, 0x2921D, 0x24382, 0x292A6, 0x29290, 0x2929E,
+	0x29298, 0x29293, 0x29292, 0x292A5, 0x29295, 0x293A9, 0x293A8, 0x293AA, 0x293AB, 0x293AC,
+	// plane 7, row 11
+	0x2941F, 0x29422, 0x294AA, 0x29496, 0x29491, 0x294AB, 0x294A2, 0x294A4, 0x2949D, 0x29497, 0x2947D, 0x2949F,
+	0x2949C, 0x29499, 0x294A6, 0x29492, 0x294A7, 0x295C9, 0x295C8, 0x295C7, 0x295CB, 0x29674, 0x296C9, 0x04B38,
+	0x296D2, 0x296DB, 0x296EB, 0x296E9, 0x296EF, 0x296EE, 0x296F9, 0x296DE, 0x29816, 0x29845, 0x2987F, 0x29898,
+	0x2989F, 0x29886, 0x29890, 0x2989A, 0x2989D, 0x2988D, 0x2989C, 0x298A0, 0x298A7, 0x2988F, 0x29A41, 0x29A35,
+	0x29A37, 0x29A30, 0x29A3A, 0x29A36, 0x2FA08, 0x29ACA, 0x29B12, 0x29B15, 0x29B22, 0x29B13, 0x29B18, 0x29B21,
+	0x29C10, 0x29C20, 0x29C2F, 0x29CA5, 0x29CA3, 0x29CB6, 0x29CAB, 0x29D68, 0x29D67, 0x29D6F, 0x29D77, 0x29D64,
+	0x29D78, 0x04C3A, 0x29D76, 0x29D6E, 0x04CB1, 0x29FD0, 0x29FB3, 0x29FCB, 0x29FD6, 0x29FB1, 0x29FB4, 0x29FD7,
+	0x29FC6, 0x29FBA, 0x29FD1, 0x29FD2, 0x29FB5, 0x29FC4, 0x29FCC, 0x29FBB, 0x29FB2, 0x2A25B,
+	// plane 7, row 12
+	0x2A25A, 0x2A259, 0x2A299, 0x2A294, 0x2A296, 0x2A293, 0x2A291, 0x2A28F, 0x2A298, 0x2A30C, 0x2A30A, 0x2A30E,
+	0x2A312, 0x2A311, 0x2A30B, 0x2A39A, 0x04D47, 0x2A3B4, 0x04D51, 0x2A41C, 0x2A420, 0x2A421, 0x2A4D3, 0x2A4D2,
+	0x2A538, 0x00000, 0x24CCF, 0x2011D, 0x20192, 0x2018F, 0x2018C, 0x203EA, 0x204B9, 0x2056E, 0x205A0, 0x291DD,
+	0x20612, 0x20617, 0x2066D, 0x20805, 0x207FB, 0x207FD, 0x20806, 0x20800, 0x207FF, 0x207FE, 0x208B6, 0x20905,
+	0x20907, 0x20ABC, 0x20ABB, 0x20B8B, 0x20B87, 0x27BFB, 0x20FE7, 0x20FE6, 0x20FE2, 0x285D3, 0x20FD9, 0x20FCA,
+	0x21012, 0x21010, 0x20FDC, 0x20FD0, 0x20F8B, 0x21013, 0x22A10, 0x20FDA, 0x2F84F, 0x20FFD, 0x20FE5, 0x20FCC,
+	0x21039, 0x20FC7, 0x20FD8, 0x21460, 0x21464, 0x21486, 0x2145E, 0x21466, 0x2145F, 0x21477, 0x21482, 0x21496,
+	0x2153E, 0x21579, 0x2157A, 0x21688, 0x21689, 0x2168C, 0x243E0, 0x2167B, 0x21686, 0x21894,
+	// plane 7, row 13
+	0x21897, 0x218B7, 0x218B5, 0x218B8, 0x21893, 0x218B2, 0x218B4, 0x21899, 0x218C3, 0x2189D, 0x2189B, 0x218A3,
+	0x03747, 0x2199F, 0x2199E, 0x219A3, 0x219A0, 0x219A1, 0x21AC5, 0x21AC6, 0x21ABE, 0x21AC4, 0x21ABF, 0x21B4C,
+	0x21B4A, 0x21BB1, 0x21C15, 0x21C17, 0x21C10, 0x21C18, 0x21CE0, 0x21CDF, 0x21D27, 0x21F43, 0x21F48, 0x21F1D,
+	0x21F50, 0x21F58, 0x21F47, 0x21F54, 0x21F53, 0x21F40, 0x21F55, 0x03817, 0x21F5A, 0x22470, 0x2215B, 0x2214D,
+	0x22156, 0x2214F, 0x2F887, 0x22159, 0x2F888, 0x22154, 0x22153, 0x22150, 0x222B3, 0x2236E, 0x038A3, 0x2236F,
+	0x22393, 0x22429, 0x22425, 0x22428, 0x2246F, 0x232B8, 0x2F897, 0x224B3, 0x22579, 0x2257E, 0x2257C, 0x22583,
+	0x2F8AC, 0x22800, 0x22834, 0x22865, 0x22836, 0x228A7, 0x22859, 0x22858, 0x22831, 0x22862, 0x22838, 0x22873,
+	0x2283E, 0x22855, 0x22854, 0x22895, 0x2F8AD, 0x228A5, 0x22894, 0x2289A, 0x2289B, 0x22899,
+	// plane 7, row 14
+	0x2289F, 0x22853, 0x22A12, 0x22A0A, 0x22A09, 0x22A0C, 0x22A0B, 0x22A0E, 0x22A0D, 0x22A08, 0x22D38, 0x22D3A,
+	0x22D37, 0x22D3D, 0x22D2D, 0x22D82, 0x22D95, 0x22D87, 0x22D9D, 0x22DB5, 0x22D81, 0x22DC7, 0x22D9B, 0x22D98,
+	0x22D94, 0x22D86, 0x22D90, 0x22D34, 0x22D8E, 0x22D85, 0x22DA6, 0x22D96, 0x22FEA, 0x22FDF, 0x232BD, 0x22FF7,
+	0x22FEB, 0x22FE0, 0x22FE8, 0x22FE1, 0x22FE9, 0x22FEE, 0x22FE5, 0x22FEC, 0x23063, 0x230C6, 0x230C2, 0x230C1,
+	0x230C0, 0x230C3, 0x23118, 0x232AE, 0x232A3, 0x232C5, 0x232A0, 0x232B4, 0x232A5, 0x03B1E, 0x232A4, 0x232A8,
+	0x232A6, 0x236A8, 0x2368D, 0x23682, 0x2369E, 0x23689, 0x23683, 0x23691, 0x236A6, 0x2369A, 0x23690, 0x23685,
+	0x2367D, 0x2F8EC, 0x2369B, 0x236A5, 0x236F0, 0x23696, 0x23688, 0x236D6, 0x236D8, 0x23686, 0x236A4, 0x23697,
+	0x2391C, 0x23919, 0x2399E, 0x2399F, 0x2399D, 0x2399C, 0x23A5D, 0x23A5E, 0x23A73, 0x23A63,
+	// plane 7, row 15
+	0x23ABE, 0x23AC1, 0x23ABC, 0x23ABF, 0x23ABB, 0x23ABD, 0x23B13, 0x23B14, 0x23BF8, 0x23BFA, 0x23C06, 0x23BFE,
+	0x23C51, 0x2400B, 0x23FF1, 0x23FA9, 0x23FD5, 0x23FDC, 0x23FCB, 0x23FF2, 0x2405F, 0x23FDB, 0x23FD6, 0x23FD9,
+	0x23FD1, 0x23FCF, 0x23FD8, 0x23FE0, 0x23FD4, 0x23FF3, 0x24005, 0x23FCE, 0x23FF4, 0x2F912, 0x23FDA, 0x2400E,
+	0x24006, 0x23FD7, 0x23FCD, 0x24007, 0x23FE1, 0x24008, 0x243BE, 0x243EF, 0x243D9, 0x243DA, 0x071D7, 0x243A0,
+	0x243DF, 0x2439E, 0x243BF, 0x243AF, 0x243A7, 0x243AA, 0x243F4, 0x243AE, 0x243B5, 0x243B8, 0x243DB, 0x243C0,
+	0x243B7, 0x243A2, 0x243DD, 0x243A1, 0x243A5, 0x243B4, 0x243A6, 0x243AB, 0x243AC, 0x2439F, 0x243B1, 0x243FC,
+	0x2452E, 0x24530, 0x24599, 0x245F8, 0x245F6, 0x246F4, 0x246EB, 0x246E1, 0x246E4, 0x24875, 0x24869, 0x03E9A,
+	0x24899, 0x2489E, 0x24894, 0x24893, 0x24891, 0x24A19, 0x24A17, 0x24A49, 0x24A47, 0x24A48,
+	// plane 7, row 16
+	0x24A52, 0x24A40, 0x24A63, 0x24A46, 0x24A5F, 0x24B0E, 0x24B85, 0x24B87, 0x24BCB, 0x24BCC, 0x24BC9, 0x24CCB,
+	0x21CE7, 0x24D20, 0x24E5F, 0x24E60, 0x24E6A, 0x24E76, 0x24E73, 0x24E71, 0x24E66, 0x03FC2, 0x24E6B, 0x24E79,
+	0x24E7B, 0x24F3C, 0x24F9E, 0x24F9C, 0x2501C, 0x2501E, 0x25021, 0x25096, 0x250A1, 0x250B6, 0x250A0, 0x25094,
+	0x25097, 0x25281, 0x25286, 0x252C0, 0x2528A, 0x2F94B, 0x25285, 0x25295, 0x25249, 0x25282, 0x25284, 0x22878,
+	0x25290, 0x252B1, 0x253FE, 0x253FB, 0x25550, 0x25556, 0x2555A, 0x2554C, 0x040DC, 0x25557, 0x256E1, 0x256D9,
+	0x256DB, 0x256F4, 0x25745, 0x25747, 0x25874, 0x2586B, 0x25868, 0x25869, 0x25879, 0x2F95B, 0x25880, 0x25881,
+	0x25861, 0x25870, 0x2586F, 0x25873, 0x2585D, 0x259FA, 0x041B3, 0x259F3, 0x25A0A, 0x25A17, 0x2F95F, 0x25AB0,
+	0x25C3E, 0x25C46, 0x25C4F, 0x25C44, 0x25C69, 0x25C33, 0x25C2F, 0x25C4E, 0x25C3A, 0x25C2B,
+	// plane 7, row 17
+	0x25CA9, 0x25C50, 0x25C45, 0x25C37, 0x25C25, 0x25C2C, 0x25C3F, 0x25C34, 0x25C29, 0x25C1E, 0x25C3D, 0x25EED,
+	0x2F966, 0x25EF3, 0x25EEA, 0x25EEB, 0x260CC, 0x260CE, 0x260D0, 0x260E3, 0x260E0, 0x260D1, 0x260DC, 0x260DD,
+	0x260F0, 0x260DB, 0x260E5, 0x260F1, 0x260C9, 0x260E8, 0x260E7, 0x260C8, 0x260DE, 0x260CD, 0x260C5, 0x260C6,
+	0x260E4, 0x260C3, 0x262FB, 0x262FC, 0x262FE, 0x26303, 0x26300, 0x262FD, 0x26305, 0x26313, 0x263A7, 0x263B4,
+	0x26470, 0x26474, 0x26471, 0x26475, 0x2647D, 0x26473, 0x264C4, 0x264C3, 0x264DE, 0x264DD, 0x26511, 0x2650F,
+	0x26510, 0x265C3, 0x265C2, 0x265CA, 0x265C1, 0x26623, 0x267B1, 0x26824, 0x26811, 0x26825, 0x2680A, 0x2681F,
+	0x26820, 0x26814, 0x26828, 0x26803, 0x26812, 0x2680B, 0x26817, 0x22A11, 0x26949, 0x26989, 0x26990, 0x2698F,
+	0x26996, 0x26995, 0x26993, 0x269ED, 0x269F0, 0x243DE, 0x26A85, 0x26A77, 0x26A7A, 0x26A84,
+	// plane 7, row 18
+	0x26A79, 0x26A7D, 0x26A7B, 0x26AED, 0x26AEC, 0x26DF6, 0x26D9B, 0x26E9D, 0x26F11, 0x26EC4, 0x26EC8, 0x26EDF,
+	0x26EBF, 0x26EB3, 0x26EBA, 0x26F10, 0x26F06, 0x26E88, 0x26E9A, 0x26EE0, 0x26ECC, 0x26EB5, 0x26EE1, 0x26EBC,
+	0x26EC6, 0x26F0B, 0x26EA4, 0x26E95, 0x26EA3, 0x26EE2, 0x26ECD, 0x26EE3, 0x26EAB, 0x26ECB, 0x26E8F, 0x2F9AB,
+	0x26EA9, 0x26F24, 0x26EE4, 0x26F12, 0x26EE5, 0x26F67, 0x26EAA, 0x26EA0, 0x26EE6, 0x26EC1, 0x26EE7, 0x26F0D,
+	0x26E86, 0x26EB0, 0x26E8B, 0x26EE8, 0x26EC9, 0x26F19, 0x26EC0, 0x26F0C, 0x26EE9, 0x26EEA, 0x271ED, 0x271E3,
+	0x271E4, 0x271E8, 0x271D2, 0x271E2, 0x08666, 0x273CF, 0x273D1, 0x273C3, 0x273C9, 0x273EA, 0x273B4, 0x273DC,
+	0x273BD, 0x273E6, 0x273C8, 0x273EC, 0x273B2, 0x273A9, 0x273D3, 0x273C0, 0x273E9, 0x273D5, 0x045D9, 0x273C5,
+	0x045DD, 0x273CB, 0x273D0, 0x273D2, 0x273E4, 0x273E8, 0x273CD, 0x273B6, 0x273AA, 0x273D4,
+	// plane 7, row 19
+	0x28449, 0x273C1, 0x273DD, 0x273CE, 0x275C9, 0x275CA, 0x276FD, 0x276D9, 0x276FF, 0x27704, 0x276FA, 0x27706,
+	0x27715, 0x27711, 0x27707, 0x2770B, 0x277FC, 0x277FD, 0x2786D, 0x27865, 0x27914, 0x27913, 0x2790C, 0x2790B,
+	0x27918, 0x27922, 0x27A7D, 0x27A6F, 0x27A75, 0x27A7B, 0x27A80, 0x27A7F, 0x27A96, 0x27A6D, 0x27A6B, 0x27A86,
+	0x27A73, 0x27A62, 0x27A77, 0x27A81, 0x27A69, 0x27A82, 0x27A97, 0x27A84, 0x046FC, 0x27A78, 0x2F9D0, 0x27A7A,
+	0x27A6A, 0x27A65, 0x27A7E, 0x27A94, 0x27BC2, 0x27BC1, 0x27BF9, 0x27C71, 0x27C6B, 0x27C6D, 0x27C6F, 0x27C6E,
+	0x27CF2, 0x27CE8, 0x27CEF, 0x27CE9, 0x27CEA, 0x27CED, 0x27CF3, 0x27CE6, 0x27DC9, 0x27DB4, 0x27DB3, 0x27DB9,
+	0x27DCA, 0x27DB1, 0x27DB6, 0x27DC7, 0x27DC4, 0x27DB7, 0x27E6F, 0x27F33, 0x27F32, 0x27F1F, 0x2F9D9, 0x27F2B,
+	0x27F30, 0x27F36, 0x27F42, 0x27F41, 0x2F9D8, 0x280A5, 0x280AB, 0x280A9, 0x280B3, 0x280A7,
+	// plane 7, row 20
+	0x280A0, 0x280D2, 0x280A8, 0x280B6, 0x280AC, 0x280AE, 0x280E6, 0x28265, 0x28347, 0x28363, 0x2834D, 0x2835F,
+	0x2834B, 0x28360, 0x28349, 0x28353, 0x2F9DF, 0x08F3A, 0x28357, 0x2834E, 0x28352, 0x28354, 0x28420, 0x28422,
+	0x285C1, 0x285D7, 0x285D5, 0x285C4, 0x285D0, 0x285BB, 0x285E2, 0x285CB, 0x285B8, 0x285BF, 0x285D8, 0x285C0,
+	0x285BA, 0x285B4, 0x285BC, 0x285D4, 0x285ED, 0x285C2, 0x285D9, 0x285CC, 0x2872F, 0x28723, 0x28796, 0x2878D,
+	0x2879E, 0x28799, 0x28883, 0x04907, 0x2886A, 0x28869, 0x28875, 0x2886C, 0x28880, 0x2886E, 0x28881, 0x28877,
+	0x28876, 0x28873, 0x28870, 0x28884, 0x28919, 0x289F2, 0x0933D, 0x289EC, 0x289EB, 0x289F6, 0x289EF, 0x289F4,
+	0x289EE, 0x289F3, 0x289ED, 0x28C9B, 0x28C98, 0x28C94, 0x2147A, 0x28C9A, 0x2F9F0, 0x28D4C, 0x049A8, 0x28D57,
+	0x28D51, 0x28D62, 0x28D52, 0x28D5C, 0x28D53, 0x2F9EF, 0x28E85, 0x28F2D, 0x28F3E, 0x28F30,
+	// plane 7, row 21
+	0x28F7D, 0x28FE6, 0x28FF6, 0x28FE4, 0x28FE9, 0x28FEC, 0x28FF2, 0x28FE8, 0x28FE3, 0x28FEB, 0x28FF0, 0x28FEA,
+	0x28FE7, 0x28FFD, 0x28FE5, 0x28FEE, 0x28FF5, 0x28FF9, 0x28FF1, 0x28FF4, 0x2F9F4, 0x29011, 0x290DE, 0x290D8,
+	0x290DD, 0x290DB, 0x290D3, 0x290E1, 0x291DE, 0x291FC, 0x29228, 0x292B7, 0x292B8, 0x292C7, 0x292B2, 0x292A9,
+	0x292B9, 0x292BC, 0x292B3, 0x292D1, 0x293B0, 0x293B1, 0x293B6, 0x29404, 0x2942C, 0x294C1, 0x2949E, 0x294BA,
+	0x04ACA, 0x294D1, 0x294D3, 0x294D4, 0x294D5, 0x294C5, 0x294B6, 0x294B2, 0x294B5, 0x294C3, 0x294B9, 0x294C6,
+	0x295D6, 0x295E0, 0x295DB, 0x295D7, 0x295DD, 0x29715, 0x2970C, 0x2970F, 0x2970E, 0x296FE, 0x29704, 0x2970B,
+	0x29702, 0x296FF, 0x29708, 0x29710, 0x29717, 0x29713, 0x29706, 0x29709, 0x29824, 0x29826, 0x29825, 0x29848,
+	0x298B5, 0x298D2, 0x298D5, 0x298C4, 0x298AF, 0x298AD, 0x298C1, 0x298C0, 0x298CC, 0x298CD,
+	// plane 7, row 22
+	0x298C3, 0x298C8, 0x298C5, 0x298BA, 0x298D0, 0x298C2, 0x298CE, 0x04B8D, 0x29A43, 0x29A42, 0x29A40, 0x29A31,
+	0x29ACF, 0x29ACE, 0x29B30, 0x29B34, 0x29B32, 0x29B43, 0x29B3C, 0x29C11, 0x29C35, 0x29C34, 0x29C37, 0x29C31,
+	0x29CBF, 0x29CBC, 0x29CC2, 0x29CC9, 0x29D83, 0x29D8B, 0x29DA0, 0x29D8D, 0x29D8C, 0x29D9A, 0x29D8A, 0x29D91,
+	0x29FF1, 0x2A00F, 0x2A001, 0x2A007, 0x2A00C, 0x04CC0, 0x29FDC, 0x29FEE, 0x29FF7, 0x29FF2, 0x29FF8, 0x29FEB,
+	0x04CCA, 0x29FE6, 0x29FED, 0x29FE9, 0x2A008, 0x2A000, 0x29FE5, 0x29FFC, 0x2A261, 0x2A25E, 0x2A25F, 0x04D25,
+	0x2A29B, 0x2A316, 0x2A315, 0x2A320, 0x2A314, 0x2A31A, 0x2A317, 0x2A39C, 0x04D4A, 0x2A3F1, 0x04D53, 0x2A3F0,
+	0x2A428, 0x2A424, 0x2A42A, 0x2A42B, 0x2A4D5, 0x2A504, 0x2A505, 0x2A591, 0x2A592, 0x2A6A6, 0x20109, 0x20422,
+	0x2041A, 0x2040E, 0x2041B, 0x20408, 0x250B3, 0x2F81C, 0x2069D, 0x20812, 0x2081E, 0x208BB,
+	// plane 7, row 23
+	0x208BF, 0x208BC, 0x20908, 0x20909, 0x20963, 0x209C8, 0x23728, 0x20B8E, 0x20B8D, 0x21043, 0x2104D, 0x2106D,
+	0x21042, 0x21052, 0x21051, 0x21069, 0x21050, 0x21056, 0x2106C, 0x21044, 0x21045, 0x2106B, 0x03605, 0x21068,
+	0x21057, 0x2122E, 0x21231, 0x2122D, 0x2148B, 0x214B2, 0x2148D, 0x214A3, 0x214A4, 0x21490, 0x21489, 0x2148E,
+	0x21492, 0x2157B, 0x21690, 0x2168F, 0x218E9, 0x218CD, 0x218C5, 0x218D0, 0x218C9, 0x218D1, 0x218C7, 0x218D2,
+	0x2185F, 0x218E1, 0x218CC, 0x218C6, 0x218E4, 0x219A9, 0x21AD3, 0x21ADA, 0x21AD2, 0x21ADB, 0x21B4D, 0x21B4E,
+	0x21C1C, 0x21CE8, 0x21F6C, 0x21F5F, 0x21F66, 0x21F64, 0x21F78, 0x21F65, 0x21F6D, 0x21F61, 0x21F7A, 0x22007,
+	0x22166, 0x22168, 0x22162, 0x222D3, 0x222D4, 0x222D1, 0x222DC, 0x22373, 0x22372, 0x2242D, 0x2242C, 0x2242F,
+	0x22472, 0x224B4, 0x22589, 0x228CD, 0x228A6, 0x228A2, 0x228A0, 0x22846, 0x228A1, 0x26857,
+	// plane 7, row 24
+	0x228A3, 0x228B1, 0x228DB, 0x228F6, 0x228DC, 0x228D6, 0x22A13, 0x22D9C, 0x22D97, 0x22DD8, 0x22DE4, 0x22DD4,
+	0x22DE5, 0x22DDB, 0x22DD0, 0x22DDA, 0x22DCC, 0x22DDC, 0x22DED, 0x22DD3, 0x22DD1, 0x22DCE, 0x22DD9, 0x22DDD,
+	0x2F8C6, 0x22EE6, 0x22EE5, 0x22EE9, 0x22FFF, 0x22FFE, 0x2300E, 0x2308C, 0x230CA, 0x230CB, 0x2311A, 0x232D7,
+	0x232CF, 0x232D0, 0x232C9, 0x232CC, 0x232D3, 0x233A4, 0x233A3, 0x23681, 0x23726, 0x236F3, 0x23727, 0x236F2,
+	0x236FF, 0x236F5, 0x236FC, 0x2370E, 0x03BF0, 0x236F6, 0x23700, 0x23723, 0x23705, 0x23725, 0x23708, 0x23750,
+	0x23925, 0x23920, 0x23923, 0x23921, 0x239A1, 0x23A69, 0x23A6C, 0x23A68, 0x23A6B, 0x23ACC, 0x23ACD, 0x23C10,
+	0x23C0D, 0x23C0A, 0x23C16, 0x23C14, 0x23C52, 0x24028, 0x2402E, 0x2402C, 0x2404D, 0x24049, 0x24031, 0x24030,
+	0x24033, 0x2402D, 0x24036, 0x2403E, 0x2402F, 0x24027, 0x24034, 0x2404C, 0x243FE, 0x24412,
+	// plane 7, row 25
+	0x2441F, 0x24417, 0x243F5, 0x24415, 0x243F7, 0x03E02, 0x21F7D, 0x243FA, 0x243F9, 0x2444B, 0x2459C, 0x2459D,
+	0x03E23, 0x246F0, 0x246F6, 0x246EF, 0x2489D, 0x2489A, 0x248A7, 0x248AF, 0x248AA, 0x24A64, 0x24A86, 0x24A75,
+	0x24A70, 0x24A84, 0x24A6B, 0x24A85, 0x24A6C, 0x24B17, 0x24B94, 0x24B93, 0x24CD2, 0x24CD7, 0x24CD4, 0x24EA6,
+	0x24EA7, 0x24E9C, 0x24E8B, 0x24E8D, 0x24E98, 0x24EB9, 0x24E9B, 0x24E9D, 0x24E99, 0x24EA8, 0x24E91, 0x24E87,
+	0x24E9A, 0x24FA6, 0x2501F, 0x250A7, 0x250B1, 0x250B2, 0x250B7, 0x252D0, 0x252B3, 0x252B5, 0x252C4, 0x252C3,
+	0x252BC, 0x252B2, 0x252BA, 0x252BB, 0x252C2, 0x252CD, 0x252BE, 0x252B7, 0x2538F, 0x25403, 0x25404, 0x25571,
+	0x25577, 0x25574, 0x2558B, 0x2557A, 0x2F951, 0x2558C, 0x25573, 0x256FF, 0x256FB, 0x256FD, 0x256F0, 0x256F3,
+	0x256FC, 0x256F2, 0x25892, 0x2589E, 0x258AE, 0x25896, 0x25A14, 0x25A12, 0x25A13, 0x25A16,
+	// plane 7, row 26
+	0x25A0F, 0x25AB6, 0x25ABD, 0x25C8C, 0x25CAE, 0x25CAC, 0x25CAB, 0x25C99, 0x25C92, 0x25CBB, 0x25C9E, 0x25C7E,
+	0x25CAF, 0x25CBC, 0x25C98, 0x25F01, 0x25F09, 0x25F06, 0x2F967, 0x25F07, 0x25F08, 0x260CF, 0x2610E, 0x26132,
+	0x04315, 0x26112, 0x26116, 0x26117, 0x2611B, 0x26115, 0x26131, 0x26118, 0x2611A, 0x26110, 0x2610A, 0x26109,
+	0x2624F, 0x2F973, 0x2630F, 0x26310, 0x2F975, 0x26328, 0x26311, 0x26316, 0x26317, 0x26302, 0x263BB, 0x263BA,
+	0x263C3, 0x263BC, 0x2648A, 0x26484, 0x26486, 0x264E0, 0x26517, 0x26518, 0x2651E, 0x26515, 0x265D3, 0x265DA,
+	0x265D9, 0x267E6, 0x267F4, 0x267E1, 0x26869, 0x26840, 0x26858, 0x2686C, 0x2684D, 0x26921, 0x26999, 0x269F1,
+	0x23F68, 0x26A86, 0x26F5B, 0x26F5C, 0x26F77, 0x26F2C, 0x2F9AD, 0x26F58, 0x26F64, 0x26F61, 0x26F48, 0x26F97,
+	0x26F59, 0x26F29, 0x26F62, 0x26F2E, 0x26F68, 0x26F90, 0x26F3A, 0x26F3D, 0x26F5E, 0x26F46,
+	// plane 7, row 27
+	0x26F69, 0x26F65, 0x26F3E, 0x26F49, 0x26F56, 0x26FE1, 0x26F78, 0x26F79, 0x26F66, 0x26F4A, 0x26F35, 0x26F7A,
+	0x26F92, 0x26F60, 0x26F36, 0x26F51, 0x26F42, 0x0455D, 0x26F3F, 0x26F7B, 0x26F5D, 0x26F94, 0x26F6A, 0x2F9B5,
+	0x271F2, 0x273BB, 0x2741B, 0x2741A, 0x27433, 0x27417, 0x2740A, 0x27415, 0x27412, 0x27401, 0x2742D, 0x273FD,
+	0x27423, 0x27405, 0x27411, 0x27400, 0x2741C, 0x27435, 0x2742E, 0x27436, 0x20ABF, 0x2742F, 0x2740C, 0x27409,
+	0x27431, 0x273FC, 0x2740F, 0x27418, 0x27402, 0x27600, 0x2771F, 0x27737, 0x0465A, 0x27738, 0x2772B, 0x2772E,
+	0x27721, 0x27730, 0x27729, 0x27731, 0x2F9C9, 0x27701, 0x2772C, 0x27722, 0x277FF, 0x27877, 0x27867, 0x2787F,
+	0x2787D, 0x2787B, 0x2787E, 0x2791E, 0x2791C, 0x27921, 0x27926, 0x27927, 0x27929, 0x2792C, 0x2791D, 0x2792B,
+	0x27ABF, 0x27AA4, 0x27AAA, 0x27AAE, 0x27A9F, 0x27AD0, 0x27AB1, 0x27AAD, 0x27A9B, 0x27AB2,
+	// plane 7, row 28
+	0x27AA9, 0x27AB3, 0x27AB4, 0x27ABA, 0x27AA5, 0x27AB7, 0x27AAC, 0x27ACB, 0x27ACF, 0x27BC6, 0x27C01, 0x27BFF,
+	0x27BFD, 0x27C77, 0x27C78, 0x27C76, 0x27CF7, 0x27DCC, 0x04785, 0x27DD4, 0x27DD7, 0x27DD5, 0x27DD6, 0x27DD3,
+	0x27F5D, 0x27F55, 0x280E0, 0x28148, 0x280EE, 0x280DB, 0x280E7, 0x280D6, 0x280E5, 0x280E1, 0x280DD, 0x280E2,
+	0x28270, 0x28266, 0x2826F, 0x2826E, 0x28381, 0x28369, 0x2836E, 0x2836D, 0x2836C, 0x28384, 0x28385, 0x28371,
+	0x28373, 0x2836A, 0x2836F, 0x2837B, 0x2856A, 0x2857C, 0x2857D, 0x28581, 0x285FA, 0x28605, 0x285EB, 0x285FB,
+	0x285E9, 0x285EF, 0x285FC, 0x285E7, 0x2F9E1, 0x285EE, 0x285FD, 0x28732, 0x287A7, 0x287B5, 0x287B1, 0x287B9,
+	0x287A8, 0x287B3, 0x2F9E6, 0x2888A, 0x28891, 0x2888D, 0x28899, 0x0490B, 0x2889A, 0x2889B, 0x28892, 0x2888F,
+	0x288AB, 0x24CDB, 0x04939, 0x28A75, 0x28A31, 0x28A38, 0x04937, 0x28A35, 0x28A69, 0x28A3B,
+	// plane 7, row 29
+	0x28A3D, 0x28A6C, 0x28A79, 0x28A3C, 0x28A3E, 0x28C97, 0x28CA5, 0x28CA2, 0x28C9D, 0x28CA1, 0x28D68, 0x28D6F,
+	0x28D6D, 0x28D72, 0x28D75, 0x28D77, 0x28D79, 0x28EB5, 0x28EEA, 0x28EAB, 0x28F43, 0x28F41, 0x28F42, 0x29009,
+	0x29008, 0x29006, 0x29001, 0x29003, 0x29000, 0x29004, 0x2900A, 0x2900E, 0x2900D, 0x29007, 0x2900F, 0x29014,
+	0x29002, 0x29015, 0x2900C, 0x29010, 0x29005, 0x290FD, 0x290FF, 0x29104, 0x29100, 0x29109, 0x2922B, 0x2F9F7,
+	0x29231, 0x292DB, 0x292C5, 0x292D3, 0x292CE, 0x04A6B, 0x292C9, 0x292BF, 0x292CB, 0x292C0, 0x292D0, 0x292D4,
+	0x293C1, 0x293B9, 0x293BB, 0x293C3, 0x293C9, 0x29407, 0x2942D, 0x294F8, 0x294E1, 0x294FA, 0x294EF, 0x294FD,
+	0x04ACD, 0x294EB, 0x294F1, 0x294ED, 0x294FE, 0x295F8, 0x29603, 0x295EE, 0x295E8, 0x29601, 0x296EC, 0x29722,
+	0x29714, 0x29734, 0x2972F, 0x29739, 0x29741, 0x2973C, 0x29749, 0x29758, 0x2973A, 0x29742,
+	// plane 7, row 30
+	0x2973F, 0x29822, 0x29823, 0x2984A, 0x298DC, 0x298D9, 0x298DB, 0x298E2, 0x2FA07, 0x298DF, 0x298E0, 0x298D7,
+	0x29A4F, 0x29A46, 0x29A53, 0x29A55, 0x29A4E, 0x29A4A, 0x29A4C, 0x29A63, 0x29B51, 0x29B53, 0x29B58, 0x29B4D,
+	0x29B5A, 0x29B49, 0x29B5D, 0x29C12, 0x29C3C, 0x29CD1, 0x29CDF, 0x29CD6, 0x29CD8, 0x29CE0, 0x29CD9, 0x29DB1,
+	0x29DAC, 0x29DAA, 0x29DEE, 0x29DBD, 0x04C4D, 0x29DC3, 0x29DA8, 0x29DAE, 0x29DAB, 0x2A01D, 0x2A027, 0x2A038,
+	0x2A012, 0x2A048, 0x2A02B, 0x2A016, 0x2A019, 0x2A03D, 0x2A023, 0x2A02A, 0x2A264, 0x2A2AD, 0x2A2AC, 0x2FA14,
+	0x2A2B1, 0x2A2AF, 0x2A32C, 0x2A324, 0x2A325, 0x2A328, 0x2A3F9, 0x2A3F7, 0x2A3FD, 0x2A3FE, 0x2A439, 0x2A433,
+	0x2A4D7, 0x2A4D8, 0x2A4E4, 0x214A1, 0x2A50E, 0x2A53B, 0x2A544, 0x2A542, 0x2A594, 0x2A593, 0x2A5D5, 0x2A6A7,
+	0x20431, 0x20423, 0x20428, 0x20427, 0x204C6, 0x205A3, 0x20621, 0x2081B, 0x03510, 0x208C1,
+	// plane 7, row 31
+	0x208C3, 0x2092A, 0x20969, 0x20A27, 0x210B6, 0x210A7, 0x210A4, 0x210A6, 0x21090, 0x2109E, 0x21094, 0x210A8,
+	0x210A5, 0x210A2, 0x21091, 0x0367B, 0x214BC, 0x214BD, 0x214B4, 0x214B0, 0x214E4, 0x21545, 0x2154B, 0x2157E,
+	0x2157F, 0x2157D, 0x215C3, 0x218FC, 0x218F7, 0x218F0, 0x218ED, 0x218F1, 0x218F8, 0x21AE9, 0x21CEB, 0x0381D,
+	0x21F90, 0x21F8D, 0x21F86, 0x21F91, 0x21F8A, 0x22008, 0x22050, 0x222EA, 0x222E6, 0x222E2, 0x222E7, 0x222ED,
+	0x222E1, 0x22434, 0x22476, 0x22475, 0x22473, 0x224B5, 0x22590, 0x22592, 0x228E1, 0x228DF, 0x228D5, 0x228F2,
+	0x228FE, 0x22913, 0x2292E, 0x22A19, 0x22E08, 0x064F5, 0x22E05, 0x22E09, 0x22E06, 0x22E03, 0x22DFD, 0x22DFC,
+	0x22E02, 0x22E42, 0x2301A, 0x23011, 0x23015, 0x23016, 0x230CC, 0x230CF, 0x230D0, 0x23122, 0x2311E, 0x23121,
+	0x232E5, 0x03B27, 0x23354, 0x232EF, 0x23353, 0x23351, 0x233AD, 0x23767, 0x23768, 0x237A4,
+	// plane 7, row 32
+	0x23777, 0x23789, 0x23744, 0x2378B, 0x23779, 0x2375B, 0x23743, 0x23757, 0x2374A, 0x2377C, 0x23746, 0x2377B,
+	0x23756, 0x239A8, 0x23A76, 0x23A72, 0x23AD6, 0x23AD8, 0x23AD1, 0x23C22, 0x23C20, 0x23C23, 0x23C1E, 0x23C6E,
+	0x240A3, 0x24077, 0x240A6, 0x2406D, 0x240A2, 0x2407C, 0x24084, 0x24068, 0x24074, 0x24086, 0x240A5, 0x2407B,
+	0x2407A, 0x24069, 0x24072, 0x24076, 0x2444A, 0x24437, 0x2442A, 0x2442D, 0x24446, 0x24428, 0x24426, 0x24442,
+	0x2442C, 0x24438, 0x2442B, 0x24433, 0x24445, 0x24539, 0x246F9, 0x246FA, 0x248B8, 0x248B7, 0x248BB, 0x248B9,
+	0x248B4, 0x24A6F, 0x24A87, 0x24A8F, 0x24AA2, 0x24AA3, 0x2F931, 0x24B9B, 0x24B9D, 0x24BCE, 0x03FCF, 0x24EBD,
+	0x24EBF, 0x24E92, 0x03FCD, 0x24EEF, 0x24EC9, 0x24FA4, 0x24FA8, 0x24FAA, 0x25028, 0x25024, 0x25025, 0x25026,
+	0x250A9, 0x250BA, 0x250BE, 0x250BC, 0x250C0, 0x252F0, 0x252DF, 0x252E0, 0x252ED, 0x252DB,
+	// plane 7, row 33
+	0x252FB, 0x252B9, 0x252DA, 0x252EB, 0x252EC, 0x2559A, 0x2559F, 0x2559B, 0x25597, 0x255A1, 0x2570F, 0x25705,
+	0x25748, 0x04182, 0x258BC, 0x258BA, 0x25ABF, 0x25D01, 0x25CE8, 0x25CEF, 0x25CE4, 0x25CE6, 0x25D02, 0x25CEB,
+	0x25CE0, 0x25CED, 0x25CD9, 0x2F964, 0x25D14, 0x25CEE, 0x04252, 0x25D13, 0x25CF9, 0x25CF8, 0x25F25, 0x2F969,
+	0x25F19, 0x25F20, 0x25F43, 0x25F3F, 0x26145, 0x2614C, 0x26149, 0x2614F, 0x26141, 0x2613E, 0x2614D, 0x26152,
+	0x2614A, 0x2614E, 0x26173, 0x26142, 0x26151, 0x26155, 0x26150, 0x2616C, 0x00000, 0x2616A, 0x26153, 0x26168,
+	0x26255, 0x26256, 0x2631C, 0x2631D, 0x07F80, 0x2631E, 0x26323, 0x2631F, 0x263E1, 0x263CD, 0x263CB, 0x263CC,
+	0x263C8, 0x263C9, 0x2649B, 0x26494, 0x26492, 0x26496, 0x26493, 0x26495, 0x2648F, 0x2651D, 0x26522, 0x26521,
+	0x265E9, 0x265EF, 0x265E0, 0x265E6, 0x265E4, 0x26829, 0x2682C, 0x26876, 0x26883, 0x26878,
+	// plane 7, row 34
+	0x2683C, 0x24443, 0x2687A, 0x04451, 0x268F2, 0x2699E, 0x2699B, 0x2699A, 0x269F6, 0x269F5, 0x26AA5, 0x26A93,
+	0x26AA4, 0x26E82, 0x26EC7, 0x26FB7, 0x2701D, 0x26FE2, 0x26FD7, 0x26FE3, 0x26FE4, 0x26FBC, 0x26FD3, 0x0455A,
+	0x26F5A, 0x26FD2, 0x26F2D, 0x2F9AF, 0x26FC4, 0x26FD0, 0x26FE5, 0x27005, 0x27007, 0x26FE6, 0x2701B, 0x26FE7,
+	0x26FD8, 0x26FBE, 0x27017, 0x26FB4, 0x26FD9, 0x26FE8, 0x26FAD, 0x26FAF, 0x26FC8, 0x26FE9, 0x26FEA, 0x271FE,
+	0x271FB, 0x27200, 0x27472, 0x27470, 0x27446, 0x27459, 0x2745E, 0x27448, 0x2744F, 0x27471, 0x27460, 0x2745F,
+	0x2746E, 0x27473, 0x2F9C0, 0x2F9BF, 0x27447, 0x2746D, 0x2746F, 0x27481, 0x2746C, 0x27478, 0x27483, 0x27449,
+	0x27468, 0x27474, 0x27463, 0x2746A, 0x26885, 0x27465, 0x27462, 0x274C8, 0x275D0, 0x275D4, 0x275D1, 0x27603,
+	0x27742, 0x27763, 0x27756, 0x2775B, 0x27755, 0x27750, 0x2772D, 0x27744, 0x27748, 0x27745,
+	// plane 7, row 35
+	0x27782, 0x04665, 0x27762, 0x27885, 0x2788D, 0x27936, 0x2792F, 0x27931, 0x27937, 0x27AA7, 0x27AD9, 0x27AF0,
+	0x27AF2, 0x27AFD, 0x27AE8, 0x27AEB, 0x27AEE, 0x27AE0, 0x27AE9, 0x27AED, 0x27AD6, 0x27AF8, 0x27AD4, 0x27ADF,
+	0x27AE7, 0x27AD8, 0x27AE3, 0x27AEF, 0x27B0F, 0x27BCA, 0x21546, 0x27C05, 0x27C0C, 0x27C0D, 0x27C7F, 0x27C80,
+	0x27C81, 0x27D01, 0x27D03, 0x27DF2, 0x27DE2, 0x27DE3, 0x27DDE, 0x27DE9, 0x27DE8, 0x27DE0, 0x27E01, 0x27DF5,
+	0x27DE4, 0x08D01, 0x27E77, 0x27F74, 0x27F6F, 0x27F62, 0x27F61, 0x27F6D, 0x27F73, 0x27F6A, 0x27F69, 0x28112,
+	0x2812D, 0x28114, 0x2810F, 0x28129, 0x28116, 0x28103, 0x28146, 0x2815C, 0x28111, 0x28106, 0x280DC, 0x2812B,
+	0x2812A, 0x2812C, 0x28127, 0x2827A, 0x2839C, 0x28399, 0x28395, 0x2838B, 0x28398, 0x28396, 0x28432, 0x285A4,
+	0x285AA, 0x2861B, 0x048AF, 0x2860D, 0x2861C, 0x2860A, 0x28620, 0x28608, 0x2861A, 0x28613,
+	// plane 7, row 36
+	0x28611, 0x2875D, 0x2875F, 0x2875E, 0x28760, 0x287BB, 0x287BC, 0x287C1, 0x287C0, 0x287C8, 0x287CE, 0x288A7,
+	0x288B2, 0x288B6, 0x288A5, 0x288BA, 0x288B5, 0x288AD, 0x288A4, 0x288D3, 0x288B0, 0x288B1, 0x2891D, 0x28A8D,
+	0x04941, 0x28A91, 0x28AB6, 0x28AB7, 0x28ABD, 0x28ABC, 0x28A96, 0x28A94, 0x28AA0, 0x28CA8, 0x28CA6, 0x28D84,
+	0x28D96, 0x28D88, 0x28D9A, 0x28ED1, 0x28ECF, 0x28F50, 0x28F51, 0x28F4E, 0x28F80, 0x28F81, 0x2901B, 0x29017,
+	0x29020, 0x29019, 0x2901A, 0x29021, 0x2901E, 0x29018, 0x2901D, 0x04A29, 0x2912D, 0x29124, 0x29127, 0x2912E,
+	0x29125, 0x2911C, 0x29119, 0x04A2A, 0x29123, 0x2911F, 0x2911A, 0x2912B, 0x2911E, 0x291E0, 0x29233, 0x292E6,
+	0x292FC, 0x292E5, 0x292F8, 0x292F6, 0x292EA, 0x292F2, 0x292ED, 0x292EB, 0x292F0, 0x292F1, 0x293C6, 0x293C8,
+	0x293CE, 0x293C5, 0x04A96, 0x293CB, 0x29513, 0x29514, 0x29507, 0x2950C, 0x2961A, 0x04B12,
+	// plane 7, row 37
+	0x29617, 0x29606, 0x29616, 0x29607, 0x29610, 0x29609, 0x29619, 0x29615, 0x2976E, 0x2973B, 0x2973E, 0x2976C,
+	0x29765, 0x29764, 0x29759, 0x2977C, 0x29770, 0x29779, 0x2982C, 0x29852, 0x29851, 0x2984C, 0x29900, 0x29910,
+	0x29913, 0x298FF, 0x298FE, 0x298ED, 0x29A5A, 0x29A58, 0x29A5C, 0x29ADA, 0x29B78, 0x29B5E, 0x29B67, 0x29B64,
+	0x29C13, 0x29C23, 0x29C41, 0x29C3F, 0x29C40, 0x29CED, 0x29CE3, 0x29CEA, 0x29CF0, 0x29CE6, 0x29CE9, 0x29CF1,
+	0x29CEE, 0x29DD4, 0x29DD1, 0x29DDC, 0x29DEC, 0x2A069, 0x2A06D, 0x2A057, 0x2A066, 0x2A0F9, 0x2A04A, 0x2A060,
+	0x2A056, 0x2A059, 0x2A04C, 0x2A06A, 0x2A062, 0x2A063, 0x2A070, 0x2A05F, 0x2FA0D, 0x2A064, 0x2A05D, 0x2A068,
+	0x2A09F, 0x2A2BA, 0x2A2B8, 0x2A2BC, 0x2A2B9, 0x2A2B4, 0x2A33B, 0x2A32D, 0x2A338, 0x2A32F, 0x2A332, 0x2A441,
+	0x2A4CB, 0x2A4DE, 0x2A4DD, 0x2A4DA, 0x2A4DC, 0x2A510, 0x2A54F, 0x2A549, 0x2A598, 0x2A596,
+	// plane 7, row 38
+	0x2A597, 0x2A5C7, 0x2019C, 0x2A5DA, 0x2A5D8, 0x2A6A8, 0x2010A, 0x2019D, 0x204CB, 0x20538, 0x20539, 0x205A6,
+	0x20823, 0x20822, 0x20821, 0x209CE, 0x20B92, 0x20B91, 0x210EC, 0x210E0, 0x210ED, 0x21108, 0x210E5, 0x210EE,
+	0x210E4, 0x210EB, 0x210E3, 0x210EA, 0x2110A, 0x2F859, 0x214D1, 0x214E3, 0x214D4, 0x214D0, 0x214D9, 0x0367E,
+	0x058E1, 0x214DA, 0x214D3, 0x2154C, 0x2154D, 0x2157C, 0x21580, 0x215CC, 0x218FF, 0x21908, 0x2A508, 0x21901,
+	0x2F86B, 0x21900, 0x21AED, 0x21AF3, 0x21AEE, 0x21AF1, 0x21AF0, 0x21ADE, 0x21B51, 0x21F82, 0x21FA9, 0x21F98,
+	0x21F9D, 0x21F9A, 0x21F9E, 0x21F9F, 0x21FA6, 0x21FA7, 0x22009, 0x2202F, 0x22171, 0x2216D, 0x22172, 0x222EF,
+	0x222F0, 0x2243B, 0x22439, 0x2243C, 0x22438, 0x24BFD, 0x2243A, 0x22478, 0x22479, 0x22477, 0x22598, 0x2259C,
+	0x22599, 0x2259A, 0x22911, 0x2290A, 0x228FD, 0x2290F, 0x22919, 0x22903, 0x22915, 0x2290C,
+	// plane 7, row 39
+	0x22909, 0x22912, 0x22934, 0x2292A, 0x22908, 0x2292D, 0x22928, 0x2F8B1, 0x2292C, 0x22926, 0x22933, 0x039A7,
+	0x22A1A, 0x22A1E, 0x22E07, 0x22E2C, 0x22E32, 0x22E28, 0x22E31, 0x22E29, 0x22E30, 0x22E2A, 0x22E44, 0x22E2E,
+	0x230D1, 0x23124, 0x232F7, 0x232F4, 0x232F3, 0x232F8, 0x237B5, 0x23796, 0x23798, 0x23795, 0x23791, 0x237B2,
+	0x2379E, 0x23759, 0x237A3, 0x2379A, 0x2379B, 0x04320, 0x26183, 0x23ADA, 0x23ADF, 0x23B16, 0x23C1F, 0x23C2D,
+	0x23C2E, 0x23C2B, 0x240B8, 0x240BB, 0x240BF, 0x240BA, 0x240D5, 0x240E3, 0x240C1, 0x240BE, 0x240BD, 0x240B4,
+	0x240C2, 0x240A1, 0x24087, 0x240D7, 0x240CA, 0x240B5, 0x240DA, 0x240D9, 0x240B3, 0x240D8, 0x24467, 0x24471,
+	0x24462, 0x2445C, 0x24468, 0x24452, 0x24456, 0x21109, 0x20442, 0x245A0, 0x24700, 0x246FE, 0x246FF, 0x248CB,
+	0x2F928, 0x248CA, 0x248A9, 0x248C8, 0x24AB4, 0x24AAC, 0x24AAA, 0x24AA9, 0x24C0E, 0x24CE9,
+	// plane 7, row 40
+	0x24CED, 0x24CF2, 0x24CEB, 0x24CEE, 0x24EE8, 0x24EDB, 0x24ED7, 0x24EE3, 0x24EE5, 0x24EEE, 0x24ED5, 0x24FB3,
+	0x2502D, 0x250C1, 0x250C3, 0x2530C, 0x2530E, 0x25307, 0x25317, 0x25309, 0x25316, 0x2539A, 0x2539C, 0x255B4,
+	0x255B7, 0x255B3, 0x2154E, 0x25713, 0x25714, 0x258E6, 0x258DC, 0x258E8, 0x258E5, 0x25A2E, 0x25A2C, 0x25A2B,
+	0x25ACD, 0x25D3D, 0x25D32, 0x25D2D, 0x25D45, 0x25D3E, 0x25D50, 0x25D25, 0x25D53, 0x25D23, 0x25F37, 0x25F38,
+	0x25F47, 0x25F3D, 0x25F3E, 0x25F49, 0x25F4A, 0x25F1D, 0x07CE9, 0x261A5, 0x2618C, 0x2618D, 0x26189, 0x26196,
+	0x26185, 0x2618F, 0x26177, 0x2618E, 0x26182, 0x2618A, 0x26188, 0x2617B, 0x26197, 0x2617D, 0x26179, 0x26259,
+	0x26324, 0x2632D, 0x2632E, 0x2632B, 0x2F976, 0x263DA, 0x263D8, 0x263D6, 0x26487, 0x264A0, 0x26528, 0x26525,
+	0x2651F, 0x265F3, 0x265F7, 0x265F6, 0x2682B, 0x2685B, 0x26848, 0x081CB, 0x2685C, 0x2686D,
+	// plane 7, row 41
+	0x2689D, 0x26899, 0x2688C, 0x26891, 0x2689B, 0x2689A, 0x2689C, 0x26895, 0x2688D, 0x26896, 0x268A5, 0x2692A,
+	0x269A1, 0x269A4, 0x269AD, 0x26AA9, 0x26AAE, 0x26AB0, 0x2700D, 0x26F63, 0x26F71, 0x27051, 0x27054, 0x2702A,
+	0x27044, 0x27055, 0x27099, 0x27039, 0x2703F, 0x2703E, 0x2704F, 0x2704D, 0x27035, 0x27040, 0x27031, 0x26FD5,
+	0x2702B, 0x27033, 0x27041, 0x27056, 0x2704C, 0x27046, 0x2703C, 0x27045, 0x27043, 0x2703D, 0x27070, 0x27057,
+	0x27038, 0x27058, 0x04565, 0x27037, 0x27207, 0x27206, 0x27209, 0x274AB, 0x27490, 0x27493, 0x274BC, 0x274A9,
+	0x2749E, 0x274BF, 0x274AA, 0x27491, 0x274A4, 0x2749A, 0x274A7, 0x274A1, 0x2749C, 0x274A2, 0x2749B, 0x2749F,
+	0x27494, 0x2748F, 0x272F0, 0x27492, 0x27495, 0x274A5, 0x274A6, 0x27604, 0x2779C, 0x27779, 0x2777A, 0x2777E,
+	0x2777B, 0x27771, 0x27781, 0x2777F, 0x2777C, 0x2777D, 0x27775, 0x27776, 0x2788E, 0x2788F,
+	// plane 7, row 42
+	0x2793E, 0x2793F, 0x27940, 0x27941, 0x04704, 0x27B0D, 0x27B17, 0x27B10, 0x27B0E, 0x27AEA, 0x27B1D, 0x27B03,
+	0x27B22, 0x27B04, 0x27B00, 0x27B20, 0x27B21, 0x27B23, 0x27B13, 0x27B09, 0x27B11, 0x27BCB, 0x27BCE, 0x27BD0,
+	0x27BCC, 0x27BD4, 0x214DB, 0x27C09, 0x27C0B, 0x27C85, 0x27D06, 0x27D0D, 0x04764, 0x27D14, 0x27D0F, 0x27E09,
+	0x27E14, 0x27E0B, 0x27DFC, 0x27E04, 0x27E0A, 0x27E00, 0x27DFD, 0x27E07, 0x27E06, 0x27E11, 0x27E79, 0x27E78,
+	0x27F88, 0x27F80, 0x27F8B, 0x28159, 0x28161, 0x28175, 0x04823, 0x28155, 0x2815B, 0x2815F, 0x28152, 0x28162,
+	0x28172, 0x2815D, 0x28168, 0x28171, 0x28165, 0x28166, 0x28167, 0x28176, 0x2814C, 0x28160, 0x28174, 0x28150,
+	0x2828A, 0x28281, 0x28286, 0x2827F, 0x28280, 0x283A5, 0x283A7, 0x283A8, 0x283A6, 0x283AF, 0x26195, 0x283B1,
+	0x28435, 0x28439, 0x285C3, 0x28630, 0x2862A, 0x2862B, 0x2862D, 0x2862E, 0x2862C, 0x28623,
+	// plane 7, row 43
+	0x28621, 0x28622, 0x2876C, 0x28781, 0x2878F, 0x28780, 0x287D0, 0x287CD, 0x287D5, 0x287D4, 0x288D1, 0x288BE,
+	0x288CB, 0x288CE, 0x288BD, 0x288D0, 0x28B04, 0x28AD5, 0x28AD0, 0x28AD3, 0x28AFB, 0x28AD8, 0x28AD1, 0x28AFD,
+	0x28AD9, 0x28AD6, 0x28AE6, 0x28AF9, 0x28DA1, 0x28D9D, 0x28D9E, 0x095D9, 0x28EFF, 0x28F5F, 0x28F57, 0x28F60,
+	0x28F59, 0x2902C, 0x29025, 0x29027, 0x29030, 0x29024, 0x29026, 0x2902D, 0x2902E, 0x29029, 0x29031, 0x29145,
+	0x29147, 0x29152, 0x2914A, 0x29150, 0x29146, 0x2914F, 0x2914E, 0x29153, 0x29321, 0x29309, 0x2931A, 0x2931B,
+	0x25315, 0x29310, 0x2F9F9, 0x29314, 0x2930E, 0x29312, 0x2930B, 0x293CF, 0x293D2, 0x293D0, 0x293D4, 0x293D3,
+	0x293D1, 0x20B18, 0x2F9FB, 0x29409, 0x2951C, 0x29527, 0x29525, 0x2951B, 0x29529, 0x2951F, 0x29530, 0x29524,
+	0x29528, 0x29519, 0x2952F, 0x29624, 0x29621, 0x29625, 0x29626, 0x29627, 0x29676, 0x29766,
+	// plane 7, row 44
+	0x29775, 0x29769, 0x2977E, 0x2978F, 0x29774, 0x27210, 0x297AD, 0x2982B, 0x2982A, 0x29858, 0x29922, 0x2991D,
+	0x2992B, 0x2992C, 0x2992D, 0x29933, 0x2991B, 0x29927, 0x2992A, 0x29928, 0x2993B, 0x29A7E, 0x29A71, 0x29A79,
+	0x29A78, 0x29A70, 0x29A6D, 0x29A7D, 0x29A75, 0x29A76, 0x29ADE, 0x29B66, 0x29B83, 0x29B87, 0x29B7B, 0x29B89,
+	0x29B86, 0x29B82, 0x29B7C, 0x29B81, 0x29C43, 0x29C45, 0x29CF7, 0x29CF9, 0x29CFA, 0x29E05, 0x29DFE, 0x29E0F,
+	0x29DFF, 0x29DFA, 0x29E09, 0x29E20, 0x29E0C, 0x29E3C, 0x29E22, 0x29DF8, 0x29E0A, 0x29E08, 0x29DF7, 0x2A08E,
+	0x2A077, 0x2A08B, 0x2A0B4, 0x2A08A, 0x2A09A, 0x2A079, 0x2A083, 0x2A07F, 0x2A0A1, 0x2A08F, 0x2A0A3, 0x2A081,
+	0x2A094, 0x2A07E, 0x2A082, 0x2A090, 0x2A0A5, 0x2A0AD, 0x2A09D, 0x2A267, 0x2A269, 0x2A2CB, 0x2A2C8, 0x2A2D1,
+	0x2A340, 0x2A34B, 0x2A349, 0x2A346, 0x2A33E, 0x2A343, 0x2A33F, 0x2A3A5, 0x2A3A7, 0x2A44E,
+	// plane 7, row 45
+	0x2A44D, 0x2259B, 0x2A4E5, 0x2A4E1, 0x2A4E2, 0x2A516, 0x2A514, 0x2FA1B, 0x2A559, 0x2A551, 0x2A55F, 0x2A54A,
+	0x2A557, 0x2A558, 0x2A5CA, 0x2A5DB, 0x2A5DE, 0x2A5E0, 0x2A5E1, 0x2A5DF, 0x2A5E2, 0x2A5E3, 0x2A692, 0x2A6BF,
+	0x2A6BE, 0x2010B, 0x20452, 0x2044E, 0x034B9, 0x2F813, 0x205A7, 0x20826, 0x20827, 0x208C6, 0x2090B, 0x2096A,
+	0x20978, 0x2111A, 0x21116, 0x21119, 0x21117, 0x2111B, 0x21118, 0x21120, 0x21237, 0x214EC, 0x21581, 0x2923D,
+	0x2190F, 0x219AD, 0x21AFB, 0x21B52, 0x21FAF, 0x21FB7, 0x21FB2, 0x22178, 0x221AC, 0x22300, 0x222FE, 0x22302,
+	0x222FD, 0x22303, 0x22440, 0x22443, 0x22442, 0x224B7, 0x225A2, 0x22900, 0x22935, 0x22941, 0x22905, 0x20453,
+	0x22950, 0x2294E, 0x22953, 0x22E53, 0x22E50, 0x22E57, 0x22E5F, 0x22E55, 0x22EEA, 0x23026, 0x29830, 0x2302A,
+	0x23028, 0x2302C, 0x2302D, 0x230D4, 0x23307, 0x23358, 0x23359, 0x237C5, 0x237CD, 0x237C7,
+	// plane 7, row 46
+	0x237E8, 0x03C4B, 0x23932, 0x2A697, 0x23ADE, 0x23C32, 0x20AC8, 0x2F915, 0x240F1, 0x240F0, 0x240EC, 0x24109,
+	0x240F9, 0x240F5, 0x240FE, 0x24474, 0x24481, 0x2447C, 0x24475, 0x24489, 0x24482, 0x24497, 0x24486, 0x2447D,
+	0x24493, 0x2449C, 0x24476, 0x24480, 0x24545, 0x206A1, 0x24703, 0x248C9, 0x248CD, 0x248D0, 0x24AAD, 0x24AC5,
+	0x24BA2, 0x24CEC, 0x24CF6, 0x24CF3, 0x24EF3, 0x24EFA, 0x24EF9, 0x24EF5, 0x24EF4, 0x24EF8, 0x24FB6, 0x24FB4,
+	0x25032, 0x250CD, 0x250C8, 0x250CE, 0x250CA, 0x2532A, 0x25321, 0x2531D, 0x255BD, 0x255BE, 0x255C2, 0x040F1,
+	0x255C9, 0x2571F, 0x258F1, 0x258ED, 0x258F2, 0x258E0, 0x258F7, 0x25A30, 0x25A37, 0x25A31, 0x25A36, 0x25AC8,
+	0x25D6D, 0x25D69, 0x25D7D, 0x25D61, 0x25D70, 0x25D71, 0x25D73, 0x25D76, 0x25D75, 0x25D78, 0x25D79, 0x25D64,
+	0x25D6E, 0x25F51, 0x25F4F, 0x25F22, 0x261AF, 0x261AA, 0x261A3, 0x2619D, 0x2619C, 0x261A1,
+	// plane 7, row 47
+	0x261B6, 0x261AC, 0x261A2, 0x261A7, 0x261B0, 0x261A9, 0x261C3, 0x26331, 0x26332, 0x26333, 0x26334, 0x26337,
+	0x2633C, 0x263D9, 0x263DD, 0x263DE, 0x263DF, 0x263E0, 0x264A5, 0x264AA, 0x264A2, 0x264A3, 0x26604, 0x26603,
+	0x265FE, 0x26628, 0x268AF, 0x268AD, 0x268A6, 0x269AC, 0x269A5, 0x269B0, 0x269B1, 0x26A01, 0x26AB2, 0x26AD2,
+	0x26AF1, 0x26FD1, 0x27047, 0x270C9, 0x270A7, 0x270C8, 0x27095, 0x2708E, 0x27091, 0x2707D, 0x270EE, 0x2708D,
+	0x2708C, 0x270B0, 0x27096, 0x27042, 0x2707C, 0x270B1, 0x270B2, 0x27084, 0x2709D, 0x270A1, 0x27098, 0x270B3,
+	0x27022, 0x2707B, 0x2708A, 0x270CE, 0x27080, 0x27097, 0x270B4, 0x270B5, 0x2709A, 0x2709F, 0x27093, 0x27212,
+	0x2720B, 0x2720E, 0x274A3, 0x274CC, 0x274DC, 0x274E1, 0x274DE, 0x274D2, 0x274DB, 0x274D9, 0x274D7, 0x274D4,
+	0x274C9, 0x274EB, 0x274DA, 0x274D1, 0x27504, 0x274CA, 0x274E2, 0x275D7, 0x2778C, 0x27799,
+	// plane 7, row 48
+	0x277A2, 0x27796, 0x27794, 0x2779F, 0x04667, 0x2778E, 0x27803, 0x27894, 0x27893, 0x27944, 0x27B2F, 0x27B35,
+	0x27B2B, 0x27B32, 0x27B2D, 0x27B36, 0x04714, 0x27B31, 0x27B12, 0x27B33, 0x27B1F, 0x27B34, 0x27B40, 0x27B3F,
+	0x27B41, 0x27BD3, 0x27C89, 0x27D18, 0x27D10, 0x27E1A, 0x27E25, 0x27E1E, 0x27F92, 0x27F95, 0x27F93, 0x28184,
+	0x2819A, 0x28189, 0x2818D, 0x28188, 0x28191, 0x2819B, 0x2819C, 0x29548, 0x2828E, 0x28292, 0x283C5, 0x283C1,
+	0x283B8, 0x283BE, 0x283B5, 0x283C7, 0x2843C, 0x04889, 0x285EC, 0x2863F, 0x28639, 0x28637, 0x287A1, 0x28794,
+	0x287A0, 0x287DE, 0x287DB, 0x287DF, 0x287DC, 0x288D6, 0x288DC, 0x288DD, 0x288E0, 0x288E3, 0x288E1, 0x28B18,
+	0x28B19, 0x28B53, 0x2F9EC, 0x28B44, 0x28B0E, 0x28B0F, 0x28B47, 0x28B17, 0x28B1D, 0x28B11, 0x28CB4, 0x28CB6,
+	0x28DB7, 0x28DBE, 0x28DC2, 0x28DB4, 0x28F31, 0x28F15, 0x28F83, 0x2903B, 0x29036, 0x29042,
+	// plane 7, row 49
+	0x29050, 0x29040, 0x29034, 0x29038, 0x2903D, 0x2903E, 0x29035, 0x2903A, 0x29046, 0x29037, 0x29039, 0x29045,
+	0x29177, 0x2915D, 0x2916A, 0x29176, 0x2916B, 0x2916C, 0x29165, 0x29164, 0x29171, 0x2915F, 0x29172, 0x291FE,
+	0x291FF, 0x2923E, 0x2932B, 0x29336, 0x2932D, 0x29339, 0x2933F, 0x2933B, 0x29333, 0x29342, 0x2933A, 0x293D5,
+	0x293D8, 0x293D9, 0x2940D, 0x2940A, 0x29439, 0x2943A, 0x2953D, 0x29545, 0x2953A, 0x29537, 0x2953E, 0x29542,
+	0x29787, 0x2978C, 0x29782, 0x2976B, 0x297A0, 0x2979A, 0x29790, 0x2978E, 0x297A1, 0x297BD, 0x297B2, 0x297B5,
+	0x297B7, 0x297AA, 0x297A2, 0x297A5, 0x297AE, 0x297AB, 0x297BC, 0x29832, 0x2985A, 0x29964, 0x2995C, 0x2994D,
+	0x2993F, 0x2993E, 0x29952, 0x29958, 0x29957, 0x2995E, 0x29953, 0x29954, 0x29956, 0x28F65, 0x29A84, 0x29A85,
+	0x29A86, 0x29B97, 0x29BA1, 0x29BA2, 0x04C0B, 0x2FA0A, 0x29BA3, 0x29BA6, 0x04C17, 0x29C15,
+	// plane 7, row 50
+	0x29C24, 0x29C4A, 0x29C49, 0x29C48, 0x29C4B, 0x29D0E, 0x29962, 0x29D0B, 0x29D0A, 0x29D08, 0x29D06, 0x29E43,
+	0x29E47, 0x29E3F, 0x29E46, 0x29E50, 0x04C6D, 0x29E4B, 0x04C70, 0x29E52, 0x2A0D7, 0x2A0BF, 0x2A0D8, 0x2A0E0,
+	0x2A0E7, 0x2A0B8, 0x2A0D5, 0x2A0EF, 0x2A0E6, 0x2A0E4, 0x2A0D4, 0x2A0D6, 0x2A0EA, 0x04CED, 0x2A0BB, 0x2A0E9,
+	0x2FA10, 0x2A26D, 0x2A270, 0x2A273, 0x2A272, 0x2A2D4, 0x2A2CE, 0x2A2D5, 0x2A35A, 0x2A358, 0x2A352, 0x2A350,
+	0x2A355, 0x2A34E, 0x2A34D, 0x2A3CB, 0x2A3CC, 0x2A3CD, 0x2A3D1, 0x2A458, 0x2A463, 0x2A45E, 0x2A454, 0x2A45B,
+	0x2A4E9, 0x2A4E7, 0x2A4E8, 0x2A51D, 0x2A567, 0x2A55A, 0x2A55C, 0x2A55B, 0x2A561, 0x2A5A1, 0x04D8D, 0x2A5A4,
+	0x2A5E9, 0x2A5EF, 0x2A5E5, 0x2A5F5, 0x2A5EB, 0x2A5ED, 0x2A696, 0x2A695, 0x2A6B3, 0x2A6B5, 0x2A6B1, 0x034A7,
+	0x2044D, 0x20624, 0x2082B, 0x209CF, 0x20AC9, 0x21136, 0x21131, 0x21154, 0x2113A, 0x21138,
+	// plane 7, row 51
+	0x21239, 0x21238, 0x214F4, 0x214F3, 0x214F6, 0x214FC, 0x214F5, 0x214F1, 0x2169C, 0x21918, 0x2191A, 0x21AFC,
+	0x21AFE, 0x21B03, 0x21B53, 0x21D2B, 0x21FC6, 0x21FC1, 0x2217B, 0x22306, 0x22449, 0x224B8, 0x225A3, 0x22952,
+	0x2294D, 0x2295F, 0x2295E, 0x22961, 0x22A23, 0x22E8C, 0x22E6F, 0x22E75, 0x22E74, 0x22E71, 0x22E70, 0x22E6C,
+	0x23126, 0x23308, 0x06707, 0x237F0, 0x237EF, 0x237FB, 0x23810, 0x2380C, 0x237F6, 0x237FE, 0x23A7C, 0x23AE1,
+	0x23C38, 0x23C6F, 0x24118, 0x24115, 0x2411C, 0x24110, 0x24135, 0x2F917, 0x24117, 0x2411D, 0x24126, 0x24128,
+	0x24129, 0x2412A, 0x2411A, 0x2F916, 0x22307, 0x244AB, 0x244AC, 0x244A1, 0x244AE, 0x244A3, 0x244A7, 0x24548,
+	0x24604, 0x246FD, 0x03E5A, 0x24708, 0x248D2, 0x24AC6, 0x24ABE, 0x24B1C, 0x24BA6, 0x24BA7, 0x24BAB, 0x24C00,
+	0x24CFB, 0x24CFC, 0x24CF9, 0x24D01, 0x24F06, 0x24F04, 0x2F93C, 0x25034, 0x25336, 0x25332,
+	// plane 7, row 52
+	0x25342, 0x2532D, 0x25335, 0x255D8, 0x25723, 0x25720, 0x25901, 0x25900, 0x25903, 0x2A6BC, 0x25A3C, 0x25A41,
+	0x25A35, 0x25AC9, 0x25D8E, 0x25D9E, 0x25D99, 0x25DB4, 0x25DAA, 0x25D9F, 0x25D96, 0x25D9D, 0x25DC3, 0x25D74,
+	0x25DAB, 0x042AF, 0x25F63, 0x25F5B, 0x25F5A, 0x261C5, 0x261C4, 0x261CF, 0x261C8, 0x261A4, 0x261BD, 0x261D3,
+	0x26260, 0x2633B, 0x263E3, 0x263E7, 0x264A8, 0x264AC, 0x264A9, 0x2652A, 0x26608, 0x26609, 0x268B9, 0x26AC1,
+	0x26AC2, 0x26AB8, 0x270E1, 0x270EB, 0x270E5, 0x270FA, 0x270E4, 0x2710B, 0x270D7, 0x270EF, 0x270E0, 0x270EC,
+	0x270FB, 0x2F9B0, 0x270D3, 0x270E6, 0x270FC, 0x270E3, 0x270CF, 0x270DA, 0x270DC, 0x270D2, 0x270A4, 0x0456B,
+	0x27217, 0x27216, 0x274F2, 0x274FC, 0x27518, 0x274F6, 0x274FE, 0x274F3, 0x274F7, 0x27501, 0x274F9, 0x27506,
+	0x274F5, 0x27510, 0x274DF, 0x27503, 0x27508, 0x275D8, 0x27605, 0x27797, 0x277B3, 0x277AE,
+	// plane 7, row 53
+	0x277AF, 0x277A7, 0x277B1, 0x277A8, 0x277AC, 0x277AB, 0x27804, 0x2789A, 0x2794A, 0x27B42, 0x27B58, 0x27B4B,
+	0x27B45, 0x27B49, 0x27B4C, 0x27B59, 0x27B56, 0x0471B, 0x27B46, 0x27B44, 0x27B5B, 0x27B69, 0x27C8E, 0x27C8F,
+	0x27D1E, 0x268BC, 0x27E2F, 0x27F9E, 0x27F9D, 0x27F9F, 0x27F9C, 0x281B4, 0x281AE, 0x281AB, 0x281B3, 0x281AF,
+	0x281C2, 0x28293, 0x28295, 0x28296, 0x28297, 0x283CF, 0x283CE, 0x283CB, 0x2844B, 0x28646, 0x28643, 0x28645,
+	0x28651, 0x287AE, 0x287AF, 0x287B0, 0x287B8, 0x287E2, 0x287E3, 0x287E6, 0x288ED, 0x288EA, 0x2893A, 0x28B59,
+	0x28B84, 0x28B5F, 0x28B7C, 0x28B5C, 0x28B58, 0x28B55, 0x28B5D, 0x28B7E, 0x28B80, 0x28B83, 0x28B57, 0x04963,
+	0x28B5E, 0x28CBA, 0x28DD5, 0x28F58, 0x28F68, 0x28F67, 0x2904A, 0x2904C, 0x29052, 0x29049, 0x2904E, 0x29047,
+	0x2904D, 0x2904B, 0x2904F, 0x2917E, 0x29187, 0x29183, 0x29189, 0x24ACA, 0x29186, 0x29188,
+	// plane 7, row 54
+	0x29247, 0x29242, 0x29249, 0x29248, 0x04A80, 0x04A84, 0x04A7F, 0x29344, 0x29351, 0x29346, 0x29347, 0x293E4,
+	0x2940F, 0x2943F, 0x2954B, 0x29557, 0x29552, 0x04AF1, 0x29551, 0x29558, 0x2955E, 0x29553, 0x2955D, 0x2954D,
+	0x2963C, 0x2963F, 0x29646, 0x2963E, 0x29644, 0x29645, 0x29641, 0x29638, 0x29642, 0x29643, 0x2967A, 0x297A3,
+	0x297BA, 0x297C0, 0x297C4, 0x297C6, 0x297CB, 0x29861, 0x2997A, 0x29973, 0x29972, 0x29974, 0x29980, 0x29981,
+	0x09A47, 0x29A95, 0x29A8F, 0x29A90, 0x29A92, 0x29A94, 0x29A8B, 0x29AE6, 0x29BB2, 0x29BB8, 0x29BBD, 0x29BBE,
+	0x29BCE, 0x29BBA, 0x29C16, 0x29C26, 0x29C25, 0x29C4C, 0x29C50, 0x29C4E, 0x29C51, 0x29C52, 0x29D14, 0x29D15,
+	0x29D1B, 0x29E82, 0x29E99, 0x29E9A, 0x29E7D, 0x29E85, 0x29E86, 0x29E9C, 0x29E79, 0x29E7B, 0x29E80, 0x29E83,
+	0x29E81, 0x2A11E, 0x2A11B, 0x2A12A, 0x2A0FB, 0x2A105, 0x2A120, 0x2A111, 0x2A104, 0x2A0FD,
+	// plane 7, row 55
+	0x2A103, 0x2A110, 0x2A118, 0x2A10A, 0x2A14E, 0x2A109, 0x2A107, 0x2A11C, 0x04D1C, 0x2A277, 0x2A276, 0x2A2D8,
+	0x2A2D9, 0x2A361, 0x2A35C, 0x2A35E, 0x2A360, 0x2A3AA, 0x2A3D6, 0x2A3D8, 0x2A409, 0x2A408, 0x2A46B, 0x2A465,
+	0x2A473, 0x2A474, 0x2A4ED, 0x2A524, 0x2A525, 0x2A56A, 0x2A5A7, 0x2A5A8, 0x2A60B, 0x2A5FA, 0x2A5F9, 0x2A5FF,
+	0x2A604, 0x2A5F6, 0x2A605, 0x2A699, 0x2A6AB, 0x2A6BD, 0x2A6B8, 0x034A8, 0x20464, 0x2045A, 0x20572, 0x2097A,
+	0x20B95, 0x2115F, 0x21161, 0x2115E, 0x2115A, 0x2115C, 0x2115D, 0x2116E, 0x21157, 0x21158, 0x21502, 0x2150B,
+	0x21508, 0x21551, 0x21925, 0x21928, 0x21923, 0x21921, 0x21924, 0x21929, 0x21B06, 0x21B0A, 0x21FCA, 0x21FCC,
+	0x21FCB, 0x21FCF, 0x2217F, 0x2217C, 0x221D6, 0x22309, 0x2230B, 0x22376, 0x2247D, 0x225A5, 0x2295B, 0x2295C,
+	0x2295D, 0x22965, 0x22E6D, 0x22E82, 0x22E83, 0x22E87, 0x22E95, 0x22E8A, 0x230D6, 0x23128,
+	// plane 7, row 56
+	0x2330D, 0x2382E, 0x2F8EE, 0x2382D, 0x23821, 0x23819, 0x2393B, 0x2393C, 0x2393A, 0x23A7E, 0x23C3B, 0x24147,
+	0x24139, 0x24134, 0x24136, 0x24146, 0x2413B, 0x24141, 0x24145, 0x244C0, 0x244C4, 0x244BA, 0x244BD, 0x244BE,
+	0x245A3, 0x2470C, 0x248D9, 0x24ACD, 0x24BAE, 0x24CFF, 0x24D24, 0x24FBB, 0x24FBC, 0x25036, 0x250D5, 0x250D3,
+	0x250D6, 0x2533C, 0x2533F, 0x255DE, 0x255E3, 0x25727, 0x25729, 0x0418B, 0x25905, 0x25907, 0x2590C, 0x25AD0,
+	0x25DBE, 0x25DBC, 0x25DD0, 0x25DC2, 0x25DB5, 0x25DC9, 0x25F66, 0x0432B, 0x261D5, 0x261E2, 0x261DC, 0x261E3,
+	0x261DA, 0x261C2, 0x261E8, 0x263E9, 0x264AF, 0x264AD, 0x264AE, 0x2660B, 0x268C1, 0x269B6, 0x269B9, 0x26AC0,
+	0x270A5, 0x27128, 0x27122, 0x27129, 0x27118, 0x2711F, 0x2711C, 0x27112, 0x2712A, 0x0457A, 0x27121, 0x2712B,
+	0x27117, 0x270F0, 0x27116, 0x27123, 0x2752B, 0x27526, 0x2753D, 0x27522, 0x2753A, 0x27531,
+	// plane 7, row 57
+	0x27532, 0x27554, 0x27521, 0x27535, 0x04609, 0x2752E, 0x27530, 0x2752F, 0x27536, 0x275DA, 0x275D9, 0x277BB,
+	0x277BC, 0x277B7, 0x277C2, 0x277BD, 0x277B2, 0x0466D, 0x25344, 0x25DD1, 0x27B52, 0x27B6B, 0x27B67, 0x0471F,
+	0x27B61, 0x27B6C, 0x27B51, 0x27B74, 0x27B77, 0x27B6F, 0x27B6D, 0x27B68, 0x2F9D1, 0x27B84, 0x27C90, 0x27C92,
+	0x27C93, 0x27D1F, 0x27E31, 0x27E38, 0x27E39, 0x27E37, 0x27FAB, 0x281C3, 0x281C8, 0x281CB, 0x281CF, 0x28298,
+	0x283D4, 0x283D3, 0x283D8, 0x283D9, 0x283DD, 0x283D1, 0x283D6, 0x2843E, 0x28658, 0x28657, 0x28655, 0x287C4,
+	0x287E4, 0x288EE, 0x288EF, 0x288F3, 0x288F2, 0x288F0, 0x28BAB, 0x28B9A, 0x28BAF, 0x28B97, 0x0496A, 0x0496C,
+	0x28BBF, 0x28B94, 0x28B93, 0x28CBE, 0x28CBB, 0x28CBC, 0x28DD9, 0x28F6C, 0x29053, 0x29054, 0x2905B, 0x29058,
+	0x29056, 0x29057, 0x2919F, 0x29194, 0x29196, 0x29197, 0x2924A, 0x2924B, 0x29355, 0x2935A,
+	// plane 7, row 58
+	0x2935E, 0x2935F, 0x29359, 0x2935B, 0x29358, 0x29354, 0x293E8, 0x293EB, 0x293EC, 0x29413, 0x29566, 0x2956B,
+	0x29562, 0x29569, 0x29563, 0x2955F, 0x2954E, 0x29648, 0x2964A, 0x297E3, 0x297DB, 0x297D8, 0x297D6, 0x29986,
+	0x29990, 0x29991, 0x29988, 0x29994, 0x29983, 0x04BB2, 0x2999C, 0x2998D, 0x29985, 0x29A98, 0x29A9A, 0x29A9C,
+	0x29AE7, 0x29BC5, 0x29BD0, 0x29BD1, 0x29C19, 0x29C27, 0x04C1D, 0x29C54, 0x29D2D, 0x29D22, 0x04C2D, 0x29D1F,
+	0x29EBD, 0x29EAE, 0x29EBB, 0x29EAD, 0x29EBC, 0x29EB9, 0x29EB4, 0x29ECB, 0x29EB7, 0x29EB3, 0x29EBA, 0x29EB6,
+	0x29ECD, 0x29EBE, 0x29EC9, 0x2FA0B, 0x2A15F, 0x2A13B, 0x2A161, 0x2A15C, 0x2A18A, 0x2A15A, 0x2A14D, 0x2A146,
+	0x2A144, 0x2A13D, 0x2A140, 0x2A13C, 0x2A18C, 0x2A141, 0x2A14C, 0x2A13E, 0x2A14A, 0x2A27C, 0x2A27A, 0x2A365,
+	0x2A36E, 0x2A369, 0x2A36A, 0x2A36F, 0x2A36C, 0x2A370, 0x2A368, 0x2A36B, 0x04D45, 0x2A3AC,
+	// plane 7, row 59
+	0x2A3DE, 0x2A3DD, 0x2A3DC, 0x244C5, 0x2A48C, 0x2A483, 0x2A482, 0x2A488, 0x2A485, 0x2A481, 0x2A4F5, 0x2A4EF,
+	0x2A4F4, 0x2A4F2, 0x2A4F6, 0x2A4F3, 0x2A4F0, 0x2A4F1, 0x2A52B, 0x2A527, 0x2A528, 0x2A56C, 0x2A5AE, 0x2A60E,
+	0x2A61B, 0x2A616, 0x2A61F, 0x2A622, 0x2A620, 0x2A621, 0x2A614, 0x2A613, 0x2A69D, 0x2A69C, 0x2A69E, 0x2A69F,
+	0x2046B, 0x208C8, 0x21178, 0x21176, 0x21170, 0x21171, 0x2150A, 0x2192C, 0x22311, 0x2247E, 0x22957, 0x22966,
+	0x22969, 0x22967, 0x22968, 0x22971, 0x2296F, 0x2A3AE, 0x22E8B, 0x22E96, 0x23035, 0x2303A, 0x2306B, 0x23316,
+	0x23843, 0x23846, 0x2383F, 0x2383B, 0x2383D, 0x239B1, 0x239B2, 0x23AE4, 0x23C40, 0x2415D, 0x24151, 0x2414D,
+	0x2414C, 0x2415B, 0x244D4, 0x244D2, 0x244CA, 0x244C8, 0x244D0, 0x244C9, 0x24549, 0x245A4, 0x24712, 0x2470F,
+	0x24711, 0x248DB, 0x248DD, 0x248DC, 0x24AD4, 0x24B21, 0x24BB2, 0x24D04, 0x24D02, 0x24F11,
+	// plane 7, row 60
+	0x24F16, 0x24F10, 0x24FBE, 0x2721A, 0x2534C, 0x2534A, 0x255F2, 0x255F1, 0x2572A, 0x2572C, 0x2572F, 0x25731,
+	0x25911, 0x25912, 0x25A4B, 0x25DDB, 0x25DD6, 0x25DDD, 0x25DE2, 0x25DE4, 0x25DE0, 0x25DDF, 0x25DE3, 0x25F6E,
+	0x25F71, 0x261E9, 0x261EA, 0x26263, 0x263EB, 0x263EA, 0x268BD, 0x268BB, 0x268C6, 0x268CC, 0x268C8, 0x244CF,
+	0x268C9, 0x268CA, 0x268CF, 0x268D0, 0x269BA, 0x269FB, 0x26A03, 0x26AC4, 0x27149, 0x27153, 0x27136, 0x2714A,
+	0x27141, 0x2714E, 0x27119, 0x2714D, 0x27145, 0x2714C, 0x27147, 0x27148, 0x2714F, 0x27137, 0x27142, 0x2754A,
+	0x27546, 0x0460A, 0x27549, 0x2754F, 0x27551, 0x2754C, 0x0460C, 0x27606, 0x27951, 0x27B7A, 0x27B83, 0x27B7E,
+	0x27B7F, 0x27B80, 0x24BB5, 0x27C91, 0x27C94, 0x27C95, 0x27D21, 0x27D20, 0x27E3D, 0x27E40, 0x27E46, 0x27E84,
+	0x27FAC, 0x27FAD, 0x0482E, 0x281DA, 0x281D9, 0x283E2, 0x283E1, 0x283E3, 0x283E4, 0x283DE,
+	// plane 7, row 61
+	0x283DF, 0x28641, 0x28659, 0x2865C, 0x2865A, 0x287E8, 0x288F6, 0x288FC, 0x288F7, 0x288FA, 0x288F9, 0x28BC4,
+	0x28BBE, 0x28BD2, 0x28BBD, 0x28B95, 0x28BD4, 0x28DE4, 0x29061, 0x29062, 0x29063, 0x29064, 0x29060, 0x2905C,
+	0x2905D, 0x2905E, 0x291A5, 0x291A6, 0x2924C, 0x29368, 0x2936E, 0x29371, 0x2936B, 0x2936F, 0x293EE, 0x293F1,
+	0x293F0, 0x293EF, 0x29415, 0x29414, 0x28F6E, 0x29447, 0x2957C, 0x2957A, 0x29574, 0x29576, 0x2956E, 0x29578,
+	0x2956D, 0x2956C, 0x2964E, 0x297D7, 0x297EA, 0x297E5, 0x29864, 0x299B3, 0x299A3, 0x299A5, 0x299A7, 0x299A2,
+	0x2999F, 0x299A6, 0x2999E, 0x299A8, 0x29AA9, 0x29AA6, 0x29AAA, 0x29AAB, 0x29AA0, 0x29AA1, 0x29AA8, 0x29AE8,
+	0x29AE9, 0x29AEA, 0x29BE4, 0x29BDF, 0x29BE0, 0x29C28, 0x29C5D, 0x29C5B, 0x29C56, 0x29C57, 0x29C5F, 0x29C62,
+	0x29EE1, 0x29EE3, 0x29EDE, 0x29ED9, 0x29EE8, 0x29EF2, 0x29EF6, 0x29EE6, 0x29EF4, 0x29EF5,
+	// plane 7, row 62
+	0x29EE5, 0x29EE2, 0x04C8D, 0x2A196, 0x2A1AA, 0x2A197, 0x2A170, 0x2A1A1, 0x2A19D, 0x2A1A9, 0x2A16F, 0x2A17E,
+	0x2A194, 0x2A19A, 0x2A173, 0x2A187, 0x2A171, 0x2A177, 0x2A188, 0x2A18D, 0x2A185, 0x2A178, 0x2A1AD, 0x2A280,
+	0x2A281, 0x2A2E5, 0x2A2E7, 0x2A37C, 0x2A3AF, 0x2A3E1, 0x2A496, 0x2A4A3, 0x2A490, 0x2A4F8, 0x2A52E, 0x2A575,
+	0x2A57E, 0x2A57D, 0x2A57B, 0x2A578, 0x2A5B0, 0x2A634, 0x2A636, 0x2A630, 0x2FA1D, 0x2A62E, 0x2A637, 0x20ACE,
+	0x20B97, 0x20B98, 0x2117C, 0x2117E, 0x2117D, 0x2117F, 0x2150F, 0x216A4, 0x21931, 0x2192E, 0x2192F, 0x21932,
+	0x21D2C, 0x21FD4, 0x21FDC, 0x21FD8, 0x2200E, 0x22183, 0x22184, 0x22312, 0x22972, 0x2297C, 0x2297E, 0x22EA6,
+	0x22EA0, 0x22E9E, 0x22EA2, 0x2312A, 0x23318, 0x2384D, 0x23858, 0x2385B, 0x25914, 0x2F8F2, 0x23AE6, 0x24164,
+	0x24168, 0x24160, 0x24162, 0x244D7, 0x2454B, 0x248E0, 0x24B22, 0x24D05, 0x24F19, 0x24F1A,
+	// plane 7, row 63
+	0x24FC3, 0x250D8, 0x250DC, 0x2534F, 0x255F7, 0x255F4, 0x255F8, 0x25913, 0x25A50, 0x25DF0, 0x25DE9, 0x25DEF,
+	0x25DED, 0x25DEA, 0x25DF8, 0x25E05, 0x25DF2, 0x25F72, 0x04331, 0x261F9, 0x261F3, 0x261F6, 0x261F2, 0x261F7,
+	0x26266, 0x26265, 0x26340, 0x2F977, 0x268D1, 0x268D3, 0x26A04, 0x27159, 0x27160, 0x2715B, 0x2715D, 0x2715E,
+	0x27169, 0x2715C, 0x27161, 0x2716A, 0x2715F, 0x2754E, 0x2755C, 0x27560, 0x27563, 0x275DB, 0x277CA, 0x277C9,
+	0x277C8, 0x278A5, 0x278A3, 0x27B8E, 0x27B87, 0x27B89, 0x27B85, 0x27B86, 0x27B8F, 0x27B8A, 0x27B90, 0x27C98,
+	0x27C9B, 0x27E47, 0x27E49, 0x27E48, 0x281E5, 0x281EA, 0x281ED, 0x283F0, 0x283EF, 0x283F2, 0x283EC, 0x28440,
+	0x28660, 0x2865F, 0x287EB, 0x287EC, 0x28900, 0x28901, 0x28BE2, 0x28BDF, 0x28BE0, 0x28BE1, 0x28BE3, 0x28CC3,
+	0x28DEB, 0x28DEA, 0x28F61, 0x28F71, 0x2906B, 0x29068, 0x29069, 0x29067, 0x291B0, 0x291B1,
+	// plane 7, row 64
+	0x291B2, 0x29251, 0x29374, 0x2957F, 0x29584, 0x29653, 0x29654, 0x297F0, 0x297F4, 0x297F1, 0x29837, 0x299BF,
+	0x04BBC, 0x04BBB, 0x299BD, 0x299BE, 0x299B7, 0x299C0, 0x299BA, 0x299B8, 0x299BC, 0x299BB, 0x29AEB, 0x29BE7,
+	0x29C1D, 0x29C1C, 0x29C63, 0x2244B, 0x29D38, 0x29D36, 0x29D34, 0x29D37, 0x29F06, 0x29F1C, 0x29F02, 0x29F1D,
+	0x29F1E, 0x29EE0, 0x29F11, 0x29F18, 0x29F21, 0x29F20, 0x29F10, 0x2A1BD, 0x2A1AE, 0x2A1B5, 0x2A1B8, 0x2A1B9,
+	0x2A1BE, 0x2A1C4, 0x2A1BC, 0x2A1BA, 0x2A283, 0x2A2EA, 0x2A2EC, 0x2A37E, 0x2A37B, 0x2A3E5, 0x2A4A7, 0x2A49E,
+	0x2A49A, 0x2A52F, 0x2A531, 0x2A583, 0x2A5B5, 0x2A646, 0x2A641, 0x2A643, 0x2A63D, 0x2A642, 0x2A63B, 0x2A647,
+	0x2096B, 0x209D0, 0x2118E, 0x21B11, 0x2F871, 0x2F889, 0x2244C, 0x2312B, 0x2384F, 0x2385E, 0x23863, 0x2386B,
+	0x2393F, 0x23AE9, 0x2416D, 0x2416B, 0x2416A, 0x29F2A, 0x244E0, 0x244DD, 0x244E1, 0x244DE,
+	// plane 7, row 65
+	0x244DC, 0x2454D, 0x24716, 0x248E3, 0x24AE5, 0x24AE4, 0x24F1D, 0x2574B, 0x2A6C1, 0x25A4E, 0x25AD6, 0x25DFD,
+	0x25E07, 0x25DFE, 0x25E03, 0x25E0B, 0x25DFF, 0x25F7A, 0x25F77, 0x261FB, 0x26343, 0x263ED, 0x269BC, 0x244DF,
+	0x26A05, 0x26AC6, 0x26AC5, 0x27174, 0x27173, 0x27172, 0x27178, 0x27573, 0x2757A, 0x24F1C, 0x27576, 0x27575,
+	0x27577, 0x277CF, 0x277CE, 0x277CD, 0x278A8, 0x27B98, 0x27B92, 0x27B94, 0x27C9A, 0x27FB4, 0x281EB, 0x281F4,
+	0x281F3, 0x281EE, 0x281F2, 0x281F0, 0x28664, 0x28C05, 0x28BFB, 0x28BFC, 0x28DF3, 0x2906C, 0x291BA, 0x29379,
+	0x2937E, 0x29378, 0x293F4, 0x29416, 0x29657, 0x299C8, 0x299C3, 0x299CE, 0x29AB3, 0x29AED, 0x29AEE, 0x29BF1,
+	0x29BF8, 0x04C14, 0x29C64, 0x29C65, 0x29F35, 0x29F29, 0x29F2C, 0x29F31, 0x29F2B, 0x29F2E, 0x29F25, 0x2A1DA,
+	0x2A1E0, 0x2A1D4, 0x2A1E1, 0x2A1DD, 0x2A3E7, 0x2A412, 0x2A4AE, 0x2A4AF, 0x2A586, 0x2A585,
+	// plane 7, row 66
+	0x2A5D1, 0x2A658, 0x2A63F, 0x2A652, 0x2A64B, 0x2A653, 0x2A650, 0x2A656, 0x2A657, 0x2A6CD, 0x2A6CB, 0x20831,
+	0x20830, 0x209D1, 0x0361C, 0x21192, 0x21190, 0x2118F, 0x21193, 0x21191, 0x03772, 0x22313, 0x2247F, 0x22EAC,
+	0x24170, 0x244E5, 0x24B23, 0x24B24, 0x24D08, 0x24D07, 0x24F1F, 0x24F20, 0x24F21, 0x250E1, 0x25354, 0x25357,
+	0x25355, 0x255FA, 0x25738, 0x27186, 0x25737, 0x25A53, 0x25F7E, 0x25F7B, 0x2F971, 0x261FD, 0x261FC, 0x26346,
+	0x26932, 0x26AC7, 0x27171, 0x27183, 0x2716F, 0x2717E, 0x2717D, 0x27181, 0x2717C, 0x2758A, 0x2757E, 0x27580,
+	0x2757D, 0x2757F, 0x27582, 0x277D4, 0x277D0, 0x277D2, 0x27955, 0x27B9B, 0x27B9A, 0x27E4E, 0x281F1, 0x283F8,
+	0x283FD, 0x2865E, 0x28666, 0x28905, 0x28C0A, 0x28C0B, 0x28C0E, 0x28C0D, 0x28C11, 0x28C09, 0x28C10, 0x28C0C,
+	0x28C12, 0x28CC4, 0x28DF7, 0x28DF8, 0x28F6A, 0x28F6B, 0x291C0, 0x291C2, 0x29385, 0x29380,
+	// plane 7, row 67
+	0x29384, 0x29381, 0x291C4, 0x2958B, 0x2958D, 0x2958E, 0x29AB7, 0x29AB9, 0x29AEF, 0x29BFB, 0x29BFF, 0x29C67,
+	0x29C68, 0x29C69, 0x29D3F, 0x29F3C, 0x29F4C, 0x29F3D, 0x29F3E, 0x29F3F, 0x29F3B, 0x2A1FF, 0x04D0E, 0x2A1F6,
+	0x2A1EE, 0x2A1FC, 0x2A1F8, 0x2A201, 0x2A1FA, 0x2A288, 0x2A380, 0x2A411, 0x2A4FE, 0x2A500, 0x2A535, 0x2503C,
+	0x2A5BD, 0x2A5BB, 0x2A65C, 0x2A65A, 0x2A6D1, 0x2A6D0, 0x20A0B, 0x23873, 0x21939, 0x21B1A, 0x21FE3, 0x22187,
+	0x22377, 0x22378, 0x22EB1, 0x2386A, 0x23874, 0x23AEB, 0x2417B, 0x245A5, 0x248E4, 0x24D0B, 0x24D0E, 0x24D0C,
+	0x25739, 0x25E10, 0x25E11, 0x25E16, 0x25F81, 0x25F80, 0x261FE, 0x26201, 0x26200, 0x26347, 0x263EF, 0x27188,
+	0x2758B, 0x2758D, 0x27587, 0x27585, 0x2758F, 0x27584, 0x27588, 0x2758E, 0x2758C, 0x277D7, 0x277D6, 0x27B9F,
+	0x22188, 0x28203, 0x283FE, 0x287EF, 0x28909, 0x28908, 0x28C20, 0x28C24, 0x28C1F, 0x29070,
+	// plane 7, row 68
+	0x29256, 0x293F7, 0x293F8, 0x293F6, 0x29590, 0x2965E, 0x297F7, 0x299D6, 0x299D5, 0x29ABB, 0x29AF0, 0x29C01,
+	0x29C6C, 0x29D41, 0x29D42, 0x29F4F, 0x29F53, 0x29F58, 0x2A212, 0x2A204, 0x2A213, 0x2A205, 0x2A20D, 0x2A382,
+	0x2A381, 0x2A4B5, 0x2A4BA, 0x2A5BE, 0x2A665, 0x2A663, 0x2A66E, 0x2A66A, 0x2A66C, 0x2A6C4, 0x2096C, 0x27BA5,
+	0x21B12, 0x2244D, 0x2331F, 0x23877, 0x23878, 0x23C44, 0x2417C, 0x244EB, 0x244EA, 0x244EC, 0x245A7, 0x24719,
+	0x24F23, 0x07674, 0x25601, 0x25E1C, 0x26203, 0x26348, 0x268DA, 0x27191, 0x27192, 0x27596, 0x27597, 0x27591,
+	0x27593, 0x277D8, 0x277D5, 0x27957, 0x27958, 0x27BA2, 0x28205, 0x283FA, 0x2890C, 0x2890A, 0x28C2E, 0x28C29,
+	0x28C2F, 0x28CC5, 0x29072, 0x291C8, 0x2925A, 0x29259, 0x29389, 0x29388, 0x299DB, 0x299D9, 0x299DA, 0x29ABC,
+	0x04BEC, 0x29C06, 0x29C05, 0x29C6F, 0x29C6D, 0x29C70, 0x29F60, 0x29F5E, 0x29F63, 0x29F5A,
+	// plane 7, row 69
+	0x29F5F, 0x2A219, 0x2A21D, 0x2A21B, 0x2A222, 0x2A21C, 0x2A21E, 0x2A2F7, 0x2A384, 0x2A415, 0x2A4B8, 0x2A4C1,
+	0x2A501, 0x2A50A, 0x2A5D2, 0x2A675, 0x2A674, 0x2A672, 0x20AD0, 0x21B1B, 0x22010, 0x22379, 0x244ED, 0x24BB9,
+	0x24F24, 0x24FC5, 0x25603, 0x2634A, 0x268F7, 0x269C1, 0x269C2, 0x27194, 0x27599, 0x2759A, 0x2795A, 0x27BA8,
+	0x27C25, 0x27C9E, 0x28441, 0x28C32, 0x28C33, 0x291CB, 0x291CA, 0x291CC, 0x29593, 0x299E0, 0x29C71, 0x29F6B,
+	0x2A22B, 0x2A229, 0x2A21A, 0x2A226, 0x2A227, 0x2A22A, 0x2A2F8, 0x2503D, 0x2A676, 0x21518, 0x2387A, 0x2417F,
+	0x04279, 0x263F0, 0x2719A, 0x27196, 0x2759E, 0x2759D, 0x275DC, 0x277DA, 0x2820B, 0x28402, 0x28442, 0x28667,
+	0x2938B, 0x299E2, 0x29F71, 0x2A22F, 0x2A22E, 0x2A4C6, 0x2A58E, 0x2A67B, 0x2A677, 0x2A678, 0x2A67C, 0x2387C,
+	0x26207, 0x27197, 0x27BD9, 0x2890D, 0x2890E, 0x299E3, 0x299E4, 0x29AF3, 0x29C75, 0x2A237,
+	// plane 7, row 70
+	0x2A235, 0x2A58F, 0x2A680, 0x2A67F, 0x2A6D4, 0x22EB4, 0x2A3EC, 0x28F75, 0x24D10, 0x26269, 0x275A4, 0x277DB,
+	0x291D1, 0x2938D, 0x29F76, 0x2A239, 0x2A684, 0x2A682, 0x20AD2, 0x244EE, 0x24D11, 0x25F84, 0x28F74, 0x2938F,
+	0x2938E, 0x2A23B, 0x208CB, 0x2A688, 0x2A686, 0x2335C, 0x253A4, 0x29075, 0x2A68B, 0x21519, 0x27C9F, 0x299E5,
+	0x2A240, 0x24D12, 0x2A2FB, 0x2A68C, 0x253A5, 0x29C77, 0x29C78, 0x2A6D6, 0x277DF, 0x291D2, 0x2151A, 0x27BA9,
+	0x291D3, 0x2A4CA, 0x269C4, 0x278B1, 0x29664, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 71
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 72
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 73
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 74
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 75
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 76
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 77
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 78
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 79
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 80
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 81
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 82
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 83
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 84
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 85
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 86
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 87
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 88
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 89
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 90
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 91
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 92
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 93
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// plane 7, row 94
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+}
@@ -0,0 +1,126 @@
+package cns11643
+
+import (
+	"fmt"
+	"testing"
+	"github.com/UncleSniper/gotextenc"
+)
+
+// testChunkings lists the input chunk and output buffer sizes every test input
+// is run at; the results must not depend on them.
+var testChunkings = [][2]int {
+	{1, 1},
+	{1, 64},
+	{2, 3},
+	{3, 1},
+	{1 << 16, 1 << 16},
+}
+
+// transcodeAll runs codec over all of src, at most srcChunk characters at a
+// time and into a buffer of destSize characters, carrying on after errors. It
+// gives the input offsets of the errors reported.
+func transcodeAll[SourceT gotextenc.CharLike, TargetT gotextenc.CharLike](
+	codec gotextenc.Codec[SourceT, TargetT],
+	src []SourceT,
+	srcChunk int,
+	destSize int,
+) (out []TargetT, offsets []uint64, err error) {
+	dest := make([]TargetT, destSize)
+	pos := 0
+	for rounds := 0; ; rounds++ {
+		if rounds > 1000000 {
+			err = fmt.Errorf("no end after %d rounds", rounds)
+			return
+		}
+		end := pos + srcChunk
+		if end > len(src) {
+			end = len(src)
+		}
+		atEOF := end == len(src)
+		consumed, outCount, codecErr := codec.Transcode(src[pos:end], dest, atEOF)
+		out = append(out, dest[:outCount]...)
+		pos += consumed
+		if codecErr != nil {
+			codecError, ok := codecErr.(gotextenc.CodecError)
+			if !ok {
+				err = codecErr
+				return
+			}
+			offsets = append(offsets, codecError.InputOffset())
+			continue
+		}
+		if atEOF && pos == len(src) && outCount < destSize {
+			return
+		}
+	}
+}
+
+func equalSlices[T comparable](a []T, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for index := range a {
+		if a[index] != b[index] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkTranscode checks that codec turns src into want and reports errors at
+// offsets, however input and output are chunked, resetting it before each run.
+func checkTranscode[SourceT gotextenc.CharLike, TargetT gotextenc.CharLike](
+	t *testing.T,
+	codec gotextenc.Codec[SourceT, TargetT],
+	src []SourceT,
+	want []TargetT,
+	offsets ...uint64,
+) {
+	t.Helper()
+	for _, chunking := range testChunkings {
+		codec.Transcode(src[:len(src) / 2], make([]TargetT, 3), false)
+		codec.Reset(0)
+		out, gotOffsets, err := transcodeAll(codec, src, chunking[0], chunking[1])
+		if err != nil {
+			t.Errorf("%X in chunks of %d/%d: %v", src, chunking[0], chunking[1], err)
+			continue
+		}
+		if !equalSlices(out, want) {
+			t.Errorf("%X in chunks of %d/%d: got %X, want %X", src, chunking[0], chunking[1], out, want)
+		}
+		if !equalSlices(gotOffsets, offsets) {
+			t.Errorf(
+				"%X in chunks of %d/%d: errors at %v, want %v",
+				src,
+				chunking[0],
+				chunking[1],
+				gotOffsets,
+				offsets,
+			)
+		}
+	}
+}
+
+func checkDecode(t *testing.T, name string, src []byte, want string, offsets ...uint64) {
+	t.Helper()
+	codec := gotextenc.NewCodec14(gotextenc.LookupEncoding14(name))
+	if codec == nil {
+		t.Fatalf("no decoder for %s", name)
+	}
+	checkTranscode(t, codec, src, []rune(want), offsets...)
+}
+
+func checkEncode(t *testing.T, name string, src string, want []byte, offsets ...uint64) {
+	t.Helper()
+	codec := gotextenc.NewCodec41(gotextenc.LookupEncoding41(name))
+	if codec == nil {
+		t.Fatalf("no encoder for %s", name)
+	}
+	checkTranscode(t, codec, []rune(src), want, offsets...)
+}
+
+func checkRoundTrip(t *testing.T, name string, encoded []byte, text string) {
+	t.Helper()
+	checkDecode(t, name, encoded, text)
+	checkEncode(t, name, text, encoded)
+}
@@ -0,0 +1,54 @@
+package cns11643
+
+import (
+	"sync"
+
+	"github.com/UncleSniper/gotextenc"
+)
+
+var allPlanesEncodeOnce sync.Once
+// allPlanesEncodeMap maps characters to their codes in ISO2022AllPlanes.
+var allPlanesEncodeMap map[rune]uint32
+
+func loadAllPlanesEncodeMap() {
+	allPlanesEncodeMap = make(map[rune]uint32)
+	for plane := 1; plane <= PLANE_COUNT; plane++ {
+		for char, code := range planeEncodeMap(plane) {
+			if _, present := allPlanesEncodeMap[char]; !present {
+				allPlanesEncodeMap[char] = uint32(0x20 + plane) << 16 | uint32(code)
+			}
+		}
+	}
+}
+
+// ISO2022AllPlanes holds all planes as a 94^3 set whose codes start with the
+// plane number plus 0x20, as EUC-TW uses it in G2. It has no designation of
+// its own.
+var ISO2022AllPlanes = &gotextenc.ISO2022Charset {
+	Kind: gotextenc.ISO2022SET_94N,
+	Width: 3,
+	Decode: func(code uint32) rune {
+		plane := int(code >> 16) - 0x20
+		if plane < 1 || plane > PLANE_COUNT {
+			return 0
+		}
+		return decodePlaneCode(plane, code & 0xFFFF)
+	},
+	Encode: func(r rune) (uint32, bool) {
+		allPlanesEncodeOnce.Do(loadAllPlanesEncodeMap)
+		code, found := allPlanesEncodeMap[r]
+		return code, found
+	},
+}
+
+// EUCTW defines EUC-TW: plane 1 in G1, coded as two bytes, and all planes in
+// G2, coded as SS2 (0x8E), the plane number plus 0xA0 and two bytes. The
+// encoder uses the four-byte form only for characters not in plane 1.
+var EUCTW = &gotextenc.ISO2022Profile {
+	EightBit: true,
+	Initial: [4]*gotextenc.ISO2022Charset {gotextenc.ISO2022ASCII, ISO2022Planes[0], ISO2022AllPlanes},
+}
+
+func init() {
+	gotextenc.RegisterISO2022Profile(EUCTW, "EUC-TW", "EUCTW", "x-euc-tw")
+}
@@ -0,0 +1,19 @@
+package cns11643
+
+import (
+	"testing"
+)
+
+func TestEUCTWRoundTrip(t *testing.T) {
+	// plane 1 as two bytes, all planes after SS2
+	checkRoundTrip(t, "EUC-TW", []byte("\xC4\xE3\xC5\xC6abc"), "中文abc")
+	checkRoundTrip(t, "EUC-TW", []byte("\x8E\xA2\xA1\xA1\x8E\xA3\xC3\xB7\x8E\xA6\xA2\xAC"), "乂碁㐀")
+	// plane 1 may be given after SS2 as well
+	checkDecode(t, "EUC-TW", []byte("\x8E\xA1\xC4\xA1"), "一")
+}
+
+func TestEUCTWErrors(t *testing.T) {
+	checkDecode(t, "EUC-TW", []byte("\xFE\xFEa"), "�a", 0)
+	checkDecode(t, "EUC-TW", []byte("a\x8E\xA2\xA1"), "a�", 1)
+	checkEncode(t, "EUC-TW", "a€", []byte("a\x00"), 1)
+}
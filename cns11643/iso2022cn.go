@@ -0,0 +1,44 @@
+package cns11643
+
+import (
+	"github.com/UncleSniper/gotextenc"
+)
+
+// ISO2022CN defines ISO-2022-CN (RFC 1922): GB 2312 or CNS 11643 plane 1 in
+// G1, shifted in with SO, and plane 2 in G2, invoked by ESC N. The encoder
+// shifts back to ASCII before each line break; designations are forgotten
+// after it.
+var ISO2022CN = &gotextenc.ISO2022Profile {
+	Initial: [4]*gotextenc.ISO2022Charset {gotextenc.ISO2022ASCII},
+	Designations: []gotextenc.ISO2022Designation {
+		{Charset: gotextenc.ISO2022GB2312, Element: 1},
+		{Charset: ISO2022Planes[0], Element: 1},
+		{Charset: ISO2022Planes[1], Element: 2},
+	},
+	ShiftInAtLineEnd: true,
+	ResetDesignationsAtLineEnd: true,
+}
+
+// ISO2022CNExt defines ISO-2022-CN-EXT, which adds ISO-IR-165 in G1 and
+// CNS 11643 planes 3 to 7 in G3, invoked by ESC O, to ISO-2022-CN.
+var ISO2022CNExt = &gotextenc.ISO2022Profile {
+	Initial: [4]*gotextenc.ISO2022Charset {gotextenc.ISO2022ASCII},
+	Designations: []gotextenc.ISO2022Designation {
+		{Charset: gotextenc.ISO2022GB2312, Element: 1},
+		{Charset: ISO2022ISOIR165, Element: 1},
+		{Charset: ISO2022Planes[0], Element: 1},
+		{Charset: ISO2022Planes[1], Element: 2},
+		{Charset: ISO2022Planes[2], Element: 3},
+		{Charset: ISO2022Planes[3], Element: 3},
+		{Charset: ISO2022Planes[4], Element: 3},
+		{Charset: ISO2022Planes[5], Element: 3},
+		{Charset: ISO2022Planes[6], Element: 3},
+	},
+	ShiftInAtLineEnd: true,
+	ResetDesignationsAtLineEnd: true,
+}
+
+func init() {
+	gotextenc.RegisterISO2022Profile(ISO2022CN, "ISO-2022-CN", "csISO2022CN")
+	gotextenc.RegisterISO2022Profile(ISO2022CNExt, "ISO-2022-CN-EXT", "csISO2022CNEXT")
+}
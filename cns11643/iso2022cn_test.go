@@ -0,0 +1,41 @@
+package cns11643
+
+import (
+	"testing"
+	"github.com/UncleSniper/gotextenc"
+)
+
+func TestISO2022CNRoundTrip(t *testing.T) {
+	// designations are made again after each line break
+	checkRoundTrip(
+		t,
+		"ISO-2022-CN",
+		[]byte("\x1B$)A\x0EVPND\x0Fa\x0EVP\x0F\n\x1B$)A\x0EVP\x0F"),
+		"中文a中\n中",
+	)
+	// CNS 11643 plane 2 by single shift
+	checkRoundTrip(t, "ISO-2022-CN", []byte("\x1B$)A\x0EVP\x1B$*H\x1BN!!\x0F"), "中乂")
+	checkRoundTrip(t, "ISO-2022-CN", []byte("\x1B$*H\x1BN!!"), "乂")
+	// planes 3 and up in G3
+	checkRoundTrip(t, "ISO-2022-CN-EXT", []byte("\x1B$)A\x0EVP\x1B$+I\x1BOC7\x0F"), "中碁")
+	checkRoundTrip(t, "ISO-2022-CN-EXT", []byte("\x1B$)A\x0EVP\x1B$+L\x1BO\",\x0F"), "中㐀")
+}
+
+func TestISO2022CNErrors(t *testing.T) {
+	checkEncode(t, "ISO-2022-CN", "中碁", []byte("\x1B$)A\x0EVP\x00\x0F"), 1)
+	// G3 designations are not part of ISO-2022-CN
+	checkDecode(t, "ISO-2022-CN", []byte("a\x1B$+I\x1BOC7"), "a��C7", 1, 5)
+	checkDecode(t, "ISO-2022-CN", []byte("\x0Ea"), "�a", 0)
+}
+
+func TestISO2022CNMIBenum(t *testing.T) {
+	for _, name := range []string {"ISO-2022-CN", "ISO-2022-CN-EXT"} {
+		charset := gotextenc.LookupCharset(name)
+		if gotextenc.LookupCharsetByMIBenum(charset.MIBenum()) != charset || charset.MIMEName() != name {
+			t.Errorf("%s has MIBenum %d and MIME name %s", name, charset.MIBenum(), charset.MIMEName())
+		}
+	}
+	if gotextenc.LookupCharsetByMIBenum(104).Names()[0] != "ISO-2022-CN" {
+		t.Errorf("MIBenum 104 is not ISO-2022-CN")
+	}
+}
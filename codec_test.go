@@ -0,0 +1,200 @@
+package gotextenc
+
+import (
+	"fmt"
+	"testing"
+	"unicode/utf16"
+)
+
+// testChunkings lists the input chunk and output buffer sizes every test input
+// is run at; the results must not depend on them.
+var testChunkings = [][2]int {
+	{1, 1},
+	{1, 64},
+	{2, 3},
+	{3, 1},
+	{5, 2},
+	{7, 64},
+	{1 << 16, 1 << 16},
+}
+
+// transcodeAll runs codec over all of src, at most srcChunk characters at a
+// time and into a buffer of destSize characters, carrying on after errors. It
+// gives the input offsets of the errors reported; a permanent error ends the
+// run.
+func transcodeAll[SourceT CharLike, TargetT CharLike](
+	codec Codec[SourceT, TargetT],
+	src []SourceT,
+	srcChunk int,
+	destSize int,
+) (out []TargetT, offsets []uint64, err error) {
+	dest := make([]TargetT, destSize)
+	var lastErr error
+	pos, width := 0, srcChunk
+	for rounds := 0; ; rounds++ {
+		if rounds > 1000000 {
+			err = fmt.Errorf("no end after %d rounds", rounds)
+			return
+		}
+		end := minInt(pos + width, len(src))
+		atEOF := end == len(src)
+		consumed, outCount, codecErr := codec.Transcode(src[pos:end], dest, atEOF)
+		out = append(out, dest[:outCount]...)
+		pos += consumed
+		if codecErr != nil {
+			if codecErr == lastErr && consumed == 0 && outCount == 0 {
+				// permanent
+				return
+			}
+			lastErr = codecErr
+			codecError, ok := codecErr.(CodecError)
+			if !ok {
+				err = codecErr
+				return
+			}
+			offsets = append(offsets, codecError.InputOffset())
+			continue
+		}
+		switch {
+			case atEOF && pos == len(src) && outCount < destSize:
+				return
+			case consumed == 0 && outCount == 0 && !atEOF:
+				// the codec wants to see more at once
+				width *= 2
+			case consumed > 0:
+				width = srcChunk
+		}
+	}
+}
+
+func equalSlices[T comparable](a []T, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for index := range a {
+		if a[index] != b[index] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkTranscode checks that codec turns src into want and reports errors at
+// offsets, however input and output are chunked. The codec is left halfway
+// through src and Reset before each run.
+func checkTranscode[SourceT CharLike, TargetT CharLike](
+	t *testing.T,
+	codec Codec[SourceT, TargetT],
+	src []SourceT,
+	want []TargetT,
+	offsets ...uint64,
+) {
+	t.Helper()
+	for _, chunking := range testChunkings {
+		codec.Transcode(src[:len(src) / 2], make([]TargetT, 3), false)
+		codec.Reset(0)
+		out, gotOffsets, err := transcodeAll(codec, src, chunking[0], chunking[1])
+		if err != nil {
+			t.Errorf("%X in chunks of %d/%d: %v", src, chunking[0], chunking[1], err)
+			continue
+		}
+		if !equalSlices(out, want) {
+			t.Errorf("%X in chunks of %d/%d: got %X, want %X", src, chunking[0], chunking[1], out, want)
+		}
+		if !equalSlices(gotOffsets, offsets) {
+			t.Errorf(
+				"%X in chunks of %d/%d: errors at %v, want %v",
+				src,
+				chunking[0],
+				chunking[1],
+				gotOffsets,
+				offsets,
+			)
+		}
+	}
+}
+
+// checkTranscodeBy is checkTranscode for input chunks of srcChunk characters
+// only, for codecs whose error offsets depend on how the input is chunked.
+func checkTranscodeBy[SourceT CharLike, TargetT CharLike](
+	t *testing.T,
+	codec Codec[SourceT, TargetT],
+	srcChunk int,
+	src []SourceT,
+	want []TargetT,
+	offsets ...uint64,
+) {
+	t.Helper()
+	for _, destSize := range []int {1, 64} {
+		codec.Reset(0)
+		out, gotOffsets, err := transcodeAll(codec, src, srcChunk, destSize)
+		if err != nil {
+			t.Errorf("%X in chunks of %d/%d: %v", src, srcChunk, destSize, err)
+			continue
+		}
+		if !equalSlices(out, want) {
+			t.Errorf("%X in chunks of %d/%d: got %X, want %X", src, srcChunk, destSize, out, want)
+		}
+		if !equalSlices(gotOffsets, offsets) {
+			t.Errorf(
+				"%X in chunks of %d/%d: errors at %v, want %v",
+				src,
+				srcChunk,
+				destSize,
+				gotOffsets,
+				offsets,
+			)
+		}
+	}
+}
+
+func newTestDecoder(t *testing.T, name string) Codec[byte, rune] {
+	t.Helper()
+	codec := NewCodec14(LookupEncoding14(name))
+	if codec == nil {
+		t.Fatalf("no decoder for %s", name)
+	}
+	return codec
+}
+
+func newTestEncoder(t *testing.T, name string) Codec[rune, byte] {
+	t.Helper()
+	codec := NewCodec41(LookupEncoding41(name))
+	if codec == nil {
+		t.Fatalf("no encoder for %s", name)
+	}
+	return codec
+}
+
+// checkDecode checks that the encoding known as name decodes src to want,
+// with errors at offsets.
+func checkDecode(t *testing.T, name string, src []byte, want string, offsets ...uint64) {
+	t.Helper()
+	checkTranscode(t, newTestDecoder(t, name), src, []rune(want), offsets...)
+}
+
+// checkEncode checks that the encoding known as name encodes src to want,
+// with errors at offsets.
+func checkEncode(t *testing.T, name string, src string, want []byte, offsets ...uint64) {
+	t.Helper()
+	checkTranscode(t, newTestEncoder(t, name), []rune(src), want, offsets...)
+}
+
+// checkRoundTrip checks that encoded and text map to each other without
+// errors in the encoding known as name, by runes as well as by UTF-16 units.
+func checkRoundTrip(t *testing.T, name string, encoded []byte, text string) {
+	t.Helper()
+	checkDecode(t, name, encoded, text)
+	checkEncode(t, name, text, encoded)
+	units := utf16.Encode([]rune(text))
+	decoder := NewCodec12(LookupEncoding12(name))
+	if decoder == nil {
+		t.Fatalf("no UTF-16 decoder for %s", name)
+	}
+	checkTranscode(t, decoder, encoded, units)
+	encoder := NewCodec21(LookupEncoding21(name))
+	if encoder == nil {
+		t.Fatalf("no UTF-16 encoder for %s", name)
+	}
+	checkTranscode(t, encoder, units, encoded)
+}
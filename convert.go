@@ -0,0 +1,83 @@
+package gotextenc
+
+import (
+	"fmt"
+	"sync"
+)
+
+// conversionKey identifies a direct conversion by the names of its source and
+// target encoding, as per NormalizeEncodingName.
+type conversionKey struct {
+	from string
+	to string
+}
+
+var conversions map[conversionKey]Encoding11
+var conversionsMutex sync.Mutex
+
+// RegisterConversion makes the Encoding11 id the direct converter Convert uses
+// from the encoding known as from to the one known as to.
+func RegisterConversion(id Encoding11, from string, to string) {
+	if id == NO_ENCODING11 {
+		return
+	}
+	key := conversionKey {NormalizeEncodingName(from), NormalizeEncodingName(to)}
+	conversionsMutex.Lock()
+	if conversions == nil {
+		conversions = make(map[conversionKey]Encoding11)
+	}
+	if conversions[key] != NO_ENCODING11 {
+		conversionsMutex.Unlock()
+		panic(fmt.Sprintf("Cannot register conversion: '%s' to '%s' is already registered", from, to))
+	}
+	conversions[key] = id
+	conversionsMutex.Unlock()
+}
+
+// lookupConversion finds a direct converter registered for any pair of names
+// of the two encodings.
+func lookupConversion(fromNames []string, toNames []string) (id Encoding11) {
+	conversionsMutex.Lock()
+	defer conversionsMutex.Unlock()
+	for _, from := range fromNames {
+		for _, to := range toNames {
+			key := conversionKey {NormalizeEncodingName(from), NormalizeEncodingName(to)}
+			if id = conversions[key]; id != NO_ENCODING11 {
+				return
+			}
+		}
+	}
+	return
+}
+
+// Convert makes a codec from the encoding known as from to the one known as
+// to. A direct converter registered by RegisterConversion for the two, or for
+// any of their aliases, takes precedence; otherwise the input is decoded to
+// runes and encoded again, as by Chain; a decoding error replaced with U+FFFD
+// is reported once even if the target encoding has no U+FFFD either. If
+// either encoding is unknown, Convert returns nil.
+func Convert(from string, to string) Codec[byte, byte] {
+	decoderID := LookupEncoding14(from)
+	encoderID := LookupEncoding41(to)
+	fromNames := []string {from}
+	toNames := []string {to}
+	encodings14Mutex.Lock()
+	if decoderID != NO_ENCODING14 {
+		fromNames = append(fromNames, encodings14[decoderID - 1].names...)
+	}
+	encodings14Mutex.Unlock()
+	encodings41Mutex.Lock()
+	if encoderID != NO_ENCODING41 {
+		toNames = append(toNames, encodings41[encoderID - 1].names...)
+	}
+	encodings41Mutex.Unlock()
+	if id := lookupConversion(fromNames, toNames); id != NO_ENCODING11 {
+		return NewCodec11(id)
+	}
+	decoder := NewCodec14(decoderID)
+	encoder := NewCodec41(encoderID)
+	if decoder == nil || encoder == nil {
+		return nil
+	}
+	return Chain[byte, rune, byte](decoder, encoder)
+}
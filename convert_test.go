@@ -0,0 +1,70 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func newTestConverter(t *testing.T, from string, to string) Codec[byte, byte] {
+	t.Helper()
+	codec := Convert(from, to)
+	if codec == nil {
+		t.Fatalf("no converter from %s to %s", from, to)
+	}
+	return codec
+}
+
+func TestConvertDirect(t *testing.T) {
+	// any aliases of the two encodings find the direct converter
+	for _, names := range [][2]string {{"ISO-8859-1", "UTF-8"}, {"latin1", "utf8"}, {"L1", "csUTF8"}} {
+		codec := newTestConverter(t, names[0], names[1])
+		if _, ok := codec.(*Latin1ToUTF8Converter); !ok {
+			t.Errorf("%s to %s: got %T, want *Latin1ToUTF8Converter", names[0], names[1], codec)
+		}
+	}
+	checkTranscode(t, Codec[byte, byte](&Latin1ToUTF8Converter{}), []byte("a\xE9\xFF\x80b"), []byte("aéÿ\u0080b"))
+}
+
+func TestConvertPivot(t *testing.T) {
+	codec := newTestConverter(t, "Shift_JIS", "EUC-JP")
+	if _, ok := codec.(*Latin1ToUTF8Converter); ok {
+		t.Errorf("Shift_JIS to EUC-JP converted as Latin-1 to UTF-8")
+	}
+	checkTranscode(t, codec, []byte("a\x93\xFA\x96{\xB1"), []byte("a\xC6\xFC\xCB\xDC\x8E\xB1"))
+	checkTranscode(t, newTestConverter(t, "UTF-8", "ISO-8859-1"), []byte("aéb"), []byte("a\xE9b"))
+}
+
+func TestConvertRegistered(t *testing.T) {
+	scopeRegistry(t)
+	id := RegisterEncoding11(func() Codec[byte, byte] {
+		return &Latin1ToUTF8Converter{}
+	})
+	RegisterConversion(id, "x-test-convert-from", "x-test-convert-to")
+	if _, ok := Convert("X_Test_Convert_From", "x-test-convert-to").(*Latin1ToUTF8Converter); !ok {
+		t.Errorf("registered converter not used")
+	}
+	// nor is the conversion known by a name of its own
+	if LookupEncoding11("x-test-convert-from>x-test-convert-to") != NO_ENCODING11 {
+		t.Errorf("conversion registered as a name")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("conversion registered twice")
+		}
+	}()
+	RegisterConversion(id, "x_test_convert_from", "X-Test-Convert-To")
+}
+
+func TestConvertUnknown(t *testing.T) {
+	if Convert("x-test-no-such-encoding", "UTF-8") != nil || Convert("UTF-8", "x-test-no-such-encoding") != nil {
+		t.Errorf("converter made for an unknown encoding")
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	// the replacement for an undecodable byte is not reported again as
+	// unrepresentable
+	checkTranscode(t, newTestConverter(t, "UTF-8", "ISO-8859-1"), []byte("a\xFFb"), []byte("a\x00b"), 1)
+	checkTranscodeBy(t, newTestConverter(t, "UTF-8", "ISO-8859-1"), 1, []byte("a€b"), []byte("a\x00b"), 1)
+	checkTranscode(t, newTestConverter(t, "UTF-8", "ISO-8859-1"), []byte("\xFF€\xFF"), []byte("\x00\x00\x00"), 0, 1, 4)
+	checkTranscode(t, newTestConverter(t, "Shift_JIS", "UTF-8"), []byte("a\xA0b"), []byte("a\xEF\xBF\xBDb"), 1)
+}
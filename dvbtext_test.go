@@ -0,0 +1,43 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestDVBTextTables(t *testing.T) {
+	// default table: ISO 6937
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("Caf\xC2e"), []rune("Café"))
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("\x01\xB0\xD0"), []rune("Аа"))
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("\x10\x00\x02\xB1"), []rune("ą"))
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("\x11\x00A\x04\x10"), []rune("AА"))
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("\x15h\xC3\xA9\xF0\x9D\x84\x9E"), []rune("hé𝄞"))
+	checkTranscode[byte, uint16](t, &DVBTextDecoder[uint16]{}, []byte("\x15\xF0\x9D\x84\x9E"), []uint16 {0xD834, 0xDD1E})
+}
+
+func TestDVBTextControlCodes(t *testing.T) {
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("a\x86b\x87\x8Ac\x80"), []rune("ab\nc"))
+	checkTranscode[byte, rune](t,
+		&DVBTextDecoder[rune] {Flags: DVBTXTFL_KEEP_EMPHASIS},
+		[]byte("a\x86b\x87"),
+		[]rune {'a', DVB_EMPHASIS_ON, 'b', DVB_EMPHASIS_OFF},
+	)
+	checkTranscode[byte, rune](t,
+		&DVBTextDecoder[rune] {Flags: DVBTXTFL_KEEP_CONTROL_CODES},
+		[]byte("a\x80"),
+		[]rune {'a', 0x80},
+	)
+	// multi-byte tables have their control codes at 0xE080..0xE09F
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("\x11\x00a\xE0\x8A\x00b\x00\x8A"), []rune("a\nb\u008A"))
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("\x15a\xEE\x82\x8Ab"), []rune("a\nb"))
+}
+
+func TestDVBTextErrors(t *testing.T) {
+	// reserved selector
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("\x08abc"), nil, 0)
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("\x10\x00\x0Cabc"), nil, 0)
+	// selector cut short
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("\x10\x00"), []rune("�"), 0)
+	// errors in the body are reported at their offset in the field
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("\x15a\xFFb"), []rune("a�b"), 2)
+	checkTranscode[byte, rune](t, &DVBTextDecoder[rune]{}, []byte("\x02a\xA1b"), []rune("a�b"), 2)
+}
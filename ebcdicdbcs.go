@@ -0,0 +1,796 @@
+package gotextenc
+
+import (
+	"sync"
+)
+
+type EBCDICDBCSVariant uint8
+
+const (
+	// Japanese: single-byte code page 290 (katakana) and double-byte 300
+	EBCDICDBCSVAR_IBM930 EBCDICDBCSVariant = iota
+	// Korean: single-byte code page 833 and double-byte 834
+	EBCDICDBCSVAR_IBM933
+	// simplified Chinese: single-byte code page 836 and double-byte 837
+	EBCDICDBCSVAR_IBM935
+	// traditional Chinese: single-byte code page 037 and double-byte 835
+	EBCDICDBCSVAR_IBM937
+	// Japanese: single-byte code page 1027 (Latin) and double-byte 300
+	EBCDICDBCSVAR_IBM939
+	// IBM-930 with EURO SIGN and double-byte code page 16684, which adds the
+	// characters of JIS X 0213; unassigned single bytes decode as SUB
+	EBCDICDBCSVAR_IBM1390
+	// IBM-939 extended as IBM-1390 extends IBM-930
+	EBCDICDBCSVAR_IBM1399
+	ebcdicdbcsvar_COUNT
+)
+
+// EBCDICShiftMode tells how double-byte characters are set apart from
+// single-byte ones, and with that whether SO and SI count against the length
+// of fixed-length fields, such as those of COBOL records.
+type EBCDICShiftMode uint8
+
+const (
+	// mixed data, as in COBOL PIC X fields: SO (0x0E) and SI (0x0F) switch
+	// between single-byte and double-byte characters and take up a byte each
+	// of the field
+	EBCDICSHIFT_SO_SI EBCDICShiftMode = iota
+	// graphic data, as in COBOL PIC G fields: double-byte characters only,
+	// without SO and SI, so that n characters take exactly 2n bytes
+	EBCDICSHIFT_NONE
+)
+
+// ShiftsCounted tells whether SO and SI count against the length of a
+// fixed-length field in mode.
+func(mode EBCDICShiftMode) ShiftsCounted() bool {
+	return mode == EBCDICSHIFT_SO_SI
+}
+
+const (
+	ebcdic_SO byte = 0x0E
+	ebcdic_SI byte = 0x0F
+	// lead and trail byte of the double-byte IDEOGRAPHIC SPACE
+	ebcdic_DBCS_SPACE byte = 0x40
+	// ebcdic_DBCS_TRAILS is the number of trail bytes, 0x41..0xFE, in the
+	// rows of the double-byte tables
+	ebcdic_DBCS_TRAILS = 190
+	ebcdic_EURO_BYTE byte = 0xE1
+	ebcdic_SUB rune = 0x1A
+)
+
+// newEBCDICEuroCharset creates the single-byte charset of the euro-enabled
+// code pages: chars with EURO SIGN at 0xE1 and SUB for unassigned bytes.
+func newEBCDICEuroCharset(chars *[256]rune) *SingleByteCharset {
+	extended := *chars
+	extended[ebcdic_EURO_BYTE] = 0x20AC
+	for b := 1; b < len(extended); b++ {
+		if extended[b] == 0 && byte(b) != ebcdic_SO && byte(b) != ebcdic_SI {
+			extended[b] = ebcdic_SUB
+		}
+	}
+	return NewSingleByteCharset(&extended)
+}
+
+type ebcdicDBCSVariantInfo struct {
+	singleBytes *SingleByteCharset
+	// doubleBytes holds rows of ebcdic_DBCS_TRAILS codes, starting with lead
+	// byte 0x41
+	doubleBytes []uint16
+	// extensions holds the codes mapping to characters outside the BMP or to
+	// two characters, the second one zero for the former
+	extensions map[uint16][2]rune
+	// preferredCodes lists codes to be used when encoding characters that
+	// more than one code maps to, where the first such code is not preferred
+	preferredCodes []uint16
+}
+
+var ebcdicDBCSVariants = [ebcdicdbcsvar_COUNT]ebcdicDBCSVariantInfo {
+	EBCDICDBCSVAR_IBM930: {
+		NewSingleByteCharset(&ibm290Chars),
+		ibm16684DoubleBytes[:(0x7F - 0x40) * ebcdic_DBCS_TRAILS],
+		nil,
+		nil,
+	},
+	EBCDICDBCSVAR_IBM933: {NewSingleByteCharset(&ibm833Chars), ibm834DoubleBytes[:], nil, nil},
+	EBCDICDBCSVAR_IBM935: {NewSingleByteCharset(&ibm836Chars), ibm837DoubleBytes[:], nil, nil},
+	EBCDICDBCSVAR_IBM937: {
+		NewSingleByteCharset(&ibm037Chars),
+		ibm835DoubleBytes[:],
+		nil,
+		ibm835PreferredCodes[:],
+	},
+	EBCDICDBCSVAR_IBM939: {
+		NewSingleByteCharset(&ibm1027Chars),
+		ibm16684DoubleBytes[:(0x7F - 0x40) * ebcdic_DBCS_TRAILS],
+		nil,
+		nil,
+	},
+	EBCDICDBCSVAR_IBM1390: {
+		newEBCDICEuroCharset(&ibm290Chars),
+		ibm16684DoubleBytes[:],
+		ibm1390Extensions,
+		nil,
+	},
+	EBCDICDBCSVAR_IBM1399: {
+		newEBCDICEuroCharset(&ibm1027Chars),
+		ibm16684DoubleBytes[:],
+		ibm1390Extensions,
+		nil,
+	},
+}
+
+func(variant EBCDICDBCSVariant) info() *ebcdicDBCSVariantInfo {
+	if variant >= ebcdicdbcsvar_COUNT {
+		variant = EBCDICDBCSVAR_IBM930
+	}
+	return &ebcdicDBCSVariants[variant]
+}
+
+// isEBCDICDBCSByte tells whether b may be the lead or trail byte of a
+// double-byte code.
+func isEBCDICDBCSByte(b byte) bool {
+	return b >= ebcdic_DBCS_SPACE && b < 0xFF
+}
+
+// decodeSingle maps the single byte b; SO and SI are never mapped.
+func(variant EBCDICDBCSVariant) decodeSingle(b byte) (rune, bool) {
+	return variant.info().singleBytes.Decode(b)
+}
+
+// decodeDouble maps the double-byte code lead/trail to one or two characters;
+// both bytes must be valid. A count of zero means the code is unmapped.
+func(variant EBCDICDBCSVariant) decodeDouble(lead byte, trail byte) (chars [2]rune, count int) {
+	if lead == ebcdic_DBCS_SPACE || trail == ebcdic_DBCS_SPACE {
+		if lead == trail {
+			return [2]rune {0x3000}, 1
+		}
+		return
+	}
+	info := variant.info()
+	if extension, present := info.extensions[uint16(lead) << 8 | uint16(trail)]; present {
+		chars = extension
+		if chars[1] != 0 {
+			return chars, 2
+		}
+		return chars, 1
+	}
+	index := int(lead - 0x41) * ebcdic_DBCS_TRAILS + int(trail - 0x41)
+	if index >= len(info.doubleBytes) || info.doubleBytes[index] == 0 {
+		return
+	}
+	return [2]rune {rune(info.doubleBytes[index])}, 1
+}
+
+type ebcdicDBCSEncodeMaps struct {
+	doubleBytes map[rune]uint16
+	pairs map[[2]rune]uint16
+	pairBases map[rune]bool
+}
+
+var ebcdicDBCSEncodeOnces [ebcdicdbcsvar_COUNT]sync.Once
+var ebcdicDBCSEncodeMapSets [ebcdicdbcsvar_COUNT]ebcdicDBCSEncodeMaps
+
+func(variant EBCDICDBCSVariant) encodeMaps() *ebcdicDBCSEncodeMaps {
+	if variant >= ebcdicdbcsvar_COUNT {
+		variant = EBCDICDBCSVAR_IBM930
+	}
+	ebcdicDBCSEncodeOnces[variant].Do(func() {
+		maps := ebcdicDBCSEncodeMaps {
+			doubleBytes: make(map[rune]uint16),
+			pairs: make(map[[2]rune]uint16),
+			pairBases: make(map[rune]bool),
+		}
+		for lead := 0x40; lead < 0xFF; lead++ {
+			for trail := 0x40; trail < 0xFF; trail++ {
+				code := uint16(lead << 8 | trail)
+				chars, count := variant.decodeDouble(byte(lead), byte(trail))
+				switch count {
+					case 1:
+						if _, present := maps.doubleBytes[chars[0]]; !present {
+							maps.doubleBytes[chars[0]] = code
+						}
+					case 2:
+						if _, present := maps.pairs[chars]; !present {
+							maps.pairs[chars] = code
+							maps.pairBases[chars[0]] = true
+						}
+				}
+			}
+		}
+		for _, code := range variant.info().preferredCodes {
+			if chars, count := variant.decodeDouble(byte(code >> 8), byte(code)); count == 1 {
+				maps.doubleBytes[chars[0]] = code
+			}
+		}
+		ebcdicDBCSEncodeMapSets[variant] = maps
+	})
+	return &ebcdicDBCSEncodeMapSets[variant]
+}
+
+// encodeSingle is the inverse of decodeSingle.
+func(variant EBCDICDBCSVariant) encodeSingle(r rune) (byte, bool) {
+	return variant.info().singleBytes.Encode(r)
+}
+
+// encodeDouble finds the double-byte code of r.
+func(variant EBCDICDBCSVariant) encodeDouble(r rune) (uint16, bool) {
+	code, found := variant.encodeMaps().doubleBytes[r]
+	return code, found
+}
+
+// isPairBase tells whether r may be the first of two characters encoded as a
+// single code.
+func(variant EBCDICDBCSVariant) isPairBase(r rune) bool {
+	return variant.info().extensions != nil && variant.encodeMaps().pairBases[r]
+}
+
+// encodePair finds the code representing base followed by combining.
+func(variant EBCDICDBCSVariant) encodePair(base rune, combining rune) (uint16, bool) {
+	code, found := variant.encodeMaps().pairs[[2]rune {base, combining}]
+	return code, found
+}
+
+var ebcdicDBCSNames = [ebcdicdbcsvar_COUNT][]string {
+	EBCDICDBCSVAR_IBM930: {"IBM930", "CP930", "x-IBM930"},
+	EBCDICDBCSVAR_IBM933: {"IBM933", "CP933", "x-IBM933"},
+	EBCDICDBCSVAR_IBM935: {"IBM935", "CP935", "x-IBM935"},
+	EBCDICDBCSVAR_IBM937: {"IBM937", "CP937", "x-IBM937"},
+	EBCDICDBCSVAR_IBM939: {"IBM939", "CP939", "x-IBM939"},
+	EBCDICDBCSVAR_IBM1390: {"IBM1390", "CP1390"},
+	EBCDICDBCSVAR_IBM1399: {"IBM1399", "CP1399"},
+}
+
+func init() {
+	for index, names := range ebcdicDBCSNames {
+		variant := EBCDICDBCSVariant(index)
+		RegisterCharset(CharsetFactories {
+			Decoder12: func() Codec[byte, uint16] {
+				return &EBCDICDBCSDecoder[uint16] {Variant: variant}
+			},
+			Decoder14: func() Codec[byte, rune] {
+				return &EBCDICDBCSDecoder[rune] {Variant: variant}
+			},
+			Encoder21: func() Codec[uint16, byte] {
+				return &EBCDICDBCSEncoder[uint16] {Variant: variant}
+			},
+			Encoder41: func() Codec[rune, byte] {
+				return &EBCDICDBCSEncoder[rune] {Variant: variant}
+			},
+		}, names...)
+	}
+}
+
+// The single-byte code pages, with zero entries for unassigned bytes and for
+// SO and SI.
+var ibm290Chars = [256]rune {
+	0x0000, 0x0001, 0x0002, 0x0003, 0x009C, 0x0009, 0x0086, 0x007F,
+	0x0097, 0x008D, 0x008E, 0x000B, 0x000C, 0x000D, 0x0000, 0x0000,
+	0x0010, 0x0011, 0x0012, 0x0013, 0x009D, 0x0085, 0x0008, 0x0087,
+	0x0018, 0x0019, 0x0092, 0x008F, 0x001C, 0x001D, 0x001E, 0x001F,
+	0x0080, 0x0081, 0x0082, 0x0083, 0x0084, 0x000A, 0x0017, 0x001B,
+	0x0088, 0x0089, 0x008A, 0x008B, 0x008C, 0x0005, 0x0006, 0x0007,
+	0x0090, 0x0091, 0x0016, 0x0093, 0x0094, 0x0095, 0x0096, 0x0004,
+	0x0098, 0x0099, 0x009A, 0x009B, 0x0014, 0x0015, 0x009E, 0x001A,
+	0x0020, 0xFF61, 0xFF62, 0xFF63, 0xFF64, 0xFF65, 0xFF66, 0xFF67,
+	0xFF68, 0xFF69, 0x00A3, 0x002E, 0x003C, 0x0028, 0x002B, 0x007C,
+	0x0026, 0xFF6A, 0xFF6B, 0xFF6C, 0xFF6D, 0xFF6E, 0xFF6F, 0x0000,
+	0xFF70, 0x0000, 0x0021, 0x00A5, 0x002A, 0x0029, 0x003B, 0x00AC,
+	0x002D, 0x002F, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066,
+	0x0067, 0x0068, 0x0000, 0x002C, 0x0025, 0x005F, 0x003E, 0x003F,
+	0x005B, 0x0069, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F,
+	0x0070, 0x0060, 0x003A, 0x0023, 0x0040, 0x0027, 0x003D, 0x0022,
+	0x005D, 0xFF71, 0xFF72, 0xFF73, 0xFF74, 0xFF75, 0xFF76, 0xFF77,
+	0xFF78, 0xFF79, 0xFF7A, 0x0071, 0xFF7B, 0xFF7C, 0xFF7D, 0xFF7E,
+	0xFF7F, 0xFF80, 0xFF81, 0xFF82, 0xFF83, 0xFF84, 0xFF85, 0xFF86,
+	0xFF87, 0xFF88, 0xFF89, 0x0072, 0x0000, 0xFF8A, 0xFF8B, 0xFF8C,
+	0x007E, 0x203E, 0xFF8D, 0xFF8E, 0xFF8F, 0xFF90, 0xFF91, 0xFF92,
+	0xFF93, 0xFF94, 0xFF95, 0x0073, 0xFF96, 0xFF97, 0xFF98, 0xFF99,
+	0x005E, 0x00A2, 0x005C, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078,
+	0x0079, 0x007A, 0xFF9A, 0xFF9B, 0xFF9C, 0xFF9D, 0xFF9E, 0xFF9F,
+	0x007B, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047,
+	0x0048, 0x0049, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x007D, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050,
+	0x0051, 0x0052, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0024, 0x0000, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058,
+	0x0059, 0x005A, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0030, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037,
+	0x0038, 0x0039, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x009F,
+}
+
+var ibm1027Chars = [256]rune {
+	0x0000, 0x0001, 0x0002, 0x0003, 0x009C, 0x0009, 0x0086, 0x007F,
+	0x0097, 0x008D, 0x008E, 0x000B, 0x000C, 0x000D, 0x0000, 0x0000,
+	0x0010, 0x0011, 0x0012, 0x0013, 0x009D, 0x0085, 0x0008, 0x0087,
+	0x0018, 0x0019, 0x0092, 0x008F, 0x001C, 0x001D, 0x001E, 0x001F,
+	0x0080, 0x0081, 0x0082, 0x0083, 0x0084, 0x000A, 0x0017, 0x001B,
+	0x0088, 0x0089, 0x008A, 0x008B, 0x008C, 0x0005, 0x0006, 0x0007,
+	0x0090, 0x0091, 0x0016, 0x0093, 0x0094, 0x0095, 0x0096, 0x0004,
+	0x0098, 0x0099, 0x009A, 0x009B, 0x0014, 0x0015, 0x009E, 0x001A,
+	0x0020, 0x0000, 0xFF61, 0xFF62, 0xFF63, 0xFF64, 0xFF65, 0xFF66,
+	0xFF67, 0xFF68, 0x00A2, 0x002E, 0x003C, 0x0028, 0x002B, 0x007C,
+	0x0026, 0xFF69, 0xFF6A, 0xFF6B, 0xFF6C, 0xFF6D, 0xFF6E, 0xFF6F,
+	0xFF70, 0xFF71, 0x0021, 0x0024, 0x002A, 0x0029, 0x003B, 0x00AC,
+	0x002D, 0x002F, 0xFF72, 0xFF73, 0xFF74, 0xFF75, 0xFF76, 0xFF77,
+	0xFF78, 0xFF79, 0x0000, 0x002C, 0x0025, 0x005F, 0x003E, 0x003F,
+	0xFF7A, 0xFF7B, 0xFF7C, 0xFF7D, 0xFF7E, 0xFF7F, 0xFF80, 0xFF81,
+	0xFF82, 0x0060, 0x003A, 0x0023, 0x0040, 0x0027, 0x003D, 0x0022,
+	0x0000, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067,
+	0x0068, 0x0069, 0xFF83, 0xFF84, 0xFF85, 0xFF86, 0xFF87, 0xFF88,
+	0x0000, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F, 0x0070,
+	0x0071, 0x0072, 0xFF89, 0xFF8A, 0xFF8B, 0xFF8C, 0xFF8D, 0xFF8E,
+	0x203E, 0x007E, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078,
+	0x0079, 0x007A, 0xFF8F, 0xFF90, 0xFF91, 0x005B, 0xFF92, 0xFF93,
+	0x005E, 0x00A3, 0x00A5, 0xFF94, 0xFF95, 0xFF96, 0xFF97, 0xFF98,
+	0xFF99, 0xFF9A, 0xFF9B, 0xFF9C, 0xFF9D, 0x005D, 0xFF9E, 0xFF9F,
+	0x007B, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047,
+	0x0048, 0x0049, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x007D, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050,
+	0x0051, 0x0052, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x005C, 0x0000, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058,
+	0x0059, 0x005A, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0030, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037,
+	0x0038, 0x0039, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x009F,
+}
+
+var ibm833Chars = [256]rune {
+	0x0000, 0x0001, 0x0002, 0x0003, 0x009C, 0x0009, 0x0086, 0x007F,
+	0x0097, 0x008D, 0x008E, 0x000B, 0x000C, 0x000D, 0x0000, 0x0000,
+	0x0010, 0x0011, 0x0012, 0x0013, 0x009D, 0x0085, 0x0008, 0x0087,
+	0x0018, 0x0019, 0x0092, 0x008F, 0x001C, 0x001D, 0x001E, 0x001F,
+	0x0080, 0x0081, 0x0082, 0x0083, 0x0084, 0x000A, 0x0017, 0x001B,
+	0x0088, 0x0089, 0x008A, 0x008B, 0x008C, 0x0005, 0x0006, 0x0007,
+	0x0090, 0x0091, 0x0016, 0x0093, 0x0094, 0x0095, 0x0096, 0x0004,
+	0x0098, 0x0099, 0x009A, 0x009B, 0x0014, 0x0015, 0x009E, 0x001A,
+	0x0020, 0x0000, 0xFFA0, 0xFFA1, 0xFFA2, 0xFFA3, 0xFFA4, 0xFFA5,
+	0xFFA6, 0xFFA7, 0x00A2, 0x002E, 0x003C, 0x0028, 0x002B, 0x007C,
+	0x0026, 0x0000, 0xFFA8, 0xFFA9, 0xFFAA, 0xFFAB, 0xFFAC, 0xFFAD,
+	0xFFAE, 0xFFAF, 0x0021, 0x0024, 0x002A, 0x0029, 0x003B, 0x00AC,
+	0x002D, 0x002F, 0xFFB0, 0xFFB1, 0xFFB2, 0xFFB3, 0xFFB4, 0xFFB5,
+	0xFFB6, 0xFFB7, 0x00A6, 0x002C, 0x0025, 0x005F, 0x003E, 0x003F,
+	0x005B, 0x0000, 0xFFB8, 0xFFB9, 0xFFBA, 0xFFBB, 0xFFBC, 0xFFBD,
+	0xFFBE, 0x0060, 0x003A, 0x0023, 0x0040, 0x0027, 0x003D, 0x0022,
+	0x005D, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067,
+	0x0068, 0x0069, 0xFFC2, 0xFFC3, 0xFFC4, 0xFFC5, 0xFFC6, 0xFFC7,
+	0x0000, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F, 0x0070,
+	0x0071, 0x0072, 0xFFCA, 0xFFCB, 0xFFCC, 0xFFCD, 0xFFCE, 0xFFCF,
+	0x203E, 0x007E, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078,
+	0x0079, 0x007A, 0xFFD2, 0xFFD3, 0xFFD4, 0xFFD5, 0xFFD6, 0xFFD7,
+	0x005E, 0x0000, 0x005C, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0xFFDA, 0xFFDB, 0xFFDC, 0x0000, 0x0000, 0x0000,
+	0x007B, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047,
+	0x0048, 0x0049, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x007D, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050,
+	0x0051, 0x0052, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x20A9, 0x0000, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058,
+	0x0059, 0x005A, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0030, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037,
+	0x0038, 0x0039, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x009F,
+}
+
+var ibm836Chars = [256]rune {
+	0x0000, 0x0001, 0x0002, 0x0003, 0x009C, 0x0009, 0x0086, 0x007F,
+	0x0097, 0x008D, 0x008E, 0x000B, 0x000C, 0x000D, 0x0000, 0x0000,
+	0x0010, 0x0011, 0x0012, 0x0013, 0x009D, 0x0085, 0x0008, 0x0087,
+	0x0018, 0x0019, 0x0092, 0x008F, 0x001C, 0x001D, 0x001E, 0x001F,
+	0x0080, 0x0081, 0x0082, 0x0083, 0x0084, 0x000A, 0x0017, 0x001B,
+	0x0088, 0x0089, 0x008A, 0x008B, 0x008C, 0x0005, 0x0006, 0x0007,
+	0x0090, 0x0091, 0x0016, 0x0093, 0x0094, 0x0095, 0x0096, 0x0004,
+	0x0098, 0x0099, 0x009A, 0x009B, 0x0014, 0x0015, 0x009E, 0x001A,
+	0x0020, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x00A3, 0x002E, 0x003C, 0x0028, 0x002B, 0x007C,
+	0x0026, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0021, 0x00A5, 0x002A, 0x0029, 0x003B, 0x00AC,
+	0x002D, 0x002F, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x00A6, 0x002C, 0x0025, 0x005F, 0x003E, 0x003F,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0060, 0x003A, 0x0023, 0x0040, 0x0027, 0x003D, 0x0022,
+	0x0000, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067,
+	0x0068, 0x0069, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F, 0x0070,
+	0x0071, 0x0072, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x007E, 0x203E, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078,
+	0x0079, 0x007A, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x005E, 0x0000, 0x005C, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x005B, 0x005D, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x007B, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047,
+	0x0048, 0x0049, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x007D, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050,
+	0x0051, 0x0052, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0024, 0x0000, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058,
+	0x0059, 0x005A, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0030, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037,
+	0x0038, 0x0039, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x009F,
+}
+
+var ibm037Chars = [256]rune {
+	0x0000, 0x0001, 0x0002, 0x0003, 0x009C, 0x0009, 0x0086, 0x007F,
+	0x0097, 0x008D, 0x008E, 0x000B, 0x000C, 0x000D, 0x0000, 0x0000,
+	0x0010, 0x0011, 0x0012, 0x0013, 0x009D, 0x0085, 0x0008, 0x0087,
+	0x0018, 0x0019, 0x0092, 0x008F, 0x001C, 0x001D, 0x001E, 0x001F,
+	0x0080, 0x0081, 0x0082, 0x0083, 0x0084, 0x000A, 0x0017, 0x001B,
+	0x0088, 0x0089, 0x008A, 0x008B, 0x008C, 0x0005, 0x0006, 0x0007,
+	0x0090, 0x0091, 0x0016, 0x0093, 0x0094, 0x0095, 0x0096, 0x0004,
+	0x0098, 0x0099, 0x009A, 0x009B, 0x0014, 0x0015, 0x009E, 0x001A,
+	0x0020, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x00A2, 0x002E, 0x003C, 0x0028, 0x002B, 0x007C,
+	0x0026, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0021, 0x0024, 0x002A, 0x0029, 0x003B, 0x00AC,
+	0x002D, 0x002F, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x00A6, 0x002C, 0x0025, 0x005F, 0x003E, 0x003F,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0060, 0x003A, 0x0023, 0x0040, 0x0027, 0x003D, 0x0022,
+	0x0000, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067,
+	0x0068, 0x0069, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F, 0x0070,
+	0x0071, 0x0072, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x007E, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078,
+	0x0079, 0x007A, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x005E, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x005B, 0x005D, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x007B, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047,
+	0x0048, 0x0049, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x007D, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F, 0x0050,
+	0x0051, 0x0052, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x005C, 0x0000, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057, 0x0058,
+	0x0059, 0x005A, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0030, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037,
+	0x0038, 0x0039, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x009F,
+}
+
+// ibm1390Extensions lists the codes of double-byte code page 16684 mapping to
+// characters outside the BMP or to two characters, as well as EURO SIGN.
+var ibm1390Extensions = map[uint16][2]rune {
+	0x42E1: {0x20AC},
+	0xB342: {0x2000B},
+	0xB346: {0x20089},
+	0xB348: {0x200A2},
+	0xB349: {0x200A4},
+	0xB34E: {0x201A2},
+	0xB353: {0x20213},
+	0xB35D: {0x2032B},
+	0xB360: {0x20371},
+	0xB364: {0x20381},
+	0xB367: {0x203F9},
+	0xB368: {0x2044A},
+	0xB36B: {0x20509},
+	0xB370: {0x205D6},
+	0xB373: {0x20628},
+	0xB376: {0x2074F},
+	0xB379: {0x20807},
+	0xB37A: {0x2083A},
+	0xB380: {0x208B9},
+	0xB382: {0x2097C},
+	0xB384: {0x2099D},
+	0xB388: {0x20AD3},
+	0xB389: {0x20B1D},
+	0xB38D: {0x20B9F},
+	0xB39B: {0x20D45},
+	0xB39F: {0x20DE1},
+	0xB3A2: {0x20E64},
+	0xB3A3: {0x20E6D},
+	0xB3A4: {0x20E95},
+	0xB3A8: {0x20F5F},
+	0xB3B0: {0x21201},
+	0xB3B1: {0x2123D},
+	0xB3B3: {0x21255},
+	0xB3B4: {0x21274},
+	0xB3B5: {0x2127B},
+	0xB3B6: {0x212D7},
+	0xB3B7: {0x212E4},
+	0xB3B8: {0x212FD},
+	0xB3BA: {0x2131B},
+	0xB3BD: {0x21336},
+	0xB3BE: {0x21344},
+	0xB3C0: {0x213C4},
+	0xB3C5: {0x2146D},
+	0xB3C6: {0x2146E},
+	0xB3CA: {0x215D7},
+	0xB3D0: {0x21647},
+	0xB3D1: {0x216B4},
+	0xB3D2: {0x21706},
+	0xB3D6: {0x21742},
+	0xB3D9: {0x218BD},
+	0xB3DA: {0x219C3},
+	0xB3E4: {0x21C56},
+	0xB3E9: {0x21D2D},
+	0xB3EA: {0x21D45},
+	0xB3EB: {0x21D62},
+	0xB3EC: {0x21D78},
+	0xB3EF: {0x21D92},
+	0xB3F0: {0x21D9C},
+	0xB3F2: {0x21DA1},
+	0xB3F4: {0x21DB7},
+	0xB3F5: {0x21DE0},
+	0xB3F9: {0x21E33},
+	0xB3FA: {0x21E34},
+	0xB444: {0x21F1E},
+	0xB446: {0x21F76},
+	0xB449: {0x21FFA},
+	0xB450: {0x2217B},
+	0xB452: {0x22218},
+	0xB456: {0x2231E},
+	0xB45A: {0x223AD},
+	0xB462: {0x226F3},
+	0xB468: {0x2285B},
+	0xB469: {0x228AB},
+	0xB46C: {0x2298F},
+	0xB472: {0x22AB8},
+	0xB475: {0x22B46},
+	0xB477: {0x22B4F},
+	0xB478: {0x22B50},
+	0xB479: {0x22BA6},
+	0xB47C: {0x22C1D},
+	0xB47D: {0x22C24},
+	0xB480: {0x22DE1},
+	0xB491: {0x231B6},
+	0xB493: {0x231C3},
+	0xB494: {0x231C4},
+	0xB496: {0x231F5},
+	0xB49F: {0x23372},
+	0xB4A1: {0x233D0},
+	0xB4A2: {0x233D2},
+	0xB4A3: {0x233D3},
+	0xB4A4: {0x233D5},
+	0xB4A5: {0x233DA},
+	0xB4A7: {0x233DF},
+	0xB4A8: {0x233E4},
+	0xB4AF: {0x2344A},
+	0xB4B0: {0x2344B},
+	0xB4B1: {0x23451},
+	0xB4B2: {0x23465},
+	0xB4BF: {0x234E4},
+	0xB4C4: {0x2355A},
+	0xB4C5: {0x23594},
+	0xB4CB: {0x235C4},
+	0xB4D2: {0x23638},
+	0xB4D3: {0x23639},
+	0xB4D4: {0x2363A},
+	0xB4D5: {0x23647},
+	0xB4DC: {0x2370C},
+	0xB4DD: {0x2371C},
+	0xB4DE: {0x2373F},
+	0xB4DF: {0x23763},
+	0xB4E0: {0x23764},
+	0xB4E3: {0x237E7},
+	0xB4E5: {0x237FF},
+	0xB4E7: {0x23824},
+	0xB4E8: {0x2383D},
+	0xB4F0: {0x23A98},
+	0xB4F6: {0x23C7F},
+	0xB543: {0x23CFE},
+	0xB544: {0x23D00},
+	0xB545: {0x23D0E},
+	0xB54F: {0x23D40},
+	0xB555: {0x23DD3},
+	0xB557: {0x23DF9},
+	0xB558: {0x23DFA},
+	0xB566: {0x23F7E},
+	0xB56C: {0x24096},
+	0xB56E: {0x24103},
+	0xB573: {0x241C6},
+	0xB574: {0x241FE},
+	0xB57F: {0x243BC},
+	0xB584: {0x24629},
+	0xB586: {0x246A5},
+	0xB58E: {0x247F1},
+	0xB591: {0x24896},
+	0xB59A: {0x24A4D},
+	0xB59E: {0x24B56},
+	0xB59F: {0x24B6F},
+	0xB5A0: {0x24C16},
+	0xB5A4: {0x24D14},
+	0xB5AE: {0x24E0E},
+	0xB5B0: {0x24E37},
+	0xB5B2: {0x24E6A},
+	0xB5B4: {0x24E8B},
+	0xB5B9: {0x2504A},
+	0xB5BA: {0x25055},
+	0xB5BB: {0x25122},
+	0xB5BD: {0x251A9},
+	0xB5BE: {0x251CD},
+	0xB5C0: {0x251E5},
+	0xB5C2: {0x2521E},
+	0xB5C4: {0x2524C},
+	0xB5C8: {0x2542E},
+	0xB5CD: {0x2548E},
+	0xB5CF: {0x254D9},
+	0xB5D0: {0x2550E},
+	0xB5D3: {0x255A7},
+	0xB5E1: {0x25771},
+	0xB5E3: {0x257A9},
+	0xB5E4: {0x257B4},
+	0xB5E9: {0x259C4},
+	0xB5EA: {0x259D4},
+	0xB5EE: {0x25AE3},
+	0xB5EF: {0x25AE4},
+	0xB5F0: {0x25AF1},
+	0xB5F9: {0x25BB2},
+	0xB5FC: {0x25C4B},
+	0xB5FD: {0x25C64},
+	0xB644: {0x25DA1},
+	0xB647: {0x25E2E},
+	0xB648: {0x25E56},
+	0xB649: {0x25E62},
+	0xB64A: {0x25E65},
+	0xB64B: {0x25EC2},
+	0xB64C: {0x25ED8},
+	0xB64D: {0x25EE8},
+	0xB64E: {0x25F23},
+	0xB64F: {0x25F5C},
+	0xB651: {0x25FD4},
+	0xB652: {0x25FE0},
+	0xB654: {0x25FFB},
+	0xB656: {0x2600C},
+	0xB657: {0x26017},
+	0xB659: {0x26060},
+	0xB65E: {0x260ED},
+	0xB663: {0x26270},
+	0xB664: {0x26286},
+	0xB666: {0x2634C},
+	0xB668: {0x26402},
+	0xB672: {0x2667E},
+	0xB675: {0x266B0},
+	0xB67B: {0x2671D},
+	0xB681: {0x268DD},
+	0xB682: {0x268EA},
+	0xB685: {0x26951},
+	0xB686: {0x2696F},
+	0xB689: {0x269DD},
+	0xB68A: {0x26A1E},
+	0xB68E: {0x26A58},
+	0xB691: {0x26A8C},
+	0xB692: {0x26AB7},
+	0xB697: {0x26AFF},
+	0xB69E: {0x26C29},
+	0xB6A1: {0x26C73},
+	0xB6A4: {0x26CDD},
+	0xB6AB: {0x26E40},
+	0xB6AC: {0x26E65},
+	0xB6B0: {0x26F94},
+	0xB6B1: {0x26FF6},
+	0xB6B2: {0x26FF7},
+	0xB6B3: {0x26FF8},
+	0xB6B7: {0x270F4},
+	0xB6B8: {0x2710D},
+	0xB6BA: {0x27139},
+	0xB6C5: {0x273DA},
+	0xB6C6: {0x273DB},
+	0xB6C7: {0x273FE},
+	0xB6C9: {0x27410},
+	0xB6CB: {0x27449},
+	0xB6D1: {0x27614},
+	0xB6D2: {0x27615},
+	0xB6D4: {0x27631},
+	0xB6D6: {0x27684},
+	0xB6D7: {0x27693},
+	0xB6DA: {0x2770E},
+	0xB6DC: {0x27723},
+	0xB6DD: {0x27752},
+	0xB6E4: {0x27985},
+	0xB6E7: {0x27A84},
+	0xB6EE: {0x27BB3},
+	0xB6EF: {0x27BBE},
+	0xB6F0: {0x27BC7},
+	0xB6F1: {0x27CB8},
+	0xB6F4: {0x27DA0},
+	0xB6F7: {0x27E10},
+	0xB6F9: {0x27FB7},
+	0xB6FC: {0x2808A},
+	0xB6FD: {0x280BB},
+	0xB743: {0x28277},
+	0xB744: {0x28282},
+	0xB747: {0x282F3},
+	0xB74A: {0x283CD},
+	0xB74B: {0x2840C},
+	0xB74E: {0x28455},
+	0xB750: {0x2856B},
+	0xB751: {0x285C8},
+	0xB752: {0x285C9},
+	0xB754: {0x286D7},
+	0xB756: {0x286FA},
+	0xB759: {0x28946},
+	0xB75A: {0x28949},
+	0xB75D: {0x2896B},
+	0xB75E: {0x28987},
+	0xB75F: {0x28988},
+	0xB760: {0x289BA},
+	0xB761: {0x289BB},
+	0xB764: {0x28A1E},
+	0xB765: {0x28A29},
+	0xB768: {0x28A43},
+	0xB769: {0x28A71},
+	0xB76A: {0x28A99},
+	0xB76B: {0x28ACD},
+	0xB76C: {0x28ADD},
+	0xB76D: {0x28AE4},
+	0xB770: {0x28BC1},
+	0xB771: {0x28BEF},
+	0xB773: {0x28D10},
+	0xB774: {0x28D71},
+	0xB776: {0x28DFB},
+	0xB778: {0x28E1F},
+	0xB779: {0x28E36},
+	0xB77A: {0x28E89},
+	0xB77B: {0x28EEB},
+	0xB77D: {0x28F32},
+	0xB780: {0x28FF8},
+	0xB787: {0x292A0},
+	0xB788: {0x292B1},
+	0xB78C: {0x29490},
+	0xB78F: {0x295CF},
+	0xB793: {0x2967F},
+	0xB798: {0x296F0},
+	0xB799: {0x29719},
+	0xB79A: {0x29750},
+	0xB79C: {0x298C6},
+	0xB7A7: {0x29A72},
+	0xB7AC: {0x29DDB},
+	0xB7AE: {0x29E15},
+	0xB7AF: {0x29E3D},
+	0xB7B0: {0x29E49},
+	0xB7B2: {0x29E8A},
+	0xB7B3: {0x29EC4},
+	0xB7B4: {0x29EDB},
+	0xB7B5: {0x29EE9},
+	0xB7B9: {0x29FCE},
+	0xB7BB: {0x2A01A},
+	0xB7BD: {0x2A02F},
+	0xB7BF: {0x2A082},
+	0xB7C2: {0x2A0F9},
+	0xB7C6: {0x2A190},
+	0xB7C9: {0x2A38C},
+	0xB7CC: {0x2A437},
+	0xB7CE: {0x2A5F1},
+	0xB7CF: {0x2A602},
+	0xB7D0: {0x2A61A},
+	0xB7D1: {0x2A6B2},
+	0xECB5: {0x304B, 0x309A},
+	0xECB6: {0x304D, 0x309A},
+	0xECB7: {0x304F, 0x309A},
+	0xECB8: {0x3051, 0x309A},
+	0xECB9: {0x3053, 0x309A},
+	0xECBA: {0x30AB, 0x309A},
+	0xECBB: {0x30AD, 0x309A},
+	0xECBC: {0x30AF, 0x309A},
+	0xECBD: {0x30B1, 0x309A},
+	0xECBE: {0x30B3, 0x309A},
+	0xECBF: {0x30BB, 0x309A},
+	0xECC0: {0x30C4, 0x309A},
+	0xECC1: {0x30C8, 0x309A},
+	0xECC2: {0x31F7, 0x309A},
+	0xECC3: {0x00E6, 0x0300},
+	0xECC4: {0x0254, 0x0300},
+	0xECC5: {0x0254, 0x0301},
+	0xECC6: {0x028C, 0x0300},
+	0xECC7: {0x028C, 0x0301},
+	0xECC8: {0x0259, 0x0300},
+	0xECC9: {0x0259, 0x0301},
+	0xECCA: {0x025A, 0x0300},
+	0xECCB: {0x025A, 0x0301},
+	0xECCC: {0x02E9, 0x02E5},
+	0xECCD: {0x02E5, 0x02E9},
+}
+
+// ibm835PreferredCodes lists the codes of double-byte code page 835 preferred
+// over earlier codes of the same characters, which are kept for decoding.
+var ibm835PreferredCodes = [...]uint16 {
+	0x4C41, 0x4C42, 0x4C48, 0x4C49, 0x4C4A, 0x4C4B, 0x4C4C, 0x4C4D, 0x4C4E, 0x4C50,
+	0x4C51, 0x4C52, 0x4C53, 0x4C54, 0x4C67, 0x4C68, 0x4C69, 0x4C6A, 0x4C6B, 0x4C6C,
+	0x4C6D, 0x4C70, 0x4C71, 0x4C72, 0x4C73, 0x4C74, 0x4C76, 0x4C77, 0x4C7A, 0x4C7B,
+	0x4C7C, 0x4C7D, 0x4C7E, 0x4CAA, 0x4CBF, 0x4CC0, 0x4CC1, 0x4CC2, 0x4CC4, 0x4CC5,
+	0x4CC6, 0x4CC7, 0x4CC8, 0x4CC9, 0x4CCA, 0x4CCB, 0x4CCC, 0x4CCD, 0x4CCE, 0x4CCF,
+	0x4CD0, 0x4CD1, 0x4CD2, 0x4CD3, 0x4CD4, 0x4CD5, 0x4CD6, 0x4CD7, 0x4CD8, 0x4CD9,
+	0x4CDA, 0x4CDB, 0x4CDC, 0x4D89, 0x4D8A, 0x4D8B, 0x4D8C, 0x4D8D, 0x4D8E, 0x4D8F,
+	0x4D91, 0x4D95, 0x4D96, 0x4D97, 0x4D98, 0x4D99, 0x4D9A, 0x4D9B, 0x4D9C, 0x4D9D,
+	0x4D9E, 0x4D9F, 0x4DA0, 0x4E6C, 0x4E6D, 0x4E6E, 0x4E6F, 0x4E70, 0x4E71, 0x4E72,
+	0x4E74, 0x4E75, 0x4E76, 0x4E77, 0x4E78, 0x4E7B, 0x4E7C, 0x4E7D, 0x4E7E, 0x4E7F,
+	0x4E81, 0x4E82, 0x4E83, 0x4E84, 0x4E86, 0x4E87, 0x4E88, 0x4E89, 0x4FC3, 0x4FC4,
+	0x4FC5, 0x4FC6, 0x4FC7, 0x4FC8, 0x4FC9, 0x4FCA, 0x4FCB, 0x4FCC, 0x4FCD, 0x4FCE,
+	0x4FCF, 0x4FD0, 0x4FD6, 0x4FDB, 0x4FDC, 0x4FDD, 0x51D9, 0x51DA, 0x51DB, 0x51DC,
+	0x51E2, 0x51E3, 0x51E4, 0x51E5, 0x53F2, 0x53F3, 0x53F4, 0x53F5, 0x53F6, 0x53F7,
+	0x53F8, 0x53F9, 0x53FA, 0x53FB, 0x53FC, 0x568C, 0x568D, 0x568E, 0x568F, 0x5690,
+	0x5691, 0x5943, 0x5944, 0x5945, 0x5946, 0x5947, 0x5948, 0x5BA6, 0x5BA7, 0x5BA8,
+	0x5DEE, 0x5DEF, 0x5DF0, 0x5FFA, 0x5FFB, 0x624F, 0x63BD, 0x63BE, 0x6943, 0x6944,
+	0x6945, 0x694A, 0x694C, 0x694D, 0x6960, 0x6962, 0x6963, 0x6988, 0x69E8, 0x69E9,
+	0x69EE, 0x69EF, 0x6B45, 0x726D, 0x726E, 0x7976, 0x7CF6, 0x8963,
+}
@@ -0,0 +1,60 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestEBCDICDBCSRoundTrip(t *testing.T) {
+	checkRoundTrip(t, "IBM930", []byte("\x81\x0E\x45\x62\x45\x66\x0F\x62"), "ｱ日本a")
+	checkRoundTrip(t, "IBM939", []byte("\x81\x82\x83\x0E\x45\x62\x45\x66\x48\xE7\x0F"), "abc日本語")
+	checkRoundTrip(t, "IBM933", []byte("\x81\x0E\xD0\x65\x8A\x82\x0F"), "a한국")
+	checkRoundTrip(t, "IBM935", []byte("\x81\x0E\x5B\xCF\x57\xC3\x0F"), "a中文")
+	checkRoundTrip(t, "IBM937", []byte("\x81\x0E\x4C\x84\x4C\xC5\x0F"), "a中文")
+	checkRoundTrip(t, "IBM1390", []byte("\x81\xE1\x0E\x45\x62\xB3\x8D\x0F"), "ｱ€日𠮟")
+	checkRoundTrip(t, "IBM1399", []byte("\x81\xE1\x0E\x45\x62\x0F"), "a€日")
+	checkRoundTrip(t, "IBM930", []byte("\x0E\x40\x40\x41\x41\x0F"), "　α")
+	// a base character followed by a combining mark that has a code of
+	// its own as a pair
+	checkRoundTrip(t, "IBM1390", []byte("\x0E\x44\x86\xEC\xB5\x0F"), "かか゚")
+}
+
+func TestEBCDICDBCSUnterminated(t *testing.T) {
+	// a missing SI at the end is not an error
+	checkDecode(t, "IBM930", []byte("\x0E\x45\x62"), "日")
+}
+
+func TestEBCDICDBCSErrors(t *testing.T) {
+	checkDecode(t, "IBM930", []byte("\x0E\x45"), "�", 1)
+	checkDecode(t, "IBM930", []byte("\x0E\x45\x0F\x81"), "�ｱ", 1)
+	checkDecode(t, "IBM930", []byte("\x0E\x41\x40\x0F\x81"), "�ｱ", 1)
+	checkEncode(t, "IBM930", "a€b", []byte("\x62\x00\x63"), 1)
+	checkEncode(t, "IBM930", "a😀日", []byte("\x62\x00\x0E\x45\x62\x0F"), 1)
+}
+
+func TestEBCDICDBCSNoShifts(t *testing.T) {
+	checkTranscode[byte, rune](
+		t,
+		&EBCDICDBCSDecoder[rune] {Variant: EBCDICDBCSVAR_IBM930, Shifts: EBCDICSHIFT_NONE},
+		[]byte("\x45\x62\x0E\x0F\x45\x66"),
+		[]rune("日��本"),
+		2,
+		3,
+	)
+	checkTranscode[rune, byte](
+		t,
+		&EBCDICDBCSEncoder[rune] {Variant: EBCDICDBCSVAR_IBM930, Shifts: EBCDICSHIFT_NONE},
+		[]rune("日本"),
+		[]byte("\x45\x62\x45\x66"),
+	)
+	// single-byte characters have no place in graphic data
+	checkTranscode[rune, byte](
+		t,
+		&EBCDICDBCSEncoder[rune] {Variant: EBCDICDBCSVAR_IBM930, Shifts: EBCDICSHIFT_NONE},
+		[]rune("日a本"),
+		[]byte("\x45\x62\x00\x45\x66"),
+		1,
+	)
+	if EBCDICSHIFT_NONE.ShiftsCounted() || !EBCDICSHIFT_SO_SI.ShiftsCounted() {
+		t.Errorf("ShiftsCounted is wrong")
+	}
+}
@@ -20,6 +20,36 @@ type UTF8DecodingErrorHandler[TargetT CharLike] interface {
 	IllegalStartOfSequence(uint64, byte, bool) ([]TargetT, error, bool)
 }
 
+// UTF8TruncationErrorHandler may be implemented by a
+// UTF8DecodingErrorHandler to learn of a sequence cut off by the end of
+// input; otherwise, such a sequence is reported via IllegalStartOfSequence.
+type UTF8TruncationErrorHandler[TargetT CharLike] interface {
+	TruncatedSequence(uint64, []byte) ([]TargetT, error, bool)
+}
+
+type UTF16DecodingErrorHandler[TargetT CharLike] interface {
+	NarrowingErrorHandler[TargetT]
+	WideningErrorHandler[TargetT]
+	TruncatedSequence(uint64, []byte) ([]TargetT, error, bool)
+}
+
+type MultiByteDecodingErrorHandler[TargetT CharLike] interface {
+	NarrowingErrorHandler[TargetT]
+	UnmappedSequence(uint64, []byte) ([]TargetT, error, bool)
+	TruncatedSequence(uint64, []byte) ([]TargetT, error, bool)
+}
+
+type DoubleByteDecodingErrorHandler[TargetT CharLike] interface {
+	MultiByteDecodingErrorHandler[TargetT]
+	IllegalLeadByte(uint64, byte) ([]TargetT, error, bool)
+	InvalidTrailByte(uint64, byte, byte) ([]TargetT, error, bool)
+}
+
+type EncodingErrorHandler[TargetT CharLike] interface {
+	NarrowingErrorHandler[TargetT]
+	WideningErrorHandler[TargetT]
+}
+
 type DefaultErrorHandlerFlags uint64
 
 const (
@@ -62,6 +92,22 @@ const (
 	DEFERRHDLFL_ILLSTRSEQ_REPEAT_ERROR
 	DEFERRHDLFL_ILLSTRSEQ_REPLACE
 	DEFERRHDLFL_ILLSTRSEQ_HIGH_REPLACEMENT
+	DEFERRHDLFL_UNMAPDSEQ_EMIT_ERROR
+	DEFERRHDLFL_UNMAPDSEQ_PERM_ERROR
+	DEFERRHDLFL_UNMAPDSEQ_REPLACE
+	DEFERRHDLFL_UNMAPDSEQ_HIGH_REPLACEMENT
+	DEFERRHDLFL_TRUNCDSEQ_EMIT_ERROR
+	DEFERRHDLFL_TRUNCDSEQ_PERM_ERROR
+	DEFERRHDLFL_TRUNCDSEQ_REPLACE
+	DEFERRHDLFL_TRUNCDSEQ_HIGH_REPLACEMENT
+	DEFERRHDLFL_ILLLEADBY_EMIT_ERROR
+	DEFERRHDLFL_ILLLEADBY_PERM_ERROR
+	DEFERRHDLFL_ILLLEADBY_REPLACE
+	DEFERRHDLFL_ILLLEADBY_HIGH_REPLACEMENT
+	DEFERRHDLFL_INVTRAILB_EMIT_ERROR
+	DEFERRHDLFL_INVTRAILB_PERM_ERROR
+	DEFERRHDLFL_INVTRAILB_REPLACE
+	DEFERRHDLFL_INVTRAILB_HIGH_REPLACEMENT
 	// UNREPCHAR
 	DEFERRHDLFL_UNREPCHAR_ERROR_MASK = DEFERRHDLFL_UNREPCHAR_EMIT_ERROR | DEFERRHDLFL_UNREPCHAR_PERM_ERROR
 	DEFERRHDLFL_UNREPCHAR_REPLACE_MASK = DEFERRHDLFL_UNREPCHAR_REPLACE | DEFERRHDLFL_UNREPCHAR_HIGH_REPLACEMENT
@@ -92,23 +138,43 @@ const (
 	DEFERRHDLFL_ILLSTRSEQ_ERROR_MASK = DEFERRHDLFL_ILLSTRSEQ_EMIT_ERROR | DEFERRHDLFL_ILLSTRSEQ_PERM_ERROR
 	DEFERRHDLFL_ILLSTRSEQ_ERROR_MASK_EXT = DEFERRHDLFL_ILLSTRSEQ_ERROR_MASK | DEFERRHDLFL_ILLSTRSEQ_REPEAT_ERROR
 	DEFERRHDLFL_ILLSTRSEQ_REPLACE_MASK = DEFERRHDLFL_ILLSTRSEQ_REPLACE | DEFERRHDLFL_ILLSTRSEQ_HIGH_REPLACEMENT
+	// UNMAPDSEQ
+	DEFERRHDLFL_UNMAPDSEQ_ERROR_MASK = DEFERRHDLFL_UNMAPDSEQ_EMIT_ERROR | DEFERRHDLFL_UNMAPDSEQ_PERM_ERROR
+	DEFERRHDLFL_UNMAPDSEQ_REPLACE_MASK = DEFERRHDLFL_UNMAPDSEQ_REPLACE | DEFERRHDLFL_UNMAPDSEQ_HIGH_REPLACEMENT
+	// TRUNCDSEQ
+	DEFERRHDLFL_TRUNCDSEQ_ERROR_MASK = DEFERRHDLFL_TRUNCDSEQ_EMIT_ERROR | DEFERRHDLFL_TRUNCDSEQ_PERM_ERROR
+	DEFERRHDLFL_TRUNCDSEQ_REPLACE_MASK = DEFERRHDLFL_TRUNCDSEQ_REPLACE | DEFERRHDLFL_TRUNCDSEQ_HIGH_REPLACEMENT
+	// ILLLEADBY
+	DEFERRHDLFL_ILLLEADBY_ERROR_MASK = DEFERRHDLFL_ILLLEADBY_EMIT_ERROR | DEFERRHDLFL_ILLLEADBY_PERM_ERROR
+	DEFERRHDLFL_ILLLEADBY_REPLACE_MASK = DEFERRHDLFL_ILLLEADBY_REPLACE | DEFERRHDLFL_ILLLEADBY_HIGH_REPLACEMENT
+	// INVTRAILB
+	DEFERRHDLFL_INVTRAILB_ERROR_MASK = DEFERRHDLFL_INVTRAILB_EMIT_ERROR | DEFERRHDLFL_INVTRAILB_PERM_ERROR
+	DEFERRHDLFL_INVTRAILB_REPLACE_MASK = DEFERRHDLFL_INVTRAILB_REPLACE | DEFERRHDLFL_INVTRAILB_HIGH_REPLACEMENT
 	// EMIT_ERROR
 	DEFERRHDLFL_ALL_EMIT_ERROR = DEFERRHDLFL_UNREPCHAR_EMIT_ERROR | DEFERRHDLFL_REPLCHRIN_EMIT_ERROR |
 			DEFERRHDLFL_UNPSURGTH_EMIT_ERROR | DEFERRHDLFL_ILLCODEPT_EMIT_ERROR |
-			DEFERRHDLFL_OVRLNGENC_EMIT_ERROR | DEFERRHDLFL_DOUBLYENC_EMIT_ERROR
+			DEFERRHDLFL_OVRLNGENC_EMIT_ERROR | DEFERRHDLFL_DOUBLYENC_EMIT_ERROR |
+			DEFERRHDLFL_UNMAPDSEQ_EMIT_ERROR | DEFERRHDLFL_TRUNCDSEQ_EMIT_ERROR |
+			DEFERRHDLFL_ILLLEADBY_EMIT_ERROR | DEFERRHDLFL_INVTRAILB_EMIT_ERROR
 	// PERM_ERROR
 	DEFERRHDLFL_ALL_PERM_ERROR = DEFERRHDLFL_UNREPCHAR_PERM_ERROR | DEFERRHDLFL_REPLCHRIN_PERM_ERROR |
 			DEFERRHDLFL_UNPSURGTH_PERM_ERROR | DEFERRHDLFL_ILLCODEPT_PERM_ERROR |
-			DEFERRHDLFL_OVRLNGENC_PERM_ERROR | DEFERRHDLFL_DOUBLYENC_PERM_ERROR
+			DEFERRHDLFL_OVRLNGENC_PERM_ERROR | DEFERRHDLFL_DOUBLYENC_PERM_ERROR |
+			DEFERRHDLFL_UNMAPDSEQ_PERM_ERROR | DEFERRHDLFL_TRUNCDSEQ_PERM_ERROR |
+			DEFERRHDLFL_ILLLEADBY_PERM_ERROR | DEFERRHDLFL_INVTRAILB_PERM_ERROR
 	// REPLACE
 	DEFERRHDLFL_ALL_REPLACE = DEFERRHDLFL_UNREPCHAR_REPLACE | DEFERRHDLFL_REPLCHRIN_REPLACE |
 			DEFERRHDLFL_UNPSURGTH_REPLACE | DEFERRHDLFL_ILLCODEPT_REPLACE |
-			DEFERRHDLFL_OVRLNGENC_REPLACE | DEFERRHDLFL_DOUBLYENC_REPLACE
+			DEFERRHDLFL_OVRLNGENC_REPLACE | DEFERRHDLFL_DOUBLYENC_REPLACE |
+			DEFERRHDLFL_UNMAPDSEQ_REPLACE | DEFERRHDLFL_TRUNCDSEQ_REPLACE |
+			DEFERRHDLFL_ILLLEADBY_REPLACE | DEFERRHDLFL_INVTRAILB_REPLACE
 	// HIGH_REPLACEMENT
 	DEFERRHDLFL_ALL_HIGH_REPLACEMENT = DEFERRHDLFL_UNREPCHAR_HIGH_REPLACEMENT |
 			DEFERRHDLFL_REPLCHRIN_HIGH_REPLACEMENT |
 			DEFERRHDLFL_UNPSURGTH_HIGH_REPLACEMENT | DEFERRHDLFL_ILLCODEPT_HIGH_REPLACEMENT |
-			DEFERRHDLFL_OVRLNGENC_HIGH_REPLACEMENT | DEFERRHDLFL_DOUBLYENC_HIGH_REPLACEMENT
+			DEFERRHDLFL_OVRLNGENC_HIGH_REPLACEMENT | DEFERRHDLFL_DOUBLYENC_HIGH_REPLACEMENT |
+			DEFERRHDLFL_UNMAPDSEQ_HIGH_REPLACEMENT | DEFERRHDLFL_TRUNCDSEQ_HIGH_REPLACEMENT |
+			DEFERRHDLFL_ILLLEADBY_HIGH_REPLACEMENT | DEFERRHDLFL_INVTRAILB_HIGH_REPLACEMENT
 	// REPEAT_ERROR
 	DEFERRHDLFL_ALL_REPEAT_ERROR = DEFERRHDLFL_INVCONTBY_REPEAT_ERROR | DEFERRHDLFL_UNEXCONTB_REPEAT_ERROR |
 			DEFERRHDLFL_ILLSTRSEQ_REPEAT_ERROR
@@ -122,7 +188,11 @@ const (
 			DEFERRHDLFL_DOUBLYENC_EMIT_ERROR | DEFERRHDLFL_DOUBLYENC_REPLACE |
 			DEFERRHDLFL_INVCONTBY_EMIT_ERROR | DEFERRHDLFL_INVCONTBY_REPLACE |
 			DEFERRHDLFL_UNEXCONTB_EMIT_ERROR | DEFERRHDLFL_UNEXCONTB_REPLACE |
-			DEFERRHDLFL_ILLSTRSEQ_EMIT_ERROR | DEFERRHDLFL_ILLSTRSEQ_REPLACE
+			DEFERRHDLFL_ILLSTRSEQ_EMIT_ERROR | DEFERRHDLFL_ILLSTRSEQ_REPLACE |
+			DEFERRHDLFL_UNMAPDSEQ_EMIT_ERROR | DEFERRHDLFL_UNMAPDSEQ_REPLACE |
+			DEFERRHDLFL_TRUNCDSEQ_EMIT_ERROR | DEFERRHDLFL_TRUNCDSEQ_REPLACE |
+			DEFERRHDLFL_ILLLEADBY_EMIT_ERROR | DEFERRHDLFL_ILLLEADBY_REPLACE |
+			DEFERRHDLFL_INVTRAILB_EMIT_ERROR | DEFERRHDLFL_INVTRAILB_REPLACE
 	DEFERRHDLFL_LAX = DEFERRHDLFL_ALL_EMIT_ERROR | DEFERRHDLFL_ALL_REPLACE
 	DEFERRHDLFL_NEGLIGENT = DEFERRHDLFL_ALL_REPLACE
 	// other
@@ -311,5 +381,83 @@ func(hdl DefaultErrorHandler[TargetT]) IllegalStartOfSequence(
 	return
 }
 
+func(hdl DefaultErrorHandler[TargetT]) UnmappedSequence(
+	offset uint64,
+	sequence []byte,
+) (replacement []TargetT, err error, permanent bool) {
+	if (hdl.Flags & DEFERRHDLFL_UNMAPDSEQ_EMIT_ERROR) != 0 {
+		err = &UnmappedSequenceError {
+			Offset: offset,
+			Sequence: append([]byte(nil), sequence...),
+		}
+		permanent = (hdl.Flags & DEFERRHDLFL_UNMAPDSEQ_PERM_ERROR) != 0
+	}
+	if (hdl.Flags & DEFERRHDLFL_UNMAPDSEQ_REPLACE) != 0 {
+		replacement = []TargetT {hdl.replacementChar(DEFERRHDLFL_UNMAPDSEQ_HIGH_REPLACEMENT)}
+	}
+	return
+}
+
+func(hdl DefaultErrorHandler[TargetT]) TruncatedSequence(
+	offset uint64,
+	sequence []byte,
+) (replacement []TargetT, err error, permanent bool) {
+	if (hdl.Flags & DEFERRHDLFL_TRUNCDSEQ_EMIT_ERROR) != 0 {
+		err = &TruncatedSequenceError {
+			Offset: offset,
+			Sequence: append([]byte(nil), sequence...),
+		}
+		permanent = (hdl.Flags & DEFERRHDLFL_TRUNCDSEQ_PERM_ERROR) != 0
+	}
+	if (hdl.Flags & DEFERRHDLFL_TRUNCDSEQ_REPLACE) != 0 {
+		replacement = []TargetT {hdl.replacementChar(DEFERRHDLFL_TRUNCDSEQ_HIGH_REPLACEMENT)}
+	}
+	return
+}
+
+func(hdl DefaultErrorHandler[TargetT]) IllegalLeadByte(
+	offset uint64,
+	b byte,
+) (replacement []TargetT, err error, permanent bool) {
+	if (hdl.Flags & DEFERRHDLFL_ILLLEADBY_EMIT_ERROR) != 0 {
+		err = &IllegalLeadByteError {
+			Offset: offset,
+			Byte: b,
+		}
+		permanent = (hdl.Flags & DEFERRHDLFL_ILLLEADBY_PERM_ERROR) != 0
+	}
+	if (hdl.Flags & DEFERRHDLFL_ILLLEADBY_REPLACE) != 0 {
+		replacement = []TargetT {hdl.replacementChar(DEFERRHDLFL_ILLLEADBY_HIGH_REPLACEMENT)}
+	}
+	return
+}
+
+func(hdl DefaultErrorHandler[TargetT]) InvalidTrailByte(
+	offset uint64,
+	lead byte,
+	trail byte,
+) (replacement []TargetT, err error, permanent bool) {
+	if (hdl.Flags & DEFERRHDLFL_INVTRAILB_EMIT_ERROR) != 0 {
+		err = &InvalidTrailByteError {
+			Offset: offset,
+			Lead: lead,
+			Trail: trail,
+		}
+		permanent = (hdl.Flags & DEFERRHDLFL_INVTRAILB_PERM_ERROR) != 0
+	}
+	if (hdl.Flags & DEFERRHDLFL_INVTRAILB_REPLACE) != 0 {
+		replacement = []TargetT {hdl.replacementChar(DEFERRHDLFL_INVTRAILB_HIGH_REPLACEMENT)}
+	}
+	return
+}
+
 var _ UTF8DecodingErrorHandler[byte] = &DefaultErrorHandler[byte]{}
 var _ UTF8DecodingErrorHandler[rune] = &DefaultErrorHandler[rune]{}
+var _ UTF8TruncationErrorHandler[byte] = &DefaultErrorHandler[byte]{}
+var _ UTF8TruncationErrorHandler[rune] = &DefaultErrorHandler[rune]{}
+var _ UTF16DecodingErrorHandler[rune] = &DefaultErrorHandler[rune]{}
+var _ MultiByteDecodingErrorHandler[uint16] = &DefaultErrorHandler[uint16]{}
+var _ MultiByteDecodingErrorHandler[rune] = &DefaultErrorHandler[rune]{}
+var _ EncodingErrorHandler[byte] = &DefaultErrorHandler[byte]{}
+var _ DoubleByteDecodingErrorHandler[uint16] = &DefaultErrorHandler[uint16]{}
+var _ DoubleByteDecodingErrorHandler[rune] = &DefaultErrorHandler[rune]{}
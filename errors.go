@@ -2,6 +2,7 @@ package gotextenc
 
 import (
 	"fmt"
+	"strings"
 )
 
 type CodecError interface {
@@ -9,6 +10,13 @@ type CodecError interface {
 	InputOffset() uint64
 }
 
+// relocatableError is implemented by the errors of this package, so that
+// chained codecs can report them at an offset in their own input.
+type relocatableError interface {
+	CodecError
+	atOffset(offset uint64) CodecError
+}
+
 type UnrepresentableCharError struct {
 	Offset uint64
 	Char rune
@@ -18,6 +26,12 @@ func(err *UnrepresentableCharError) InputOffset() uint64 {
 	return err.Offset
 }
 
+func(err *UnrepresentableCharError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
 func(err *UnrepresentableCharError) Error() string {
 	return fmt.Sprintf("At offset %d: Character U+%04X is no representable in target encoding", err.Offset, err.Char)
 }
@@ -30,6 +44,12 @@ func(err *ReplacementCharInInputError) InputOffset() uint64 {
 	return err.Offset
 }
 
+func(err *ReplacementCharInInputError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
 func(err *ReplacementCharInInputError) Error() string {
 	return fmt.Sprintf("At offset %d: Replacement character U+FFFD in input", err.Offset)
 }
@@ -43,6 +63,12 @@ func(err *UnpairedSurrogateHalfError) InputOffset() uint64 {
 	return err.Offset
 }
 
+func(err *UnpairedSurrogateHalfError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
 func(err *UnpairedSurrogateHalfError) Error() string {
 	var whichHalf string
 	if err.Half >= 0xD800 && err.Half < 0xDC00 {
@@ -69,6 +95,12 @@ func(err *IllegalCodePointError) InputOffset() uint64 {
 	return err.Offset
 }
 
+func(err *IllegalCodePointError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
 func(err *IllegalCodePointError) Error() string {
 	return fmt.Sprintf("At offset %d: Illegal code point U+%04X", err.Offset, err.Rune)
 }
@@ -83,6 +115,12 @@ func(err *OverlongEncodingError) InputOffset() uint64 {
 	return err.Offset
 }
 
+func(err *OverlongEncodingError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
 func(err *OverlongEncodingError) Error() string {
 	return fmt.Sprintf(
 		"At offset %d: Overlong encoding of code point U+%04X as %d bytes instead of %d",
@@ -103,6 +141,12 @@ func(err *DoublyEncodedError) InputOffset() uint64 {
 	return err.Offset
 }
 
+func(err *DoublyEncodedError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
 func(err *DoublyEncodedError) Error() string {
 	var codePoint string
 	r := CodePointFromSurrogatePair(err.High, err.Low)
@@ -110,7 +154,7 @@ func(err *DoublyEncodedError) Error() string {
 		codePoint = fmt.Sprintf(" U+%04X", r)
 	}
 	return fmt.Sprintf(
-		"At offset %d: Doubly encoded code point%s as surrogate halves 0x%04 and 0x%04",
+		"At offset %d: Doubly encoded code point%s as surrogate halves 0x%04X and 0x%04X",
 		err.Offset,
 		codePoint,
 		err.High,
@@ -129,6 +173,12 @@ func(err *InvalidContinuationByteError) InputOffset() uint64 {
 	return err.Offset
 }
 
+func(err *InvalidContinuationByteError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
 func(err *InvalidContinuationByteError) Error() string {
 	return fmt.Sprintf(
 		"At offset %d: Invalid continuation byte 0x%02X as byte %d in %d-byte sequence",
@@ -148,6 +198,12 @@ func(err *UnexpectedContinuationByteError) InputOffset() uint64 {
 	return err.Offset
 }
 
+func(err *UnexpectedContinuationByteError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
 func(err *UnexpectedContinuationByteError) Error() string {
 	return fmt.Sprintf(
 		"At offset %d: Unexpected continuation byte 0x%02X outside of multi-byte sequence",
@@ -165,6 +221,12 @@ func(err *IllegalStartOfSequenceError) InputOffset() uint64 {
 	return err.Offset
 }
 
+func(err *IllegalStartOfSequenceError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
 func(err *IllegalStartOfSequenceError) Error() string {
 	return fmt.Sprintf(
 		"At offset %d: Byte 0x%02X is illegal start of UTF-8 sequence",
@@ -173,12 +235,149 @@ func(err *IllegalStartOfSequenceError) Error() string {
 	)
 }
 
-var _ CodecError = &UnrepresentableCharError{}
-var _ CodecError = &ReplacementCharInInputError{}
-var _ CodecError = &UnpairedSurrogateHalfError{}
-var _ CodecError = &IllegalCodePointError{}
-var _ CodecError = &OverlongEncodingError{}
-var _ CodecError = &DoublyEncodedError{}
-var _ CodecError = &InvalidContinuationByteError{}
-var _ CodecError = &UnexpectedContinuationByteError{}
-var _ CodecError = &IllegalStartOfSequenceError{}
+type UnmappedSequenceError struct {
+	Offset uint64
+	Sequence []byte
+}
+
+func(err *UnmappedSequenceError) InputOffset() uint64 {
+	return err.Offset
+}
+
+func(err *UnmappedSequenceError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
+func(err *UnmappedSequenceError) Error() string {
+	return fmt.Sprintf(
+		"At offset %d: Byte sequence %s does not map to any character",
+		err.Offset,
+		formatByteSequence(err.Sequence),
+	)
+}
+
+type TruncatedSequenceError struct {
+	Offset uint64
+	Sequence []byte
+}
+
+func(err *TruncatedSequenceError) InputOffset() uint64 {
+	return err.Offset
+}
+
+func(err *TruncatedSequenceError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
+func(err *TruncatedSequenceError) Error() string {
+	return fmt.Sprintf(
+		"At offset %d: Byte sequence %s is truncated by end of input",
+		err.Offset,
+		formatByteSequence(err.Sequence),
+	)
+}
+
+// IllegalLeadByteError reports a byte that can neither stand on its own nor
+// start a multi-byte sequence.
+type IllegalLeadByteError struct {
+	Offset uint64
+	Byte byte
+}
+
+func(err *IllegalLeadByteError) InputOffset() uint64 {
+	return err.Offset
+}
+
+func(err *IllegalLeadByteError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
+func(err *IllegalLeadByteError) Error() string {
+	return fmt.Sprintf(
+		"At offset %d: Byte 0x%02X is neither a character nor a lead byte",
+		err.Offset,
+		err.Byte,
+	)
+}
+
+// InvalidTrailByteError reports a lead byte followed by a byte that cannot
+// complete a multi-byte sequence; Offset refers to the lead byte.
+type InvalidTrailByteError struct {
+	Offset uint64
+	Lead byte
+	Trail byte
+}
+
+func(err *InvalidTrailByteError) InputOffset() uint64 {
+	return err.Offset
+}
+
+func(err *InvalidTrailByteError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
+func(err *InvalidTrailByteError) Error() string {
+	return fmt.Sprintf(
+		"At offset %d: Byte 0x%02X is not a valid trail byte after lead byte 0x%02X",
+		err.Offset,
+		err.Trail,
+		err.Lead,
+	)
+}
+
+type UnsupportedCharacterTableError struct {
+	Offset uint64
+	Selector []byte
+}
+
+func(err *UnsupportedCharacterTableError) InputOffset() uint64 {
+	return err.Offset
+}
+
+func(err *UnsupportedCharacterTableError) atOffset(offset uint64) CodecError {
+	moved := *err
+	moved.Offset = offset
+	return &moved
+}
+
+func(err *UnsupportedCharacterTableError) Error() string {
+	return fmt.Sprintf(
+		"At offset %d: Character table selector %s is reserved or not supported",
+		err.Offset,
+		formatByteSequence(err.Selector),
+	)
+}
+
+func formatByteSequence(sequence []byte) string {
+	var builder strings.Builder
+	for index, b := range sequence {
+		if index > 0 {
+			builder.WriteByte(' ')
+		}
+		fmt.Fprintf(&builder, "0x%02X", b)
+	}
+	return builder.String()
+}
+
+var _ relocatableError = &UnrepresentableCharError{}
+var _ relocatableError = &ReplacementCharInInputError{}
+var _ relocatableError = &UnpairedSurrogateHalfError{}
+var _ relocatableError = &IllegalCodePointError{}
+var _ relocatableError = &OverlongEncodingError{}
+var _ relocatableError = &DoublyEncodedError{}
+var _ relocatableError = &InvalidContinuationByteError{}
+var _ relocatableError = &UnexpectedContinuationByteError{}
+var _ relocatableError = &IllegalStartOfSequenceError{}
+var _ relocatableError = &UnmappedSequenceError{}
+var _ relocatableError = &TruncatedSequenceError{}
+var _ relocatableError = &IllegalLeadByteError{}
+var _ relocatableError = &InvalidTrailByteError{}
+var _ relocatableError = &UnsupportedCharacterTableError{}
@@ -0,0 +1,199 @@
+package gotextenc
+
+import (
+	"sync"
+)
+
+// EUCJPMapping selects how EUC-JP codes whose Unicode mapping differs between
+// implementations are treated.
+type EUCJPMapping uint8
+
+const (
+	// as glibc's EUC-JP: 0xA1C1 is WAVE DASH and JIS X 0212 0x2237 is
+	// FULLWIDTH TILDE
+	EUCJPMAP_GLIBC EUCJPMapping = iota
+	// as JIS0212.TXT: JIS X 0212 0x2237 is TILDE, which is always encoded as
+	// ASCII, so that 0x8FA2B7 does not round-trip
+	EUCJPMAP_UNICODE
+	// as eucJP-ms: the mappings of Windows-31J, i.e. 0xA1C1 is FULLWIDTH
+	// TILDE, plus NEC row 13, the IBM extensions in JIS X 0212 rows 83 and 84
+	// and the user-defined areas in rows 85 to 94 of both planes
+	EUCJPMAP_EUCJP_MS
+	eucjpmap_COUNT
+)
+
+const (
+	eucJP_SS2 byte = 0x8E
+	eucJP_SS3 byte = 0x8F
+)
+
+const (
+	eucJPMS_NEC_ROW = 13
+	eucJPMS_IBM_FIRST_ROW = 83
+	eucJPMS_USER_DEFINED_FIRST_ROW = 85
+	// eucJPMS_USER_DEFINED_SIZE is the number of user-defined codes per plane
+	eucJPMS_USER_DEFINED_SIZE = (94 - eucJPMS_USER_DEFINED_FIRST_ROW + 1) * 94
+)
+
+func isEUCJPByte(b byte) bool {
+	return b >= 0xA1 && b <= 0xFE
+}
+
+// decodeJISX0208 maps the JIS X 0208 row and cell (counting from 1).
+func(mapping EUCJPMapping) decodeJISX0208(row int, cell int) rune {
+	if mapping == EUCJPMAP_EUCJP_MS {
+		if row == eucJPMS_NEC_ROW {
+			return rune(cp932Extensions[0][cell - 1])
+		}
+		if row >= eucJPMS_USER_DEFINED_FIRST_ROW {
+			return 0xE000 + rune(row - eucJPMS_USER_DEFINED_FIRST_ROW) * 94 + rune(cell - 1)
+		}
+		if char, found := cp932Overrides[jisToShiftJIS(row, cell)]; found {
+			return char
+		}
+	}
+	return rune(jisX0208[(row - 1) * 94 + cell - 1])
+}
+
+// decodeJISX0212 maps the JIS X 0212 row and cell (counting from 1).
+func(mapping EUCJPMapping) decodeJISX0212(row int, cell int) rune {
+	if mapping == EUCJPMAP_UNICODE && row == 2 && cell == 23 {
+		return 0x007E
+	}
+	if mapping == EUCJPMAP_EUCJP_MS {
+		switch {
+			case row == 2 && cell == 35:
+				// instead of BROKEN BAR
+				return 0xFFE4
+			case row >= eucJPMS_USER_DEFINED_FIRST_ROW:
+				userDefinedIndex := (row - eucJPMS_USER_DEFINED_FIRST_ROW) * 94 + cell - 1
+				return 0xE000 + eucJPMS_USER_DEFINED_SIZE + rune(userDefinedIndex)
+			case row >= eucJPMS_IBM_FIRST_ROW:
+				return rune(eucJPMSIBMExtensions[row - eucJPMS_IBM_FIRST_ROW][cell - 1])
+		}
+	}
+	return rune(jisX0212[(row - 1) * 94 + cell - 1])
+}
+
+var eucJPEncodeOnces [eucjpmap_COUNT]sync.Once
+// eucJPEncodeMaps map characters to their two-byte codes or, for JIS X 0212,
+// to their three-byte codes starting with SS3.
+var eucJPEncodeMaps [eucjpmap_COUNT]map[rune]uint32
+
+func(mapping EUCJPMapping) encodeMap() map[rune]uint32 {
+	if mapping >= eucjpmap_COUNT {
+		mapping = EUCJPMAP_GLIBC
+	}
+	eucJPEncodeOnces[mapping].Do(func() {
+		encodeMap := make(map[rune]uint32)
+		add := func(char rune, code uint32) {
+			if char < 0x80 {
+				return
+			}
+			if _, present := encodeMap[char]; !present {
+				encodeMap[char] = code
+			}
+		}
+		for row := 1; row <= 94; row++ {
+			for cell := 1; cell <= 94; cell++ {
+				code := uint32(0xA0 + row) << 8 | uint32(0xA0 + cell)
+				if char := mapping.decodeJISX0208(row, cell); char != 0 {
+					add(char, code)
+				}
+			}
+		}
+		if mapping == EUCJPMAP_EUCJP_MS {
+			// the characters replaced by the Windows-31J mappings are still
+			// accepted for their codes
+			for code := range cp932Overrides {
+				lead, trail := byte(code >> 8), byte(code)
+				trailIndex, _ := shiftJISTrailIndex(trail)
+				row, cell := shiftJISToJIS(lead, trailIndex)
+				add(rune(jisX0208[(row - 1) * 94 + cell - 1]), uint32(0xA0 + row) << 8 | uint32(0xA0 + cell))
+			}
+			add(0x00A6, uint32(eucJP_SS3) << 16 | 0xA2C3)
+		}
+		for row := 1; row <= 94; row++ {
+			for cell := 1; cell <= 94; cell++ {
+				code := uint32(eucJP_SS3) << 16 | uint32(0xA0 + row) << 8 | uint32(0xA0 + cell)
+				if char := mapping.decodeJISX0212(row, cell); char != 0 {
+					add(char, code)
+				}
+			}
+		}
+		eucJPEncodeMaps[mapping] = encodeMap
+	})
+	return eucJPEncodeMaps[mapping]
+}
+
+var eucJPNames = []string {
+	"EUC-JP",
+	"Extended_UNIX_Code_Packed_Format_for_Japanese",
+	"csEUCPkdFmtJapanese",
+	"EUCJP",
+	"ujis",
+}
+
+var eucJPMSNames = []string {
+	"eucJP-ms",
+	"EUC-JP-MS",
+	"eucJP-open",
+}
+
+func init() {
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &EUCJPDecoder[uint16]{}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &EUCJPDecoder[rune]{}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &EUCJPEncoder[uint16]{}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &EUCJPEncoder[rune]{}
+		},
+	}, eucJPNames...)
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &EUCJPDecoder[uint16] {Mapping: EUCJPMAP_EUCJP_MS}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &EUCJPDecoder[rune] {Mapping: EUCJPMAP_EUCJP_MS}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &EUCJPEncoder[uint16] {Mapping: EUCJPMAP_EUCJP_MS}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &EUCJPEncoder[rune] {Mapping: EUCJPMAP_EUCJP_MS}
+		},
+	}, eucJPMSNames...)
+}
+
+// eucJPMSIBMExtensions maps JIS X 0212 rows 83 and 84 in eucJP-ms, which hold
+// the IBM extensions missing from JIS X 0212.
+var eucJPMSIBMExtensions = [2][94]uint16 {
+	// row 83
+	{
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x2170, 0x2171,
+		0x2172, 0x2173, 0x2174, 0x2175, 0x2176, 0x2177, 0x2178, 0x2179, 0x2160, 0x2161,
+	},
+	// row 84
+	{
+		0x2162, 0x2163, 0x2164, 0x2165, 0x2166, 0x2167, 0x2168, 0x2169, 0xFF07, 0xFF02, 0x3231, 0x2116,
+		0x2121, 0x70BB, 0x4EFC, 0x50F4, 0x51EC, 0x5307, 0x5324, 0xFA0E, 0x548A, 0x5759, 0xFA0F, 0xFA10,
+		0x589E, 0x5BEC, 0x5CF5, 0x5D53, 0xFA11, 0x5FB7, 0x6085, 0x6120, 0x654E, 0x663B, 0x6665, 0xFA12,
+		0xF929, 0x6801, 0xFA13, 0xFA14, 0x6A6B, 0x6AE2, 0x6DF8, 0x6DF2, 0x7028, 0xFA15, 0xFA16, 0x7501,
+		0x7682, 0x769E, 0xFA17, 0x7930, 0xFA18, 0xFA19, 0xFA1A, 0xFA1B, 0x7AE7, 0xFA1C, 0xFA1D, 0x7DA0,
+		0x7DD6, 0xFA1E, 0x8362, 0xFA1F, 0x85B0, 0xFA20, 0xFA21, 0x8807, 0xFA22, 0x8B7F, 0x8CF4, 0x8D76,
+		0xFA23, 0xFA24, 0xFA25, 0x90DE, 0xFA26, 0x9115, 0xFA27, 0xFA28, 0x9592, 0xF9DC, 0xFA29, 0x973B,
+		0x974D, 0x9751, 0xFA2A, 0xFA2B, 0xFA2C, 0x999E, 0x9AD9, 0x9B72, 0xFA2D, 0x9ED1,
+	},
+}
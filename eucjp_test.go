@@ -0,0 +1,43 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestEUCJPRoundTrip(t *testing.T) {
+	checkRoundTrip(
+		t,
+		"EUC-JP",
+		[]byte("\xC6\xFC\xCB\xDC\xB8\xEC\x8E\xB6\x8E\xC0 abc"),
+		"日本語ｶﾀ abc",
+	)
+	// JIS X 0212 after SS3
+	checkRoundTrip(t, "EUC-JP", []byte("\x8F\xB0\xA1"), "丂")
+	checkRoundTrip(t, "eucJP-ms", []byte("\xAD\xA1\x8F\xF3\xF3\xF5\xA1"), "①ⅰ\uE000")
+}
+
+func TestEUCJPMappings(t *testing.T) {
+	src := []byte("\xA1\xC1\x8F\xA2\xB7")
+	check := func(mapping EUCJPMapping, decoded string, text string, encoded string, offsets ...uint64) {
+		t.Helper()
+		checkTranscode[byte, rune](t, &EUCJPDecoder[rune] {Mapping: mapping}, src, []rune(decoded))
+		checkTranscode[rune, byte](
+			t,
+			&EUCJPEncoder[rune] {Mapping: mapping},
+			[]rune(text),
+			[]byte(encoded),
+			offsets...,
+		)
+	}
+	check(EUCJPMAP_GLIBC, "〜～", "〜～~", "\xA1\xC1\x8F\xA2\xB7~")
+	// TILDE goes to ASCII, and FULLWIDTH TILDE nowhere
+	check(EUCJPMAP_UNICODE, "〜~", "〜～~", "\xA1\xC1\x00~", 1)
+	check(EUCJPMAP_EUCJP_MS, "～～", "〜～~", "\xA1\xC1\xA1\xC1~")
+}
+
+func TestEUCJPErrors(t *testing.T) {
+	checkDecode(t, "EUC-JP", []byte("a\x8E\xE0b\xA1 \x8F\xA1"), "a�b� �", 1, 4, 6)
+	// no NEC row 13, IBM extensions or user-defined area in plain EUC-JP
+	checkDecode(t, "EUC-JP", []byte("\xAD\xA1\x8F\xF3\xF3\xF5\xA1"), "���", 0, 2, 5)
+	checkEncode(t, "EUC-JP", "a①\uE000", []byte("a\x00\x00"), 1, 2)
+}
@@ -0,0 +1,263 @@
+package gotextenc
+
+import (
+	"sync"
+)
+
+type EUCKRVariant uint8
+
+const (
+	// KS X 1001 in EUC form; C1 controls are passed through
+	EUCKRVAR_EUC_KR EUCKRVariant = iota
+	// Microsoft's Unified Hangul Code (code page 949): EUC-KR plus the 8822
+	// Hangul syllables missing from KS X 1001, in Unicode order, in codes
+	// with lead bytes 0x81..0xC6
+	EUCKRVAR_UHC
+	euckrvar_COUNT
+)
+
+const (
+	hangul_FIRST_SYLLABLE rune = 0xAC00
+	hangul_SYLLABLE_COUNT = 11172
+	hangul_VOWEL_COUNT = 21
+	// hangul_FINAL_COUNT includes the absence of a final consonant
+	hangul_FINAL_COUNT = 28
+	hangul_FIRST_COMPATIBILITY_JAMO rune = 0x3131
+	hangul_FIRST_COMPATIBILITY_VOWEL rune = 0x314F
+	hangul_FILLER rune = 0x3164
+)
+
+// hangulInitialJamo lists the compatibility jamo, as offsets from U+3131,
+// corresponding to the initial consonants of Hangul syllables.
+var hangulInitialJamo = [19]byte {
+	0, 1, 3, 6, 7, 8, 16, 17, 18, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29,
+}
+
+// hangulFinalJamo lists the compatibility jamo, as offsets from U+3131,
+// corresponding to the final consonants of Hangul syllables, starting with
+// the first final consonant.
+var hangulFinalJamo = [hangul_FINAL_COUNT - 1]byte {
+	0, 1, 2, 3, 4, 5, 6, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 19, 20, 21, 22, 23, 25, 26, 27, 28, 29,
+}
+
+// findJamo returns the index of the compatibility jamo offset in jamo, or -1.
+func findJamo(jamo []byte, offset byte) int {
+	for index, candidate := range jamo {
+		if candidate == offset {
+			return index
+		}
+	}
+	return -1
+}
+
+const (
+	// lead byte of the KS X 1001 jamo row
+	ksX1001_JAMO_LEAD byte = 0xA4
+	// trail byte of HANGUL FILLER
+	ksX1001_JAMO_FILLER byte = 0xD4
+	// trail byte of the first vowel
+	ksX1001_JAMO_FIRST_VOWEL byte = 0xBF
+	// ksX1001_JAMO_SEQUENCE_LENGTH is the number of bytes in a KS X 1001
+	// jamo sequence: HANGUL FILLER, initial, vowel and final (or HANGUL
+	// FILLER)
+	ksX1001_JAMO_SEQUENCE_LENGTH = 8
+)
+
+// isKSX1001JamoTrail tells whether trail, following ksX1001_JAMO_LEAD, is
+// acceptable at position (counting from 0) of a KS X 1001 jamo sequence.
+func isKSX1001JamoTrail(position int, trail byte) bool {
+	switch position {
+		case 1:
+			return trail == ksX1001_JAMO_FILLER
+		case 3:
+			return trail >= 0xA1 && findJamo(hangulInitialJamo[:], trail - 0xA1) >= 0
+		case 5:
+			return trail >= ksX1001_JAMO_FIRST_VOWEL && trail < ksX1001_JAMO_FILLER
+		default:
+			return trail == ksX1001_JAMO_FILLER || trail >= 0xA1 && findJamo(hangulFinalJamo[:], trail - 0xA1) >= 0
+	}
+}
+
+// composeKSX1001JamoSequence maps a complete and valid KS X 1001 jamo
+// sequence to the Hangul syllable.
+func composeKSX1001JamoSequence(sequence [ksX1001_JAMO_SEQUENCE_LENGTH]byte) rune {
+	initial := findJamo(hangulInitialJamo[:], sequence[3] - 0xA1)
+	vowel := int(sequence[5] - ksX1001_JAMO_FIRST_VOWEL)
+	final := 0
+	if sequence[7] != ksX1001_JAMO_FILLER {
+		final = findJamo(hangulFinalJamo[:], sequence[7] - 0xA1) + 1
+	}
+	return hangul_FIRST_SYLLABLE + rune((initial * hangul_VOWEL_COUNT + vowel) * hangul_FINAL_COUNT + final)
+}
+
+// appendKSX1001JamoSequence appends the KS X 1001 jamo sequence representing
+// the Hangul syllable r to units.
+func appendKSX1001JamoSequence(units []byte, r rune) []byte {
+	index := int(r - hangul_FIRST_SYLLABLE)
+	final := index % hangul_FINAL_COUNT
+	vowel := index / hangul_FINAL_COUNT % hangul_VOWEL_COUNT
+	initial := index / hangul_FINAL_COUNT / hangul_VOWEL_COUNT
+	units = append(
+		units,
+		ksX1001_JAMO_LEAD, ksX1001_JAMO_FILLER,
+		ksX1001_JAMO_LEAD, 0xA1 + hangulInitialJamo[initial],
+		ksX1001_JAMO_LEAD, ksX1001_JAMO_FIRST_VOWEL + byte(vowel),
+		ksX1001_JAMO_LEAD,
+	)
+	if final == 0 {
+		return append(units, ksX1001_JAMO_FILLER)
+	}
+	return append(units, 0xA1 + hangulFinalJamo[final - 1])
+}
+
+func(variant EUCKRVariant) isLead(b byte) bool {
+	if variant == EUCKRVAR_UHC {
+		return b >= 0x81 && b <= 0xFE
+	}
+	return b >= 0xA1 && b <= 0xFE
+}
+
+// decodeSingle maps the single byte b, if it stands on its own.
+func(variant EUCKRVariant) decodeSingle(b byte) (rune, bool) {
+	if b < 0x80 || b < 0xA0 && variant == EUCKRVAR_EUC_KR {
+		return rune(b), true
+	}
+	return 0, false
+}
+
+// uhcTrailIndex numbers the UHC trail bytes 0x41..0x5A, 0x61..0x7A and
+// 0x81..0xFE consecutively.
+func uhcTrailIndex(trail byte) (int, bool) {
+	switch {
+		case trail >= 0x41 && trail <= 0x5A:
+			return int(trail - 0x41), true
+		case trail >= 0x61 && trail <= 0x7A:
+			return int(trail - 0x47), true
+		case trail >= 0x81 && trail <= 0xFE:
+			return int(trail - 0x4D), true
+		default:
+			return 0, false
+	}
+}
+
+func(variant EUCKRVariant) isTrail(b byte) bool {
+	if variant == EUCKRVAR_UHC {
+		_, valid := uhcTrailIndex(b)
+		return valid
+	}
+	return b >= 0xA1 && b <= 0xFE
+}
+
+const (
+	// uhc_WIDE_LEADS is the number of lead bytes, from 0x81, taking all
+	// trail bytes for syllables
+	uhc_WIDE_LEADS = 0xA1 - 0x81
+	// uhc_WIDE_TRAILS is the number of trail bytes used with them
+	uhc_WIDE_TRAILS = 178
+	// uhc_NARROW_TRAILS is the number of trail bytes, up to 0xA0, used for
+	// syllables with later lead bytes
+	uhc_NARROW_TRAILS = 84
+)
+
+var uhcSyllablesOnce sync.Once
+// uhcSyllables lists the Hangul syllables not in KS X 1001, in Unicode order.
+var uhcSyllables []rune
+
+func loadUHCSyllables() {
+	var inKSX1001 [hangul_SYLLABLE_COUNT]bool
+	for _, char := range ksX1001 {
+		if index := rune(char) - hangul_FIRST_SYLLABLE; index >= 0 && index < hangul_SYLLABLE_COUNT {
+			inKSX1001[index] = true
+		}
+	}
+	for index, present := range inKSX1001 {
+		if !present {
+			uhcSyllables = append(uhcSyllables, hangul_FIRST_SYLLABLE + rune(index))
+		}
+	}
+}
+
+// decodeDouble maps the double-byte code lead/trail; trail must be valid.
+func(variant EUCKRVariant) decodeDouble(lead byte, trail byte) rune {
+	if lead >= 0xA1 && trail >= 0xA1 {
+		return rune(ksX1001[int(lead - 0xA1) * 94 + int(trail - 0xA1)])
+	}
+	// UHC extension
+	trailIndex, _ := uhcTrailIndex(trail)
+	var index int
+	if lead < 0xA1 {
+		index = int(lead - 0x81) * uhc_WIDE_TRAILS + trailIndex
+	} else {
+		index = uhc_WIDE_LEADS * uhc_WIDE_TRAILS + int(lead - 0xA1) * uhc_NARROW_TRAILS + trailIndex
+	}
+	uhcSyllablesOnce.Do(loadUHCSyllables)
+	if index < len(uhcSyllables) {
+		return uhcSyllables[index]
+	}
+	return 0
+}
+
+var euckrEncodeOnces [euckrvar_COUNT]sync.Once
+var euckrEncodeMaps [euckrvar_COUNT]map[rune]uint16
+
+func(variant EUCKRVariant) encodeMap() map[rune]uint16 {
+	if variant >= euckrvar_COUNT {
+		variant = EUCKRVAR_EUC_KR
+	}
+	euckrEncodeOnces[variant].Do(func() {
+		encodeMap := make(map[rune]uint16)
+		for lead := 0x81; lead <= 0xFE; lead++ {
+			for trail := 0x41; trail <= 0xFE; trail++ {
+				if !variant.isLead(byte(lead)) || !variant.isTrail(byte(trail)) {
+					continue
+				}
+				char := variant.decodeDouble(byte(lead), byte(trail))
+				if _, present := encodeMap[char]; char != 0 && !present {
+					encodeMap[char] = uint16(lead << 8 | trail)
+				}
+			}
+		}
+		euckrEncodeMaps[variant] = encodeMap
+	})
+	return euckrEncodeMaps[variant]
+}
+
+// encodeSingle is the inverse of decodeSingle.
+func(variant EUCKRVariant) encodeSingle(r rune) (byte, bool) {
+	if r < 0x80 || r < 0xA0 && variant == EUCKRVAR_EUC_KR {
+		return byte(r), true
+	}
+	return 0, false
+}
+
+var eucKRNames = []string {
+	"EUC-KR",
+	"csEUCKR",
+	"EUCKR",
+}
+
+var uhcNames = []string {
+	"UHC",
+	"CP949",
+	"windows-949",
+}
+
+func init() {
+	for index, names := range [euckrvar_COUNT][]string {eucKRNames, uhcNames} {
+		variant := EUCKRVariant(index)
+		RegisterCharset(CharsetFactories {
+			Decoder12: func() Codec[byte, uint16] {
+				return &EUCKRDecoder[uint16] {Variant: variant}
+			},
+			Decoder14: func() Codec[byte, rune] {
+				return &EUCKRDecoder[rune] {Variant: variant}
+			},
+			Encoder21: func() Codec[uint16, byte] {
+				return &EUCKREncoder[uint16] {Variant: variant}
+			},
+			Encoder41: func() Codec[rune, byte] {
+				return &EUCKREncoder[rune] {Variant: variant}
+			},
+		}, names...)
+	}
+}
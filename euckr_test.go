@@ -0,0 +1,20 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestEUCKRRoundTrip(t *testing.T) {
+	checkRoundTrip(t, "EUC-KR", []byte("\xC7\xD1\xB1\xB9\xBE\xEEabc"), "한국어abc")
+	checkRoundTrip(t, "UHC", []byte("\xC7\xD1\xB1\xB9\xBE\xEE\x8C\x63"), "한국어똠")
+	// the Hangul filler
+	checkRoundTrip(t, "EUC-KR", []byte("\xA4\xD4\xA4\xA1"), "ㅤㄱ")
+}
+
+func TestEUCKRErrors(t *testing.T) {
+	// C1 controls pass as in glibc
+	checkDecode(t, "EUC-KR", []byte("\x8C\x63a\xC7"), "\u008Cca�", 3)
+	checkDecode(t, "UHC", []byte("\x8C\x63\xC7 "), "똠� ", 2)
+	// UHC's extra syllables are not in EUC-KR
+	checkEncode(t, "EUC-KR", "똠한", []byte("\x00\xC7\xD1"), 0)
+}
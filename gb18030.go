@@ -0,0 +1,638 @@
+package gotextenc
+
+import (
+	"sort"
+	"sync"
+)
+
+const (
+	// gb18030_BMP_POINTERS is the number of four-byte codes mapped to the BMP
+	gb18030_BMP_POINTERS = 39420
+	// gb18030_SUPPLEMENTARY_POINTER is the linear index of 0x90308130, which
+	// maps to U+10000
+	gb18030_SUPPLEMENTARY_POINTER = 189000
+)
+
+func isGB18030Lead(b byte) bool {
+	return b >= 0x81 && b <= 0xFE
+}
+
+func isGB18030Digit(b byte) bool {
+	return b >= 0x30 && b <= 0x39
+}
+
+// gb18030Pointer computes the linear index of the four-byte code in sequence,
+// 0x81308130 having index zero.
+func gb18030Pointer(sequence [4]byte) uint32 {
+	pointer := uint32(sequence[0] - 0x81) * 10 + uint32(sequence[1] - 0x30)
+	pointer = pointer * 126 + uint32(sequence[2] - 0x81)
+	return pointer * 10 + uint32(sequence[3] - 0x30)
+}
+
+// gb18030FourBytes is the inverse of gb18030Pointer.
+func gb18030FourBytes(pointer uint32) [4]byte {
+	var sequence [4]byte
+	sequence[3] = byte(pointer % 10) + 0x30
+	pointer /= 10
+	sequence[2] = byte(pointer % 126) + 0x81
+	pointer /= 126
+	sequence[1] = byte(pointer % 10) + 0x30
+	sequence[0] = byte(pointer / 10) + 0x81
+	return sequence
+}
+
+// decodeGB18030Double maps the two-byte code lead/trail; trail must be a
+// valid GBK trail byte. All of the two-byte codes are mapped.
+func decodeGB18030Double(lead byte, trail byte) rune {
+	trailIndex, _ := gbkTrailIndex(trail)
+	if char := gbkDoubleBytes[int(lead - 0x81) * 190 + trailIndex]; char != 0 {
+		return rune(char)
+	}
+	if char, found := decodeCP936UserDefined(lead, trail); found {
+		return char
+	}
+	return rune(gb18030DoubleByteAdditions[uint16(lead) << 8 | uint16(trail)])
+}
+
+// decodeGB18030Four maps the four-byte code in sequence, returning 0 if it is
+// unmapped.
+func decodeGB18030Four(sequence [4]byte) rune {
+	code := uint32(sequence[0]) << 24 | uint32(sequence[1]) << 16 | uint32(sequence[2]) << 8 | uint32(sequence[3])
+	if char, found := gb18030FourByteOverrides[code]; found {
+		return char
+	}
+	pointer := gb18030Pointer(sequence)
+	if pointer >= gb18030_SUPPLEMENTARY_POINTER {
+		if char := rune(pointer - gb18030_SUPPLEMENTARY_POINTER) + 0x10000; char <= 0x10FFFF {
+			return char
+		}
+		return 0
+	}
+	if pointer >= gb18030_BMP_POINTERS {
+		return 0
+	}
+	index := sort.Search(len(gb18030Ranges), func(index int) bool {
+		return gb18030Ranges[index][0] > pointer
+	}) - 1
+	return rune(gb18030Ranges[index][1] + (pointer - gb18030Ranges[index][0]))
+}
+
+var gb18030EncodeOnce sync.Once
+var gb18030DoubleByteEncodeMap map[rune]uint16
+var gb18030FourByteEncodeMap map[rune][4]byte
+
+func loadGB18030EncodeMaps() {
+	gb18030DoubleByteEncodeMap = make(map[rune]uint16)
+	for lead := 0x81; lead <= 0xFE; lead++ {
+		for trail := 0x40; trail <= 0xFE; trail++ {
+			if trail == 0x7F {
+				continue
+			}
+			char := decodeGB18030Double(byte(lead), byte(trail))
+			if _, present := gb18030DoubleByteEncodeMap[char]; !present {
+				gb18030DoubleByteEncodeMap[char] = uint16(lead << 8 | trail)
+			}
+		}
+	}
+	gb18030FourByteEncodeMap = make(map[rune][4]byte)
+	for code, char := range gb18030FourByteOverrides {
+		gb18030FourByteEncodeMap[char] = [4]byte {byte(code >> 24), byte(code >> 16), byte(code >> 8), byte(code)}
+	}
+}
+
+// encodeGB18030Four finds the four-byte code for r, which must be a
+// character that has no one- or two-byte code.
+func encodeGB18030Four(r rune) [4]byte {
+	if sequence, found := gb18030FourByteEncodeMap[r]; found {
+		return sequence
+	}
+	if r >= 0x10000 {
+		return gb18030FourBytes(uint32(r - 0x10000) + gb18030_SUPPLEMENTARY_POINTER)
+	}
+	index := sort.Search(len(gb18030Ranges), func(index int) bool {
+		return rune(gb18030Ranges[index][1]) > r
+	}) - 1
+	return gb18030FourBytes(gb18030Ranges[index][0] + uint32(r - rune(gb18030Ranges[index][1])))
+}
+
+var gb18030Names = []string {
+	"GB18030",
+	"csGB18030",
+	"GB18030-2022",
+}
+
+func init() {
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &GB18030Decoder[uint16]{}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &GB18030Decoder[rune]{}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &GB18030Encoder[uint16]{}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &GB18030Encoder[rune]{}
+		},
+	}, gb18030Names...)
+}
+
+// gb18030DoubleByteAdditions maps the two-byte codes of GB 18030 that are
+// neither in GBK nor in the user-defined areas.
+var gb18030DoubleByteAdditions = map[uint16]uint16 {
+	0xA2AB: 0xE766,
+	0xA2AC: 0xE767,
+	0xA2AD: 0xE768,
+	0xA2AE: 0xE769,
+	0xA2AF: 0xE76A,
+	0xA2B0: 0xE76B,
+	0xA2E3: 0x20AC,
+	0xA2E4: 0xE76D,
+	0xA2EF: 0xE76E,
+	0xA2F0: 0xE76F,
+	0xA2FD: 0xE770,
+	0xA2FE: 0xE771,
+	0xA4F4: 0xE772,
+	0xA4F5: 0xE773,
+	0xA4F6: 0xE774,
+	0xA4F7: 0xE775,
+	0xA4F8: 0xE776,
+	0xA4F9: 0xE777,
+	0xA4FA: 0xE778,
+	0xA4FB: 0xE779,
+	0xA4FC: 0xE77A,
+	0xA4FD: 0xE77B,
+	0xA4FE: 0xE77C,
+	0xA5F7: 0xE77D,
+	0xA5F8: 0xE77E,
+	0xA5F9: 0xE77F,
+	0xA5FA: 0xE780,
+	0xA5FB: 0xE781,
+	0xA5FC: 0xE782,
+	0xA5FD: 0xE783,
+	0xA5FE: 0xE784,
+	0xA6B9: 0xE785,
+	0xA6BA: 0xE786,
+	0xA6BB: 0xE787,
+	0xA6BC: 0xE788,
+	0xA6BD: 0xE789,
+	0xA6BE: 0xE78A,
+	0xA6BF: 0xE78B,
+	0xA6C0: 0xE78C,
+	0xA6D9: 0xFE10, // 0xE78D until GB 18030-2022
+	0xA6DA: 0xFE12, // 0xE78E until GB 18030-2022
+	0xA6DB: 0xFE11, // 0xE78F until GB 18030-2022
+	0xA6DC: 0xFE13, // 0xE790 until GB 18030-2022
+	0xA6DD: 0xFE14, // 0xE791 until GB 18030-2022
+	0xA6DE: 0xFE15, // 0xE792 until GB 18030-2022
+	0xA6DF: 0xFE16, // 0xE793 until GB 18030-2022
+	0xA6EC: 0xFE17, // 0xE794 until GB 18030-2022
+	0xA6ED: 0xFE18, // 0xE795 until GB 18030-2022
+	0xA6F3: 0xFE19, // 0xE796 until GB 18030-2022
+	0xA6F6: 0xE797,
+	0xA6F7: 0xE798,
+	0xA6F8: 0xE799,
+	0xA6F9: 0xE79A,
+	0xA6FA: 0xE79B,
+	0xA6FB: 0xE79C,
+	0xA6FC: 0xE79D,
+	0xA6FD: 0xE79E,
+	0xA6FE: 0xE79F,
+	0xA7C2: 0xE7A0,
+	0xA7C3: 0xE7A1,
+	0xA7C4: 0xE7A2,
+	0xA7C5: 0xE7A3,
+	0xA7C6: 0xE7A4,
+	0xA7C7: 0xE7A5,
+	0xA7C8: 0xE7A6,
+	0xA7C9: 0xE7A7,
+	0xA7CA: 0xE7A8,
+	0xA7CB: 0xE7A9,
+	0xA7CC: 0xE7AA,
+	0xA7CD: 0xE7AB,
+	0xA7CE: 0xE7AC,
+	0xA7CF: 0xE7AD,
+	0xA7D0: 0xE7AE,
+	0xA7F2: 0xE7AF,
+	0xA7F3: 0xE7B0,
+	0xA7F4: 0xE7B1,
+	0xA7F5: 0xE7B2,
+	0xA7F6: 0xE7B3,
+	0xA7F7: 0xE7B4,
+	0xA7F8: 0xE7B5,
+	0xA7F9: 0xE7B6,
+	0xA7FA: 0xE7B7,
+	0xA7FB: 0xE7B8,
+	0xA7FC: 0xE7B9,
+	0xA7FD: 0xE7BA,
+	0xA7FE: 0xE7BB,
+	0xA896: 0xE7BC,
+	0xA897: 0xE7BD,
+	0xA898: 0xE7BE,
+	0xA899: 0xE7BF,
+	0xA89A: 0xE7C0,
+	0xA89B: 0xE7C1,
+	0xA89C: 0xE7C2,
+	0xA89D: 0xE7C3,
+	0xA89E: 0xE7C4,
+	0xA89F: 0xE7C5,
+	0xA8A0: 0xE7C6,
+	0xA8BC: 0x1E3F, // 0xE7C7 until GB 18030-2005
+	0xA8BF: 0x01F9,
+	0xA8C1: 0xE7C9,
+	0xA8C2: 0xE7CA,
+	0xA8C3: 0xE7CB,
+	0xA8C4: 0xE7CC,
+	0xA8EA: 0xE7CD,
+	0xA8EB: 0xE7CE,
+	0xA8EC: 0xE7CF,
+	0xA8ED: 0xE7D0,
+	0xA8EE: 0xE7D1,
+	0xA8EF: 0xE7D2,
+	0xA8F0: 0xE7D3,
+	0xA8F1: 0xE7D4,
+	0xA8F2: 0xE7D5,
+	0xA8F3: 0xE7D6,
+	0xA8F4: 0xE7D7,
+	0xA8F5: 0xE7D8,
+	0xA8F6: 0xE7D9,
+	0xA8F7: 0xE7DA,
+	0xA8F8: 0xE7DB,
+	0xA8F9: 0xE7DC,
+	0xA8FA: 0xE7DD,
+	0xA8FB: 0xE7DE,
+	0xA8FC: 0xE7DF,
+	0xA8FD: 0xE7E0,
+	0xA8FE: 0xE7E1,
+	0xA958: 0xE7E2,
+	0xA95B: 0xE7E3,
+	0xA95D: 0xE7E4,
+	0xA95E: 0xE7E5,
+	0xA95F: 0xE7E6,
+	0xA989: 0x303E,
+	0xA98A: 0x2FF0,
+	0xA98B: 0x2FF1,
+	0xA98C: 0x2FF2,
+	0xA98D: 0x2FF3,
+	0xA98E: 0x2FF4,
+	0xA98F: 0x2FF5,
+	0xA990: 0x2FF6,
+	0xA991: 0x2FF7,
+	0xA992: 0x2FF8,
+	0xA993: 0x2FF9,
+	0xA994: 0x2FFA,
+	0xA995: 0x2FFB,
+	0xA997: 0xE7F4,
+	0xA998: 0xE7F5,
+	0xA999: 0xE7F6,
+	0xA99A: 0xE7F7,
+	0xA99B: 0xE7F8,
+	0xA99C: 0xE7F9,
+	0xA99D: 0xE7FA,
+	0xA99E: 0xE7FB,
+	0xA99F: 0xE7FC,
+	0xA9A0: 0xE7FD,
+	0xA9A1: 0xE7FE,
+	0xA9A2: 0xE7FF,
+	0xA9A3: 0xE800,
+	0xA9F0: 0xE801,
+	0xA9F1: 0xE802,
+	0xA9F2: 0xE803,
+	0xA9F3: 0xE804,
+	0xA9F4: 0xE805,
+	0xA9F5: 0xE806,
+	0xA9F6: 0xE807,
+	0xA9F7: 0xE808,
+	0xA9F8: 0xE809,
+	0xA9F9: 0xE80A,
+	0xA9FA: 0xE80B,
+	0xA9FB: 0xE80C,
+	0xA9FC: 0xE80D,
+	0xA9FD: 0xE80E,
+	0xA9FE: 0xE80F,
+	0xD7FA: 0xE810,
+	0xD7FB: 0xE811,
+	0xD7FC: 0xE812,
+	0xD7FD: 0xE813,
+	0xD7FE: 0xE814,
+	0xFE50: 0x2E81,
+	0xFE51: 0xE816,
+	0xFE52: 0xE817,
+	0xFE53: 0xE818,
+	0xFE54: 0x2E84,
+	0xFE55: 0x3473,
+	0xFE56: 0x3447,
+	0xFE57: 0x2E88,
+	0xFE58: 0x2E8B,
+	0xFE59: 0x9FB4, // 0xE81E until GB 18030-2022
+	0xFE5A: 0x359E,
+	0xFE5B: 0x361A,
+	0xFE5C: 0x360E,
+	0xFE5D: 0x2E8C,
+	0xFE5E: 0x2E97,
+	0xFE5F: 0x396E,
+	0xFE60: 0x3918,
+	0xFE61: 0x9FB5, // 0xE826 until GB 18030-2022
+	0xFE62: 0x39CF,
+	0xFE63: 0x39DF,
+	0xFE64: 0x3A73,
+	0xFE65: 0x39D0,
+	0xFE66: 0x9FB6, // 0xE82B until GB 18030-2022
+	0xFE67: 0x9FB7, // 0xE82C until GB 18030-2022
+	0xFE68: 0x3B4E,
+	0xFE69: 0x3C6E,
+	0xFE6A: 0x3CE0,
+	0xFE6B: 0x2EA7,
+	0xFE6C: 0xE831,
+	0xFE6D: 0x9FB8, // 0xE832 until GB 18030-2022
+	0xFE6E: 0x2EAA,
+	0xFE6F: 0x4056,
+	0xFE70: 0x415F,
+	0xFE71: 0x2EAE,
+	0xFE72: 0x4337,
+	0xFE73: 0x2EB3,
+	0xFE74: 0x2EB6,
+	0xFE75: 0x2EB7,
+	0xFE76: 0xE83B,
+	0xFE77: 0x43B1,
+	0xFE78: 0x43AC,
+	0xFE79: 0x2EBB,
+	0xFE7A: 0x43DD,
+	0xFE7B: 0x44D6,
+	0xFE7C: 0x4661,
+	0xFE7D: 0x464C,
+	0xFE7E: 0x9FB9, // 0xE843 until GB 18030-2022
+	0xFE80: 0x4723,
+	0xFE81: 0x4729,
+	0xFE82: 0x477C,
+	0xFE83: 0x478D,
+	0xFE84: 0x2ECA,
+	0xFE85: 0x4947,
+	0xFE86: 0x497A,
+	0xFE87: 0x497D,
+	0xFE88: 0x4982,
+	0xFE89: 0x4983,
+	0xFE8A: 0x4985,
+	0xFE8B: 0x4986,
+	0xFE8C: 0x499F,
+	0xFE8D: 0x499B,
+	0xFE8E: 0x49B7,
+	0xFE8F: 0x49B6,
+	0xFE90: 0x9FBA, // 0xE854 until GB 18030-2022
+	0xFE91: 0xE855,
+	0xFE92: 0x4CA3,
+	0xFE93: 0x4C9F,
+	0xFE94: 0x4CA0,
+	0xFE95: 0x4CA1,
+	0xFE96: 0x4C77,
+	0xFE97: 0x4CA2,
+	0xFE98: 0x4D13,
+	0xFE99: 0x4D14,
+	0xFE9A: 0x4D15,
+	0xFE9B: 0x4D16,
+	0xFE9C: 0x4D17,
+	0xFE9D: 0x4D18,
+	0xFE9E: 0x4D19,
+	0xFE9F: 0x4DAE,
+	0xFEA0: 0x9FBB, // 0xE864 until GB 18030-2022
+}
+
+// gb18030Ranges lists the four-byte codes mapped to the BMP as runs of
+// consecutive characters: the linear index of each run's first code and its
+// character. The characters between runs have two-byte codes.
+var gb18030Ranges = [...][2]uint32 {
+	{0, 0x0080},
+	{36, 0x00A5},
+	{38, 0x00A9},
+	{45, 0x00B2},
+	{50, 0x00B8},
+	{81, 0x00D8},
+	{89, 0x00E2},
+	{95, 0x00EB},
+	{96, 0x00EE},
+	{100, 0x00F4},
+	{103, 0x00F8},
+	{104, 0x00FB},
+	{105, 0x00FD},
+	{109, 0x0102},
+	{126, 0x0114},
+	{133, 0x011C},
+	{148, 0x012C},
+	{172, 0x0145},
+	{175, 0x0149},
+	{179, 0x014E},
+	{208, 0x016C},
+	{306, 0x01CF},
+	{307, 0x01D1},
+	{308, 0x01D3},
+	{309, 0x01D5},
+	{310, 0x01D7},
+	{311, 0x01D9},
+	{312, 0x01DB},
+	{313, 0x01DD},
+	{341, 0x01FA},
+	{428, 0x0252},
+	{443, 0x0262},
+	{544, 0x02C8},
+	{545, 0x02CC},
+	{558, 0x02DA},
+	{741, 0x03A2},
+	{742, 0x03AA},
+	{749, 0x03C2},
+	{750, 0x03CA},
+	{805, 0x0402},
+	{819, 0x0450},
+	{820, 0x0452},
+	{7922, 0x2011},
+	{7924, 0x2017},
+	{7925, 0x201A},
+	{7927, 0x201E},
+	{7934, 0x2027},
+	{7943, 0x2031},
+	{7944, 0x2034},
+	{7945, 0x2036},
+	{7950, 0x203C},
+	{8062, 0x20AD},
+	{8148, 0x2104},
+	{8149, 0x2106},
+	{8152, 0x210A},
+	{8164, 0x2117},
+	{8174, 0x2122},
+	{8236, 0x216C},
+	{8240, 0x217A},
+	{8262, 0x2194},
+	{8264, 0x219A},
+	{8374, 0x2209},
+	{8380, 0x2210},
+	{8381, 0x2212},
+	{8384, 0x2216},
+	{8388, 0x221B},
+	{8390, 0x2221},
+	{8392, 0x2224},
+	{8393, 0x2226},
+	{8394, 0x222C},
+	{8396, 0x222F},
+	{8401, 0x2238},
+	{8406, 0x223E},
+	{8416, 0x2249},
+	{8419, 0x224D},
+	{8424, 0x2253},
+	{8437, 0x2262},
+	{8439, 0x2268},
+	{8445, 0x2270},
+	{8482, 0x2296},
+	{8485, 0x229A},
+	{8496, 0x22A6},
+	{8521, 0x22C0},
+	{8603, 0x2313},
+	{8936, 0x246A},
+	{8946, 0x249C},
+	{9046, 0x254C},
+	{9050, 0x2574},
+	{9063, 0x2590},
+	{9066, 0x2596},
+	{9076, 0x25A2},
+	{9092, 0x25B4},
+	{9100, 0x25BE},
+	{9108, 0x25C8},
+	{9111, 0x25CC},
+	{9113, 0x25D0},
+	{9131, 0x25E6},
+	{9162, 0x2607},
+	{9164, 0x260A},
+	{9218, 0x2641},
+	{9219, 0x2643},
+	{11329, 0x2E82},
+	{11331, 0x2E85},
+	{11334, 0x2E89},
+	{11336, 0x2E8D},
+	{11346, 0x2E98},
+	{11361, 0x2EA8},
+	{11363, 0x2EAB},
+	{11366, 0x2EAF},
+	{11370, 0x2EB4},
+	{11372, 0x2EB8},
+	{11375, 0x2EBC},
+	{11389, 0x2ECB},
+	{11682, 0x2FFC},
+	{11686, 0x3004},
+	{11687, 0x3018},
+	{11692, 0x301F},
+	{11694, 0x302A},
+	{11714, 0x303F},
+	{11716, 0x3094},
+	{11723, 0x309F},
+	{11725, 0x30F7},
+	{11730, 0x30FF},
+	{11736, 0x312A},
+	{11982, 0x322A},
+	{11989, 0x3232},
+	{12102, 0x32A4},
+	{12336, 0x3390},
+	{12348, 0x339F},
+	{12350, 0x33A2},
+	{12384, 0x33C5},
+	{12393, 0x33CF},
+	{12395, 0x33D3},
+	{12397, 0x33D6},
+	{12510, 0x3448},
+	{12553, 0x3474},
+	{12851, 0x359F},
+	{12962, 0x360F},
+	{12973, 0x361B},
+	{13738, 0x3919},
+	{13823, 0x396F},
+	{13919, 0x39D1},
+	{13933, 0x39E0},
+	{14080, 0x3A74},
+	{14298, 0x3B4F},
+	{14585, 0x3C6F},
+	{14698, 0x3CE1},
+	{15583, 0x4057},
+	{15847, 0x4160},
+	{16318, 0x4338},
+	{16434, 0x43AD},
+	{16438, 0x43B2},
+	{16481, 0x43DE},
+	{16729, 0x44D7},
+	{17102, 0x464D},
+	{17122, 0x4662},
+	{17315, 0x4724},
+	{17320, 0x472A},
+	{17402, 0x477D},
+	{17418, 0x478E},
+	{17859, 0x4948},
+	{17909, 0x497B},
+	{17911, 0x497E},
+	{17915, 0x4984},
+	{17916, 0x4987},
+	{17936, 0x499C},
+	{17939, 0x49A0},
+	{17961, 0x49B8},
+	{18664, 0x4C78},
+	{18703, 0x4CA4},
+	{18814, 0x4D1A},
+	{18962, 0x4DAF},
+	{19043, 0x9FA6},
+	{33469, 0xE76C},
+	{33470, 0xE7C8},
+	{33471, 0xE7E7},
+	{33484, 0xE815},
+	{33485, 0xE819},
+	{33490, 0xE81F},
+	{33497, 0xE827},
+	{33501, 0xE82D},
+	{33505, 0xE833},
+	{33513, 0xE83C},
+	{33520, 0xE844},
+	{33536, 0xE856},
+	{33550, 0xE865},
+	{37845, 0xF92D},
+	{37921, 0xF97A},
+	{37948, 0xF996},
+	{38029, 0xF9E8},
+	{38038, 0xF9F2},
+	{38064, 0xFA10},
+	{38065, 0xFA12},
+	{38066, 0xFA15},
+	{38069, 0xFA19},
+	{38075, 0xFA22},
+	{38076, 0xFA25},
+	{38078, 0xFA2A},
+	{39108, 0xFE32},
+	{39109, 0xFE45},
+	{39113, 0xFE53},
+	{39114, 0xFE58},
+	{39115, 0xFE67},
+	{39116, 0xFE6C},
+	{39265, 0xFF5F},
+	{39394, 0xFFE6},
+}
+
+// gb18030FourByteOverrides lists the four-byte codes deviating from
+// gb18030Ranges. Where a revision of the standard moved a two-byte code from a
+// private use character to a standard one, the four-byte code previously
+// mapped to the latter now takes over the former, so that both still
+// round-trip.
+var gb18030FourByteOverrides = map[uint32]rune {
+	0x8135F437: 0xE7C7, // U+1E3F until GB 18030-2005
+	0x84318236: 0xE78D, // U+FE10 until GB 18030-2022
+	0x84318238: 0xE78E, // U+FE12 until GB 18030-2022
+	0x84318237: 0xE78F, // U+FE11 until GB 18030-2022
+	0x84318239: 0xE790, // U+FE13 until GB 18030-2022
+	0x84318330: 0xE791, // U+FE14 until GB 18030-2022
+	0x84318331: 0xE792, // U+FE15 until GB 18030-2022
+	0x84318332: 0xE793, // U+FE16 until GB 18030-2022
+	0x84318333: 0xE794, // U+FE17 until GB 18030-2022
+	0x84318334: 0xE795, // U+FE18 until GB 18030-2022
+	0x84318335: 0xE796, // U+FE19 until GB 18030-2022
+	0x82359037: 0xE81E, // U+9FB4 until GB 18030-2022
+	0x82359038: 0xE826, // U+9FB5 until GB 18030-2022
+	0x82359039: 0xE82B, // U+9FB6 until GB 18030-2022
+	0x82359130: 0xE82C, // U+9FB7 until GB 18030-2022
+	0x82359131: 0xE832, // U+9FB8 until GB 18030-2022
+	0x82359132: 0xE843, // U+9FB9 until GB 18030-2022
+	0x82359133: 0xE854, // U+9FBA until GB 18030-2022
+	0x82359134: 0xE864, // U+9FBB until GB 18030-2022
+}
@@ -0,0 +1,37 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestGB18030RoundTrip(t *testing.T) {
+	checkRoundTrip(
+		t,
+		"GB18030",
+		[]byte("\xD6\xD0\xCE\xC4\xA2\xE3\x94\x32\xBE\x34\xA8\xA6\x81\x30\x81\x30"),
+		"中文€𝄞é\u0080",
+	)
+	// the ends of the four-byte ranges
+	checkRoundTrip(
+		t,
+		"GB18030",
+		[]byte("\x84\x31\xA4\x39\x90\x30\x81\x30\xE3\x32\x9A\x35\x95\x32\x90\x31"),
+		"\uFFFF\U00010000\U0010FFFF\U00020087",
+	)
+}
+
+func TestGB18030Version2022(t *testing.T) {
+	// moved out of the private use area, which is coded with four bytes now
+	checkRoundTrip(t, "GB18030", []byte("\xA6\xD9\x84\x31\x82\x36"), "︐\uE78D")
+	checkRoundTrip(t, "GB18030", []byte("\xA8\xBC\x81\x35\xF4\x37"), "ḿ\uE7C7")
+	// but not all of it
+	checkRoundTrip(t, "GB18030", []byte("\xFE\x51"), "\uE816")
+}
+
+func TestGB18030Errors(t *testing.T) {
+	// just past the ends of the four-byte ranges
+	checkDecode(t, "GB18030", []byte("\x84\x31\xA5\x30a\xE3\x32\x9A\x36b"), "�a�b", 0, 5)
+	checkDecode(t, "GB18030", []byte("a\x81\x30\x81"), "a�", 1)
+	checkDecode(t, "GB18030", []byte("\x81\x30\xFFa"), "�a", 0)
+	checkDecode(t, "GB18030", []byte("\x80\xFF"), "��", 0, 1)
+}
@@ -0,0 +1,20 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestGBKRoundTrip(t *testing.T) {
+	checkRoundTrip(t, "GBK", []byte("\xD6\xD0\xCE\xC4\xBA\xBA\xD7\xD6\x81\x40"), "中文汉字丂")
+	checkRoundTrip(t, "CP936", []byte("\xD6\xD0\x80"), "中€")
+	checkRoundTrip(t, "GB2312", []byte("\xD6\xD0\xCE\xC4abc"), "中文abc")
+}
+
+func TestGBKErrors(t *testing.T) {
+	checkDecode(t, "GBK", []byte("\x80\x81\x40\xFF\xA1"), "�丂��", 0, 3, 4)
+	checkDecode(t, "GBK", []byte("\x81\x7Fb"), "�\x7Fb", 0)
+	checkEncode(t, "GBK", "€丂", []byte("\x00\x81\x40"), 0)
+	// GB2312 has no GBK extensions
+	checkDecode(t, "GB2312", []byte("\x81\x40a"), "�@a", 0)
+	checkEncode(t, "GB2312", "€丂中", []byte("\x00\x00\xD6\xD0"), 0, 1)
+}
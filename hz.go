@@ -0,0 +1,29 @@
+package gotextenc
+
+const (
+	hz_ESCAPE byte = '~'
+	hz_GB_MODE byte = '{'
+	hz_ASCII_MODE byte = '}'
+)
+
+var hzNames = []string {
+	"HZ-GB-2312",
+	"HZ",
+}
+
+func init() {
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &HZDecoder[uint16]{}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &HZDecoder[rune]{}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &HZEncoder[uint16]{}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &HZEncoder[rune]{}
+		},
+	}, hzNames...)
+}
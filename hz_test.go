@@ -0,0 +1,30 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestHZRoundTrip(t *testing.T) {
+	checkRoundTrip(t, "HZ-GB-2312", []byte("a~{VPND~}b"), "a中文b")
+	// GB mode ends before line breaks and tildes are doubled
+	checkRoundTrip(t, "HZ-GB-2312", []byte("~{VP~}~~~{ND~}\n~{VP~}"), "中~文\n中")
+	checkRoundTrip(t, "HZ", []byte("~~"), "~")
+}
+
+func TestHZDecodeLenient(t *testing.T) {
+	// line continuation
+	checkDecode(t, "HZ", []byte("a~\nb"), "ab")
+	// a line break ends GB mode, as does the end of input
+	checkDecode(t, "HZ", []byte("~{VP\nb"), "中\nb")
+	checkDecode(t, "HZ", []byte("~{VPND"), "中文")
+}
+
+func TestHZErrors(t *testing.T) {
+	checkDecode(t, "HZ", []byte("~x"), "�x", 0)
+	checkDecode(t, "HZ", []byte("~{V"), "�", 2)
+	checkDecode(t, "HZ", []byte("~{V\x01"), "��", 2, 3)
+	checkDecode(t, "HZ", []byte("~{VPzz~}"), "中�", 4)
+	checkDecode(t, "HZ", []byte("\xC4"), "�", 0)
+	checkEncode(t, "HZ", "a€b", []byte("a\x00b"), 1)
+	checkEncode(t, "HZ", "中😀", []byte("~{VP\x00~}"), 1)
+}
@@ -0,0 +1,472 @@
+package gotextenc
+
+import (
+	"sync"
+)
+
+// ianaCharset holds what the IANA character set registry records about an
+// encoding.
+type ianaCharset struct {
+	name string
+	// mimeName is empty if it is the same as name
+	mimeName string
+	aliases []string
+	mibEnum uint16
+}
+
+// ianaCharsets lists the entries of the IANA character set registry for the
+// encodings this package implements.
+var ianaCharsets = []ianaCharset {
+	{
+		name: "ISO_8859-1:1987",
+		mimeName: "ISO-8859-1",
+		aliases: []string {
+			"iso-ir-100", "ISO_8859-1", "ISO-8859-1", "latin1", "l1", "IBM819",
+			"CP819", "csISOLatin1",
+		},
+		mibEnum: 4,
+	},
+	{
+		name: "ISO_8859-2:1987",
+		mimeName: "ISO-8859-2",
+		aliases: []string {
+			"iso-ir-101", "ISO_8859-2", "ISO-8859-2", "latin2", "l2", "csISOLatin2",
+		},
+		mibEnum: 5,
+	},
+	{
+		name: "ISO_8859-3:1988",
+		mimeName: "ISO-8859-3",
+		aliases: []string {
+			"iso-ir-109", "ISO_8859-3", "ISO-8859-3", "latin3", "l3", "csISOLatin3",
+		},
+		mibEnum: 6,
+	},
+	{
+		name: "ISO_8859-4:1988",
+		mimeName: "ISO-8859-4",
+		aliases: []string {
+			"iso-ir-110", "ISO_8859-4", "ISO-8859-4", "latin4", "l4", "csISOLatin4",
+		},
+		mibEnum: 7,
+	},
+	{
+		name: "ISO_8859-5:1988",
+		mimeName: "ISO-8859-5",
+		aliases: []string {
+			"iso-ir-144", "ISO_8859-5", "ISO-8859-5", "cyrillic",
+			"csISOLatinCyrillic",
+		},
+		mibEnum: 8,
+	},
+	{
+		name: "ISO_8859-6:1987",
+		mimeName: "ISO-8859-6",
+		aliases: []string {
+			"iso-ir-127", "ISO_8859-6", "ISO-8859-6", "ECMA-114", "ASMO-708",
+			"arabic", "csISOLatinArabic",
+		},
+		mibEnum: 9,
+	},
+	{
+		name: "ISO_8859-7:1987",
+		mimeName: "ISO-8859-7",
+		aliases: []string {
+			"iso-ir-126", "ISO_8859-7", "ISO-8859-7", "ELOT_928", "ECMA-118", "greek",
+			"greek8", "csISOLatinGreek",
+		},
+		mibEnum: 10,
+	},
+	{
+		name: "ISO_8859-8:1988",
+		mimeName: "ISO-8859-8",
+		aliases: []string {
+			"iso-ir-138", "ISO_8859-8", "ISO-8859-8", "hebrew", "csISOLatinHebrew",
+		},
+		mibEnum: 11,
+	},
+	{
+		name: "ISO_8859-9:1989",
+		mimeName: "ISO-8859-9",
+		aliases: []string {
+			"iso-ir-148", "ISO_8859-9", "ISO-8859-9", "latin5", "l5", "csISOLatin5",
+		},
+		mibEnum: 12,
+	},
+	{
+		name: "ISO-8859-10",
+		aliases: []string {
+			"iso-ir-157", "l6", "ISO_8859-10:1992", "csISOLatin6", "latin6",
+		},
+		mibEnum: 13,
+	},
+	{
+		name: "ISO_6937-2-add",
+		aliases: []string {"iso-ir-142", "csISOTextComm"},
+		mibEnum: 14,
+	},
+	{
+		name: "Shift_JIS",
+		aliases: []string {"MS_Kanji", "csShiftJIS"},
+		mibEnum: 17,
+	},
+	{
+		name: "Extended_UNIX_Code_Packed_Format_for_Japanese",
+		mimeName: "EUC-JP",
+		aliases: []string {"csEUCPkdFmtJapanese", "EUC-JP"},
+		mibEnum: 18,
+	},
+	{
+		name: "ISO-2022-KR",
+		aliases: []string {"csISO2022KR"},
+		mibEnum: 37,
+	},
+	{
+		name: "EUC-KR",
+		aliases: []string {"csEUCKR"},
+		mibEnum: 38,
+	},
+	{
+		name: "ISO-2022-JP",
+		aliases: []string {"csISO2022JP"},
+		mibEnum: 39,
+	},
+	{
+		name: "ISO-2022-JP-2",
+		aliases: []string {"csISO2022JP2"},
+		mibEnum: 40,
+	},
+	{
+		name: "T.61-8bit",
+		aliases: []string {"T.61", "iso-ir-103", "csISO103T618bit"},
+		mibEnum: 76,
+	},
+	{
+		name: "ISO-2022-CN",
+		aliases: []string {"csISO2022CN"},
+		mibEnum: 104,
+	},
+	{
+		name: "ISO-2022-CN-EXT",
+		aliases: []string {"csISO2022CNEXT"},
+		mibEnum: 105,
+	},
+	{
+		name: "UTF-8",
+		aliases: []string {"csUTF8"},
+		mibEnum: 106,
+	},
+	{
+		name: "ISO-8859-13",
+		aliases: []string {"csISO885913"},
+		mibEnum: 109,
+	},
+	{
+		name: "ISO-8859-14",
+		aliases: []string {
+			"iso-ir-199", "ISO_8859-14:1998", "ISO_8859-14", "latin8", "iso-celtic",
+			"l8", "csISO885914",
+		},
+		mibEnum: 110,
+	},
+	{
+		name: "ISO-8859-15",
+		aliases: []string {"ISO_8859-15", "Latin-9", "csISO885915"},
+		mibEnum: 111,
+	},
+	{
+		name: "ISO-8859-16",
+		aliases: []string {
+			"iso-ir-226", "ISO_8859-16:2001", "ISO_8859-16", "latin10", "l10",
+			"csISO885916",
+		},
+		mibEnum: 112,
+	},
+	{
+		name: "GBK",
+		aliases: []string {"CP936", "MS936", "windows-936", "csGBK"},
+		mibEnum: 113,
+	},
+	{
+		name: "GB18030",
+		aliases: []string {"csGB18030"},
+		mibEnum: 114,
+	},
+	{
+		name: "UTF-16BE",
+		aliases: []string {"csUTF16BE"},
+		mibEnum: 1013,
+	},
+	{
+		name: "UTF-16LE",
+		aliases: []string {"csUTF16LE"},
+		mibEnum: 1014,
+	},
+	{
+		name: "Windows-31J",
+		aliases: []string {"csWindows31J"},
+		mibEnum: 2024,
+	},
+	{
+		name: "GB2312",
+		aliases: []string {"csGB2312"},
+		mibEnum: 2025,
+	},
+	{
+		name: "Big5",
+		aliases: []string {"csBig5"},
+		mibEnum: 2026,
+	},
+	{
+		name: "macintosh",
+		aliases: []string {"mac", "csMacintosh"},
+		mibEnum: 2027,
+	},
+	{
+		name: "KOI8-R",
+		aliases: []string {"csKOI8R"},
+		mibEnum: 2084,
+	},
+	{
+		name: "HZ-GB-2312",
+		mibEnum: 2085,
+	},
+	{
+		name: "IBM866",
+		aliases: []string {"cp866", "866", "csIBM866"},
+		mibEnum: 2086,
+	},
+	{
+		name: "KOI8-U",
+		aliases: []string {"csKOI8U"},
+		mibEnum: 2088,
+	},
+	{
+		name: "Big5-HKSCS",
+		aliases: []string {"csBig5HKSCS"},
+		mibEnum: 2101,
+	},
+	{
+		name: "windows-874",
+		aliases: []string {"cswindows874"},
+		mibEnum: 2109,
+	},
+	{
+		name: "windows-1250",
+		aliases: []string {"cswindows1250"},
+		mibEnum: 2250,
+	},
+	{
+		name: "windows-1251",
+		aliases: []string {"cswindows1251"},
+		mibEnum: 2251,
+	},
+	{
+		name: "windows-1252",
+		aliases: []string {"cswindows1252"},
+		mibEnum: 2252,
+	},
+	{
+		name: "windows-1253",
+		aliases: []string {"cswindows1253"},
+		mibEnum: 2253,
+	},
+	{
+		name: "windows-1254",
+		aliases: []string {"cswindows1254"},
+		mibEnum: 2254,
+	},
+	{
+		name: "windows-1255",
+		aliases: []string {"cswindows1255"},
+		mibEnum: 2255,
+	},
+	{
+		name: "windows-1256",
+		aliases: []string {"cswindows1256"},
+		mibEnum: 2256,
+	},
+	{
+		name: "windows-1257",
+		aliases: []string {"cswindows1257"},
+		mibEnum: 2257,
+	},
+	{
+		name: "windows-1258",
+		aliases: []string {"cswindows1258"},
+		mibEnum: 2258,
+	},
+	{
+		name: "TIS-620",
+		aliases: []string {"csTIS620", "ISO-8859-11"},
+		mibEnum: 2259,
+	},
+}
+
+var ianaCharsetOnce sync.Once
+// ianaCharsetNameMap and ianaCharsetAliasMap map the normalized names and
+// aliases of the entries of ianaCharsets to their index.
+var ianaCharsetNameMap map[string]int
+var ianaCharsetAliasMap map[string]int
+var ianaCharsetMIBMap map[uint16]int
+
+func initIANACharsets() {
+	ianaCharsetOnce.Do(func() {
+		ianaCharsetNameMap = make(map[string]int)
+		ianaCharsetAliasMap = make(map[string]int)
+		ianaCharsetMIBMap = make(map[uint16]int)
+		for index, charset := range ianaCharsets {
+			ianaCharsetNameMap[NormalizeEncodingName(charset.name)] = index
+			if charset.mimeName != "" {
+				ianaCharsetNameMap[NormalizeEncodingName(charset.mimeName)] = index
+			}
+			for _, alias := range charset.aliases {
+				ianaCharsetAliasMap[NormalizeEncodingName(alias)] = index
+			}
+			ianaCharsetMIBMap[charset.mibEnum] = index
+		}
+	})
+}
+
+// findIANACharset finds the registry entry for an encoding known by names,
+// preferring one whose name is among them over one that lists them as
+// aliases.
+func findIANACharset(names []string) *ianaCharset {
+	initIANACharsets()
+	for _, name := range names {
+		if index, found := ianaCharsetNameMap[NormalizeEncodingName(name)]; found {
+			return &ianaCharsets[index]
+		}
+	}
+	for _, name := range names {
+		if index, found := ianaCharsetAliasMap[NormalizeEncodingName(name)]; found {
+			return &ianaCharsets[index]
+		}
+	}
+	return nil
+}
+
+// IANAName gives the name the IANA registers the encoding under, or the
+// empty string if it does not.
+func(info *EncodingInfo[IDT, FactoryT]) IANAName() string {
+	if info == nil {
+		return ""
+	}
+	return ianaName(findIANACharset(info.names))
+}
+
+// MIMEName gives the name to use for the encoding in MIME headers such as
+// Content-Type: the preferred MIME name the IANA registers, or else the
+// registered name, or else the first name of the encoding here.
+func(info *EncodingInfo[IDT, FactoryT]) MIMEName() string {
+	if info == nil {
+		return ""
+	}
+	return mimeName(findIANACharset(info.names), info.names)
+}
+
+// IANAAliases gives the aliases the IANA registers for the encoding.
+func(info *EncodingInfo[IDT, FactoryT]) IANAAliases() []string {
+	if info == nil {
+		return nil
+	}
+	return ianaAliases(findIANACharset(info.names))
+}
+
+// MIBenum gives the number the IANA assigns to the encoding, or zero if it
+// does not.
+func(info *EncodingInfo[IDT, FactoryT]) MIBenum() uint16 {
+	if info == nil {
+		return 0
+	}
+	return mibEnum(findIANACharset(info.names))
+}
+
+// IANAName gives the name the IANA registers the charset under, or the
+// empty string if it does not.
+func(charset *Charset) IANAName() string {
+	if charset == nil {
+		return ""
+	}
+	return ianaName(findIANACharset(charset.names))
+}
+
+// MIMEName gives the name to use for the charset in MIME headers, as per
+// EncodingInfo.MIMEName.
+func(charset *Charset) MIMEName() string {
+	if charset == nil {
+		return ""
+	}
+	return mimeName(findIANACharset(charset.names), charset.names)
+}
+
+// IANAAliases gives the aliases the IANA registers for the charset.
+func(charset *Charset) IANAAliases() []string {
+	if charset == nil {
+		return nil
+	}
+	return ianaAliases(findIANACharset(charset.names))
+}
+
+// MIBenum gives the number the IANA assigns to the charset, or zero if it
+// does not.
+func(charset *Charset) MIBenum() uint16 {
+	if charset == nil {
+		return 0
+	}
+	return mibEnum(findIANACharset(charset.names))
+}
+
+func ianaName(iana *ianaCharset) string {
+	if iana == nil {
+		return ""
+	}
+	return iana.name
+}
+
+func mimeName(iana *ianaCharset, names []string) string {
+	switch {
+		case iana == nil && len(names) > 0:
+			return names[0]
+		case iana == nil:
+			return ""
+		case iana.mimeName != "":
+			return iana.mimeName
+		default:
+			return iana.name
+	}
+}
+
+func ianaAliases(iana *ianaCharset) []string {
+	if iana == nil || len(iana.aliases) == 0 {
+		return nil
+	}
+	return append([]string(nil), iana.aliases...)
+}
+
+func mibEnum(iana *ianaCharset) uint16 {
+	if iana == nil {
+		return 0
+	}
+	return iana.mibEnum
+}
+
+// LookupCharsetByMIBenum finds the Charset the IANA assigns mib to, or
+// returns nil.
+func LookupCharsetByMIBenum(mib uint16) *Charset {
+	initIANACharsets()
+	index, found := ianaCharsetMIBMap[mib]
+	if !found {
+		return nil
+	}
+	iana := &ianaCharsets[index]
+	if charset := LookupCharset(iana.name); charset != nil {
+		return charset
+	}
+	for _, alias := range iana.aliases {
+		if charset := LookupCharset(alias); charset != nil {
+			return charset
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestIANACharsets(t *testing.T) {
+	var last uint16
+	for _, iana := range ianaCharsets {
+		if iana.mibEnum <= last {
+			t.Errorf("%s out of order at MIBenum %d", iana.name, iana.mibEnum)
+		}
+		last = iana.mibEnum
+		charset := LookupCharsetByMIBenum(iana.mibEnum)
+		if charset == nil {
+			// left to the packages implementing it
+			continue
+		}
+		if charset.MIBenum() != iana.mibEnum || charset.IANAName() != iana.name {
+			t.Errorf(
+				"MIBenum %d gives %s, which has MIBenum %d and IANA name %s",
+				iana.mibEnum,
+				charset.Names()[0],
+				charset.MIBenum(),
+				charset.IANAName(),
+			)
+		}
+	}
+}
+
+func TestIANAMetadata(t *testing.T) {
+	latin1 := LookupCharset("latin1")
+	if latin1.MIBenum() != 4 || latin1.IANAName() != "ISO_8859-1:1987" || latin1.MIMEName() != "ISO-8859-1" {
+		t.Errorf("ISO-8859-1 is %d %s %s", latin1.MIBenum(), latin1.IANAName(), latin1.MIMEName())
+	}
+	if aliases := latin1.IANAAliases(); len(aliases) != 8 || aliases[3] != "latin1" {
+		t.Errorf("ISO-8859-1 has aliases %v", aliases)
+	}
+	// no preferred MIME name: the registered name serves
+	if windows := LookupCharset("cp1252"); windows.MIBenum() != 2252 || windows.MIMEName() != "windows-1252" {
+		t.Errorf("windows-1252 is %d %s", windows.MIBenum(), windows.MIMEName())
+	}
+	info := LookupEncoding41("utf8").Info()
+	if info.MIBenum() != 106 || info.IANAName() != "UTF-8" || info.MIMEName() != "UTF-8" {
+		t.Errorf("UTF-8 is %d %s %s", info.MIBenum(), info.IANAName(), info.MIMEName())
+	}
+	if LookupCharsetByMIBenum(17).Names()[0] != "Shift_JIS" || LookupCharsetByMIBenum(2084).Names()[0] != "KOI8-R" {
+		t.Errorf("MIBenum lookup gives the wrong Charset")
+	}
+}
+
+func TestIANAUnregistered(t *testing.T) {
+	charset := LookupCharset("x-user-defined")
+	if charset.MIBenum() != 0 || charset.IANAName() != "" || charset.IANAAliases() != nil {
+		t.Errorf("x-user-defined has IANA metadata")
+	}
+	// the name of the encoding here is used instead
+	if charset.MIMEName() != "x-user-defined" {
+		t.Errorf("x-user-defined has MIME name %s", charset.MIMEName())
+	}
+	if LookupCharsetByMIBenum(0) != nil || LookupCharsetByMIBenum(65535) != nil {
+		t.Errorf("Charset found for an unassigned MIBenum")
+	}
+	var none *Charset
+	if none.MIBenum() != 0 || none.MIMEName() != "" {
+		t.Errorf("nil Charset has IANA metadata")
+	}
+}
@@ -0,0 +1,211 @@
+package gotextenc
+
+import (
+	"sync"
+)
+
+// ISCIIScript selects one of the nine Indic scripts covered by ISCII-91. The
+// values follow the order of the corresponding Unicode blocks, which lie 0x80
+// apart starting at U+0900.
+type ISCIIScript uint8
+
+const (
+	ISCIISCR_DEVANAGARI ISCIIScript = iota
+	ISCIISCR_BENGALI
+	ISCIISCR_GURMUKHI
+	ISCIISCR_GUJARATI
+	ISCIISCR_ORIYA
+	ISCIISCR_TAMIL
+	ISCIISCR_TELUGU
+	ISCIISCR_KANNADA
+	ISCIISCR_MALAYALAM
+	isciiscr_COUNT
+)
+
+const (
+	iscii_INV byte = 0xD9
+	iscii_HALANT byte = 0xE8
+	iscii_NUKTA byte = 0xE9
+	iscii_DANDA byte = 0xEA
+	iscii_ATR byte = 0xEF
+	iscii_EXT byte = 0xF0
+)
+
+const (
+	isciiZWNJ rune = 0x200C
+	isciiZWJ rune = 0x200D
+)
+
+// isciiHigh maps 0xA0..0xFF to Devanagari; the other scripts are derived by
+// moving the result into the respective Unicode block. INV, ATR and EXT are
+// handled separately.
+var isciiHigh = [96]rune {
+	0x0000, 0x0901, 0x0902, 0x0903, 0x0905, 0x0906, 0x0907, 0x0908,
+	0x0909, 0x090A, 0x090B, 0x090E, 0x090F, 0x0910, 0x090D, 0x0912,
+	0x0913, 0x0914, 0x0911, 0x0915, 0x0916, 0x0917, 0x0918, 0x0919,
+	0x091A, 0x091B, 0x091C, 0x091D, 0x091E, 0x091F, 0x0920, 0x0921,
+	0x0922, 0x0923, 0x0924, 0x0925, 0x0926, 0x0927, 0x0928, 0x0929,
+	0x092A, 0x092B, 0x092C, 0x092D, 0x092E, 0x092F, 0x095F, 0x0930,
+	0x0931, 0x0932, 0x0933, 0x0934, 0x0935, 0x0936, 0x0937, 0x0938,
+	0x0939, 0x0000, 0x093E, 0x093F, 0x0940, 0x0941, 0x0942, 0x0943,
+	0x0946, 0x0947, 0x0948, 0x0945, 0x094A, 0x094B, 0x094C, 0x0949,
+	0x094D, 0x093C, 0x0964, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0966, 0x0967, 0x0968, 0x0969, 0x096A, 0x096B, 0x096C,
+	0x096D, 0x096E, 0x096F, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+}
+
+// isciiNuktaForms lists the characters formed by a byte followed by NUKTA.
+// Where the target script lacks the precomposed character, the pair decodes
+// to the base character followed by the script's nukta instead.
+var isciiNuktaForms = map[byte]rune {
+	0xA1: 0x0950, // OM
+	0xA6: 0x090C,
+	0xA7: 0x0961,
+	0xAA: 0x0960,
+	0xB3: 0x0958,
+	0xB4: 0x0959,
+	0xB5: 0x095A,
+	0xBA: 0x095B,
+	0xBF: 0x095C,
+	0xC0: 0x095D,
+	0xC9: 0x095E,
+	0xDB: 0x0962,
+	0xDC: 0x0963,
+	0xDF: 0x0944,
+	0xEA: 0x093D, // avagraha
+}
+
+// isciiExtForms lists the characters reachable through EXT.
+var isciiExtForms = map[byte]rune {
+	0xB8: 0x0952, // stress sign anudatta
+	0xBF: 0x0970, // abbreviation sign
+}
+
+// isciiScriptMasks flags the code points assigned in each script's Unicode
+// block, so that ISCII positions a script does not use are rejected.
+var isciiScriptMasks = [isciiscr_COUNT][2]uint64 {
+	{0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF}, // Devanagari
+	{0xF3C5FDFFFFF99FEF, 0x7FFFFFCFB080799F}, // Bengali
+	{0xD36DFDFFFFF987EE, 0x007FFFC05E023987}, // Gurmukhi
+	{0xF3EDFDFFFFFBBFEE, 0xFE03FFCF00013BBF}, // Gujarati
+	{0xF3EDFDFFFFF99FEE, 0x00FFFFCFB0E0399F}, // Oriya
+	{0xC3FFC718D63DC7EC, 0x07FFFFC000813DC7}, // Tamil
+	{0xF3FFFDFFFFFDDFFF, 0xFF80FFCF27603DDF}, // Telugu
+	{0xF3EFFDFFFFFDDFFF, 0x0006FFCF60603DDF}, // Kannada
+	{0xFFFFFFFFFFFDDFFF, 0xFFFFFFCFFFF0FDDF}, // Malayalam
+}
+
+// isciiATRCodes are the font attribute codes following ATR that select the
+// respective script.
+var isciiATRCodes = [isciiscr_COUNT]byte {
+	ISCIISCR_DEVANAGARI: 0x42,
+	ISCIISCR_BENGALI: 0x43,
+	ISCIISCR_GURMUKHI: 0x4B,
+	ISCIISCR_GUJARATI: 0x4A,
+	ISCIISCR_ORIYA: 0x47,
+	ISCIISCR_TAMIL: 0x44,
+	ISCIISCR_TELUGU: 0x45,
+	ISCIISCR_KANNADA: 0x48,
+	ISCIISCR_MALAYALAM: 0x49,
+}
+
+// isciiATRScript returns the script selected by the attribute code following
+// ATR. Other attribute codes (display attributes and the remaining font
+// attributes) are valid, but do not select a script.
+func isciiATRScript(code byte) (script ISCIIScript, selects bool, valid bool) {
+	if code == 0x46 {
+		// Assamese is written in Bengali script
+		return ISCIISCR_BENGALI, true, true
+	}
+	for index, atrCode := range isciiATRCodes {
+		if atrCode == code {
+			return ISCIIScript(index), true, true
+		}
+	}
+	return 0, false, code >= 0x30 && code < 0x50
+}
+
+// scriptRune moves the Devanagari character r into the block of script and
+// reports whether the result is assigned there. Characters outside the
+// Devanagari block, as well as the dandas, are shared by all scripts.
+func(script ISCIIScript) scriptRune(r rune) (rune, bool) {
+	if r < 0x0900 || r >= 0x0980 || r == 0x0964 || r == 0x0965 {
+		return r, true
+	}
+	if script >= isciiscr_COUNT {
+		return 0, false
+	}
+	offset := uint(r - 0x0900)
+	if isciiScriptMasks[script][offset >> 6] & (1 << (offset & 0x3F)) == 0 {
+		return 0, false
+	}
+	return r + rune(script) * 0x80, true
+}
+
+// isciiSingle returns the Devanagari character denoted by the byte b on its
+// own, or 0 if there is none.
+func isciiSingle(b byte) rune {
+	switch {
+		case b < 0x80:
+			return rune(b)
+		case b == iscii_INV:
+			return isciiZWJ
+		case b >= 0xA0:
+			return isciiHigh[b - 0xA0]
+		default:
+			return 0
+	}
+}
+
+var isciiEncodeOnce sync.Once
+// isciiEncodeMap maps Devanagari characters to one or two ISCII bytes, the
+// first of which is stored in the high byte.
+var isciiEncodeMap map[rune]uint16
+
+func loadISCIIEncodeMap() {
+	isciiEncodeMap = make(map[rune]uint16)
+	for index, char := range isciiHigh {
+		if char != 0 {
+			isciiEncodeMap[char] = uint16(0xA0 + index)
+		}
+	}
+	for b, char := range isciiNuktaForms {
+		isciiEncodeMap[char] = uint16(b) << 8 | uint16(iscii_NUKTA)
+	}
+	for b, char := range isciiExtForms {
+		isciiEncodeMap[char] = uint16(iscii_EXT) << 8 | uint16(b)
+	}
+	isciiEncodeMap[0x0965] = uint16(iscii_DANDA) << 8 | uint16(iscii_DANDA)
+}
+
+var isciiNames = [isciiscr_COUNT][]string {
+	ISCIISCR_DEVANAGARI: {"ISCII-91", "ISCII91", "x-ISCII91", "x-iscii-de"},
+	ISCIISCR_BENGALI: {"x-iscii-be", "x-iscii-as"},
+	ISCIISCR_GURMUKHI: {"x-iscii-pa"},
+	ISCIISCR_GUJARATI: {"x-iscii-gu"},
+	ISCIISCR_ORIYA: {"x-iscii-or"},
+	ISCIISCR_TAMIL: {"x-iscii-ta"},
+	ISCIISCR_TELUGU: {"x-iscii-te"},
+	ISCIISCR_KANNADA: {"x-iscii-ka"},
+	ISCIISCR_MALAYALAM: {"x-iscii-ma"},
+}
+
+func init() {
+	for index, names := range isciiNames {
+		script := ISCIIScript(index)
+		RegisterCharset(CharsetFactories {
+			Decoder12: func() Codec[byte, uint16] {
+				return &ISCIIDecoder[uint16] {Script: script}
+			},
+			Decoder14: func() Codec[byte, rune] {
+				return &ISCIIDecoder[rune] {Script: script}
+			},
+			Encoder21: func() Codec[uint16, byte] {
+				return &ISCIIEncoder[uint16] {Script: script}
+			},
+			Encoder41: func() Codec[rune, byte] {
+				return &ISCIIEncoder[rune] {Script: script}
+			},
+		}, names...)
+	}
+}
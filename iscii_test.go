@@ -0,0 +1,34 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestISCIIRoundTrip(t *testing.T) {
+	checkRoundTrip(t, "ISCII-91", []byte("\xA4\xB3\xDA \xEA"), "अका ।")
+	// explicit and soft halant, nukta form, avagraha and double danda
+	checkRoundTrip(
+		t,
+		"ISCII-91",
+		[]byte("\xB3\xE8\xE8\xB3\xE8\xE9\xB3\xE9\xEA\xE9\xEA\xEA"),
+		"क्‌क्‍क़ऽ॥",
+	)
+	// OM and EXT
+	checkRoundTrip(t, "ISCII-91", []byte("\xA1\xE9\xF0\xB8"), "ॐ॒")
+	checkRoundTrip(t, "x-iscii-ta", []byte("\xB3\xA3"), "கஃ")
+	// KA and NUKTA make QA either way
+	checkEncode(t, "ISCII-91", "क़", []byte("\xB3\xE9"))
+}
+
+func TestISCIIScriptSwitching(t *testing.T) {
+	checkRoundTrip(t, "ISCII-91", []byte("a\xEF\x43\xB3\xEF\x42\xB3"), "aকक")
+}
+
+func TestISCIIErrors(t *testing.T) {
+	checkDecode(t, "ISCII-91", []byte("\xB3\xFF\xEF"), "क��", 1, 2)
+	// characters that would merge with the preceding byte into something
+	// else are not representable
+	checkEncode(t, "ISCII-91", "क़्", []byte("\xB3\xE8\x00"), 2)
+	checkEncode(t, "ISCII-91", "।।", []byte("\xEA\x00"), 1)
+	checkEncode(t, "ISCII-91", "ा््", []byte("\xDA\xE8\x00"), 2)
+}
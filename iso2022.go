@@ -0,0 +1,209 @@
+package gotextenc
+
+const (
+	iso2022_SS3_7BIT byte = 'O'
+	iso2022_LS2 byte = 'n'
+	iso2022_LS3 byte = 'o'
+	iso2022_SS2_8BIT byte = 0x8E
+	iso2022_SS3_8BIT byte = 0x8F
+)
+
+// ISO2022SetKind tells how many characters per byte a graphic character set
+// for ISO2022Decoder and ISO2022Encoder has.
+type ISO2022SetKind uint8
+
+const (
+	// 94 characters, bytes 0x21..0x7E
+	ISO2022SET_94 ISO2022SetKind = iota
+	// 96 characters, bytes 0x20..0x7F; cannot be designated to G0
+	ISO2022SET_96
+	// 94 characters per byte, several bytes per character
+	ISO2022SET_94N
+)
+
+// ISO2022Charset describes a graphic character set that ISO 2022 based
+// encodings designate.
+type ISO2022Charset struct {
+	Kind ISO2022SetKind
+	// Width is the number of bytes per character, from 1 to 4; it must be 1
+	// unless Kind is ISO2022SET_94N.
+	Width int
+	// Final is the final byte of the escape sequences designating the set.
+	Final byte
+	// Decode maps a code, i.e. its bytes in GL form (0x21..0x7E, or
+	// 0x20..0x7F for ISO2022SET_96) in big endian order, to a character;
+	// zero means the code is unmapped.
+	Decode func(code uint32) rune
+	// Encode maps a character to its code in the form passed to Decode.
+	Encode func(r rune) (code uint32, found bool)
+}
+
+// ISO2022Designation names a graphic element, 0 to 3 for G0 to G3, that a
+// set may be designated to.
+type ISO2022Designation struct {
+	Charset *ISO2022Charset
+	Element int
+}
+
+// ISO2022Profile defines an ISO 2022 based encoding for ISO2022Decoder and
+// ISO2022Encoder.
+//
+// In 7-bit codes, SI and SO invoke G0 and G1 into GL (as do LS2 and LS3 for
+// G2 and G3), and ESC N and ESC O invoke G2 and G3 for a single character.
+// In 8-bit codes, G0 is always invoked into GL and G1 into GR, so that SI and
+// SO are ordinary controls, and SS2 (0x8E) and SS3 (0x8F) invoke G2 and G3
+// into GR for a single character; other C1 controls are passed through.
+type ISO2022Profile struct {
+	EightBit bool
+	// Initial holds the sets designated at the start, nil meaning none.
+	Initial [4]*ISO2022Charset
+	// Designations lists the designations escape sequences may make, in the
+	// order in which the encoder tries them for characters not in any of the
+	// sets currently designated. In 8-bit codes without designations, ESC is
+	// an ordinary control.
+	Designations []ISO2022Designation
+	// Announce lists the designations the encoder makes before the first
+	// character, as the ISO-2022-KR header does.
+	Announce []ISO2022Designation
+	// ShiftInAtLineEnd makes the decoder return to G0 after LF; the encoder
+	// always returns to G0 before controls.
+	ShiftInAtLineEnd bool
+	// ResetDesignationsAtLineEnd restores the initial designations after LF.
+	ResetDesignationsAtLineEnd bool
+}
+
+var iso2022DefaultProfile = ISO2022Profile {
+	Initial: [4]*ISO2022Charset {ISO2022ASCII},
+}
+
+// usesEscapes tells whether ESC introduces escape sequences.
+func(profile *ISO2022Profile) usesEscapes() bool {
+	return !profile.EightBit || len(profile.Designations) > 0
+}
+
+// isGraphicByte tells whether b, with the high bit cleared, is a valid byte of
+// a character in charset.
+func(charset *ISO2022Charset) isGraphicByte(b byte) bool {
+	b &= 0x7F
+	if charset.Kind == ISO2022SET_96 {
+		return b >= 0x20
+	}
+	return b > 0x20 && b < 0x7F
+}
+
+// appendISO2022Designation appends the escape sequence making designation to
+// units.
+func appendISO2022Designation(units []byte, designation ISO2022Designation) []byte {
+	charset := designation.Charset
+	units = append(units, iso2022_ESC)
+	switch {
+		case charset.Kind == ISO2022SET_96:
+			units = append(units, ",-./"[designation.Element])
+		case charset.Kind == ISO2022SET_94:
+			units = append(units, "()*+"[designation.Element])
+		case designation.Element == 0 && charset.Final >= 0x40 && charset.Final <= 0x42:
+			// short form for the sets registered before ISO 2022:1986
+			units = append(units, '$')
+		default:
+			units = append(units, '$', "()*+"[designation.Element])
+	}
+	return append(units, charset.Final)
+}
+
+// parseDesignation finds the designation made by the escape sequence escape
+// (without ESC), as far as the profile allows it.
+func(profile *ISO2022Profile) parseDesignation(escape []byte) (ISO2022Designation, bool) {
+	intermediates, final := escape[:len(escape) - 1], escape[len(escape) - 1]
+	multiByte := len(intermediates) > 0 && intermediates[0] == '$'
+	if multiByte {
+		intermediates = intermediates[1:]
+	}
+	var kind ISO2022SetKind
+	var element int
+	switch {
+		case len(intermediates) == 0 && multiByte && final >= 0x40 && final <= 0x42:
+			kind, element = ISO2022SET_94N, 0
+		case len(intermediates) != 1:
+			return ISO2022Designation {}, false
+		case intermediates[0] >= '(' && intermediates[0] <= '+':
+			kind, element = ISO2022SET_94, int(intermediates[0] - '(')
+			if multiByte {
+				kind = ISO2022SET_94N
+			}
+		case intermediates[0] >= '-' && intermediates[0] <= '/' && !multiByte:
+			kind, element = ISO2022SET_96, int(intermediates[0] - ',')
+		default:
+			return ISO2022Designation {}, false
+	}
+	for _, designation := range profile.Designations {
+		charset := designation.Charset
+		if designation.Element == element && charset.Kind == kind && charset.Final == final {
+			return designation, true
+		}
+	}
+	return ISO2022Designation {}, false
+}
+
+// charset describes the set for ISO2022Decoder and ISO2022Encoder.
+func(set iso2022JPSet) charset() *ISO2022Charset {
+	info := &iso2022JPSets[set]
+	charset := &ISO2022Charset {
+		Kind: ISO2022SET_94,
+		Width: 1,
+		Final: info.designation[len(info.designation) - 1],
+	}
+	switch {
+		case info.doubleByte:
+			charset.Kind, charset.Width = ISO2022SET_94N, 2
+		case info.g2:
+			charset.Kind = ISO2022SET_96
+	}
+	charset.Decode = func(code uint32) rune {
+		first, second := byte(code), byte(0)
+		if info.doubleByte {
+			first, second = byte(code >> 8), byte(code)
+		}
+		chars, count := set.decode(first, second)
+		if count != 1 {
+			return 0
+		}
+		return chars[0]
+	}
+	charset.Encode = func(r rune) (uint32, bool) {
+		code, found := set.encode(r)
+		return uint32(code), found
+	}
+	return charset
+}
+
+// The character sets known from ISO-2022-JP, for use in other profiles.
+var (
+	ISO2022ASCII = iso2022jpset_ASCII.charset()
+	ISO2022JISX0201Roman = iso2022jpset_JIS_X0201_ROMAN.charset()
+	ISO2022JISX0201Katakana = iso2022jpset_JIS_X0201_KATAKANA.charset()
+	ISO2022JISX0208 = iso2022jpset_JIS_X0208.charset()
+	ISO2022JISX0212 = iso2022jpset_JIS_X0212.charset()
+	ISO2022GB2312 = iso2022jpset_GB2312.charset()
+	ISO2022KSC5601 = iso2022jpset_KSC5601.charset()
+	ISO2022ISO8859_1High = iso2022jpset_ISO8859_1_HIGH.charset()
+	ISO2022ISO8859_7High = iso2022jpset_ISO8859_7_HIGH.charset()
+)
+
+// RegisterISO2022Profile registers the encoding that profile defines under
+// names, for all directions.
+func RegisterISO2022Profile(profile *ISO2022Profile, names ...string) {
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &ISO2022Decoder[uint16] {Profile: profile}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &ISO2022Decoder[rune] {Profile: profile}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &ISO2022Encoder[uint16] {Profile: profile}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &ISO2022Encoder[rune] {Profile: profile}
+		},
+	}, names...)
+}
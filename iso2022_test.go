@@ -0,0 +1,49 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestISO2022KRRoundTrip(t *testing.T) {
+	// the header comes first, and the encoder shifts in before line breaks
+	checkRoundTrip(t, "ISO-2022-KR", []byte("\x1B$)C\x0EGQ19\x0F\n\x0EGQ\x0Fa"), "한국\n한a")
+	// the decoder shifts in at line breaks, and does without the header
+	checkDecode(t, "ISO-2022-KR", []byte("\x0EGQ\nGQ"), "한\nGQ")
+}
+
+func TestISO2022KRErrors(t *testing.T) {
+	checkDecode(t, "ISO-2022-KR", []byte("\x0EG"), "�", 1)
+	checkDecode(t, "ISO-2022-KR", []byte("a\x1B$)A\x0EGQ"), "a�한", 1)
+	checkDecode(t, "ISO-2022-KR", []byte("\xC7a"), "�a", 0)
+}
+
+func TestISO2022Profiles(t *testing.T) {
+	// without a profile, it is ASCII with no escape sequences or shifts
+	checkTranscode[byte, rune](
+		t,
+		&ISO2022Decoder[rune]{},
+		[]byte("a\x1B(Bb\x0E"),
+		[]rune("a�b�"),
+		1,
+		5,
+	)
+	// an 8-bit code in the manner of EUC
+	euc := &ISO2022Profile {
+		EightBit: true,
+		Initial: [4]*ISO2022Charset {ISO2022ASCII, ISO2022KSC5601, ISO2022JISX0201Katakana},
+	}
+	checkTranscode[byte, rune](t, &ISO2022Decoder[rune] {Profile: euc}, []byte("a\xC7\xD1\x8E\xB1\x0E"), []rune("a한ｱ\x0E"))
+	checkTranscode[rune, byte](t, &ISO2022Encoder[rune] {Profile: euc}, []rune("a한ｱ"), []byte("a\xC7\xD1\x8E\xB1"))
+	// G2 designated as needed and invoked by single shifts
+	g2 := &ISO2022Profile {
+		Initial: [4]*ISO2022Charset {ISO2022ASCII},
+		Designations: []ISO2022Designation {
+			{Charset: ISO2022ISO8859_1High, Element: 2},
+			{Charset: ISO2022ISO8859_7High, Element: 2},
+		},
+	}
+	encoded := []byte("a\x1B.A\x1BNi\x1B.F\x1BNY\x1B.A\x1BNi\x1BNi")
+	checkTranscode[byte, rune](t, &ISO2022Decoder[rune] {Profile: g2}, encoded, []rune("aéΩéé"))
+	checkTranscode[rune, byte](t, &ISO2022Encoder[rune] {Profile: g2}, []rune("aéΩéé"), encoded)
+	checkTranscode[rune, byte](t, &ISO2022Encoder[rune] {Profile: g2}, []rune("a한"), []byte("a\x00"), 1)
+}
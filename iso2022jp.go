@@ -0,0 +1,358 @@
+package gotextenc
+
+import (
+	"sync"
+)
+
+type ISO2022JPVariant uint8
+
+const (
+	// RFC 1468: ASCII, JIS X 0201 Roman and JIS X 0208
+	ISO2022JPVAR_JP ISO2022JPVariant = iota
+	// RFC 2237: ISO2022JPVAR_JP plus JIS X 0212
+	ISO2022JPVAR_JP1
+	// RFC 1554: ISO2022JPVAR_JP1 plus GB 2312 and KS C 5601, and the upper
+	// halves of ISO-8859-1 and ISO-8859-7 through single shifts
+	ISO2022JPVAR_JP2
+	// JIS X 0213:2000 Annex 2: ASCII, JIS X 0201, JIS X 0208 and both planes
+	// of JIS X 0213
+	ISO2022JPVAR_JP3
+	// JIS X 0213:2004 Annex 2: ISO2022JPVAR_JP3 with plane 1 of JIS X 0213
+	// designated as ESC $ ( Q, which includes the characters added in 2004
+	ISO2022JPVAR_JP2004
+	iso2022jpvar_COUNT
+)
+
+const (
+	iso2022_ESC byte = 0x1B
+	iso2022_SO byte = 0x0E
+	iso2022_SI byte = 0x0F
+	iso2022_SS2_7BIT byte = 'N'
+)
+
+// iso2022JPSet identifies a graphic character set that the ISO-2022-JP
+// variants can designate.
+type iso2022JPSet uint8
+
+const (
+	iso2022jpset_ASCII iso2022JPSet = iota
+	iso2022jpset_JIS_X0201_ROMAN
+	iso2022jpset_JIS_X0201_KATAKANA
+	// JIS C 6226-1978, decoded as JIS X 0208
+	iso2022jpset_JIS_C6226
+	iso2022jpset_JIS_X0208
+	iso2022jpset_JIS_X0212
+	iso2022jpset_GB2312
+	iso2022jpset_KSC5601
+	iso2022jpset_JIS_X0213_PLANE1
+	iso2022jpset_JIS_X0213_PLANE2
+	// JIS X 0213:2004 plane 1; iso2022jpset_JIS_X0213_PLANE1 excludes the
+	// characters added in 2004 when encoding
+	iso2022jpset_JIS_X0213_PLANE1_2004
+	iso2022jpset_ISO8859_1_HIGH
+	iso2022jpset_ISO8859_7_HIGH
+	iso2022jpset_COUNT
+	// iso2022jpset_NONE means that G2 has not been designated
+	iso2022jpset_NONE = iso2022jpset_COUNT
+)
+
+type iso2022JPSetInfo struct {
+	// designation is the escape sequence designating the set, without ESC
+	designation string
+	doubleByte bool
+	// g2 marks the 96-character sets, which are designated to G2 and invoked
+	// for a single character by ESC N
+	g2 bool
+	// variants flags the variants allowing the set, by 1 << variant
+	variants uint8
+}
+
+const (
+	iso2022jp_JP_ONLY = 1 << ISO2022JPVAR_JP
+	iso2022jp_JP1_ONLY = 1 << ISO2022JPVAR_JP1
+	iso2022jp_JP2_ONLY = 1 << ISO2022JPVAR_JP2
+	iso2022jp_JP3_ONLY = 1 << ISO2022JPVAR_JP3
+	iso2022jp_JP2004_ONLY = 1 << ISO2022JPVAR_JP2004
+	iso2022jp_FROM_JP = iso2022jp_JP_ONLY | iso2022jp_FROM_JP1
+	iso2022jp_FROM_JP1 = iso2022jp_JP1_ONLY | iso2022jp_JP2_ONLY
+	iso2022jp_FROM_JP3 = iso2022jp_JP3_ONLY | iso2022jp_JP2004_ONLY
+	iso2022jp_ALL = iso2022jp_FROM_JP | iso2022jp_FROM_JP3
+)
+
+var iso2022JPSets = [iso2022jpset_COUNT]iso2022JPSetInfo {
+	iso2022jpset_ASCII: {"(B", false, false, iso2022jp_ALL},
+	iso2022jpset_JIS_X0201_ROMAN: {"(J", false, false, iso2022jp_ALL},
+	iso2022jpset_JIS_X0201_KATAKANA: {"(I", false, false, iso2022jp_FROM_JP3},
+	iso2022jpset_JIS_C6226: {"$@", true, false, iso2022jp_FROM_JP},
+	iso2022jpset_JIS_X0208: {"$B", true, false, iso2022jp_ALL},
+	iso2022jpset_JIS_X0212: {"$(D", true, false, iso2022jp_FROM_JP1},
+	iso2022jpset_GB2312: {"$A", true, false, iso2022jp_JP2_ONLY},
+	iso2022jpset_KSC5601: {"$(C", true, false, iso2022jp_JP2_ONLY},
+	iso2022jpset_JIS_X0213_PLANE1: {"$(O", true, false, iso2022jp_FROM_JP3},
+	iso2022jpset_JIS_X0213_PLANE2: {"$(P", true, false, iso2022jp_FROM_JP3},
+	iso2022jpset_JIS_X0213_PLANE1_2004: {"$(Q", true, false, iso2022jp_JP2004_ONLY},
+	iso2022jpset_ISO8859_1_HIGH: {".A", false, true, iso2022jp_JP2_ONLY},
+	iso2022jpset_ISO8859_7_HIGH: {".F", false, true, iso2022jp_JP2_ONLY},
+}
+
+// iso2022JPEncodeOrders lists the sets each variant's encoder tries, in order
+// of preference, if the designated ones do not contain the character.
+var iso2022JPEncodeOrders = [iso2022jpvar_COUNT][]iso2022JPSet {
+	ISO2022JPVAR_JP: {
+		iso2022jpset_ASCII,
+		iso2022jpset_JIS_X0208,
+		iso2022jpset_JIS_X0201_ROMAN,
+	},
+	ISO2022JPVAR_JP1: {
+		iso2022jpset_ASCII,
+		iso2022jpset_JIS_X0208,
+		iso2022jpset_JIS_X0212,
+		iso2022jpset_JIS_X0201_ROMAN,
+	},
+	ISO2022JPVAR_JP2: {
+		iso2022jpset_ASCII,
+		iso2022jpset_JIS_X0208,
+		iso2022jpset_JIS_X0212,
+		iso2022jpset_KSC5601,
+		iso2022jpset_GB2312,
+		iso2022jpset_JIS_X0201_ROMAN,
+		iso2022jpset_ISO8859_1_HIGH,
+		iso2022jpset_ISO8859_7_HIGH,
+	},
+	ISO2022JPVAR_JP3: {
+		iso2022jpset_ASCII,
+		iso2022jpset_JIS_X0208,
+		iso2022jpset_JIS_X0213_PLANE1,
+		iso2022jpset_JIS_X0213_PLANE2,
+		iso2022jpset_JIS_X0201_ROMAN,
+		iso2022jpset_JIS_X0201_KATAKANA,
+	},
+	ISO2022JPVAR_JP2004: {
+		iso2022jpset_ASCII,
+		iso2022jpset_JIS_X0208,
+		iso2022jpset_JIS_X0213_PLANE1_2004,
+		iso2022jpset_JIS_X0213_PLANE2,
+		iso2022jpset_JIS_X0201_ROMAN,
+		iso2022jpset_JIS_X0201_KATAKANA,
+	},
+}
+
+// pairSet gives the JIS X 0213 plane 1 set in which the variant's encoder
+// codes base characters followed by combining characters, if any.
+func(variant ISO2022JPVariant) pairSet() (iso2022JPSet, bool) {
+	switch variant {
+		case ISO2022JPVAR_JP3:
+			return iso2022jpset_JIS_X0213_PLANE1, true
+		case ISO2022JPVAR_JP2004:
+			return iso2022jpset_JIS_X0213_PLANE1_2004, true
+		default:
+			return iso2022jpset_NONE, false
+	}
+}
+
+func(variant ISO2022JPVariant) allows(set iso2022JPSet) bool {
+	return variant < iso2022jpvar_COUNT && iso2022JPSets[set].variants & (1 << variant) != 0
+}
+
+// parseDesignation finds the set designated by the escape sequence escape
+// (without ESC), as far as variant allows it.
+func(variant ISO2022JPVariant) parseDesignation(escape []byte) (iso2022JPSet, bool) {
+	for index := range iso2022JPSets {
+		set := iso2022JPSet(index)
+		if iso2022JPSets[index].designation == string(escape) && variant.allows(set) {
+			return set, true
+		}
+	}
+	return iso2022jpset_NONE, false
+}
+
+// decodeJISX0213Plane1 maps the JIS X 0213 plane 1 row and cell (counting
+// from 1) to one or two characters.
+func decodeJISX0213Plane1(row int, cell int) (chars [2]rune, count int) {
+	if pair, found := jisX0213Pairs[uint16(row << 8 | cell)]; found {
+		return pair, 2
+	}
+	if char := jisX0213Plane1[(row - 1) * 94 + cell - 1]; char != 0 {
+		return [2]rune {rune(char)}, 1
+	}
+	return
+}
+
+// decodeJISX0213Plane2 maps the JIS X 0213 plane 2 row and cell (counting
+// from 1).
+func decodeJISX0213Plane2(row int, cell int) rune {
+	for rowIndex, plane2Row := range jisX0213Plane2Rows {
+		if int(plane2Row) == row {
+			return rune(jisX0213Plane2[rowIndex * 94 + cell - 1])
+		}
+	}
+	return 0
+}
+
+// isJISX0213PairBase tells whether r is the first of two characters that a
+// JIS X 0213 plane 1 code maps to.
+func isJISX0213PairBase(r rune) bool {
+	for _, pair := range jisX0213Pairs {
+		if pair[0] == r {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeJISX0213Pair finds the JIS X 0213 plane 1 code, as row << 8 | cell,
+// of base followed by combining.
+func encodeJISX0213Pair(base rune, combining rune) (uint16, bool) {
+	for code, pair := range jisX0213Pairs {
+		if pair == [2]rune {base, combining} {
+			return code, true
+		}
+	}
+	return 0, false
+}
+
+// isJISX0213Addition2004 reports whether the JIS X 0213 plane 1 code
+// row << 8 | cell was added in JIS X 0213:2004.
+func isJISX0213Addition2004(code uint16) bool {
+	for _, addition := range jisX0213Additions2004 {
+		if code == addition {
+			return true
+		}
+	}
+	return false
+}
+
+// decode maps a character of the set. For double-byte sets, first and second
+// are the two bytes (0x21..0x7E); for single-byte sets, second is ignored.
+func(set iso2022JPSet) decode(first byte, second byte) (chars [2]rune, count int) {
+	var char rune
+	row, cell := int(first) - 0x20, int(second) - 0x20
+	switch set {
+		case iso2022jpset_ASCII:
+			char = rune(first)
+		case iso2022jpset_JIS_X0201_ROMAN:
+			switch first {
+				case 0x5C:
+					char = 0x00A5
+				case 0x7E:
+					char = 0x203E
+				default:
+					char = rune(first)
+			}
+		case iso2022jpset_JIS_X0201_KATAKANA:
+			if first <= 0x5F {
+				char = 0xFF61 + rune(first - 0x21)
+			}
+		case iso2022jpset_JIS_C6226, iso2022jpset_JIS_X0208:
+			char = rune(jisX0208[(row - 1) * 94 + cell - 1])
+		case iso2022jpset_JIS_X0212:
+			char = rune(jisX0212[(row - 1) * 94 + cell - 1])
+		case iso2022jpset_GB2312:
+			char = rune(gb2312[(row - 1) * 94 + cell - 1])
+		case iso2022jpset_KSC5601:
+			char = rune(ksX1001[(row - 1) * 94 + cell - 1])
+		case iso2022jpset_JIS_X0213_PLANE1, iso2022jpset_JIS_X0213_PLANE1_2004:
+			return decodeJISX0213Plane1(row, cell)
+		case iso2022jpset_JIS_X0213_PLANE2:
+			char = decodeJISX0213Plane2(row, cell)
+		case iso2022jpset_ISO8859_1_HIGH:
+			char, _ = ISO8859_1.Decode(first | 0x80)
+		case iso2022jpset_ISO8859_7_HIGH:
+			char, _ = ISO8859_7.Decode(first | 0x80)
+	}
+	if char == 0 {
+		return
+	}
+	return [2]rune {char}, 1
+}
+
+var iso2022JPEncodeOnces [iso2022jpset_COUNT]sync.Once
+// iso2022JPEncodeMaps map the characters of the double-byte sets to their
+// codes, first byte in the high byte.
+var iso2022JPEncodeMaps [iso2022jpset_COUNT]map[rune]uint16
+
+func(set iso2022JPSet) encodeMap() map[rune]uint16 {
+	iso2022JPEncodeOnces[set].Do(func() {
+		encodeMap := make(map[rune]uint16)
+		for first := byte(0x21); first <= 0x7E; first++ {
+			for second := byte(0x21); second <= 0x7E; second++ {
+				code := uint16(first) << 8 | uint16(second)
+				if set == iso2022jpset_JIS_X0213_PLANE1 && isJISX0213Addition2004(code - 0x2020) {
+					// not part of JIS X 0213:2000
+					continue
+				}
+				chars, count := set.decode(first, second)
+				if count != 1 {
+					continue
+				}
+				if _, present := encodeMap[chars[0]]; !present {
+					encodeMap[chars[0]] = code
+				}
+			}
+		}
+		iso2022JPEncodeMaps[set] = encodeMap
+	})
+	return iso2022JPEncodeMaps[set]
+}
+
+// encode finds the code of r in the set; single-byte sets yield 7-bit codes.
+func(set iso2022JPSet) encode(r rune) (code uint16, found bool) {
+	switch set {
+		case iso2022jpset_ASCII:
+			return uint16(r), r >= 0x20 && r < 0x7F
+		case iso2022jpset_JIS_X0201_ROMAN:
+			switch r {
+				case 0x00A5:
+					return 0x5C, true
+				case 0x203E:
+					return 0x7E, true
+				case 0x5C, 0x7E:
+					return 0, false
+				default:
+					return uint16(r), r >= 0x20 && r < 0x7F
+			}
+		case iso2022jpset_JIS_X0201_KATAKANA:
+			return uint16(r - 0xFF61 + 0x21), r >= 0xFF61 && r <= 0xFF9F
+		case iso2022jpset_ISO8859_1_HIGH, iso2022jpset_ISO8859_7_HIGH:
+			charset := ISO8859_1
+			if set == iso2022jpset_ISO8859_7_HIGH {
+				charset = ISO8859_7
+			}
+			b, mapped := charset.Encode(r)
+			return uint16(b & 0x7F), mapped && b >= 0xA0
+		case iso2022jpset_JIS_C6226, iso2022jpset_COUNT:
+			// never designated by the encoder
+			return 0, false
+		default:
+			code, found = set.encodeMap()[r]
+			return
+	}
+}
+
+var iso2022JPNames = [iso2022jpvar_COUNT][]string {
+	ISO2022JPVAR_JP: {"ISO-2022-JP", "csISO2022JP"},
+	ISO2022JPVAR_JP1: {"ISO-2022-JP-1"},
+	ISO2022JPVAR_JP2: {"ISO-2022-JP-2", "csISO2022JP2"},
+	ISO2022JPVAR_JP3: {"ISO-2022-JP-3"},
+	ISO2022JPVAR_JP2004: {"ISO-2022-JP-2004"},
+}
+
+func init() {
+	for index, names := range iso2022JPNames {
+		variant := ISO2022JPVariant(index)
+		RegisterCharset(CharsetFactories {
+			Decoder12: func() Codec[byte, uint16] {
+				return &ISO2022JPDecoder[uint16] {Variant: variant}
+			},
+			Decoder14: func() Codec[byte, rune] {
+				return &ISO2022JPDecoder[rune] {Variant: variant}
+			},
+			Encoder21: func() Codec[uint16, byte] {
+				return &ISO2022JPEncoder[uint16] {Variant: variant}
+			},
+			Encoder41: func() Codec[rune, byte] {
+				return &ISO2022JPEncoder[rune] {Variant: variant}
+			},
+		}, names...)
+	}
+}
@@ -0,0 +1,33 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestISO2022JPRoundTrip(t *testing.T) {
+	checkRoundTrip(t, "ISO-2022-JP", []byte("abc\x1B$BF|K\\8l\x1B(Bdef"), "abc日本語def")
+	checkRoundTrip(t, "ISO-2022-JP", []byte("\x1B(J\\\x1B(B"), "¥")
+	checkRoundTrip(t, "ISO-2022-JP-1", []byte("a\x1B$(D0!\x1B(Bb"), "a丂b")
+	checkRoundTrip(t, "ISO-2022-JP-2", []byte("a\x1B$(D+1\x1B$(CGQ19\x1B(Bb"), "aé한국b")
+	checkRoundTrip(t, "ISO-2022-JP-3", []byte("a\x1B$(O.\"\x1B(Bb"), "a𠀋b")
+}
+
+func TestISO2022JPDecoding(t *testing.T) {
+	// the decoder accepts what the encoder does not produce
+	checkDecode(t, "ISO-2022-JP", []byte("\x1B(J\\~\x1B$@F|"), "¥‾日")
+	checkDecode(t, "ISO-2022-JP-2", []byte("\x1B.A\x1BNi"), "é")
+	checkDecode(t, "ISO-2022-JP-3", []byte("\x1B(I1\x1B(B"), "ｱ")
+}
+
+func TestISO2022JPErrors(t *testing.T) {
+	checkDecode(t, "ISO-2022-JP", []byte("a\xA4b"), "a�b", 1)
+	checkDecode(t, "ISO-2022-JP", []byte("a\x1B$Zb"), "a�b", 1)
+	// SO and SI are not passed through, as the encoder refuses them
+	checkDecode(t, "ISO-2022-JP", []byte("\x0Ea\x0F"), "�a�", 0, 2)
+	checkEncode(t, "ISO-2022-JP", "\u000Ea", []byte("\x00a"), 0)
+	checkDecode(t, "ISO-2022-JP", []byte("a\x1B$"), "a�", 1)
+	checkDecode(t, "ISO-2022-JP", []byte("\x1B$BF"), "�", 3)
+	// half-width katakana only from ISO-2022-JP-3 on
+	checkDecode(t, "ISO-2022-JP", []byte("\x1B(I1"), "�1", 0)
+	checkEncode(t, "ISO-2022-JP", "aｶb", []byte("a\x00b"), 1)
+}
@@ -0,0 +1,19 @@
+package gotextenc
+
+// ISO2022KR defines ISO-2022-KR (RFC 1557): KS X 1001 is designated to G1 once,
+// at the start, and shifted in with SO; the encoder shifts back to ASCII before
+// each line break. The decoder accepts text lacking the designation.
+var ISO2022KR = &ISO2022Profile {
+	Initial: [4]*ISO2022Charset {ISO2022ASCII, ISO2022KSC5601},
+	Designations: []ISO2022Designation {
+		{Charset: ISO2022KSC5601, Element: 1},
+	},
+	Announce: []ISO2022Designation {
+		{Charset: ISO2022KSC5601, Element: 1},
+	},
+	ShiftInAtLineEnd: true,
+}
+
+func init() {
+	RegisterISO2022Profile(ISO2022KR, "ISO-2022-KR", "csISO2022KR")
+}
@@ -0,0 +1,252 @@
+package gotextenc
+
+import (
+	"sync"
+)
+
+type ISO6937Variant uint8
+
+const (
+	ISO6937VAR_ISO6937 ISO6937Variant = iota
+	ISO6937VAR_T61
+)
+
+// iso6937Charset describes one member of the ISO 6937 family: Characters
+// are represented either by a single byte or by a non-spacing diacritic prefix
+// in 0xC0..0xCF followed by the base character.
+type iso6937Charset struct {
+	// high maps 0xA0..0xFF; zero entries are unmapped or diacritic prefixes
+	high *[96]rune
+	// g0Holes lists the positions in 0x20..0x7E that are not part of the
+	// primary set
+	g0Holes []byte
+	// diacritics maps the prefixes 0xC0..0xCF to combining characters
+	diacritics [16]rune
+	loadOnce sync.Once
+	single [256]rune
+	composed [16][95]rune
+	encodeMap map[rune]uint16
+}
+
+type iso6937Composition struct {
+	prefix byte
+	base byte
+	char rune
+}
+
+var iso6937High = [96]rune {
+	0x00A0, 0x00A1, 0x00A2, 0x00A3, 0x0000, 0x00A5, 0x0000, 0x00A7,
+	0x00A4, 0x2018, 0x201C, 0x00AB, 0x2190, 0x2191, 0x2192, 0x2193,
+	0x00B0, 0x00B1, 0x00B2, 0x00B3, 0x00D7, 0x00B5, 0x00B6, 0x00B7,
+	0x00F7, 0x2019, 0x201D, 0x00BB, 0x00BC, 0x00BD, 0x00BE, 0x00BF,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x2014, 0x00B9, 0x00AE, 0x00A9, 0x2122, 0x266A, 0x00AC, 0x00A6,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x215B, 0x215C, 0x215D, 0x215E,
+	0x2126, 0x00C6, 0x00D0, 0x00AA, 0x0126, 0x0000, 0x0132, 0x013F,
+	0x0141, 0x00D8, 0x0152, 0x00BA, 0x00DE, 0x0166, 0x014A, 0x0149,
+	0x0138, 0x00E6, 0x0111, 0x00F0, 0x0127, 0x0131, 0x0133, 0x0140,
+	0x0142, 0x00F8, 0x0153, 0x00DF, 0x00FE, 0x0167, 0x014B, 0x00AD,
+}
+
+var t61High = [96]rune {
+	0x0000, 0x00A1, 0x00A2, 0x00A3, 0x0024, 0x00A5, 0x0023, 0x00A7,
+	0x00A4, 0x0000, 0x0000, 0x00AB, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x00B0, 0x00B1, 0x00B2, 0x00B3, 0x00D7, 0x00B5, 0x00B6, 0x00B7,
+	0x00F7, 0x0000, 0x0000, 0x00BB, 0x00BC, 0x00BD, 0x00BE, 0x00BF,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x2126, 0x00C6, 0x00D0, 0x00AA, 0x0126, 0x0000, 0x0132, 0x013F,
+	0x0141, 0x00D8, 0x0152, 0x00BA, 0x00DE, 0x0166, 0x014A, 0x0149,
+	0x0138, 0x00E6, 0x0111, 0x00F0, 0x0127, 0x0131, 0x0133, 0x0140,
+	0x0142, 0x00F8, 0x0153, 0x00DF, 0x00FE, 0x0167, 0x014B, 0x0000,
+}
+
+// iso6937Compositions lists all diacritic-prefixed sequences that have a
+// precomposed (or, with SPACE as base, a spacing) counterpart.
+var iso6937Compositions = [...]iso6937Composition {
+	{0xC1, ' ', 0x0060}, {0xC1, 'A', 0x00C0}, {0xC1, 'E', 0x00C8}, {0xC1, 'I', 0x00CC},
+	{0xC1, 'O', 0x00D2}, {0xC1, 'U', 0x00D9}, {0xC1, 'a', 0x00E0}, {0xC1, 'e', 0x00E8},
+	{0xC1, 'i', 0x00EC}, {0xC1, 'o', 0x00F2}, {0xC1, 'u', 0x00F9},
+	{0xC2, ' ', 0x00B4}, {0xC2, 'A', 0x00C1}, {0xC2, 'C', 0x0106}, {0xC2, 'E', 0x00C9},
+	{0xC2, 'I', 0x00CD}, {0xC2, 'L', 0x0139}, {0xC2, 'N', 0x0143}, {0xC2, 'O', 0x00D3},
+	{0xC2, 'R', 0x0154}, {0xC2, 'S', 0x015A}, {0xC2, 'U', 0x00DA}, {0xC2, 'Y', 0x00DD},
+	{0xC2, 'Z', 0x0179}, {0xC2, 'a', 0x00E1}, {0xC2, 'c', 0x0107}, {0xC2, 'e', 0x00E9},
+	{0xC2, 'i', 0x00ED}, {0xC2, 'l', 0x013A}, {0xC2, 'n', 0x0144}, {0xC2, 'o', 0x00F3},
+	{0xC2, 'r', 0x0155}, {0xC2, 's', 0x015B}, {0xC2, 'u', 0x00FA}, {0xC2, 'y', 0x00FD},
+	{0xC2, 'z', 0x017A},
+	{0xC3, ' ', 0x005E}, {0xC3, 'A', 0x00C2}, {0xC3, 'C', 0x0108}, {0xC3, 'E', 0x00CA},
+	{0xC3, 'G', 0x011C}, {0xC3, 'H', 0x0124}, {0xC3, 'I', 0x00CE}, {0xC3, 'J', 0x0134},
+	{0xC3, 'O', 0x00D4}, {0xC3, 'S', 0x015C}, {0xC3, 'U', 0x00DB}, {0xC3, 'W', 0x0174},
+	{0xC3, 'Y', 0x0176}, {0xC3, 'a', 0x00E2}, {0xC3, 'c', 0x0109}, {0xC3, 'e', 0x00EA},
+	{0xC3, 'g', 0x011D}, {0xC3, 'h', 0x0125}, {0xC3, 'i', 0x00EE}, {0xC3, 'j', 0x0135},
+	{0xC3, 'o', 0x00F4}, {0xC3, 's', 0x015D}, {0xC3, 'u', 0x00FB}, {0xC3, 'w', 0x0175},
+	{0xC3, 'y', 0x0177},
+	{0xC4, ' ', 0x007E}, {0xC4, 'A', 0x00C3}, {0xC4, 'I', 0x0128}, {0xC4, 'N', 0x00D1},
+	{0xC4, 'O', 0x00D5}, {0xC4, 'U', 0x0168}, {0xC4, 'a', 0x00E3}, {0xC4, 'i', 0x0129},
+	{0xC4, 'n', 0x00F1}, {0xC4, 'o', 0x00F5}, {0xC4, 'u', 0x0169},
+	{0xC5, ' ', 0x00AF}, {0xC5, 'A', 0x0100}, {0xC5, 'E', 0x0112}, {0xC5, 'I', 0x012A},
+	{0xC5, 'O', 0x014C}, {0xC5, 'U', 0x016A}, {0xC5, 'a', 0x0101}, {0xC5, 'e', 0x0113},
+	{0xC5, 'i', 0x012B}, {0xC5, 'o', 0x014D}, {0xC5, 'u', 0x016B},
+	{0xC6, ' ', 0x02D8}, {0xC6, 'A', 0x0102}, {0xC6, 'G', 0x011E}, {0xC6, 'U', 0x016C},
+	{0xC6, 'a', 0x0103}, {0xC6, 'g', 0x011F}, {0xC6, 'u', 0x016D},
+	{0xC7, ' ', 0x02D9}, {0xC7, 'C', 0x010A}, {0xC7, 'E', 0x0116}, {0xC7, 'G', 0x0120},
+	{0xC7, 'I', 0x0130}, {0xC7, 'Z', 0x017B}, {0xC7, 'c', 0x010B}, {0xC7, 'e', 0x0117},
+	{0xC7, 'g', 0x0121}, {0xC7, 'z', 0x017C},
+	{0xC8, ' ', 0x00A8}, {0xC8, 'A', 0x00C4}, {0xC8, 'E', 0x00CB}, {0xC8, 'I', 0x00CF},
+	{0xC8, 'O', 0x00D6}, {0xC8, 'U', 0x00DC}, {0xC8, 'Y', 0x0178}, {0xC8, 'a', 0x00E4},
+	{0xC8, 'e', 0x00EB}, {0xC8, 'i', 0x00EF}, {0xC8, 'o', 0x00F6}, {0xC8, 'u', 0x00FC},
+	{0xC8, 'y', 0x00FF},
+	{0xCA, ' ', 0x02DA}, {0xCA, 'A', 0x00C5}, {0xCA, 'U', 0x016E}, {0xCA, 'a', 0x00E5},
+	{0xCA, 'u', 0x016F},
+	{0xCB, ' ', 0x00B8}, {0xCB, 'C', 0x00C7}, {0xCB, 'G', 0x0122}, {0xCB, 'K', 0x0136},
+	{0xCB, 'L', 0x013B}, {0xCB, 'N', 0x0145}, {0xCB, 'R', 0x0156}, {0xCB, 'S', 0x015E},
+	{0xCB, 'T', 0x0162}, {0xCB, 'c', 0x00E7}, {0xCB, 'g', 0x0123}, {0xCB, 'k', 0x0137},
+	{0xCB, 'l', 0x013C}, {0xCB, 'n', 0x0146}, {0xCB, 'r', 0x0157}, {0xCB, 's', 0x015F},
+	{0xCB, 't', 0x0163},
+	{0xCD, ' ', 0x02DD}, {0xCD, 'O', 0x0150}, {0xCD, 'U', 0x0170}, {0xCD, 'o', 0x0151},
+	{0xCD, 'u', 0x0171},
+	{0xCE, ' ', 0x02DB}, {0xCE, 'A', 0x0104}, {0xCE, 'E', 0x0118}, {0xCE, 'I', 0x012E},
+	{0xCE, 'U', 0x0172}, {0xCE, 'a', 0x0105}, {0xCE, 'e', 0x0119}, {0xCE, 'i', 0x012F},
+	{0xCE, 'u', 0x0173},
+	{0xCF, ' ', 0x02C7}, {0xCF, 'C', 0x010C}, {0xCF, 'D', 0x010E}, {0xCF, 'E', 0x011A},
+	{0xCF, 'L', 0x013D}, {0xCF, 'N', 0x0147}, {0xCF, 'R', 0x0158}, {0xCF, 'S', 0x0160},
+	{0xCF, 'T', 0x0164}, {0xCF, 'Z', 0x017D}, {0xCF, 'c', 0x010D}, {0xCF, 'd', 0x010F},
+	{0xCF, 'e', 0x011B}, {0xCF, 'l', 0x013E}, {0xCF, 'n', 0x0148}, {0xCF, 'r', 0x0159},
+	{0xCF, 's', 0x0161}, {0xCF, 't', 0x0165}, {0xCF, 'z', 0x017E},
+}
+
+var iso6937Charsets = [...]*iso6937Charset {
+	ISO6937VAR_ISO6937: &iso6937Charset {
+		high: &iso6937High,
+		diacritics: [16]rune {
+			0x0, 0x0300, 0x0301, 0x0302, 0x0303, 0x0304, 0x0306, 0x0307,
+			0x0308, 0x0, 0x030A, 0x0327, 0x0, 0x030B, 0x0328, 0x030C,
+		},
+	},
+	ISO6937VAR_T61: &iso6937Charset {
+		high: &t61High,
+		g0Holes: []byte {'#', '$', '\\', '^', '`', '{', '}', '~'},
+		diacritics: [16]rune {
+			0x0, 0x0300, 0x0301, 0x0302, 0x0303, 0x0304, 0x0306, 0x0307,
+			0x0308, 0x0308, 0x030A, 0x0327, 0x0332, 0x030B, 0x0328, 0x030C,
+		},
+	},
+}
+
+func(variant ISO6937Variant) charset() *iso6937Charset {
+	var charset *iso6937Charset
+	if int(variant) < len(iso6937Charsets) {
+		charset = iso6937Charsets[variant]
+	} else {
+		charset = iso6937Charsets[ISO6937VAR_ISO6937]
+	}
+	charset.loadOnce.Do(charset.load)
+	return charset
+}
+
+func(charset *iso6937Charset) load() {
+	for b := 0; b < 0xA0; b++ {
+		charset.single[b] = rune(b)
+	}
+	for _, hole := range charset.g0Holes {
+		charset.single[hole] = 0
+	}
+	copy(charset.single[0xA0:], charset.high[:])
+	for _, composition := range iso6937Compositions {
+		charset.composed[composition.prefix & 0x0F][composition.base - 0x20] = composition.char
+	}
+	// some variants know several prefixes for the same diacritic
+	for index, diacritic := range charset.diacritics {
+		if diacritic == 0 {
+			continue
+		}
+		for other := 0; other < index; other++ {
+			if charset.diacritics[other] == diacritic {
+				charset.composed[index] = charset.composed[other]
+				break
+			}
+		}
+	}
+	charset.encodeMap = make(map[rune]uint16)
+	for b := 0xFF; b >= 0; b-- {
+		if charset.single[b] != 0 || b == 0 {
+			charset.encodeMap[charset.single[b]] = uint16(b)
+		}
+	}
+	for index := range charset.composed {
+		for offset, char := range charset.composed[index] {
+			if char == 0 || charset.single[offset + 0x20] == 0 {
+				continue
+			}
+			if _, present := charset.encodeMap[char]; !present {
+				charset.encodeMap[char] = uint16((0xC0 | index) << 8 | (offset + 0x20))
+			}
+		}
+	}
+}
+
+// diacriticPrefix returns the prefix byte for the combining character r, or 0
+// if r is not a diacritic known to charset.
+func(charset *iso6937Charset) diacriticPrefix(r rune) byte {
+	if r < 0x0300 || r >= 0x0370 {
+		return 0
+	}
+	for index, diacritic := range charset.diacritics {
+		if diacritic == r {
+			return byte(0xC0 | index)
+		}
+	}
+	return 0
+}
+
+var iso6937Names = []string {
+	"ISO_6937",
+	"ISO6937",
+	"ISO-6937",
+	"ISO_6937-2",
+	"ISO_6937-2-add",
+	"iso-ir-142",
+	"csISOTextComm",
+}
+
+var t61Names = []string {
+	"T.61-8bit",
+	"T.61",
+	"T61",
+	"iso-ir-103",
+	"csISO103T618bit",
+}
+
+func init() {
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &ISO6937Decoder[uint16]{}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &ISO6937Decoder[rune]{}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &ISO6937Encoder[uint16]{}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &ISO6937Encoder[rune]{}
+		},
+	}, iso6937Names...)
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &ISO6937Decoder[uint16] {Variant: ISO6937VAR_T61}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &ISO6937Decoder[rune] {Variant: ISO6937VAR_T61}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &ISO6937Encoder[uint16] {Variant: ISO6937VAR_T61}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &ISO6937Encoder[rune] {Variant: ISO6937VAR_T61}
+		},
+	}, t61Names...)
+}
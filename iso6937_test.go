@@ -0,0 +1,34 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestISO6937RoundTrip(t *testing.T) {
+	checkRoundTrip(
+		t,
+		"ISO_6937",
+		[]byte("Zo\xC8e \xCAAngstr\xC8om \xFB\xA3$\xA8#"),
+		"Zoë Ångström ß£$¤#",
+	)
+	checkRoundTrip(t, "ISO_6937", []byte("\xE8\xC2od\xC2z \xF6 \xED \xEF"), "Łódź ĳ Ŧ ŉ")
+	checkRoundTrip(t, "ISO_6937", []byte("\xCBCa co\xC3ute 5 \xA5"), "Ça coûte 5 ¥")
+}
+
+func TestT61RoundTrip(t *testing.T) {
+	// T.61 has no '$' and '#' in G0
+	checkRoundTrip(
+		t,
+		"T.61",
+		[]byte("Zo\xC8e \xCAAngstr\xC8om \xFB\xA3\xA4\xA8\xA6"),
+		"Zoë Ångström ß£$¤#",
+	)
+}
+
+func TestISO6937Errors(t *testing.T) {
+	// diacritic prefix at the end of input
+	checkDecode(t, "ISO_6937", []byte("a\xC1"), "a�", 1)
+	// diacritic prefix before something that is no base character
+	checkDecode(t, "ISO_6937", []byte("\xC1\x01b"), "�\x01b", 0)
+	checkEncode(t, "ISO_6937", "a€b", []byte("a\x00b"), 1)
+}
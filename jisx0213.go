@@ -0,0 +1,1133 @@
+package gotextenc
+
+// jisX0213Plane1 maps JIS X 0213 plane 1 row/cell pairs, at index
+// (row - 1) * 94 + cell - 1, to characters; zero entries are unmapped or, if
+// listed in jisX0213Pairs, map to two characters. Plane 1 is a superset of
+// JIS X 0208, but follows the JIS X 0213 mapping for 1-1-29 (EM DASH).
+var jisX0213Plane1 = [94 * 94]uint32 {
+	// row 1
+	0x03000, 0x03001, 0x03002, 0x0FF0C, 0x0FF0E, 0x030FB, 0x0FF1A, 0x0FF1B, 0x0FF1F, 0x0FF01, 0x0309B, 0x0309C,
+	0x000B4, 0x0FF40, 0x000A8, 0x0FF3E, 0x0FFE3, 0x0FF3F, 0x030FD, 0x030FE, 0x0309D, 0x0309E, 0x03003, 0x04EDD,
+	0x03005, 0x03006, 0x03007, 0x030FC, 0x02014, 0x02010, 0x0FF0F, 0x0FF3C, 0x0301C, 0x02016, 0x0FF5C, 0x02026,
+	0x02025, 0x02018, 0x02019, 0x0201C, 0x0201D, 0x0FF08, 0x0FF09, 0x03014, 0x03015, 0x0FF3B, 0x0FF3D, 0x0FF5B,
+	0x0FF5D, 0x03008, 0x03009, 0x0300A, 0x0300B, 0x0300C, 0x0300D, 0x0300E, 0x0300F, 0x03010, 0x03011, 0x0FF0B,
+	0x02212, 0x000B1, 0x000D7, 0x000F7, 0x0FF1D, 0x02260, 0x0FF1C, 0x0FF1E, 0x02266, 0x02267, 0x0221E, 0x02234,
+	0x02642, 0x02640, 0x000B0, 0x02032, 0x02033, 0x02103, 0x0FFE5, 0x0FF04, 0x000A2, 0x000A3, 0x0FF05, 0x0FF03,
+	0x0FF06, 0x0FF0A, 0x0FF20, 0x000A7, 0x02606, 0x02605, 0x025CB, 0x025CF, 0x025CE, 0x025C7,
+	// row 2
+	0x025C6, 0x025A1, 0x025A0, 0x025B3, 0x025B2, 0x025BD, 0x025BC, 0x0203B, 0x03012, 0x02192, 0x02190, 0x02191,
+	0x02193, 0x03013, 0x0FF07, 0x0FF02, 0x0FF0D, 0x0FF5E, 0x03033, 0x03034, 0x03035, 0x0303B, 0x0303C, 0x030FF,
+	0x0309F, 0x02208, 0x0220B, 0x02286, 0x02287, 0x02282, 0x02283, 0x0222A, 0x02229, 0x02284, 0x02285, 0x0228A,
+	0x0228B, 0x02209, 0x02205, 0x02305, 0x02306, 0x02227, 0x02228, 0x000AC, 0x021D2, 0x021D4, 0x02200, 0x02203,
+	0x02295, 0x02296, 0x02297, 0x02225, 0x02226, 0x0FF5F, 0x0FF60, 0x03018, 0x03019, 0x03016, 0x03017, 0x02220,
+	0x022A5, 0x02312, 0x02202, 0x02207, 0x02261, 0x02252, 0x0226A, 0x0226B, 0x0221A, 0x0223D, 0x0221D, 0x02235,
+	0x0222B, 0x0222C, 0x02262, 0x02243, 0x02245, 0x02248, 0x02276, 0x02277, 0x02194, 0x0212B, 0x02030, 0x0266F,
+	0x0266D, 0x0266A, 0x02020, 0x02021, 0x000B6, 0x0266E, 0x0266B, 0x0266C, 0x02669, 0x025EF,
+	// row 3
+	0x025B7, 0x025B6, 0x025C1, 0x025C0, 0x02197, 0x02198, 0x02196, 0x02199, 0x021C4, 0x021E8, 0x021E6, 0x021E7,
+	0x021E9, 0x02934, 0x02935, 0x0FF10, 0x0FF11, 0x0FF12, 0x0FF13, 0x0FF14, 0x0FF15, 0x0FF16, 0x0FF17, 0x0FF18,
+	0x0FF19, 0x029BF, 0x025C9, 0x0303D, 0x0FE46, 0x0FE45, 0x025E6, 0x02022, 0x0FF21, 0x0FF22, 0x0FF23, 0x0FF24,
+	0x0FF25, 0x0FF26, 0x0FF27, 0x0FF28, 0x0FF29, 0x0FF2A, 0x0FF2B, 0x0FF2C, 0x0FF2D, 0x0FF2E, 0x0FF2F, 0x0FF30,
+	0x0FF31, 0x0FF32, 0x0FF33, 0x0FF34, 0x0FF35, 0x0FF36, 0x0FF37, 0x0FF38, 0x0FF39, 0x0FF3A, 0x02213, 0x02135,
+	0x0210F, 0x033CB, 0x02113, 0x02127, 0x0FF41, 0x0FF42, 0x0FF43, 0x0FF44, 0x0FF45, 0x0FF46, 0x0FF47, 0x0FF48,
+	0x0FF49, 0x0FF4A, 0x0FF4B, 0x0FF4C, 0x0FF4D, 0x0FF4E, 0x0FF4F, 0x0FF50, 0x0FF51, 0x0FF52, 0x0FF53, 0x0FF54,
+	0x0FF55, 0x0FF56, 0x0FF57, 0x0FF58, 0x0FF59, 0x0FF5A, 0x030A0, 0x02013, 0x029FA, 0x029FB,
+	// row 4
+	0x03041, 0x03042, 0x03043, 0x03044, 0x03045, 0x03046, 0x03047, 0x03048, 0x03049, 0x0304A, 0x0304B, 0x0304C,
+	0x0304D, 0x0304E, 0x0304F, 0x03050, 0x03051, 0x03052, 0x03053, 0x03054, 0x03055, 0x03056, 0x03057, 0x03058,
+	0x03059, 0x0305A, 0x0305B, 0x0305C, 0x0305D, 0x0305E, 0x0305F, 0x03060, 0x03061, 0x03062, 0x03063, 0x03064,
+	0x03065, 0x03066, 0x03067, 0x03068, 0x03069, 0x0306A, 0x0306B, 0x0306C, 0x0306D, 0x0306E, 0x0306F, 0x03070,
+	0x03071, 0x03072, 0x03073, 0x03074, 0x03075, 0x03076, 0x03077, 0x03078, 0x03079, 0x0307A, 0x0307B, 0x0307C,
+	0x0307D, 0x0307E, 0x0307F, 0x03080, 0x03081, 0x03082, 0x03083, 0x03084, 0x03085, 0x03086, 0x03087, 0x03088,
+	0x03089, 0x0308A, 0x0308B, 0x0308C, 0x0308D, 0x0308E, 0x0308F, 0x03090, 0x03091, 0x03092, 0x03093, 0x03094,
+	0x03095, 0x03096, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// row 5
+	0x030A1, 0x030A2, 0x030A3, 0x030A4, 0x030A5, 0x030A6, 0x030A7, 0x030A8, 0x030A9, 0x030AA, 0x030AB, 0x030AC,
+	0x030AD, 0x030AE, 0x030AF, 0x030B0, 0x030B1, 0x030B2, 0x030B3, 0x030B4, 0x030B5, 0x030B6, 0x030B7, 0x030B8,
+	0x030B9, 0x030BA, 0x030BB, 0x030BC, 0x030BD, 0x030BE, 0x030BF, 0x030C0, 0x030C1, 0x030C2, 0x030C3, 0x030C4,
+	0x030C5, 0x030C6, 0x030C7, 0x030C8, 0x030C9, 0x030CA, 0x030CB, 0x030CC, 0x030CD, 0x030CE, 0x030CF, 0x030D0,
+	0x030D1, 0x030D2, 0x030D3, 0x030D4, 0x030D5, 0x030D6, 0x030D7, 0x030D8, 0x030D9, 0x030DA, 0x030DB, 0x030DC,
+	0x030DD, 0x030DE, 0x030DF, 0x030E0, 0x030E1, 0x030E2, 0x030E3, 0x030E4, 0x030E5, 0x030E6, 0x030E7, 0x030E8,
+	0x030E9, 0x030EA, 0x030EB, 0x030EC, 0x030ED, 0x030EE, 0x030EF, 0x030F0, 0x030F1, 0x030F2, 0x030F3, 0x030F4,
+	0x030F5, 0x030F6, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	// row 6
+	0x00391, 0x00392, 0x00393, 0x00394, 0x00395, 0x00396, 0x00397, 0x00398, 0x00399, 0x0039A, 0x0039B, 0x0039C,
+	0x0039D, 0x0039E, 0x0039F, 0x003A0, 0x003A1, 0x003A3, 0x003A4, 0x003A5, 0x003A6, 0x003A7, 0x003A8, 0x003A9,
+	0x02664, 0x02660, 0x02662, 0x02666, 0x02661, 0x02665, 0x02667, 0x02663, 0x003B1, 0x003B2, 0x003B3, 0x003B4,
+	0x003B5, 0x003B6, 0x003B7, 0x003B8, 0x003B9, 0x003BA, 0x003BB, 0x003BC, 0x003BD, 0x003BE, 0x003BF, 0x003C0,
+	0x003C1, 0x003C3, 0x003C4, 0x003C5, 0x003C6, 0x003C7, 0x003C8, 0x003C9, 0x003C2, 0x024F5, 0x024F6, 0x024F7,
+	0x024F8, 0x024F9, 0x024FA, 0x024FB, 0x024FC, 0x024FD, 0x024FE, 0x02616, 0x02617, 0x03020, 0x0260E, 0x02600,
+	0x02601, 0x02602, 0x02603, 0x02668, 0x025B1, 0x031F0, 0x031F1, 0x031F2, 0x031F3, 0x031F4, 0x031F5, 0x031F6,
+	0x031F7, 0x031F8, 0x031F9, 0x00000, 0x031FA, 0x031FB, 0x031FC, 0x031FD, 0x031FE, 0x031FF,
+	// row 7
+	0x00410, 0x00411, 0x00412, 0x00413, 0x00414, 0x00415, 0x00401, 0x00416, 0x00417, 0x00418, 0x00419, 0x0041A,
+	0x0041B, 0x0041C, 0x0041D, 0x0041E, 0x0041F, 0x00420, 0x00421, 0x00422, 0x00423, 0x00424, 0x00425, 0x00426,
+	0x00427, 0x00428, 0x00429, 0x0042A, 0x0042B, 0x0042C, 0x0042D, 0x0042E, 0x0042F, 0x023BE, 0x023BF, 0x023C0,
+	0x023C1, 0x023C2, 0x023C3, 0x023C4, 0x023C5, 0x023C6, 0x023C7, 0x023C8, 0x023C9, 0x023CA, 0x023CB, 0x023CC,
+	0x00430, 0x00431, 0x00432, 0x00433, 0x00434, 0x00435, 0x00451, 0x00436, 0x00437, 0x00438, 0x00439, 0x0043A,
+	0x0043B, 0x0043C, 0x0043D, 0x0043E, 0x0043F, 0x00440, 0x00441, 0x00442, 0x00443, 0x00444, 0x00445, 0x00446,
+	0x00447, 0x00448, 0x00449, 0x0044A, 0x0044B, 0x0044C, 0x0044D, 0x0044E, 0x0044F, 0x030F7, 0x030F8, 0x030F9,
+	0x030FA, 0x022DA, 0x022DB, 0x02153, 0x02154, 0x02155, 0x02713, 0x02318, 0x02423, 0x023CE,
+	// row 8
+	0x02500, 0x02502, 0x0250C, 0x02510, 0x02518, 0x02514, 0x0251C, 0x0252C, 0x02524, 0x02534, 0x0253C, 0x02501,
+	0x02503, 0x0250F, 0x02513, 0x0251B, 0x02517, 0x02523, 0x02533, 0x0252B, 0x0253B, 0x0254B, 0x02520, 0x0252F,
+	0x02528, 0x02537, 0x0253F, 0x0251D, 0x02530, 0x02525, 0x02538, 0x02542, 0x03251, 0x03252, 0x03253, 0x03254,
+	0x03255, 0x03256, 0x03257, 0x03258, 0x03259, 0x0325A, 0x0325B, 0x0325C, 0x0325D, 0x0325E, 0x0325F, 0x032B1,
+	0x032B2, 0x032B3, 0x032B4, 0x032B5, 0x032B6, 0x032B7, 0x032B8, 0x032B9, 0x032BA, 0x032BB, 0x032BC, 0x032BD,
+	0x032BE, 0x032BF, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x025D0, 0x025D1,
+	0x025D2, 0x025D3, 0x0203C, 0x02047, 0x02048, 0x02049, 0x001CD, 0x001CE, 0x001D0, 0x01E3E, 0x01E3F, 0x001F8,
+	0x001F9, 0x001D1, 0x001D2, 0x001D4, 0x001D6, 0x001D8, 0x001DA, 0x001DC, 0x00000, 0x00000,
+	// row 9
+	0x020AC, 0x000A0, 0x000A1, 0x000A4, 0x000A6, 0x000A9, 0x000AA, 0x000AB, 0x000AD, 0x000AE, 0x000AF, 0x000B2,
+	0x000B3, 0x000B7, 0x000B8, 0x000B9, 0x000BA, 0x000BB, 0x000BC, 0x000BD, 0x000BE, 0x000BF, 0x000C0, 0x000C1,
+	0x000C2, 0x000C3, 0x000C4, 0x000C5, 0x000C6, 0x000C7, 0x000C8, 0x000C9, 0x000CA, 0x000CB, 0x000CC, 0x000CD,
+	0x000CE, 0x000CF, 0x000D0, 0x000D1, 0x000D2, 0x000D3, 0x000D4, 0x000D5, 0x000D6, 0x000D8, 0x000D9, 0x000DA,
+	0x000DB, 0x000DC, 0x000DD, 0x000DE, 0x000DF, 0x000E0, 0x000E1, 0x000E2, 0x000E3, 0x000E4, 0x000E5, 0x000E6,
+	0x000E7, 0x000E8, 0x000E9, 0x000EA, 0x000EB, 0x000EC, 0x000ED, 0x000EE, 0x000EF, 0x000F0, 0x000F1, 0x000F2,
+	0x000F3, 0x000F4, 0x000F5, 0x000F6, 0x000F8, 0x000F9, 0x000FA, 0x000FB, 0x000FC, 0x000FD, 0x000FE, 0x000FF,
+	0x00100, 0x0012A, 0x0016A, 0x00112, 0x0014C, 0x00101, 0x0012B, 0x0016B, 0x00113, 0x0014D,
+	// row 10
+	0x00104, 0x002D8, 0x00141, 0x0013D, 0x0015A, 0x00160, 0x0015E, 0x00164, 0x00179, 0x0017D, 0x0017B, 0x00105,
+	0x002DB, 0x00142, 0x0013E, 0x0015B, 0x002C7, 0x00161, 0x0015F, 0x00165, 0x0017A, 0x002DD, 0x0017E, 0x0017C,
+	0x00154, 0x00102, 0x00139, 0x00106, 0x0010C, 0x00118, 0x0011A, 0x0010E, 0x00143, 0x00147, 0x00150, 0x00158,
+	0x0016E, 0x00170, 0x00162, 0x00155, 0x00103, 0x0013A, 0x00107, 0x0010D, 0x00119, 0x0011B, 0x0010F, 0x00111,
+	0x00144, 0x00148, 0x00151, 0x00159, 0x0016F, 0x00171, 0x00163, 0x002D9, 0x00108, 0x0011C, 0x00124, 0x00134,
+	0x0015C, 0x0016C, 0x00109, 0x0011D, 0x00125, 0x00135, 0x0015D, 0x0016D, 0x00271, 0x0028B, 0x0027E, 0x00283,
+	0x00292, 0x0026C, 0x0026E, 0x00279, 0x00288, 0x00256, 0x00273, 0x0027D, 0x00282, 0x00290, 0x0027B, 0x0026D,
+	0x0025F, 0x00272, 0x0029D, 0x0028E, 0x00261, 0x0014B, 0x00270, 0x00281, 0x00127, 0x00295,
+	// row 11
+	0x00294, 0x00266, 0x00298, 0x001C2, 0x00253, 0x00257, 0x00284, 0x00260, 0x00193, 0x00153, 0x00152, 0x00268,
+	0x00289, 0x00258, 0x00275, 0x00259, 0x0025C, 0x0025E, 0x00250, 0x0026F, 0x0028A, 0x00264, 0x0028C, 0x00254,
+	0x00251, 0x00252, 0x0028D, 0x00265, 0x002A2, 0x002A1, 0x00255, 0x00291, 0x0027A, 0x00267, 0x0025A, 0x00000,
+	0x001FD, 0x01F70, 0x01F71, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x01F72,
+	0x01F73, 0x00361, 0x002C8, 0x002CC, 0x002D0, 0x002D1, 0x00306, 0x0203F, 0x0030B, 0x00301, 0x00304, 0x00300,
+	0x0030F, 0x0030C, 0x00302, 0x002E5, 0x002E6, 0x002E7, 0x002E8, 0x002E9, 0x00000, 0x00000, 0x00325, 0x0032C,
+	0x00339, 0x0031C, 0x0031F, 0x00320, 0x00308, 0x0033D, 0x00329, 0x0032F, 0x002DE, 0x00324, 0x00330, 0x0033C,
+	0x00334, 0x0031D, 0x0031E, 0x00318, 0x00319, 0x0032A, 0x0033A, 0x0033B, 0x00303, 0x0031A,
+	// row 12
+	0x02776, 0x02777, 0x02778, 0x02779, 0x0277A, 0x0277B, 0x0277C, 0x0277D, 0x0277E, 0x0277F, 0x024EB, 0x024EC,
+	0x024ED, 0x024EE, 0x024EF, 0x024F0, 0x024F1, 0x024F2, 0x024F3, 0x024F4, 0x02170, 0x02171, 0x02172, 0x02173,
+	0x02174, 0x02175, 0x02176, 0x02177, 0x02178, 0x02179, 0x0217A, 0x0217B, 0x024D0, 0x024D1, 0x024D2, 0x024D3,
+	0x024D4, 0x024D5, 0x024D6, 0x024D7, 0x024D8, 0x024D9, 0x024DA, 0x024DB, 0x024DC, 0x024DD, 0x024DE, 0x024DF,
+	0x024E0, 0x024E1, 0x024E2, 0x024E3, 0x024E4, 0x024E5, 0x024E6, 0x024E7, 0x024E8, 0x024E9, 0x032D0, 0x032D1,
+	0x032D2, 0x032D3, 0x032D4, 0x032D5, 0x032D6, 0x032D7, 0x032D8, 0x032D9, 0x032DA, 0x032DB, 0x032DC, 0x032DD,
+	0x032DE, 0x032DF, 0x032E0, 0x032E1, 0x032E2, 0x032E3, 0x032FA, 0x032E9, 0x032E5, 0x032ED, 0x032EC, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x02051, 0x02042,
+	// row 13
+	0x02460, 0x02461, 0x02462, 0x02463, 0x02464, 0x02465, 0x02466, 0x02467, 0x02468, 0x02469, 0x0246A, 0x0246B,
+	0x0246C, 0x0246D, 0x0246E, 0x0246F, 0x02470, 0x02471, 0x02472, 0x02473, 0x02160, 0x02161, 0x02162, 0x02163,
+	0x02164, 0x02165, 0x02166, 0x02167, 0x02168, 0x02169, 0x0216A, 0x03349, 0x03314, 0x03322, 0x0334D, 0x03318,
+	0x03327, 0x03303, 0x03336, 0x03351, 0x03357, 0x0330D, 0x03326, 0x03323, 0x0332B, 0x0334A, 0x0333B, 0x0339C,
+	0x0339D, 0x0339E, 0x0338E, 0x0338F, 0x033C4, 0x033A1, 0x0216B, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+	0x00000, 0x00000, 0x0337B, 0x0301D, 0x0301F, 0x02116, 0x033CD, 0x02121, 0x032A4, 0x032A5, 0x032A6, 0x032A7,
+	0x032A8, 0x03231, 0x03232, 0x03239, 0x0337E, 0x0337D, 0x0337C, 0x00000, 0x00000, 0x00000, 0x0222E, 0x00000,
+	0x00000, 0x00000, 0x00000, 0x0221F, 0x022BF, 0x00000, 0x00000, 0x00000, 0x02756, 0x0261E,
+	// row 14
+	0x04FF1, 0x2000B, 0x03402, 0x04E28, 0x04E2F, 0x04E30, 0x04E8D, 0x04EE1, 0x04EFD, 0x04EFF, 0x04F03, 0x04F0B,
+	0x04F60, 0x04F48, 0x04F49, 0x04F56, 0x04F5F, 0x04F6A, 0x04F6C, 0x04F7E, 0x04F8A, 0x04F94, 0x04F97, 0x0FA30,
+	0x04FC9, 0x04FE0, 0x05001, 0x05002, 0x0500E, 0x05018, 0x05027, 0x0502E, 0x05040, 0x0503B, 0x05041, 0x05094,
+	0x050CC, 0x050F2, 0x050D0, 0x050E6, 0x0FA31, 0x05106, 0x05103, 0x0510B, 0x0511E, 0x05135, 0x0514A, 0x0FA32,
+	0x05155, 0x05157, 0x034B5, 0x0519D, 0x051C3, 0x051CA, 0x051DE, 0x051E2, 0x051EE, 0x05201, 0x034DB, 0x05213,
+	0x05215, 0x05249, 0x05257, 0x05261, 0x05293, 0x052C8, 0x0FA33, 0x052CC, 0x052D0, 0x052D6, 0x052DB, 0x0FA34,
+	0x052F0, 0x052FB, 0x05300, 0x05307, 0x0531C, 0x0FA35, 0x05361, 0x05363, 0x0537D, 0x05393, 0x0539D, 0x053B2,
+	0x05412, 0x05427, 0x0544D, 0x0549C, 0x0546B, 0x05474, 0x0547F, 0x05488, 0x05496, 0x054A1,
+	// row 15
+	0x054A9, 0x054C6, 0x054FF, 0x0550E, 0x0552B, 0x05535, 0x05550, 0x0555E, 0x05581, 0x05586, 0x0558E, 0x0FA36,
+	0x055AD, 0x055CE, 0x0FA37, 0x05608, 0x0560E, 0x0563B, 0x05649, 0x05676, 0x05666, 0x0FA38, 0x0566F, 0x05671,
+	0x05672, 0x05699, 0x0569E, 0x056A9, 0x056AC, 0x056B3, 0x056C9, 0x056CA, 0x0570A, 0x2123D, 0x05721, 0x0572F,
+	0x05733, 0x05734, 0x05770, 0x05777, 0x0577C, 0x0579C, 0x0FA0F, 0x2131B, 0x057B8, 0x057C7, 0x057C8, 0x057CF,
+	0x057E4, 0x057ED, 0x057F5, 0x057F6, 0x057FF, 0x05809, 0x0FA10, 0x05861, 0x05864, 0x0FA39, 0x0587C, 0x05889,
+	0x0589E, 0x0FA3A, 0x058A9, 0x2146E, 0x058D2, 0x058CE, 0x058D4, 0x058DA, 0x058E0, 0x058E9, 0x0590C, 0x08641,
+	0x0595D, 0x0596D, 0x0598B, 0x05992, 0x059A4, 0x059C3, 0x059D2, 0x059DD, 0x05A13, 0x05A23, 0x05A67, 0x05A6D,
+	0x05A77, 0x05A7E, 0x05A84, 0x05A9E, 0x05AA7, 0x05AC4, 0x218BD, 0x05B19, 0x05B25, 0x0525D,
+	// row 16
+	0x04E9C, 0x05516, 0x05A03, 0x0963F, 0x054C0, 0x0611B, 0x06328, 0x059F6, 0x09022, 0x08475, 0x0831C, 0x07A50,
+	0x060AA, 0x063E1, 0x06E25, 0x065ED, 0x08466, 0x082A6, 0x09BF5, 0x06893, 0x05727, 0x065A1, 0x06271, 0x05B9B,
+	0x059D0, 0x0867B, 0x098F4, 0x07D62, 0x07DBE, 0x09B8E, 0x06216, 0x07C9F, 0x088B7, 0x05B89, 0x05EB5, 0x06309,
+	0x06697, 0x06848, 0x095C7, 0x0978D, 0x0674F, 0x04EE5, 0x04F0A, 0x04F4D, 0x04F9D, 0x05049, 0x056F2, 0x05937,
+	0x059D4, 0x05A01, 0x05C09, 0x060DF, 0x0610F, 0x06170, 0x06613, 0x06905, 0x070BA, 0x0754F, 0x07570, 0x079FB,
+	0x07DAD, 0x07DEF, 0x080C3, 0x0840E, 0x08863, 0x08B02, 0x09055, 0x0907A, 0x0533B, 0x04E95, 0x04EA5, 0x057DF,
+	0x080B2, 0x090C1, 0x078EF, 0x04E00, 0x058F1, 0x06EA2, 0x09038, 0x07A32, 0x08328, 0x0828B, 0x09C2F, 0x05141,
+	0x05370, 0x054BD, 0x054E1, 0x056E0, 0x059FB, 0x05F15, 0x098F2, 0x06DEB, 0x080E4, 0x0852D,
+	// row 17
+	0x09662, 0x09670, 0x096A0, 0x097FB, 0x0540B, 0x053F3, 0x05B87, 0x070CF, 0x07FBD, 0x08FC2, 0x096E8, 0x0536F,
+	0x09D5C, 0x07ABA, 0x04E11, 0x07893, 0x081FC, 0x06E26, 0x05618, 0x05504, 0x06B1D, 0x0851A, 0x09C3B, 0x059E5,
+	0x053A9, 0x06D66, 0x074DC, 0x0958F, 0x05642, 0x04E91, 0x0904B, 0x096F2, 0x0834F, 0x0990C, 0x053E1, 0x055B6,
+	0x05B30, 0x05F71, 0x06620, 0x066F3, 0x06804, 0x06C38, 0x06CF3, 0x06D29, 0x0745B, 0x076C8, 0x07A4E, 0x09834,
+	0x082F1, 0x0885B, 0x08A60, 0x092ED, 0x06DB2, 0x075AB, 0x076CA, 0x099C5, 0x060A6, 0x08B01, 0x08D8A, 0x095B2,
+	0x0698E, 0x053AD, 0x05186, 0x05712, 0x05830, 0x05944, 0x05BB4, 0x05EF6, 0x06028, 0x063A9, 0x063F4, 0x06CBF,
+	0x06F14, 0x0708E, 0x07114, 0x07159, 0x071D5, 0x0733F, 0x07E01, 0x08276, 0x082D1, 0x08597, 0x09060, 0x0925B,
+	0x09D1B, 0x05869, 0x065BC, 0x06C5A, 0x07525, 0x051F9, 0x0592E, 0x05965, 0x05F80, 0x05FDC,
+	// row 18
+	0x062BC, 0x065FA, 0x06A2A, 0x06B27, 0x06BB4, 0x0738B, 0x07FC1, 0x08956, 0x09D2C, 0x09D0E, 0x09EC4, 0x05CA1,
+	0x06C96, 0x0837B, 0x05104, 0x05C4B, 0x061B6, 0x081C6, 0x06876, 0x07261, 0x04E59, 0x04FFA, 0x05378, 0x06069,
+	0x06E29, 0x07A4F, 0x097F3, 0x04E0B, 0x05316, 0x04EEE, 0x04F55, 0x04F3D, 0x04FA1, 0x04F73, 0x052A0, 0x053EF,
+	0x05609, 0x0590F, 0x05AC1, 0x05BB6, 0x05BE1, 0x079D1, 0x06687, 0x0679C, 0x067B6, 0x06B4C, 0x06CB3, 0x0706B,
+	0x073C2, 0x0798D, 0x079BE, 0x07A3C, 0x07B87, 0x082B1, 0x082DB, 0x08304, 0x08377, 0x083EF, 0x083D3, 0x08766,
+	0x08AB2, 0x05629, 0x08CA8, 0x08FE6, 0x0904E, 0x0971E, 0x0868A, 0x04FC4, 0x05CE8, 0x06211, 0x07259, 0x0753B,
+	0x081E5, 0x082BD, 0x086FE, 0x08CC0, 0x096C5, 0x09913, 0x099D5, 0x04ECB, 0x04F1A, 0x089E3, 0x056DE, 0x0584A,
+	0x058CA, 0x05EFB, 0x05FEB, 0x0602A, 0x06094, 0x06062, 0x061D0, 0x06212, 0x062D0, 0x06539,
+	// row 19
+	0x09B41, 0x06666, 0x068B0, 0x06D77, 0x07070, 0x0754C, 0x07686, 0x07D75, 0x082A5, 0x087F9, 0x0958B, 0x0968E,
+	0x08C9D, 0x051F1, 0x052BE, 0x05916, 0x054B3, 0x05BB3, 0x05D16, 0x06168, 0x06982, 0x06DAF, 0x0788D, 0x084CB,
+	0x08857, 0x08A72, 0x093A7, 0x09AB8, 0x06D6C, 0x099A8, 0x086D9, 0x057A3, 0x067FF, 0x086CE, 0x0920E, 0x05283,
+	0x05687, 0x05404, 0x05ED3, 0x062E1, 0x064B9, 0x0683C, 0x06838, 0x06BBB, 0x07372, 0x078BA, 0x07A6B, 0x0899A,
+	0x089D2, 0x08D6B, 0x08F03, 0x090ED, 0x095A3, 0x09694, 0x09769, 0x05B66, 0x05CB3, 0x0697D, 0x0984D, 0x0984E,
+	0x0639B, 0x07B20, 0x06A2B, 0x06A7F, 0x068B6, 0x09C0D, 0x06F5F, 0x05272, 0x0559D, 0x06070, 0x062EC, 0x06D3B,
+	0x06E07, 0x06ED1, 0x0845B, 0x08910, 0x08F44, 0x04E14, 0x09C39, 0x053F6, 0x0691B, 0x06A3A, 0x09784, 0x0682A,
+	0x0515C, 0x07AC3, 0x084B2, 0x091DC, 0x0938C, 0x0565B, 0x09D28, 0x06822, 0x08305, 0x08431,
+	// row 20
+	0x07CA5, 0x05208, 0x082C5, 0x074E6, 0x04E7E, 0x04F83, 0x051A0, 0x05BD2, 0x0520A, 0x052D8, 0x052E7, 0x05DFB,
+	0x0559A, 0x0582A, 0x059E6, 0x05B8C, 0x05B98, 0x05BDB, 0x05E72, 0x05E79, 0x060A3, 0x0611F, 0x06163, 0x061BE,
+	0x063DB, 0x06562, 0x067D1, 0x06853, 0x068FA, 0x06B3E, 0x06B53, 0x06C57, 0x06F22, 0x06F97, 0x06F45, 0x074B0,
+	0x07518, 0x076E3, 0x0770B, 0x07AFF, 0x07BA1, 0x07C21, 0x07DE9, 0x07F36, 0x07FF0, 0x0809D, 0x08266, 0x0839E,
+	0x089B3, 0x08ACC, 0x08CAB, 0x09084, 0x09451, 0x09593, 0x09591, 0x095A2, 0x09665, 0x097D3, 0x09928, 0x08218,
+	0x04E38, 0x0542B, 0x05CB8, 0x05DCC, 0x073A9, 0x0764C, 0x0773C, 0x05CA9, 0x07FEB, 0x08D0B, 0x096C1, 0x09811,
+	0x09854, 0x09858, 0x04F01, 0x04F0E, 0x05371, 0x0559C, 0x05668, 0x057FA, 0x05947, 0x05B09, 0x05BC4, 0x05C90,
+	0x05E0C, 0x05E7E, 0x05FCC, 0x063EE, 0x0673A, 0x065D7, 0x065E2, 0x0671F, 0x068CB, 0x068C4,
+	// row 21
+	0x06A5F, 0x05E30, 0x06BC5, 0x06C17, 0x06C7D, 0x0757F, 0x07948, 0x05B63, 0x07A00, 0x07D00, 0x05FBD, 0x0898F,
+	0x08A18, 0x08CB4, 0x08D77, 0x08ECC, 0x08F1D, 0x098E2, 0x09A0E, 0x09B3C, 0x04E80, 0x0507D, 0x05100, 0x05993,
+	0x05B9C, 0x0622F, 0x06280, 0x064EC, 0x06B3A, 0x072A0, 0x07591, 0x07947, 0x07FA9, 0x087FB, 0x08ABC, 0x08B70,
+	0x063AC, 0x083CA, 0x097A0, 0x05409, 0x05403, 0x055AB, 0x06854, 0x06A58, 0x08A70, 0x07827, 0x06775, 0x09ECD,
+	0x05374, 0x05BA2, 0x0811A, 0x08650, 0x09006, 0x04E18, 0x04E45, 0x04EC7, 0x04F11, 0x053CA, 0x05438, 0x05BAE,
+	0x05F13, 0x06025, 0x06551, 0x0673D, 0x06C42, 0x06C72, 0x06CE3, 0x07078, 0x07403, 0x07A76, 0x07AAE, 0x07B08,
+	0x07D1A, 0x07CFE, 0x07D66, 0x065E7, 0x0725B, 0x053BB, 0x05C45, 0x05DE8, 0x062D2, 0x062E0, 0x06319, 0x06E20,
+	0x0865A, 0x08A31, 0x08DDD, 0x092F8, 0x06F01, 0x079A6, 0x09B5A, 0x04EA8, 0x04EAB, 0x04EAC,
+	// row 22
+	0x04F9B, 0x04FA0, 0x050D1, 0x05147, 0x07AF6, 0x05171, 0x051F6, 0x05354, 0x05321, 0x0537F, 0x053EB, 0x055AC,
+	0x05883, 0x05CE1, 0x05F37, 0x05F4A, 0x0602F, 0x06050, 0x0606D, 0x0631F, 0x06559, 0x06A4B, 0x06CC1, 0x072C2,
+	0x072ED, 0x077EF, 0x080F8, 0x08105, 0x08208, 0x0854E, 0x090F7, 0x093E1, 0x097FF, 0x09957, 0x09A5A, 0x04EF0,
+	0x051DD, 0x05C2D, 0x06681, 0x0696D, 0x05C40, 0x066F2, 0x06975, 0x07389, 0x06850, 0x07C81, 0x050C5, 0x052E4,
+	0x05747, 0x05DFE, 0x09326, 0x065A4, 0x06B23, 0x06B3D, 0x07434, 0x07981, 0x079BD, 0x07B4B, 0x07DCA, 0x082B9,
+	0x083CC, 0x0887F, 0x0895F, 0x08B39, 0x08FD1, 0x091D1, 0x0541F, 0x09280, 0x04E5D, 0x05036, 0x053E5, 0x0533A,
+	0x072D7, 0x07396, 0x077E9, 0x082E6, 0x08EAF, 0x099C6, 0x099C8, 0x099D2, 0x05177, 0x0611A, 0x0865E, 0x055B0,
+	0x07A7A, 0x05076, 0x05BD3, 0x09047, 0x09685, 0x04E32, 0x06ADB, 0x091E7, 0x05C51, 0x05C48,
+	// row 23
+	0x06398, 0x07A9F, 0x06C93, 0x09774, 0x08F61, 0x07AAA, 0x0718A, 0x09688, 0x07C82, 0x06817, 0x07E70, 0x06851,
+	0x0936C, 0x052F2, 0x0541B, 0x085AB, 0x08A13, 0x07FA4, 0x08ECD, 0x090E1, 0x05366, 0x08888, 0x07941, 0x04FC2,
+	0x050BE, 0x05211, 0x05144, 0x05553, 0x0572D, 0x073EA, 0x0578B, 0x05951, 0x05F62, 0x05F84, 0x06075, 0x06176,
+	0x06167, 0x061A9, 0x063B2, 0x0643A, 0x0656C, 0x0666F, 0x06842, 0x06E13, 0x07566, 0x07A3D, 0x07CFB, 0x07D4C,
+	0x07D99, 0x07E4B, 0x07F6B, 0x0830E, 0x0834A, 0x086CD, 0x08A08, 0x08A63, 0x08B66, 0x08EFD, 0x0981A, 0x09D8F,
+	0x082B8, 0x08FCE, 0x09BE8, 0x05287, 0x0621F, 0x06483, 0x06FC0, 0x09699, 0x06841, 0x05091, 0x06B20, 0x06C7A,
+	0x06F54, 0x07A74, 0x07D50, 0x08840, 0x08A23, 0x06708, 0x04EF6, 0x05039, 0x05026, 0x05065, 0x0517C, 0x05238,
+	0x05263, 0x055A7, 0x0570F, 0x05805, 0x05ACC, 0x05EFA, 0x061B2, 0x061F8, 0x062F3, 0x06372,
+	// row 24
+	0x0691C, 0x06A29, 0x0727D, 0x072AC, 0x0732E, 0x07814, 0x0786F, 0x07D79, 0x0770C, 0x080A9, 0x0898B, 0x08B19,
+	0x08CE2, 0x08ED2, 0x09063, 0x09375, 0x0967A, 0x09855, 0x09A13, 0x09E78, 0x05143, 0x0539F, 0x053B3, 0x05E7B,
+	0x05F26, 0x06E1B, 0x06E90, 0x07384, 0x073FE, 0x07D43, 0x08237, 0x08A00, 0x08AFA, 0x09650, 0x04E4E, 0x0500B,
+	0x053E4, 0x0547C, 0x056FA, 0x059D1, 0x05B64, 0x05DF1, 0x05EAB, 0x05F27, 0x06238, 0x06545, 0x067AF, 0x06E56,
+	0x072D0, 0x07CCA, 0x088B4, 0x080A1, 0x080E1, 0x083F0, 0x0864E, 0x08A87, 0x08DE8, 0x09237, 0x096C7, 0x09867,
+	0x09F13, 0x04E94, 0x04E92, 0x04F0D, 0x05348, 0x05449, 0x0543E, 0x05A2F, 0x05F8C, 0x05FA1, 0x0609F, 0x068A7,
+	0x06A8E, 0x0745A, 0x07881, 0x08A9E, 0x08AA4, 0x08B77, 0x09190, 0x04E5E, 0x09BC9, 0x04EA4, 0x04F7C, 0x04FAF,
+	0x05019, 0x05016, 0x05149, 0x0516C, 0x0529F, 0x052B9, 0x052FE, 0x0539A, 0x053E3, 0x05411,
+	// row 25
+	0x0540E, 0x05589, 0x05751, 0x057A2, 0x0597D, 0x05B54, 0x05B5D, 0x05B8F, 0x05DE5, 0x05DE7, 0x05DF7, 0x05E78,
+	0x05E83, 0x05E9A, 0x05EB7, 0x05F18, 0x06052, 0x0614C, 0x06297, 0x062D8, 0x063A7, 0x0653B, 0x06602, 0x06643,
+	0x066F4, 0x0676D, 0x06821, 0x06897, 0x069CB, 0x06C5F, 0x06D2A, 0x06D69, 0x06E2F, 0x06E9D, 0x07532, 0x07687,
+	0x0786C, 0x07A3F, 0x07CE0, 0x07D05, 0x07D18, 0x07D5E, 0x07DB1, 0x08015, 0x08003, 0x080AF, 0x080B1, 0x08154,
+	0x0818F, 0x0822A, 0x08352, 0x0884C, 0x08861, 0x08B1B, 0x08CA2, 0x08CFC, 0x090CA, 0x09175, 0x09271, 0x0783F,
+	0x092FC, 0x095A4, 0x0964D, 0x09805, 0x09999, 0x09AD8, 0x09D3B, 0x0525B, 0x052AB, 0x053F7, 0x05408, 0x058D5,
+	0x062F7, 0x06FE0, 0x08C6A, 0x08F5F, 0x09EB9, 0x0514B, 0x0523B, 0x0544A, 0x056FD, 0x07A40, 0x09177, 0x09D60,
+	0x09ED2, 0x07344, 0x06F09, 0x08170, 0x07511, 0x05FFD, 0x060DA, 0x09AA8, 0x072DB, 0x08FBC,
+	// row 26
+	0x06B64, 0x09803, 0x04ECA, 0x056F0, 0x05764, 0x058BE, 0x05A5A, 0x06068, 0x061C7, 0x0660F, 0x06606, 0x06839,
+	0x068B1, 0x06DF7, 0x075D5, 0x07D3A, 0x0826E, 0x09B42, 0x04E9B, 0x04F50, 0x053C9, 0x05506, 0x05D6F, 0x05DE6,
+	0x05DEE, 0x067FB, 0x06C99, 0x07473, 0x07802, 0x08A50, 0x09396, 0x088DF, 0x05750, 0x05EA7, 0x0632B, 0x050B5,
+	0x050AC, 0x0518D, 0x06700, 0x054C9, 0x0585E, 0x059BB, 0x05BB0, 0x05F69, 0x0624D, 0x063A1, 0x0683D, 0x06B73,
+	0x06E08, 0x0707D, 0x091C7, 0x07280, 0x07815, 0x07826, 0x0796D, 0x0658E, 0x07D30, 0x083DC, 0x088C1, 0x08F09,
+	0x0969B, 0x05264, 0x05728, 0x06750, 0x07F6A, 0x08CA1, 0x051B4, 0x05742, 0x0962A, 0x0583A, 0x0698A, 0x080B4,
+	0x054B2, 0x05D0E, 0x057FC, 0x07895, 0x09DFA, 0x04F5C, 0x0524A, 0x0548B, 0x0643E, 0x06628, 0x06714, 0x067F5,
+	0x07A84, 0x07B56, 0x07D22, 0x0932F, 0x0685C, 0x09BAD, 0x07B39, 0x05319, 0x0518A, 0x05237,
+	// row 27
+	0x05BDF, 0x062F6, 0x064AE, 0x064E6, 0x0672D, 0x06BBA, 0x085A9, 0x096D1, 0x07690, 0x09BD6, 0x0634C, 0x09306,
+	0x09BAB, 0x076BF, 0x06652, 0x04E09, 0x05098, 0x053C2, 0x05C71, 0x060E8, 0x06492, 0x06563, 0x0685F, 0x071E6,
+	0x073CA, 0x07523, 0x07B97, 0x07E82, 0x08695, 0x08B83, 0x08CDB, 0x09178, 0x09910, 0x065AC, 0x066AB, 0x06B8B,
+	0x04ED5, 0x04ED4, 0x04F3A, 0x04F7F, 0x0523A, 0x053F8, 0x053F2, 0x055E3, 0x056DB, 0x058EB, 0x059CB, 0x059C9,
+	0x059FF, 0x05B50, 0x05C4D, 0x05E02, 0x05E2B, 0x05FD7, 0x0601D, 0x06307, 0x0652F, 0x05B5C, 0x065AF, 0x065BD,
+	0x065E8, 0x0679D, 0x06B62, 0x06B7B, 0x06C0F, 0x07345, 0x07949, 0x079C1, 0x07CF8, 0x07D19, 0x07D2B, 0x080A2,
+	0x08102, 0x081F3, 0x08996, 0x08A5E, 0x08A69, 0x08A66, 0x08A8C, 0x08AEE, 0x08CC7, 0x08CDC, 0x096CC, 0x098FC,
+	0x06B6F, 0x04E8B, 0x04F3C, 0x04F8D, 0x05150, 0x05B57, 0x05BFA, 0x06148, 0x06301, 0x06642,
+	// row 28
+	0x06B21, 0x06ECB, 0x06CBB, 0x0723E, 0x074BD, 0x075D4, 0x078C1, 0x0793A, 0x0800C, 0x08033, 0x081EA, 0x08494,
+	0x08F9E, 0x06C50, 0x09E7F, 0x05F0F, 0x08B58, 0x09D2B, 0x07AFA, 0x08EF8, 0x05B8D, 0x096EB, 0x04E03, 0x053F1,
+	0x057F7, 0x05931, 0x05AC9, 0x05BA4, 0x06089, 0x06E7F, 0x06F06, 0x075BE, 0x08CEA, 0x05B9F, 0x08500, 0x07BE0,
+	0x05072, 0x067F4, 0x0829D, 0x05C61, 0x0854A, 0x07E1E, 0x0820E, 0x05199, 0x05C04, 0x06368, 0x08D66, 0x0659C,
+	0x0716E, 0x0793E, 0x07D17, 0x08005, 0x08B1D, 0x08ECA, 0x0906E, 0x086C7, 0x090AA, 0x0501F, 0x052FA, 0x05C3A,
+	0x06753, 0x0707C, 0x07235, 0x0914C, 0x091C8, 0x0932B, 0x082E5, 0x05BC2, 0x05F31, 0x060F9, 0x04E3B, 0x053D6,
+	0x05B88, 0x0624B, 0x06731, 0x06B8A, 0x072E9, 0x073E0, 0x07A2E, 0x0816B, 0x08DA3, 0x09152, 0x09996, 0x05112,
+	0x053D7, 0x0546A, 0x05BFF, 0x06388, 0x06A39, 0x07DAC, 0x09700, 0x056DA, 0x053CE, 0x05468,
+	// row 29
+	0x05B97, 0x05C31, 0x05DDE, 0x04FEE, 0x06101, 0x062FE, 0x06D32, 0x079C0, 0x079CB, 0x07D42, 0x07E4D, 0x07FD2,
+	0x081ED, 0x0821F, 0x08490, 0x08846, 0x08972, 0x08B90, 0x08E74, 0x08F2F, 0x09031, 0x0914B, 0x0916C, 0x096C6,
+	0x0919C, 0x04EC0, 0x04F4F, 0x05145, 0x05341, 0x05F93, 0x0620E, 0x067D4, 0x06C41, 0x06E0B, 0x07363, 0x07E26,
+	0x091CD, 0x09283, 0x053D4, 0x05919, 0x05BBF, 0x06DD1, 0x0795D, 0x07E2E, 0x07C9B, 0x0587E, 0x0719F, 0x051FA,
+	0x08853, 0x08FF0, 0x04FCA, 0x05CFB, 0x06625, 0x077AC, 0x07AE3, 0x0821C, 0x099FF, 0x051C6, 0x05FAA, 0x065EC,
+	0x0696F, 0x06B89, 0x06DF3, 0x06E96, 0x06F64, 0x076FE, 0x07D14, 0x05DE1, 0x09075, 0x09187, 0x09806, 0x051E6,
+	0x0521D, 0x06240, 0x06691, 0x066D9, 0x06E1A, 0x05EB6, 0x07DD2, 0x07F72, 0x066F8, 0x085AF, 0x085F7, 0x08AF8,
+	0x052A9, 0x053D9, 0x05973, 0x05E8F, 0x05F90, 0x06055, 0x092E4, 0x09664, 0x050B7, 0x0511F,
+	// row 30
+	0x052DD, 0x05320, 0x05347, 0x053EC, 0x054E8, 0x05546, 0x05531, 0x05617, 0x05968, 0x059BE, 0x05A3C, 0x05BB5,
+	0x05C06, 0x05C0F, 0x05C11, 0x05C1A, 0x05E84, 0x05E8A, 0x05EE0, 0x05F70, 0x0627F, 0x06284, 0x062DB, 0x0638C,
+	0x06377, 0x06607, 0x0660C, 0x0662D, 0x06676, 0x0677E, 0x068A2, 0x06A1F, 0x06A35, 0x06CBC, 0x06D88, 0x06E09,
+	0x06E58, 0x0713C, 0x07126, 0x07167, 0x075C7, 0x07701, 0x0785D, 0x07901, 0x07965, 0x079F0, 0x07AE0, 0x07B11,
+	0x07CA7, 0x07D39, 0x08096, 0x083D6, 0x0848B, 0x08549, 0x0885D, 0x088F3, 0x08A1F, 0x08A3C, 0x08A54, 0x08A73,
+	0x08C61, 0x08CDE, 0x091A4, 0x09266, 0x0937E, 0x09418, 0x0969C, 0x09798, 0x04E0A, 0x04E08, 0x04E1E, 0x04E57,
+	0x05197, 0x05270, 0x057CE, 0x05834, 0x058CC, 0x05B22, 0x05E38, 0x060C5, 0x064FE, 0x06761, 0x06756, 0x06D44,
+	0x072B6, 0x07573, 0x07A63, 0x084B8, 0x08B72, 0x091B8, 0x09320, 0x05631, 0x057F4, 0x098FE,
+	// row 31
+	0x062ED, 0x0690D, 0x06B96, 0x071ED, 0x07E54, 0x08077, 0x08272, 0x089E6, 0x098DF, 0x08755, 0x08FB1, 0x05C3B,
+	0x04F38, 0x04FE1, 0x04FB5, 0x05507, 0x05A20, 0x05BDD, 0x05BE9, 0x05FC3, 0x0614E, 0x0632F, 0x065B0, 0x0664B,
+	0x068EE, 0x0699B, 0x06D78, 0x06DF1, 0x07533, 0x075B9, 0x0771F, 0x0795E, 0x079E6, 0x07D33, 0x081E3, 0x082AF,
+	0x085AA, 0x089AA, 0x08A3A, 0x08EAB, 0x08F9B, 0x09032, 0x091DD, 0x09707, 0x04EBA, 0x04EC1, 0x05203, 0x05875,
+	0x058EC, 0x05C0B, 0x0751A, 0x05C3D, 0x0814E, 0x08A0A, 0x08FC5, 0x09663, 0x0976D, 0x07B25, 0x08ACF, 0x09808,
+	0x09162, 0x056F3, 0x053A8, 0x09017, 0x05439, 0x05782, 0x05E25, 0x063A8, 0x06C34, 0x0708A, 0x07761, 0x07C8B,
+	0x07FE0, 0x08870, 0x09042, 0x09154, 0x09310, 0x09318, 0x0968F, 0x0745E, 0x09AC4, 0x05D07, 0x05D69, 0x06570,
+	0x067A2, 0x08DA8, 0x096DB, 0x0636E, 0x06749, 0x06919, 0x083C5, 0x09817, 0x096C0, 0x088FE,
+	// row 32
+	0x06F84, 0x0647A, 0x05BF8, 0x04E16, 0x0702C, 0x0755D, 0x0662F, 0x051C4, 0x05236, 0x052E2, 0x059D3, 0x05F81,
+	0x06027, 0x06210, 0x0653F, 0x06574, 0x0661F, 0x06674, 0x068F2, 0x06816, 0x06B63, 0x06E05, 0x07272, 0x0751F,
+	0x076DB, 0x07CBE, 0x08056, 0x058F0, 0x088FD, 0x0897F, 0x08AA0, 0x08A93, 0x08ACB, 0x0901D, 0x09192, 0x09752,
+	0x09759, 0x06589, 0x07A0E, 0x08106, 0x096BB, 0x05E2D, 0x060DC, 0x0621A, 0x065A5, 0x06614, 0x06790, 0x077F3,
+	0x07A4D, 0x07C4D, 0x07E3E, 0x0810A, 0x08CAC, 0x08D64, 0x08DE1, 0x08E5F, 0x078A9, 0x05207, 0x062D9, 0x063A5,
+	0x06442, 0x06298, 0x08A2D, 0x07A83, 0x07BC0, 0x08AAC, 0x096EA, 0x07D76, 0x0820C, 0x08749, 0x04ED9, 0x05148,
+	0x05343, 0x05360, 0x05BA3, 0x05C02, 0x05C16, 0x05DDD, 0x06226, 0x06247, 0x064B0, 0x06813, 0x06834, 0x06CC9,
+	0x06D45, 0x06D17, 0x067D3, 0x06F5C, 0x0714E, 0x0717D, 0x065CB, 0x07A7F, 0x07BAD, 0x07DDA,
+	// row 33
+	0x07E4A, 0x07FA8, 0x0817A, 0x0821B, 0x08239, 0x085A6, 0x08A6E, 0x08CCE, 0x08DF5, 0x09078, 0x09077, 0x092AD,
+	0x09291, 0x09583, 0x09BAE, 0x0524D, 0x05584, 0x06F38, 0x07136, 0x05168, 0x07985, 0x07E55, 0x081B3, 0x07CCE,
+	0x0564C, 0x05851, 0x05CA8, 0x063AA, 0x066FE, 0x066FD, 0x0695A, 0x072D9, 0x0758F, 0x0758E, 0x0790E, 0x07956,
+	0x079DF, 0x07C97, 0x07D20, 0x07D44, 0x08607, 0x08A34, 0x0963B, 0x09061, 0x09F20, 0x050E7, 0x05275, 0x053CC,
+	0x053E2, 0x05009, 0x055AA, 0x058EE, 0x0594F, 0x0723D, 0x05B8B, 0x05C64, 0x0531D, 0x060E3, 0x060F3, 0x0635C,
+	0x06383, 0x0633F, 0x063BB, 0x064CD, 0x065E9, 0x066F9, 0x05DE3, 0x069CD, 0x069FD, 0x06F15, 0x071E5, 0x04E89,
+	0x075E9, 0x076F8, 0x07A93, 0x07CDF, 0x07DCF, 0x07D9C, 0x08061, 0x08349, 0x08358, 0x0846C, 0x084BC, 0x085FB,
+	0x088C5, 0x08D70, 0x09001, 0x0906D, 0x09397, 0x0971C, 0x09A12, 0x050CF, 0x05897, 0x0618E,
+	// row 34
+	0x081D3, 0x08535, 0x08D08, 0x09020, 0x04FC3, 0x05074, 0x05247, 0x05373, 0x0606F, 0x06349, 0x0675F, 0x06E2C,
+	0x08DB3, 0x0901F, 0x04FD7, 0x05C5E, 0x08CCA, 0x065CF, 0x07D9A, 0x05352, 0x08896, 0x05176, 0x063C3, 0x05B58,
+	0x05B6B, 0x05C0A, 0x0640D, 0x06751, 0x0905C, 0x04ED6, 0x0591A, 0x0592A, 0x06C70, 0x08A51, 0x0553E, 0x05815,
+	0x059A5, 0x060F0, 0x06253, 0x067C1, 0x08235, 0x06955, 0x09640, 0x099C4, 0x09A28, 0x04F53, 0x05806, 0x05BFE,
+	0x08010, 0x05CB1, 0x05E2F, 0x05F85, 0x06020, 0x0614B, 0x06234, 0x066FF, 0x06CF0, 0x06EDE, 0x080CE, 0x0817F,
+	0x082D4, 0x0888B, 0x08CB8, 0x09000, 0x0902E, 0x0968A, 0x09EDB, 0x09BDB, 0x04EE3, 0x053F0, 0x05927, 0x07B2C,
+	0x0918D, 0x0984C, 0x09DF9, 0x06EDD, 0x07027, 0x05353, 0x05544, 0x05B85, 0x06258, 0x0629E, 0x062D3, 0x06CA2,
+	0x06FEF, 0x07422, 0x08A17, 0x09438, 0x06FC1, 0x08AFE, 0x08338, 0x051E7, 0x086F8, 0x053EA,
+	// row 35
+	0x053E9, 0x04F46, 0x09054, 0x08FB0, 0x0596A, 0x08131, 0x05DFD, 0x07AEA, 0x08FBF, 0x068DA, 0x08C37, 0x072F8,
+	0x09C48, 0x06A3D, 0x08AB0, 0x04E39, 0x05358, 0x05606, 0x05766, 0x062C5, 0x063A2, 0x065E6, 0x06B4E, 0x06DE1,
+	0x06E5B, 0x070AD, 0x077ED, 0x07AEF, 0x07BAA, 0x07DBB, 0x0803D, 0x080C6, 0x086CB, 0x08A95, 0x0935B, 0x056E3,
+	0x058C7, 0x05F3E, 0x065AD, 0x06696, 0x06A80, 0x06BB5, 0x07537, 0x08AC7, 0x05024, 0x077E5, 0x05730, 0x05F1B,
+	0x06065, 0x0667A, 0x06C60, 0x075F4, 0x07A1A, 0x07F6E, 0x081F4, 0x08718, 0x09045, 0x099B3, 0x07BC9, 0x0755C,
+	0x07AF9, 0x07B51, 0x084C4, 0x09010, 0x079E9, 0x07A92, 0x08336, 0x05AE1, 0x07740, 0x04E2D, 0x04EF2, 0x05B99,
+	0x05FE0, 0x062BD, 0x0663C, 0x067F1, 0x06CE8, 0x0866B, 0x08877, 0x08A3B, 0x0914E, 0x092F3, 0x099D0, 0x06A17,
+	0x07026, 0x0732A, 0x082E7, 0x08457, 0x08CAF, 0x04E01, 0x05146, 0x051CB, 0x0558B, 0x05BF5,
+	// row 36
+	0x05E16, 0x05E33, 0x05E81, 0x05F14, 0x05F35, 0x05F6B, 0x05FB4, 0x061F2, 0x06311, 0x066A2, 0x0671D, 0x06F6E,
+	0x07252, 0x0753A, 0x0773A, 0x08074, 0x08139, 0x08178, 0x08776, 0x08ABF, 0x08ADC, 0x08D85, 0x08DF3, 0x0929A,
+	0x09577, 0x09802, 0x09CE5, 0x052C5, 0x06357, 0x076F4, 0x06715, 0x06C88, 0x073CD, 0x08CC3, 0x093AE, 0x09673,
+	0x06D25, 0x0589C, 0x0690E, 0x069CC, 0x08FFD, 0x0939A, 0x075DB, 0x0901A, 0x0585A, 0x06802, 0x063B4, 0x069FB,
+	0x04F43, 0x06F2C, 0x067D8, 0x08FBB, 0x08526, 0x07DB4, 0x09354, 0x0693F, 0x06F70, 0x0576A, 0x058F7, 0x05B2C,
+	0x07D2C, 0x0722A, 0x0540A, 0x091E3, 0x09DB4, 0x04EAD, 0x04F4E, 0x0505C, 0x05075, 0x05243, 0x08C9E, 0x05448,
+	0x05824, 0x05B9A, 0x05E1D, 0x05E95, 0x05EAD, 0x05EF7, 0x05F1F, 0x0608C, 0x062B5, 0x0633A, 0x063D0, 0x068AF,
+	0x06C40, 0x07887, 0x0798E, 0x07A0B, 0x07DE0, 0x08247, 0x08A02, 0x08AE6, 0x08E44, 0x09013,
+	// row 37
+	0x090B8, 0x0912D, 0x091D8, 0x09F0E, 0x06CE5, 0x06458, 0x064E2, 0x06575, 0x06EF4, 0x07684, 0x07B1B, 0x09069,
+	0x093D1, 0x06EBA, 0x054F2, 0x05FB9, 0x064A4, 0x08F4D, 0x08FED, 0x09244, 0x05178, 0x0586B, 0x05929, 0x05C55,
+	0x05E97, 0x06DFB, 0x07E8F, 0x0751C, 0x08CBC, 0x08EE2, 0x0985B, 0x070B9, 0x04F1D, 0x06BBF, 0x06FB1, 0x07530,
+	0x096FB, 0x0514E, 0x05410, 0x05835, 0x05857, 0x059AC, 0x05C60, 0x05F92, 0x06597, 0x0675C, 0x06E21, 0x0767B,
+	0x083DF, 0x08CED, 0x09014, 0x090FD, 0x0934D, 0x07825, 0x0783A, 0x052AA, 0x05EA6, 0x0571F, 0x05974, 0x06012,
+	0x05012, 0x0515A, 0x051AC, 0x051CD, 0x05200, 0x05510, 0x05854, 0x05858, 0x05957, 0x05B95, 0x05CF6, 0x05D8B,
+	0x060BC, 0x06295, 0x0642D, 0x06771, 0x06843, 0x068BC, 0x068DF, 0x076D7, 0x06DD8, 0x06E6F, 0x06D9B, 0x0706F,
+	0x071C8, 0x05F53, 0x075D8, 0x07977, 0x07B49, 0x07B54, 0x07B52, 0x07CD6, 0x07D71, 0x05230,
+	// row 38
+	0x08463, 0x08569, 0x085E4, 0x08A0E, 0x08B04, 0x08C46, 0x08E0F, 0x09003, 0x0900F, 0x09419, 0x09676, 0x0982D,
+	0x09A30, 0x095D8, 0x050CD, 0x052D5, 0x0540C, 0x05802, 0x05C0E, 0x061A7, 0x0649E, 0x06D1E, 0x077B3, 0x07AE5,
+	0x080F4, 0x08404, 0x09053, 0x09285, 0x05CE0, 0x09D07, 0x0533F, 0x05F97, 0x05FB3, 0x06D9C, 0x07279, 0x07763,
+	0x079BF, 0x07BE4, 0x06BD2, 0x072EC, 0x08AAD, 0x06803, 0x06A61, 0x051F8, 0x07A81, 0x06934, 0x05C4A, 0x09CF6,
+	0x082EB, 0x05BC5, 0x09149, 0x0701E, 0x05678, 0x05C6F, 0x060C7, 0x06566, 0x06C8C, 0x08C5A, 0x09041, 0x09813,
+	0x05451, 0x066C7, 0x0920D, 0x05948, 0x090A3, 0x05185, 0x04E4D, 0x051EA, 0x08599, 0x08B0E, 0x07058, 0x0637A,
+	0x0934B, 0x06962, 0x099B4, 0x07E04, 0x07577, 0x05357, 0x06960, 0x08EDF, 0x096E3, 0x06C5D, 0x04E8C, 0x05C3C,
+	0x05F10, 0x08FE9, 0x05302, 0x08CD1, 0x08089, 0x08679, 0x05EFF, 0x065E5, 0x04E73, 0x05165,
+	// row 39
+	0x05982, 0x05C3F, 0x097EE, 0x04EFB, 0x0598A, 0x05FCD, 0x08A8D, 0x06FE1, 0x079B0, 0x07962, 0x05BE7, 0x08471,
+	0x0732B, 0x071B1, 0x05E74, 0x05FF5, 0x0637B, 0x0649A, 0x071C3, 0x07C98, 0x04E43, 0x05EFC, 0x04E4B, 0x057DC,
+	0x056A2, 0x060A9, 0x06FC3, 0x07D0D, 0x080FD, 0x08133, 0x081BF, 0x08FB2, 0x08997, 0x086A4, 0x05DF4, 0x0628A,
+	0x064AD, 0x08987, 0x06777, 0x06CE2, 0x06D3E, 0x07436, 0x07834, 0x05A46, 0x07F75, 0x082AD, 0x099AC, 0x04FF3,
+	0x05EC3, 0x062DD, 0x06392, 0x06557, 0x0676F, 0x076C3, 0x0724C, 0x080CC, 0x080BA, 0x08F29, 0x0914D, 0x0500D,
+	0x057F9, 0x05A92, 0x06885, 0x06973, 0x07164, 0x072FD, 0x08CB7, 0x058F2, 0x08CE0, 0x0966A, 0x09019, 0x0877F,
+	0x079E4, 0x077E7, 0x08429, 0x04F2F, 0x05265, 0x0535A, 0x062CD, 0x067CF, 0x06CCA, 0x0767D, 0x07B94, 0x07C95,
+	0x08236, 0x08584, 0x08FEB, 0x066DD, 0x06F20, 0x07206, 0x07E1B, 0x083AB, 0x099C1, 0x09EA6,
+	// row 40
+	0x051FD, 0x07BB1, 0x07872, 0x07BB8, 0x08087, 0x07B48, 0x06AE8, 0x05E61, 0x0808C, 0x07551, 0x07560, 0x0516B,
+	0x09262, 0x06E8C, 0x0767A, 0x09197, 0x09AEA, 0x04F10, 0x07F70, 0x0629C, 0x07B4F, 0x095A5, 0x09CE9, 0x0567A,
+	0x05859, 0x086E4, 0x096BC, 0x04F34, 0x05224, 0x0534A, 0x053CD, 0x053DB, 0x05E06, 0x0642C, 0x06591, 0x0677F,
+	0x06C3E, 0x06C4E, 0x07248, 0x072AF, 0x073ED, 0x07554, 0x07E41, 0x0822C, 0x085E9, 0x08CA9, 0x07BC4, 0x091C6,
+	0x07169, 0x09812, 0x098EF, 0x0633D, 0x06669, 0x0756A, 0x076E4, 0x078D0, 0x08543, 0x086EE, 0x0532A, 0x05351,
+	0x05426, 0x05983, 0x05E87, 0x05F7C, 0x060B2, 0x06249, 0x06279, 0x062AB, 0x06590, 0x06BD4, 0x06CCC, 0x075B2,
+	0x076AE, 0x07891, 0x079D8, 0x07DCB, 0x07F77, 0x080A5, 0x088AB, 0x08AB9, 0x08CBB, 0x0907F, 0x0975E, 0x098DB,
+	0x06A0B, 0x07C38, 0x05099, 0x05C3E, 0x05FAE, 0x06787, 0x06BD8, 0x07435, 0x07709, 0x07F8E,
+	// row 41
+	0x09F3B, 0x067CA, 0x07A17, 0x05339, 0x0758B, 0x09AED, 0x05F66, 0x0819D, 0x083F1, 0x08098, 0x05F3C, 0x05FC5,
+	0x07562, 0x07B46, 0x0903C, 0x06867, 0x059EB, 0x05A9B, 0x07D10, 0x0767E, 0x08B2C, 0x04FF5, 0x05F6A, 0x06A19,
+	0x06C37, 0x06F02, 0x074E2, 0x07968, 0x08868, 0x08A55, 0x08C79, 0x05EDF, 0x063CF, 0x075C5, 0x079D2, 0x082D7,
+	0x09328, 0x092F2, 0x0849C, 0x086ED, 0x09C2D, 0x054C1, 0x05F6C, 0x0658C, 0x06D5C, 0x07015, 0x08CA7, 0x08CD3,
+	0x0983B, 0x0654F, 0x074F6, 0x04E0D, 0x04ED8, 0x057E0, 0x0592B, 0x05A66, 0x05BCC, 0x051A8, 0x05E03, 0x05E9C,
+	0x06016, 0x06276, 0x06577, 0x065A7, 0x0666E, 0x06D6E, 0x07236, 0x07B26, 0x08150, 0x0819A, 0x08299, 0x08B5C,
+	0x08CA0, 0x08CE6, 0x08D74, 0x0961C, 0x09644, 0x04FAE, 0x064AB, 0x06B66, 0x0821E, 0x08461, 0x0856A, 0x090E8,
+	0x05C01, 0x06953, 0x098A8, 0x0847A, 0x08557, 0x04F0F, 0x0526F, 0x05FA9, 0x05E45, 0x0670D,
+	// row 42
+	0x0798F, 0x08179, 0x08907, 0x08986, 0x06DF5, 0x05F17, 0x06255, 0x06CB8, 0x04ECF, 0x07269, 0x09B92, 0x05206,
+	0x0543B, 0x05674, 0x058B3, 0x061A4, 0x0626E, 0x0711A, 0x0596E, 0x07C89, 0x07CDE, 0x07D1B, 0x096F0, 0x06587,
+	0x0805E, 0x04E19, 0x04F75, 0x05175, 0x05840, 0x05E63, 0x05E73, 0x05F0A, 0x067C4, 0x04E26, 0x0853D, 0x09589,
+	0x0965B, 0x07C73, 0x09801, 0x050FB, 0x058C1, 0x07656, 0x078A7, 0x05225, 0x077A5, 0x08511, 0x07B86, 0x0504F,
+	0x05909, 0x07247, 0x07BC7, 0x07DE8, 0x08FBA, 0x08FD4, 0x0904D, 0x04FBF, 0x052C9, 0x05A29, 0x05F01, 0x097AD,
+	0x04FDD, 0x08217, 0x092EA, 0x05703, 0x06355, 0x06B69, 0x0752B, 0x088DC, 0x08F14, 0x07A42, 0x052DF, 0x05893,
+	0x06155, 0x0620A, 0x066AE, 0x06BCD, 0x07C3F, 0x083E9, 0x05023, 0x04FF8, 0x05305, 0x05446, 0x05831, 0x05949,
+	0x05B9D, 0x05CF0, 0x05CEF, 0x05D29, 0x05E96, 0x062B1, 0x06367, 0x0653E, 0x065B9, 0x0670B,
+	// row 43
+	0x06CD5, 0x06CE1, 0x070F9, 0x07832, 0x07E2B, 0x080DE, 0x082B3, 0x0840C, 0x084EC, 0x08702, 0x08912, 0x08A2A,
+	0x08C4A, 0x090A6, 0x092D2, 0x098FD, 0x09CF3, 0x09D6C, 0x04E4F, 0x04EA1, 0x0508D, 0x05256, 0x0574A, 0x059A8,
+	0x05E3D, 0x05FD8, 0x05FD9, 0x0623F, 0x066B4, 0x0671B, 0x067D0, 0x068D2, 0x05192, 0x07D21, 0x080AA, 0x081A8,
+	0x08B00, 0x08C8C, 0x08CBF, 0x0927E, 0x09632, 0x05420, 0x0982C, 0x05317, 0x050D5, 0x0535C, 0x058A8, 0x064B2,
+	0x06734, 0x07267, 0x07766, 0x07A46, 0x091E6, 0x052C3, 0x06CA1, 0x06B86, 0x05800, 0x05E4C, 0x05954, 0x0672C,
+	0x07FFB, 0x051E1, 0x076C6, 0x06469, 0x078E8, 0x09B54, 0x09EBB, 0x057CB, 0x059B9, 0x06627, 0x0679A, 0x06BCE,
+	0x054E9, 0x069D9, 0x05E55, 0x0819C, 0x06795, 0x09BAA, 0x067FE, 0x09C52, 0x0685D, 0x04EA6, 0x04FE3, 0x053C8,
+	0x062B9, 0x0672B, 0x06CAB, 0x08FC4, 0x04FAD, 0x07E6D, 0x09EBF, 0x04E07, 0x06162, 0x06E80,
+	// row 44
+	0x06F2B, 0x08513, 0x05473, 0x0672A, 0x09B45, 0x05DF3, 0x07B95, 0x05CAC, 0x05BC6, 0x0871C, 0x06E4A, 0x084D1,
+	0x07A14, 0x08108, 0x05999, 0x07C8D, 0x06C11, 0x07720, 0x052D9, 0x05922, 0x07121, 0x0725F, 0x077DB, 0x09727,
+	0x09D61, 0x0690B, 0x05A7F, 0x05A18, 0x051A5, 0x0540D, 0x0547D, 0x0660E, 0x076DF, 0x08FF7, 0x09298, 0x09CF4,
+	0x059EA, 0x0725D, 0x06EC5, 0x0514D, 0x068C9, 0x07DBF, 0x07DEC, 0x09762, 0x09EBA, 0x06478, 0x06A21, 0x08302,
+	0x05984, 0x05B5F, 0x06BDB, 0x0731B, 0x076F2, 0x07DB2, 0x08017, 0x08499, 0x05132, 0x06728, 0x09ED9, 0x076EE,
+	0x06762, 0x052FF, 0x09905, 0x05C24, 0x0623B, 0x07C7E, 0x08CB0, 0x0554F, 0x060B6, 0x07D0B, 0x09580, 0x05301,
+	0x04E5F, 0x051B6, 0x0591C, 0x0723A, 0x08036, 0x091CE, 0x05F25, 0x077E2, 0x05384, 0x05F79, 0x07D04, 0x085AC,
+	0x08A33, 0x08E8D, 0x09756, 0x067F3, 0x085AE, 0x09453, 0x06109, 0x06108, 0x06CB9, 0x07652,
+	// row 45
+	0x08AED, 0x08F38, 0x0552F, 0x04F51, 0x0512A, 0x052C7, 0x053CB, 0x05BA5, 0x05E7D, 0x060A0, 0x06182, 0x063D6,
+	0x06709, 0x067DA, 0x06E67, 0x06D8C, 0x07336, 0x07337, 0x07531, 0x07950, 0x088D5, 0x08A98, 0x0904A, 0x09091,
+	0x090F5, 0x096C4, 0x0878D, 0x05915, 0x04E88, 0x04F59, 0x04E0E, 0x08A89, 0x08F3F, 0x09810, 0x050AD, 0x05E7C,
+	0x05996, 0x05BB9, 0x05EB8, 0x063DA, 0x063FA, 0x064C1, 0x066DC, 0x0694A, 0x069D8, 0x06D0B, 0x06EB6, 0x07194,
+	0x07528, 0x07AAF, 0x07F8A, 0x08000, 0x08449, 0x084C9, 0x08981, 0x08B21, 0x08E0A, 0x09065, 0x0967D, 0x0990A,
+	0x0617E, 0x06291, 0x06B32, 0x06C83, 0x06D74, 0x07FCC, 0x07FFC, 0x06DC0, 0x07F85, 0x087BA, 0x088F8, 0x06765,
+	0x083B1, 0x0983C, 0x096F7, 0x06D1B, 0x07D61, 0x0843D, 0x0916A, 0x04E71, 0x05375, 0x05D50, 0x06B04, 0x06FEB,
+	0x085CD, 0x0862D, 0x089A7, 0x05229, 0x0540F, 0x05C65, 0x0674E, 0x068A8, 0x07406, 0x07483,
+	// row 46
+	0x075E2, 0x088CF, 0x088E1, 0x091CC, 0x096E2, 0x09678, 0x05F8B, 0x07387, 0x07ACB, 0x0844E, 0x063A0, 0x07565,
+	0x05289, 0x06D41, 0x06E9C, 0x07409, 0x07559, 0x0786B, 0x07C92, 0x09686, 0x07ADC, 0x09F8D, 0x04FB6, 0x0616E,
+	0x065C5, 0x0865C, 0x04E86, 0x04EAE, 0x050DA, 0x04E21, 0x051CC, 0x05BEE, 0x06599, 0x06881, 0x06DBC, 0x0731F,
+	0x07642, 0x077AD, 0x07A1C, 0x07CE7, 0x0826F, 0x08AD2, 0x0907C, 0x091CF, 0x09675, 0x09818, 0x0529B, 0x07DD1,
+	0x0502B, 0x05398, 0x06797, 0x06DCB, 0x071D0, 0x07433, 0x081E8, 0x08F2A, 0x096A3, 0x09C57, 0x09E9F, 0x07460,
+	0x05841, 0x06D99, 0x07D2F, 0x0985E, 0x04EE4, 0x04F36, 0x04F8B, 0x051B7, 0x052B1, 0x05DBA, 0x0601C, 0x073B2,
+	0x0793C, 0x082D3, 0x09234, 0x096B7, 0x096F6, 0x0970A, 0x09E97, 0x09F62, 0x066A6, 0x06B74, 0x05217, 0x052A3,
+	0x070C8, 0x088C2, 0x05EC9, 0x0604B, 0x06190, 0x06F23, 0x07149, 0x07C3E, 0x07DF4, 0x0806F,
+	// row 47
+	0x084EE, 0x09023, 0x0932C, 0x05442, 0x09B6F, 0x06AD3, 0x07089, 0x08CC2, 0x08DEF, 0x09732, 0x052B4, 0x05A41,
+	0x05ECA, 0x05F04, 0x06717, 0x0697C, 0x06994, 0x06D6A, 0x06F0F, 0x07262, 0x072FC, 0x07BED, 0x08001, 0x0807E,
+	0x0874B, 0x090CE, 0x0516D, 0x09E93, 0x07984, 0x0808B, 0x09332, 0x08AD6, 0x0502D, 0x0548C, 0x08A71, 0x06B6A,
+	0x08CC4, 0x08107, 0x060D1, 0x067A0, 0x09DF2, 0x04E99, 0x04E98, 0x09C10, 0x08A6B, 0x085C1, 0x08568, 0x06900,
+	0x06E7E, 0x07897, 0x08155, 0x20B9F, 0x05B41, 0x05B56, 0x05B7D, 0x05B93, 0x05BD8, 0x05BEC, 0x05C12, 0x05C1E,
+	0x05C23, 0x05C2B, 0x0378D, 0x05C62, 0x0FA3B, 0x0FA3C, 0x216B4, 0x05C7A, 0x05C8F, 0x05C9F, 0x05CA3, 0x05CAA,
+	0x05CBA, 0x05CCB, 0x05CD0, 0x05CD2, 0x05CF4, 0x21E34, 0x037E2, 0x05D0D, 0x05D27, 0x0FA11, 0x05D46, 0x05D47,
+	0x05D53, 0x05D4A, 0x05D6D, 0x05D81, 0x05DA0, 0x05DA4, 0x05DA7, 0x05DB8, 0x05DCB, 0x0541E,
+	// row 48
+	0x05F0C, 0x04E10, 0x04E15, 0x04E2A, 0x04E31, 0x04E36, 0x04E3C, 0x04E3F, 0x04E42, 0x04E56, 0x04E58, 0x04E82,
+	0x04E85, 0x08C6B, 0x04E8A, 0x08212, 0x05F0D, 0x04E8E, 0x04E9E, 0x04E9F, 0x04EA0, 0x04EA2, 0x04EB0, 0x04EB3,
+	0x04EB6, 0x04ECE, 0x04ECD, 0x04EC4, 0x04EC6, 0x04EC2, 0x04ED7, 0x04EDE, 0x04EED, 0x04EDF, 0x04EF7, 0x04F09,
+	0x04F5A, 0x04F30, 0x04F5B, 0x04F5D, 0x04F57, 0x04F47, 0x04F76, 0x04F88, 0x04F8F, 0x04F98, 0x04F7B, 0x04F69,
+	0x04F70, 0x04F91, 0x04F6F, 0x04F86, 0x04F96, 0x05118, 0x04FD4, 0x04FDF, 0x04FCE, 0x04FD8, 0x04FDB, 0x04FD1,
+	0x04FDA, 0x04FD0, 0x04FE4, 0x04FE5, 0x0501A, 0x05028, 0x05014, 0x0502A, 0x05025, 0x05005, 0x04F1C, 0x04FF6,
+	0x05021, 0x05029, 0x0502C, 0x04FFE, 0x04FEF, 0x05011, 0x05006, 0x05043, 0x05047, 0x06703, 0x05055, 0x05050,
+	0x05048, 0x0505A, 0x05056, 0x0506C, 0x05078, 0x05080, 0x0509A, 0x05085, 0x050B4, 0x050B2,
+	// row 49
+	0x050C9, 0x050CA, 0x050B3, 0x050C2, 0x050D6, 0x050DE, 0x050E5, 0x050ED, 0x050E3, 0x050EE, 0x050F9, 0x050F5,
+	0x05109, 0x05101, 0x05102, 0x05116, 0x05115, 0x05114, 0x0511A, 0x05121, 0x0513A, 0x05137, 0x0513C, 0x0513B,
+	0x0513F, 0x05140, 0x05152, 0x0514C, 0x05154, 0x05162, 0x07AF8, 0x05169, 0x0516A, 0x0516E, 0x05180, 0x05182,
+	0x056D8, 0x0518C, 0x05189, 0x0518F, 0x05191, 0x05193, 0x05195, 0x05196, 0x051A4, 0x051A6, 0x051A2, 0x051A9,
+	0x051AA, 0x051AB, 0x051B3, 0x051B1, 0x051B2, 0x051B0, 0x051B5, 0x051BD, 0x051C5, 0x051C9, 0x051DB, 0x051E0,
+	0x08655, 0x051E9, 0x051ED, 0x051F0, 0x051F5, 0x051FE, 0x05204, 0x0520B, 0x05214, 0x0520E, 0x05227, 0x0522A,
+	0x0522E, 0x05233, 0x05239, 0x0524F, 0x05244, 0x0524B, 0x0524C, 0x0525E, 0x05254, 0x0526A, 0x05274, 0x05269,
+	0x05273, 0x0527F, 0x0527D, 0x0528D, 0x05294, 0x05292, 0x05271, 0x05288, 0x05291, 0x08FA8,
+	// row 50
+	0x08FA7, 0x052AC, 0x052AD, 0x052BC, 0x052B5, 0x052C1, 0x052CD, 0x052D7, 0x052DE, 0x052E3, 0x052E6, 0x098ED,
+	0x052E0, 0x052F3, 0x052F5, 0x052F8, 0x052F9, 0x05306, 0x05308, 0x07538, 0x0530D, 0x05310, 0x0530F, 0x05315,
+	0x0531A, 0x05323, 0x0532F, 0x05331, 0x05333, 0x05338, 0x05340, 0x05346, 0x05345, 0x04E17, 0x05349, 0x0534D,
+	0x051D6, 0x0535E, 0x05369, 0x0536E, 0x05918, 0x0537B, 0x05377, 0x05382, 0x05396, 0x053A0, 0x053A6, 0x053A5,
+	0x053AE, 0x053B0, 0x053B6, 0x053C3, 0x07C12, 0x096D9, 0x053DF, 0x066FC, 0x071EE, 0x053EE, 0x053E8, 0x053ED,
+	0x053FA, 0x05401, 0x0543D, 0x05440, 0x0542C, 0x0542D, 0x0543C, 0x0542E, 0x05436, 0x05429, 0x0541D, 0x0544E,
+	0x0548F, 0x05475, 0x0548E, 0x0545F, 0x05471, 0x05477, 0x05470, 0x05492, 0x0547B, 0x05480, 0x05476, 0x05484,
+	0x05490, 0x05486, 0x054C7, 0x054A2, 0x054B8, 0x054A5, 0x054AC, 0x054C4, 0x054C8, 0x054A8,
+	// row 51
+	0x054AB, 0x054C2, 0x054A4, 0x054BE, 0x054BC, 0x054D8, 0x054E5, 0x054E6, 0x0550F, 0x05514, 0x054FD, 0x054EE,
+	0x054ED, 0x054FA, 0x054E2, 0x05539, 0x05540, 0x05563, 0x0554C, 0x0552E, 0x0555C, 0x05545, 0x05556, 0x05557,
+	0x05538, 0x05533, 0x0555D, 0x05599, 0x05580, 0x054AF, 0x0558A, 0x0559F, 0x0557B, 0x0557E, 0x05598, 0x0559E,
+	0x055AE, 0x0557C, 0x05583, 0x055A9, 0x05587, 0x055A8, 0x055DA, 0x055C5, 0x055DF, 0x055C4, 0x055DC, 0x055E4,
+	0x055D4, 0x05614, 0x055F7, 0x05616, 0x055FE, 0x055FD, 0x0561B, 0x055F9, 0x0564E, 0x05650, 0x071DF, 0x05634,
+	0x05636, 0x05632, 0x05638, 0x0566B, 0x05664, 0x0562F, 0x0566C, 0x0566A, 0x05686, 0x05680, 0x0568A, 0x056A0,
+	0x05694, 0x0568F, 0x056A5, 0x056AE, 0x056B6, 0x056B4, 0x056C2, 0x056BC, 0x056C1, 0x056C3, 0x056C0, 0x056C8,
+	0x056CE, 0x056D1, 0x056D3, 0x056D7, 0x056EE, 0x056F9, 0x05700, 0x056FF, 0x05704, 0x05709,
+	// row 52
+	0x05708, 0x0570B, 0x0570D, 0x05713, 0x05718, 0x05716, 0x055C7, 0x0571C, 0x05726, 0x05737, 0x05738, 0x0574E,
+	0x0573B, 0x05740, 0x0574F, 0x05769, 0x057C0, 0x05788, 0x05761, 0x0577F, 0x05789, 0x05793, 0x057A0, 0x057B3,
+	0x057A4, 0x057AA, 0x057B0, 0x057C3, 0x057C6, 0x057D4, 0x057D2, 0x057D3, 0x0580A, 0x057D6, 0x057E3, 0x0580B,
+	0x05819, 0x0581D, 0x05872, 0x05821, 0x05862, 0x0584B, 0x05870, 0x06BC0, 0x05852, 0x0583D, 0x05879, 0x05885,
+	0x058B9, 0x0589F, 0x058AB, 0x058BA, 0x058DE, 0x058BB, 0x058B8, 0x058AE, 0x058C5, 0x058D3, 0x058D1, 0x058D7,
+	0x058D9, 0x058D8, 0x058E5, 0x058DC, 0x058E4, 0x058DF, 0x058EF, 0x058FA, 0x058F9, 0x058FB, 0x058FC, 0x058FD,
+	0x05902, 0x0590A, 0x05910, 0x0591B, 0x068A6, 0x05925, 0x0592C, 0x0592D, 0x05932, 0x05938, 0x0593E, 0x07AD2,
+	0x05955, 0x05950, 0x0594E, 0x0595A, 0x05958, 0x05962, 0x05960, 0x05967, 0x0596C, 0x05969,
+	// row 53
+	0x05978, 0x05981, 0x0599D, 0x04F5E, 0x04FAB, 0x059A3, 0x059B2, 0x059C6, 0x059E8, 0x059DC, 0x0598D, 0x059D9,
+	0x059DA, 0x05A25, 0x05A1F, 0x05A11, 0x05A1C, 0x05A09, 0x05A1A, 0x05A40, 0x05A6C, 0x05A49, 0x05A35, 0x05A36,
+	0x05A62, 0x05A6A, 0x05A9A, 0x05ABC, 0x05ABE, 0x05ACB, 0x05AC2, 0x05ABD, 0x05AE3, 0x05AD7, 0x05AE6, 0x05AE9,
+	0x05AD6, 0x05AFA, 0x05AFB, 0x05B0C, 0x05B0B, 0x05B16, 0x05B32, 0x05AD0, 0x05B2A, 0x05B36, 0x05B3E, 0x05B43,
+	0x05B45, 0x05B40, 0x05B51, 0x05B55, 0x05B5A, 0x05B5B, 0x05B65, 0x05B69, 0x05B70, 0x05B73, 0x05B75, 0x05B78,
+	0x06588, 0x05B7A, 0x05B80, 0x05B83, 0x05BA6, 0x05BB8, 0x05BC3, 0x05BC7, 0x05BC9, 0x05BD4, 0x05BD0, 0x05BE4,
+	0x05BE6, 0x05BE2, 0x05BDE, 0x05BE5, 0x05BEB, 0x05BF0, 0x05BF6, 0x05BF3, 0x05C05, 0x05C07, 0x05C08, 0x05C0D,
+	0x05C13, 0x05C20, 0x05C22, 0x05C28, 0x05C38, 0x05C39, 0x05C41, 0x05C46, 0x05C4E, 0x05C53,
+	// row 54
+	0x05C50, 0x05C4F, 0x05B71, 0x05C6C, 0x05C6E, 0x04E62, 0x05C76, 0x05C79, 0x05C8C, 0x05C91, 0x05C94, 0x0599B,
+	0x05CAB, 0x05CBB, 0x05CB6, 0x05CBC, 0x05CB7, 0x05CC5, 0x05CBE, 0x05CC7, 0x05CD9, 0x05CE9, 0x05CFD, 0x05CFA,
+	0x05CED, 0x05D8C, 0x05CEA, 0x05D0B, 0x05D15, 0x05D17, 0x05D5C, 0x05D1F, 0x05D1B, 0x05D11, 0x05D14, 0x05D22,
+	0x05D1A, 0x05D19, 0x05D18, 0x05D4C, 0x05D52, 0x05D4E, 0x05D4B, 0x05D6C, 0x05D73, 0x05D76, 0x05D87, 0x05D84,
+	0x05D82, 0x05DA2, 0x05D9D, 0x05DAC, 0x05DAE, 0x05DBD, 0x05D90, 0x05DB7, 0x05DBC, 0x05DC9, 0x05DCD, 0x05DD3,
+	0x05DD2, 0x05DD6, 0x05DDB, 0x05DEB, 0x05DF2, 0x05DF5, 0x05E0B, 0x05E1A, 0x05E19, 0x05E11, 0x05E1B, 0x05E36,
+	0x05E37, 0x05E44, 0x05E43, 0x05E40, 0x05E4E, 0x05E57, 0x05E54, 0x05E5F, 0x05E62, 0x05E64, 0x05E47, 0x05E75,
+	0x05E76, 0x05E7A, 0x09EBC, 0x05E7F, 0x05EA0, 0x05EC1, 0x05EC2, 0x05EC8, 0x05ED0, 0x05ECF,
+	// row 55
+	0x05ED6, 0x05EE3, 0x05EDD, 0x05EDA, 0x05EDB, 0x05EE2, 0x05EE1, 0x05EE8, 0x05EE9, 0x05EEC, 0x05EF1, 0x05EF3,
+	0x05EF0, 0x05EF4, 0x05EF8, 0x05EFE, 0x05F03, 0x05F09, 0x05F5D, 0x05F5C, 0x05F0B, 0x05F11, 0x05F16, 0x05F29,
+	0x05F2D, 0x05F38, 0x05F41, 0x05F48, 0x05F4C, 0x05F4E, 0x05F2F, 0x05F51, 0x05F56, 0x05F57, 0x05F59, 0x05F61,
+	0x05F6D, 0x05F73, 0x05F77, 0x05F83, 0x05F82, 0x05F7F, 0x05F8A, 0x05F88, 0x05F91, 0x05F87, 0x05F9E, 0x05F99,
+	0x05F98, 0x05FA0, 0x05FA8, 0x05FAD, 0x05FBC, 0x05FD6, 0x05FFB, 0x05FE4, 0x05FF8, 0x05FF1, 0x05FDD, 0x060B3,
+	0x05FFF, 0x06021, 0x06060, 0x06019, 0x06010, 0x06029, 0x0600E, 0x06031, 0x0601B, 0x06015, 0x0602B, 0x06026,
+	0x0600F, 0x0603A, 0x0605A, 0x06041, 0x0606A, 0x06077, 0x0605F, 0x0604A, 0x06046, 0x0604D, 0x06063, 0x06043,
+	0x06064, 0x06042, 0x0606C, 0x0606B, 0x06059, 0x06081, 0x0608D, 0x060E7, 0x06083, 0x0609A,
+	// row 56
+	0x06084, 0x0609B, 0x06096, 0x06097, 0x06092, 0x060A7, 0x0608B, 0x060E1, 0x060B8, 0x060E0, 0x060D3, 0x060B4,
+	0x05FF0, 0x060BD, 0x060C6, 0x060B5, 0x060D8, 0x0614D, 0x06115, 0x06106, 0x060F6, 0x060F7, 0x06100, 0x060F4,
+	0x060FA, 0x06103, 0x06121, 0x060FB, 0x060F1, 0x0610D, 0x0610E, 0x06147, 0x0613E, 0x06128, 0x06127, 0x0614A,
+	0x0613F, 0x0613C, 0x0612C, 0x06134, 0x0613D, 0x06142, 0x06144, 0x06173, 0x06177, 0x06158, 0x06159, 0x0615A,
+	0x0616B, 0x06174, 0x0616F, 0x06165, 0x06171, 0x0615F, 0x0615D, 0x06153, 0x06175, 0x06199, 0x06196, 0x06187,
+	0x061AC, 0x06194, 0x0619A, 0x0618A, 0x06191, 0x061AB, 0x061AE, 0x061CC, 0x061CA, 0x061C9, 0x061F7, 0x061C8,
+	0x061C3, 0x061C6, 0x061BA, 0x061CB, 0x07F79, 0x061CD, 0x061E6, 0x061E3, 0x061F6, 0x061FA, 0x061F4, 0x061FF,
+	0x061FD, 0x061FC, 0x061FE, 0x06200, 0x06208, 0x06209, 0x0620D, 0x0620C, 0x06214, 0x0621B,
+	// row 57
+	0x0621E, 0x06221, 0x0622A, 0x0622E, 0x06230, 0x06232, 0x06233, 0x06241, 0x0624E, 0x0625E, 0x06263, 0x0625B,
+	0x06260, 0x06268, 0x0627C, 0x06282, 0x06289, 0x0627E, 0x06292, 0x06293, 0x06296, 0x062D4, 0x06283, 0x06294,
+	0x062D7, 0x062D1, 0x062BB, 0x062CF, 0x062FF, 0x062C6, 0x064D4, 0x062C8, 0x062DC, 0x062CC, 0x062CA, 0x062C2,
+	0x062C7, 0x0629B, 0x062C9, 0x0630C, 0x062EE, 0x062F1, 0x06327, 0x06302, 0x06308, 0x062EF, 0x062F5, 0x06350,
+	0x0633E, 0x0634D, 0x0641C, 0x0634F, 0x06396, 0x0638E, 0x06380, 0x063AB, 0x06376, 0x063A3, 0x0638F, 0x06389,
+	0x0639F, 0x063B5, 0x0636B, 0x06369, 0x063BE, 0x063E9, 0x063C0, 0x063C6, 0x063E3, 0x063C9, 0x063D2, 0x063F6,
+	0x063C4, 0x06416, 0x06434, 0x06406, 0x06413, 0x06426, 0x06436, 0x0651D, 0x06417, 0x06428, 0x0640F, 0x06467,
+	0x0646F, 0x06476, 0x0644E, 0x0652A, 0x06495, 0x06493, 0x064A5, 0x064A9, 0x06488, 0x064BC,
+	// row 58
+	0x064DA, 0x064D2, 0x064C5, 0x064C7, 0x064BB, 0x064D8, 0x064C2, 0x064F1, 0x064E7, 0x08209, 0x064E0, 0x064E1,
+	0x062AC, 0x064E3, 0x064EF, 0x0652C, 0x064F6, 0x064F4, 0x064F2, 0x064FA, 0x06500, 0x064FD, 0x06518, 0x0651C,
+	0x06505, 0x06524, 0x06523, 0x0652B, 0x06534, 0x06535, 0x06537, 0x06536, 0x06538, 0x0754B, 0x06548, 0x06556,
+	0x06555, 0x0654D, 0x06558, 0x0655E, 0x0655D, 0x06572, 0x06578, 0x06582, 0x06583, 0x08B8A, 0x0659B, 0x0659F,
+	0x065AB, 0x065B7, 0x065C3, 0x065C6, 0x065C1, 0x065C4, 0x065CC, 0x065D2, 0x065DB, 0x065D9, 0x065E0, 0x065E1,
+	0x065F1, 0x06772, 0x0660A, 0x06603, 0x065FB, 0x06773, 0x06635, 0x06636, 0x06634, 0x0661C, 0x0664F, 0x06644,
+	0x06649, 0x06641, 0x0665E, 0x0665D, 0x06664, 0x06667, 0x06668, 0x0665F, 0x06662, 0x06670, 0x06683, 0x06688,
+	0x0668E, 0x06689, 0x06684, 0x06698, 0x0669D, 0x066C1, 0x066B9, 0x066C9, 0x066BE, 0x066BC,
+	// row 59
+	0x066C4, 0x066B8, 0x066D6, 0x066DA, 0x066E0, 0x0663F, 0x066E6, 0x066E9, 0x066F0, 0x066F5, 0x066F7, 0x0670F,
+	0x06716, 0x0671E, 0x06726, 0x06727, 0x09738, 0x0672E, 0x0673F, 0x06736, 0x06741, 0x06738, 0x06737, 0x06746,
+	0x0675E, 0x06760, 0x06759, 0x06763, 0x06764, 0x06789, 0x06770, 0x067A9, 0x0677C, 0x0676A, 0x0678C, 0x0678B,
+	0x067A6, 0x067A1, 0x06785, 0x067B7, 0x067EF, 0x067B4, 0x067EC, 0x067B3, 0x067E9, 0x067B8, 0x067E4, 0x067DE,
+	0x067DD, 0x067E2, 0x067EE, 0x067B9, 0x067CE, 0x067C6, 0x067E7, 0x06A9C, 0x0681E, 0x06846, 0x06829, 0x06840,
+	0x0684D, 0x06832, 0x0684E, 0x068B3, 0x0682B, 0x06859, 0x06863, 0x06877, 0x0687F, 0x0689F, 0x0688F, 0x068AD,
+	0x06894, 0x0689D, 0x0689B, 0x06883, 0x06AAE, 0x068B9, 0x06874, 0x068B5, 0x068A0, 0x068BA, 0x0690F, 0x0688D,
+	0x0687E, 0x06901, 0x068CA, 0x06908, 0x068D8, 0x06922, 0x06926, 0x068E1, 0x0690C, 0x068CD,
+	// row 60
+	0x068D4, 0x068E7, 0x068D5, 0x06936, 0x06912, 0x06904, 0x068D7, 0x068E3, 0x06925, 0x068F9, 0x068E0, 0x068EF,
+	0x06928, 0x0692A, 0x0691A, 0x06923, 0x06921, 0x068C6, 0x06979, 0x06977, 0x0695C, 0x06978, 0x0696B, 0x06954,
+	0x0697E, 0x0696E, 0x06939, 0x06974, 0x0693D, 0x06959, 0x06930, 0x06961, 0x0695E, 0x0695D, 0x06981, 0x0696A,
+	0x069B2, 0x069AE, 0x069D0, 0x069BF, 0x069C1, 0x069D3, 0x069BE, 0x069CE, 0x05BE8, 0x069CA, 0x069DD, 0x069BB,
+	0x069C3, 0x069A7, 0x06A2E, 0x06991, 0x069A0, 0x0699C, 0x06995, 0x069B4, 0x069DE, 0x069E8, 0x06A02, 0x06A1B,
+	0x069FF, 0x06B0A, 0x069F9, 0x069F2, 0x069E7, 0x06A05, 0x069B1, 0x06A1E, 0x069ED, 0x06A14, 0x069EB, 0x06A0A,
+	0x06A12, 0x06AC1, 0x06A23, 0x06A13, 0x06A44, 0x06A0C, 0x06A72, 0x06A36, 0x06A78, 0x06A47, 0x06A62, 0x06A59,
+	0x06A66, 0x06A48, 0x06A38, 0x06A22, 0x06A90, 0x06A8D, 0x06AA0, 0x06A84, 0x06AA2, 0x06AA3,
+	// row 61
+	0x06A97, 0x08617, 0x06ABB, 0x06AC3, 0x06AC2, 0x06AB8, 0x06AB3, 0x06AAC, 0x06ADE, 0x06AD1, 0x06ADF, 0x06AAA,
+	0x06ADA, 0x06AEA, 0x06AFB, 0x06B05, 0x08616, 0x06AFA, 0x06B12, 0x06B16, 0x09B31, 0x06B1F, 0x06B38, 0x06B37,
+	0x076DC, 0x06B39, 0x098EE, 0x06B47, 0x06B43, 0x06B49, 0x06B50, 0x06B59, 0x06B54, 0x06B5B, 0x06B5F, 0x06B61,
+	0x06B78, 0x06B79, 0x06B7F, 0x06B80, 0x06B84, 0x06B83, 0x06B8D, 0x06B98, 0x06B95, 0x06B9E, 0x06BA4, 0x06BAA,
+	0x06BAB, 0x06BAF, 0x06BB2, 0x06BB1, 0x06BB3, 0x06BB7, 0x06BBC, 0x06BC6, 0x06BCB, 0x06BD3, 0x06BDF, 0x06BEC,
+	0x06BEB, 0x06BF3, 0x06BEF, 0x09EBE, 0x06C08, 0x06C13, 0x06C14, 0x06C1B, 0x06C24, 0x06C23, 0x06C5E, 0x06C55,
+	0x06C62, 0x06C6A, 0x06C82, 0x06C8D, 0x06C9A, 0x06C81, 0x06C9B, 0x06C7E, 0x06C68, 0x06C73, 0x06C92, 0x06C90,
+	0x06CC4, 0x06CF1, 0x06CD3, 0x06CBD, 0x06CD7, 0x06CC5, 0x06CDD, 0x06CAE, 0x06CB1, 0x06CBE,
+	// row 62
+	0x06CBA, 0x06CDB, 0x06CEF, 0x06CD9, 0x06CEA, 0x06D1F, 0x0884D, 0x06D36, 0x06D2B, 0x06D3D, 0x06D38, 0x06D19,
+	0x06D35, 0x06D33, 0x06D12, 0x06D0C, 0x06D63, 0x06D93, 0x06D64, 0x06D5A, 0x06D79, 0x06D59, 0x06D8E, 0x06D95,
+	0x06FE4, 0x06D85, 0x06DF9, 0x06E15, 0x06E0A, 0x06DB5, 0x06DC7, 0x06DE6, 0x06DB8, 0x06DC6, 0x06DEC, 0x06DDE,
+	0x06DCC, 0x06DE8, 0x06DD2, 0x06DC5, 0x06DFA, 0x06DD9, 0x06DE4, 0x06DD5, 0x06DEA, 0x06DEE, 0x06E2D, 0x06E6E,
+	0x06E2E, 0x06E19, 0x06E72, 0x06E5F, 0x06E3E, 0x06E23, 0x06E6B, 0x06E2B, 0x06E76, 0x06E4D, 0x06E1F, 0x06E43,
+	0x06E3A, 0x06E4E, 0x06E24, 0x06EFF, 0x06E1D, 0x06E38, 0x06E82, 0x06EAA, 0x06E98, 0x06EC9, 0x06EB7, 0x06ED3,
+	0x06EBD, 0x06EAF, 0x06EC4, 0x06EB2, 0x06ED4, 0x06ED5, 0x06E8F, 0x06EA5, 0x06EC2, 0x06E9F, 0x06F41, 0x06F11,
+	0x0704C, 0x06EEC, 0x06EF8, 0x06EFE, 0x06F3F, 0x06EF2, 0x06F31, 0x06EEF, 0x06F32, 0x06ECC,
+	// row 63
+	0x06F3E, 0x06F13, 0x06EF7, 0x06F86, 0x06F7A, 0x06F78, 0x06F81, 0x06F80, 0x06F6F, 0x06F5B, 0x06FF3, 0x06F6D,
+	0x06F82, 0x06F7C, 0x06F58, 0x06F8E, 0x06F91, 0x06FC2, 0x06F66, 0x06FB3, 0x06FA3, 0x06FA1, 0x06FA4, 0x06FB9,
+	0x06FC6, 0x06FAA, 0x06FDF, 0x06FD5, 0x06FEC, 0x06FD4, 0x06FD8, 0x06FF1, 0x06FEE, 0x06FDB, 0x07009, 0x0700B,
+	0x06FFA, 0x07011, 0x07001, 0x0700F, 0x06FFE, 0x0701B, 0x0701A, 0x06F74, 0x0701D, 0x07018, 0x0701F, 0x07030,
+	0x0703E, 0x07032, 0x07051, 0x07063, 0x07099, 0x07092, 0x070AF, 0x070F1, 0x070AC, 0x070B8, 0x070B3, 0x070AE,
+	0x070DF, 0x070CB, 0x070DD, 0x070D9, 0x07109, 0x070FD, 0x0711C, 0x07119, 0x07165, 0x07155, 0x07188, 0x07166,
+	0x07162, 0x0714C, 0x07156, 0x0716C, 0x0718F, 0x071FB, 0x07184, 0x07195, 0x071A8, 0x071AC, 0x071D7, 0x071B9,
+	0x071BE, 0x071D2, 0x071C9, 0x071D4, 0x071CE, 0x071E0, 0x071EC, 0x071E7, 0x071F5, 0x071FC,
+	// row 64
+	0x071F9, 0x071FF, 0x0720D, 0x07210, 0x0721B, 0x07228, 0x0722D, 0x0722C, 0x07230, 0x07232, 0x0723B, 0x0723C,
+	0x0723F, 0x07240, 0x07246, 0x0724B, 0x07258, 0x07274, 0x0727E, 0x07282, 0x07281, 0x07287, 0x07292, 0x07296,
+	0x072A2, 0x072A7, 0x072B9, 0x072B2, 0x072C3, 0x072C6, 0x072C4, 0x072CE, 0x072D2, 0x072E2, 0x072E0, 0x072E1,
+	0x072F9, 0x072F7, 0x0500F, 0x07317, 0x0730A, 0x0731C, 0x07316, 0x0731D, 0x07334, 0x0732F, 0x07329, 0x07325,
+	0x0733E, 0x0734E, 0x0734F, 0x09ED8, 0x07357, 0x0736A, 0x07368, 0x07370, 0x07378, 0x07375, 0x0737B, 0x0737A,
+	0x073C8, 0x073B3, 0x073CE, 0x073BB, 0x073C0, 0x073E5, 0x073EE, 0x073DE, 0x074A2, 0x07405, 0x0746F, 0x07425,
+	0x073F8, 0x07432, 0x0743A, 0x07455, 0x0743F, 0x0745F, 0x07459, 0x07441, 0x0745C, 0x07469, 0x07470, 0x07463,
+	0x0746A, 0x07476, 0x0747E, 0x0748B, 0x0749E, 0x074A7, 0x074CA, 0x074CF, 0x074D4, 0x073F1,
+	// row 65
+	0x074E0, 0x074E3, 0x074E7, 0x074E9, 0x074EE, 0x074F2, 0x074F0, 0x074F1, 0x074F8, 0x074F7, 0x07504, 0x07503,
+	0x07505, 0x0750C, 0x0750E, 0x0750D, 0x07515, 0x07513, 0x0751E, 0x07526, 0x0752C, 0x0753C, 0x07544, 0x0754D,
+	0x0754A, 0x07549, 0x0755B, 0x07546, 0x0755A, 0x07569, 0x07564, 0x07567, 0x0756B, 0x0756D, 0x07578, 0x07576,
+	0x07586, 0x07587, 0x07574, 0x0758A, 0x07589, 0x07582, 0x07594, 0x0759A, 0x0759D, 0x075A5, 0x075A3, 0x075C2,
+	0x075B3, 0x075C3, 0x075B5, 0x075BD, 0x075B8, 0x075BC, 0x075B1, 0x075CD, 0x075CA, 0x075D2, 0x075D9, 0x075E3,
+	0x075DE, 0x075FE, 0x075FF, 0x075FC, 0x07601, 0x075F0, 0x075FA, 0x075F2, 0x075F3, 0x0760B, 0x0760D, 0x07609,
+	0x0761F, 0x07627, 0x07620, 0x07621, 0x07622, 0x07624, 0x07634, 0x07630, 0x0763B, 0x07647, 0x07648, 0x07646,
+	0x0765C, 0x07658, 0x07661, 0x07662, 0x07668, 0x07669, 0x0766A, 0x07667, 0x0766C, 0x07670,
+	// row 66
+	0x07672, 0x07676, 0x07678, 0x0767C, 0x07680, 0x07683, 0x07688, 0x0768B, 0x0768E, 0x07696, 0x07693, 0x07699,
+	0x0769A, 0x076B0, 0x076B4, 0x076B8, 0x076B9, 0x076BA, 0x076C2, 0x076CD, 0x076D6, 0x076D2, 0x076DE, 0x076E1,
+	0x076E5, 0x076E7, 0x076EA, 0x0862F, 0x076FB, 0x07708, 0x07707, 0x07704, 0x07729, 0x07724, 0x0771E, 0x07725,
+	0x07726, 0x0771B, 0x07737, 0x07738, 0x07747, 0x0775A, 0x07768, 0x0776B, 0x0775B, 0x07765, 0x0777F, 0x0777E,
+	0x07779, 0x0778E, 0x0778B, 0x07791, 0x077A0, 0x0779E, 0x077B0, 0x077B6, 0x077B9, 0x077BF, 0x077BC, 0x077BD,
+	0x077BB, 0x077C7, 0x077CD, 0x077D7, 0x077DA, 0x077DC, 0x077E3, 0x077EE, 0x077FC, 0x0780C, 0x07812, 0x07926,
+	0x07820, 0x0792A, 0x07845, 0x0788E, 0x07874, 0x07886, 0x0787C, 0x0789A, 0x0788C, 0x078A3, 0x078B5, 0x078AA,
+	0x078AF, 0x078D1, 0x078C6, 0x078CB, 0x078D4, 0x078BE, 0x078BC, 0x078C5, 0x078CA, 0x078EC,
+	// row 67
+	0x078E7, 0x078DA, 0x078FD, 0x078F4, 0x07907, 0x07912, 0x07911, 0x07919, 0x0792C, 0x0792B, 0x07940, 0x07960,
+	0x07957, 0x0795F, 0x0795A, 0x07955, 0x07953, 0x0797A, 0x0797F, 0x0798A, 0x0799D, 0x079A7, 0x09F4B, 0x079AA,
+	0x079AE, 0x079B3, 0x079B9, 0x079BA, 0x079C9, 0x079D5, 0x079E7, 0x079EC, 0x079E1, 0x079E3, 0x07A08, 0x07A0D,
+	0x07A18, 0x07A19, 0x07A20, 0x07A1F, 0x07980, 0x07A31, 0x07A3B, 0x07A3E, 0x07A37, 0x07A43, 0x07A57, 0x07A49,
+	0x07A61, 0x07A62, 0x07A69, 0x09F9D, 0x07A70, 0x07A79, 0x07A7D, 0x07A88, 0x07A97, 0x07A95, 0x07A98, 0x07A96,
+	0x07AA9, 0x07AC8, 0x07AB0, 0x07AB6, 0x07AC5, 0x07AC4, 0x07ABF, 0x09083, 0x07AC7, 0x07ACA, 0x07ACD, 0x07ACF,
+	0x07AD5, 0x07AD3, 0x07AD9, 0x07ADA, 0x07ADD, 0x07AE1, 0x07AE2, 0x07AE6, 0x07AED, 0x07AF0, 0x07B02, 0x07B0F,
+	0x07B0A, 0x07B06, 0x07B33, 0x07B18, 0x07B19, 0x07B1E, 0x07B35, 0x07B28, 0x07B36, 0x07B50,
+	// row 68
+	0x07B7A, 0x07B04, 0x07B4D, 0x07B0B, 0x07B4C, 0x07B45, 0x07B75, 0x07B65, 0x07B74, 0x07B67, 0x07B70, 0x07B71,
+	0x07B6C, 0x07B6E, 0x07B9D, 0x07B98, 0x07B9F, 0x07B8D, 0x07B9C, 0x07B9A, 0x07B8B, 0x07B92, 0x07B8F, 0x07B5D,
+	0x07B99, 0x07BCB, 0x07BC1, 0x07BCC, 0x07BCF, 0x07BB4, 0x07BC6, 0x07BDD, 0x07BE9, 0x07C11, 0x07C14, 0x07BE6,
+	0x07BE5, 0x07C60, 0x07C00, 0x07C07, 0x07C13, 0x07BF3, 0x07BF7, 0x07C17, 0x07C0D, 0x07BF6, 0x07C23, 0x07C27,
+	0x07C2A, 0x07C1F, 0x07C37, 0x07C2B, 0x07C3D, 0x07C4C, 0x07C43, 0x07C54, 0x07C4F, 0x07C40, 0x07C50, 0x07C58,
+	0x07C5F, 0x07C64, 0x07C56, 0x07C65, 0x07C6C, 0x07C75, 0x07C83, 0x07C90, 0x07CA4, 0x07CAD, 0x07CA2, 0x07CAB,
+	0x07CA1, 0x07CA8, 0x07CB3, 0x07CB2, 0x07CB1, 0x07CAE, 0x07CB9, 0x07CBD, 0x07CC0, 0x07CC5, 0x07CC2, 0x07CD8,
+	0x07CD2, 0x07CDC, 0x07CE2, 0x09B3B, 0x07CEF, 0x07CF2, 0x07CF4, 0x07CF6, 0x07CFA, 0x07D06,
+	// row 69
+	0x07D02, 0x07D1C, 0x07D15, 0x07D0A, 0x07D45, 0x07D4B, 0x07D2E, 0x07D32, 0x07D3F, 0x07D35, 0x07D46, 0x07D73,
+	0x07D56, 0x07D4E, 0x07D72, 0x07D68, 0x07D6E, 0x07D4F, 0x07D63, 0x07D93, 0x07D89, 0x07D5B, 0x07D8F, 0x07D7D,
+	0x07D9B, 0x07DBA, 0x07DAE, 0x07DA3, 0x07DB5, 0x07DC7, 0x07DBD, 0x07DAB, 0x07E3D, 0x07DA2, 0x07DAF, 0x07DDC,
+	0x07DB8, 0x07D9F, 0x07DB0, 0x07DD8, 0x07DDD, 0x07DE4, 0x07DDE, 0x07DFB, 0x07DF2, 0x07DE1, 0x07E05, 0x07E0A,
+	0x07E23, 0x07E21, 0x07E12, 0x07E31, 0x07E1F, 0x07E09, 0x07E0B, 0x07E22, 0x07E46, 0x07E66, 0x07E3B, 0x07E35,
+	0x07E39, 0x07E43, 0x07E37, 0x07E32, 0x07E3A, 0x07E67, 0x07E5D, 0x07E56, 0x07E5E, 0x07E59, 0x07E5A, 0x07E79,
+	0x07E6A, 0x07E69, 0x07E7C, 0x07E7B, 0x07E83, 0x07DD5, 0x07E7D, 0x08FAE, 0x07E7F, 0x07E88, 0x07E89, 0x07E8C,
+	0x07E92, 0x07E90, 0x07E93, 0x07E94, 0x07E96, 0x07E8E, 0x07E9B, 0x07E9C, 0x07F38, 0x07F3A,
+	// row 70
+	0x07F45, 0x07F4C, 0x07F4D, 0x07F4E, 0x07F50, 0x07F51, 0x07F55, 0x07F54, 0x07F58, 0x07F5F, 0x07F60, 0x07F68,
+	0x07F69, 0x07F67, 0x07F78, 0x07F82, 0x07F86, 0x07F83, 0x07F88, 0x07F87, 0x07F8C, 0x07F94, 0x07F9E, 0x07F9D,
+	0x07F9A, 0x07FA3, 0x07FAF, 0x07FB2, 0x07FB9, 0x07FAE, 0x07FB6, 0x07FB8, 0x08B71, 0x07FC5, 0x07FC6, 0x07FCA,
+	0x07FD5, 0x07FD4, 0x07FE1, 0x07FE6, 0x07FE9, 0x07FF3, 0x07FF9, 0x098DC, 0x08006, 0x08004, 0x0800B, 0x08012,
+	0x08018, 0x08019, 0x0801C, 0x08021, 0x08028, 0x0803F, 0x0803B, 0x0804A, 0x08046, 0x08052, 0x08058, 0x0805A,
+	0x0805F, 0x08062, 0x08068, 0x08073, 0x08072, 0x08070, 0x08076, 0x08079, 0x0807D, 0x0807F, 0x08084, 0x08086,
+	0x08085, 0x0809B, 0x08093, 0x0809A, 0x080AD, 0x05190, 0x080AC, 0x080DB, 0x080E5, 0x080D9, 0x080DD, 0x080C4,
+	0x080DA, 0x080D6, 0x08109, 0x080EF, 0x080F1, 0x0811B, 0x08129, 0x08123, 0x0812F, 0x0814B,
+	// row 71
+	0x0968B, 0x08146, 0x0813E, 0x08153, 0x08151, 0x080FC, 0x08171, 0x0816E, 0x08165, 0x08166, 0x08174, 0x08183,
+	0x08188, 0x0818A, 0x08180, 0x08182, 0x081A0, 0x08195, 0x081A4, 0x081A3, 0x0815F, 0x08193, 0x081A9, 0x081B0,
+	0x081B5, 0x081BE, 0x081B8, 0x081BD, 0x081C0, 0x081C2, 0x081BA, 0x081C9, 0x081CD, 0x081D1, 0x081D9, 0x081D8,
+	0x081C8, 0x081DA, 0x081DF, 0x081E0, 0x081E7, 0x081FA, 0x081FB, 0x081FE, 0x08201, 0x08202, 0x08205, 0x08207,
+	0x0820A, 0x0820D, 0x08210, 0x08216, 0x08229, 0x0822B, 0x08238, 0x08233, 0x08240, 0x08259, 0x08258, 0x0825D,
+	0x0825A, 0x0825F, 0x08264, 0x08262, 0x08268, 0x0826A, 0x0826B, 0x0822E, 0x08271, 0x08277, 0x08278, 0x0827E,
+	0x0828D, 0x08292, 0x082AB, 0x0829F, 0x082BB, 0x082AC, 0x082E1, 0x082E3, 0x082DF, 0x082D2, 0x082F4, 0x082F3,
+	0x082FA, 0x08393, 0x08303, 0x082FB, 0x082F9, 0x082DE, 0x08306, 0x082DC, 0x08309, 0x082D9,
+	// row 72
+	0x08335, 0x08334, 0x08316, 0x08332, 0x08331, 0x08340, 0x08339, 0x08350, 0x08345, 0x0832F, 0x0832B, 0x08317,
+	0x08318, 0x08385, 0x0839A, 0x083AA, 0x0839F, 0x083A2, 0x08396, 0x08323, 0x0838E, 0x08387, 0x0838A, 0x0837C,
+	0x083B5, 0x08373, 0x08375, 0x083A0, 0x08389, 0x083A8, 0x083F4, 0x08413, 0x083EB, 0x083CE, 0x083FD, 0x08403,
+	0x083D8, 0x0840B, 0x083C1, 0x083F7, 0x08407, 0x083E0, 0x083F2, 0x0840D, 0x08422, 0x08420, 0x083BD, 0x08438,
+	0x08506, 0x083FB, 0x0846D, 0x0842A, 0x0843C, 0x0855A, 0x08484, 0x08477, 0x0846B, 0x084AD, 0x0846E, 0x08482,
+	0x08469, 0x08446, 0x0842C, 0x0846F, 0x08479, 0x08435, 0x084CA, 0x08462, 0x084B9, 0x084BF, 0x0849F, 0x084D9,
+	0x084CD, 0x084BB, 0x084DA, 0x084D0, 0x084C1, 0x084C6, 0x084D6, 0x084A1, 0x08521, 0x084FF, 0x084F4, 0x08517,
+	0x08518, 0x0852C, 0x0851F, 0x08515, 0x08514, 0x084FC, 0x08540, 0x08563, 0x08558, 0x08548,
+	// row 73
+	0x08541, 0x08602, 0x0854B, 0x08555, 0x08580, 0x085A4, 0x08588, 0x08591, 0x0858A, 0x085A8, 0x0856D, 0x08594,
+	0x0859B, 0x085EA, 0x08587, 0x0859C, 0x08577, 0x0857E, 0x08590, 0x085C9, 0x085BA, 0x085CF, 0x085B9, 0x085D0,
+	0x085D5, 0x085DD, 0x085E5, 0x085DC, 0x085F9, 0x0860A, 0x08613, 0x0860B, 0x085FE, 0x085FA, 0x08606, 0x08622,
+	0x0861A, 0x08630, 0x0863F, 0x0864D, 0x04E55, 0x08654, 0x0865F, 0x08667, 0x08671, 0x08693, 0x086A3, 0x086A9,
+	0x086AA, 0x0868B, 0x0868C, 0x086B6, 0x086AF, 0x086C4, 0x086C6, 0x086B0, 0x086C9, 0x08823, 0x086AB, 0x086D4,
+	0x086DE, 0x086E9, 0x086EC, 0x086DF, 0x086DB, 0x086EF, 0x08712, 0x08706, 0x08708, 0x08700, 0x08703, 0x086FB,
+	0x08711, 0x08709, 0x0870D, 0x086F9, 0x0870A, 0x08734, 0x0873F, 0x08737, 0x0873B, 0x08725, 0x08729, 0x0871A,
+	0x08760, 0x0875F, 0x08778, 0x0874C, 0x0874E, 0x08774, 0x08757, 0x08768, 0x0876E, 0x08759,
+	// row 74
+	0x08753, 0x08763, 0x0876A, 0x08805, 0x087A2, 0x0879F, 0x08782, 0x087AF, 0x087CB, 0x087BD, 0x087C0, 0x087D0,
+	0x096D6, 0x087AB, 0x087C4, 0x087B3, 0x087C7, 0x087C6, 0x087BB, 0x087EF, 0x087F2, 0x087E0, 0x0880F, 0x0880D,
+	0x087FE, 0x087F6, 0x087F7, 0x0880E, 0x087D2, 0x08811, 0x08816, 0x08815, 0x08822, 0x08821, 0x08831, 0x08836,
+	0x08839, 0x08827, 0x0883B, 0x08844, 0x08842, 0x08852, 0x08859, 0x0885E, 0x08862, 0x0886B, 0x08881, 0x0887E,
+	0x0889E, 0x08875, 0x0887D, 0x088B5, 0x08872, 0x08882, 0x08897, 0x08892, 0x088AE, 0x08899, 0x088A2, 0x0888D,
+	0x088A4, 0x088B0, 0x088BF, 0x088B1, 0x088C3, 0x088C4, 0x088D4, 0x088D8, 0x088D9, 0x088DD, 0x088F9, 0x08902,
+	0x088FC, 0x088F4, 0x088E8, 0x088F2, 0x08904, 0x0890C, 0x0890A, 0x08913, 0x08943, 0x0891E, 0x08925, 0x0892A,
+	0x0892B, 0x08941, 0x08944, 0x0893B, 0x08936, 0x08938, 0x0894C, 0x0891D, 0x08960, 0x0895E,
+	// row 75
+	0x08966, 0x08964, 0x0896D, 0x0896A, 0x0896F, 0x08974, 0x08977, 0x0897E, 0x08983, 0x08988, 0x0898A, 0x08993,
+	0x08998, 0x089A1, 0x089A9, 0x089A6, 0x089AC, 0x089AF, 0x089B2, 0x089BA, 0x089BD, 0x089BF, 0x089C0, 0x089DA,
+	0x089DC, 0x089DD, 0x089E7, 0x089F4, 0x089F8, 0x08A03, 0x08A16, 0x08A10, 0x08A0C, 0x08A1B, 0x08A1D, 0x08A25,
+	0x08A36, 0x08A41, 0x08A5B, 0x08A52, 0x08A46, 0x08A48, 0x08A7C, 0x08A6D, 0x08A6C, 0x08A62, 0x08A85, 0x08A82,
+	0x08A84, 0x08AA8, 0x08AA1, 0x08A91, 0x08AA5, 0x08AA6, 0x08A9A, 0x08AA3, 0x08AC4, 0x08ACD, 0x08AC2, 0x08ADA,
+	0x08AEB, 0x08AF3, 0x08AE7, 0x08AE4, 0x08AF1, 0x08B14, 0x08AE0, 0x08AE2, 0x08AF7, 0x08ADE, 0x08ADB, 0x08B0C,
+	0x08B07, 0x08B1A, 0x08AE1, 0x08B16, 0x08B10, 0x08B17, 0x08B20, 0x08B33, 0x097AB, 0x08B26, 0x08B2B, 0x08B3E,
+	0x08B28, 0x08B41, 0x08B4C, 0x08B4F, 0x08B4E, 0x08B49, 0x08B56, 0x08B5B, 0x08B5A, 0x08B6B,
+	// row 76
+	0x08B5F, 0x08B6C, 0x08B6F, 0x08B74, 0x08B7D, 0x08B80, 0x08B8C, 0x08B8E, 0x08B92, 0x08B93, 0x08B96, 0x08B99,
+	0x08B9A, 0x08C3A, 0x08C41, 0x08C3F, 0x08C48, 0x08C4C, 0x08C4E, 0x08C50, 0x08C55, 0x08C62, 0x08C6C, 0x08C78,
+	0x08C7A, 0x08C82, 0x08C89, 0x08C85, 0x08C8A, 0x08C8D, 0x08C8E, 0x08C94, 0x08C7C, 0x08C98, 0x0621D, 0x08CAD,
+	0x08CAA, 0x08CBD, 0x08CB2, 0x08CB3, 0x08CAE, 0x08CB6, 0x08CC8, 0x08CC1, 0x08CE4, 0x08CE3, 0x08CDA, 0x08CFD,
+	0x08CFA, 0x08CFB, 0x08D04, 0x08D05, 0x08D0A, 0x08D07, 0x08D0F, 0x08D0D, 0x08D10, 0x09F4E, 0x08D13, 0x08CCD,
+	0x08D14, 0x08D16, 0x08D67, 0x08D6D, 0x08D71, 0x08D73, 0x08D81, 0x08D99, 0x08DC2, 0x08DBE, 0x08DBA, 0x08DCF,
+	0x08DDA, 0x08DD6, 0x08DCC, 0x08DDB, 0x08DCB, 0x08DEA, 0x08DEB, 0x08DDF, 0x08DE3, 0x08DFC, 0x08E08, 0x08E09,
+	0x08DFF, 0x08E1D, 0x08E1E, 0x08E10, 0x08E1F, 0x08E42, 0x08E35, 0x08E30, 0x08E34, 0x08E4A,
+	// row 77
+	0x08E47, 0x08E49, 0x08E4C, 0x08E50, 0x08E48, 0x08E59, 0x08E64, 0x08E60, 0x08E2A, 0x08E63, 0x08E55, 0x08E76,
+	0x08E72, 0x08E7C, 0x08E81, 0x08E87, 0x08E85, 0x08E84, 0x08E8B, 0x08E8A, 0x08E93, 0x08E91, 0x08E94, 0x08E99,
+	0x08EAA, 0x08EA1, 0x08EAC, 0x08EB0, 0x08EC6, 0x08EB1, 0x08EBE, 0x08EC5, 0x08EC8, 0x08ECB, 0x08EDB, 0x08EE3,
+	0x08EFC, 0x08EFB, 0x08EEB, 0x08EFE, 0x08F0A, 0x08F05, 0x08F15, 0x08F12, 0x08F19, 0x08F13, 0x08F1C, 0x08F1F,
+	0x08F1B, 0x08F0C, 0x08F26, 0x08F33, 0x08F3B, 0x08F39, 0x08F45, 0x08F42, 0x08F3E, 0x08F4C, 0x08F49, 0x08F46,
+	0x08F4E, 0x08F57, 0x08F5C, 0x08F62, 0x08F63, 0x08F64, 0x08F9C, 0x08F9F, 0x08FA3, 0x08FAD, 0x08FAF, 0x08FB7,
+	0x08FDA, 0x08FE5, 0x08FE2, 0x08FEA, 0x08FEF, 0x09087, 0x08FF4, 0x09005, 0x08FF9, 0x08FFA, 0x09011, 0x09015,
+	0x09021, 0x0900D, 0x0901E, 0x09016, 0x0900B, 0x09027, 0x09036, 0x09035, 0x09039, 0x08FF8,
+	// row 78
+	0x0904F, 0x09050, 0x09051, 0x09052, 0x0900E, 0x09049, 0x0903E, 0x09056, 0x09058, 0x0905E, 0x09068, 0x0906F,
+	0x09076, 0x096A8, 0x09072, 0x09082, 0x0907D, 0x09081, 0x09080, 0x0908A, 0x09089, 0x0908F, 0x090A8, 0x090AF,
+	0x090B1, 0x090B5, 0x090E2, 0x090E4, 0x06248, 0x090DB, 0x09102, 0x09112, 0x09119, 0x09132, 0x09130, 0x0914A,
+	0x09156, 0x09158, 0x09163, 0x09165, 0x09169, 0x09173, 0x09172, 0x0918B, 0x09189, 0x09182, 0x091A2, 0x091AB,
+	0x091AF, 0x091AA, 0x091B5, 0x091B4, 0x091BA, 0x091C0, 0x091C1, 0x091C9, 0x091CB, 0x091D0, 0x091D6, 0x091DF,
+	0x091E1, 0x091DB, 0x091FC, 0x091F5, 0x091F6, 0x0921E, 0x091FF, 0x09214, 0x0922C, 0x09215, 0x09211, 0x0925E,
+	0x09257, 0x09245, 0x09249, 0x09264, 0x09248, 0x09295, 0x0923F, 0x0924B, 0x09250, 0x0929C, 0x09296, 0x09293,
+	0x0929B, 0x0925A, 0x092CF, 0x092B9, 0x092B7, 0x092E9, 0x0930F, 0x092FA, 0x09344, 0x0932E,
+	// row 79
+	0x09319, 0x09322, 0x0931A, 0x09323, 0x0933A, 0x09335, 0x0933B, 0x0935C, 0x09360, 0x0937C, 0x0936E, 0x09356,
+	0x093B0, 0x093AC, 0x093AD, 0x09394, 0x093B9, 0x093D6, 0x093D7, 0x093E8, 0x093E5, 0x093D8, 0x093C3, 0x093DD,
+	0x093D0, 0x093C8, 0x093E4, 0x0941A, 0x09414, 0x09413, 0x09403, 0x09407, 0x09410, 0x09436, 0x0942B, 0x09435,
+	0x09421, 0x0943A, 0x09441, 0x09452, 0x09444, 0x0945B, 0x09460, 0x09462, 0x0945E, 0x0946A, 0x09229, 0x09470,
+	0x09475, 0x09477, 0x0947D, 0x0945A, 0x0947C, 0x0947E, 0x09481, 0x0947F, 0x09582, 0x09587, 0x0958A, 0x09594,
+	0x09596, 0x09598, 0x09599, 0x095A0, 0x095A8, 0x095A7, 0x095AD, 0x095BC, 0x095BB, 0x095B9, 0x095BE, 0x095CA,
+	0x06FF6, 0x095C3, 0x095CD, 0x095CC, 0x095D5, 0x095D4, 0x095D6, 0x095DC, 0x095E1, 0x095E5, 0x095E2, 0x09621,
+	0x09628, 0x0962E, 0x0962F, 0x09642, 0x0964C, 0x0964F, 0x0964B, 0x09677, 0x0965C, 0x0965E,
+	// row 80
+	0x0965D, 0x0965F, 0x09666, 0x09672, 0x0966C, 0x0968D, 0x09698, 0x09695, 0x09697, 0x096AA, 0x096A7, 0x096B1,
+	0x096B2, 0x096B0, 0x096B4, 0x096B6, 0x096B8, 0x096B9, 0x096CE, 0x096CB, 0x096C9, 0x096CD, 0x0894D, 0x096DC,
+	0x0970D, 0x096D5, 0x096F9, 0x09704, 0x09706, 0x09708, 0x09713, 0x0970E, 0x09711, 0x0970F, 0x09716, 0x09719,
+	0x09724, 0x0972A, 0x09730, 0x09739, 0x0973D, 0x0973E, 0x09744, 0x09746, 0x09748, 0x09742, 0x09749, 0x0975C,
+	0x09760, 0x09764, 0x09766, 0x09768, 0x052D2, 0x0976B, 0x09771, 0x09779, 0x09785, 0x0977C, 0x09781, 0x0977A,
+	0x09786, 0x0978B, 0x0978F, 0x09790, 0x0979C, 0x097A8, 0x097A6, 0x097A3, 0x097B3, 0x097B4, 0x097C3, 0x097C6,
+	0x097C8, 0x097CB, 0x097DC, 0x097ED, 0x09F4F, 0x097F2, 0x07ADF, 0x097F6, 0x097F5, 0x0980F, 0x0980C, 0x09838,
+	0x09824, 0x09821, 0x09837, 0x0983D, 0x09846, 0x0984F, 0x0984B, 0x0986B, 0x0986F, 0x09870,
+	// row 81
+	0x09871, 0x09874, 0x09873, 0x098AA, 0x098AF, 0x098B1, 0x098B6, 0x098C4, 0x098C3, 0x098C6, 0x098E9, 0x098EB,
+	0x09903, 0x09909, 0x09912, 0x09914, 0x09918, 0x09921, 0x0991D, 0x0991E, 0x09924, 0x09920, 0x0992C, 0x0992E,
+	0x0993D, 0x0993E, 0x09942, 0x09949, 0x09945, 0x09950, 0x0994B, 0x09951, 0x09952, 0x0994C, 0x09955, 0x09997,
+	0x09998, 0x099A5, 0x099AD, 0x099AE, 0x099BC, 0x099DF, 0x099DB, 0x099DD, 0x099D8, 0x099D1, 0x099ED, 0x099EE,
+	0x099F1, 0x099F2, 0x099FB, 0x099F8, 0x09A01, 0x09A0F, 0x09A05, 0x099E2, 0x09A19, 0x09A2B, 0x09A37, 0x09A45,
+	0x09A42, 0x09A40, 0x09A43, 0x09A3E, 0x09A55, 0x09A4D, 0x09A5B, 0x09A57, 0x09A5F, 0x09A62, 0x09A65, 0x09A64,
+	0x09A69, 0x09A6B, 0x09A6A, 0x09AAD, 0x09AB0, 0x09ABC, 0x09AC0, 0x09ACF, 0x09AD1, 0x09AD3, 0x09AD4, 0x09ADE,
+	0x09ADF, 0x09AE2, 0x09AE3, 0x09AE6, 0x09AEF, 0x09AEB, 0x09AEE, 0x09AF4, 0x09AF1, 0x09AF7,
+	// row 82
+	0x09AFB, 0x09B06, 0x09B18, 0x09B1A, 0x09B1F, 0x09B22, 0x09B23, 0x09B25, 0x09B27, 0x09B28, 0x09B29, 0x09B2A,
+	0x09B2E, 0x09B2F, 0x09B32, 0x09B44, 0x09B43, 0x09B4F, 0x09B4D, 0x09B4E, 0x09B51, 0x09B58, 0x09B74, 0x09B93,
+	0x09B83, 0x09B91, 0x09B96, 0x09B97, 0x09B9F, 0x09BA0, 0x09BA8, 0x09BB4, 0x09BC0, 0x09BCA, 0x09BB9, 0x09BC6,
+	0x09BCF, 0x09BD1, 0x09BD2, 0x09BE3, 0x09BE2, 0x09BE4, 0x09BD4, 0x09BE1, 0x09C3A, 0x09BF2, 0x09BF1, 0x09BF0,
+	0x09C15, 0x09C14, 0x09C09, 0x09C13, 0x09C0C, 0x09C06, 0x09C08, 0x09C12, 0x09C0A, 0x09C04, 0x09C2E, 0x09C1B,
+	0x09C25, 0x09C24, 0x09C21, 0x09C30, 0x09C47, 0x09C32, 0x09C46, 0x09C3E, 0x09C5A, 0x09C60, 0x09C67, 0x09C76,
+	0x09C78, 0x09CE7, 0x09CEC, 0x09CF0, 0x09D09, 0x09D08, 0x09CEB, 0x09D03, 0x09D06, 0x09D2A, 0x09D26, 0x09DAF,
+	0x09D23, 0x09D1F, 0x09D44, 0x09D15, 0x09D12, 0x09D41, 0x09D3F, 0x09D3E, 0x09D46, 0x09D48,
+	// row 83
+	0x09D5D, 0x09D5E, 0x09D64, 0x09D51, 0x09D50, 0x09D59, 0x09D72, 0x09D89, 0x09D87, 0x09DAB, 0x09D6F, 0x09D7A,
+	0x09D9A, 0x09DA4, 0x09DA9, 0x09DB2, 0x09DC4, 0x09DC1, 0x09DBB, 0x09DB8, 0x09DBA, 0x09DC6, 0x09DCF, 0x09DC2,
+	0x09DD9, 0x09DD3, 0x09DF8, 0x09DE6, 0x09DED, 0x09DEF, 0x09DFD, 0x09E1A, 0x09E1B, 0x09E1E, 0x09E75, 0x09E79,
+	0x09E7D, 0x09E81, 0x09E88, 0x09E8B, 0x09E8C, 0x09E92, 0x09E95, 0x09E91, 0x09E9D, 0x09EA5, 0x09EA9, 0x09EB8,
+	0x09EAA, 0x09EAD, 0x09761, 0x09ECC, 0x09ECE, 0x09ECF, 0x09ED0, 0x09ED4, 0x09EDC, 0x09EDE, 0x09EDD, 0x09EE0,
+	0x09EE5, 0x09EE8, 0x09EEF, 0x09EF4, 0x09EF6, 0x09EF7, 0x09EF9, 0x09EFB, 0x09EFC, 0x09EFD, 0x09F07, 0x09F08,
+	0x076B7, 0x09F15, 0x09F21, 0x09F2C, 0x09F3E, 0x09F4A, 0x09F52, 0x09F54, 0x09F63, 0x09F5F, 0x09F60, 0x09F61,
+	0x09F66, 0x09F67, 0x09F6C, 0x09F6A, 0x09F77, 0x09F72, 0x09F76, 0x09F95, 0x09F9C, 0x09FA0,
+	// row 84
+	0x0582F, 0x069C7, 0x09059, 0x07464, 0x051DC, 0x07199, 0x05653, 0x05DE2, 0x05E14, 0x05E18, 0x05E58, 0x05E5E,
+	0x05EBE, 0x0F928, 0x05ECB, 0x05EF9, 0x05F00, 0x05F02, 0x05F07, 0x05F1D, 0x05F23, 0x05F34, 0x05F36, 0x05F3D,
+	0x05F40, 0x05F45, 0x05F54, 0x05F58, 0x05F64, 0x05F67, 0x05F7D, 0x05F89, 0x05F9C, 0x05FA7, 0x05FAF, 0x05FB5,
+	0x05FB7, 0x05FC9, 0x05FDE, 0x05FE1, 0x05FE9, 0x0600D, 0x06014, 0x06018, 0x06033, 0x06035, 0x06047, 0x0FA3D,
+	0x0609D, 0x0609E, 0x060CB, 0x060D4, 0x060D5, 0x060DD, 0x060F8, 0x0611C, 0x0612B, 0x06130, 0x06137, 0x0FA3E,
+	0x0618D, 0x0FA3F, 0x061BC, 0x061B9, 0x0FA40, 0x06222, 0x0623E, 0x06243, 0x06256, 0x0625A, 0x0626F, 0x06285,
+	0x062C4, 0x062D6, 0x062FC, 0x0630A, 0x06318, 0x06339, 0x06343, 0x06365, 0x0637C, 0x063E5, 0x063ED, 0x063F5,
+	0x06410, 0x06414, 0x06422, 0x06479, 0x06451, 0x06460, 0x0646D, 0x064CE, 0x064BE, 0x064BF,
+	// row 85
+	0x064C4, 0x064CA, 0x064D0, 0x064F7, 0x064FB, 0x06522, 0x06529, 0x0FA41, 0x06567, 0x0659D, 0x0FA42, 0x06600,
+	0x06609, 0x06615, 0x0661E, 0x0663A, 0x06622, 0x06624, 0x0662B, 0x06630, 0x06631, 0x06633, 0x066FB, 0x06648,
+	0x0664C, 0x231C4, 0x06659, 0x0665A, 0x06661, 0x06665, 0x06673, 0x06677, 0x06678, 0x0668D, 0x0FA43, 0x066A0,
+	0x066B2, 0x066BB, 0x066C6, 0x066C8, 0x03B22, 0x066DB, 0x066E8, 0x066FA, 0x06713, 0x0F929, 0x06733, 0x06766,
+	0x06747, 0x06748, 0x0677B, 0x06781, 0x06793, 0x06798, 0x0679B, 0x067BB, 0x067F9, 0x067C0, 0x067D7, 0x067FC,
+	0x06801, 0x06852, 0x0681D, 0x0682C, 0x06831, 0x0685B, 0x06872, 0x06875, 0x0FA44, 0x068A3, 0x068A5, 0x068B2,
+	0x068C8, 0x068D0, 0x068E8, 0x068ED, 0x068F0, 0x068F1, 0x068FC, 0x0690A, 0x06949, 0x235C4, 0x06935, 0x06942,
+	0x06957, 0x06963, 0x06964, 0x06968, 0x06980, 0x0FA14, 0x069A5, 0x069AD, 0x069CF, 0x03BB6,
+	// row 86
+	0x03BC3, 0x069E2, 0x069E9, 0x069EA, 0x069F5, 0x069F6, 0x06A0F, 0x06A15, 0x2373F, 0x06A3B, 0x06A3E, 0x06A45,
+	0x06A50, 0x06A56, 0x06A5B, 0x06A6B, 0x06A73, 0x23763, 0x06A89, 0x06A94, 0x06A9D, 0x06A9E, 0x06AA5, 0x06AE4,
+	0x06AE7, 0x03C0F, 0x0F91D, 0x06B1B, 0x06B1E, 0x06B2C, 0x06B35, 0x06B46, 0x06B56, 0x06B60, 0x06B65, 0x06B67,
+	0x06B77, 0x06B82, 0x06BA9, 0x06BAD, 0x0F970, 0x06BCF, 0x06BD6, 0x06BD7, 0x06BFF, 0x06C05, 0x06C10, 0x06C33,
+	0x06C59, 0x06C5C, 0x06CAA, 0x06C74, 0x06C76, 0x06C85, 0x06C86, 0x06C98, 0x06C9C, 0x06CFB, 0x06CC6, 0x06CD4,
+	0x06CE0, 0x06CEB, 0x06CEE, 0x23CFE, 0x06D04, 0x06D0E, 0x06D2E, 0x06D31, 0x06D39, 0x06D3F, 0x06D58, 0x06D65,
+	0x0FA45, 0x06D82, 0x06D87, 0x06D89, 0x06D94, 0x06DAA, 0x06DAC, 0x06DBF, 0x06DC4, 0x06DD6, 0x06DDA, 0x06DDB,
+	0x06DDD, 0x06DFC, 0x0FA46, 0x06E34, 0x06E44, 0x06E5C, 0x06E5E, 0x06EAB, 0x06EB1, 0x06EC1,
+	// row 87
+	0x06EC7, 0x06ECE, 0x06F10, 0x06F1A, 0x0FA47, 0x06F2A, 0x06F2F, 0x06F33, 0x06F51, 0x06F59, 0x06F5E, 0x06F61,
+	0x06F62, 0x06F7E, 0x06F88, 0x06F8C, 0x06F8D, 0x06F94, 0x06FA0, 0x06FA7, 0x06FB6, 0x06FBC, 0x06FC7, 0x06FCA,
+	0x06FF9, 0x06FF0, 0x06FF5, 0x07005, 0x07006, 0x07028, 0x0704A, 0x0705D, 0x0705E, 0x0704E, 0x07064, 0x07075,
+	0x07085, 0x070A4, 0x070AB, 0x070B7, 0x070D4, 0x070D8, 0x070E4, 0x0710F, 0x0712B, 0x0711E, 0x07120, 0x0712E,
+	0x07130, 0x07146, 0x07147, 0x07151, 0x0FA48, 0x07152, 0x0715C, 0x07160, 0x07168, 0x0FA15, 0x07185, 0x07187,
+	0x07192, 0x071C1, 0x071BA, 0x071C4, 0x071FE, 0x07200, 0x07215, 0x07255, 0x07256, 0x03E3F, 0x0728D, 0x0729B,
+	0x072BE, 0x072C0, 0x072FB, 0x247F1, 0x07327, 0x07328, 0x0FA16, 0x07350, 0x07366, 0x0737C, 0x07395, 0x0739F,
+	0x073A0, 0x073A2, 0x073A6, 0x073AB, 0x073C9, 0x073CF, 0x073D6, 0x073D9, 0x073E3, 0x073E9,
+	// row 88
+	0x07407, 0x0740A, 0x0741A, 0x0741B, 0x0FA4A, 0x07426, 0x07428, 0x0742A, 0x0742B, 0x0742C, 0x0742E, 0x0742F,
+	0x07430, 0x07444, 0x07446, 0x07447, 0x0744B, 0x07457, 0x07462, 0x0746B, 0x0746D, 0x07486, 0x07487, 0x07489,
+	0x07498, 0x0749C, 0x0749F, 0x074A3, 0x07490, 0x074A6, 0x074A8, 0x074A9, 0x074B5, 0x074BF, 0x074C8, 0x074C9,
+	0x074DA, 0x074FF, 0x07501, 0x07517, 0x0752F, 0x0756F, 0x07579, 0x07592, 0x03F72, 0x075CE, 0x075E4, 0x07600,
+	0x07602, 0x07608, 0x07615, 0x07616, 0x07619, 0x0761E, 0x0762D, 0x07635, 0x07643, 0x0764B, 0x07664, 0x07665,
+	0x0766D, 0x0766F, 0x07671, 0x07681, 0x0769B, 0x0769D, 0x0769E, 0x076A6, 0x076AA, 0x076B6, 0x076C5, 0x076CC,
+	0x076CE, 0x076D4, 0x076E6, 0x076F1, 0x076FC, 0x0770A, 0x07719, 0x07734, 0x07736, 0x07746, 0x0774D, 0x0774E,
+	0x0775C, 0x0775F, 0x07762, 0x0777A, 0x07780, 0x07794, 0x077AA, 0x077E0, 0x0782D, 0x2548E,
+	// row 89
+	0x07843, 0x0784E, 0x0784F, 0x07851, 0x07868, 0x0786E, 0x0FA4B, 0x078B0, 0x2550E, 0x078AD, 0x078E4, 0x078F2,
+	0x07900, 0x078F7, 0x0791C, 0x0792E, 0x07931, 0x07934, 0x0FA4C, 0x0FA4D, 0x07945, 0x07946, 0x0FA4E, 0x0FA4F,
+	0x0FA50, 0x0795C, 0x0FA51, 0x0FA19, 0x0FA1A, 0x07979, 0x0FA52, 0x0FA53, 0x0FA1B, 0x07998, 0x079B1, 0x079B8,
+	0x079C8, 0x079CA, 0x25771, 0x079D4, 0x079DE, 0x079EB, 0x079ED, 0x07A03, 0x0FA54, 0x07A39, 0x07A5D, 0x07A6D,
+	0x0FA55, 0x07A85, 0x07AA0, 0x259C4, 0x07AB3, 0x07ABB, 0x07ACE, 0x07AEB, 0x07AFD, 0x07B12, 0x07B2D, 0x07B3B,
+	0x07B47, 0x07B4E, 0x07B60, 0x07B6D, 0x07B6F, 0x07B72, 0x07B9E, 0x0FA56, 0x07BD7, 0x07BD9, 0x07C01, 0x07C31,
+	0x07C1E, 0x07C20, 0x07C33, 0x07C36, 0x04264, 0x25DA1, 0x07C59, 0x07C6D, 0x07C79, 0x07C8F, 0x07C94, 0x07CA0,
+	0x07CBC, 0x07CD5, 0x07CD9, 0x07CDD, 0x07D07, 0x07D08, 0x07D13, 0x07D1D, 0x07D23, 0x07D31,
+	// row 90
+	0x07D41, 0x07D48, 0x07D53, 0x07D5C, 0x07D7A, 0x07D83, 0x07D8B, 0x07DA0, 0x07DA6, 0x07DC2, 0x07DCC, 0x07DD6,
+	0x07DE3, 0x0FA57, 0x07E28, 0x07E08, 0x07E11, 0x07E15, 0x0FA59, 0x07E47, 0x07E52, 0x07E61, 0x07E8A, 0x07E8D,
+	0x07F47, 0x0FA5A, 0x07F91, 0x07F97, 0x07FBF, 0x07FCE, 0x07FDB, 0x07FDF, 0x07FEC, 0x07FEE, 0x07FFA, 0x0FA5B,
+	0x08014, 0x08026, 0x08035, 0x08037, 0x0803C, 0x080CA, 0x080D7, 0x080E0, 0x080F3, 0x08118, 0x0814A, 0x08160,
+	0x08167, 0x08168, 0x0816D, 0x081BB, 0x081CA, 0x081CF, 0x081D7, 0x0FA5C, 0x04453, 0x0445B, 0x08260, 0x08274,
+	0x26AFF, 0x0828E, 0x082A1, 0x082A3, 0x082A4, 0x082A9, 0x082AE, 0x082B7, 0x082BE, 0x082BF, 0x082C6, 0x082D5,
+	0x082FD, 0x082FE, 0x08300, 0x08301, 0x08362, 0x08322, 0x0832D, 0x0833A, 0x08343, 0x08347, 0x08351, 0x08355,
+	0x0837D, 0x08386, 0x08392, 0x08398, 0x083A7, 0x083A9, 0x083BF, 0x083C0, 0x083C7, 0x083CF,
+	// row 91
+	0x083D1, 0x083E1, 0x083EA, 0x08401, 0x08406, 0x0840A, 0x0FA5F, 0x08448, 0x0845F, 0x08470, 0x08473, 0x08485,
+	0x0849E, 0x084AF, 0x084B4, 0x084BA, 0x084C0, 0x084C2, 0x26E40, 0x08532, 0x0851E, 0x08523, 0x0852F, 0x08559,
+	0x08564, 0x0FA1F, 0x085AD, 0x0857A, 0x0858C, 0x0858F, 0x085A2, 0x085B0, 0x085CB, 0x085CE, 0x085ED, 0x08612,
+	0x085FF, 0x08604, 0x08605, 0x08610, 0x270F4, 0x08618, 0x08629, 0x08638, 0x08657, 0x0865B, 0x0F936, 0x08662,
+	0x0459D, 0x0866C, 0x08675, 0x08698, 0x086B8, 0x086FA, 0x086FC, 0x086FD, 0x0870B, 0x08771, 0x08787, 0x08788,
+	0x087AC, 0x087AD, 0x087B5, 0x045EA, 0x087D6, 0x087EC, 0x08806, 0x0880A, 0x08810, 0x08814, 0x0881F, 0x08898,
+	0x088AA, 0x088CA, 0x088CE, 0x27684, 0x088F5, 0x0891C, 0x0FA60, 0x08918, 0x08919, 0x0891A, 0x08927, 0x08930,
+	0x08932, 0x08939, 0x08940, 0x08994, 0x0FA61, 0x089D4, 0x089E5, 0x089F6, 0x08A12, 0x08A15,
+	// row 92
+	0x08A22, 0x08A37, 0x08A47, 0x08A4E, 0x08A5D, 0x08A61, 0x08A75, 0x08A79, 0x08AA7, 0x08AD0, 0x08ADF, 0x08AF4,
+	0x08AF6, 0x0FA22, 0x0FA62, 0x0FA63, 0x08B46, 0x08B54, 0x08B59, 0x08B69, 0x08B9D, 0x08C49, 0x08C68, 0x0FA64,
+	0x08CE1, 0x08CF4, 0x08CF8, 0x08CFE, 0x0FA65, 0x08D12, 0x08D1B, 0x08DAF, 0x08DCE, 0x08DD1, 0x08DD7, 0x08E20,
+	0x08E23, 0x08E3D, 0x08E70, 0x08E7B, 0x28277, 0x08EC0, 0x04844, 0x08EFA, 0x08F1E, 0x08F2D, 0x08F36, 0x08F54,
+	0x283CD, 0x08FA6, 0x08FB5, 0x08FE4, 0x08FE8, 0x08FEE, 0x09008, 0x0902D, 0x0FA67, 0x09088, 0x09095, 0x09097,
+	0x09099, 0x0909B, 0x090A2, 0x090B3, 0x090BE, 0x090C4, 0x090C5, 0x090C7, 0x090D7, 0x090DD, 0x090DE, 0x090EF,
+	0x090F4, 0x0FA26, 0x09114, 0x09115, 0x09116, 0x09122, 0x09123, 0x09127, 0x0912F, 0x09131, 0x09134, 0x0913D,
+	0x09148, 0x0915B, 0x09183, 0x0919E, 0x091AC, 0x091B1, 0x091BC, 0x091D7, 0x091FB, 0x091E4,
+	// row 93
+	0x091E5, 0x091ED, 0x091F1, 0x09207, 0x09210, 0x09238, 0x09239, 0x0923A, 0x0923C, 0x09240, 0x09243, 0x0924F,
+	0x09278, 0x09288, 0x092C2, 0x092CB, 0x092CC, 0x092D3, 0x092E0, 0x092FF, 0x09304, 0x0931F, 0x09321, 0x09325,
+	0x09348, 0x09349, 0x0934A, 0x09364, 0x09365, 0x0936A, 0x09370, 0x0939B, 0x093A3, 0x093BA, 0x093C6, 0x093DE,
+	0x093DF, 0x09404, 0x093FD, 0x09433, 0x0944A, 0x09463, 0x0946B, 0x09471, 0x09472, 0x0958E, 0x0959F, 0x095A6,
+	0x095A9, 0x095AC, 0x095B6, 0x095BD, 0x095CB, 0x095D0, 0x095D3, 0x049B0, 0x095DA, 0x095DE, 0x09658, 0x09684,
+	0x0F9DC, 0x0969D, 0x096A4, 0x096A5, 0x096D2, 0x096DE, 0x0FA68, 0x096E9, 0x096EF, 0x09733, 0x0973B, 0x0974D,
+	0x0974E, 0x0974F, 0x0975A, 0x0976E, 0x09773, 0x09795, 0x097AE, 0x097BA, 0x097C1, 0x097C9, 0x097DE, 0x097DB,
+	0x097F4, 0x0FA69, 0x0980A, 0x0981E, 0x0982B, 0x09830, 0x0FA6A, 0x09852, 0x09853, 0x09856,
+	// row 94
+	0x09857, 0x09859, 0x0985A, 0x0F9D0, 0x09865, 0x0986C, 0x098BA, 0x098C8, 0x098E7, 0x09958, 0x0999E, 0x09A02,
+	0x09A03, 0x09A24, 0x09A2D, 0x09A2E, 0x09A38, 0x09A4A, 0x09A4E, 0x09A52, 0x09AB6, 0x09AC1, 0x09AC3, 0x09ACE,
+	0x09AD6, 0x09AF9, 0x09B02, 0x09B08, 0x09B20, 0x04C17, 0x09B2D, 0x09B5E, 0x09B79, 0x09B66, 0x09B72, 0x09B75,
+	0x09B84, 0x09B8A, 0x09B8F, 0x09B9E, 0x09BA7, 0x09BC1, 0x09BCE, 0x09BE5, 0x09BF8, 0x09BFD, 0x09C00, 0x09C23,
+	0x09C41, 0x09C4F, 0x09C50, 0x09C53, 0x09C63, 0x09C65, 0x09C77, 0x09D1D, 0x09D1E, 0x09D43, 0x09D47, 0x09D52,
+	0x09D63, 0x09D70, 0x09D7C, 0x09D8A, 0x09D96, 0x09DC0, 0x09DAC, 0x09DBC, 0x09DD7, 0x2A190, 0x09DE7, 0x09E07,
+	0x09E15, 0x09E7C, 0x09E9E, 0x09EA4, 0x09EAC, 0x09EAF, 0x09EB4, 0x09EB5, 0x09EC3, 0x09ED1, 0x09F10, 0x09F39,
+	0x09F57, 0x09F90, 0x09F94, 0x09F97, 0x09FA2, 0x059F8, 0x05C5B, 0x05E77, 0x07626, 0x07E6B,
+}
+
+// jisX0213Plane2Rows lists the rows used in JIS X 0213 plane 2, in the order
+// in which they appear in jisX0213Plane2.
+var jisX0213Plane2Rows = [26]byte {1, 3, 4, 5, 8, 12, 13, 14, 15, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94}
+
+// jisX0213Plane2 maps JIS X 0213 plane 2 row/cell pairs, at index
+// rowIndex * 94 + cell - 1, with rowIndex referring to jisX0213Plane2Rows.
+var jisX0213Plane2 = [26 * 94]uint32 {
+	// row 1
+	0x20089, 0x04E02, 0x04E0F, 0x04E12, 0x04E29, 0x04E2B, 0x04E2E, 0x04E40, 0x04E47, 0x04E48, 0x200A2, 0x04E51,
+	0x03406, 0x200A4, 0x04E5A, 0x04E69, 0x04E9D, 0x0342C, 0x0342E, 0x04EB9, 0x04EBB, 0x201A2, 0x04EBC, 0x04EC3,
+	0x04EC8, 0x04ED0, 0x04EEB, 0x04EDA, 0x04EF1, 0x04EF5, 0x04F00, 0x04F16, 0x04F64, 0x04F37, 0x04F3E, 0x04F54,
+	0x04F58, 0x20213, 0x04F77, 0x04F78, 0x04F7A, 0x04F7D, 0x04F82, 0x04F85, 0x04F92, 0x04F9A, 0x04FE6, 0x04FB2,
+	0x04FBE, 0x04FC5, 0x04FCB, 0x04FCF, 0x04FD2, 0x0346A, 0x04FF2, 0x05000, 0x05010, 0x05013, 0x0501C, 0x0501E,
+	0x05022, 0x03468, 0x05042, 0x05046, 0x0504E, 0x05053, 0x05057, 0x05063, 0x05066, 0x0506A, 0x05070, 0x050A3,
+	0x05088, 0x05092, 0x05093, 0x05095, 0x05096, 0x0509C, 0x050AA, 0x2032B, 0x050B1, 0x050BA, 0x050BB, 0x050C4,
+	0x050C7, 0x050F3, 0x20381, 0x050CE, 0x20371, 0x050D4, 0x050D9, 0x050E1, 0x050E9, 0x03492,
+	// row 3
+	0x05108, 0x203F9, 0x05117, 0x0511B, 0x2044A, 0x05160, 0x20509, 0x05173, 0x05183, 0x0518B, 0x034BC, 0x05198,
+	0x051A3, 0x051AD, 0x034C7, 0x051BC, 0x205D6, 0x20628, 0x051F3, 0x051F4, 0x05202, 0x05212, 0x05216, 0x2074F,
+	0x05255, 0x0525C, 0x0526C, 0x05277, 0x05284, 0x05282, 0x20807, 0x05298, 0x2083A, 0x052A4, 0x052A6, 0x052AF,
+	0x052BA, 0x052BB, 0x052CA, 0x0351F, 0x052D1, 0x208B9, 0x052F7, 0x0530A, 0x0530B, 0x05324, 0x05335, 0x0533E,
+	0x05342, 0x2097C, 0x2099D, 0x05367, 0x0536C, 0x0537A, 0x053A4, 0x053B4, 0x20AD3, 0x053B7, 0x053C0, 0x20B1D,
+	0x0355D, 0x0355E, 0x053D5, 0x053DA, 0x03563, 0x053F4, 0x053F5, 0x05455, 0x05424, 0x05428, 0x0356E, 0x05443,
+	0x05462, 0x05466, 0x0546C, 0x0548A, 0x0548D, 0x05495, 0x054A0, 0x054A6, 0x054AD, 0x054AE, 0x054B7, 0x054BA,
+	0x054BF, 0x054C3, 0x20D45, 0x054EC, 0x054EF, 0x054F1, 0x054F3, 0x05500, 0x05501, 0x05509,
+	// row 4
+	0x0553C, 0x05541, 0x035A6, 0x05547, 0x0554A, 0x035A8, 0x05560, 0x05561, 0x05564, 0x20DE1, 0x0557D, 0x05582,
+	0x05588, 0x05591, 0x035C5, 0x055D2, 0x20E95, 0x20E6D, 0x055BF, 0x055C9, 0x055CC, 0x055D1, 0x055DD, 0x035DA,
+	0x055E2, 0x20E64, 0x055E9, 0x05628, 0x20F5F, 0x05607, 0x05610, 0x05630, 0x05637, 0x035F4, 0x0563D, 0x0563F,
+	0x05640, 0x05647, 0x0565E, 0x05660, 0x0566D, 0x03605, 0x05688, 0x0568C, 0x05695, 0x0569A, 0x0569D, 0x056A8,
+	0x056AD, 0x056B2, 0x056C5, 0x056CD, 0x056DF, 0x056E8, 0x056F6, 0x056F7, 0x21201, 0x05715, 0x05723, 0x21255,
+	0x05729, 0x2127B, 0x05745, 0x05746, 0x0574C, 0x0574D, 0x21274, 0x05768, 0x0576F, 0x05773, 0x05774, 0x05775,
+	0x0577B, 0x212E4, 0x212D7, 0x057AC, 0x0579A, 0x0579D, 0x0579E, 0x057A8, 0x057D7, 0x212FD, 0x057CC, 0x21336,
+	0x21344, 0x057DE, 0x057E6, 0x057F0, 0x0364A, 0x057F8, 0x057FB, 0x057FD, 0x05804, 0x0581E,
+	// row 5
+	0x05820, 0x05827, 0x05832, 0x05839, 0x213C4, 0x05849, 0x0584C, 0x05867, 0x0588A, 0x0588B, 0x0588D, 0x0588F,
+	0x05890, 0x05894, 0x0589D, 0x058AA, 0x058B1, 0x2146D, 0x058C3, 0x058CD, 0x058E2, 0x058F3, 0x058F4, 0x05905,
+	0x05906, 0x0590B, 0x0590D, 0x05914, 0x05924, 0x215D7, 0x03691, 0x0593D, 0x03699, 0x05946, 0x03696, 0x26C29,
+	0x0595B, 0x0595F, 0x21647, 0x05975, 0x05976, 0x0597C, 0x0599F, 0x059AE, 0x059BC, 0x059C8, 0x059CD, 0x059DE,
+	0x059E3, 0x059E4, 0x059E7, 0x059EE, 0x21706, 0x21742, 0x036CF, 0x05A0C, 0x05A0D, 0x05A17, 0x05A27, 0x05A2D,
+	0x05A55, 0x05A65, 0x05A7A, 0x05A8B, 0x05A9C, 0x05A9F, 0x05AA0, 0x05AA2, 0x05AB1, 0x05AB3, 0x05AB5, 0x05ABA,
+	0x05ABF, 0x05ADA, 0x05ADC, 0x05AE0, 0x05AE5, 0x05AF0, 0x05AEE, 0x05AF5, 0x05B00, 0x05B08, 0x05B17, 0x05B34,
+	0x05B2D, 0x05B4C, 0x05B52, 0x05B68, 0x05B6F, 0x05B7C, 0x05B7F, 0x05B81, 0x05B84, 0x219C3,
+	// row 8
+	0x05B96, 0x05BAC, 0x03761, 0x05BC0, 0x03762, 0x05BCE, 0x05BD6, 0x0376C, 0x0376B, 0x05BF1, 0x05BFD, 0x03775,
+	0x05C03, 0x05C29, 0x05C30, 0x21C56, 0x05C5F, 0x05C63, 0x05C67, 0x05C68, 0x05C69, 0x05C70, 0x21D2D, 0x21D45,
+	0x05C7C, 0x21D78, 0x21D62, 0x05C88, 0x05C8A, 0x037C1, 0x21DA1, 0x21D9C, 0x05CA0, 0x05CA2, 0x05CA6, 0x05CA7,
+	0x21D92, 0x05CAD, 0x05CB5, 0x21DB7, 0x05CC9, 0x21DE0, 0x21E33, 0x05D06, 0x05D10, 0x05D2B, 0x05D1D, 0x05D20,
+	0x05D24, 0x05D26, 0x05D31, 0x05D39, 0x05D42, 0x037E8, 0x05D61, 0x05D6A, 0x037F4, 0x05D70, 0x21F1E, 0x037FD,
+	0x05D88, 0x03800, 0x05D92, 0x05D94, 0x05D97, 0x05D99, 0x05DB0, 0x05DB2, 0x05DB4, 0x21F76, 0x05DB9, 0x05DD1,
+	0x05DD7, 0x05DD8, 0x05DE0, 0x21FFA, 0x05DE4, 0x05DE9, 0x0382F, 0x05E00, 0x03836, 0x05E12, 0x05E15, 0x03840,
+	0x05E1F, 0x05E2E, 0x05E3E, 0x05E49, 0x0385C, 0x05E56, 0x03861, 0x05E6B, 0x05E6C, 0x05E6D,
+	// row 12
+	0x05E6E, 0x2217B, 0x05EA5, 0x05EAA, 0x05EAC, 0x05EB9, 0x05EBF, 0x05EC6, 0x05ED2, 0x05ED9, 0x2231E, 0x05EFD,
+	0x05F08, 0x05F0E, 0x05F1C, 0x223AD, 0x05F1E, 0x05F47, 0x05F63, 0x05F72, 0x05F7E, 0x05F8F, 0x05FA2, 0x05FA4,
+	0x05FB8, 0x05FC4, 0x038FA, 0x05FC7, 0x05FCB, 0x05FD2, 0x05FD3, 0x05FD4, 0x05FE2, 0x05FEE, 0x05FEF, 0x05FF3,
+	0x05FFC, 0x03917, 0x06017, 0x06022, 0x06024, 0x0391A, 0x0604C, 0x0607F, 0x0608A, 0x06095, 0x060A8, 0x226F3,
+	0x060B0, 0x060B1, 0x060BE, 0x060C8, 0x060D9, 0x060DB, 0x060EE, 0x060F2, 0x060F5, 0x06110, 0x06112, 0x06113,
+	0x06119, 0x0611E, 0x0613A, 0x0396F, 0x06141, 0x06146, 0x06160, 0x0617C, 0x2285B, 0x06192, 0x06193, 0x06197,
+	0x06198, 0x061A5, 0x061A8, 0x061AD, 0x228AB, 0x061D5, 0x061DD, 0x061DF, 0x061F5, 0x2298F, 0x06215, 0x06223,
+	0x06229, 0x06246, 0x0624C, 0x06251, 0x06252, 0x06261, 0x06264, 0x0627B, 0x0626D, 0x06273,
+	// row 13
+	0x06299, 0x062A6, 0x062D5, 0x22AB8, 0x062FD, 0x06303, 0x0630D, 0x06310, 0x22B4F, 0x22B50, 0x06332, 0x06335,
+	0x0633B, 0x0633C, 0x06341, 0x06344, 0x0634E, 0x22B46, 0x06359, 0x22C1D, 0x22BA6, 0x0636C, 0x06384, 0x06399,
+	0x22C24, 0x06394, 0x063BD, 0x063F7, 0x063D4, 0x063D5, 0x063DC, 0x063E0, 0x063EB, 0x063EC, 0x063F2, 0x06409,
+	0x0641E, 0x06425, 0x06429, 0x0642F, 0x0645A, 0x0645B, 0x0645D, 0x06473, 0x0647D, 0x06487, 0x06491, 0x0649D,
+	0x0649F, 0x064CB, 0x064CC, 0x064D5, 0x064D7, 0x22DE1, 0x064E4, 0x064E5, 0x064FF, 0x06504, 0x03A6E, 0x0650F,
+	0x06514, 0x06516, 0x03A73, 0x0651E, 0x06532, 0x06544, 0x06554, 0x0656B, 0x0657A, 0x06581, 0x06584, 0x06585,
+	0x0658A, 0x065B2, 0x065B5, 0x065B8, 0x065BF, 0x065C2, 0x065C9, 0x065D4, 0x03AD6, 0x065F2, 0x065F9, 0x065FC,
+	0x06604, 0x06608, 0x06621, 0x0662A, 0x06645, 0x06651, 0x0664E, 0x03AEA, 0x231C3, 0x06657,
+	// row 14
+	0x0665B, 0x06663, 0x231F5, 0x231B6, 0x0666A, 0x0666B, 0x0666C, 0x0666D, 0x0667B, 0x06680, 0x06690, 0x06692,
+	0x06699, 0x03B0E, 0x066AD, 0x066B1, 0x066B5, 0x03B1A, 0x066BF, 0x03B1C, 0x066EC, 0x03AD7, 0x06701, 0x06705,
+	0x06712, 0x23372, 0x06719, 0x233D3, 0x233D2, 0x0674C, 0x0674D, 0x06754, 0x0675D, 0x233D0, 0x233E4, 0x233D5,
+	0x06774, 0x06776, 0x233DA, 0x06792, 0x233DF, 0x08363, 0x06810, 0x067B0, 0x067B2, 0x067C3, 0x067C8, 0x067D2,
+	0x067D9, 0x067DB, 0x067F0, 0x067F7, 0x2344A, 0x23451, 0x2344B, 0x06818, 0x0681F, 0x0682D, 0x23465, 0x06833,
+	0x0683B, 0x0683E, 0x06844, 0x06845, 0x06849, 0x0684C, 0x06855, 0x06857, 0x03B77, 0x0686B, 0x0686E, 0x0687A,
+	0x0687C, 0x06882, 0x06890, 0x06896, 0x03B6D, 0x06898, 0x06899, 0x0689A, 0x0689C, 0x068AA, 0x068AB, 0x068B4,
+	0x068BB, 0x068FB, 0x234E4, 0x2355A, 0x0FA13, 0x068C3, 0x068C5, 0x068CC, 0x068CF, 0x068D6,
+	// row 15
+	0x068D9, 0x068E4, 0x068E5, 0x068EC, 0x068F7, 0x06903, 0x06907, 0x03B87, 0x03B88, 0x23594, 0x0693B, 0x03B8D,
+	0x06946, 0x06969, 0x0696C, 0x06972, 0x0697A, 0x0697F, 0x06992, 0x03BA4, 0x06996, 0x06998, 0x069A6, 0x069B0,
+	0x069B7, 0x069BA, 0x069BC, 0x069C0, 0x069D1, 0x069D6, 0x23639, 0x23647, 0x06A30, 0x23638, 0x2363A, 0x069E3,
+	0x069EE, 0x069EF, 0x069F3, 0x03BCD, 0x069F4, 0x069FE, 0x06A11, 0x06A1A, 0x06A1D, 0x2371C, 0x06A32, 0x06A33,
+	0x06A34, 0x06A3F, 0x06A46, 0x06A49, 0x06A7A, 0x06A4E, 0x06A52, 0x06A64, 0x2370C, 0x06A7E, 0x06A83, 0x06A8B,
+	0x03BF0, 0x06A91, 0x06A9F, 0x06AA1, 0x23764, 0x06AAB, 0x06ABD, 0x06AC6, 0x06AD4, 0x06AD0, 0x06ADC, 0x06ADD,
+	0x237FF, 0x237E7, 0x06AEC, 0x06AF1, 0x06AF2, 0x06AF3, 0x06AFD, 0x23824, 0x06B0B, 0x06B0F, 0x06B10, 0x06B11,
+	0x2383D, 0x06B17, 0x03C26, 0x06B2F, 0x06B4A, 0x06B58, 0x06B6C, 0x06B75, 0x06B7A, 0x06B81,
+	// row 78
+	0x06B9B, 0x06BAE, 0x23A98, 0x06BBD, 0x06BBE, 0x06BC7, 0x06BC8, 0x06BC9, 0x06BDA, 0x06BE6, 0x06BE7, 0x06BEE,
+	0x06BF1, 0x06C02, 0x06C0A, 0x06C0E, 0x06C35, 0x06C36, 0x06C3A, 0x23C7F, 0x06C3F, 0x06C4D, 0x06C5B, 0x06C6D,
+	0x06C84, 0x06C89, 0x03CC3, 0x06C94, 0x06C95, 0x06C97, 0x06CAD, 0x06CC2, 0x06CD0, 0x03CD2, 0x06CD6, 0x06CDA,
+	0x06CDC, 0x06CE9, 0x06CEC, 0x06CED, 0x23D00, 0x06D00, 0x06D0A, 0x06D24, 0x06D26, 0x06D27, 0x06C67, 0x06D2F,
+	0x06D3C, 0x06D5B, 0x06D5E, 0x06D60, 0x06D70, 0x06D80, 0x06D81, 0x06D8A, 0x06D8D, 0x06D91, 0x06D98, 0x23D40,
+	0x06E17, 0x23DFA, 0x23DF9, 0x23DD3, 0x06DAB, 0x06DAE, 0x06DB4, 0x06DC2, 0x06D34, 0x06DC8, 0x06DCE, 0x06DCF,
+	0x06DD0, 0x06DDF, 0x06DE9, 0x06DF6, 0x06E36, 0x06E1E, 0x06E22, 0x06E27, 0x03D11, 0x06E32, 0x06E3C, 0x06E48,
+	0x06E49, 0x06E4B, 0x06E4C, 0x06E4F, 0x06E51, 0x06E53, 0x06E54, 0x06E57, 0x06E63, 0x03D1E,
+	// row 79
+	0x06E93, 0x06EA7, 0x06EB4, 0x06EBF, 0x06EC3, 0x06ECA, 0x06ED9, 0x06F35, 0x06EEB, 0x06EF9, 0x06EFB, 0x06F0A,
+	0x06F0C, 0x06F18, 0x06F25, 0x06F36, 0x06F3C, 0x23F7E, 0x06F52, 0x06F57, 0x06F5A, 0x06F60, 0x06F68, 0x06F98,
+	0x06F7D, 0x06F90, 0x06F96, 0x06FBE, 0x06F9F, 0x06FA5, 0x06FAF, 0x03D64, 0x06FB5, 0x06FC8, 0x06FC9, 0x06FDA,
+	0x06FDE, 0x06FE9, 0x24096, 0x06FFC, 0x07000, 0x07007, 0x0700A, 0x07023, 0x24103, 0x07039, 0x0703A, 0x0703C,
+	0x07043, 0x07047, 0x0704B, 0x03D9A, 0x07054, 0x07065, 0x07069, 0x0706C, 0x0706E, 0x07076, 0x0707E, 0x07081,
+	0x07086, 0x07095, 0x07097, 0x070BB, 0x241C6, 0x0709F, 0x070B1, 0x241FE, 0x070EC, 0x070CA, 0x070D1, 0x070D3,
+	0x070DC, 0x07103, 0x07104, 0x07106, 0x07107, 0x07108, 0x0710C, 0x03DC0, 0x0712F, 0x07131, 0x07150, 0x0714A,
+	0x07153, 0x0715E, 0x03DD4, 0x07196, 0x07180, 0x0719B, 0x071A0, 0x071A2, 0x071AE, 0x071AF,
+	// row 80
+	0x071B3, 0x243BC, 0x071CB, 0x071D3, 0x071D9, 0x071DC, 0x07207, 0x03E05, 0x0FA49, 0x0722B, 0x07234, 0x07238,
+	0x07239, 0x04E2C, 0x07242, 0x07253, 0x07257, 0x07263, 0x24629, 0x0726E, 0x0726F, 0x07278, 0x0727F, 0x0728E,
+	0x246A5, 0x072AD, 0x072AE, 0x072B0, 0x072B1, 0x072C1, 0x03E60, 0x072CC, 0x03E66, 0x03E68, 0x072F3, 0x072FA,
+	0x07307, 0x07312, 0x07318, 0x07319, 0x03E83, 0x07339, 0x0732C, 0x07331, 0x07333, 0x0733D, 0x07352, 0x03E94,
+	0x0736B, 0x0736C, 0x24896, 0x0736E, 0x0736F, 0x07371, 0x07377, 0x07381, 0x07385, 0x0738A, 0x07394, 0x07398,
+	0x0739C, 0x0739E, 0x073A5, 0x073A8, 0x073B5, 0x073B7, 0x073B9, 0x073BC, 0x073BF, 0x073C5, 0x073CB, 0x073E1,
+	0x073E7, 0x073F9, 0x07413, 0x073FA, 0x07401, 0x07424, 0x07431, 0x07439, 0x07453, 0x07440, 0x07443, 0x0744D,
+	0x07452, 0x0745D, 0x07471, 0x07481, 0x07485, 0x07488, 0x24A4D, 0x07492, 0x07497, 0x07499,
+	// row 81
+	0x074A0, 0x074A1, 0x074A5, 0x074AA, 0x074AB, 0x074B9, 0x074BB, 0x074BA, 0x074D6, 0x074D8, 0x074DE, 0x074EF,
+	0x074EB, 0x24B56, 0x074FA, 0x24B6F, 0x07520, 0x07524, 0x0752A, 0x03F57, 0x24C16, 0x0753D, 0x0753E, 0x07540,
+	0x07548, 0x0754E, 0x07550, 0x07552, 0x0756C, 0x07572, 0x07571, 0x0757A, 0x0757D, 0x0757E, 0x07581, 0x24D14,
+	0x0758C, 0x03F75, 0x075A2, 0x03F77, 0x075B0, 0x075B7, 0x075BF, 0x075C0, 0x075C6, 0x075CF, 0x075D3, 0x075DD,
+	0x075DF, 0x075E0, 0x075E7, 0x075EC, 0x075EE, 0x075F1, 0x075F9, 0x07603, 0x07618, 0x07607, 0x0760F, 0x03FAE,
+	0x24E0E, 0x07613, 0x0761B, 0x0761C, 0x24E37, 0x07625, 0x07628, 0x0763C, 0x07633, 0x24E6A, 0x03FC9, 0x07641,
+	0x24E8B, 0x07649, 0x07655, 0x03FD7, 0x0766E, 0x07695, 0x0769C, 0x076A1, 0x076A0, 0x076A7, 0x076A8, 0x076AF,
+	0x2504A, 0x076C9, 0x25055, 0x076E8, 0x076EC, 0x25122, 0x07717, 0x0771A, 0x0772D, 0x07735,
+	// row 82
+	0x251A9, 0x04039, 0x251E5, 0x251CD, 0x07758, 0x07760, 0x0776A, 0x2521E, 0x07772, 0x0777C, 0x0777D, 0x2524C,
+	0x04058, 0x0779A, 0x0779F, 0x077A2, 0x077A4, 0x077A9, 0x077DE, 0x077DF, 0x077E4, 0x077E6, 0x077EA, 0x077EC,
+	0x04093, 0x077F0, 0x077F4, 0x077FB, 0x2542E, 0x07805, 0x07806, 0x07809, 0x0780D, 0x07819, 0x07821, 0x0782C,
+	0x07847, 0x07864, 0x0786A, 0x254D9, 0x0788A, 0x07894, 0x078A4, 0x0789D, 0x0789E, 0x0789F, 0x078BB, 0x078C8,
+	0x078CC, 0x078CE, 0x078D5, 0x078E0, 0x078E1, 0x078E6, 0x078F9, 0x078FA, 0x078FB, 0x078FE, 0x255A7, 0x07910,
+	0x0791B, 0x07930, 0x07925, 0x0793B, 0x0794A, 0x07958, 0x0795B, 0x04105, 0x07967, 0x07972, 0x07994, 0x07995,
+	0x07996, 0x0799B, 0x079A1, 0x079A9, 0x079B4, 0x079BB, 0x079C2, 0x079C7, 0x079CC, 0x079CD, 0x079D6, 0x04148,
+	0x257A9, 0x257B4, 0x0414F, 0x07A0A, 0x07A11, 0x07A15, 0x07A1B, 0x07A1E, 0x04163, 0x07A2D,
+	// row 83
+	0x07A38, 0x07A47, 0x07A4C, 0x07A56, 0x07A59, 0x07A5C, 0x07A5F, 0x07A60, 0x07A67, 0x07A6A, 0x07A75, 0x07A78,
+	0x07A82, 0x07A8A, 0x07A90, 0x07AA3, 0x07AAC, 0x259D4, 0x041B4, 0x07AB9, 0x07ABC, 0x07ABE, 0x041BF, 0x07ACC,
+	0x07AD1, 0x07AE7, 0x07AE8, 0x07AF4, 0x25AE4, 0x25AE3, 0x07B07, 0x25AF1, 0x07B3D, 0x07B27, 0x07B2A, 0x07B2E,
+	0x07B2F, 0x07B31, 0x041E6, 0x041F3, 0x07B7F, 0x07B41, 0x041EE, 0x07B55, 0x07B79, 0x07B64, 0x07B66, 0x07B69,
+	0x07B73, 0x25BB2, 0x04207, 0x07B90, 0x07B91, 0x07B9B, 0x0420E, 0x07BAF, 0x07BB5, 0x07BBC, 0x07BC5, 0x07BCA,
+	0x25C4B, 0x25C64, 0x07BD4, 0x07BD6, 0x07BDA, 0x07BEA, 0x07BF0, 0x07C03, 0x07C0B, 0x07C0E, 0x07C0F, 0x07C26,
+	0x07C45, 0x07C4A, 0x07C51, 0x07C57, 0x07C5E, 0x07C61, 0x07C69, 0x07C6E, 0x07C6F, 0x07C70, 0x25E2E, 0x25E56,
+	0x25E65, 0x07CA6, 0x25E62, 0x07CB6, 0x07CB7, 0x07CBF, 0x25ED8, 0x07CC4, 0x25EC2, 0x07CC8,
+	// row 84
+	0x07CCD, 0x25EE8, 0x07CD7, 0x25F23, 0x07CE6, 0x07CEB, 0x25F5C, 0x07CF5, 0x07D03, 0x07D09, 0x042C6, 0x07D12,
+	0x07D1E, 0x25FE0, 0x25FD4, 0x07D3D, 0x07D3E, 0x07D40, 0x07D47, 0x2600C, 0x25FFB, 0x042D6, 0x07D59, 0x07D5A,
+	0x07D6A, 0x07D70, 0x042DD, 0x07D7F, 0x26017, 0x07D86, 0x07D88, 0x07D8C, 0x07D97, 0x26060, 0x07D9D, 0x07DA7,
+	0x07DAA, 0x07DB6, 0x07DB7, 0x07DC0, 0x07DD7, 0x07DD9, 0x07DE6, 0x07DF1, 0x07DF9, 0x04302, 0x260ED, 0x0FA58,
+	0x07E10, 0x07E17, 0x07E1D, 0x07E20, 0x07E27, 0x07E2C, 0x07E45, 0x07E73, 0x07E75, 0x07E7E, 0x07E86, 0x07E87,
+	0x0432B, 0x07E91, 0x07E98, 0x07E9A, 0x04343, 0x07F3C, 0x07F3B, 0x07F3E, 0x07F43, 0x07F44, 0x07F4F, 0x034C1,
+	0x26270, 0x07F52, 0x26286, 0x07F61, 0x07F63, 0x07F64, 0x07F6D, 0x07F7D, 0x07F7E, 0x2634C, 0x07F90, 0x0517B,
+	0x23D0E, 0x07F96, 0x07F9C, 0x07FAD, 0x26402, 0x07FC3, 0x07FCF, 0x07FE3, 0x07FE5, 0x07FEF,
+	// row 85
+	0x07FF2, 0x08002, 0x0800A, 0x08008, 0x0800E, 0x08011, 0x08016, 0x08024, 0x0802C, 0x08030, 0x08043, 0x08066,
+	0x08071, 0x08075, 0x0807B, 0x08099, 0x0809C, 0x080A4, 0x080A7, 0x080B8, 0x2667E, 0x080C5, 0x080D5, 0x080D8,
+	0x080E6, 0x266B0, 0x0810D, 0x080F5, 0x080FB, 0x043EE, 0x08135, 0x08116, 0x0811E, 0x043F0, 0x08124, 0x08127,
+	0x0812C, 0x2671D, 0x0813D, 0x04408, 0x08169, 0x04417, 0x08181, 0x0441C, 0x08184, 0x08185, 0x04422, 0x08198,
+	0x081B2, 0x081C1, 0x081C3, 0x081D6, 0x081DB, 0x268DD, 0x081E4, 0x268EA, 0x081EC, 0x26951, 0x081FD, 0x081FF,
+	0x2696F, 0x08204, 0x269DD, 0x08219, 0x08221, 0x08222, 0x26A1E, 0x08232, 0x08234, 0x0823C, 0x08246, 0x08249,
+	0x08245, 0x26A58, 0x0824B, 0x04476, 0x0824F, 0x0447A, 0x08257, 0x26A8C, 0x0825C, 0x08263, 0x26AB7, 0x0FA5D,
+	0x0FA5E, 0x08279, 0x04491, 0x0827D, 0x0827F, 0x08283, 0x0828A, 0x08293, 0x082A7, 0x082A8,
+	// row 86
+	0x082B2, 0x082B4, 0x082BA, 0x082BC, 0x082E2, 0x082E8, 0x082F7, 0x08307, 0x08308, 0x0830C, 0x08354, 0x0831B,
+	0x0831D, 0x08330, 0x0833C, 0x08344, 0x08357, 0x044BE, 0x0837F, 0x044D4, 0x044B3, 0x0838D, 0x08394, 0x08395,
+	0x0839B, 0x0839D, 0x083C9, 0x083D0, 0x083D4, 0x083DD, 0x083E5, 0x083F9, 0x0840F, 0x08411, 0x08415, 0x26C73,
+	0x08417, 0x08439, 0x0844A, 0x0844F, 0x08451, 0x08452, 0x08459, 0x0845A, 0x0845C, 0x26CDD, 0x08465, 0x08476,
+	0x08478, 0x0847C, 0x08481, 0x0450D, 0x084DC, 0x08497, 0x084A6, 0x084BE, 0x04508, 0x084CE, 0x084CF, 0x084D3,
+	0x26E65, 0x084E7, 0x084EA, 0x084EF, 0x084F0, 0x084F1, 0x084FA, 0x084FD, 0x0850C, 0x0851B, 0x08524, 0x08525,
+	0x0852B, 0x08534, 0x0854F, 0x0856F, 0x04525, 0x04543, 0x0853E, 0x08551, 0x08553, 0x0855E, 0x08561, 0x08562,
+	0x26F94, 0x0857B, 0x0857D, 0x0857F, 0x08581, 0x08586, 0x08593, 0x0859D, 0x0859F, 0x26FF8,
+	// row 87
+	0x26FF6, 0x26FF7, 0x085B7, 0x085BC, 0x085C7, 0x085CA, 0x085D8, 0x085D9, 0x085DF, 0x085E1, 0x085E6, 0x085F6,
+	0x08600, 0x08611, 0x0861E, 0x08621, 0x08624, 0x08627, 0x2710D, 0x08639, 0x0863C, 0x27139, 0x08640, 0x0FA20,
+	0x08653, 0x08656, 0x0866F, 0x08677, 0x0867A, 0x08687, 0x08689, 0x0868D, 0x08691, 0x0869C, 0x0869D, 0x086A8,
+	0x0FA21, 0x086B1, 0x086B3, 0x086C1, 0x086C3, 0x086D1, 0x086D5, 0x086D7, 0x086E3, 0x086E6, 0x045B8, 0x08705,
+	0x08707, 0x0870E, 0x08710, 0x08713, 0x08719, 0x0871F, 0x08721, 0x08723, 0x08731, 0x0873A, 0x0873E, 0x08740,
+	0x08743, 0x08751, 0x08758, 0x08764, 0x08765, 0x08772, 0x0877C, 0x273DB, 0x273DA, 0x087A7, 0x08789, 0x0878B,
+	0x08793, 0x087A0, 0x273FE, 0x045E5, 0x087BE, 0x27410, 0x087C1, 0x087CE, 0x087F5, 0x087DF, 0x27449, 0x087E3,
+	0x087E5, 0x087E6, 0x087EA, 0x087EB, 0x087ED, 0x08801, 0x08803, 0x0880B, 0x08813, 0x08828,
+	// row 88
+	0x0882E, 0x08832, 0x0883C, 0x0460F, 0x0884A, 0x08858, 0x0885F, 0x08864, 0x27615, 0x27614, 0x08869, 0x27631,
+	0x0886F, 0x088A0, 0x088BC, 0x088BD, 0x088BE, 0x088C0, 0x088D2, 0x27693, 0x088D1, 0x088D3, 0x088DB, 0x088F0,
+	0x088F1, 0x04641, 0x08901, 0x2770E, 0x08937, 0x27723, 0x08942, 0x08945, 0x08949, 0x27752, 0x04665, 0x08962,
+	0x08980, 0x08989, 0x08990, 0x0899F, 0x089B0, 0x089B7, 0x089D6, 0x089D8, 0x089EB, 0x046A1, 0x089F1, 0x089F3,
+	0x089FD, 0x089FF, 0x046AF, 0x08A11, 0x08A14, 0x27985, 0x08A21, 0x08A35, 0x08A3E, 0x08A45, 0x08A4D, 0x08A58,
+	0x08AAE, 0x08A90, 0x08AB7, 0x08ABE, 0x08AD7, 0x08AFC, 0x27A84, 0x08B0A, 0x08B05, 0x08B0D, 0x08B1C, 0x08B1F,
+	0x08B2D, 0x08B43, 0x0470C, 0x08B51, 0x08B5E, 0x08B76, 0x08B7F, 0x08B81, 0x08B8B, 0x08B94, 0x08B95, 0x08B9C,
+	0x08B9E, 0x08C39, 0x27BB3, 0x08C3D, 0x27BBE, 0x27BC7, 0x08C45, 0x08C47, 0x08C4F, 0x08C54,
+	// row 89
+	0x08C57, 0x08C69, 0x08C6D, 0x08C73, 0x27CB8, 0x08C93, 0x08C92, 0x08C99, 0x04764, 0x08C9B, 0x08CA4, 0x08CD6,
+	0x08CD5, 0x08CD9, 0x27DA0, 0x08CF0, 0x08CF1, 0x27E10, 0x08D09, 0x08D0E, 0x08D6C, 0x08D84, 0x08D95, 0x08DA6,
+	0x27FB7, 0x08DC6, 0x08DC8, 0x08DD9, 0x08DEC, 0x08E0C, 0x047FD, 0x08DFD, 0x08E06, 0x2808A, 0x08E14, 0x08E16,
+	0x08E21, 0x08E22, 0x08E27, 0x280BB, 0x04816, 0x08E36, 0x08E39, 0x08E4B, 0x08E54, 0x08E62, 0x08E6C, 0x08E6D,
+	0x08E6F, 0x08E98, 0x08E9E, 0x08EAE, 0x08EB3, 0x08EB5, 0x08EB6, 0x08EBB, 0x28282, 0x08ED1, 0x08ED4, 0x0484E,
+	0x08EF9, 0x282F3, 0x08F00, 0x08F08, 0x08F17, 0x08F2B, 0x08F40, 0x08F4A, 0x08F58, 0x2840C, 0x08FA4, 0x08FB4,
+	0x0FA66, 0x08FB6, 0x28455, 0x08FC1, 0x08FC6, 0x0FA24, 0x08FCA, 0x08FCD, 0x08FD3, 0x08FD5, 0x08FE0, 0x08FF1,
+	0x08FF5, 0x08FFB, 0x09002, 0x0900C, 0x09037, 0x2856B, 0x09043, 0x09044, 0x0905D, 0x285C8,
+	// row 90
+	0x285C9, 0x09085, 0x0908C, 0x09090, 0x0961D, 0x090A1, 0x048B5, 0x090B0, 0x090B6, 0x090C3, 0x090C8, 0x286D7,
+	0x090DC, 0x090DF, 0x286FA, 0x090F6, 0x090F2, 0x09100, 0x090EB, 0x090FE, 0x090FF, 0x09104, 0x09106, 0x09118,
+	0x0911C, 0x0911E, 0x09137, 0x09139, 0x0913A, 0x09146, 0x09147, 0x09157, 0x09159, 0x09161, 0x09164, 0x09174,
+	0x09179, 0x09185, 0x0918E, 0x091A8, 0x091AE, 0x091B3, 0x091B6, 0x091C3, 0x091C4, 0x091DA, 0x28949, 0x28946,
+	0x091EC, 0x091EE, 0x09201, 0x0920A, 0x09216, 0x09217, 0x2896B, 0x09233, 0x09242, 0x09247, 0x0924A, 0x0924E,
+	0x09251, 0x09256, 0x09259, 0x09260, 0x09261, 0x09265, 0x09267, 0x09268, 0x28987, 0x28988, 0x0927C, 0x0927D,
+	0x0927F, 0x09289, 0x0928D, 0x09297, 0x09299, 0x0929F, 0x092A7, 0x092AB, 0x289BA, 0x289BB, 0x092B2, 0x092BF,
+	0x092C0, 0x092C6, 0x092CE, 0x092D0, 0x092D7, 0x092D9, 0x092E5, 0x092E7, 0x09311, 0x28A1E,
+	// row 91
+	0x28A29, 0x092F7, 0x092F9, 0x092FB, 0x09302, 0x0930D, 0x09315, 0x0931D, 0x0931E, 0x09327, 0x09329, 0x28A71,
+	0x28A43, 0x09347, 0x09351, 0x09357, 0x0935A, 0x0936B, 0x09371, 0x09373, 0x093A1, 0x28A99, 0x28ACD, 0x09388,
+	0x0938B, 0x0938F, 0x0939E, 0x093F5, 0x28AE4, 0x28ADD, 0x093F1, 0x093C1, 0x093C7, 0x093DC, 0x093E2, 0x093E7,
+	0x09409, 0x0940F, 0x09416, 0x09417, 0x093FB, 0x09432, 0x09434, 0x0943B, 0x09445, 0x28BC1, 0x28BEF, 0x0946D,
+	0x0946F, 0x09578, 0x09579, 0x09586, 0x0958C, 0x0958D, 0x28D10, 0x095AB, 0x095B4, 0x28D71, 0x095C8, 0x28DFB,
+	0x28E1F, 0x0962C, 0x09633, 0x09634, 0x28E36, 0x0963C, 0x09641, 0x09661, 0x28E89, 0x09682, 0x28EEB, 0x0969A,
+	0x28F32, 0x049E7, 0x096A9, 0x096AF, 0x096B3, 0x096BA, 0x096BD, 0x049FA, 0x28FF8, 0x096D8, 0x096DA, 0x096DD,
+	0x04A04, 0x09714, 0x09723, 0x04A29, 0x09736, 0x09741, 0x09747, 0x09755, 0x09757, 0x0975B,
+	// row 92
+	0x0976A, 0x292A0, 0x292B1, 0x09796, 0x0979A, 0x0979E, 0x097A2, 0x097B1, 0x097B2, 0x097BE, 0x097CC, 0x097D1,
+	0x097D4, 0x097D8, 0x097D9, 0x097E1, 0x097F1, 0x09804, 0x0980D, 0x0980E, 0x09814, 0x09816, 0x04ABC, 0x29490,
+	0x09823, 0x09832, 0x09833, 0x09825, 0x09847, 0x09866, 0x098AB, 0x098AD, 0x098B0, 0x295CF, 0x098B7, 0x098B8,
+	0x098BB, 0x098BC, 0x098BF, 0x098C2, 0x098C7, 0x098CB, 0x098E0, 0x2967F, 0x098E1, 0x098E3, 0x098E5, 0x098EA,
+	0x098F0, 0x098F1, 0x098F3, 0x09908, 0x04B3B, 0x296F0, 0x09916, 0x09917, 0x29719, 0x0991A, 0x0991B, 0x0991C,
+	0x29750, 0x09931, 0x09932, 0x09933, 0x0993A, 0x0993B, 0x0993C, 0x09940, 0x09941, 0x09946, 0x0994D, 0x0994E,
+	0x0995C, 0x0995F, 0x09960, 0x099A3, 0x099A6, 0x099B9, 0x099BD, 0x099BF, 0x099C3, 0x099C9, 0x099D4, 0x099D9,
+	0x099DE, 0x298C6, 0x099F0, 0x099F9, 0x099FC, 0x09A0A, 0x09A11, 0x09A16, 0x09A1A, 0x09A20,
+	// row 93
+	0x09A31, 0x09A36, 0x09A44, 0x09A4C, 0x09A58, 0x04BC2, 0x09AAF, 0x04BCA, 0x09AB7, 0x04BD2, 0x09AB9, 0x29A72,
+	0x09AC6, 0x09AD0, 0x09AD2, 0x09AD5, 0x04BE8, 0x09ADC, 0x09AE0, 0x09AE5, 0x09AE9, 0x09B03, 0x09B0C, 0x09B10,
+	0x09B12, 0x09B16, 0x09B1C, 0x09B2B, 0x09B33, 0x09B3D, 0x04C20, 0x09B4B, 0x09B63, 0x09B65, 0x09B6B, 0x09B6C,
+	0x09B73, 0x09B76, 0x09B77, 0x09BA6, 0x09BAC, 0x09BB1, 0x29DDB, 0x29E3D, 0x09BB2, 0x09BB8, 0x09BBE, 0x09BC7,
+	0x09BF3, 0x09BD8, 0x09BDD, 0x09BE7, 0x09BEA, 0x09BEB, 0x09BEF, 0x09BEE, 0x29E15, 0x09BFA, 0x29E8A, 0x09BF7,
+	0x29E49, 0x09C16, 0x09C18, 0x09C19, 0x09C1A, 0x09C1D, 0x09C22, 0x09C27, 0x09C29, 0x09C2A, 0x29EC4, 0x09C31,
+	0x09C36, 0x09C37, 0x09C45, 0x09C5C, 0x29EE9, 0x09C49, 0x09C4A, 0x29EDB, 0x09C54, 0x09C58, 0x09C5B, 0x09C5D,
+	0x09C5F, 0x09C69, 0x09C6A, 0x09C6B, 0x09C6D, 0x09C6E, 0x09C70, 0x09C72, 0x09C75, 0x09C7A,
+	// row 94
+	0x09CE6, 0x09CF2, 0x09D0B, 0x09D02, 0x29FCE, 0x09D11, 0x09D17, 0x09D18, 0x2A02F, 0x04CC4, 0x2A01A, 0x09D32,
+	0x04CD1, 0x09D42, 0x09D4A, 0x09D5F, 0x09D62, 0x2A0F9, 0x09D69, 0x09D6B, 0x2A082, 0x09D73, 0x09D76, 0x09D77,
+	0x09D7E, 0x09D84, 0x09D8D, 0x09D99, 0x09DA1, 0x09DBF, 0x09DB5, 0x09DB9, 0x09DBD, 0x09DC3, 0x09DC7, 0x09DC9,
+	0x09DD6, 0x09DDA, 0x09DDF, 0x09DE0, 0x09DE3, 0x09DF4, 0x04D07, 0x09E0A, 0x09E02, 0x09E0D, 0x09E19, 0x09E1C,
+	0x09E1D, 0x09E7B, 0x22218, 0x09E80, 0x09E85, 0x09E9B, 0x09EA8, 0x2A38C, 0x09EBD, 0x2A437, 0x09EDF, 0x09EE7,
+	0x09EEE, 0x09EFF, 0x09F02, 0x04D77, 0x09F03, 0x09F17, 0x09F19, 0x09F2F, 0x09F37, 0x09F3A, 0x09F3D, 0x09F41,
+	0x09F45, 0x09F46, 0x09F53, 0x09F55, 0x09F58, 0x2A5F1, 0x09F5D, 0x2A602, 0x09F69, 0x2A61A, 0x09F6D, 0x09F70,
+	0x09F75, 0x2A6B2, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000, 0x00000,
+}
+
+// jisX0213Pairs lists the plane 1 codes, as row << 8 | cell, that map to a
+// base character followed by a combining character.
+var jisX0213Pairs = map[uint16][2]rune {
+	0x0457: {0x304B, 0x309A},
+	0x0458: {0x304D, 0x309A},
+	0x0459: {0x304F, 0x309A},
+	0x045A: {0x3051, 0x309A},
+	0x045B: {0x3053, 0x309A},
+	0x0557: {0x30AB, 0x309A},
+	0x0558: {0x30AD, 0x309A},
+	0x0559: {0x30AF, 0x309A},
+	0x055A: {0x30B1, 0x309A},
+	0x055B: {0x30B3, 0x309A},
+	0x055C: {0x30BB, 0x309A},
+	0x055D: {0x30C4, 0x309A},
+	0x055E: {0x30C8, 0x309A},
+	0x0658: {0x31F7, 0x309A},
+	0x0B24: {0x00E6, 0x0300},
+	0x0B28: {0x0254, 0x0300},
+	0x0B29: {0x0254, 0x0301},
+	0x0B2A: {0x028C, 0x0300},
+	0x0B2B: {0x028C, 0x0301},
+	0x0B2C: {0x0259, 0x0300},
+	0x0B2D: {0x0259, 0x0301},
+	0x0B2E: {0x025A, 0x0300},
+	0x0B2F: {0x025A, 0x0301},
+	0x0B45: {0x02E9, 0x02E5},
+	0x0B46: {0x02E5, 0x02E9},
+}
+
+// jisX0213Additions2004 lists the plane 1 codes, as row << 8 | cell, that were
+// added in JIS X 0213:2004.
+var jisX0213Additions2004 = [...]uint16 {
+	0x0E01, 0x0F5E, 0x2F34, 0x2F5E, 0x5407, 0x5E5A, 0x5E5B, 0x5E5C, 0x5E5D, 0x5E5E,
+}
@@ -0,0 +1,58 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestJISX0213RoundTrip(t *testing.T) {
+	checkRoundTrip(t, "Shift_JIS-2004", []byte("a\x93\xFA\x96{\xB1"), "a日本ｱ")
+	checkRoundTrip(t, "Shift_JIS-2004", []byte("\x87\xA0\x87\x9F\x85\x40"), "𠀋俱€")
+	checkRoundTrip(t, "Shift_JIS-2004", []byte("\xF0\x40"), "𠂉")
+	checkRoundTrip(t, "EUC-JIS-2004", []byte("a\xC6\xFC\xCB\xDC\x8E\xB1"), "a日本ｱ")
+	checkRoundTrip(t, "EUC-JIS-2004", []byte("\xAE\xA2\xAE\xA1\xA9\xA1"), "𠀋俱€")
+	checkRoundTrip(t, "EUC-JIS-2004", []byte("\x8F\xA1\xA1"), "𠂉")
+	checkRoundTrip(t, "ISO-2022-JP-2004", []byte("\x1B$(Q.\"\x1B(B"), "𠀋")
+	checkRoundTrip(t, "ISO-2022-JP-2004", []byte("\x1B$(Q.!)!\x1B(B"), "俱€")
+	checkRoundTrip(t, "ISO-2022-JP-2004", []byte("a\x1B$BF|K\\\x1B(I1\x1B(B"), "a日本ｱ")
+}
+
+func TestJISX0213Combining(t *testing.T) {
+	// a base character is held back until the next one shows whether the
+	// two make up one code, also when they arrive in separate calls
+	checkRoundTrip(t, "Shift_JIS-2004", []byte("\x82\xF5\x82\xA9"), "か゚か")
+	checkRoundTrip(t, "EUC-JIS-2004", []byte("\xA4\xAB\xA4\xF7"), "かか゚")
+	checkRoundTrip(t, "ISO-2022-JP-2004", []byte("\x1B$(Q$w$+\x1B(B"), "か゚か")
+	// and flushed at the end of input
+	checkRoundTrip(t, "Shift_JIS-2004", []byte("\x82\xA9"), "か")
+}
+
+func TestJISX0213Roman(t *testing.T) {
+	// 0x5C and 0x7E are JIS X 0201 Roman, so REVERSE SOLIDUS and TILDE
+	// take plane 1 codes instead
+	checkRoundTrip(t, "Shift_JIS-2004", []byte("\\~"), "¥‾")
+	checkRoundTrip(t, "Shift_JIS-2004", []byte("\x81\x5F\x81\xB0a"), "\\~a")
+	// which leaves their fullwidth forms out
+	checkEncode(t, "Shift_JIS-2004", "\uFF3C\uFF5E", []byte("\x00\x00"), 0, 1)
+	checkRoundTrip(t, "EUC-JIS-2004", []byte("\\~"), "\\~")
+	checkEncode(t, "EUC-JIS-2004", "¥‾", []byte("\x00\x00"), 0, 1)
+	checkEncode(t, "ISO-2022-JP-2004", "\\¥", []byte("\\\x1B(J\\\x1B(B"))
+}
+
+func TestJISX0213Versions(t *testing.T) {
+	// the characters added in 2004 are only in ISO-2022-JP-2004, but both
+	// decode them
+	checkDecode(t, "ISO-2022-JP-2004", []byte("\x1B$(O.!\x1B(B"), "俱")
+	checkDecode(t, "ISO-2022-JP-3", []byte("\x1B$(O.!\x1B(B"), "俱")
+	checkEncode(t, "ISO-2022-JP-3", "俱", []byte("\x00"), 0)
+}
+
+func TestJISX0213Errors(t *testing.T) {
+	checkDecode(t, "Shift_JIS-2004", []byte("a\x82"), "a�", 1)
+	checkDecode(t, "Shift_JIS-2004", []byte("a\xA0"), "a�", 1)
+	checkDecode(t, "Shift_JIS-2004", []byte("a\xFC\xFC"), "a�", 1)
+	checkDecode(t, "EUC-JIS-2004", []byte("a\x8F\xA1"), "a�", 1)
+	checkDecode(t, "EUC-JIS-2004", []byte("a\xA4A"), "a�A", 1)
+	checkDecode(t, "EUC-JIS-2004", []byte("a\x8F\xA2\xA1"), "a�", 1)
+	checkEncode(t, "Shift_JIS-2004", "か😀", []byte("\x82\xA9\x00"), 1)
+	checkEncode(t, "EUC-JIS-2004", "😀", []byte("\x00"), 0)
+}
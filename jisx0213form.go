@@ -0,0 +1,273 @@
+package gotextenc
+
+import (
+	"sync"
+)
+
+// JISX0213Form selects the encoding scheme in which JISX0213Decoder and
+// JISX0213Encoder represent JIS X 0213:2004.
+type JISX0213Form uint8
+
+const (
+	// Shift_JIS-2004: JIS X 0201 Roman and katakana as single bytes, plane 1
+	// as Shift_JIS and plane 2 behind lead bytes 0xF0..0xFC
+	JISX0213FORM_SHIFT_JIS JISX0213Form = iota
+	// EUC-JIS-2004: ASCII, JIS X 0201 katakana after SS2, plane 1 as two
+	// bytes and plane 2 after SS3
+	JISX0213FORM_EUC
+	jisx0213form_COUNT
+)
+
+const (
+	shiftJIS2004_FIRST_PLANE2_LEAD byte = 0xF0
+	shiftJIS2004_LAST_PLANE2_LEAD byte = 0xFC
+)
+
+// shiftJIS2004ASCIICodes are the plane 1 codes that stand for REVERSE SOLIDUS
+// and TILDE in Shift_JIS-2004, as 0x5C and 0x7E are JIS X 0201 Roman there.
+// They are FULLWIDTH REVERSE SOLIDUS and FULLWIDTH TILDE in EUC-JIS-2004.
+var shiftJIS2004ASCIICodes = map[uint32]rune {
+	0x815F: 0x005C,
+	0x81B0: 0x007E,
+}
+
+// shiftJIS2004Plane2Rows lists the plane 2 rows coded in the first and second
+// half of the trail bytes after each lead byte from 0xF0.
+var shiftJIS2004Plane2Rows = [13][2]int {
+	{1, 8}, {3, 4}, {5, 12}, {13, 14}, {15, 78}, {79, 80}, {81, 82},
+	{83, 84}, {85, 86}, {87, 88}, {89, 90}, {91, 92}, {93, 94},
+}
+
+func(form JISX0213Form) isLead(b byte) bool {
+	if form == JISX0213FORM_EUC {
+		return b == eucJP_SS2 || b == eucJP_SS3 || isEUCJPByte(b)
+	}
+	return b >= 0x81 && b <= 0x9F || b >= 0xE0 && b <= shiftJIS2004_LAST_PLANE2_LEAD
+}
+
+func(form JISX0213Form) isTrail(b byte) bool {
+	if form == JISX0213FORM_EUC {
+		return isEUCJPByte(b)
+	}
+	_, valid := shiftJISTrailIndex(b)
+	return valid
+}
+
+// sequenceLength tells how many bytes the sequence starting with lead has.
+func(form JISX0213Form) sequenceLength(lead byte) int {
+	if form == JISX0213FORM_EUC && lead == eucJP_SS3 {
+		return 3
+	}
+	return 2
+}
+
+// decodeSingle maps the single byte b, if it stands on its own. In
+// Shift_JIS-2004, 0x5C and 0x7E are YEN SIGN and OVERLINE.
+func(form JISX0213Form) decodeSingle(b byte) (rune, bool) {
+	switch {
+		case form == JISX0213FORM_EUC:
+			// ASCII or C1
+			return rune(b), b < 0xA0 && b != eucJP_SS2 && b != eucJP_SS3
+		case b == 0x5C:
+			return 0x00A5, true
+		case b == 0x7E:
+			return 0x203E, true
+		case b < 0x80:
+			return rune(b), true
+		case b >= 0xA1 && b <= 0xDF:
+			// JIS X 0201 half-width katakana
+			return 0xFF61 + rune(b - 0xA1), true
+		default:
+			return 0, false
+	}
+}
+
+// decodeSequence maps the complete multi-byte sequence to one or two
+// characters.
+func(form JISX0213Form) decodeSequence(sequence []byte) (chars [2]rune, count int) {
+	if form == JISX0213FORM_EUC {
+		switch sequence[0] {
+			case eucJP_SS2:
+				if sequence[1] <= 0xDF {
+					// JIS X 0201 half-width katakana
+					return [2]rune {0xFF61 + rune(sequence[1] - 0xA1)}, 1
+				}
+				return
+			case eucJP_SS3:
+				if char := decodeJISX0213Plane2(int(sequence[1] - 0xA0), int(sequence[2] - 0xA0)); char != 0 {
+					return [2]rune {char}, 1
+				}
+				return
+			default:
+				return decodeJISX0213Plane1(int(sequence[0] - 0xA0), int(sequence[1] - 0xA0))
+		}
+	}
+	lead := sequence[0]
+	if char, found := shiftJIS2004ASCIICodes[uint32(lead) << 8 | uint32(sequence[1])]; found {
+		return [2]rune {char}, 1
+	}
+	trailIndex, _ := shiftJISTrailIndex(sequence[1])
+	if lead < shiftJIS2004_FIRST_PLANE2_LEAD {
+		return decodeJISX0213Plane1(shiftJISToJIS(lead, trailIndex))
+	}
+	row := shiftJIS2004Plane2Rows[lead - shiftJIS2004_FIRST_PLANE2_LEAD][trailIndex / 94]
+	if char := decodeJISX0213Plane2(row, trailIndex % 94 + 1); char != 0 {
+		return [2]rune {char}, 1
+	}
+	return
+}
+
+// encodeCode gives the bytes, in big endian order, coding the row and cell
+// (counting from 1) of the plane.
+func(form JISX0213Form) encodeCode(plane int, row int, cell int) uint32 {
+	if form == JISX0213FORM_EUC {
+		code := uint32(0xA0 + row) << 8 | uint32(0xA0 + cell)
+		if plane == 2 {
+			code |= uint32(eucJP_SS3) << 16
+		}
+		return code
+	}
+	if plane == 1 {
+		return uint32(jisToShiftJIS(row, cell))
+	}
+	for index, rows := range shiftJIS2004Plane2Rows {
+		for half, plane2Row := range rows {
+			if plane2Row == row {
+				lead := uint32(shiftJIS2004_FIRST_PLANE2_LEAD) + uint32(index)
+				// the trail byte as for rows 1 and 2
+				trail := uint32(jisToShiftJIS(half + 1, cell)) & 0xFF
+				return lead << 8 | trail
+			}
+		}
+	}
+	return 0
+}
+
+type jisX0213EncodeMapSet struct {
+	codes map[rune]uint32
+	pairs map[[2]rune]uint32
+	pairBases map[rune]bool
+}
+
+var jisX0213EncodeOnces [jisx0213form_COUNT]sync.Once
+// jisX0213EncodeMaps map characters, and base characters followed by
+// combining characters, to the bytes coding them in big endian order.
+var jisX0213EncodeMaps [jisx0213form_COUNT]jisX0213EncodeMapSet
+
+func(form JISX0213Form) encodeMaps() *jisX0213EncodeMapSet {
+	if form >= jisx0213form_COUNT {
+		form = JISX0213FORM_SHIFT_JIS
+	}
+	jisX0213EncodeOnces[form].Do(func() {
+		maps := jisX0213EncodeMapSet {
+			codes: make(map[rune]uint32),
+			pairs: make(map[[2]rune]uint32),
+			pairBases: make(map[rune]bool),
+		}
+		for row := 1; row <= 94; row++ {
+			for cell := 1; cell <= 94; cell++ {
+				chars, count := decodeJISX0213Plane1(row, cell)
+				code := form.encodeCode(1, row, cell)
+				switch {
+					case count == 2:
+						maps.pairs[chars] = code
+						maps.pairBases[chars[0]] = true
+					case count == 0:
+					default:
+						if _, present := maps.codes[chars[0]]; !present {
+							maps.codes[chars[0]] = code
+						}
+				}
+			}
+		}
+		if form == JISX0213FORM_SHIFT_JIS {
+			for code, char := range shiftJIS2004ASCIICodes {
+				for fullwidth, fullwidthCode := range maps.codes {
+					if fullwidthCode == code {
+						delete(maps.codes, fullwidth)
+					}
+				}
+				maps.codes[char] = code
+			}
+		}
+		for _, row := range jisX0213Plane2Rows {
+			for cell := 1; cell <= 94; cell++ {
+				char := decodeJISX0213Plane2(int(row), cell)
+				if _, present := maps.codes[char]; char != 0 && !present {
+					maps.codes[char] = form.encodeCode(2, int(row), cell)
+				}
+			}
+		}
+		jisX0213EncodeMaps[form] = maps
+	})
+	return &jisX0213EncodeMaps[form]
+}
+
+// encodeSingle finds the single byte representing r. In Shift_JIS-2004,
+// REVERSE SOLIDUS and TILDE are left to encode, as Python does, so that
+// they come back as they were.
+func(form JISX0213Form) encodeSingle(r rune) (byte, bool) {
+	switch {
+		case form == JISX0213FORM_EUC:
+			return byte(r), r < 0xA0 && r != rune(eucJP_SS2) && r != rune(eucJP_SS3)
+		case r == 0x5C || r == 0x7E:
+			return 0, false
+		case r < 0x80:
+			return byte(r), true
+		case r == 0x00A5:
+			return 0x5C, true
+		case r == 0x203E:
+			return 0x7E, true
+		case r >= 0xFF61 && r <= 0xFF9F:
+			return byte(r - 0xFF61 + 0xA1), true
+		default:
+			return 0, false
+	}
+}
+
+// encode finds the bytes, in big endian order, representing r, which must not
+// be representable as a single byte.
+func(form JISX0213Form) encode(r rune) (uint32, bool) {
+	if form == JISX0213FORM_EUC && r >= 0xFF61 && r <= 0xFF9F {
+		return uint32(eucJP_SS2) << 8 | uint32(r - 0xFF61 + 0xA1), true
+	}
+	code, found := form.encodeMaps().codes[r]
+	return code, found
+}
+
+// isPairBase tells whether r may be the first of two characters encoded as a
+// single code.
+func(form JISX0213Form) isPairBase(r rune) bool {
+	return form.encodeMaps().pairBases[r]
+}
+
+// encodePair finds the code representing base followed by combining.
+func(form JISX0213Form) encodePair(base rune, combining rune) (uint32, bool) {
+	code, found := form.encodeMaps().pairs[[2]rune {base, combining}]
+	return code, found
+}
+
+var jisX0213FormNames = [jisx0213form_COUNT][]string {
+	JISX0213FORM_SHIFT_JIS: {"Shift_JIS-2004", "Shift_JISX0213", "SJIS-2004"},
+	JISX0213FORM_EUC: {"EUC-JIS-2004", "EUC-JISX0213"},
+}
+
+func init() {
+	for index, names := range jisX0213FormNames {
+		form := JISX0213Form(index)
+		RegisterCharset(CharsetFactories {
+			Decoder12: func() Codec[byte, uint16] {
+				return &JISX0213Decoder[uint16] {Form: form}
+			},
+			Decoder14: func() Codec[byte, rune] {
+				return &JISX0213Decoder[rune] {Form: form}
+			},
+			Encoder21: func() Codec[uint16, byte] {
+				return &JISX0213Encoder[uint16] {Form: form}
+			},
+			Encoder41: func() Codec[rune, byte] {
+				return &JISX0213Encoder[rune] {Form: form}
+			},
+		}, names...)
+	}
+}
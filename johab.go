@@ -0,0 +1,175 @@
+package gotextenc
+
+import (
+	"sync"
+)
+
+// Johab codes Hangul as 1 followed by three five-bit fields for the initial
+// consonant, vowel and final consonant; the other characters of KS X 1001
+// occupy two rows per lead byte after that.
+const (
+	johab_LAST_HANGUL_LEAD byte = 0xD3
+	johab_USER_DEFINED_LEAD byte = 0xD8
+	johab_FIRST_SYMBOL_LEAD byte = 0xD9
+	johab_LAST_SYMBOL_LEAD byte = 0xDE
+	johab_FIRST_HANJA_LEAD byte = 0xE0
+	johab_LAST_HANJA_LEAD byte = 0xF9
+	// KS X 1001 row of the first hanja
+	johab_FIRST_HANJA_ROW = 42
+	// initial and final consonant field value meaning none
+	johab_CONSONANT_FILL = 1
+	// vowel field value meaning none
+	johab_VOWEL_FILL = 2
+	// the codes with neither initial consonant nor vowel, followed by the
+	// final consonant field
+	johab_FINAL_ONLY_PREFIX uint16 = 0x8440
+	johab_FINAL_ONLY_MASK uint16 = 0xFFE0
+)
+
+// johabVowelFields maps the values of the vowel field to vowel indices plus
+// one; zero entries are unused values.
+var johabVowelFields = [32]byte {
+	0, 0, 0, 1, 2, 3, 4, 5, 0, 0, 6, 7, 8, 9, 10, 11,
+	0, 0, 12, 13, 14, 15, 16, 17, 0, 0, 18, 19, 20, 21, 0, 0,
+}
+
+func isJohabLead(b byte) bool {
+	return b >= 0x84 && b <= johab_LAST_HANGUL_LEAD ||
+			b >= johab_USER_DEFINED_LEAD && b <= johab_LAST_SYMBOL_LEAD ||
+			b >= johab_FIRST_HANJA_LEAD && b <= johab_LAST_HANJA_LEAD
+}
+
+// johabSymbolTrailIndex numbers the trail bytes 0x31..0x7E and 0x91..0xFE
+// used for characters other than Hangul consecutively.
+func johabSymbolTrailIndex(trail byte) (int, bool) {
+	switch {
+		case trail >= 0x31 && trail <= 0x7E:
+			return int(trail - 0x31), true
+		case trail >= 0x91 && trail <= 0xFE:
+			return int(trail - 0x43), true
+		default:
+			return 0, false
+	}
+}
+
+func isJohabTrail(lead byte, trail byte) bool {
+	if lead <= johab_LAST_HANGUL_LEAD {
+		return trail >= 0x41 && trail <= 0x7E || trail >= 0x81 && trail <= 0xFE
+	}
+	_, valid := johabSymbolTrailIndex(trail)
+	return valid
+}
+
+// decodeJohabHangul maps a code in the Hangul area to a syllable or to the
+// compatibility jamo for a lone initial consonant, vowel, or final consonant.
+// The code with all three fields empty is HANGUL FILLER, as in CP1361.
+func decodeJohabHangul(code uint16) rune {
+	initialField := int(code >> 10 & 0x1F)
+	vowelField := int(code >> 5 & 0x1F)
+	finalField := int(code & 0x1F)
+	initial := initialField - 2
+	if initial >= len(hangulInitialJamo) || initialField < johab_CONSONANT_FILL {
+		return 0
+	}
+	vowel := int(johabVowelFields[vowelField]) - 1
+	if vowel < 0 && vowelField != johab_VOWEL_FILL {
+		return 0
+	}
+	var final int
+	switch {
+		case finalField == johab_CONSONANT_FILL:
+			final = -1
+		case finalField >= 2 && finalField <= 17:
+			final = finalField - 2
+		case finalField >= 19 && finalField <= 29:
+			final = finalField - 3
+		default:
+			return 0
+	}
+	switch {
+		case initial >= 0 && vowel >= 0:
+			return hangul_FIRST_SYLLABLE + rune((initial * hangul_VOWEL_COUNT + vowel) * hangul_FINAL_COUNT + final + 1)
+		case final >= 0:
+			if initial >= 0 || vowel >= 0 {
+				return 0
+			}
+			return hangul_FIRST_COMPATIBILITY_JAMO + rune(hangulFinalJamo[final])
+		case initial >= 0:
+			return hangul_FIRST_COMPATIBILITY_JAMO + rune(hangulInitialJamo[initial])
+		case vowel >= 0:
+			return hangul_FIRST_COMPATIBILITY_VOWEL + rune(vowel)
+		case initialField == johab_CONSONANT_FILL && vowelField == johab_VOWEL_FILL:
+			return hangul_FILLER
+		default:
+			return 0
+	}
+}
+
+// decodeJohab maps the double-byte code lead/trail; trail must be valid.
+func decodeJohab(lead byte, trail byte) rune {
+	var row int
+	switch {
+		case lead <= johab_LAST_HANGUL_LEAD:
+			return decodeJohabHangul(uint16(lead) << 8 | uint16(trail))
+		case lead >= johab_FIRST_SYMBOL_LEAD && lead <= johab_LAST_SYMBOL_LEAD:
+			row = int(lead - johab_FIRST_SYMBOL_LEAD) * 2 + 1
+		case lead >= johab_FIRST_HANJA_LEAD:
+			row = int(lead - johab_FIRST_HANJA_LEAD) * 2 + johab_FIRST_HANJA_ROW
+		default:
+			// user-defined
+			return 0
+	}
+	trailIndex, _ := johabSymbolTrailIndex(trail)
+	row += trailIndex / 94
+	cell := trailIndex % 94 + 1
+	if row == 4 && cell < int(ksX1001_JAMO_FILLER - 0xA0) {
+		// the jamo are coded in the Hangul area
+		return 0
+	}
+	return rune(ksX1001[(row - 1) * 94 + cell - 1])
+}
+
+var johabEncodeOnce sync.Once
+var johabEncodeMap map[rune]uint16
+
+func loadJohabEncodeMap() {
+	johabEncodeMap = make(map[rune]uint16)
+	for lead := 0x84; lead <= int(johab_LAST_HANJA_LEAD); lead++ {
+		for trail := 0x31; trail <= 0xFE; trail++ {
+			if !isJohabLead(byte(lead)) || !isJohabTrail(byte(lead), byte(trail)) {
+				continue
+			}
+			char := decodeJohab(byte(lead), byte(trail))
+			if char == 0 {
+				continue
+			}
+			// a lone final consonant is encoded as initial consonant where
+			// possible, and HANGUL FILLER as in KS X 1001
+			if code, present := johabEncodeMap[char]; !present || code & johab_FINAL_ONLY_MASK == johab_FINAL_ONLY_PREFIX {
+				johabEncodeMap[char] = uint16(lead << 8 | trail)
+			}
+		}
+	}
+}
+
+var johabNames = []string {
+	"Johab",
+	"CP1361",
+}
+
+func init() {
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &JohabDecoder[uint16]{}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &JohabDecoder[rune]{}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &JohabEncoder[uint16]{}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &JohabEncoder[rune]{}
+		},
+	}, johabNames...)
+}
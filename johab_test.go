@@ -0,0 +1,26 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestJohabRoundTrip(t *testing.T) {
+	checkRoundTrip(t, "Johab", []byte("\xD0\x65\x8A\x82\xB4\xE1abc"), "한국어abc")
+	// every syllable has a code, as have the compatibility jamo
+	checkRoundTrip(t, "Johab", []byte("\x99\xB1\x88\x41\x88\x61"), "똠ㄱ가")
+	// a lone final consonant has a code too, though one that may be initial
+	// is encoded as such
+	checkDecode(t, "Johab", []byte("\x84\x42\x84\x44\x84\x5D"), "ㄱㄳㅎ")
+	checkEncode(t, "Johab", "ㄱㄳㅎ", []byte("\x88\x41\x84\x44\xD0\x41"))
+	// as has HANGUL FILLER, which is encoded as in KS X 1001
+	checkDecode(t, "Johab", []byte("\x84\x41\xDA\xD4"), "\u3164\u3164")
+	checkEncode(t, "Johab", "\u3164", []byte("\xDA\xD4"))
+	// symbols and hanja from KS X 1001
+	checkRoundTrip(t, "Johab", []byte("\xD9\x31\xE0\x31"), "　伽")
+}
+
+func TestJohabErrors(t *testing.T) {
+	checkDecode(t, "Johab", []byte("\x84\x52\x88\x61\xFF"), "�가�", 0, 4)
+	checkDecode(t, "Johab", []byte("a\x88"), "a�", 1)
+	checkEncode(t, "Johab", "a😀", []byte("a\x00"), 1)
+}
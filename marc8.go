@@ -0,0 +1,1129 @@
+package gotextenc
+
+import (
+	"sync"
+	"unicode"
+)
+
+type MARC8Variant uint8
+
+const (
+	// MARC-8 proper, with escape sequences designating alternate sets
+	MARC8VAR_MARC8 MARC8Variant = iota
+	// plain ANSEL: ASCII in G0 and Extended Latin in G1, no escapes
+	MARC8VAR_ANSEL
+)
+
+type marc8SetID uint8
+
+const (
+	// unknown or unsupported set
+	marc8set_NONE marc8SetID = iota
+	marc8set_BASIC_LATIN
+	marc8set_ANSEL
+	marc8set_BASIC_HEBREW
+	marc8set_BASIC_CYRILLIC
+	marc8set_EXTENDED_CYRILLIC
+	marc8set_BASIC_GREEK
+	marc8set_BASIC_ARABIC
+	marc8set_SUBSCRIPTS
+	marc8set_SUPERSCRIPTS
+	marc8set_GREEK_SYMBOLS
+	marc8set_COUNT
+)
+
+const (
+	marc8_ESC byte = 0x1B
+	marc8_RECORD_TERMINATOR byte = 0x1D
+	marc8_FIELD_TERMINATOR byte = 0x1E
+)
+
+// marc8Set describes one of the graphic sets supported. EACC and Extended
+// Arabic are not among them, as their tables are not available here: their
+// designations are understood, but leave the graphic set undecodable.
+type marc8Set struct {
+	// chars maps 0x21..0x7E (or 0xA1..0xFE)
+	chars *[94]rune
+	// g1 sets are designated as G1 by the encoder, all others as G0
+	g1 bool
+	// technique1 sets are selected by ESC and a single final byte and left
+	// by ESC s
+	technique1 bool
+	// designation is what the encoder emits after ESC
+	designation string
+}
+
+var marc8Sets = [marc8set_COUNT]marc8Set {
+	marc8set_BASIC_LATIN: {
+		designation: "(B",
+	},
+	marc8set_ANSEL: {
+		chars: &marc8ANSEL,
+		g1: true,
+		designation: ")!E",
+	},
+	marc8set_BASIC_HEBREW: {
+		chars: &marc8BasicHebrew,
+		designation: "(2",
+	},
+	marc8set_BASIC_CYRILLIC: {
+		chars: &marc8BasicCyrillic,
+		designation: "(N",
+	},
+	marc8set_EXTENDED_CYRILLIC: {
+		chars: &marc8ExtendedCyrillic,
+		g1: true,
+		designation: ")Q",
+	},
+	marc8set_BASIC_GREEK: {
+		chars: &marc8BasicGreek,
+		designation: "(S",
+	},
+	marc8set_BASIC_ARABIC: {
+		chars: &marc8BasicArabic,
+		designation: "(3",
+	},
+	marc8set_SUBSCRIPTS: {
+		chars: &marc8Subscripts,
+		technique1: true,
+		designation: "b",
+	},
+	marc8set_SUPERSCRIPTS: {
+		chars: &marc8Superscripts,
+		technique1: true,
+		designation: "p",
+	},
+	marc8set_GREEK_SYMBOLS: {
+		chars: &marc8GreekSymbols,
+		technique1: true,
+		designation: "g",
+	},
+}
+
+// char returns the character at code (0x21..0x7E) in set, or 0 if there is
+// none.
+func(set marc8SetID) char(code byte) rune {
+	switch {
+		case set == marc8set_BASIC_LATIN:
+			return rune(code)
+		case set == marc8set_NONE || set >= marc8set_COUNT:
+			return 0
+		default:
+			return marc8Sets[set].chars[code - 0x21]
+	}
+}
+
+// code returns the position of r in set, or 0 if set does not contain r.
+func(set marc8SetID) code(r rune) byte {
+	switch {
+		case set == marc8set_BASIC_LATIN:
+			if r >= 0x21 && r <= 0x7E {
+				return byte(r)
+			}
+			return 0
+		case set == marc8set_NONE || set >= marc8set_COUNT:
+			return 0
+		default:
+			marc8EncodeOnce.Do(loadMARC8Tables)
+			return marc8SetCodes[set][r]
+	}
+}
+
+// parseMARC8Escape interprets the escape sequence escape (without the leading
+// ESC), which ends in a final byte. It returns the graphic set (0 for G0, 1 for
+// G1) and the character set designated to it; the latter is marc8set_NONE if
+// the set is unknown. valid is false if escape is not a designation at all.
+func parseMARC8Escape(escape []byte) (graphicSet int, set marc8SetID, valid bool) {
+	final := escape[len(escape) - 1]
+	intermediates := escape[:len(escape) - 1]
+	multiByte := false
+	if len(intermediates) > 0 && intermediates[0] == '$' {
+		multiByte = true
+		intermediates = intermediates[1:]
+	}
+	switch {
+		case len(intermediates) == 0 && !multiByte:
+			// technique 1
+			switch final {
+				case 's':
+					return 0, marc8set_BASIC_LATIN, true
+				case 'b':
+					return 0, marc8set_SUBSCRIPTS, true
+				case 'p':
+					return 0, marc8set_SUPERSCRIPTS, true
+				case 'g':
+					return 0, marc8set_GREEK_SYMBOLS, true
+				default:
+					return 0, marc8set_NONE, false
+			}
+		case len(intermediates) == 0:
+			// ESC $ F designates G0
+		case intermediates[0] == '(' || intermediates[0] == ',':
+		case intermediates[0] == ')' || intermediates[0] == '-':
+			graphicSet = 1
+		default:
+			return 0, marc8set_NONE, false
+	}
+	valid = true
+	if len(intermediates) > 0 {
+		intermediates = intermediates[1:]
+	}
+	if multiByte {
+		// EACC, or some other unsupported set
+		return
+	}
+	if len(intermediates) == 1 && intermediates[0] == '!' && final == 'E' {
+		set = marc8set_ANSEL
+		return
+	}
+	if len(intermediates) > 0 {
+		return
+	}
+	switch final {
+		case 'B':
+			set = marc8set_BASIC_LATIN
+		case 'E':
+			set = marc8set_ANSEL
+		case '2':
+			set = marc8set_BASIC_HEBREW
+		case 'N':
+			set = marc8set_BASIC_CYRILLIC
+		case 'Q':
+			set = marc8set_EXTENDED_CYRILLIC
+		case 'S':
+			set = marc8set_BASIC_GREEK
+		case '3':
+			set = marc8set_BASIC_ARABIC
+	}
+	return
+}
+
+// control returns the byte representing the control character r, if any.
+// ESC is not included in MARC-8 proper, where it always introduces an escape
+// sequence.
+func(variant MARC8Variant) control(r rune) (byte, bool) {
+	switch {
+		case r == rune(marc8_ESC):
+			return marc8_ESC, variant == MARC8VAR_ANSEL
+		case r < 0x20, r == 0x7F:
+			return byte(r), true
+		case r == 0x0098:
+			// non-sort begin
+			return 0x88, true
+		case r == 0x009C:
+			// non-sort end
+			return 0x89, true
+		case r == 0x200D:
+			return 0x8D, true
+		case r == 0x200C:
+			return 0x8E, true
+		default:
+			return 0, false
+	}
+}
+
+// marc8C1 maps the C1 control bytes 0x80..0x9F.
+func marc8C1(b byte) rune {
+	switch b {
+		case 0x88:
+			return 0x0098
+		case 0x89:
+			return 0x009C
+		case 0x8D:
+			return 0x200D
+		case 0x8E:
+			return 0x200C
+		default:
+			return 0
+	}
+}
+
+// isMARC8Combining reports whether r is a combining character, which MARC-8
+// places before the base character rather than after it.
+func isMARC8Combining(r rune) bool {
+	return r >= 0x0300 && unicode.Is(unicode.Mn, r)
+}
+
+type marc8Decomposition struct {
+	char rune
+	parts [3]rune
+}
+
+var marc8EncodeOnce sync.Once
+// marc8EncodeMap maps characters to their set (high byte) and position (low
+// byte), preferring the sets listed first in marc8Sets.
+var marc8EncodeMap map[rune]uint16
+// marc8SetCodes map the characters of each set to their first position.
+var marc8SetCodes [marc8set_COUNT]map[rune]byte
+var marc8DecompositionMap map[rune][]rune
+
+func loadMARC8Tables() {
+	marc8EncodeMap = make(map[rune]uint16)
+	for set := marc8set_COUNT - 1; set > marc8set_NONE; set-- {
+		codes := make(map[rune]byte)
+		for code := byte(0x7E); code >= 0x21; code-- {
+			if char := set.char(code); char != 0 {
+				codes[char] = code
+				marc8EncodeMap[char] = uint16(set) << 8 | uint16(code)
+			}
+		}
+		marc8SetCodes[set] = codes
+	}
+	marc8DecompositionMap = make(map[rune][]rune)
+	for index := range marc8Decompositions {
+		decomposition := &marc8Decompositions[index]
+		parts := decomposition.parts[:]
+		for parts[len(parts) - 1] == 0 {
+			parts = parts[:len(parts) - 1]
+		}
+		marc8DecompositionMap[decomposition.char] = parts
+	}
+}
+
+var marc8Names = []string {
+	"MARC-8",
+	"MARC8",
+	"MARC",
+}
+
+var anselNames = []string {
+	"ANSEL",
+	"ANSI_Z39.47",
+	"Z39.47",
+}
+
+func init() {
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &MARC8Decoder[uint16]{}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &MARC8Decoder[rune]{}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &MARC8Encoder[uint16]{}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &MARC8Encoder[rune]{}
+		},
+	}, marc8Names...)
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &MARC8Decoder[uint16] {Variant: MARC8VAR_ANSEL}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &MARC8Decoder[rune] {Variant: MARC8VAR_ANSEL}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &MARC8Encoder[uint16] {Variant: MARC8VAR_ANSEL}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &MARC8Encoder[rune] {Variant: MARC8VAR_ANSEL}
+		},
+	}, anselNames...)
+}
+
+// marc8BasicCyrillic is ISO 5427 with DOLLAR SIGN in 0x24.
+var marc8BasicCyrillic = [94]rune {
+	0x0021, 0x0022, 0x0023, 0x0024, 0x0025, 0x0026, 0x0027, 0x0028,
+	0x0029, 0x002A, 0x002B, 0x002C, 0x002D, 0x002E, 0x002F, 0x0030,
+	0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037, 0x0038,
+	0x0039, 0x003A, 0x003B, 0x003C, 0x003D, 0x003E, 0x003F, 0x044E,
+	0x0430, 0x0431, 0x0446, 0x0434, 0x0435, 0x0444, 0x0433, 0x0445,
+	0x0438, 0x0439, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E, 0x043F,
+	0x044F, 0x0440, 0x0441, 0x0442, 0x0443, 0x0436, 0x0432, 0x044C,
+	0x044B, 0x0437, 0x0448, 0x044D, 0x0449, 0x0447, 0x044A, 0x042E,
+	0x0410, 0x0411, 0x0426, 0x0414, 0x0415, 0x0424, 0x0413, 0x0425,
+	0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E, 0x041F,
+	0x042F, 0x0420, 0x0421, 0x0422, 0x0423, 0x0416, 0x0412, 0x042C,
+	0x042B, 0x0417, 0x0428, 0x042D, 0x0429, 0x0427,
+}
+
+// marc8ExtendedCyrillic is the ISO 5427 extension.
+var marc8ExtendedCyrillic = [94]rune {
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0491,
+	0x0452, 0x0453, 0x0454, 0x0451, 0x0455, 0x0456, 0x0457, 0x0458,
+	0x0459, 0x045A, 0x045B, 0x045C, 0x045E, 0x045F, 0x0000, 0x0463,
+	0x0473, 0x0475, 0x046B, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x005B, 0x0000, 0x005D, 0x0000, 0x005F, 0x0490,
+	0x0402, 0x0403, 0x0404, 0x0401, 0x0405, 0x0406, 0x0407, 0x0408,
+	0x0409, 0x040A, 0x040B, 0x040C, 0x040E, 0x040F, 0x042A, 0x0462,
+	0x0472, 0x0474, 0x046A, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+}
+
+// marc8BasicGreek is ISO 5428.
+var marc8BasicGreek = [94]rune {
+	0x0300, 0x0301, 0x0308, 0x0342, 0x0313, 0x0314, 0x0345, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x00AB,
+	0x00BB, 0x201D, 0x201C, 0x0374, 0x0375, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x00B7, 0x0000, 0x0000, 0x0000, 0x003B, 0x0000,
+	0x0391, 0x0392, 0x0000, 0x0393, 0x0394, 0x0395, 0x03DA, 0x03DC,
+	0x0396, 0x0397, 0x0398, 0x0399, 0x039A, 0x039B, 0x039C, 0x039D,
+	0x039E, 0x039F, 0x03A0, 0x03DE, 0x03A1, 0x03A3, 0x0000, 0x03A4,
+	0x03A5, 0x03A6, 0x03A7, 0x03A8, 0x03A9, 0x03E0, 0x0000, 0x0000,
+	0x03B1, 0x03B2, 0x03D0, 0x03B3, 0x03B4, 0x03B5, 0x03DB, 0x03DD,
+	0x03B6, 0x03B7, 0x03B8, 0x03B9, 0x03BA, 0x03BB, 0x03BC, 0x03BD,
+	0x03BE, 0x03BF, 0x03C0, 0x03DF, 0x03C1, 0x03C3, 0x03C2, 0x03C4,
+	0x03C5, 0x03C6, 0x03C7, 0x03C8, 0x03C9, 0x03E1,
+}
+
+// marc8BasicArabic is ASMO 449 (ISO 9036).
+var marc8BasicArabic = [94]rune {
+	0x0021, 0x0022, 0x0023, 0x00A4, 0x0025, 0x0026, 0x0027, 0x0028,
+	0x0029, 0x002A, 0x002B, 0x060C, 0x002D, 0x002E, 0x002F, 0x0030,
+	0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037, 0x0038,
+	0x0039, 0x003A, 0x061B, 0x003C, 0x003D, 0x003E, 0x061F, 0x0040,
+	0x0621, 0x0622, 0x0623, 0x0624, 0x0625, 0x0626, 0x0627, 0x0628,
+	0x0629, 0x062A, 0x062B, 0x062C, 0x062D, 0x062E, 0x062F, 0x0630,
+	0x0631, 0x0632, 0x0633, 0x0634, 0x0635, 0x0636, 0x0637, 0x0638,
+	0x0639, 0x063A, 0x005B, 0x005C, 0x005D, 0x005E, 0x005F, 0x0640,
+	0x0641, 0x0642, 0x0643, 0x0644, 0x0645, 0x0646, 0x0647, 0x0648,
+	0x0649, 0x064A, 0x064B, 0x064C, 0x064D, 0x064E, 0x064F, 0x0650,
+	0x0651, 0x0652, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x007B, 0x007C, 0x007D, 0x203E,
+}
+
+// marc8ANSEL is the Extended Latin set (ANSI/NISO Z39.47).
+var marc8ANSEL = [94]rune {
+	0x0141, 0x00D8, 0x0110, 0x00DE, 0x00C6, 0x0152, 0x02B9, 0x00B7,
+	0x266D, 0x00AE, 0x00B1, 0x01A0, 0x01AF, 0x02BC, 0x0000, 0x02BB,
+	0x0142, 0x00F8, 0x0111, 0x00FE, 0x00E6, 0x0153, 0x02BA, 0x0131,
+	0x00A3, 0x00F0, 0x0000, 0x01A1, 0x01B0, 0x0000, 0x0000, 0x00B0,
+	0x2113, 0x2117, 0x00A9, 0x266F, 0x00BF, 0x00A1, 0x00DF, 0x20AC,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0309,
+	0x0300, 0x0301, 0x0302, 0x0303, 0x0304, 0x0306, 0x0307, 0x0308,
+	0x030C, 0x030A, 0xFE20, 0xFE21, 0x0315, 0x030B, 0x0310, 0x0327,
+	0x0328, 0x0323, 0x0324, 0x0325, 0x0333, 0x0332, 0x0326, 0x031C,
+	0x032E, 0xFE22, 0xFE23, 0x0000, 0x0000, 0x0313,
+}
+
+// marc8BasicHebrew covers punctuation, digits, the vowel points (which are
+// combining characters) and letters.
+var marc8BasicHebrew = [94]rune {
+	0x0021, 0x0022, 0x0023, 0x0024, 0x0025, 0x0026, 0x0027, 0x0028,
+	0x0029, 0x002A, 0x002B, 0x002C, 0x002D, 0x002E, 0x002F, 0x0030,
+	0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037, 0x0038,
+	0x0039, 0x003A, 0x003B, 0x003C, 0x003D, 0x003E, 0x003F, 0x05B7,
+	0x05B8, 0x05B6, 0x05B5, 0x05B4, 0x05B9, 0x05BB, 0x05B0, 0x05B2,
+	0x05B3, 0x05B1, 0x05BC, 0x05BF, 0x05C1, 0xFB1E, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x05D0,
+	0x05D1, 0x05D2, 0x05D3, 0x05D4, 0x05D5, 0x05D6, 0x05D7, 0x05D8,
+	0x05D9, 0x05DA, 0x05DB, 0x05DC, 0x05DD, 0x05DE, 0x05DF, 0x05E0,
+	0x05E1, 0x05E2, 0x05E3, 0x05E4, 0x05E5, 0x05E6, 0x05E7, 0x05E8,
+	0x05E9, 0x05EA, 0x0000, 0x0000, 0x0000, 0x0000,
+}
+
+// The technique 1 sets only populate a few positions.
+var marc8Subscripts = [94]rune {
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x208D,
+	0x208E, 0x0000, 0x208A, 0x0000, 0x208B, 0x0000, 0x0000, 0x2080,
+	0x2081, 0x2082, 0x2083, 0x2084, 0x2085, 0x2086, 0x2087, 0x2088,
+	0x2089, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+}
+
+var marc8Superscripts = [94]rune {
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x207D,
+	0x207E, 0x0000, 0x207A, 0x0000, 0x207B, 0x0000, 0x0000, 0x2070,
+	0x00B9, 0x00B2, 0x00B3, 0x2074, 0x2075, 0x2076, 0x2077, 0x2078,
+	0x2079, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+}
+
+var marc8GreekSymbols = [94]rune {
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x03B1, 0x03B2, 0x03B3, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+}
+
+// marc8Decompositions lists the canonical decompositions of the characters
+// that are only representable in decomposed form.
+var marc8Decompositions = []marc8Decomposition {
+	{0x00C0, [3]rune {0x0041, 0x0300}},
+	{0x00C1, [3]rune {0x0041, 0x0301}},
+	{0x00C2, [3]rune {0x0041, 0x0302}},
+	{0x00C3, [3]rune {0x0041, 0x0303}},
+	{0x00C4, [3]rune {0x0041, 0x0308}},
+	{0x00C5, [3]rune {0x0041, 0x030A}},
+	{0x00C7, [3]rune {0x0043, 0x0327}},
+	{0x00C8, [3]rune {0x0045, 0x0300}},
+	{0x00C9, [3]rune {0x0045, 0x0301}},
+	{0x00CA, [3]rune {0x0045, 0x0302}},
+	{0x00CB, [3]rune {0x0045, 0x0308}},
+	{0x00CC, [3]rune {0x0049, 0x0300}},
+	{0x00CD, [3]rune {0x0049, 0x0301}},
+	{0x00CE, [3]rune {0x0049, 0x0302}},
+	{0x00CF, [3]rune {0x0049, 0x0308}},
+	{0x00D1, [3]rune {0x004E, 0x0303}},
+	{0x00D2, [3]rune {0x004F, 0x0300}},
+	{0x00D3, [3]rune {0x004F, 0x0301}},
+	{0x00D4, [3]rune {0x004F, 0x0302}},
+	{0x00D5, [3]rune {0x004F, 0x0303}},
+	{0x00D6, [3]rune {0x004F, 0x0308}},
+	{0x00D9, [3]rune {0x0055, 0x0300}},
+	{0x00DA, [3]rune {0x0055, 0x0301}},
+	{0x00DB, [3]rune {0x0055, 0x0302}},
+	{0x00DC, [3]rune {0x0055, 0x0308}},
+	{0x00DD, [3]rune {0x0059, 0x0301}},
+	{0x00E0, [3]rune {0x0061, 0x0300}},
+	{0x00E1, [3]rune {0x0061, 0x0301}},
+	{0x00E2, [3]rune {0x0061, 0x0302}},
+	{0x00E3, [3]rune {0x0061, 0x0303}},
+	{0x00E4, [3]rune {0x0061, 0x0308}},
+	{0x00E5, [3]rune {0x0061, 0x030A}},
+	{0x00E7, [3]rune {0x0063, 0x0327}},
+	{0x00E8, [3]rune {0x0065, 0x0300}},
+	{0x00E9, [3]rune {0x0065, 0x0301}},
+	{0x00EA, [3]rune {0x0065, 0x0302}},
+	{0x00EB, [3]rune {0x0065, 0x0308}},
+	{0x00EC, [3]rune {0x0069, 0x0300}},
+	{0x00ED, [3]rune {0x0069, 0x0301}},
+	{0x00EE, [3]rune {0x0069, 0x0302}},
+	{0x00EF, [3]rune {0x0069, 0x0308}},
+	{0x00F1, [3]rune {0x006E, 0x0303}},
+	{0x00F2, [3]rune {0x006F, 0x0300}},
+	{0x00F3, [3]rune {0x006F, 0x0301}},
+	{0x00F4, [3]rune {0x006F, 0x0302}},
+	{0x00F5, [3]rune {0x006F, 0x0303}},
+	{0x00F6, [3]rune {0x006F, 0x0308}},
+	{0x00F9, [3]rune {0x0075, 0x0300}},
+	{0x00FA, [3]rune {0x0075, 0x0301}},
+	{0x00FB, [3]rune {0x0075, 0x0302}},
+	{0x00FC, [3]rune {0x0075, 0x0308}},
+	{0x00FD, [3]rune {0x0079, 0x0301}},
+	{0x00FF, [3]rune {0x0079, 0x0308}},
+	{0x0100, [3]rune {0x0041, 0x0304}},
+	{0x0101, [3]rune {0x0061, 0x0304}},
+	{0x0102, [3]rune {0x0041, 0x0306}},
+	{0x0103, [3]rune {0x0061, 0x0306}},
+	{0x0104, [3]rune {0x0041, 0x0328}},
+	{0x0105, [3]rune {0x0061, 0x0328}},
+	{0x0106, [3]rune {0x0043, 0x0301}},
+	{0x0107, [3]rune {0x0063, 0x0301}},
+	{0x0108, [3]rune {0x0043, 0x0302}},
+	{0x0109, [3]rune {0x0063, 0x0302}},
+	{0x010A, [3]rune {0x0043, 0x0307}},
+	{0x010B, [3]rune {0x0063, 0x0307}},
+	{0x010C, [3]rune {0x0043, 0x030C}},
+	{0x010D, [3]rune {0x0063, 0x030C}},
+	{0x010E, [3]rune {0x0044, 0x030C}},
+	{0x010F, [3]rune {0x0064, 0x030C}},
+	{0x0112, [3]rune {0x0045, 0x0304}},
+	{0x0113, [3]rune {0x0065, 0x0304}},
+	{0x0114, [3]rune {0x0045, 0x0306}},
+	{0x0115, [3]rune {0x0065, 0x0306}},
+	{0x0116, [3]rune {0x0045, 0x0307}},
+	{0x0117, [3]rune {0x0065, 0x0307}},
+	{0x0118, [3]rune {0x0045, 0x0328}},
+	{0x0119, [3]rune {0x0065, 0x0328}},
+	{0x011A, [3]rune {0x0045, 0x030C}},
+	{0x011B, [3]rune {0x0065, 0x030C}},
+	{0x011C, [3]rune {0x0047, 0x0302}},
+	{0x011D, [3]rune {0x0067, 0x0302}},
+	{0x011E, [3]rune {0x0047, 0x0306}},
+	{0x011F, [3]rune {0x0067, 0x0306}},
+	{0x0120, [3]rune {0x0047, 0x0307}},
+	{0x0121, [3]rune {0x0067, 0x0307}},
+	{0x0122, [3]rune {0x0047, 0x0327}},
+	{0x0123, [3]rune {0x0067, 0x0327}},
+	{0x0124, [3]rune {0x0048, 0x0302}},
+	{0x0125, [3]rune {0x0068, 0x0302}},
+	{0x0128, [3]rune {0x0049, 0x0303}},
+	{0x0129, [3]rune {0x0069, 0x0303}},
+	{0x012A, [3]rune {0x0049, 0x0304}},
+	{0x012B, [3]rune {0x0069, 0x0304}},
+	{0x012C, [3]rune {0x0049, 0x0306}},
+	{0x012D, [3]rune {0x0069, 0x0306}},
+	{0x012E, [3]rune {0x0049, 0x0328}},
+	{0x012F, [3]rune {0x0069, 0x0328}},
+	{0x0130, [3]rune {0x0049, 0x0307}},
+	{0x0134, [3]rune {0x004A, 0x0302}},
+	{0x0135, [3]rune {0x006A, 0x0302}},
+	{0x0136, [3]rune {0x004B, 0x0327}},
+	{0x0137, [3]rune {0x006B, 0x0327}},
+	{0x0139, [3]rune {0x004C, 0x0301}},
+	{0x013A, [3]rune {0x006C, 0x0301}},
+	{0x013B, [3]rune {0x004C, 0x0327}},
+	{0x013C, [3]rune {0x006C, 0x0327}},
+	{0x013D, [3]rune {0x004C, 0x030C}},
+	{0x013E, [3]rune {0x006C, 0x030C}},
+	{0x0143, [3]rune {0x004E, 0x0301}},
+	{0x0144, [3]rune {0x006E, 0x0301}},
+	{0x0145, [3]rune {0x004E, 0x0327}},
+	{0x0146, [3]rune {0x006E, 0x0327}},
+	{0x0147, [3]rune {0x004E, 0x030C}},
+	{0x0148, [3]rune {0x006E, 0x030C}},
+	{0x014C, [3]rune {0x004F, 0x0304}},
+	{0x014D, [3]rune {0x006F, 0x0304}},
+	{0x014E, [3]rune {0x004F, 0x0306}},
+	{0x014F, [3]rune {0x006F, 0x0306}},
+	{0x0150, [3]rune {0x004F, 0x030B}},
+	{0x0151, [3]rune {0x006F, 0x030B}},
+	{0x0154, [3]rune {0x0052, 0x0301}},
+	{0x0155, [3]rune {0x0072, 0x0301}},
+	{0x0156, [3]rune {0x0052, 0x0327}},
+	{0x0157, [3]rune {0x0072, 0x0327}},
+	{0x0158, [3]rune {0x0052, 0x030C}},
+	{0x0159, [3]rune {0x0072, 0x030C}},
+	{0x015A, [3]rune {0x0053, 0x0301}},
+	{0x015B, [3]rune {0x0073, 0x0301}},
+	{0x015C, [3]rune {0x0053, 0x0302}},
+	{0x015D, [3]rune {0x0073, 0x0302}},
+	{0x015E, [3]rune {0x0053, 0x0327}},
+	{0x015F, [3]rune {0x0073, 0x0327}},
+	{0x0160, [3]rune {0x0053, 0x030C}},
+	{0x0161, [3]rune {0x0073, 0x030C}},
+	{0x0162, [3]rune {0x0054, 0x0327}},
+	{0x0163, [3]rune {0x0074, 0x0327}},
+	{0x0164, [3]rune {0x0054, 0x030C}},
+	{0x0165, [3]rune {0x0074, 0x030C}},
+	{0x0168, [3]rune {0x0055, 0x0303}},
+	{0x0169, [3]rune {0x0075, 0x0303}},
+	{0x016A, [3]rune {0x0055, 0x0304}},
+	{0x016B, [3]rune {0x0075, 0x0304}},
+	{0x016C, [3]rune {0x0055, 0x0306}},
+	{0x016D, [3]rune {0x0075, 0x0306}},
+	{0x016E, [3]rune {0x0055, 0x030A}},
+	{0x016F, [3]rune {0x0075, 0x030A}},
+	{0x0170, [3]rune {0x0055, 0x030B}},
+	{0x0171, [3]rune {0x0075, 0x030B}},
+	{0x0172, [3]rune {0x0055, 0x0328}},
+	{0x0173, [3]rune {0x0075, 0x0328}},
+	{0x0174, [3]rune {0x0057, 0x0302}},
+	{0x0175, [3]rune {0x0077, 0x0302}},
+	{0x0176, [3]rune {0x0059, 0x0302}},
+	{0x0177, [3]rune {0x0079, 0x0302}},
+	{0x0178, [3]rune {0x0059, 0x0308}},
+	{0x0179, [3]rune {0x005A, 0x0301}},
+	{0x017A, [3]rune {0x007A, 0x0301}},
+	{0x017B, [3]rune {0x005A, 0x0307}},
+	{0x017C, [3]rune {0x007A, 0x0307}},
+	{0x017D, [3]rune {0x005A, 0x030C}},
+	{0x017E, [3]rune {0x007A, 0x030C}},
+	{0x01CD, [3]rune {0x0041, 0x030C}},
+	{0x01CE, [3]rune {0x0061, 0x030C}},
+	{0x01CF, [3]rune {0x0049, 0x030C}},
+	{0x01D0, [3]rune {0x0069, 0x030C}},
+	{0x01D1, [3]rune {0x004F, 0x030C}},
+	{0x01D2, [3]rune {0x006F, 0x030C}},
+	{0x01D3, [3]rune {0x0055, 0x030C}},
+	{0x01D4, [3]rune {0x0075, 0x030C}},
+	{0x01D5, [3]rune {0x0055, 0x0308, 0x0304}},
+	{0x01D6, [3]rune {0x0075, 0x0308, 0x0304}},
+	{0x01D7, [3]rune {0x0055, 0x0308, 0x0301}},
+	{0x01D8, [3]rune {0x0075, 0x0308, 0x0301}},
+	{0x01D9, [3]rune {0x0055, 0x0308, 0x030C}},
+	{0x01DA, [3]rune {0x0075, 0x0308, 0x030C}},
+	{0x01DB, [3]rune {0x0055, 0x0308, 0x0300}},
+	{0x01DC, [3]rune {0x0075, 0x0308, 0x0300}},
+	{0x01DE, [3]rune {0x0041, 0x0308, 0x0304}},
+	{0x01DF, [3]rune {0x0061, 0x0308, 0x0304}},
+	{0x01E0, [3]rune {0x0041, 0x0307, 0x0304}},
+	{0x01E1, [3]rune {0x0061, 0x0307, 0x0304}},
+	{0x01E2, [3]rune {0x00C6, 0x0304}},
+	{0x01E3, [3]rune {0x00E6, 0x0304}},
+	{0x01E6, [3]rune {0x0047, 0x030C}},
+	{0x01E7, [3]rune {0x0067, 0x030C}},
+	{0x01E8, [3]rune {0x004B, 0x030C}},
+	{0x01E9, [3]rune {0x006B, 0x030C}},
+	{0x01EA, [3]rune {0x004F, 0x0328}},
+	{0x01EB, [3]rune {0x006F, 0x0328}},
+	{0x01EC, [3]rune {0x004F, 0x0328, 0x0304}},
+	{0x01ED, [3]rune {0x006F, 0x0328, 0x0304}},
+	{0x01F0, [3]rune {0x006A, 0x030C}},
+	{0x01F4, [3]rune {0x0047, 0x0301}},
+	{0x01F5, [3]rune {0x0067, 0x0301}},
+	{0x01F8, [3]rune {0x004E, 0x0300}},
+	{0x01F9, [3]rune {0x006E, 0x0300}},
+	{0x01FA, [3]rune {0x0041, 0x030A, 0x0301}},
+	{0x01FB, [3]rune {0x0061, 0x030A, 0x0301}},
+	{0x01FC, [3]rune {0x00C6, 0x0301}},
+	{0x01FD, [3]rune {0x00E6, 0x0301}},
+	{0x01FE, [3]rune {0x00D8, 0x0301}},
+	{0x01FF, [3]rune {0x00F8, 0x0301}},
+	{0x0218, [3]rune {0x0053, 0x0326}},
+	{0x0219, [3]rune {0x0073, 0x0326}},
+	{0x021A, [3]rune {0x0054, 0x0326}},
+	{0x021B, [3]rune {0x0074, 0x0326}},
+	{0x021E, [3]rune {0x0048, 0x030C}},
+	{0x021F, [3]rune {0x0068, 0x030C}},
+	{0x0226, [3]rune {0x0041, 0x0307}},
+	{0x0227, [3]rune {0x0061, 0x0307}},
+	{0x0228, [3]rune {0x0045, 0x0327}},
+	{0x0229, [3]rune {0x0065, 0x0327}},
+	{0x022A, [3]rune {0x004F, 0x0308, 0x0304}},
+	{0x022B, [3]rune {0x006F, 0x0308, 0x0304}},
+	{0x022C, [3]rune {0x004F, 0x0303, 0x0304}},
+	{0x022D, [3]rune {0x006F, 0x0303, 0x0304}},
+	{0x022E, [3]rune {0x004F, 0x0307}},
+	{0x022F, [3]rune {0x006F, 0x0307}},
+	{0x0230, [3]rune {0x004F, 0x0307, 0x0304}},
+	{0x0231, [3]rune {0x006F, 0x0307, 0x0304}},
+	{0x0232, [3]rune {0x0059, 0x0304}},
+	{0x0233, [3]rune {0x0079, 0x0304}},
+	{0x0340, [3]rune {0x0300}},
+	{0x0341, [3]rune {0x0301}},
+	{0x0343, [3]rune {0x0313}},
+	{0x0344, [3]rune {0x0308, 0x0301}},
+	{0x037E, [3]rune {0x003B}},
+	{0x0386, [3]rune {0x0391, 0x0301}},
+	{0x0387, [3]rune {0x00B7}},
+	{0x0388, [3]rune {0x0395, 0x0301}},
+	{0x0389, [3]rune {0x0397, 0x0301}},
+	{0x038A, [3]rune {0x0399, 0x0301}},
+	{0x038C, [3]rune {0x039F, 0x0301}},
+	{0x038E, [3]rune {0x03A5, 0x0301}},
+	{0x038F, [3]rune {0x03A9, 0x0301}},
+	{0x0390, [3]rune {0x03B9, 0x0308, 0x0301}},
+	{0x03AA, [3]rune {0x0399, 0x0308}},
+	{0x03AB, [3]rune {0x03A5, 0x0308}},
+	{0x03AC, [3]rune {0x03B1, 0x0301}},
+	{0x03AD, [3]rune {0x03B5, 0x0301}},
+	{0x03AE, [3]rune {0x03B7, 0x0301}},
+	{0x03AF, [3]rune {0x03B9, 0x0301}},
+	{0x03B0, [3]rune {0x03C5, 0x0308, 0x0301}},
+	{0x03CA, [3]rune {0x03B9, 0x0308}},
+	{0x03CB, [3]rune {0x03C5, 0x0308}},
+	{0x03CC, [3]rune {0x03BF, 0x0301}},
+	{0x03CD, [3]rune {0x03C5, 0x0301}},
+	{0x03CE, [3]rune {0x03C9, 0x0301}},
+	{0x0400, [3]rune {0x0415, 0x0300}},
+	{0x040D, [3]rune {0x0418, 0x0300}},
+	{0x0450, [3]rune {0x0435, 0x0300}},
+	{0x045D, [3]rune {0x0438, 0x0300}},
+	{0x04C1, [3]rune {0x0416, 0x0306}},
+	{0x04C2, [3]rune {0x0436, 0x0306}},
+	{0x04D0, [3]rune {0x0410, 0x0306}},
+	{0x04D1, [3]rune {0x0430, 0x0306}},
+	{0x04D2, [3]rune {0x0410, 0x0308}},
+	{0x04D3, [3]rune {0x0430, 0x0308}},
+	{0x04D6, [3]rune {0x0415, 0x0306}},
+	{0x04D7, [3]rune {0x0435, 0x0306}},
+	{0x04DC, [3]rune {0x0416, 0x0308}},
+	{0x04DD, [3]rune {0x0436, 0x0308}},
+	{0x04DE, [3]rune {0x0417, 0x0308}},
+	{0x04DF, [3]rune {0x0437, 0x0308}},
+	{0x04E2, [3]rune {0x0418, 0x0304}},
+	{0x04E3, [3]rune {0x0438, 0x0304}},
+	{0x04E4, [3]rune {0x0418, 0x0308}},
+	{0x04E5, [3]rune {0x0438, 0x0308}},
+	{0x04E6, [3]rune {0x041E, 0x0308}},
+	{0x04E7, [3]rune {0x043E, 0x0308}},
+	{0x04EC, [3]rune {0x042D, 0x0308}},
+	{0x04ED, [3]rune {0x044D, 0x0308}},
+	{0x04EE, [3]rune {0x0423, 0x0304}},
+	{0x04EF, [3]rune {0x0443, 0x0304}},
+	{0x04F0, [3]rune {0x0423, 0x0308}},
+	{0x04F1, [3]rune {0x0443, 0x0308}},
+	{0x04F2, [3]rune {0x0423, 0x030B}},
+	{0x04F3, [3]rune {0x0443, 0x030B}},
+	{0x04F4, [3]rune {0x0427, 0x0308}},
+	{0x04F5, [3]rune {0x0447, 0x0308}},
+	{0x04F8, [3]rune {0x042B, 0x0308}},
+	{0x04F9, [3]rune {0x044B, 0x0308}},
+	{0x1E00, [3]rune {0x0041, 0x0325}},
+	{0x1E01, [3]rune {0x0061, 0x0325}},
+	{0x1E02, [3]rune {0x0042, 0x0307}},
+	{0x1E03, [3]rune {0x0062, 0x0307}},
+	{0x1E04, [3]rune {0x0042, 0x0323}},
+	{0x1E05, [3]rune {0x0062, 0x0323}},
+	{0x1E08, [3]rune {0x0043, 0x0327, 0x0301}},
+	{0x1E09, [3]rune {0x0063, 0x0327, 0x0301}},
+	{0x1E0A, [3]rune {0x0044, 0x0307}},
+	{0x1E0B, [3]rune {0x0064, 0x0307}},
+	{0x1E0C, [3]rune {0x0044, 0x0323}},
+	{0x1E0D, [3]rune {0x0064, 0x0323}},
+	{0x1E10, [3]rune {0x0044, 0x0327}},
+	{0x1E11, [3]rune {0x0064, 0x0327}},
+	{0x1E14, [3]rune {0x0045, 0x0304, 0x0300}},
+	{0x1E15, [3]rune {0x0065, 0x0304, 0x0300}},
+	{0x1E16, [3]rune {0x0045, 0x0304, 0x0301}},
+	{0x1E17, [3]rune {0x0065, 0x0304, 0x0301}},
+	{0x1E1C, [3]rune {0x0045, 0x0327, 0x0306}},
+	{0x1E1D, [3]rune {0x0065, 0x0327, 0x0306}},
+	{0x1E1E, [3]rune {0x0046, 0x0307}},
+	{0x1E1F, [3]rune {0x0066, 0x0307}},
+	{0x1E20, [3]rune {0x0047, 0x0304}},
+	{0x1E21, [3]rune {0x0067, 0x0304}},
+	{0x1E22, [3]rune {0x0048, 0x0307}},
+	{0x1E23, [3]rune {0x0068, 0x0307}},
+	{0x1E24, [3]rune {0x0048, 0x0323}},
+	{0x1E25, [3]rune {0x0068, 0x0323}},
+	{0x1E26, [3]rune {0x0048, 0x0308}},
+	{0x1E27, [3]rune {0x0068, 0x0308}},
+	{0x1E28, [3]rune {0x0048, 0x0327}},
+	{0x1E29, [3]rune {0x0068, 0x0327}},
+	{0x1E2A, [3]rune {0x0048, 0x032E}},
+	{0x1E2B, [3]rune {0x0068, 0x032E}},
+	{0x1E2E, [3]rune {0x0049, 0x0308, 0x0301}},
+	{0x1E2F, [3]rune {0x0069, 0x0308, 0x0301}},
+	{0x1E30, [3]rune {0x004B, 0x0301}},
+	{0x1E31, [3]rune {0x006B, 0x0301}},
+	{0x1E32, [3]rune {0x004B, 0x0323}},
+	{0x1E33, [3]rune {0x006B, 0x0323}},
+	{0x1E36, [3]rune {0x004C, 0x0323}},
+	{0x1E37, [3]rune {0x006C, 0x0323}},
+	{0x1E38, [3]rune {0x004C, 0x0323, 0x0304}},
+	{0x1E39, [3]rune {0x006C, 0x0323, 0x0304}},
+	{0x1E3E, [3]rune {0x004D, 0x0301}},
+	{0x1E3F, [3]rune {0x006D, 0x0301}},
+	{0x1E40, [3]rune {0x004D, 0x0307}},
+	{0x1E41, [3]rune {0x006D, 0x0307}},
+	{0x1E42, [3]rune {0x004D, 0x0323}},
+	{0x1E43, [3]rune {0x006D, 0x0323}},
+	{0x1E44, [3]rune {0x004E, 0x0307}},
+	{0x1E45, [3]rune {0x006E, 0x0307}},
+	{0x1E46, [3]rune {0x004E, 0x0323}},
+	{0x1E47, [3]rune {0x006E, 0x0323}},
+	{0x1E4C, [3]rune {0x004F, 0x0303, 0x0301}},
+	{0x1E4D, [3]rune {0x006F, 0x0303, 0x0301}},
+	{0x1E4E, [3]rune {0x004F, 0x0303, 0x0308}},
+	{0x1E4F, [3]rune {0x006F, 0x0303, 0x0308}},
+	{0x1E50, [3]rune {0x004F, 0x0304, 0x0300}},
+	{0x1E51, [3]rune {0x006F, 0x0304, 0x0300}},
+	{0x1E52, [3]rune {0x004F, 0x0304, 0x0301}},
+	{0x1E53, [3]rune {0x006F, 0x0304, 0x0301}},
+	{0x1E54, [3]rune {0x0050, 0x0301}},
+	{0x1E55, [3]rune {0x0070, 0x0301}},
+	{0x1E56, [3]rune {0x0050, 0x0307}},
+	{0x1E57, [3]rune {0x0070, 0x0307}},
+	{0x1E58, [3]rune {0x0052, 0x0307}},
+	{0x1E59, [3]rune {0x0072, 0x0307}},
+	{0x1E5A, [3]rune {0x0052, 0x0323}},
+	{0x1E5B, [3]rune {0x0072, 0x0323}},
+	{0x1E5C, [3]rune {0x0052, 0x0323, 0x0304}},
+	{0x1E5D, [3]rune {0x0072, 0x0323, 0x0304}},
+	{0x1E60, [3]rune {0x0053, 0x0307}},
+	{0x1E61, [3]rune {0x0073, 0x0307}},
+	{0x1E62, [3]rune {0x0053, 0x0323}},
+	{0x1E63, [3]rune {0x0073, 0x0323}},
+	{0x1E64, [3]rune {0x0053, 0x0301, 0x0307}},
+	{0x1E65, [3]rune {0x0073, 0x0301, 0x0307}},
+	{0x1E66, [3]rune {0x0053, 0x030C, 0x0307}},
+	{0x1E67, [3]rune {0x0073, 0x030C, 0x0307}},
+	{0x1E68, [3]rune {0x0053, 0x0323, 0x0307}},
+	{0x1E69, [3]rune {0x0073, 0x0323, 0x0307}},
+	{0x1E6A, [3]rune {0x0054, 0x0307}},
+	{0x1E6B, [3]rune {0x0074, 0x0307}},
+	{0x1E6C, [3]rune {0x0054, 0x0323}},
+	{0x1E6D, [3]rune {0x0074, 0x0323}},
+	{0x1E72, [3]rune {0x0055, 0x0324}},
+	{0x1E73, [3]rune {0x0075, 0x0324}},
+	{0x1E78, [3]rune {0x0055, 0x0303, 0x0301}},
+	{0x1E79, [3]rune {0x0075, 0x0303, 0x0301}},
+	{0x1E7A, [3]rune {0x0055, 0x0304, 0x0308}},
+	{0x1E7B, [3]rune {0x0075, 0x0304, 0x0308}},
+	{0x1E7C, [3]rune {0x0056, 0x0303}},
+	{0x1E7D, [3]rune {0x0076, 0x0303}},
+	{0x1E7E, [3]rune {0x0056, 0x0323}},
+	{0x1E7F, [3]rune {0x0076, 0x0323}},
+	{0x1E80, [3]rune {0x0057, 0x0300}},
+	{0x1E81, [3]rune {0x0077, 0x0300}},
+	{0x1E82, [3]rune {0x0057, 0x0301}},
+	{0x1E83, [3]rune {0x0077, 0x0301}},
+	{0x1E84, [3]rune {0x0057, 0x0308}},
+	{0x1E85, [3]rune {0x0077, 0x0308}},
+	{0x1E86, [3]rune {0x0057, 0x0307}},
+	{0x1E87, [3]rune {0x0077, 0x0307}},
+	{0x1E88, [3]rune {0x0057, 0x0323}},
+	{0x1E89, [3]rune {0x0077, 0x0323}},
+	{0x1E8A, [3]rune {0x0058, 0x0307}},
+	{0x1E8B, [3]rune {0x0078, 0x0307}},
+	{0x1E8C, [3]rune {0x0058, 0x0308}},
+	{0x1E8D, [3]rune {0x0078, 0x0308}},
+	{0x1E8E, [3]rune {0x0059, 0x0307}},
+	{0x1E8F, [3]rune {0x0079, 0x0307}},
+	{0x1E90, [3]rune {0x005A, 0x0302}},
+	{0x1E91, [3]rune {0x007A, 0x0302}},
+	{0x1E92, [3]rune {0x005A, 0x0323}},
+	{0x1E93, [3]rune {0x007A, 0x0323}},
+	{0x1E97, [3]rune {0x0074, 0x0308}},
+	{0x1E98, [3]rune {0x0077, 0x030A}},
+	{0x1E99, [3]rune {0x0079, 0x030A}},
+	{0x1EA0, [3]rune {0x0041, 0x0323}},
+	{0x1EA1, [3]rune {0x0061, 0x0323}},
+	{0x1EA2, [3]rune {0x0041, 0x0309}},
+	{0x1EA3, [3]rune {0x0061, 0x0309}},
+	{0x1EA4, [3]rune {0x0041, 0x0302, 0x0301}},
+	{0x1EA5, [3]rune {0x0061, 0x0302, 0x0301}},
+	{0x1EA6, [3]rune {0x0041, 0x0302, 0x0300}},
+	{0x1EA7, [3]rune {0x0061, 0x0302, 0x0300}},
+	{0x1EA8, [3]rune {0x0041, 0x0302, 0x0309}},
+	{0x1EA9, [3]rune {0x0061, 0x0302, 0x0309}},
+	{0x1EAA, [3]rune {0x0041, 0x0302, 0x0303}},
+	{0x1EAB, [3]rune {0x0061, 0x0302, 0x0303}},
+	{0x1EAC, [3]rune {0x0041, 0x0323, 0x0302}},
+	{0x1EAD, [3]rune {0x0061, 0x0323, 0x0302}},
+	{0x1EAE, [3]rune {0x0041, 0x0306, 0x0301}},
+	{0x1EAF, [3]rune {0x0061, 0x0306, 0x0301}},
+	{0x1EB0, [3]rune {0x0041, 0x0306, 0x0300}},
+	{0x1EB1, [3]rune {0x0061, 0x0306, 0x0300}},
+	{0x1EB2, [3]rune {0x0041, 0x0306, 0x0309}},
+	{0x1EB3, [3]rune {0x0061, 0x0306, 0x0309}},
+	{0x1EB4, [3]rune {0x0041, 0x0306, 0x0303}},
+	{0x1EB5, [3]rune {0x0061, 0x0306, 0x0303}},
+	{0x1EB6, [3]rune {0x0041, 0x0323, 0x0306}},
+	{0x1EB7, [3]rune {0x0061, 0x0323, 0x0306}},
+	{0x1EB8, [3]rune {0x0045, 0x0323}},
+	{0x1EB9, [3]rune {0x0065, 0x0323}},
+	{0x1EBA, [3]rune {0x0045, 0x0309}},
+	{0x1EBB, [3]rune {0x0065, 0x0309}},
+	{0x1EBC, [3]rune {0x0045, 0x0303}},
+	{0x1EBD, [3]rune {0x0065, 0x0303}},
+	{0x1EBE, [3]rune {0x0045, 0x0302, 0x0301}},
+	{0x1EBF, [3]rune {0x0065, 0x0302, 0x0301}},
+	{0x1EC0, [3]rune {0x0045, 0x0302, 0x0300}},
+	{0x1EC1, [3]rune {0x0065, 0x0302, 0x0300}},
+	{0x1EC2, [3]rune {0x0045, 0x0302, 0x0309}},
+	{0x1EC3, [3]rune {0x0065, 0x0302, 0x0309}},
+	{0x1EC4, [3]rune {0x0045, 0x0302, 0x0303}},
+	{0x1EC5, [3]rune {0x0065, 0x0302, 0x0303}},
+	{0x1EC6, [3]rune {0x0045, 0x0323, 0x0302}},
+	{0x1EC7, [3]rune {0x0065, 0x0323, 0x0302}},
+	{0x1EC8, [3]rune {0x0049, 0x0309}},
+	{0x1EC9, [3]rune {0x0069, 0x0309}},
+	{0x1ECA, [3]rune {0x0049, 0x0323}},
+	{0x1ECB, [3]rune {0x0069, 0x0323}},
+	{0x1ECC, [3]rune {0x004F, 0x0323}},
+	{0x1ECD, [3]rune {0x006F, 0x0323}},
+	{0x1ECE, [3]rune {0x004F, 0x0309}},
+	{0x1ECF, [3]rune {0x006F, 0x0309}},
+	{0x1ED0, [3]rune {0x004F, 0x0302, 0x0301}},
+	{0x1ED1, [3]rune {0x006F, 0x0302, 0x0301}},
+	{0x1ED2, [3]rune {0x004F, 0x0302, 0x0300}},
+	{0x1ED3, [3]rune {0x006F, 0x0302, 0x0300}},
+	{0x1ED4, [3]rune {0x004F, 0x0302, 0x0309}},
+	{0x1ED5, [3]rune {0x006F, 0x0302, 0x0309}},
+	{0x1ED6, [3]rune {0x004F, 0x0302, 0x0303}},
+	{0x1ED7, [3]rune {0x006F, 0x0302, 0x0303}},
+	{0x1ED8, [3]rune {0x004F, 0x0323, 0x0302}},
+	{0x1ED9, [3]rune {0x006F, 0x0323, 0x0302}},
+	{0x1EE4, [3]rune {0x0055, 0x0323}},
+	{0x1EE5, [3]rune {0x0075, 0x0323}},
+	{0x1EE6, [3]rune {0x0055, 0x0309}},
+	{0x1EE7, [3]rune {0x0075, 0x0309}},
+	{0x1EF2, [3]rune {0x0059, 0x0300}},
+	{0x1EF3, [3]rune {0x0079, 0x0300}},
+	{0x1EF4, [3]rune {0x0059, 0x0323}},
+	{0x1EF5, [3]rune {0x0079, 0x0323}},
+	{0x1EF6, [3]rune {0x0059, 0x0309}},
+	{0x1EF7, [3]rune {0x0079, 0x0309}},
+	{0x1EF8, [3]rune {0x0059, 0x0303}},
+	{0x1EF9, [3]rune {0x0079, 0x0303}},
+	{0x1F00, [3]rune {0x03B1, 0x0313}},
+	{0x1F01, [3]rune {0x03B1, 0x0314}},
+	{0x1F02, [3]rune {0x03B1, 0x0313, 0x0300}},
+	{0x1F03, [3]rune {0x03B1, 0x0314, 0x0300}},
+	{0x1F04, [3]rune {0x03B1, 0x0313, 0x0301}},
+	{0x1F05, [3]rune {0x03B1, 0x0314, 0x0301}},
+	{0x1F06, [3]rune {0x03B1, 0x0313, 0x0342}},
+	{0x1F07, [3]rune {0x03B1, 0x0314, 0x0342}},
+	{0x1F08, [3]rune {0x0391, 0x0313}},
+	{0x1F09, [3]rune {0x0391, 0x0314}},
+	{0x1F0A, [3]rune {0x0391, 0x0313, 0x0300}},
+	{0x1F0B, [3]rune {0x0391, 0x0314, 0x0300}},
+	{0x1F0C, [3]rune {0x0391, 0x0313, 0x0301}},
+	{0x1F0D, [3]rune {0x0391, 0x0314, 0x0301}},
+	{0x1F0E, [3]rune {0x0391, 0x0313, 0x0342}},
+	{0x1F0F, [3]rune {0x0391, 0x0314, 0x0342}},
+	{0x1F10, [3]rune {0x03B5, 0x0313}},
+	{0x1F11, [3]rune {0x03B5, 0x0314}},
+	{0x1F12, [3]rune {0x03B5, 0x0313, 0x0300}},
+	{0x1F13, [3]rune {0x03B5, 0x0314, 0x0300}},
+	{0x1F14, [3]rune {0x03B5, 0x0313, 0x0301}},
+	{0x1F15, [3]rune {0x03B5, 0x0314, 0x0301}},
+	{0x1F18, [3]rune {0x0395, 0x0313}},
+	{0x1F19, [3]rune {0x0395, 0x0314}},
+	{0x1F1A, [3]rune {0x0395, 0x0313, 0x0300}},
+	{0x1F1B, [3]rune {0x0395, 0x0314, 0x0300}},
+	{0x1F1C, [3]rune {0x0395, 0x0313, 0x0301}},
+	{0x1F1D, [3]rune {0x0395, 0x0314, 0x0301}},
+	{0x1F20, [3]rune {0x03B7, 0x0313}},
+	{0x1F21, [3]rune {0x03B7, 0x0314}},
+	{0x1F22, [3]rune {0x03B7, 0x0313, 0x0300}},
+	{0x1F23, [3]rune {0x03B7, 0x0314, 0x0300}},
+	{0x1F24, [3]rune {0x03B7, 0x0313, 0x0301}},
+	{0x1F25, [3]rune {0x03B7, 0x0314, 0x0301}},
+	{0x1F26, [3]rune {0x03B7, 0x0313, 0x0342}},
+	{0x1F27, [3]rune {0x03B7, 0x0314, 0x0342}},
+	{0x1F28, [3]rune {0x0397, 0x0313}},
+	{0x1F29, [3]rune {0x0397, 0x0314}},
+	{0x1F2A, [3]rune {0x0397, 0x0313, 0x0300}},
+	{0x1F2B, [3]rune {0x0397, 0x0314, 0x0300}},
+	{0x1F2C, [3]rune {0x0397, 0x0313, 0x0301}},
+	{0x1F2D, [3]rune {0x0397, 0x0314, 0x0301}},
+	{0x1F2E, [3]rune {0x0397, 0x0313, 0x0342}},
+	{0x1F2F, [3]rune {0x0397, 0x0314, 0x0342}},
+	{0x1F30, [3]rune {0x03B9, 0x0313}},
+	{0x1F31, [3]rune {0x03B9, 0x0314}},
+	{0x1F32, [3]rune {0x03B9, 0x0313, 0x0300}},
+	{0x1F33, [3]rune {0x03B9, 0x0314, 0x0300}},
+	{0x1F34, [3]rune {0x03B9, 0x0313, 0x0301}},
+	{0x1F35, [3]rune {0x03B9, 0x0314, 0x0301}},
+	{0x1F36, [3]rune {0x03B9, 0x0313, 0x0342}},
+	{0x1F37, [3]rune {0x03B9, 0x0314, 0x0342}},
+	{0x1F38, [3]rune {0x0399, 0x0313}},
+	{0x1F39, [3]rune {0x0399, 0x0314}},
+	{0x1F3A, [3]rune {0x0399, 0x0313, 0x0300}},
+	{0x1F3B, [3]rune {0x0399, 0x0314, 0x0300}},
+	{0x1F3C, [3]rune {0x0399, 0x0313, 0x0301}},
+	{0x1F3D, [3]rune {0x0399, 0x0314, 0x0301}},
+	{0x1F3E, [3]rune {0x0399, 0x0313, 0x0342}},
+	{0x1F3F, [3]rune {0x0399, 0x0314, 0x0342}},
+	{0x1F40, [3]rune {0x03BF, 0x0313}},
+	{0x1F41, [3]rune {0x03BF, 0x0314}},
+	{0x1F42, [3]rune {0x03BF, 0x0313, 0x0300}},
+	{0x1F43, [3]rune {0x03BF, 0x0314, 0x0300}},
+	{0x1F44, [3]rune {0x03BF, 0x0313, 0x0301}},
+	{0x1F45, [3]rune {0x03BF, 0x0314, 0x0301}},
+	{0x1F48, [3]rune {0x039F, 0x0313}},
+	{0x1F49, [3]rune {0x039F, 0x0314}},
+	{0x1F4A, [3]rune {0x039F, 0x0313, 0x0300}},
+	{0x1F4B, [3]rune {0x039F, 0x0314, 0x0300}},
+	{0x1F4C, [3]rune {0x039F, 0x0313, 0x0301}},
+	{0x1F4D, [3]rune {0x039F, 0x0314, 0x0301}},
+	{0x1F50, [3]rune {0x03C5, 0x0313}},
+	{0x1F51, [3]rune {0x03C5, 0x0314}},
+	{0x1F52, [3]rune {0x03C5, 0x0313, 0x0300}},
+	{0x1F53, [3]rune {0x03C5, 0x0314, 0x0300}},
+	{0x1F54, [3]rune {0x03C5, 0x0313, 0x0301}},
+	{0x1F55, [3]rune {0x03C5, 0x0314, 0x0301}},
+	{0x1F56, [3]rune {0x03C5, 0x0313, 0x0342}},
+	{0x1F57, [3]rune {0x03C5, 0x0314, 0x0342}},
+	{0x1F59, [3]rune {0x03A5, 0x0314}},
+	{0x1F5B, [3]rune {0x03A5, 0x0314, 0x0300}},
+	{0x1F5D, [3]rune {0x03A5, 0x0314, 0x0301}},
+	{0x1F5F, [3]rune {0x03A5, 0x0314, 0x0342}},
+	{0x1F60, [3]rune {0x03C9, 0x0313}},
+	{0x1F61, [3]rune {0x03C9, 0x0314}},
+	{0x1F62, [3]rune {0x03C9, 0x0313, 0x0300}},
+	{0x1F63, [3]rune {0x03C9, 0x0314, 0x0300}},
+	{0x1F64, [3]rune {0x03C9, 0x0313, 0x0301}},
+	{0x1F65, [3]rune {0x03C9, 0x0314, 0x0301}},
+	{0x1F66, [3]rune {0x03C9, 0x0313, 0x0342}},
+	{0x1F67, [3]rune {0x03C9, 0x0314, 0x0342}},
+	{0x1F68, [3]rune {0x03A9, 0x0313}},
+	{0x1F69, [3]rune {0x03A9, 0x0314}},
+	{0x1F6A, [3]rune {0x03A9, 0x0313, 0x0300}},
+	{0x1F6B, [3]rune {0x03A9, 0x0314, 0x0300}},
+	{0x1F6C, [3]rune {0x03A9, 0x0313, 0x0301}},
+	{0x1F6D, [3]rune {0x03A9, 0x0314, 0x0301}},
+	{0x1F6E, [3]rune {0x03A9, 0x0313, 0x0342}},
+	{0x1F6F, [3]rune {0x03A9, 0x0314, 0x0342}},
+	{0x1F70, [3]rune {0x03B1, 0x0300}},
+	{0x1F71, [3]rune {0x03B1, 0x0301}},
+	{0x1F72, [3]rune {0x03B5, 0x0300}},
+	{0x1F73, [3]rune {0x03B5, 0x0301}},
+	{0x1F74, [3]rune {0x03B7, 0x0300}},
+	{0x1F75, [3]rune {0x03B7, 0x0301}},
+	{0x1F76, [3]rune {0x03B9, 0x0300}},
+	{0x1F77, [3]rune {0x03B9, 0x0301}},
+	{0x1F78, [3]rune {0x03BF, 0x0300}},
+	{0x1F79, [3]rune {0x03BF, 0x0301}},
+	{0x1F7A, [3]rune {0x03C5, 0x0300}},
+	{0x1F7B, [3]rune {0x03C5, 0x0301}},
+	{0x1F7C, [3]rune {0x03C9, 0x0300}},
+	{0x1F7D, [3]rune {0x03C9, 0x0301}},
+	{0x1F80, [3]rune {0x03B1, 0x0313, 0x0345}},
+	{0x1F81, [3]rune {0x03B1, 0x0314, 0x0345}},
+	{0x1F88, [3]rune {0x0391, 0x0313, 0x0345}},
+	{0x1F89, [3]rune {0x0391, 0x0314, 0x0345}},
+	{0x1F90, [3]rune {0x03B7, 0x0313, 0x0345}},
+	{0x1F91, [3]rune {0x03B7, 0x0314, 0x0345}},
+	{0x1F98, [3]rune {0x0397, 0x0313, 0x0345}},
+	{0x1F99, [3]rune {0x0397, 0x0314, 0x0345}},
+	{0x1FA0, [3]rune {0x03C9, 0x0313, 0x0345}},
+	{0x1FA1, [3]rune {0x03C9, 0x0314, 0x0345}},
+	{0x1FA8, [3]rune {0x03A9, 0x0313, 0x0345}},
+	{0x1FA9, [3]rune {0x03A9, 0x0314, 0x0345}},
+	{0x1FB0, [3]rune {0x03B1, 0x0306}},
+	{0x1FB1, [3]rune {0x03B1, 0x0304}},
+	{0x1FB2, [3]rune {0x03B1, 0x0300, 0x0345}},
+	{0x1FB3, [3]rune {0x03B1, 0x0345}},
+	{0x1FB4, [3]rune {0x03B1, 0x0301, 0x0345}},
+	{0x1FB6, [3]rune {0x03B1, 0x0342}},
+	{0x1FB7, [3]rune {0x03B1, 0x0342, 0x0345}},
+	{0x1FB8, [3]rune {0x0391, 0x0306}},
+	{0x1FB9, [3]rune {0x0391, 0x0304}},
+	{0x1FBA, [3]rune {0x0391, 0x0300}},
+	{0x1FBB, [3]rune {0x0391, 0x0301}},
+	{0x1FBC, [3]rune {0x0391, 0x0345}},
+	{0x1FBE, [3]rune {0x03B9}},
+	{0x1FC2, [3]rune {0x03B7, 0x0300, 0x0345}},
+	{0x1FC3, [3]rune {0x03B7, 0x0345}},
+	{0x1FC4, [3]rune {0x03B7, 0x0301, 0x0345}},
+	{0x1FC6, [3]rune {0x03B7, 0x0342}},
+	{0x1FC7, [3]rune {0x03B7, 0x0342, 0x0345}},
+	{0x1FC8, [3]rune {0x0395, 0x0300}},
+	{0x1FC9, [3]rune {0x0395, 0x0301}},
+	{0x1FCA, [3]rune {0x0397, 0x0300}},
+	{0x1FCB, [3]rune {0x0397, 0x0301}},
+	{0x1FCC, [3]rune {0x0397, 0x0345}},
+	{0x1FD0, [3]rune {0x03B9, 0x0306}},
+	{0x1FD1, [3]rune {0x03B9, 0x0304}},
+	{0x1FD2, [3]rune {0x03B9, 0x0308, 0x0300}},
+	{0x1FD3, [3]rune {0x03B9, 0x0308, 0x0301}},
+	{0x1FD6, [3]rune {0x03B9, 0x0342}},
+	{0x1FD7, [3]rune {0x03B9, 0x0308, 0x0342}},
+	{0x1FD8, [3]rune {0x0399, 0x0306}},
+	{0x1FD9, [3]rune {0x0399, 0x0304}},
+	{0x1FDA, [3]rune {0x0399, 0x0300}},
+	{0x1FDB, [3]rune {0x0399, 0x0301}},
+	{0x1FE0, [3]rune {0x03C5, 0x0306}},
+	{0x1FE1, [3]rune {0x03C5, 0x0304}},
+	{0x1FE2, [3]rune {0x03C5, 0x0308, 0x0300}},
+	{0x1FE3, [3]rune {0x03C5, 0x0308, 0x0301}},
+	{0x1FE4, [3]rune {0x03C1, 0x0313}},
+	{0x1FE5, [3]rune {0x03C1, 0x0314}},
+	{0x1FE6, [3]rune {0x03C5, 0x0342}},
+	{0x1FE7, [3]rune {0x03C5, 0x0308, 0x0342}},
+	{0x1FE8, [3]rune {0x03A5, 0x0306}},
+	{0x1FE9, [3]rune {0x03A5, 0x0304}},
+	{0x1FEA, [3]rune {0x03A5, 0x0300}},
+	{0x1FEB, [3]rune {0x03A5, 0x0301}},
+	{0x1FEC, [3]rune {0x03A1, 0x0314}},
+	{0x1FEF, [3]rune {0x0060}},
+	{0x1FF2, [3]rune {0x03C9, 0x0300, 0x0345}},
+	{0x1FF3, [3]rune {0x03C9, 0x0345}},
+	{0x1FF4, [3]rune {0x03C9, 0x0301, 0x0345}},
+	{0x1FF6, [3]rune {0x03C9, 0x0342}},
+	{0x1FF7, [3]rune {0x03C9, 0x0342, 0x0345}},
+	{0x1FF8, [3]rune {0x039F, 0x0300}},
+	{0x1FF9, [3]rune {0x039F, 0x0301}},
+	{0x1FFA, [3]rune {0x03A9, 0x0300}},
+	{0x1FFB, [3]rune {0x03A9, 0x0301}},
+	{0x1FFC, [3]rune {0x03A9, 0x0345}},
+	{0x2126, [3]rune {0x03A9}},
+	{0x212A, [3]rune {0x004B}},
+	{0x212B, [3]rune {0x0041, 0x030A}},
+}
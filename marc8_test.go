@@ -0,0 +1,39 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestMARC8RoundTrip(t *testing.T) {
+	// combining characters precede their base in MARC-8 and follow it in
+	// Unicode
+	checkRoundTrip(t, "MARC-8", []byte("Caf\xE2e \xA1\xB1"), "Café Łł")
+	checkRoundTrip(t, "MARC-8", []byte("\xE2\xE3e"), "é̂")
+	checkRoundTrip(t, "MARC-8", []byte("a\x1B(Nbb\x1B(Bb"), "aББb")
+	checkRoundTrip(t, "MARC-8", []byte("\x1Bb2\x1Bsx"), "₂x")
+	// Hebrew points
+	checkRoundTrip(t, "MARC-8", []byte("\x1B(2\x41r\x40`\x1B(B"), "עָאַ")
+	checkRoundTrip(t, "ANSEL", []byte("Caf\xE2e"), "Café")
+}
+
+func TestMARC8Designations(t *testing.T) {
+	// precomposed characters are taken apart
+	checkEncode(t, "MARC-8", "é", []byte("\xE2e"))
+	// field and record terminators return to the default sets
+	checkDecode(t, "MARC-8", []byte("a\x1B(NAB\x1ECD"), "aаб\x1ECD")
+	// ANSEL knows no escape sequences, so ESC is a control character there
+	checkRoundTrip(t, "ANSEL", []byte("\x1B(NA"), "\x1B(NA")
+	// but not in MARC-8
+	checkEncode(t, "MARC-8", "a\x1B(NA", []byte("a\x00(NA"), 1)
+}
+
+func TestMARC8Errors(t *testing.T) {
+	checkDecode(t, "MARC-8", []byte("\x1Bbx2\x1Bs"), "�₂", 2)
+	checkDecode(t, "MARC-8", []byte("a\x1B"), "a�", 1)
+	// EACC and Extended Arabic are not supported: their designation is
+	// reported, and the graphic set is undecodable until designated again
+	checkDecode(t, "MARC-8", []byte("\x1B$1!!!a"), "�����", 0, 3, 4, 5, 6)
+	checkDecode(t, "MARC-8", []byte("\x1B)4\xA1a\x1B)!E\xA1"), "��aŁ", 0, 3)
+	checkEncode(t, "MARC-8", "a中b", []byte("a\x00b"), 1)
+	checkEncode(t, "ANSEL", "aБb", []byte("a\x00b"), 1)
+}
@@ -5,33 +5,45 @@ import (
 	"sync"
 )
 
+type Encoding11 uint
 type Encoding12 uint
 type Encoding14 uint
 type Encoding21 uint
 type Encoding24 uint
 type Encoding41 uint
 type Encoding42 uint
+type Encoding44 uint
 
 const (
+	NO_ENCODING11 Encoding11 = 0
 	NO_ENCODING12 Encoding12 = 0
 	NO_ENCODING14 Encoding14 = 0
 	NO_ENCODING21 Encoding21 = 0
 	NO_ENCODING24 Encoding24 = 0
 	NO_ENCODING41 Encoding41 = 0
 	NO_ENCODING42 Encoding42 = 0
+	NO_ENCODING44 Encoding44 = 0
 )
 
+type Factory11 func() Codec[byte, byte]
 type Factory12 func() Codec[byte, uint16]
 type Factory14 func() Codec[byte, rune]
 type Factory21 func() Codec[uint16, byte]
 type Factory24 func() Codec[uint16, rune]
 type Factory41 func() Codec[rune, byte]
 type Factory42 func() Codec[rune, uint16]
+// Factory44 makes transforms between runes, such as normalizations, which
+// Chain can put in front of encoders or behind decoders.
+type Factory44 func() Codec[rune, rune]
 
 type EncodingInfo[IDT any, FactoryT any] struct {
 	id IDT
 	names []string
 	factory FactoryT
+	// derived is set for entries the registry made up from another category
+	// by way of a pivot; registering an encoding proper under their names
+	// supersedes them
+	derived bool
 }
 
 func(info *EncodingInfo[IDT, FactoryT]) ID() (id IDT) {
@@ -55,53 +67,170 @@ func(info *EncodingInfo[IDT, FactoryT]) Factory() (factory FactoryT) {
 	return
 }
 
+var encodings11 []*EncodingInfo[Encoding11, Factory11]
 var encodings12 []*EncodingInfo[Encoding12, Factory12]
 var encodings14 []*EncodingInfo[Encoding14, Factory14]
 var encodings21 []*EncodingInfo[Encoding21, Factory21]
 var encodings24 []*EncodingInfo[Encoding24, Factory24]
 var encodings41 []*EncodingInfo[Encoding41, Factory41]
 var encodings42 []*EncodingInfo[Encoding42, Factory42]
+var encodings44 []*EncodingInfo[Encoding44, Factory44]
 
+var encoding11NameMap map[string]Encoding11
 var encoding12NameMap map[string]Encoding12
 var encoding14NameMap map[string]Encoding14
 var encoding21NameMap map[string]Encoding21
 var encoding24NameMap map[string]Encoding24
 var encoding41NameMap map[string]Encoding41
 var encoding42NameMap map[string]Encoding42
+var encoding44NameMap map[string]Encoding44
 
+var encodings11Mutex sync.Mutex
 var encodings12Mutex sync.Mutex
 var encodings14Mutex sync.Mutex
 var encodings21Mutex sync.Mutex
 var encodings24Mutex sync.Mutex
 var encodings41Mutex sync.Mutex
 var encodings42Mutex sync.Mutex
+var encodings44Mutex sync.Mutex
+
+// NormalizeEncodingName reduces name to the form in which the registry
+// compares names, as per the loose matching of UTS #22: all characters but
+// ASCII letters and digits are dropped, letters are folded to lower case and
+// a zero is dropped if it starts a run of digits and another digit follows,
+// so that "UTF-8", "utf8" and "Utf_08" all match.
+func NormalizeEncodingName(name string) string {
+	normalized := make([]byte, 0, len(name))
+	afterDigit := false
+	for index := 0; index < len(name); index++ {
+		c := name[index]
+		switch {
+			case c >= 'A' && c <= 'Z':
+				normalized = append(normalized, c + ('a' - 'A'))
+				afterDigit = false
+			case c >= 'a' && c <= 'z':
+				normalized = append(normalized, c)
+				afterDigit = false
+			case c == '0':
+				if !afterDigit && index + 1 < len(name) && name[index + 1] >= '0' && name[index + 1] <= '9' {
+					continue
+				}
+				normalized = append(normalized, c)
+			case c >= '1' && c <= '9':
+				normalized = append(normalized, c)
+				afterDigit = true
+			default:
+				afterDigit = false
+		}
+	}
+	return string(normalized)
+}
+
+// Registering an encoding derives the encodings of the same name in the other
+// categories that differ from it only in the Unicode code units used, by
+// chaining it with a UnitConverter: decoders to UTF-16 and to runes from one
+// another, encoders likewise, and transforms between runes, UTF-16 to runes
+// and runes to UTF-16 from any of these. A derived encoding takes only the
+// names not registered in its category yet; registering an encoding proper
+// under its names later supersedes it. Derived encodings do not derive any
+// further.
+
+func RegisterEncoding11(factory Factory11, names ...string) (id Encoding11) {
+	if factory == nil {
+		return
+	}
+	encodings11Mutex.Lock()
+	for _, name := range names {
+		if encoding11NameTaken(name) {
+			encodings11Mutex.Unlock()
+			panic(fmt.Sprintf("Cannot register new Encoding11: Name '%s' is already registered", name))
+		}
+	}
+	id = insertEncoding11(factory, names, false)
+	encodings11Mutex.Unlock()
+	if id == NO_ENCODING11 {
+		panic("Too many Encoding11 instances registered")
+	}
+	return
+}
+
+// encoding11NameTaken tells whether name belongs to an Encoding11 that was
+// not derived; encodings11Mutex must be held.
+func encoding11NameTaken(name string) bool {
+	existing := encoding11NameMap[NormalizeEncodingName(name)]
+	return existing != NO_ENCODING11 && !encodings11[existing - 1].derived
+}
+
+// insertEncoding11 adds an Encoding11 under names, which must not be taken,
+// or gives NO_ENCODING11 if there are too many already; encodings11Mutex
+// must be held.
+func insertEncoding11(factory Factory11, names []string, derived bool) (id Encoding11) {
+	id = Encoding11(len(encodings11) + 1)
+	if id == NO_ENCODING11 {
+		return
+	}
+	if encoding11NameMap == nil {
+		encoding11NameMap = make(map[string]Encoding11)
+	}
+	encodings11 = append(encodings11, &EncodingInfo[Encoding11, Factory11] {
+		id: id,
+		names: append([]string(nil), names...),
+		factory: factory,
+		derived: derived,
+	})
+	for _, name := range names {
+		encoding11NameMap[NormalizeEncodingName(name)] = id
+	}
+	return
+}
 
 func RegisterEncoding12(factory Factory12, names ...string) (id Encoding12) {
 	if factory == nil {
 		return
 	}
 	encodings12Mutex.Lock()
-	if encoding12NameMap == nil {
-		encoding12NameMap = make(map[string]Encoding12)
-	}
 	for _, name := range names {
-		if encoding12NameMap[name] != NO_ENCODING12 {
+		if encoding12NameTaken(name) {
+			encodings12Mutex.Unlock()
 			panic(fmt.Sprintf("Cannot register new Encoding12: Name '%s' is already registered", name))
 		}
 	}
-	id = Encoding12(len(encodings12) + 1)
-	if id == 0 {
+	id = insertEncoding12(factory, names, false)
+	encodings12Mutex.Unlock()
+	if id == NO_ENCODING12 {
 		panic("Too many Encoding12 instances registered")
 	}
+	deriveFromEncoding12(factory, names)
+	return
+}
+
+// encoding12NameTaken tells whether name belongs to an Encoding12 that was
+// not derived; encodings12Mutex must be held.
+func encoding12NameTaken(name string) bool {
+	existing := encoding12NameMap[NormalizeEncodingName(name)]
+	return existing != NO_ENCODING12 && !encodings12[existing - 1].derived
+}
+
+// insertEncoding12 adds an Encoding12 under names, which must not be taken,
+// or gives NO_ENCODING12 if there are too many already; encodings12Mutex
+// must be held.
+func insertEncoding12(factory Factory12, names []string, derived bool) (id Encoding12) {
+	id = Encoding12(len(encodings12) + 1)
+	if id == NO_ENCODING12 {
+		return
+	}
+	if encoding12NameMap == nil {
+		encoding12NameMap = make(map[string]Encoding12)
+	}
 	encodings12 = append(encodings12, &EncodingInfo[Encoding12, Factory12] {
 		id: id,
 		names: append([]string(nil), names...),
 		factory: factory,
+		derived: derived,
 	})
 	for _, name := range names {
-		encoding12NameMap[name] = id
+		encoding12NameMap[NormalizeEncodingName(name)] = id
 	}
-	encodings12Mutex.Unlock()
 	return
 }
 
@@ -110,27 +239,48 @@ func RegisterEncoding14(factory Factory14, names ...string) (id Encoding14) {
 		return
 	}
 	encodings14Mutex.Lock()
-	if encoding14NameMap == nil {
-		encoding14NameMap = make(map[string]Encoding14)
-	}
 	for _, name := range names {
-		if encoding14NameMap[name] != NO_ENCODING14 {
+		if encoding14NameTaken(name) {
+			encodings14Mutex.Unlock()
 			panic(fmt.Sprintf("Cannot register new Encoding14: Name '%s' is already registered", name))
 		}
 	}
-	id = Encoding14(len(encodings14) + 1)
-	if id == 0 {
+	id = insertEncoding14(factory, names, false)
+	encodings14Mutex.Unlock()
+	if id == NO_ENCODING14 {
 		panic("Too many Encoding14 instances registered")
 	}
+	deriveFromEncoding14(factory, names)
+	return
+}
+
+// encoding14NameTaken tells whether name belongs to an Encoding14 that was
+// not derived; encodings14Mutex must be held.
+func encoding14NameTaken(name string) bool {
+	existing := encoding14NameMap[NormalizeEncodingName(name)]
+	return existing != NO_ENCODING14 && !encodings14[existing - 1].derived
+}
+
+// insertEncoding14 adds an Encoding14 under names, which must not be taken,
+// or gives NO_ENCODING14 if there are too many already; encodings14Mutex
+// must be held.
+func insertEncoding14(factory Factory14, names []string, derived bool) (id Encoding14) {
+	id = Encoding14(len(encodings14) + 1)
+	if id == NO_ENCODING14 {
+		return
+	}
+	if encoding14NameMap == nil {
+		encoding14NameMap = make(map[string]Encoding14)
+	}
 	encodings14 = append(encodings14, &EncodingInfo[Encoding14, Factory14] {
 		id: id,
 		names: append([]string(nil), names...),
 		factory: factory,
+		derived: derived,
 	})
 	for _, name := range names {
-		encoding14NameMap[name] = id
+		encoding14NameMap[NormalizeEncodingName(name)] = id
 	}
-	encodings14Mutex.Unlock()
 	return
 }
 
@@ -139,27 +289,48 @@ func RegisterEncoding21(factory Factory21, names ...string) (id Encoding21) {
 		return
 	}
 	encodings21Mutex.Lock()
-	if encoding21NameMap == nil {
-		encoding21NameMap = make(map[string]Encoding21)
-	}
 	for _, name := range names {
-		if encoding21NameMap[name] != NO_ENCODING21 {
+		if encoding21NameTaken(name) {
+			encodings21Mutex.Unlock()
 			panic(fmt.Sprintf("Cannot register new Encoding21: Name '%s' is already registered", name))
 		}
 	}
-	id = Encoding21(len(encodings21) + 1)
-	if id == 0 {
+	id = insertEncoding21(factory, names, false)
+	encodings21Mutex.Unlock()
+	if id == NO_ENCODING21 {
 		panic("Too many Encoding21 instances registered")
 	}
+	deriveFromEncoding21(factory, names)
+	return
+}
+
+// encoding21NameTaken tells whether name belongs to an Encoding21 that was
+// not derived; encodings21Mutex must be held.
+func encoding21NameTaken(name string) bool {
+	existing := encoding21NameMap[NormalizeEncodingName(name)]
+	return existing != NO_ENCODING21 && !encodings21[existing - 1].derived
+}
+
+// insertEncoding21 adds an Encoding21 under names, which must not be taken,
+// or gives NO_ENCODING21 if there are too many already; encodings21Mutex
+// must be held.
+func insertEncoding21(factory Factory21, names []string, derived bool) (id Encoding21) {
+	id = Encoding21(len(encodings21) + 1)
+	if id == NO_ENCODING21 {
+		return
+	}
+	if encoding21NameMap == nil {
+		encoding21NameMap = make(map[string]Encoding21)
+	}
 	encodings21 = append(encodings21, &EncodingInfo[Encoding21, Factory21] {
 		id: id,
 		names: append([]string(nil), names...),
 		factory: factory,
+		derived: derived,
 	})
 	for _, name := range names {
-		encoding21NameMap[name] = id
+		encoding21NameMap[NormalizeEncodingName(name)] = id
 	}
-	encodings21Mutex.Unlock()
 	return
 }
 
@@ -168,27 +339,48 @@ func RegisterEncoding24(factory Factory24, names ...string) (id Encoding24) {
 		return
 	}
 	encodings24Mutex.Lock()
-	if encoding24NameMap == nil {
-		encoding24NameMap = make(map[string]Encoding24)
-	}
 	for _, name := range names {
-		if encoding24NameMap[name] != NO_ENCODING24 {
+		if encoding24NameTaken(name) {
+			encodings24Mutex.Unlock()
 			panic(fmt.Sprintf("Cannot register new Encoding24: Name '%s' is already registered", name))
 		}
 	}
-	id = Encoding24(len(encodings24) + 1)
-	if id == 0 {
+	id = insertEncoding24(factory, names, false)
+	encodings24Mutex.Unlock()
+	if id == NO_ENCODING24 {
 		panic("Too many Encoding24 instances registered")
 	}
+	deriveFromEncoding24(factory, names)
+	return
+}
+
+// encoding24NameTaken tells whether name belongs to an Encoding24 that was
+// not derived; encodings24Mutex must be held.
+func encoding24NameTaken(name string) bool {
+	existing := encoding24NameMap[NormalizeEncodingName(name)]
+	return existing != NO_ENCODING24 && !encodings24[existing - 1].derived
+}
+
+// insertEncoding24 adds an Encoding24 under names, which must not be taken,
+// or gives NO_ENCODING24 if there are too many already; encodings24Mutex
+// must be held.
+func insertEncoding24(factory Factory24, names []string, derived bool) (id Encoding24) {
+	id = Encoding24(len(encodings24) + 1)
+	if id == NO_ENCODING24 {
+		return
+	}
+	if encoding24NameMap == nil {
+		encoding24NameMap = make(map[string]Encoding24)
+	}
 	encodings24 = append(encodings24, &EncodingInfo[Encoding24, Factory24] {
 		id: id,
 		names: append([]string(nil), names...),
 		factory: factory,
+		derived: derived,
 	})
 	for _, name := range names {
-		encoding24NameMap[name] = id
+		encoding24NameMap[NormalizeEncodingName(name)] = id
 	}
-	encodings24Mutex.Unlock()
 	return
 }
 
@@ -197,27 +389,48 @@ func RegisterEncoding41(factory Factory41, names ...string) (id Encoding41) {
 		return
 	}
 	encodings41Mutex.Lock()
-	if encoding41NameMap == nil {
-		encoding41NameMap = make(map[string]Encoding41)
-	}
 	for _, name := range names {
-		if encoding41NameMap[name] != NO_ENCODING41 {
+		if encoding41NameTaken(name) {
+			encodings41Mutex.Unlock()
 			panic(fmt.Sprintf("Cannot register new Encoding41: Name '%s' is already registered", name))
 		}
 	}
-	id = Encoding41(len(encodings41) + 1)
-	if id == 0 {
+	id = insertEncoding41(factory, names, false)
+	encodings41Mutex.Unlock()
+	if id == NO_ENCODING41 {
 		panic("Too many Encoding41 instances registered")
 	}
+	deriveFromEncoding41(factory, names)
+	return
+}
+
+// encoding41NameTaken tells whether name belongs to an Encoding41 that was
+// not derived; encodings41Mutex must be held.
+func encoding41NameTaken(name string) bool {
+	existing := encoding41NameMap[NormalizeEncodingName(name)]
+	return existing != NO_ENCODING41 && !encodings41[existing - 1].derived
+}
+
+// insertEncoding41 adds an Encoding41 under names, which must not be taken,
+// or gives NO_ENCODING41 if there are too many already; encodings41Mutex
+// must be held.
+func insertEncoding41(factory Factory41, names []string, derived bool) (id Encoding41) {
+	id = Encoding41(len(encodings41) + 1)
+	if id == NO_ENCODING41 {
+		return
+	}
+	if encoding41NameMap == nil {
+		encoding41NameMap = make(map[string]Encoding41)
+	}
 	encodings41 = append(encodings41, &EncodingInfo[Encoding41, Factory41] {
 		id: id,
 		names: append([]string(nil), names...),
 		factory: factory,
+		derived: derived,
 	})
 	for _, name := range names {
-		encoding41NameMap[name] = id
+		encoding41NameMap[NormalizeEncodingName(name)] = id
 	}
-	encodings41Mutex.Unlock()
 	return
 }
 
@@ -226,68 +439,532 @@ func RegisterEncoding42(factory Factory42, names ...string) (id Encoding42) {
 		return
 	}
 	encodings42Mutex.Lock()
-	if encoding42NameMap == nil {
-		encoding42NameMap = make(map[string]Encoding42)
-	}
 	for _, name := range names {
-		if encoding42NameMap[name] != NO_ENCODING42 {
+		if encoding42NameTaken(name) {
+			encodings42Mutex.Unlock()
 			panic(fmt.Sprintf("Cannot register new Encoding42: Name '%s' is already registered", name))
 		}
 	}
-	id = Encoding42(len(encodings42) + 1)
-	if id == 0 {
+	id = insertEncoding42(factory, names, false)
+	encodings42Mutex.Unlock()
+	if id == NO_ENCODING42 {
 		panic("Too many Encoding42 instances registered")
 	}
+	deriveFromEncoding42(factory, names)
+	return
+}
+
+// encoding42NameTaken tells whether name belongs to an Encoding42 that was
+// not derived; encodings42Mutex must be held.
+func encoding42NameTaken(name string) bool {
+	existing := encoding42NameMap[NormalizeEncodingName(name)]
+	return existing != NO_ENCODING42 && !encodings42[existing - 1].derived
+}
+
+// insertEncoding42 adds an Encoding42 under names, which must not be taken,
+// or gives NO_ENCODING42 if there are too many already; encodings42Mutex
+// must be held.
+func insertEncoding42(factory Factory42, names []string, derived bool) (id Encoding42) {
+	id = Encoding42(len(encodings42) + 1)
+	if id == NO_ENCODING42 {
+		return
+	}
+	if encoding42NameMap == nil {
+		encoding42NameMap = make(map[string]Encoding42)
+	}
 	encodings42 = append(encodings42, &EncodingInfo[Encoding42, Factory42] {
 		id: id,
 		names: append([]string(nil), names...),
 		factory: factory,
+		derived: derived,
 	})
 	for _, name := range names {
-		encoding42NameMap[name] = id
+		encoding42NameMap[NormalizeEncodingName(name)] = id
+	}
+	return
+}
+
+func RegisterEncoding44(factory Factory44, names ...string) (id Encoding44) {
+	if factory == nil {
+		return
+	}
+	encodings44Mutex.Lock()
+	for _, name := range names {
+		if encoding44NameTaken(name) {
+			encodings44Mutex.Unlock()
+			panic(fmt.Sprintf("Cannot register new Encoding44: Name '%s' is already registered", name))
+		}
+	}
+	id = insertEncoding44(factory, names, false)
+	encodings44Mutex.Unlock()
+	if id == NO_ENCODING44 {
+		panic("Too many Encoding44 instances registered")
+	}
+	deriveFromEncoding44(factory, names)
+	return
+}
+
+// encoding44NameTaken tells whether name belongs to an Encoding44 that was
+// not derived; encodings44Mutex must be held.
+func encoding44NameTaken(name string) bool {
+	existing := encoding44NameMap[NormalizeEncodingName(name)]
+	return existing != NO_ENCODING44 && !encodings44[existing - 1].derived
+}
+
+// insertEncoding44 adds an Encoding44 under names, which must not be taken,
+// or gives NO_ENCODING44 if there are too many already; encodings44Mutex
+// must be held.
+func insertEncoding44(factory Factory44, names []string, derived bool) (id Encoding44) {
+	id = Encoding44(len(encodings44) + 1)
+	if id == NO_ENCODING44 {
+		return
+	}
+	if encoding44NameMap == nil {
+		encoding44NameMap = make(map[string]Encoding44)
+	}
+	encodings44 = append(encodings44, &EncodingInfo[Encoding44, Factory44] {
+		id: id,
+		names: append([]string(nil), names...),
+		factory: factory,
+		derived: derived,
+	})
+	for _, name := range names {
+		encoding44NameMap[NormalizeEncodingName(name)] = id
 	}
-	encodings42Mutex.Unlock()
+	return
+}
+
+func NewCodec11(id Encoding11) (codec Codec[byte, byte]) {
+	encodings11Mutex.Lock()
+	var info *EncodingInfo[Encoding11, Factory11]
+	if id > NO_ENCODING11 && id <= Encoding11(len(encodings11)) {
+		info = encodings11[id - 1]
+	}
+	encodings11Mutex.Unlock()
+	if info != nil {
+		codec = info.factory()
+	}
+	return
+}
+
+// Info gives the registry entry of the encoding, or nil if there is none.
+func(id Encoding11) Info() (info *EncodingInfo[Encoding11, Factory11]) {
+	encodings11Mutex.Lock()
+	if id > NO_ENCODING11 && id <= Encoding11(len(encodings11)) {
+		info = encodings11[id - 1]
+	}
+	encodings11Mutex.Unlock()
 	return
 }
 
 func NewCodec12(id Encoding12) (codec Codec[byte, uint16]) {
-	if id < Encoding12(len(encodings12)) {
-		codec = encodings12[id].factory()
+	encodings12Mutex.Lock()
+	var info *EncodingInfo[Encoding12, Factory12]
+	if id > NO_ENCODING12 && id <= Encoding12(len(encodings12)) {
+		info = encodings12[id - 1]
+	}
+	encodings12Mutex.Unlock()
+	if info != nil {
+		codec = info.factory()
 	}
 	return
 }
 
+// Info gives the registry entry of the encoding, or nil if there is none.
+func(id Encoding12) Info() (info *EncodingInfo[Encoding12, Factory12]) {
+	encodings12Mutex.Lock()
+	if id > NO_ENCODING12 && id <= Encoding12(len(encodings12)) {
+		info = encodings12[id - 1]
+	}
+	encodings12Mutex.Unlock()
+	return
+}
+
 func NewCodec14(id Encoding14) (codec Codec[byte, rune]) {
-	if id < Encoding14(len(encodings14)) {
-		codec = encodings14[id].factory()
+	encodings14Mutex.Lock()
+	var info *EncodingInfo[Encoding14, Factory14]
+	if id > NO_ENCODING14 && id <= Encoding14(len(encodings14)) {
+		info = encodings14[id - 1]
+	}
+	encodings14Mutex.Unlock()
+	if info != nil {
+		codec = info.factory()
+	}
+	return
+}
+
+// Info gives the registry entry of the encoding, or nil if there is none.
+func(id Encoding14) Info() (info *EncodingInfo[Encoding14, Factory14]) {
+	encodings14Mutex.Lock()
+	if id > NO_ENCODING14 && id <= Encoding14(len(encodings14)) {
+		info = encodings14[id - 1]
 	}
+	encodings14Mutex.Unlock()
 	return
 }
 
 func NewCodec21(id Encoding21) (codec Codec[uint16, byte]) {
-	if id < Encoding21(len(encodings21)) {
-		codec = encodings21[id].factory()
+	encodings21Mutex.Lock()
+	var info *EncodingInfo[Encoding21, Factory21]
+	if id > NO_ENCODING21 && id <= Encoding21(len(encodings21)) {
+		info = encodings21[id - 1]
+	}
+	encodings21Mutex.Unlock()
+	if info != nil {
+		codec = info.factory()
 	}
 	return
 }
 
+// Info gives the registry entry of the encoding, or nil if there is none.
+func(id Encoding21) Info() (info *EncodingInfo[Encoding21, Factory21]) {
+	encodings21Mutex.Lock()
+	if id > NO_ENCODING21 && id <= Encoding21(len(encodings21)) {
+		info = encodings21[id - 1]
+	}
+	encodings21Mutex.Unlock()
+	return
+}
+
 func NewCodec24(id Encoding24) (codec Codec[uint16, rune]) {
-	if id < Encoding24(len(encodings24)) {
-		codec = encodings24[id].factory()
+	encodings24Mutex.Lock()
+	var info *EncodingInfo[Encoding24, Factory24]
+	if id > NO_ENCODING24 && id <= Encoding24(len(encodings24)) {
+		info = encodings24[id - 1]
+	}
+	encodings24Mutex.Unlock()
+	if info != nil {
+		codec = info.factory()
 	}
 	return
 }
 
+// Info gives the registry entry of the encoding, or nil if there is none.
+func(id Encoding24) Info() (info *EncodingInfo[Encoding24, Factory24]) {
+	encodings24Mutex.Lock()
+	if id > NO_ENCODING24 && id <= Encoding24(len(encodings24)) {
+		info = encodings24[id - 1]
+	}
+	encodings24Mutex.Unlock()
+	return
+}
+
 func NewCodec41(id Encoding41) (codec Codec[rune, byte]) {
-	if id < Encoding41(len(encodings41)) {
-		codec = encodings41[id].factory()
+	encodings41Mutex.Lock()
+	var info *EncodingInfo[Encoding41, Factory41]
+	if id > NO_ENCODING41 && id <= Encoding41(len(encodings41)) {
+		info = encodings41[id - 1]
+	}
+	encodings41Mutex.Unlock()
+	if info != nil {
+		codec = info.factory()
 	}
 	return
 }
 
+// Info gives the registry entry of the encoding, or nil if there is none.
+func(id Encoding41) Info() (info *EncodingInfo[Encoding41, Factory41]) {
+	encodings41Mutex.Lock()
+	if id > NO_ENCODING41 && id <= Encoding41(len(encodings41)) {
+		info = encodings41[id - 1]
+	}
+	encodings41Mutex.Unlock()
+	return
+}
+
 func NewCodec42(id Encoding42) (codec Codec[rune, uint16]) {
-	if id < Encoding42(len(encodings42)) {
-		codec = encodings42[id].factory()
+	encodings42Mutex.Lock()
+	var info *EncodingInfo[Encoding42, Factory42]
+	if id > NO_ENCODING42 && id <= Encoding42(len(encodings42)) {
+		info = encodings42[id - 1]
+	}
+	encodings42Mutex.Unlock()
+	if info != nil {
+		codec = info.factory()
+	}
+	return
+}
+
+// Info gives the registry entry of the encoding, or nil if there is none.
+func(id Encoding42) Info() (info *EncodingInfo[Encoding42, Factory42]) {
+	encodings42Mutex.Lock()
+	if id > NO_ENCODING42 && id <= Encoding42(len(encodings42)) {
+		info = encodings42[id - 1]
+	}
+	encodings42Mutex.Unlock()
+	return
+}
+
+func NewCodec44(id Encoding44) (codec Codec[rune, rune]) {
+	encodings44Mutex.Lock()
+	var info *EncodingInfo[Encoding44, Factory44]
+	if id > NO_ENCODING44 && id <= Encoding44(len(encodings44)) {
+		info = encodings44[id - 1]
+	}
+	encodings44Mutex.Unlock()
+	if info != nil {
+		codec = info.factory()
+	}
+	return
+}
+
+// Info gives the registry entry of the encoding, or nil if there is none.
+func(id Encoding44) Info() (info *EncodingInfo[Encoding44, Factory44]) {
+	encodings44Mutex.Lock()
+	if id > NO_ENCODING44 && id <= Encoding44(len(encodings44)) {
+		info = encodings44[id - 1]
 	}
+	encodings44Mutex.Unlock()
+	return
+}
+
+// LookupEncoding11 finds the Encoding11 known as name, as per
+// NormalizeEncodingName.
+func LookupEncoding11(name string) (id Encoding11) {
+	encodings11Mutex.Lock()
+	id = encoding11NameMap[NormalizeEncodingName(name)]
+	encodings11Mutex.Unlock()
 	return
 }
+
+// LookupEncoding12 finds the Encoding12 known as name, as per
+// NormalizeEncodingName.
+func LookupEncoding12(name string) (id Encoding12) {
+	encodings12Mutex.Lock()
+	id = encoding12NameMap[NormalizeEncodingName(name)]
+	encodings12Mutex.Unlock()
+	return
+}
+
+// LookupEncoding14 finds the Encoding14 known as name, as per
+// NormalizeEncodingName.
+func LookupEncoding14(name string) (id Encoding14) {
+	encodings14Mutex.Lock()
+	id = encoding14NameMap[NormalizeEncodingName(name)]
+	encodings14Mutex.Unlock()
+	return
+}
+
+// LookupEncoding21 finds the Encoding21 known as name, as per
+// NormalizeEncodingName.
+func LookupEncoding21(name string) (id Encoding21) {
+	encodings21Mutex.Lock()
+	id = encoding21NameMap[NormalizeEncodingName(name)]
+	encodings21Mutex.Unlock()
+	return
+}
+
+// LookupEncoding24 finds the Encoding24 known as name, as per
+// NormalizeEncodingName.
+func LookupEncoding24(name string) (id Encoding24) {
+	encodings24Mutex.Lock()
+	id = encoding24NameMap[NormalizeEncodingName(name)]
+	encodings24Mutex.Unlock()
+	return
+}
+
+// LookupEncoding41 finds the Encoding41 known as name, as per
+// NormalizeEncodingName.
+func LookupEncoding41(name string) (id Encoding41) {
+	encodings41Mutex.Lock()
+	id = encoding41NameMap[NormalizeEncodingName(name)]
+	encodings41Mutex.Unlock()
+	return
+}
+
+// LookupEncoding42 finds the Encoding42 known as name, as per
+// NormalizeEncodingName.
+func LookupEncoding42(name string) (id Encoding42) {
+	encodings42Mutex.Lock()
+	id = encoding42NameMap[NormalizeEncodingName(name)]
+	encodings42Mutex.Unlock()
+	return
+}
+
+// LookupEncoding44 finds the Encoding44 known as name, as per
+// NormalizeEncodingName.
+func LookupEncoding44(name string) (id Encoding44) {
+	encodings44Mutex.Lock()
+	id = encoding44NameMap[NormalizeEncodingName(name)]
+	encodings44Mutex.Unlock()
+	return
+}
+
+// deriveEncoding12 registers factory as a derived Encoding12 under those of
+// names that are not taken yet, if any.
+func deriveEncoding12(factory Factory12, names []string) {
+	encodings12Mutex.Lock()
+	var free []string
+	for _, name := range names {
+		if encoding12NameMap[NormalizeEncodingName(name)] == NO_ENCODING12 {
+			free = append(free, name)
+		}
+	}
+	if len(free) > 0 {
+		insertEncoding12(factory, free, true)
+	}
+	encodings12Mutex.Unlock()
+}
+
+// deriveEncoding14 registers factory as a derived Encoding14 under those of
+// names that are not taken yet, if any.
+func deriveEncoding14(factory Factory14, names []string) {
+	encodings14Mutex.Lock()
+	var free []string
+	for _, name := range names {
+		if encoding14NameMap[NormalizeEncodingName(name)] == NO_ENCODING14 {
+			free = append(free, name)
+		}
+	}
+	if len(free) > 0 {
+		insertEncoding14(factory, free, true)
+	}
+	encodings14Mutex.Unlock()
+}
+
+// deriveEncoding21 registers factory as a derived Encoding21 under those of
+// names that are not taken yet, if any.
+func deriveEncoding21(factory Factory21, names []string) {
+	encodings21Mutex.Lock()
+	var free []string
+	for _, name := range names {
+		if encoding21NameMap[NormalizeEncodingName(name)] == NO_ENCODING21 {
+			free = append(free, name)
+		}
+	}
+	if len(free) > 0 {
+		insertEncoding21(factory, free, true)
+	}
+	encodings21Mutex.Unlock()
+}
+
+// deriveEncoding24 registers factory as a derived Encoding24 under those of
+// names that are not taken yet, if any.
+func deriveEncoding24(factory Factory24, names []string) {
+	encodings24Mutex.Lock()
+	var free []string
+	for _, name := range names {
+		if encoding24NameMap[NormalizeEncodingName(name)] == NO_ENCODING24 {
+			free = append(free, name)
+		}
+	}
+	if len(free) > 0 {
+		insertEncoding24(factory, free, true)
+	}
+	encodings24Mutex.Unlock()
+}
+
+// deriveEncoding41 registers factory as a derived Encoding41 under those of
+// names that are not taken yet, if any.
+func deriveEncoding41(factory Factory41, names []string) {
+	encodings41Mutex.Lock()
+	var free []string
+	for _, name := range names {
+		if encoding41NameMap[NormalizeEncodingName(name)] == NO_ENCODING41 {
+			free = append(free, name)
+		}
+	}
+	if len(free) > 0 {
+		insertEncoding41(factory, free, true)
+	}
+	encodings41Mutex.Unlock()
+}
+
+// deriveEncoding42 registers factory as a derived Encoding42 under those of
+// names that are not taken yet, if any.
+func deriveEncoding42(factory Factory42, names []string) {
+	encodings42Mutex.Lock()
+	var free []string
+	for _, name := range names {
+		if encoding42NameMap[NormalizeEncodingName(name)] == NO_ENCODING42 {
+			free = append(free, name)
+		}
+	}
+	if len(free) > 0 {
+		insertEncoding42(factory, free, true)
+	}
+	encodings42Mutex.Unlock()
+}
+
+// deriveEncoding44 registers factory as a derived Encoding44 under those of
+// names that are not taken yet, if any.
+func deriveEncoding44(factory Factory44, names []string) {
+	encodings44Mutex.Lock()
+	var free []string
+	for _, name := range names {
+		if encoding44NameMap[NormalizeEncodingName(name)] == NO_ENCODING44 {
+			free = append(free, name)
+		}
+	}
+	if len(free) > 0 {
+		insertEncoding44(factory, free, true)
+	}
+	encodings44Mutex.Unlock()
+}
+
+// deriveFromEncoding12 derives the Encoding14 of the Encoding12 made by
+// factory under names.
+func deriveFromEncoding12(factory Factory12, names []string) {
+	deriveEncoding14(func() Codec[byte, rune] {
+		return Chain[byte, uint16, rune](factory(), &UnitConverter[uint16, rune]{})
+	}, names)
+}
+
+// deriveFromEncoding14 derives the Encoding12 of the Encoding14 made by
+// factory under names.
+func deriveFromEncoding14(factory Factory14, names []string) {
+	deriveEncoding12(func() Codec[byte, uint16] {
+		return Chain[byte, rune, uint16](factory(), &UnitConverter[rune, uint16]{})
+	}, names)
+}
+
+// deriveFromEncoding21 derives the Encoding41 of the Encoding21 made by
+// factory under names.
+func deriveFromEncoding21(factory Factory21, names []string) {
+	deriveEncoding41(func() Codec[rune, byte] {
+		return Chain[rune, uint16, byte](&UnitConverter[rune, uint16]{}, factory())
+	}, names)
+}
+
+// deriveFromEncoding24 derives the Encoding44 and Encoding42 of the
+// Encoding24 made by factory under names.
+func deriveFromEncoding24(factory Factory24, names []string) {
+	transform := func() Codec[rune, rune] {
+		return Chain[rune, uint16, rune](&UnitConverter[rune, uint16]{}, factory())
+	}
+	deriveEncoding44(transform, names)
+	deriveEncoding42(func() Codec[rune, uint16] {
+		return Chain[rune, rune, uint16](transform(), &UnitConverter[rune, uint16]{})
+	}, names)
+}
+
+// deriveFromEncoding41 derives the Encoding21 of the Encoding41 made by
+// factory under names.
+func deriveFromEncoding41(factory Factory41, names []string) {
+	deriveEncoding21(func() Codec[uint16, byte] {
+		return Chain[uint16, rune, byte](&UnitConverter[uint16, rune]{}, factory())
+	}, names)
+}
+
+// deriveFromEncoding42 derives the Encoding44 and Encoding24 of the
+// Encoding42 made by factory under names.
+func deriveFromEncoding42(factory Factory42, names []string) {
+	transform := func() Codec[rune, rune] {
+		return Chain[rune, uint16, rune](factory(), &UnitConverter[uint16, rune]{})
+	}
+	deriveEncoding44(transform, names)
+	deriveEncoding24(func() Codec[uint16, rune] {
+		return Chain[uint16, rune, rune](&UnitConverter[uint16, rune]{}, transform())
+	}, names)
+}
+
+// deriveFromEncoding44 derives the Encoding24 and Encoding42 of the
+// Encoding44 made by factory under names.
+func deriveFromEncoding44(factory Factory44, names []string) {
+	deriveEncoding24(func() Codec[uint16, rune] {
+		return Chain[uint16, rune, rune](&UnitConverter[uint16, rune]{}, factory())
+	}, names)
+	deriveEncoding42(func() Codec[rune, uint16] {
+		return Chain[rune, rune, uint16](factory(), &UnitConverter[rune, uint16]{})
+	}, names)
+}
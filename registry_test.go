@@ -0,0 +1,260 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+// scopeRegistry makes the registry forget whatever t registers once t is
+// done, so that the names of one test do not leak into the next.
+func scopeRegistry(t *testing.T) {
+	lockTestRegistry()
+	restores := []func() {
+		saveTestSlice(&encodings11), saveTestMap(&encoding11NameMap),
+		saveTestSlice(&encodings12), saveTestMap(&encoding12NameMap),
+		saveTestSlice(&encodings14), saveTestMap(&encoding14NameMap),
+		saveTestSlice(&encodings21), saveTestMap(&encoding21NameMap),
+		saveTestSlice(&encodings24), saveTestMap(&encoding24NameMap),
+		saveTestSlice(&encodings41), saveTestMap(&encoding41NameMap),
+		saveTestSlice(&encodings42), saveTestMap(&encoding42NameMap),
+		saveTestSlice(&encodings44), saveTestMap(&encoding44NameMap),
+		saveTestSlice(&charsets), saveTestMap(&charsetNameMap),
+		saveTestMap(&conversions),
+	}
+	unlockTestRegistry()
+	t.Cleanup(func() {
+		lockTestRegistry()
+		for _, restore := range restores {
+			restore()
+		}
+		unlockTestRegistry()
+	})
+}
+
+func lockTestRegistry() {
+	charsetsMutex.Lock()
+	encodings11Mutex.Lock()
+	encodings12Mutex.Lock()
+	encodings14Mutex.Lock()
+	encodings21Mutex.Lock()
+	encodings24Mutex.Lock()
+	encodings41Mutex.Lock()
+	encodings42Mutex.Lock()
+	encodings44Mutex.Lock()
+	conversionsMutex.Lock()
+}
+
+func unlockTestRegistry() {
+	conversionsMutex.Unlock()
+	encodings44Mutex.Unlock()
+	encodings42Mutex.Unlock()
+	encodings41Mutex.Unlock()
+	encodings24Mutex.Unlock()
+	encodings21Mutex.Unlock()
+	encodings14Mutex.Unlock()
+	encodings12Mutex.Unlock()
+	encodings11Mutex.Unlock()
+	charsetsMutex.Unlock()
+}
+
+// saveTestSlice copies *slice and gives a function putting the copy back.
+func saveTestSlice[T any](slice *[]T) func() {
+	saved := append([]T(nil), *slice...)
+	return func() {
+		*slice = saved
+	}
+}
+
+// saveTestMap copies *m and gives a function putting the copy back.
+func saveTestMap[K comparable, V any](m *map[K]V) func() {
+	var saved map[K]V
+	if *m != nil {
+		saved = make(map[K]V, len(*m))
+		for key, value := range *m {
+			saved[key] = value
+		}
+	}
+	return func() {
+		*m = saved
+	}
+}
+
+func TestDeriveFromRunes(t *testing.T) {
+	scopeRegistry(t)
+	RegisterEncoding14(func() Codec[byte, rune] {
+		return &UTF8Decoder[rune]{}
+	}, "x-test-derive-14")
+	RegisterEncoding41(func() Codec[rune, byte] {
+		return &UTF8Encoder[rune]{}
+	}, "x-test-derive-41")
+	// derived on registration, so that the new ID is there already
+	decoder := Encoding12(len(encodings12))
+	if info := decoder.Info(); info == nil || !info.derived || info.Names()[0] != "x-test-derive-14" {
+		t.Fatalf("no Encoding12 derived")
+	}
+	if LookupEncoding12("X_Test_Derive_14") != decoder {
+		t.Errorf("derived Encoding12 not registered under its name")
+	}
+	checkTranscode[byte, uint16](t, NewCodec12(decoder), []byte("a😀\xFF"), []uint16 {'a', 0xD83D, 0xDE00, 0xFFFD}, 5)
+	encoder := LookupEncoding21("x-test-derive-41")
+	if encoder == NO_ENCODING21 {
+		t.Fatalf("no Encoding21 derived")
+	}
+	checkTranscode[uint16, byte](t, NewCodec21(encoder), []uint16 {'a', 0xD83D, 0xDE00}, []byte("a😀"))
+	// an unpaired surrogate is reported at its offset in the UTF-16 input
+	checkTranscode[uint16, byte](t, NewCodec21(encoder), []uint16 {'a', 0xD83D, 'b'}, []byte("a\xEF\xBF\xBDb"), 1)
+	if LookupEncoding12("x-test-derive-41") != NO_ENCODING12 || LookupEncoding41("x-test-derive-14") != NO_ENCODING41 {
+		t.Errorf("directions derived across decoding and encoding")
+	}
+}
+
+func TestDeriveFromUnits(t *testing.T) {
+	scopeRegistry(t)
+	RegisterEncoding12(func() Codec[byte, uint16] {
+		return &UTF8Decoder[uint16]{}
+	}, "x-test-derive-12")
+	RegisterEncoding21(func() Codec[uint16, byte] {
+		return &UTF8Encoder[uint16]{}
+	}, "x-test-derive-21")
+	decoder := NewCodec14(LookupEncoding14("x-test-derive-12"))
+	if decoder == nil {
+		t.Fatalf("no Encoding14 derived")
+	}
+	checkTranscode[byte, rune](t, decoder, []byte("a😀\xFF"), []rune("a😀�"), 5)
+	encoder := NewCodec41(LookupEncoding41("x-test-derive-21"))
+	if encoder == nil {
+		t.Fatalf("no Encoding41 derived")
+	}
+	checkTranscode[rune, byte](t, encoder, []rune("a😀b"), []byte("a😀b"))
+}
+
+func TestDeriveTransforms(t *testing.T) {
+	scopeRegistry(t)
+	RegisterEncoding24(func() Codec[uint16, rune] {
+		return &UnitConverter[uint16, rune]{}
+	}, "x-test-derive-24")
+	RegisterEncoding42(func() Codec[rune, uint16] {
+		return &UnitConverter[rune, uint16]{}
+	}, "x-test-derive-42")
+	RegisterEncoding44(func() Codec[rune, rune] {
+		return &UnitConverter[rune, rune]{}
+	}, "x-test-derive-44")
+	for _, name := range []string {"x-test-derive-42", "x-test-derive-44"} {
+		checkTranscode[uint16, rune](
+			t,
+			NewCodec24(LookupEncoding24(name)),
+			[]uint16 {'a', 0xD83D, 0xDE00, 0xDE00},
+			[]rune("a😀�"),
+			3,
+		)
+	}
+	for _, name := range []string {"x-test-derive-24", "x-test-derive-44"} {
+		checkTranscode[rune, uint16](t, NewCodec42(LookupEncoding42(name)), []rune("a😀"), []uint16 {'a', 0xD83D, 0xDE00})
+	}
+	for _, name := range []string {"x-test-derive-24", "x-test-derive-42"} {
+		id := LookupEncoding44(name)
+		if info := id.Info(); info == nil || !info.derived {
+			t.Fatalf("no Encoding44 derived from %s", name)
+		}
+		checkTranscode[rune, rune](t, NewCodec44(id), []rune("a😀"), []rune("a😀"))
+	}
+}
+
+func TestDerivedSuperseded(t *testing.T) {
+	scopeRegistry(t)
+	RegisterEncoding14(func() Codec[byte, rune] {
+		return &UTF8Decoder[rune]{}
+	}, "x-test-supersede")
+	derived := LookupEncoding12("x-test-supersede")
+	if derived == NO_ENCODING12 || !derived.Info().derived {
+		t.Fatalf("no Encoding12 derived")
+	}
+	// registering under the name of a derived entry is no collision
+	proper := RegisterEncoding12(func() Codec[byte, uint16] {
+		return &UTF8Decoder[uint16]{}
+	}, "x-test-supersede")
+	if proper == derived || LookupEncoding12("x-test-supersede") != proper {
+		t.Errorf("derived Encoding12 not superseded")
+	}
+}
+
+func TestUnitConverter(t *testing.T) {
+	checkTranscode[rune, uint16](t, &UnitConverter[rune, uint16]{}, []rune("a😀"), []uint16 {'a', 0xD83D, 0xDE00})
+	checkTranscode[uint16, rune](t, &UnitConverter[uint16, rune]{}, []uint16 {0xD83D, 0xDE00, 'a'}, []rune("😀a"))
+	checkTranscode[uint16, rune](t, &UnitConverter[uint16, rune]{}, []uint16 {'a', 0xDE00, 0xD83D}, []rune("a��"), 1, 2)
+}
+
+func TestEncoding44(t *testing.T) {
+	scopeRegistry(t)
+	id := RegisterEncoding44(func() Codec[rune, rune] {
+		return &UnitConverter[rune, rune]{}
+	}, "x-test-identity", "x-test-same")
+	if LookupEncoding44("X_TEST_SAME") != id || !equalSlices(id.Info().Names(), []string {"x-test-identity", "x-test-same"}) {
+		t.Errorf("Encoding44 not registered under its names")
+	}
+	if LookupEncoding44("x-test-no-such-transform") != NO_ENCODING44 || NewCodec44(NO_ENCODING44) != nil {
+		t.Errorf("unregistered Encoding44 found")
+	}
+	transform := NewCodec44(id)
+	checkTranscode(t, transform, []rune("a😀"), []rune("a😀"))
+	checkTranscode(t, transform, []rune {'a', 0xD800, 'b'}, []rune("a�b"), 1)
+	// put between a decoder and an encoder
+	codec := Chain(Chain(newTestDecoder(t, "UTF-8"), NewCodec44(id)), newTestEncoder(t, "ISO-8859-1"))
+	checkTranscodeBy(t, codec, 1, []byte("é€a"), []byte("\xE9\x00a"), 2)
+}
+
+func TestNormalizeEncodingName(t *testing.T) {
+	for _, pair := range [][2]string {
+		{"UTF-8", "utf8"},
+		{"Utf_08", "utf8"},
+		{"ISO-8859-01", "iso88591"},
+		{"ISO_8859-1:1987", "iso885911987"},
+		{"windows-1250", "windows1250"},
+		{"ISO-2022-JP", "iso2022jp"},
+		{"x0", "x0"},
+		{"00", "0"},
+		{"100", "100"},
+		// KELVIN SIGN is not ASCII, so not a letter here
+		{"\u212Aoi8-r", "oi8r"},
+		{"ISO-8859-1>UTF-8", "iso88591utf8"},
+	} {
+		if got := NormalizeEncodingName(pair[0]); got != pair[1] {
+			t.Errorf("%q normalized to %q, want %q", pair[0], got, pair[1])
+		}
+	}
+}
+
+func TestLookupLoosely(t *testing.T) {
+	want := LookupEncoding14("UTF-8")
+	for _, name := range []string {"utf8", "Utf_8", "UTF 08", "csUTF8"} {
+		if LookupEncoding14(name) != want {
+			t.Errorf("%q does not find UTF-8", name)
+		}
+	}
+	if LookupEncoding41("latin1") != LookupEncoding41("ISO_8859-1") || LookupEncoding41("latin-01") == NO_ENCODING41 {
+		t.Errorf("latin1 does not find ISO-8859-1")
+	}
+	if LookupEncoding14("UTF-9") != NO_ENCODING14 {
+		t.Errorf("UTF-9 found")
+	}
+}
+
+func TestRegisterCollision(t *testing.T) {
+	scopeRegistry(t)
+	RegisterEncoding14(func() Codec[byte, rune] {
+		return &UTF8Decoder[rune]{}
+	}, "x-test-collision")
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("names equal after normalization registered twice")
+			}
+		}()
+		RegisterEncoding14(func() Codec[byte, rune] {
+			return &UTF8Decoder[rune]{}
+		}, "x-test-fresh-name", "X_TEST_COLLISION")
+	}()
+	// the registry is still usable, and the fresh name was not registered
+	if LookupEncoding14("x-test-fresh-name") != NO_ENCODING14 {
+		t.Errorf("colliding Encoding14 partially registered")
+	}
+}
@@ -0,0 +1,409 @@
+package gotextenc
+
+import (
+	"sync"
+)
+
+type ShiftJISVariant uint8
+
+const (
+	// JIS X 0201 and JIS X 0208 only
+	SJISVAR_SHIFT_JIS ShiftJISVariant = iota
+	// Windows-31J: NEC row 13, NEC-selected IBM extensions, IBM extensions and
+	// the user-defined area on top of SJISVAR_SHIFT_JIS
+	SJISVAR_CP932
+)
+
+// CP932Preference determines which code CP932Encoder emits for characters
+// that CP932 encodes several times. JIS X 0208 codes are always preferred.
+type CP932Preference uint8
+
+const (
+	// NEC row 13, then IBM extensions, then NEC-selected IBM extensions, as
+	// Windows does
+	CP932PREF_MICROSOFT CP932Preference = iota
+	// NEC row 13, then NEC-selected IBM extensions, then IBM extensions
+	CP932PREF_NEC
+	// IBM extensions, then NEC row 13, then NEC-selected IBM extensions
+	CP932PREF_IBM
+	cp932pref_COUNT
+)
+
+// cp932ExtensionLeads are the lead bytes whose codes are listed in
+// cp932Extensions: NEC row 13, the NEC-selected IBM extensions and the IBM
+// extensions.
+var cp932ExtensionLeads = [6]byte {0x87, 0xED, 0xEE, 0xFA, 0xFB, 0xFC}
+
+// cp932ExtensionOrders lists indices into cp932ExtensionLeads in order of
+// preference.
+var cp932ExtensionOrders = [cp932pref_COUNT][6]int {
+	CP932PREF_MICROSOFT: {0, 3, 4, 5, 1, 2},
+	CP932PREF_NEC: {0, 1, 2, 3, 4, 5},
+	CP932PREF_IBM: {3, 4, 5, 0, 1, 2},
+}
+
+// cp932Overrides are the codes that CP932 maps differently from JIS X 0208.
+var cp932Overrides = map[uint16]rune {
+	0x8160: 0xFF5E, // instead of WAVE DASH
+	0x8161: 0x2225, // instead of DOUBLE VERTICAL LINE
+	0x817C: 0xFF0D, // instead of MINUS SIGN
+	0x8191: 0xFFE0, // instead of CENT SIGN
+	0x8192: 0xFFE1, // instead of POUND SIGN
+	0x81CA: 0xFFE2, // instead of NOT SIGN
+}
+
+const (
+	cp932_USER_DEFINED_FIRST_LEAD byte = 0xF0
+	cp932_USER_DEFINED_LAST_LEAD byte = 0xF9
+)
+
+func(variant ShiftJISVariant) isLead(b byte) bool {
+	switch {
+		case b >= 0x81 && b <= 0x9F:
+			return true
+		case variant == SJISVAR_CP932:
+			return b >= 0xE0 && b <= 0xFC
+		default:
+			return b >= 0xE0 && b <= 0xEF
+	}
+}
+
+// decodeSingle maps the single byte b, if it stands on its own.
+func(variant ShiftJISVariant) decodeSingle(b byte) (rune, bool) {
+	switch {
+		case b < 0x80:
+			return rune(b), true
+		case b >= 0xA1 && b <= 0xDF:
+			// JIS X 0201 half-width katakana
+			return 0xFF61 + rune(b - 0xA1), true
+		case variant != SJISVAR_CP932:
+			return 0, false
+		case b == 0x80:
+			return 0x0080, true
+		case b == 0xA0:
+			return 0xF8F0, true
+		case b >= 0xFD:
+			return 0xF8F1 + rune(b - 0xFD), true
+		default:
+			return 0, false
+	}
+}
+
+// shiftJISTrailIndex numbers the trail bytes 0x40..0x7E and 0x80..0xFC
+// consecutively.
+func shiftJISTrailIndex(trail byte) (int, bool) {
+	switch {
+		case trail >= 0x40 && trail <= 0x7E:
+			return int(trail - 0x40), true
+		case trail >= 0x80 && trail <= 0xFC:
+			return int(trail - 0x41), true
+		default:
+			return 0, false
+	}
+}
+
+// shiftJISToJIS converts a lead byte and trail index to a JIS X 0208 row and
+// cell, counting from 1.
+func shiftJISToJIS(lead byte, trailIndex int) (row int, cell int) {
+	if lead >= 0xE0 {
+		lead -= 0x40
+	}
+	row = int(lead - 0x81) * 2 + 1 + trailIndex / 94
+	cell = trailIndex % 94 + 1
+	return
+}
+
+// jisToShiftJIS is the inverse of shiftJISToJIS.
+func jisToShiftJIS(row int, cell int) uint16 {
+	lead := (row - 1) / 2 + 0x81
+	if lead >= 0xA0 {
+		lead += 0x40
+	}
+	trailIndex := (row - 1) % 2 * 94 + cell - 1
+	trail := trailIndex + 0x40
+	if trail >= 0x7F {
+		trail++
+	}
+	return uint16(lead << 8 | trail)
+}
+
+// decodeDouble maps the double-byte code lead/trail; trail must be valid.
+func(variant ShiftJISVariant) decodeDouble(lead byte, trail byte) rune {
+	trailIndex, _ := shiftJISTrailIndex(trail)
+	if variant == SJISVAR_CP932 {
+		if lead >= cp932_USER_DEFINED_FIRST_LEAD && lead <= cp932_USER_DEFINED_LAST_LEAD {
+			return 0xE000 + rune(lead - cp932_USER_DEFINED_FIRST_LEAD) * 188 + rune(trailIndex)
+		}
+		for index, extensionLead := range cp932ExtensionLeads {
+			if lead == extensionLead {
+				return rune(cp932Extensions[index][trailIndex])
+			}
+		}
+		if char, found := cp932Overrides[uint16(lead) << 8 | uint16(trail)]; found {
+			return char
+		}
+	}
+	row, cell := shiftJISToJIS(lead, trailIndex)
+	if row > 94 {
+		return 0
+	}
+	return rune(jisX0208[(row - 1) * 94 + cell - 1])
+}
+
+var shiftJISEncodeOnce sync.Once
+var shiftJISEncodeMap map[rune]uint16
+var cp932EncodeOnces [cp932pref_COUNT]sync.Once
+var cp932EncodeMaps [cp932pref_COUNT]map[rune]uint16
+
+// addJISX0208 adds the JIS X 0208 codes to encodeMap.
+func addJISX0208(encodeMap map[rune]uint16) {
+	for index, char := range jisX0208 {
+		if char == 0 {
+			continue
+		}
+		code := jisToShiftJIS(index / 94 + 1, index % 94 + 1)
+		if _, present := encodeMap[rune(char)]; !present {
+			encodeMap[rune(char)] = code
+		}
+	}
+}
+
+func(variant ShiftJISVariant) encodeMap(preference CP932Preference) map[rune]uint16 {
+	if variant != SJISVAR_CP932 {
+		shiftJISEncodeOnce.Do(func() {
+			shiftJISEncodeMap = make(map[rune]uint16)
+			addJISX0208(shiftJISEncodeMap)
+		})
+		return shiftJISEncodeMap
+	}
+	if preference >= cp932pref_COUNT {
+		preference = CP932PREF_MICROSOFT
+	}
+	cp932EncodeOnces[preference].Do(func() {
+		encodeMap := make(map[rune]uint16)
+		for code, char := range cp932Overrides {
+			encodeMap[char] = code
+		}
+		// the JIS X 0208 characters replaced by cp932Overrides are still
+		// accepted for their codes
+		addJISX0208(encodeMap)
+		for _, index := range cp932ExtensionOrders[preference] {
+			lead := uint16(cp932ExtensionLeads[index])
+			for trailIndex, char := range cp932Extensions[index] {
+				if char == 0 {
+					continue
+				}
+				if _, present := encodeMap[rune(char)]; !present {
+					trail := uint16(trailIndex + 0x40)
+					if trail >= 0x7F {
+						trail++
+					}
+					encodeMap[rune(char)] = lead << 8 | trail
+				}
+			}
+		}
+		cp932EncodeMaps[preference] = encodeMap
+	})
+	return cp932EncodeMaps[preference]
+}
+
+// encodeSingle is the inverse of decodeSingle.
+func(variant ShiftJISVariant) encodeSingle(r rune) (byte, bool) {
+	switch {
+		case r < 0x80:
+			return byte(r), true
+		case r >= 0xFF61 && r <= 0xFF9F:
+			return byte(r - 0xFF61 + 0xA1), true
+		case variant != SJISVAR_CP932:
+			return 0, false
+		case r == 0x0080:
+			return 0x80, true
+		case r == 0xF8F0:
+			return 0xA0, true
+		case r >= 0xF8F1 && r <= 0xF8F3:
+			return byte(r - 0xF8F1 + 0xFD), true
+		default:
+			return 0, false
+	}
+}
+
+// encodeUserDefined maps the private use characters of the CP932 user-defined
+// area.
+func(variant ShiftJISVariant) encodeUserDefined(r rune) (uint16, bool) {
+	userDefinedSize := rune(cp932_USER_DEFINED_LAST_LEAD - cp932_USER_DEFINED_FIRST_LEAD + 1) * 188
+	if variant != SJISVAR_CP932 || r < 0xE000 || r >= 0xE000 + userDefinedSize {
+		return 0, false
+	}
+	lead := uint16(cp932_USER_DEFINED_FIRST_LEAD) + uint16(r - 0xE000) / 188
+	trail := uint16(r - 0xE000) % 188 + 0x40
+	if trail >= 0x7F {
+		trail++
+	}
+	return lead << 8 | trail, true
+}
+
+var shiftJISNames = []string {
+	"Shift_JIS",
+	"MS_Kanji",
+	"csShiftJIS",
+	"SJIS",
+	"Shift-JIS",
+}
+
+var cp932Names = []string {
+	"Windows-31J",
+	"csWindows31J",
+	"CP932",
+	"MS932",
+	"windows-932",
+}
+
+func init() {
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &ShiftJISDecoder[uint16]{}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &ShiftJISDecoder[rune]{}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &ShiftJISEncoder[uint16]{}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &ShiftJISEncoder[rune]{}
+		},
+	}, shiftJISNames...)
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &ShiftJISDecoder[uint16] {Variant: SJISVAR_CP932}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &ShiftJISDecoder[rune] {Variant: SJISVAR_CP932}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &ShiftJISEncoder[uint16] {Variant: SJISVAR_CP932}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &ShiftJISEncoder[rune] {Variant: SJISVAR_CP932}
+		},
+	}, cp932Names...)
+}
+
+// cp932Extensions maps the trail bytes (indexed as by shiftJISTrailIndex) after
+// the lead bytes in cp932ExtensionLeads.
+var cp932Extensions = [6][188]uint16 {
+	// lead byte 0x87
+	{
+		0x2460, 0x2461, 0x2462, 0x2463, 0x2464, 0x2465, 0x2466, 0x2467, 0x2468, 0x2469, 0x246A, 0x246B,
+		0x246C, 0x246D, 0x246E, 0x246F, 0x2470, 0x2471, 0x2472, 0x2473, 0x2160, 0x2161, 0x2162, 0x2163,
+		0x2164, 0x2165, 0x2166, 0x2167, 0x2168, 0x2169, 0x0000, 0x3349, 0x3314, 0x3322, 0x334D, 0x3318,
+		0x3327, 0x3303, 0x3336, 0x3351, 0x3357, 0x330D, 0x3326, 0x3323, 0x332B, 0x334A, 0x333B, 0x339C,
+		0x339D, 0x339E, 0x338E, 0x338F, 0x33C4, 0x33A1, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x337B, 0x301D, 0x301F, 0x2116, 0x33CD, 0x2121, 0x32A4, 0x32A5, 0x32A6, 0x32A7,
+		0x32A8, 0x3231, 0x3232, 0x3239, 0x337E, 0x337D, 0x337C, 0x2252, 0x2261, 0x222B, 0x222E, 0x2211,
+		0x221A, 0x22A5, 0x2220, 0x221F, 0x22BF, 0x2235, 0x2229, 0x222A, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	},
+	// lead byte 0xED
+	{
+		0x7E8A, 0x891C, 0x9348, 0x9288, 0x84DC, 0x4FC9, 0x70BB, 0x6631, 0x68C8, 0x92F9, 0x66FB, 0x5F45,
+		0x4E28, 0x4EE1, 0x4EFC, 0x4F00, 0x4F03, 0x4F39, 0x4F56, 0x4F92, 0x4F8A, 0x4F9A, 0x4F94, 0x4FCD,
+		0x5040, 0x5022, 0x4FFF, 0x501E, 0x5046, 0x5070, 0x5042, 0x5094, 0x50F4, 0x50D8, 0x514A, 0x5164,
+		0x519D, 0x51BE, 0x51EC, 0x5215, 0x529C, 0x52A6, 0x52C0, 0x52DB, 0x5300, 0x5307, 0x5324, 0x5372,
+		0x5393, 0x53B2, 0x53DD, 0xFA0E, 0x549C, 0x548A, 0x54A9, 0x54FF, 0x5586, 0x5759, 0x5765, 0x57AC,
+		0x57C8, 0x57C7, 0xFA0F, 0xFA10, 0x589E, 0x58B2, 0x590B, 0x5953, 0x595B, 0x595D, 0x5963, 0x59A4,
+		0x59BA, 0x5B56, 0x5BC0, 0x752F, 0x5BD8, 0x5BEC, 0x5C1E, 0x5CA6, 0x5CBA, 0x5CF5, 0x5D27, 0x5D53,
+		0xFA11, 0x5D42, 0x5D6D, 0x5DB8, 0x5DB9, 0x5DD0, 0x5F21, 0x5F34, 0x5F67, 0x5FB7, 0x5FDE, 0x605D,
+		0x6085, 0x608A, 0x60DE, 0x60D5, 0x6120, 0x60F2, 0x6111, 0x6137, 0x6130, 0x6198, 0x6213, 0x62A6,
+		0x63F5, 0x6460, 0x649D, 0x64CE, 0x654E, 0x6600, 0x6615, 0x663B, 0x6609, 0x662E, 0x661E, 0x6624,
+		0x6665, 0x6657, 0x6659, 0xFA12, 0x6673, 0x6699, 0x66A0, 0x66B2, 0x66BF, 0x66FA, 0x670E, 0xF929,
+		0x6766, 0x67BB, 0x6852, 0x67C0, 0x6801, 0x6844, 0x68CF, 0xFA13, 0x6968, 0xFA14, 0x6998, 0x69E2,
+		0x6A30, 0x6A6B, 0x6A46, 0x6A73, 0x6A7E, 0x6AE2, 0x6AE4, 0x6BD6, 0x6C3F, 0x6C5C, 0x6C86, 0x6C6F,
+		0x6CDA, 0x6D04, 0x6D87, 0x6D6F, 0x6D96, 0x6DAC, 0x6DCF, 0x6DF8, 0x6DF2, 0x6DFC, 0x6E39, 0x6E5C,
+		0x6E27, 0x6E3C, 0x6EBF, 0x6F88, 0x6FB5, 0x6FF5, 0x7005, 0x7007, 0x7028, 0x7085, 0x70AB, 0x710F,
+		0x7104, 0x715C, 0x7146, 0x7147, 0xFA15, 0x71C1, 0x71FE, 0x72B1,
+	},
+	// lead byte 0xEE
+	{
+		0x72BE, 0x7324, 0xFA16, 0x7377, 0x73BD, 0x73C9, 0x73D6, 0x73E3, 0x73D2, 0x7407, 0x73F5, 0x7426,
+		0x742A, 0x7429, 0x742E, 0x7462, 0x7489, 0x749F, 0x7501, 0x756F, 0x7682, 0x769C, 0x769E, 0x769B,
+		0x76A6, 0xFA17, 0x7746, 0x52AF, 0x7821, 0x784E, 0x7864, 0x787A, 0x7930, 0xFA18, 0xFA19, 0xFA1A,
+		0x7994, 0xFA1B, 0x799B, 0x7AD1, 0x7AE7, 0xFA1C, 0x7AEB, 0x7B9E, 0xFA1D, 0x7D48, 0x7D5C, 0x7DB7,
+		0x7DA0, 0x7DD6, 0x7E52, 0x7F47, 0x7FA1, 0xFA1E, 0x8301, 0x8362, 0x837F, 0x83C7, 0x83F6, 0x8448,
+		0x84B4, 0x8553, 0x8559, 0x856B, 0xFA1F, 0x85B0, 0xFA20, 0xFA21, 0x8807, 0x88F5, 0x8A12, 0x8A37,
+		0x8A79, 0x8AA7, 0x8ABE, 0x8ADF, 0xFA22, 0x8AF6, 0x8B53, 0x8B7F, 0x8CF0, 0x8CF4, 0x8D12, 0x8D76,
+		0xFA23, 0x8ECF, 0xFA24, 0xFA25, 0x9067, 0x90DE, 0xFA26, 0x9115, 0x9127, 0x91DA, 0x91D7, 0x91DE,
+		0x91ED, 0x91EE, 0x91E4, 0x91E5, 0x9206, 0x9210, 0x920A, 0x923A, 0x9240, 0x923C, 0x924E, 0x9259,
+		0x9251, 0x9239, 0x9267, 0x92A7, 0x9277, 0x9278, 0x92E7, 0x92D7, 0x92D9, 0x92D0, 0xFA27, 0x92D5,
+		0x92E0, 0x92D3, 0x9325, 0x9321, 0x92FB, 0xFA28, 0x931E, 0x92FF, 0x931D, 0x9302, 0x9370, 0x9357,
+		0x93A4, 0x93C6, 0x93DE, 0x93F8, 0x9431, 0x9445, 0x9448, 0x9592, 0xF9DC, 0xFA29, 0x969D, 0x96AF,
+		0x9733, 0x973B, 0x9743, 0x974D, 0x974F, 0x9751, 0x9755, 0x9857, 0x9865, 0xFA2A, 0xFA2B, 0x9927,
+		0xFA2C, 0x999E, 0x9A4E, 0x9AD9, 0x9ADC, 0x9B75, 0x9B72, 0x9B8F, 0x9BB1, 0x9BBB, 0x9C00, 0x9D70,
+		0x9D6B, 0xFA2D, 0x9E19, 0x9ED1, 0x0000, 0x0000, 0x2170, 0x2171, 0x2172, 0x2173, 0x2174, 0x2175,
+		0x2176, 0x2177, 0x2178, 0x2179, 0xFFE2, 0xFFE4, 0xFF07, 0xFF02,
+	},
+	// lead byte 0xFA
+	{
+		0x2170, 0x2171, 0x2172, 0x2173, 0x2174, 0x2175, 0x2176, 0x2177, 0x2178, 0x2179, 0x2160, 0x2161,
+		0x2162, 0x2163, 0x2164, 0x2165, 0x2166, 0x2167, 0x2168, 0x2169, 0xFFE2, 0xFFE4, 0xFF07, 0xFF02,
+		0x3231, 0x2116, 0x2121, 0x2235, 0x7E8A, 0x891C, 0x9348, 0x9288, 0x84DC, 0x4FC9, 0x70BB, 0x6631,
+		0x68C8, 0x92F9, 0x66FB, 0x5F45, 0x4E28, 0x4EE1, 0x4EFC, 0x4F00, 0x4F03, 0x4F39, 0x4F56, 0x4F92,
+		0x4F8A, 0x4F9A, 0x4F94, 0x4FCD, 0x5040, 0x5022, 0x4FFF, 0x501E, 0x5046, 0x5070, 0x5042, 0x5094,
+		0x50F4, 0x50D8, 0x514A, 0x5164, 0x519D, 0x51BE, 0x51EC, 0x5215, 0x529C, 0x52A6, 0x52C0, 0x52DB,
+		0x5300, 0x5307, 0x5324, 0x5372, 0x5393, 0x53B2, 0x53DD, 0xFA0E, 0x549C, 0x548A, 0x54A9, 0x54FF,
+		0x5586, 0x5759, 0x5765, 0x57AC, 0x57C8, 0x57C7, 0xFA0F, 0xFA10, 0x589E, 0x58B2, 0x590B, 0x5953,
+		0x595B, 0x595D, 0x5963, 0x59A4, 0x59BA, 0x5B56, 0x5BC0, 0x752F, 0x5BD8, 0x5BEC, 0x5C1E, 0x5CA6,
+		0x5CBA, 0x5CF5, 0x5D27, 0x5D53, 0xFA11, 0x5D42, 0x5D6D, 0x5DB8, 0x5DB9, 0x5DD0, 0x5F21, 0x5F34,
+		0x5F67, 0x5FB7, 0x5FDE, 0x605D, 0x6085, 0x608A, 0x60DE, 0x60D5, 0x6120, 0x60F2, 0x6111, 0x6137,
+		0x6130, 0x6198, 0x6213, 0x62A6, 0x63F5, 0x6460, 0x649D, 0x64CE, 0x654E, 0x6600, 0x6615, 0x663B,
+		0x6609, 0x662E, 0x661E, 0x6624, 0x6665, 0x6657, 0x6659, 0xFA12, 0x6673, 0x6699, 0x66A0, 0x66B2,
+		0x66BF, 0x66FA, 0x670E, 0xF929, 0x6766, 0x67BB, 0x6852, 0x67C0, 0x6801, 0x6844, 0x68CF, 0xFA13,
+		0x6968, 0xFA14, 0x6998, 0x69E2, 0x6A30, 0x6A6B, 0x6A46, 0x6A73, 0x6A7E, 0x6AE2, 0x6AE4, 0x6BD6,
+		0x6C3F, 0x6C5C, 0x6C86, 0x6C6F, 0x6CDA, 0x6D04, 0x6D87, 0x6D6F,
+	},
+	// lead byte 0xFB
+	{
+		0x6D96, 0x6DAC, 0x6DCF, 0x6DF8, 0x6DF2, 0x6DFC, 0x6E39, 0x6E5C, 0x6E27, 0x6E3C, 0x6EBF, 0x6F88,
+		0x6FB5, 0x6FF5, 0x7005, 0x7007, 0x7028, 0x7085, 0x70AB, 0x710F, 0x7104, 0x715C, 0x7146, 0x7147,
+		0xFA15, 0x71C1, 0x71FE, 0x72B1, 0x72BE, 0x7324, 0xFA16, 0x7377, 0x73BD, 0x73C9, 0x73D6, 0x73E3,
+		0x73D2, 0x7407, 0x73F5, 0x7426, 0x742A, 0x7429, 0x742E, 0x7462, 0x7489, 0x749F, 0x7501, 0x756F,
+		0x7682, 0x769C, 0x769E, 0x769B, 0x76A6, 0xFA17, 0x7746, 0x52AF, 0x7821, 0x784E, 0x7864, 0x787A,
+		0x7930, 0xFA18, 0xFA19, 0xFA1A, 0x7994, 0xFA1B, 0x799B, 0x7AD1, 0x7AE7, 0xFA1C, 0x7AEB, 0x7B9E,
+		0xFA1D, 0x7D48, 0x7D5C, 0x7DB7, 0x7DA0, 0x7DD6, 0x7E52, 0x7F47, 0x7FA1, 0xFA1E, 0x8301, 0x8362,
+		0x837F, 0x83C7, 0x83F6, 0x8448, 0x84B4, 0x8553, 0x8559, 0x856B, 0xFA1F, 0x85B0, 0xFA20, 0xFA21,
+		0x8807, 0x88F5, 0x8A12, 0x8A37, 0x8A79, 0x8AA7, 0x8ABE, 0x8ADF, 0xFA22, 0x8AF6, 0x8B53, 0x8B7F,
+		0x8CF0, 0x8CF4, 0x8D12, 0x8D76, 0xFA23, 0x8ECF, 0xFA24, 0xFA25, 0x9067, 0x90DE, 0xFA26, 0x9115,
+		0x9127, 0x91DA, 0x91D7, 0x91DE, 0x91ED, 0x91EE, 0x91E4, 0x91E5, 0x9206, 0x9210, 0x920A, 0x923A,
+		0x9240, 0x923C, 0x924E, 0x9259, 0x9251, 0x9239, 0x9267, 0x92A7, 0x9277, 0x9278, 0x92E7, 0x92D7,
+		0x92D9, 0x92D0, 0xFA27, 0x92D5, 0x92E0, 0x92D3, 0x9325, 0x9321, 0x92FB, 0xFA28, 0x931E, 0x92FF,
+		0x931D, 0x9302, 0x9370, 0x9357, 0x93A4, 0x93C6, 0x93DE, 0x93F8, 0x9431, 0x9445, 0x9448, 0x9592,
+		0xF9DC, 0xFA29, 0x969D, 0x96AF, 0x9733, 0x973B, 0x9743, 0x974D, 0x974F, 0x9751, 0x9755, 0x9857,
+		0x9865, 0xFA2A, 0xFA2B, 0x9927, 0xFA2C, 0x999E, 0x9A4E, 0x9AD9,
+	},
+	// lead byte 0xFC
+	{
+		0x9ADC, 0x9B75, 0x9B72, 0x9B8F, 0x9BB1, 0x9BBB, 0x9C00, 0x9D70, 0x9D6B, 0xFA2D, 0x9E19, 0x9ED1,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+		0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	},
+}
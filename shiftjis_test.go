@@ -0,0 +1,44 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestShiftJISRoundTrip(t *testing.T) {
+	checkRoundTrip(
+		t,
+		"Shift_JIS",
+		[]byte("\x93\xFA\x96\x7B\x8C\xEA\xB6\xC0\xB6\xC5 abc"),
+		"日本語ｶﾀｶﾅ abc",
+	)
+	checkRoundTrip(t, "Windows-31J", []byte("\x87\x40\x87\x8A\x81\x60\x81\x5F"), "①㈱～＼")
+	// user-defined area
+	checkRoundTrip(t, "Windows-31J", []byte("\xF0\x40\xF9\xFC"), "\uE000\uE757")
+}
+
+func TestCP932Preference(t *testing.T) {
+	// both the NEC-selected and the IBM code decode
+	checkDecode(t, "Windows-31J", []byte("\xEE\xE0\xFB\xFC\xEE\xEF\xFA\x40"), "髙髙ⅰⅰ")
+	// JIS X 0208 codes win over NEC row 13 either way
+	encode := func(preference CP932Preference, want string) {
+		t.Helper()
+		checkTranscode[rune, byte](
+			t,
+			&ShiftJISEncoder[rune] {Variant: SJISVAR_CP932, Preference: preference},
+			[]rune("髙∵ⅰ"),
+			[]byte(want),
+		)
+	}
+	encode(CP932PREF_MICROSOFT, "\xFB\xFC\x81\xE6\xFA\x40")
+	encode(CP932PREF_NEC, "\xEE\xE0\x81\xE6\xEE\xEF")
+	encode(CP932PREF_IBM, "\xFB\xFC\x81\xE6\xFA\x40")
+}
+
+func TestShiftJISErrors(t *testing.T) {
+	checkDecode(t, "Shift_JIS", []byte("a\x81"), "a�", 1)
+	// an invalid trail byte is taken on its own
+	checkDecode(t, "Shift_JIS", []byte("\x81\x20b"), "� b", 0)
+	checkDecode(t, "Shift_JIS", []byte("\xA0b\x87\x40"), "�b�", 0, 2)
+	// no vendor extensions in plain Shift_JIS
+	checkEncode(t, "Shift_JIS", "a①b", []byte("a\x00b"), 1)
+}
@@ -0,0 +1,81 @@
+package gotextenc
+
+import (
+	"sync"
+)
+
+// SingleByteCharset maps each byte value to at most one character.
+type SingleByteCharset struct {
+	chars [256]rune
+	encodeOnce sync.Once
+	encodeMap map[rune]byte
+}
+
+// NewSingleByteCharset creates a charset from the characters for all byte
+// values. A zero entry marks a byte value as unmapped, except for 0x00.
+func NewSingleByteCharset(chars *[256]rune) *SingleByteCharset {
+	charset := &SingleByteCharset {}
+	if chars != nil {
+		charset.chars = *chars
+	}
+	return charset
+}
+
+// newLatinCharset creates a charset that agrees with ISO-8859-1 in 0x00..0x9F
+// and maps 0xA0..0xFF as per high.
+func newLatinCharset(high *[96]rune) *SingleByteCharset {
+	charset := &SingleByteCharset {}
+	for b := 0; b < 0xA0; b++ {
+		charset.chars[b] = rune(b)
+	}
+	copy(charset.chars[0xA0:], high[:])
+	return charset
+}
+
+// newASCIICharset creates a charset that agrees with ASCII in 0x00..0x7F and
+// maps 0x80..0xFF as per high.
+func newASCIICharset(high *[128]rune) *SingleByteCharset {
+	charset := &SingleByteCharset {}
+	for b := 0; b < 0x80; b++ {
+		charset.chars[b] = rune(b)
+	}
+	copy(charset.chars[0x80:], high[:])
+	return charset
+}
+
+func(charset *SingleByteCharset) Decode(b byte) (char rune, mapped bool) {
+	char = charset.chars[b]
+	mapped = char != 0 || b == 0
+	return
+}
+
+func(charset *SingleByteCharset) Encode(char rune) (b byte, mapped bool) {
+	charset.encodeOnce.Do(func() {
+		charset.encodeMap = make(map[rune]byte)
+		// lowest byte wins if a character is mapped more than once
+		for index := 0xFF; index >= 0; index-- {
+			if charset.chars[index] != 0 || index == 0 {
+				charset.encodeMap[charset.chars[index]] = byte(index)
+			}
+		}
+	})
+	b, mapped = charset.encodeMap[char]
+	return
+}
+
+func registerSingleByteCharset(charset *SingleByteCharset, names ...string) {
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &SingleByteDecoder[uint16] {Charset: charset}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &SingleByteDecoder[rune] {Charset: charset}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &SingleByteEncoder[uint16] {Charset: charset}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &SingleByteEncoder[rune] {Charset: charset}
+		},
+	}, names...)
+}
@@ -0,0 +1,411 @@
+package gotextenc
+
+import (
+	"math/bits"
+	"sync"
+)
+
+type TeletextCharset uint8
+
+const (
+	TTXCS_LATIN TeletextCharset = iota
+	TTXCS_CYRILLIC_SERBIAN_CROATIAN
+	TTXCS_CYRILLIC_RUSSIAN_BULGARIAN
+	TTXCS_CYRILLIC_UKRAINIAN
+	TTXCS_GREEK
+	TTXCS_ARABIC
+	ttxcs_COUNT
+)
+
+// TeletextG2Set selects the supplementary set addressed by 0xA0..0xFF. By
+// default, it is the one that goes with the G0 set selected by the
+// TeletextCharset.
+type TeletextG2Set uint8
+
+const (
+	TTXG2_DEFAULT TeletextG2Set = iota
+	TTXG2_LATIN
+	TTXG2_CYRILLIC
+	TTXG2_GREEK
+	TTXG2_ARABIC
+	ttxg2_COUNT
+)
+
+type TeletextNationalSubset uint8
+
+const (
+	TTXNOS_ENGLISH TeletextNationalSubset = iota
+	TTXNOS_GERMAN
+	TTXNOS_SWEDISH_FINNISH_HUNGARIAN
+	TTXNOS_ITALIAN
+	TTXNOS_FRENCH
+	TTXNOS_PORTUGUESE_SPANISH
+	TTXNOS_CZECH_SLOVAK
+	TTXNOS_POLISH
+	TTXNOS_TURKISH
+	TTXNOS_SERBIAN_CROATIAN_SLOVENIAN
+	TTXNOS_RUMANIAN
+	TTXNOS_ESTONIAN
+	TTXNOS_LETTISH_LITHUANIAN
+	ttxnos_COUNT
+)
+
+type TeletextFlags uint8
+
+const (
+	// Bytes carry odd parity in bit 7, which is checked and stripped. The
+	// G2 set is not available then.
+	TTXFL_ODD_PARITY TeletextFlags = 1 << iota
+	// Spacing attributes (0x00..0x1F) are passed through as control
+	// characters instead of being displayed as SPACE.
+	TTXFL_KEEP_CONTROL_CODES
+)
+
+type teletextDesignation struct {
+	charset TeletextCharset
+	subset TeletextNationalSubset
+	g2 TeletextG2Set
+}
+
+// teletextDesignations maps the 7-bit default G0/G2 character set designation
+// and national option selection code of packet X/28 (or M/29) as per ETS 300
+// 706 table 32; reserved codes and the Hebrew G0 set are left out.
+var teletextDesignations = map[byte]teletextDesignation {
+	0x00: {TTXCS_LATIN, TTXNOS_ENGLISH, TTXG2_LATIN},
+	0x01: {TTXCS_LATIN, TTXNOS_GERMAN, TTXG2_LATIN},
+	0x02: {TTXCS_LATIN, TTXNOS_SWEDISH_FINNISH_HUNGARIAN, TTXG2_LATIN},
+	0x03: {TTXCS_LATIN, TTXNOS_ITALIAN, TTXG2_LATIN},
+	0x04: {TTXCS_LATIN, TTXNOS_FRENCH, TTXG2_LATIN},
+	0x05: {TTXCS_LATIN, TTXNOS_PORTUGUESE_SPANISH, TTXG2_LATIN},
+	0x06: {TTXCS_LATIN, TTXNOS_CZECH_SLOVAK, TTXG2_LATIN},
+	0x08: {TTXCS_LATIN, TTXNOS_POLISH, TTXG2_LATIN},
+	0x09: {TTXCS_LATIN, TTXNOS_GERMAN, TTXG2_LATIN},
+	0x0A: {TTXCS_LATIN, TTXNOS_SWEDISH_FINNISH_HUNGARIAN, TTXG2_LATIN},
+	0x0B: {TTXCS_LATIN, TTXNOS_ITALIAN, TTXG2_LATIN},
+	0x0C: {TTXCS_LATIN, TTXNOS_FRENCH, TTXG2_LATIN},
+	0x0E: {TTXCS_LATIN, TTXNOS_CZECH_SLOVAK, TTXG2_LATIN},
+	0x10: {TTXCS_LATIN, TTXNOS_ENGLISH, TTXG2_LATIN},
+	0x11: {TTXCS_LATIN, TTXNOS_GERMAN, TTXG2_LATIN},
+	0x12: {TTXCS_LATIN, TTXNOS_SWEDISH_FINNISH_HUNGARIAN, TTXG2_LATIN},
+	0x13: {TTXCS_LATIN, TTXNOS_ITALIAN, TTXG2_LATIN},
+	0x14: {TTXCS_LATIN, TTXNOS_FRENCH, TTXG2_LATIN},
+	0x15: {TTXCS_LATIN, TTXNOS_PORTUGUESE_SPANISH, TTXG2_LATIN},
+	0x16: {TTXCS_LATIN, TTXNOS_TURKISH, TTXG2_LATIN},
+	0x1D: {TTXCS_LATIN, TTXNOS_SERBIAN_CROATIAN_SLOVENIAN, TTXG2_LATIN},
+	0x1F: {TTXCS_LATIN, TTXNOS_RUMANIAN, TTXG2_LATIN},
+	0x20: {TTXCS_CYRILLIC_SERBIAN_CROATIAN, TTXNOS_ENGLISH, TTXG2_CYRILLIC},
+	0x21: {TTXCS_LATIN, TTXNOS_GERMAN, TTXG2_LATIN},
+	0x22: {TTXCS_LATIN, TTXNOS_ESTONIAN, TTXG2_LATIN},
+	0x23: {TTXCS_LATIN, TTXNOS_LETTISH_LITHUANIAN, TTXG2_LATIN},
+	0x24: {TTXCS_CYRILLIC_RUSSIAN_BULGARIAN, TTXNOS_ENGLISH, TTXG2_CYRILLIC},
+	0x25: {TTXCS_CYRILLIC_UKRAINIAN, TTXNOS_ENGLISH, TTXG2_CYRILLIC},
+	0x26: {TTXCS_LATIN, TTXNOS_CZECH_SLOVAK, TTXG2_LATIN},
+	0x36: {TTXCS_LATIN, TTXNOS_TURKISH, TTXG2_LATIN},
+	0x37: {TTXCS_GREEK, TTXNOS_ENGLISH, TTXG2_GREEK},
+	0x40: {TTXCS_LATIN, TTXNOS_ENGLISH, TTXG2_ARABIC},
+	0x44: {TTXCS_LATIN, TTXNOS_FRENCH, TTXG2_ARABIC},
+	0x47: {TTXCS_ARABIC, TTXNOS_ENGLISH, TTXG2_ARABIC},
+	0x57: {TTXCS_ARABIC, TTXNOS_ENGLISH, TTXG2_ARABIC},
+}
+
+// TeletextDesignation decodes the character set designation and national
+// option selection code (the four designation bits followed by C12..C14) into
+// the options of TeletextDecoder and TeletextEncoder. It returns false for
+// codes that are reserved or designate sets not supported here.
+func TeletextDesignation(code byte) (
+	charset TeletextCharset,
+	subset TeletextNationalSubset,
+	g2 TeletextG2Set,
+	ok bool,
+) {
+	designation, ok := teletextDesignations[code]
+	if ok {
+		charset, subset, g2 = designation.charset, designation.subset, designation.g2
+	}
+	return
+}
+
+// teletextNationalPositions are the Latin G0 positions that are replaced by
+// the national option subsets.
+var teletextNationalPositions = [13]byte {
+	0x23, 0x24, 0x40, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F, 0x60, 0x7B, 0x7C, 0x7D, 0x7E,
+}
+
+var teletextNationalSubsets = [ttxnos_COUNT][13]rune {
+	TTXNOS_ENGLISH: {
+		0x00A3, 0x0024, 0x0040, 0x2190, 0x00BD, 0x2192, 0x2191, 0x0023, 0x2015, 0x00BC, 0x2016, 0x00BE, 0x00F7,
+	},
+	TTXNOS_GERMAN: {
+		0x0023, 0x0024, 0x00A7, 0x00C4, 0x00D6, 0x00DC, 0x005E, 0x005F, 0x00B0, 0x00E4, 0x00F6, 0x00FC, 0x00DF,
+	},
+	TTXNOS_SWEDISH_FINNISH_HUNGARIAN: {
+		0x0023, 0x00A4, 0x00C9, 0x00C4, 0x00D6, 0x00C5, 0x00DC, 0x005F, 0x00E9, 0x00E4, 0x00F6, 0x00E5, 0x00FC,
+	},
+	TTXNOS_ITALIAN: {
+		0x00A3, 0x0024, 0x00E9, 0x00B0, 0x00E7, 0x2192, 0x2191, 0x0023, 0x00F9, 0x00E0, 0x00F2, 0x00E8, 0x00EC,
+	},
+	TTXNOS_FRENCH: {
+		0x00E9, 0x00EF, 0x00E0, 0x00EB, 0x00EA, 0x00F9, 0x00EE, 0x0023, 0x00E8, 0x00E2, 0x00F4, 0x00FB, 0x00E7,
+	},
+	TTXNOS_PORTUGUESE_SPANISH: {
+		0x00E7, 0x0024, 0x00A1, 0x00E1, 0x00E9, 0x00ED, 0x00F3, 0x00FA, 0x00BF, 0x00FC, 0x00F1, 0x00E8, 0x00E0,
+	},
+	TTXNOS_CZECH_SLOVAK: {
+		0x0023, 0x016F, 0x010D, 0x0165, 0x017E, 0x00FD, 0x00ED, 0x0159, 0x00E9, 0x00E1, 0x011B, 0x00FA, 0x0161,
+	},
+	TTXNOS_POLISH: {
+		0x0023, 0x0144, 0x0105, 0x01B5, 0x015A, 0x0141, 0x0107, 0x00F3, 0x0119, 0x017C, 0x015B, 0x0142, 0x017A,
+	},
+	TTXNOS_TURKISH: {
+		0x20A4, 0x011F, 0x0130, 0x015E, 0x00D6, 0x00C7, 0x00DC, 0x011E, 0x0131, 0x015F, 0x00F6, 0x00E7, 0x00FC,
+	},
+	TTXNOS_SERBIAN_CROATIAN_SLOVENIAN: {
+		0x0023, 0x00CB, 0x010C, 0x0106, 0x017D, 0x0110, 0x0160, 0x00EB, 0x010D, 0x0107, 0x017E, 0x0111, 0x0161,
+	},
+	TTXNOS_RUMANIAN: {
+		0x0023, 0x00A4, 0x0162, 0x00C2, 0x015E, 0x0102, 0x00CE, 0x0131, 0x0163, 0x00E2, 0x015F, 0x0103, 0x00EE,
+	},
+	TTXNOS_ESTONIAN: {
+		0x0023, 0x00F5, 0x0160, 0x00C4, 0x00D6, 0x017D, 0x00DC, 0x00D5, 0x0161, 0x00E4, 0x00F6, 0x017E, 0x00FC,
+	},
+	TTXNOS_LETTISH_LITHUANIAN: {
+		0x0023, 0x0024, 0x0160, 0x0117, 0x0119, 0x017D, 0x010D, 0x016B, 0x0161, 0x0105, 0x0173, 0x017E, 0x012F,
+	},
+}
+
+// teletextCyrillicG0 maps 0x40..0x7F of the Cyrillic G0 sets; 0x20..0x3F
+// agree with ASCII except for the position given in teletextCyrillicExtra.
+var teletextCyrillicG0 = [3][64]rune {
+	{
+		// option 1: Serbian/Croatian
+		0x0427, 0x0410, 0x0411, 0x0426, 0x0414, 0x0415, 0x0424, 0x0413,
+		0x0425, 0x0418, 0x0408, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E,
+		0x041F, 0x040C, 0x0420, 0x0421, 0x0422, 0x0423, 0x0412, 0x0403,
+		0x0409, 0x040A, 0x0417, 0x040B, 0x0416, 0x0402, 0x0428, 0x040F,
+		0x0447, 0x0430, 0x0431, 0x0446, 0x0434, 0x0435, 0x0444, 0x0433,
+		0x0445, 0x0438, 0x0458, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E,
+		0x043F, 0x045C, 0x0440, 0x0441, 0x0442, 0x0443, 0x0432, 0x0453,
+		0x0459, 0x045A, 0x0437, 0x045B, 0x0436, 0x0452, 0x0448, 0x25A0,
+	},
+	{
+		// option 2: Russian/Bulgarian
+		0x042E, 0x0410, 0x0411, 0x0426, 0x0414, 0x0415, 0x0424, 0x0413,
+		0x0425, 0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E,
+		0x041F, 0x042F, 0x0420, 0x0421, 0x0422, 0x0423, 0x0416, 0x0412,
+		0x042C, 0x042A, 0x0417, 0x0428, 0x042D, 0x0429, 0x0427, 0x042B,
+		0x044E, 0x0430, 0x0431, 0x0446, 0x0434, 0x0435, 0x0444, 0x0433,
+		0x0445, 0x0438, 0x0439, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E,
+		0x043F, 0x044F, 0x0440, 0x0441, 0x0442, 0x0443, 0x0436, 0x0432,
+		0x044C, 0x044A, 0x0437, 0x0448, 0x044D, 0x0449, 0x0447, 0x25A0,
+	},
+	{
+		// option 3: Ukrainian
+		0x042E, 0x0410, 0x0411, 0x0426, 0x0414, 0x0415, 0x0424, 0x0413,
+		0x0425, 0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E,
+		0x041F, 0x042F, 0x0420, 0x0421, 0x0422, 0x0423, 0x0416, 0x0412,
+		0x042C, 0x0406, 0x0417, 0x0428, 0x0404, 0x0429, 0x0427, 0x0407,
+		0x044E, 0x0430, 0x0431, 0x0446, 0x0434, 0x0435, 0x0444, 0x0433,
+		0x0445, 0x0438, 0x0439, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E,
+		0x043F, 0x044F, 0x0440, 0x0441, 0x0442, 0x0443, 0x0436, 0x0432,
+		0x044C, 0x0456, 0x0437, 0x0448, 0x0454, 0x0449, 0x0447, 0x25A0,
+	},
+}
+
+var teletextCyrillicExtra = [3]rune {
+	0,
+	0x044B, // option 2: 0x26
+	0x0457, // option 3: 0x26
+}
+
+// teletextLatinG2 maps 0xA0..0xFF, i.e. the Latin G2 set invoked into GR. It
+// follows ISO 6937, so column 4 holds the non-spacing diacritics.
+var teletextLatinG2 = func() (g2 [96]rune) {
+	g2 = iso6937High
+	g2[0x04] = '$'
+	g2[0x06] = '#'
+	return
+}()
+
+// teletextCyrillicG2 maps 0xA0..0xFF of the Cyrillic G2 set. Like the Latin
+// one, it holds the non-spacing diacritics in column 4; columns 6 and 7 carry
+// the Latin letters missing from the Cyrillic G0 sets.
+var teletextCyrillicG2 = [96]rune {
+	0x00A0, 0x00A1, 0x00A2, 0x00A3, 0x0024, 0x00A5, 0x0000, 0x00A7,
+	0x0000, 0x2018, 0x201C, 0x00AB, 0x2190, 0x2191, 0x2192, 0x2193,
+	0x00B0, 0x00B1, 0x00B2, 0x00B3, 0x00D7, 0x00B5, 0x00B6, 0x00B7,
+	0x00F7, 0x2019, 0x201D, 0x00BB, 0x00BC, 0x00BD, 0x00BE, 0x00BF,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x2015, 0x00B9, 0x00AE, 0x00A9, 0x2122, 0x266A, 0x20A0, 0x2030,
+	0x03B1, 0x0141, 0x0142, 0x00DF, 0x215B, 0x215C, 0x215D, 0x215E,
+	0x0044, 0x0045, 0x0046, 0x0047, 0x0049, 0x004A, 0x004B, 0x004C,
+	0x004E, 0x0051, 0x0052, 0x0053, 0x0055, 0x0056, 0x0057, 0x005A,
+	0x0064, 0x0065, 0x0066, 0x0067, 0x0069, 0x006A, 0x006B, 0x006C,
+	0x006E, 0x0071, 0x0072, 0x0073, 0x0075, 0x0076, 0x0077, 0x007A,
+}
+
+// teletextGreekG2 maps 0xA0..0xFF of the Greek G2 set, which also holds the
+// non-spacing diacritics in column 4, and the Latin letters that do not look
+// like Greek ones elsewhere.
+var teletextGreekG2 = [96]rune {
+	0x00A0, 0x0061, 0x0062, 0x00A3, 0x0065, 0x0068, 0x0069, 0x00A7,
+	0x003A, 0x2018, 0x201C, 0x006B, 0x2190, 0x2191, 0x2192, 0x2193,
+	0x00B0, 0x00B1, 0x00B2, 0x00B3, 0x0078, 0x006D, 0x006E, 0x0070,
+	0x00F7, 0x2019, 0x201D, 0x0074, 0x00BC, 0x00BD, 0x00BE, 0x0078,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x003F, 0x00B9, 0x00AE, 0x00A9, 0x2122, 0x266A, 0x20A0, 0x2030,
+	0x03B1, 0x038A, 0x038E, 0x038F, 0x215B, 0x215C, 0x215D, 0x215E,
+	0x0043, 0x0044, 0x0046, 0x0047, 0x004A, 0x004C, 0x0051, 0x0052,
+	0x0053, 0x0055, 0x0056, 0x0057, 0x0059, 0x005A, 0x0386, 0x0389,
+	0x0063, 0x0064, 0x0066, 0x0067, 0x006A, 0x006C, 0x0071, 0x0072,
+	0x0073, 0x0075, 0x0076, 0x0077, 0x0079, 0x007A, 0x0388, 0x25A0,
+}
+
+// teletextArabicG0 maps 0x20..0x7F of the Arabic G0 set. Letters are given
+// in the contextual form the set shows them in, hence the presentation forms.
+var teletextArabicG0 = [96]rune {
+	0x0020, 0x0021, 0x0022, 0x00A3, 0x0024, 0x066A, 0xFEF0, 0xFEF2,
+	0xFD3F, 0xFD3E, 0x002A, 0x002B, 0x060C, 0x002D, 0x002E, 0x002F,
+	0x0660, 0x0661, 0x0662, 0x0663, 0x0664, 0x0665, 0x0666, 0x0667,
+	0x0668, 0x0669, 0x003A, 0x061B, 0x003E, 0x003D, 0x003C, 0x061F,
+	0xFE94, 0x0621, 0xFE92, 0x0628, 0xFE98, 0x062A, 0xFE8E, 0x0627,
+	0xFE91, 0xFE97, 0xFE9B, 0xFE9F, 0xFEA3, 0xFEA7, 0xFEA9, 0x0630,
+	0xFEAD, 0xFEAF, 0xFEB3, 0xFEB7, 0xFEBB, 0xFEBF, 0xFEC1, 0xFEC5,
+	0xFECB, 0xFECF, 0xFE9C, 0xFEA0, 0xFEA4, 0xFEA8, 0x0023, 0x0640,
+	0xFED3, 0xFED7, 0xFEDB, 0xFEDF, 0xFEE3, 0xFEE7, 0xFEEB, 0xFEED,
+	0xFEEF, 0xFEF3, 0xFE99, 0xFE9D, 0xFEA1, 0xFEA5, 0xFEF4, 0xFEF0,
+	0xFECC, 0xFED0, 0xFED4, 0xFED1, 0xFED8, 0xFED5, 0xFED9, 0xFEE0,
+	0xFEDD, 0xFEE4, 0xFEE1, 0xFEE8, 0xFEE5, 0xFEEC, 0xFEFB, 0x25A0,
+}
+
+// teletextArabicG2 maps 0xA0..0xFF of the Arabic G2 set: further Arabic
+// letter forms in columns 2 and 3, and Latin letters (with the accented ones
+// French needs in place of the brackets) in columns 4..7. It has no
+// diacritics.
+var teletextArabicG2 = [96]rune {
+	0x00A0, 0x0639, 0xFEC9, 0xFE83, 0xFE85, 0xFE87, 0xFE8B, 0xFE89,
+	0xFB7C, 0xFB7D, 0xFB7A, 0xFB58, 0xFB59, 0xFB56, 0xFB6D, 0xFB8E,
+	0x0660, 0x0661, 0x0662, 0x0663, 0x0664, 0x0665, 0x0666, 0x0667,
+	0x0668, 0x0669, 0xFECE, 0xFECD, 0xFEFC, 0xFECC, 0xFEEA, 0xFEE9,
+	0x00E0, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047,
+	0x0048, 0x0049, 0x004A, 0x004B, 0x004C, 0x004D, 0x004E, 0x004F,
+	0x0050, 0x0051, 0x0052, 0x0053, 0x0054, 0x0055, 0x0056, 0x0057,
+	0x0058, 0x0059, 0x005A, 0x00EB, 0x00EA, 0x00F9, 0x00EE, 0xFECA,
+	0x00E9, 0x0061, 0x0062, 0x0063, 0x0064, 0x0065, 0x0066, 0x0067,
+	0x0068, 0x0069, 0x006A, 0x006B, 0x006C, 0x006D, 0x006E, 0x006F,
+	0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077,
+	0x0078, 0x0079, 0x007A, 0x00E2, 0x00F4, 0x00FB, 0x00E7, 0x25A0,
+}
+
+type teletextTable struct {
+	g0 [96]rune
+	g2 *[96]rune
+	// whether g2 has the non-spacing diacritics in column 4
+	diacritics bool
+	encodeMap map[rune]byte
+}
+
+var teletextTables [ttxcs_COUNT][ttxnos_COUNT][ttxg2_COUNT]*teletextTable
+var teletextTablesMutex sync.Mutex
+
+func getTeletextTable(
+	charset TeletextCharset,
+	subset TeletextNationalSubset,
+	g2 TeletextG2Set,
+) *teletextTable {
+	if charset >= ttxcs_COUNT {
+		charset = TTXCS_LATIN
+	}
+	if charset != TTXCS_LATIN || subset >= ttxnos_COUNT {
+		subset = TTXNOS_ENGLISH
+	}
+	if g2 >= ttxg2_COUNT {
+		g2 = TTXG2_DEFAULT
+	}
+	teletextTablesMutex.Lock()
+	defer teletextTablesMutex.Unlock()
+	table := teletextTables[charset][subset][g2]
+	if table == nil {
+		table = newTeletextTable(charset, subset, g2)
+		teletextTables[charset][subset][g2] = table
+	}
+	return table
+}
+
+func newTeletextTable(
+	charset TeletextCharset,
+	subset TeletextNationalSubset,
+	g2 TeletextG2Set,
+) *teletextTable {
+	table := &teletextTable {}
+	for b := 0x20; b < 0x7F; b++ {
+		table.g0[b - 0x20] = rune(b)
+	}
+	table.g0[0x7F - 0x20] = 0x25A0
+	switch charset {
+		case TTXCS_LATIN:
+			for index, position := range teletextNationalPositions {
+				table.g0[position - 0x20] = teletextNationalSubsets[subset][index]
+			}
+		case TTXCS_CYRILLIC_SERBIAN_CROATIAN, TTXCS_CYRILLIC_RUSSIAN_BULGARIAN, TTXCS_CYRILLIC_UKRAINIAN:
+			option := charset - TTXCS_CYRILLIC_SERBIAN_CROATIAN
+			copy(table.g0[0x40 - 0x20:], teletextCyrillicG0[option][:])
+			if teletextCyrillicExtra[option] != 0 {
+				table.g0[0x26 - 0x20] = teletextCyrillicExtra[option]
+			}
+		case TTXCS_GREEK:
+			table.g0[0x3C - 0x20] = 0x00AB
+			table.g0[0x3E - 0x20] = 0x00BB
+			for b := 0x40; b < 0x7F; b++ {
+				table.g0[b - 0x20] = rune(0x0390 + b - 0x40)
+			}
+			// U+03A2 is unassigned
+			table.g0[0x52 - 0x20] = 0
+		case TTXCS_ARABIC:
+			table.g0 = teletextArabicG0
+	}
+	if g2 == TTXG2_DEFAULT {
+		switch charset {
+			case TTXCS_CYRILLIC_SERBIAN_CROATIAN, TTXCS_CYRILLIC_RUSSIAN_BULGARIAN, TTXCS_CYRILLIC_UKRAINIAN:
+				g2 = TTXG2_CYRILLIC
+			case TTXCS_GREEK:
+				g2 = TTXG2_GREEK
+			case TTXCS_ARABIC:
+				g2 = TTXG2_ARABIC
+			default:
+				g2 = TTXG2_LATIN
+		}
+	}
+	switch g2 {
+		case TTXG2_CYRILLIC:
+			table.g2 = &teletextCyrillicG2
+		case TTXG2_GREEK:
+			table.g2 = &teletextGreekG2
+		case TTXG2_ARABIC:
+			table.g2 = &teletextArabicG2
+		default:
+			table.g2 = &teletextLatinG2
+	}
+	table.diacritics = g2 != TTXG2_ARABIC
+	table.encodeMap = make(map[rune]byte)
+	for index := len(table.g2) - 1; index >= 0; index-- {
+		if table.g2[index] != 0 {
+			table.encodeMap[table.g2[index]] = byte(0xA0 + index)
+		}
+	}
+	// G0 takes precedence over G2
+	for index := len(table.g0) - 1; index >= 0; index-- {
+		if table.g0[index] != 0 {
+			table.encodeMap[table.g0[index]] = byte(0x20 + index)
+		}
+	}
+	return table
+}
+
+func teletextWithParity(b byte) byte {
+	if bits.OnesCount8(b) % 2 == 0 {
+		b |= 0x80
+	}
+	return b
+}
@@ -0,0 +1,119 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+// checkTeletextRoundTrip checks that encoded and text map to each other
+// without errors with the given options.
+func checkTeletextRoundTrip(
+	t *testing.T,
+	charset TeletextCharset,
+	subset TeletextNationalSubset,
+	g2 TeletextG2Set,
+	flags TeletextFlags,
+	encoded []byte,
+	text string,
+) {
+	t.Helper()
+	checkTranscode[byte, rune](
+		t,
+		&TeletextDecoder[rune] {Charset: charset, NationalSubset: subset, G2: g2, Flags: flags},
+		encoded,
+		[]rune(text),
+	)
+	checkTranscode[rune, byte](
+		t,
+		&TeletextEncoder[rune] {Charset: charset, NationalSubset: subset, G2: g2, Flags: flags},
+		[]rune(text),
+		encoded,
+	)
+}
+
+func TestTeletextNationalSubsets(t *testing.T) {
+	checkTeletextRoundTrip(t, TTXCS_LATIN, TTXNOS_ENGLISH, TTXG2_DEFAULT, 0, []byte("#5 [a]"), "£5 ←a→")
+	checkTeletextRoundTrip(t, TTXCS_LATIN, TTXNOS_GERMAN, TTXG2_DEFAULT, 0, []byte("[\\]{|}~"), "ÄÖÜäöüß")
+	checkTeletextRoundTrip(t, TTXCS_LATIN, TTXNOS_POLISH, TTXG2_DEFAULT, 0, []byte("\\]{"), "ŚŁż")
+}
+
+func TestTeletextDiacritics(t *testing.T) {
+	// English has no é in G0, so it takes a G2 diacritic
+	checkTeletextRoundTrip(t, TTXCS_LATIN, TTXNOS_ENGLISH, TTXG2_DEFAULT, 0, []byte("caf\xC2e"), "café")
+	// French has
+	checkTeletextRoundTrip(t, TTXCS_LATIN, TTXNOS_FRENCH, TTXG2_DEFAULT, 0, []byte("caf#"), "café")
+	checkTranscode[byte, rune](
+		t,
+		&TeletextDecoder[rune]{},
+		[]byte("\xC1Q"),
+		[]rune("Q̀"),
+	)
+}
+
+func TestTeletextOtherScripts(t *testing.T) {
+	checkTeletextRoundTrip(t, TTXCS_CYRILLIC_RUSSIAN_BULGARIAN, TTXNOS_ENGLISH, TTXG2_DEFAULT, 0, []byte("Priwet"), "Привет")
+	checkTeletextRoundTrip(t, TTXCS_GREEK, TTXNOS_ENGLISH, TTXG2_DEFAULT, 0, []byte("ABC"), "ΑΒΓ")
+	// Latin letters are in the Arabic G2 set
+	checkTeletextRoundTrip(t, TTXCS_ARABIC, TTXNOS_ENGLISH, TTXG2_DEFAULT, 0, []byte("AC G\xC1\xE2"), "ءب اAb")
+	// and in the Cyrillic one, those missing from the Cyrillic G0 sets
+	checkTeletextRoundTrip(t, TTXCS_CYRILLIC_RUSSIAN_BULGARIAN, TTXNOS_ENGLISH, TTXG2_DEFAULT, 0, []byte("A\xE0\xEA\xD4"), "АDR™")
+}
+
+func TestTeletextParity(t *testing.T) {
+	checkTeletextRoundTrip(t, TTXCS_LATIN, TTXNOS_ENGLISH, TTXG2_DEFAULT, TTXFL_ODD_PARITY, []byte("\xC1b"), "Ab")
+	checkTranscode[byte, rune](
+		t,
+		&TeletextDecoder[rune] {Flags: TTXFL_ODD_PARITY},
+		[]byte("b\x41b"),
+		[]rune("b�b"),
+		1,
+	)
+}
+
+func TestTeletextErrors(t *testing.T) {
+	checkTranscode[byte, rune](t, &TeletextDecoder[rune]{}, []byte("a\x01\xC2"), []rune("a �"), 2)
+	checkTranscode[rune, byte](t, &TeletextEncoder[rune]{}, []rune("aЖb"), []byte("a\x00b"), 1)
+}
+
+// TestTeletextTables checks that every character in the tables is encoded to
+// a byte that decodes to it again.
+func TestTeletextTables(t *testing.T) {
+	for charset := TeletextCharset(0); charset < ttxcs_COUNT; charset++ {
+		for g2 := TeletextG2Set(0); g2 < ttxg2_COUNT; g2++ {
+			for subset := TeletextNationalSubset(0); subset < ttxnos_COUNT; subset++ {
+				if charset != TTXCS_LATIN && subset != TTXNOS_ENGLISH {
+					break
+				}
+				table := getTeletextTable(charset, subset, g2)
+				decode := func(b byte) rune {
+					switch {
+						case b >= 0x20 && b < 0x80:
+							return table.g0[b - 0x20]
+						case b >= 0xA0 && (!table.diacritics || b < 0xC1 || b >= 0xD0):
+							return table.g2[b - 0xA0]
+						default:
+							return 0
+					}
+				}
+				for b := 0x20; b < 0x100; b++ {
+					char := decode(byte(b))
+					if char == 0 {
+						continue
+					}
+					if code, found := table.encodeMap[char]; !found || decode(code) != char {
+						t.Errorf("%d/%d/%d: %U at 0x%02X is encoded as 0x%02X", charset, subset, g2, char, b, code)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestTeletextDesignation(t *testing.T) {
+	charset, subset, g2, ok := TeletextDesignation(0x24)
+	if !ok || charset != TTXCS_CYRILLIC_RUSSIAN_BULGARIAN || subset != TTXNOS_ENGLISH || g2 != TTXG2_CYRILLIC {
+		t.Errorf("0x24 designates %d/%d/%d/%t", charset, subset, g2, ok)
+	}
+	if _, _, _, ok := TeletextDesignation(0x07); ok {
+		t.Errorf("0x07 is reserved")
+	}
+}
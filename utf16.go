@@ -0,0 +1,44 @@
+package gotextenc
+
+var utf16BENames = []string {
+	"UTF-16BE",
+	"UTF16BE",
+	"csUTF16BE",
+}
+
+var utf16LENames = []string {
+	"UTF-16LE",
+	"UTF16LE",
+	"csUTF16LE",
+}
+
+func init() {
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &UTF16Decoder[uint16]{}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &UTF16Decoder[rune]{}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &UTF16Encoder[uint16]{}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &UTF16Encoder[rune]{}
+		},
+	}, utf16BENames...)
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &UTF16Decoder[uint16] {LittleEndian: true}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &UTF16Decoder[rune] {LittleEndian: true}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &UTF16Encoder[uint16] {LittleEndian: true}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &UTF16Encoder[rune] {LittleEndian: true}
+		},
+	}, utf16LENames...)
+}
@@ -0,0 +1,24 @@
+package gotextenc
+
+var utf8Names = []string {
+	"UTF-8",
+	"UTF8",
+	"csUTF8",
+}
+
+func init() {
+	RegisterCharset(CharsetFactories {
+		Decoder12: func() Codec[byte, uint16] {
+			return &UTF8Decoder[uint16]{}
+		},
+		Decoder14: func() Codec[byte, rune] {
+			return &UTF8Decoder[rune]{}
+		},
+		Encoder21: func() Codec[uint16, byte] {
+			return &UTF8Encoder[uint16]{}
+		},
+		Encoder41: func() Codec[rune, byte] {
+			return &UTF8Encoder[rune]{}
+		},
+	}, utf8Names...)
+}
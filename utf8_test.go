@@ -0,0 +1,81 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestUTF8Decoder(t *testing.T) {
+	checkTranscode[byte, rune](t, &UTF8Decoder[rune]{}, []byte("aé€𝄞"), []rune("aé€𝄞"))
+	checkTranscode[byte, uint16](
+		t,
+		&UTF8Decoder[uint16]{},
+		[]byte("a𝄞"),
+		[]uint16 {'a', 0xD834, 0xDD1E},
+	)
+	// U+FFFD in the input is passed on without complaint
+	checkTranscode[byte, rune](t, &UTF8Decoder[rune]{}, []byte("\xEF\xBF\xBD"), []rune("�"))
+}
+
+func TestUTF8DecoderErrors(t *testing.T) {
+	decode := func(src string, want string, offsets ...uint64) {
+		t.Helper()
+		checkTranscode[byte, rune](t, &UTF8Decoder[rune]{}, []byte(src), []rune(want), offsets...)
+	}
+	decode("a\xC3", "a�", 1)
+	// the byte that breaks off a sequence starts afresh
+	decode("\xE2\x82a", "�a", 2)
+	// a run of stray continuation bytes is reported once
+	decode("\x80\x80a", "��a", 0)
+	decode("\xFFz\xF8\x80\x80z", "�z���z", 0, 2)
+	decode("\xF4\x90\x80\x80z", "�z", 0)
+	decode("\xED\xA0\x80z", "�z", 0)
+	decode("\xED\xB0\x80z", "�z", 0)
+	decode("y\xED\xA0\x80\xED\xB0\x80z", "y�z", 1)
+	// overlong encodings are permanent errors
+	decode("a\xC0\x80b", "a", 1)
+}
+
+// plainUTF8Handler hides all but the methods of UTF8DecodingErrorHandler.
+type plainUTF8Handler struct {
+	UTF8DecodingErrorHandler[rune]
+}
+
+func TestUTF8DecoderTruncation(t *testing.T) {
+	decoder := &UTF8Decoder[rune]{}
+	if _, _, err := decoder.Transcode([]byte("a\xE2\x82"), make([]rune, 2), true); err == nil {
+		t.Fatalf("truncated sequence not reported")
+	} else if _, ok := err.(*TruncatedSequenceError); !ok {
+		t.Errorf("truncated sequence reported as %T", err)
+	}
+	// a handler unaware of truncation learns of the sequence anyway
+	decoder = &UTF8Decoder[rune]{
+		ErrorHandler: plainUTF8Handler {&DefaultErrorHandler[rune]{DEFERRHDLFL_SECURE}},
+	}
+	checkTranscode[byte, rune](t, decoder, []byte("a\xE2\x82"), []rune("a�"), 1)
+	decoder.Reset(0)
+	if _, _, err := decoder.Transcode([]byte("a\xE2\x82"), make([]rune, 2), true); err == nil {
+		t.Fatalf("truncated sequence not reported")
+	} else if _, ok := err.(*IllegalStartOfSequenceError); !ok {
+		t.Errorf("truncated sequence reported as %T", err)
+	}
+}
+
+func TestUTF8Encoder(t *testing.T) {
+	checkTranscode[rune, byte](t, &UTF8Encoder[rune]{}, []rune("aé€𝄞"), []byte("aé€𝄞"))
+	checkTranscode[uint16, byte](t, &UTF8Encoder[uint16]{}, []uint16 {'a', 0xD834, 0xDD1E}, []byte("a𝄞"))
+	checkRoundTrip(t, "UTF-8", []byte("aé€𝄞"), "aé€𝄞")
+}
+
+func TestUTF8EncoderErrors(t *testing.T) {
+	// replaced with NUL, as by every encoder
+	checkTranscode[rune, byte](t, &UTF8Encoder[rune]{}, []rune {'a', 0xD800, 'b'}, []byte("a\x00b"), 1)
+	checkTranscode[rune, byte](t, &UTF8Encoder[rune]{}, []rune {'a', 0x110000}, []byte("a\x00"), 1)
+	checkTranscode[uint16, byte](
+		t,
+		&UTF8Encoder[uint16]{},
+		[]uint16 {0xDD1E, 'a', 0xD834},
+		[]byte("\x00a\x00"),
+		0,
+		2,
+	)
+}
@@ -34,5 +34,111 @@ func CodePointFromSurrogatePair(hi, lo uint16) rune {
 	if (hi & 0xFC00) != 0xD800 || (lo & 0xFC00) != 0xDC00 {
 		return 0
 	}
-	return (rune(hi & 0x03FF) << 10) | rune(lo & 0x03FF)
+	return ((rune(hi & 0x03FF) << 10) | rune(lo & 0x03FF)) + 0x10000
+}
+
+func isSurrogateHalf(r rune) bool {
+	return r >= 0xD800 && r < 0xE000
+}
+
+func isCodePoint(r rune) bool {
+	return r >= 0 && r <= 0x10FFFF && !isSurrogateHalf(r)
+}
+
+// encodeRune converts the code point r into the code units of TargetT, using
+// buffer as backing storage. Code points that do not fit into a single code
+// unit are split into a UTF-16 surrogate pair if TargetT is wide enough for
+// that. ok is false if r cannot be represented in TargetT at all.
+func encodeRune[TargetT CharLike](r rune, buffer *[2]TargetT) (units []TargetT, ok bool) {
+	if rune(TargetT(r)) == r {
+		buffer[0] = TargetT(r)
+		return buffer[:1], true
+	}
+	var widest rune = 0xFFFF
+	if rune(TargetT(widest)) != widest || r < 0x10000 || r > 0x10FFFF {
+		return nil, false
+	}
+	r -= 0x10000
+	buffer[0] = TargetT(0xD800 | (r >> 10))
+	buffer[1] = TargetT(0xDC00 | (r & 0x03FF))
+	return buffer[:2], true
+}
+
+// putChars stores as much of chars as fits into destChars at *outCount and
+// returns the part that did not fit.
+func putChars[CharT CharLike](chars []CharT, destChars []CharT, outCount *int) []CharT {
+	copyCount := copy(destChars[*outCount:], chars)
+	*outCount += copyCount
+	return chars[copyCount:]
+}
+
+type sourceRuneStatus uint8
+
+const (
+	srcrune_OK sourceRuneStatus = iota
+	srcrune_PENDING
+	srcrune_UNPAIRED_PENDING
+	srcrune_UNPAIRED
+	srcrune_ILLEGAL
+)
+
+// nextSourceRune interprets unit as the next code unit of the input of an
+// encoder, joining UTF-16 surrogate pairs via *surrogateHalf if SourceT is
+// uint16. The status tells what happened:
+//
+//   srcrune_OK:               r is a complete code point; unit was consumed
+//   srcrune_PENDING:          unit is a high surrogate half and was stored
+//   srcrune_UNPAIRED_PENDING: the stored high half r is unpaired; unit was
+//                             not consumed
+//   srcrune_UNPAIRED:         unit is an unpaired low surrogate half r
+//   srcrune_ILLEGAL:          unit is an illegal code point r
+func nextSourceRune[SourceT CharLike](unit SourceT, surrogateHalf *uint16) (r rune, status sourceRuneStatus) {
+	r = rune(unit)
+	var widest rune = 0xFFFF
+	if rune(SourceT(widest)) != widest {
+		// byte units are Latin-1 code points
+		return
+	}
+	if rune(SourceT(widest + 1)) == widest + 1 {
+		// rune units are code points already
+		if !isCodePoint(r) {
+			status = srcrune_ILLEGAL
+		}
+		return
+	}
+	if *surrogateHalf != 0 {
+		if r >= 0xDC00 && r < 0xE000 {
+			r = CodePointFromSurrogatePair(*surrogateHalf, uint16(r))
+		} else {
+			r = rune(*surrogateHalf)
+			status = srcrune_UNPAIRED_PENDING
+		}
+		*surrogateHalf = 0
+		return
+	}
+	switch {
+		case r >= 0xD800 && r < 0xDC00:
+			*surrogateHalf = uint16(r)
+			status = srcrune_PENDING
+		case r >= 0xDC00 && r < 0xE000:
+			status = srcrune_UNPAIRED
+	}
+	return
+}
+
+// appendDecodedRune appends the code point r to units as per encodeRune. If r
+// cannot be represented in TargetT, handler decides what to append instead.
+func appendDecodedRune[TargetT CharLike](
+	units []TargetT,
+	r rune,
+	offset uint64,
+	handler NarrowingErrorHandler[TargetT],
+) ([]TargetT, error, bool) {
+	var buffer [2]TargetT
+	encoded, ok := encodeRune(r, &buffer)
+	if ok {
+		return append(units, encoded...), nil, false
+	}
+	replacement, err, permanent := handler.UnrepresentableChar(offset, r)
+	return append(units, replacement...), err, permanent
 }
@@ -0,0 +1,122 @@
+package gotextenc
+
+import (
+	"testing"
+)
+
+func TestWHATWGLabels(t *testing.T) {
+	for name, labels := range whatwgLabels {
+		for _, label := range labels {
+			if got, found := GetWHATWGEncoding(label); !found || got != name {
+				t.Errorf("label %q gives %q, want %q", label, got, name)
+			}
+			if LookupWHATWGCharset(label) == nil {
+				t.Errorf("label %q has no Charset", label)
+			}
+		}
+	}
+	for label, want := range map[string]string {
+		" Latin1\n": "windows-1252",
+		"ASCII": "windows-1252",
+		"\tUTF8 ": "UTF-8",
+		"ks_c_5601-1987": "EUC-KR",
+		"csISO2022KR": "replacement",
+	} {
+		if got, found := GetWHATWGEncoding(label); !found || got != want {
+			t.Errorf("label %q gives %q, want %q", label, got, want)
+		}
+	}
+	// only ASCII letters are folded, and nothing is normalized away
+	for _, label := range []string {"Koi8-r", "utf_8", "latin-1", "\vutf-8"} {
+		if name, found := GetWHATWGEncoding(label); found {
+			t.Errorf("label %q gives %q", label, name)
+		}
+	}
+}
+
+func TestWHATWGCharsets(t *testing.T) {
+	check := func(label string, encoded string, text string) {
+		t.Helper()
+		charset := LookupWHATWGCharset(label)
+		checkTranscode(t, charset.NewDecoder14(), []byte(encoded), []rune(text))
+		if encoder := charset.NewEncoder41(); encoder != nil {
+			checkTranscode(t, encoder, []rune(text), []byte(encoded))
+		}
+	}
+	check("latin1", "\x80\x82\x83\x85\x9F\xE9", "€‚ƒ…Ÿé")
+	// the bytes Windows leaves unassigned are C1 controls
+	check("windows-1252", "\x81\x8D\x8F\x90\x9D", "\u0081\u008D\u008F\u0090\u009D")
+	check("windows-874", "\x80\xA1\xFB", "€ก๛")
+	check("cp1250", "\xA3\xB9\x8C", "ŁąŚ")
+	check("windows-1251", "\x80\x88\xDF", "Ђ€Я")
+	check("greek", "\xB6\xA4\xD9", "Ά€Ω")
+	check("windows-1253", "\xA2\x80\xD9", "Ά€Ω")
+	check("windows-1254", "\xD0\xFD\xFE", "Ğış")
+	check("windows-1255", "\xA4\xE0", "₪א")
+	check("windows-1256", "\x81\x80\xFF", "پ€ے")
+	check("windows-1257", "\xC2\xD0\xFE", "ĀŠž")
+	check("windows-1258", "\xFE\xD5", "₫Ơ")
+	check("866", "\x80\xEF\xCE", "Ая╬")
+	check("koi8", "\xC0\xE1\xBE", "юА╬")
+	check("koi8-u", "\xAE\xBE\xA4", "ўЎє")
+	check("mac", "\x80\xDB\xDF", "Ä€ﬂ")
+	check("x-mac-cyrillic", "\xAB\xFF\xDF", "Ђ€я")
+	// these go by other names here
+	check("gbk", "\x80\xD6\xD0", "€中")
+	check("gb18030", "\xA2\xE3\xD6\xD0\x81\x30\x81\x30", "€中\u0080")
+	// browsers take 0x80 for EURO SIGN in gb18030 too
+	checkTranscode(t, LookupWHATWGCharset("gb18030").NewDecoder14(), []byte("\x80"), []rune("€"))
+	// and GBK has no four-byte codes
+	checkTranscode(t, LookupWHATWGCharset("gbk").NewEncoder41(), []rune("a\u0080"), []byte("a\x00"), 1)
+	// and goes by the gb18030 index rather than CP936, which lacks e.g. these
+	check("gbk", "\xFE\x98\xA9\x92", "\u4D13\u2FF8")
+	check("sjis", "\x93\xFA\xB1", "日ｱ")
+	check("euc-kr", "\xB0\xA1\x81\x41", "가갂")
+	check("big5", "\xA4\xA4\xF9\xF9\xA2\x7E", "中═╭")
+	// HKSCS codes with lead bytes below 0xA1 are decoded, but not produced
+	checkTranscode(t, LookupWHATWGCharset("big5").NewDecoder14(), []byte("\x87\x40\x88\x62"), []rune("䏰Ê̄"))
+	checkTranscode(t, LookupWHATWGCharset("big5").NewEncoder41(), []rune("䏰中Ê"), []byte("\x00\xA4\xA4\x00"), 0, 2)
+	checkTranscode(t, LookupWHATWGCharset("big5").NewEncoder41(), []rune("\u0080"), []byte("\x00"), 0)
+}
+
+func TestWHATWGUnregistered(t *testing.T) {
+	// the Charsets of whatwgCharsets are built without registering anything,
+	// so no entry goes without a name
+	for _, info := range encodings12 {
+		if len(info.names) == 0 {
+			t.Errorf("Encoding12 %d has no name", info.id)
+		}
+	}
+	for _, info := range encodings14 {
+		if len(info.names) == 0 {
+			t.Errorf("Encoding14 %d has no name", info.id)
+		}
+	}
+	for _, info := range encodings21 {
+		if len(info.names) == 0 {
+			t.Errorf("Encoding21 %d has no name", info.id)
+		}
+	}
+	for _, info := range encodings41 {
+		if len(info.names) == 0 {
+			t.Errorf("Encoding41 %d has no name", info.id)
+		}
+	}
+}
+
+func TestXUserDefined(t *testing.T) {
+	checkRoundTrip(t, "x-user-defined", []byte("a\x80\xFF"), "a\uF780\uF7FF")
+	checkEncode(t, "x-user-defined", "\u0080a", []byte("\x00a"), 0)
+}
+
+func TestReplacement(t *testing.T) {
+	decoder := LookupWHATWGCharset("iso-2022-kr").NewDecoder14()
+	checkTranscode(t, decoder, []byte("\x1B$)Cabc"), []rune("�"), 0)
+	checkTranscode(t, decoder, []byte {}, []rune {})
+	// the error handler has its say
+	decoder = &ReplacementDecoder[rune] {ErrorHandler: DefaultErrorHandler[rune]{DEFERRHDLFL_NEGLIGENT}}
+	checkTranscode(t, decoder, []byte("abc"), []rune("�"))
+	if LookupWHATWGCharset("replacement").Directions() != CHSETDIR_DECODE {
+		t.Errorf("replacement encodes")
+	}
+}